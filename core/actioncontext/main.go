@@ -2,6 +2,7 @@ package actioncontext
 
 import (
 	"context"
+	"io"
 	"slices"
 	"time"
 
@@ -30,12 +31,21 @@ const (
 	slaveKey
 	slavesKey
 	subsetKey
+	syncDataKey
 	tagKey
 	targetKey
 	toKey
 	verboseKey
 )
 
+type (
+	// syncData is a sync data stream ingested by the sync ingest action.
+	syncData struct {
+		name string
+		r    io.Reader
+	}
+)
+
 func WithLockDisabled(ctx context.Context, v bool) context.Context {
 	return context.WithValue(ctx, lockDisabledKey, v)
 }
@@ -206,6 +216,22 @@ func Subset(ctx context.Context) string {
 	return ""
 }
 
+// WithSyncData sets the name and reader of the data stream ingested by
+// the sync resources.
+func WithSyncData(ctx context.Context, name string, r io.Reader) context.Context {
+	return context.WithValue(ctx, syncDataKey, syncData{name: name, r: r})
+}
+
+// SyncData returns the name and reader of the data stream ingested by
+// the sync resources, or an empty name if no stream is set.
+func SyncData(ctx context.Context) (string, io.Reader) {
+	if i := ctx.Value(syncDataKey); i != nil {
+		v := i.(syncData)
+		return v.name, v.r
+	}
+	return "", nil
+}
+
 func WithTarget(ctx context.Context, s []string) context.Context {
 	return context.WithValue(ctx, targetKey, s)
 }
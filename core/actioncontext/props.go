@@ -112,6 +112,10 @@ var (
 		Name: "push resinfo",
 		PG:   true,
 	}
+	Resize = Properties{
+		Name:     "resize",
+		MustLock: true,
+	}
 	Run = Properties{
 		Name:            "run",
 		TimeoutKeywords: []string{"run_timeout", "timeout"},
@@ -126,6 +130,18 @@ var (
 		TimeoutKeywords: []string{"stop_timeout", "timeout"},
 		PG:              true,
 	}
+	SnapshotCreate = Properties{
+		Name:     "snapshot_create",
+		MustLock: true,
+	}
+	SnapshotDelete = Properties{
+		Name:     "snapshot_delete",
+		MustLock: true,
+	}
+	SnapshotRollback = Properties{
+		Name:     "snapshot_rollback",
+		MustLock: true,
+	}
 	PGUpdate = Properties{
 		Name:     "pg_update",
 		MustLock: true,
@@ -74,6 +74,10 @@ type (
 		// WaitDuration is the maximum duration allowed for the Wait
 		WaitDuration time.Duration
 
+		// WaitJob polls the daemon job registry until the submitted
+		// orchestrations or actions end, and fails if they did not succeed.
+		WaitJob bool
+
 		//
 		// Watch runs a event-driven monitor on the selected objects after
 		// setting a new target. So the operator can see the orchestration
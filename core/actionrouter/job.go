@@ -0,0 +1,60 @@
+package actionrouter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+var (
+	// WaitJobInterval is the delay between two job state requests.
+	WaitJobInterval = time.Second
+)
+
+// WaitJob polls the job with the id until it reaches a terminal state, and
+// returns an error if the job did not succeed. With an empty nodename, the
+// job is searched on all the cluster nodes.
+func WaitJob(ctx context.Context, c *client.T, nodename string, id uuid.UUID) error {
+	params := api.GetJobParams{}
+	if nodename != "" {
+		params.Node = &nodename
+	}
+	ticker := time.NewTicker(WaitJobInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := c.GetJobWithResponse(ctx, id, &params)
+		if err != nil {
+			return fmt.Errorf("job %s: %w", id, err)
+		}
+		switch resp.StatusCode() {
+		case http.StatusOK:
+		case http.StatusNotFound:
+			// the job may be not yet registered on the hosting node
+		default:
+			return fmt.Errorf("job %s: unexpected status %s", id, resp.Status())
+		}
+		if j := resp.JSON200; j != nil {
+			switch j.State {
+			case api.Running:
+			case api.Succeeded:
+				return nil
+			default:
+				if j.Result != nil {
+					return fmt.Errorf("job %s %s on %s: %s", id, j.State, j.Node, *j.Result)
+				}
+				return fmt.Errorf("job %s %s on %s", id, j.State, j.Node)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("job %s: %w", id, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -27,6 +27,14 @@ type GetEvents struct {
 	Replay    bool
 	Duration  *time.Duration
 	ServedBy  string
+
+	// Since is a duration or a RFC3339 time. The journaled events
+	// published after are replayed before the live events.
+	Since *string
+
+	// LastEventID is the id of the last event received. The journaled
+	// events with a greater id are replayed before the live events.
+	LastEventID *uint64
 }
 
 func (t *GetEvents) SetDuration(duration time.Duration) *GetEvents {
@@ -39,6 +47,16 @@ func (t *GetEvents) SetLimit(limit uint64) *GetEvents {
 	return t
 }
 
+func (t *GetEvents) SetSince(s string) *GetEvents {
+	t.Since = &s
+	return t
+}
+
+func (t *GetEvents) SetLastEventID(id uint64) *GetEvents {
+	t.LastEventID = &id
+	return t
+}
+
 func (t *GetEvents) SetFilter(filters ...string) *GetEvents {
 	t.Filters = filters
 	return t
@@ -220,6 +238,12 @@ func (t *GetEvents) eventsBase() (*http.Response, error) {
 		s := t.Duration.String()
 		params.Duration = &s
 	}
+	if t.Since != nil && *t.Since != "" {
+		params.Since = t.Since
+	}
+	if t.LastEventID != nil {
+		params.LastEventID = t.LastEventID
+	}
 	resp, err := t.client.GetDaemonEvents(context.Background(), t.nodename, &params)
 	if err != nil {
 		return resp, err
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
@@ -42,9 +43,17 @@ type (
 	RefreshTransport struct {
 		Base     http.RoundTripper
 		baseURL  string
-		tokens   tokencache.Entry
 		Username string
 		Password string
+
+		// bearer is the token set with Config.Bearer, when it is not the
+		// access token of the context token cache. This explicit token
+		// takes precedence over the cached tokens.
+		bearer string
+
+		// mu protects tokens from the concurrent requests of the client.
+		mu     sync.Mutex
+		tokens tokencache.Entry
 	}
 )
 
@@ -125,13 +134,17 @@ func NewInet(config Config) (apiClient *api.ClientWithResponses, err error) {
 	}
 
 	httpClient := *cachedClient
-	httpClient.Transport = &RefreshTransport{
+	transport := &RefreshTransport{
 		Base:     baseTransport,
 		baseURL:  config.URL,
 		tokens:   config.Tokens,
 		Username: config.Username,
 		Password: config.Password,
 	}
+	if config.Bearer != config.Tokens.AccessToken {
+		transport.bearer = config.Bearer
+	}
+	httpClient.Transport = transport
 
 	if !strings.Contains(config.URL[8:], ":") {
 		config.URL += fmt.Sprintf(":%d", daemonenv.HTTPPort)
@@ -179,12 +192,21 @@ func (t *RefreshTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	ctx := req.Context()
 	base := t.getBaseTransport()
 
+	t.mu.Lock()
+	accessToken := t.tokens.AccessToken
+	isAccessTokenValid := t.isAccessTokenValid()
+	t.mu.Unlock()
+
 	reqClone := req.Clone(ctx)
-	if strings.HasSuffix(req.URL.Path, authURLPath) && (reqClone.Header != nil && strings.HasSuffix(reqClone.Header.Get("Authorization"), t.tokens.AccessToken)) {
+	if strings.HasSuffix(req.URL.Path, authURLPath) && (reqClone.Header != nil && strings.HasSuffix(reqClone.Header.Get("Authorization"), accessToken)) {
 		reqClone.Header.Del("Authorization")
 		if t.Username != "" && t.Password != "" {
 			reqClone.SetBasicAuth(t.Username, t.Password)
 		}
+	} else if auth := reqClone.Header.Get("Authorization"); t.bearer == "" && isAccessTokenValid && strings.HasPrefix(auth, "Bearer ") && auth != "Bearer "+accessToken {
+		// The request editor sets the access token loaded on client
+		// creation. Use the refreshed one to avoid a 401 round trip.
+		reqClone.Header.Set("Authorization", "Bearer "+accessToken)
 	}
 
 	resp, err := base.RoundTrip(reqClone)
@@ -192,20 +214,23 @@ func (t *RefreshTransport) RoundTrip(req *http.Request) (*http.Response, error)
 		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusUnauthorized {
+	if resp.StatusCode != http.StatusUnauthorized || t.bearer != "" {
 		return resp, nil
 	}
 
+	t.mu.Lock()
 	hasTokens := t.tokens.AccessToken != "" || t.tokens.RefreshToken != ""
 	hasCredentials := t.Username != "" && t.Password != ""
 
 	if !hasTokens && hasCredentials {
+		t.mu.Unlock()
 		return resp, nil
 	}
 
 	_ = resp.Body.Close()
 
 	newToken, err := t.authenticateOrRefresh(ctx, base)
+	t.mu.Unlock()
 	if err != nil {
 		return nil, err
 	}
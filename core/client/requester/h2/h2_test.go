@@ -0,0 +1,67 @@
+package reqh2
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/client/tokencache"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRefreshTransportBearer(t *testing.T) {
+	tokens := tokencache.Entry{
+		AccessToken:       "refreshed",
+		AccessTokenExpire: time.Now().Add(time.Hour),
+	}
+	cases := map[string]struct {
+		bearer   string
+		header   string
+		expected string
+	}{
+		"cached token is replaced by the refreshed one": {
+			header:   "Bearer loaded",
+			expected: "Bearer refreshed",
+		},
+		"explicit token takes precedence over the cached one": {
+			bearer:   "explicit",
+			header:   "Bearer explicit",
+			expected: "Bearer explicit",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent []string
+			transport := &RefreshTransport{
+				Base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					sent = append(sent, req.Header.Get("Authorization"))
+					status := http.StatusOK
+					if req.Header.Get("Authorization") != tc.expected {
+						status = http.StatusUnauthorized
+					}
+					return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(""))}, nil
+				}),
+				tokens: tokens,
+				bearer: tc.bearer,
+			}
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://node1:1215/api/node", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", tc.header)
+			resp, err := transport.RoundTrip(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, []string{tc.expected}, sent)
+		})
+	}
+}
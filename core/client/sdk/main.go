@@ -0,0 +1,127 @@
+// Package sdk is the high-level go client of the opensvc agent api.
+//
+// It wraps the generated api client of core/client with helpers the api
+// tooling would otherwise re-implement: reconnecting event and log
+// streams, object selection, batch actions and waits.
+//
+// The clients authenticated by a context token refresh their access token
+// using the context refresh token on expiry, and save the new tokens in the
+// context token cache.
+package sdk
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/util/funcopt"
+)
+
+type (
+	// T is the sdk client.
+	T struct {
+		// client is the api client used for the requests.
+		client *client.T
+
+		// stream is the api client used for the event and log streams,
+		// without timeout.
+		stream *client.T
+
+		minBackoff   time.Duration
+		maxBackoff   time.Duration
+		pollInterval time.Duration
+	}
+
+	// statusError is an unexpected api response status. The streams are
+	// not reconnected on client side errors.
+	statusError struct {
+		code   int
+		status string
+		body   []byte
+	}
+)
+
+var (
+	// DefaultMinBackoff is the default delay before the first stream
+	// reconnection.
+	DefaultMinBackoff = 500 * time.Millisecond
+
+	// DefaultMaxBackoff is the default maximum delay between two stream
+	// reconnections.
+	DefaultMaxBackoff = 30 * time.Second
+
+	// DefaultPollInterval is the default delay between two job state
+	// requests.
+	DefaultPollInterval = time.Second
+)
+
+// New returns a sdk client for the api configured by the core/client
+// options, like client.WithURL or client.WithBearer.
+func New(opts ...funcopt.O) (*T, error) {
+	c, err := client.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+	streamOpts := append(append([]funcopt.O{}, opts...), client.WithTimeout(0))
+	stream, err := client.New(streamOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &T{
+		client:       c,
+		stream:       stream,
+		minBackoff:   DefaultMinBackoff,
+		maxBackoff:   DefaultMaxBackoff,
+		pollInterval: DefaultPollInterval,
+	}, nil
+}
+
+// Client returns the api client, for the requests without sdk helper.
+func (t *T) Client() *client.T {
+	return t.client
+}
+
+// SetBackoff sets the minimum and maximum delays between two stream
+// reconnections. The delay doubles on each failed reconnection, and is
+// reset when events are received.
+func (t *T) SetBackoff(minDelay, maxDelay time.Duration) *T {
+	t.minBackoff = minDelay
+	t.maxBackoff = maxDelay
+	return t
+}
+
+// SetPollInterval sets the delay between two job state requests.
+func (t *T) SetPollInterval(d time.Duration) *T {
+	t.pollInterval = d
+	return t
+}
+
+// backoff returns the delay before the reconnection attempt.
+func (t *T) backoff(attempt int) time.Duration {
+	d := t.minBackoff
+	for i := 0; i < attempt && d < t.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > t.maxBackoff {
+		d = t.maxBackoff
+	}
+	return d
+}
+
+func newStatusError(code int, status string, body []byte) error {
+	return &statusError{code: code, status: status, body: bytes.TrimSpace(body)}
+}
+
+func (t *statusError) Error() string {
+	if len(t.body) == 0 {
+		return fmt.Sprintf("unexpected status %s", t.status)
+	}
+	return fmt.Sprintf("unexpected status %s: %s", t.status, t.body)
+}
+
+// isPermanent returns true if the request is not worth retrying.
+func (t *statusError) isPermanent() bool {
+	return t.code >= 400 && t.code < 500 && t.code != http.StatusRequestTimeout && t.code != http.StatusTooManyRequests
+}
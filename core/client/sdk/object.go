@@ -0,0 +1,137 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+)
+
+type (
+	// ActionOptions are the options of a batch action.
+	ActionOptions struct {
+		// Order is the priority waves order: "asc", "desc" or "none".
+		// Default depends on the action.
+		Order string
+
+		// Parallel is the maximum number of objects orchestrated at the
+		// same time. Default is 10.
+		Parallel int
+
+		// AllOrNothing rolls back the objects already acted on when an
+		// object fails.
+		AllOrNothing bool
+
+		// NoWait returns the batch job as soon as it is started.
+		NoWait bool
+	}
+)
+
+// Select returns the paths of the objects matching the selector
+// expression.
+func (t *T) Select(ctx context.Context, selector string) (naming.Paths, error) {
+	resp, err := t.client.GetObjectPathsWithResponse(ctx, &api.GetObjectPathsParams{Path: selector})
+	if err != nil {
+		return nil, err
+	} else if resp.StatusCode() != http.StatusOK {
+		return nil, newStatusError(resp.StatusCode(), resp.Status(), resp.Body)
+	}
+	return naming.ParsePaths(*resp.JSON200...)
+}
+
+// RunAction orchestrates the action on the objects matching the selector
+// with a batch job, and waits for the job to end. The returned error is
+// non-nil if the job did not succeed, the job per-object results tell
+// which objects failed.
+func (t *T) RunAction(ctx context.Context, selector, action string, opts ActionOptions) (*api.Job, error) {
+	body := api.PostObjectsAction{
+		Action:   action,
+		Selector: selector,
+	}
+	if opts.Order != "" {
+		body.Order = &opts.Order
+	}
+	if opts.Parallel > 0 {
+		body.Parallel = &opts.Parallel
+	}
+	if opts.AllOrNothing {
+		body.AllOrNothing = &opts.AllOrNothing
+	}
+	resp, err := t.client.PostObjectsActionWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	} else if resp.StatusCode() != http.StatusOK {
+		return nil, newStatusError(resp.StatusCode(), resp.Status(), resp.Body)
+	}
+	if opts.NoWait {
+		return resp.JSON200, nil
+	}
+	return t.WaitJob(ctx, resp.JSON200.Node, resp.JSON200.ID)
+}
+
+// WaitJob polls the job with the id until it ends, and returns the ended
+// job. The returned error is non-nil if the job did not succeed. With an
+// empty nodename, the job is searched on all the cluster nodes.
+func (t *T) WaitJob(ctx context.Context, nodename string, id uuid.UUID) (*api.Job, error) {
+	params := api.GetJobParams{}
+	if nodename != "" {
+		params.Node = &nodename
+	}
+	ticker := time.NewTicker(t.pollInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := t.client.GetJobWithResponse(ctx, id, &params)
+		switch {
+		case err != nil:
+			return nil, fmt.Errorf("job %s: %w", id, err)
+		case resp.StatusCode() == http.StatusNotFound:
+			// the job may be not yet registered on the hosting node
+		case resp.StatusCode() != http.StatusOK:
+			return nil, fmt.Errorf("job %s: %w", id, newStatusError(resp.StatusCode(), resp.Status(), resp.Body))
+		case resp.JSON200.State == api.Running:
+		case resp.JSON200.State == api.Succeeded:
+			return resp.JSON200, nil
+		case resp.JSON200.Result != nil:
+			return resp.JSON200, fmt.Errorf("job %s %s: %s", id, resp.JSON200.State, *resp.JSON200.Result)
+		default:
+			return resp.JSON200, fmt.Errorf("job %s %s", id, resp.JSON200.State)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("job %s: %w", id, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitAvail waits for the object p aggregated availability status to be
+// one of the states, up if no state is given.
+func (t *T) WaitAvail(ctx context.Context, p naming.Path, states ...status.T) error {
+	if len(states) == 0 {
+		states = []status.T{status.Up}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream := t.Events(ctx, EventsOptions{
+		Filters: []string{"ObjectStatusUpdated,path=" + p.String()},
+		Replay:  true,
+	})
+	for ev := range stream.C {
+		msg, err := msgbus.EventToMessage(*ev)
+		if err != nil {
+			continue
+		}
+		if c, ok := msg.(*msgbus.ObjectStatusUpdated); ok && slices.Contains(states, c.Value.Avail) {
+			return nil
+		}
+	}
+	return fmt.Errorf("wait %s avail %v: %w", p, states, stream.Err())
+}
@@ -0,0 +1,232 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/event"
+	"github.com/opensvc/om3/v3/core/event/sseevent"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+type (
+	// Stream is a reconnecting stream of server-sent events.
+	Stream struct {
+		// C is the channel of the received events. It is closed when the
+		// stream context is done, or on a permanent error.
+		C <-chan *event.Event
+
+		err error
+	}
+
+	// EventsOptions are the options of an event stream.
+	EventsOptions struct {
+		// Node is the node serving the events. Default is the node
+		// serving the api.
+		Node string
+
+		// Filters are the event filters, using the
+		// `om node events --filter` syntax.
+		Filters []string
+
+		// Selector is an object selector expression limiting the events
+		// to the selected objects.
+		Selector string
+
+		// Replay requests the compacted event history that creates the
+		// current cluster dataset before the live events. Once an event
+		// is received, the reconnected streams don't replay the history,
+		// but resume after the last received event id.
+		Replay bool
+	}
+
+	// LogsOptions are the options of a log stream.
+	LogsOptions struct {
+		// Node is the node serving the logs. Default is the node serving
+		// the api.
+		Node string
+
+		// Filters are the log filters, like "level=error".
+		Filters []string
+
+		// Paths limits the logs to the objects logs.
+		Paths naming.Paths
+
+		// Grep limits the logs to the messages matching the pattern.
+		Grep string
+
+		// Lines is the number of past log entries sent before the live
+		// entries on the first connection. Default is 50.
+		Lines *int
+	}
+
+	// connectFunc returns the stream response. reconnect is false on the
+	// first connection, lastID is the id of the last received event.
+	connectFunc func(ctx context.Context, reconnect bool, lastID *uint64) (*http.Response, error)
+)
+
+// Err returns the error ending the stream. It must be called after C is
+// closed.
+func (s *Stream) Err() error {
+	return s.err
+}
+
+// Events returns a stream of the daemon events. The stream is reconnected
+// on error and resumes after the last received event id, so the events
+// journaled by the daemon during the disconnection are not lost.
+func (t *T) Events(ctx context.Context, opts EventsOptions) *Stream {
+	node := opts.Node
+	if node == "" {
+		node = "localhost"
+	}
+	return t.newStream(ctx, func(ctx context.Context, _ bool, lastID *uint64) (*http.Response, error) {
+		filters := opts.Filters
+		// replay until an event is received, so a failed first
+		// connection does not lose the history.
+		replay := opts.Replay && lastID == nil
+		params := api.GetDaemonEventsParams{
+			Filter:      &filters,
+			Replay:      &replay,
+			LastEventID: lastID,
+		}
+		if opts.Selector != "" {
+			params.Selector = &opts.Selector
+		}
+		return t.stream.GetDaemonEvents(ctx, node, &params)
+	})
+}
+
+// WatchObject returns a stream of the events about the object p: the
+// object and instances status and monitor updates, and the orchestration
+// ends. The stream starts with the events describing the current object
+// state.
+func (t *T) WatchObject(ctx context.Context, p naming.Path) *Stream {
+	s := p.String()
+	return t.Events(ctx, EventsOptions{
+		Filters: []string{
+			"ObjectStatusUpdated,path=" + s,
+			"ObjectStatusDeleted,path=" + s,
+			"InstanceStatusUpdated,path=" + s,
+			"InstanceStatusDeleted,path=" + s,
+			"InstanceMonitorUpdated,path=" + s,
+			"InstanceMonitorDeleted,path=" + s,
+			"ObjectOrchestrationEnd,path=" + s,
+			"ObjectOrchestrationRefused,path=" + s,
+		},
+		Replay: true,
+	})
+}
+
+// StreamLogs returns a stream of the daemon log entries. The stream is
+// reconnected on error, without the past entries.
+func (t *T) StreamLogs(ctx context.Context, opts LogsOptions) *Stream {
+	node := opts.Node
+	if node == "" {
+		node = "localhost"
+	}
+	return t.newStream(ctx, func(ctx context.Context, reconnect bool, _ *uint64) (*http.Response, error) {
+		follow := true
+		params := api.GetNodeLogsParams{
+			Follow: &follow,
+			Lines:  opts.Lines,
+		}
+		if reconnect {
+			lines := 0
+			params.Lines = &lines
+		}
+		if len(opts.Filters) > 0 {
+			filters := opts.Filters
+			params.Filter = &filters
+		}
+		if len(opts.Paths) > 0 {
+			paths := opts.Paths.StrSlice()
+			params.Paths = &paths
+		}
+		if opts.Grep != "" {
+			params.Grep = &opts.Grep
+		}
+		return t.stream.GetNodeLogs(ctx, node, &params)
+	})
+}
+
+// newStream starts the routine connecting the stream, forwarding its
+// events and reconnecting it with backoff until ctx is done or a
+// permanent error.
+func (t *T) newStream(ctx context.Context, connect connectFunc) *Stream {
+	c := make(chan *event.Event, 100)
+	s := &Stream{C: c}
+	go func() {
+		defer close(c)
+		var (
+			lastID    *uint64
+			reconnect bool
+			attempt   int
+		)
+		for {
+			resp, err := connect(ctx, reconnect, lastID)
+			if err == nil {
+				var n int
+				n, err = t.forward(ctx, resp, c, &lastID)
+				if n > 0 {
+					attempt = 0
+				}
+			}
+			var errStatus *statusError
+			switch {
+			case ctx.Err() != nil:
+				s.err = ctx.Err()
+				return
+			case errors.As(err, &errStatus) && errStatus.isPermanent():
+				s.err = err
+				return
+			}
+			reconnect = true
+			timer := time.NewTimer(t.backoff(attempt))
+			attempt++
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				s.err = ctx.Err()
+				return
+			case <-timer.C:
+			}
+		}
+	}()
+	return s
+}
+
+// forward sends the events read from the stream response to c, until the
+// stream ends, and returns the number of events sent.
+func (t *T) forward(ctx context.Context, resp *http.Response, c chan<- *event.Event, lastID **uint64) (int, error) {
+	if resp.StatusCode != http.StatusOK {
+		defer func() { _ = resp.Body.Close() }()
+		b, _ := io.ReadAll(resp.Body)
+		return 0, newStatusError(resp.StatusCode, resp.Status, b)
+	}
+	r := sseevent.NewReadCloser(resp.Body)
+	r.SetContext(ctx)
+	defer func() { _ = r.Close() }()
+	var n int
+	for {
+		ev, err := r.Read()
+		if err != nil {
+			return n, err
+		} else if ev == nil {
+			continue
+		}
+		if ev.ID > 0 {
+			id := ev.ID
+			*lastID = &id
+		}
+		select {
+		case <-ctx.Done():
+			return n, ctx.Err()
+		case c <- ev:
+			n++
+		}
+	}
+}
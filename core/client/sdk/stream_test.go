@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/event"
+	"github.com/opensvc/om3/v3/core/event/sseevent"
+)
+
+func newTestSDK(t *testing.T, h http.Handler) *T {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(h)
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	c, err := New(client.WithURL(srv.URL), client.WithInsecureSkipVerify(true), client.WithBearer("token"))
+	require.NoError(t, err)
+	return c.SetBackoff(10*time.Millisecond, 50*time.Millisecond)
+}
+
+func TestEventsReconnect(t *testing.T) {
+	var connections atomic.Int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		writer := sseevent.NewWriter(w)
+		switch connections.Add(1) {
+		case 1:
+			assert.Equal(t, "", r.Header.Get("Last-Event-ID"))
+			assert.Equal(t, "true", r.URL.Query().Get("replay"))
+			_, _ = writer.Write(&event.Event{Kind: "ObjectStatusUpdated", ID: 1, Data: []byte(`{}`)})
+			_, _ = writer.Write(&event.Event{Kind: "ObjectStatusUpdated", ID: 2, Data: []byte(`{}`)})
+			w.(http.Flusher).Flush()
+		default:
+			assert.Equal(t, "2", r.Header.Get("Last-Event-ID"))
+			assert.Equal(t, "false", r.URL.Query().Get("replay"))
+			_, _ = writer.Write(&event.Event{Kind: "ObjectStatusUpdated", ID: 3, Data: []byte(`{}`)})
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		}
+	})
+	c := newTestSDK(t, h)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := c.Events(ctx, EventsOptions{Filters: []string{"ObjectStatusUpdated"}, Replay: true})
+	for _, id := range []uint64{1, 2, 3} {
+		select {
+		case ev := <-stream.C:
+			require.Equal(t, id, ev.ID)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout waiting for event %d", id)
+		}
+	}
+	cancel()
+	for range stream.C {
+	}
+	require.ErrorIs(t, stream.Err(), context.Canceled)
+	require.Equal(t, int32(2), connections.Load())
+}
+
+func TestEventsFirstConnectFails(t *testing.T) {
+	var connections atomic.Int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if connections.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		assert.Equal(t, "", r.Header.Get("Last-Event-ID"))
+		assert.Equal(t, "true", r.URL.Query().Get("replay"), "expected the history replayed until an event is received")
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		writer := sseevent.NewWriter(w)
+		_, _ = writer.Write(&event.Event{Kind: "ObjectStatusUpdated", ID: 1, Data: []byte(`{}`)})
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	})
+	c := newTestSDK(t, h)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := c.Events(ctx, EventsOptions{Replay: true})
+	select {
+	case ev := <-stream.C:
+		require.Equal(t, uint64(1), ev.ID)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout waiting for event 1")
+	}
+	cancel()
+	for range stream.C {
+	}
+	require.Equal(t, int32(2), connections.Load())
+}
+
+func TestEventsPermanentError(t *testing.T) {
+	var connections atomic.Int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connections.Add(1)
+		w.WriteHeader(http.StatusForbidden)
+	})
+	c := newTestSDK(t, h)
+
+	stream := c.Events(context.Background(), EventsOptions{})
+	for range stream.C {
+	}
+	require.ErrorContains(t, stream.Err(), "403")
+	require.Equal(t, int32(1), connections.Load())
+}
+
+func TestBackoff(t *testing.T) {
+	c := &T{}
+	c.SetBackoff(time.Second, 5*time.Second)
+	require.Equal(t, time.Second, c.backoff(0))
+	require.Equal(t, 2*time.Second, c.backoff(1))
+	require.Equal(t, 4*time.Second, c.backoff(2))
+	require.Equal(t, 5*time.Second, c.backoff(3))
+	require.Equal(t, 5*time.Second, c.backoff(100))
+}
@@ -1,10 +1,14 @@
 package cluster
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"golang.org/x/time/rate"
 
+	"github.com/opensvc/om3/v3/daemon/rbac"
 	"github.com/opensvc/om3/v3/util/file"
 )
 
@@ -15,14 +19,22 @@ type (
 	// The cluster name is used as the right most part of cluster dns
 	// names.
 	Config struct {
-		Issues     []string       `json:"issues"`
-		ID         string         `json:"id"`
-		Name       string         `json:"name"`
-		Nodes      Nodes          `json:"nodes"`
-		DNS        []string       `json:"dns"`
-		CASecPaths []string       `json:"ca_sec_paths"`
-		Listener   ConfigListener `json:"listener"`
-		Quorum     bool           `json:"quorum"`
+		Issues     []string        `json:"issues"`
+		ID         string          `json:"id"`
+		Name       string          `json:"name"`
+		Nodes      Nodes           `json:"nodes"`
+		DNS        []string        `json:"dns"`
+		CASecPaths []string        `json:"ca_sec_paths"`
+		Listener   ConfigListener  `json:"listener"`
+		Quorum     bool            `json:"quorum"`
+		Rebalance  ConfigRebalance `json:"rebalance"`
+
+		// Roles is the custom roles declared in the role#<name> sections.
+		Roles rbac.CustomRoles `json:"roles"`
+
+		// LDAP is the ldap authentication strategy configuration, declared
+		// in the ldap and ldap_group#<name> sections.
+		LDAP ConfigLDAP `json:"ldap"`
 
 		// fields private, no exposed in daemon data
 		// json nor events
@@ -37,6 +49,53 @@ type (
 		Expires time.Duration `json:"expires"`
 	}
 
+	// ConfigRebalance describes the automatic rebalancing of the ha objects
+	// with a non-optimal placement.
+	ConfigRebalance struct {
+		Enabled     bool          `json:"enabled"`
+		Interval    time.Duration `json:"interval"`
+		MaxParallel int           `json:"max_parallel"`
+		Schedule    string        `json:"schedule"`
+	}
+
+	// ConfigLDAP describes the ldap directory used to authenticate the api
+	// users, and the mapping of the user groups to grants.
+	ConfigLDAP struct {
+		URL            string            `json:"url"`
+		BindDN         string            `json:"bind_dn"`
+		BaseDN         string            `json:"base_dn"`
+		UserFilter     string            `json:"user_filter"`
+		GroupAttribute string            `json:"group_attribute"`
+		CA             string            `json:"ca"`
+		StartTLS       bool              `json:"start_tls"`
+		Insecure       bool              `json:"insecure"`
+		Groups         []ConfigLDAPGroup `json:"groups"`
+
+		// bindPassword is private, not exposed in daemon data json nor
+		// events
+		bindPassword string
+	}
+
+	// ConfigLDAPGroup describes the grants of the members of a ldap group.
+	ConfigLDAPGroup struct {
+		Name   string   `json:"name"`
+		DN     string   `json:"dn"`
+		Grants []string `json:"grants"`
+	}
+
+	// ConfigRateLimit describes a rate limit and a concurrent stream quota
+	// applied per user to the api requests of the users, roles and
+	// endpoint groups it matches. An empty list matches all.
+	ConfigRateLimit struct {
+		Name      string     `json:"name"`
+		Users     []string   `json:"users"`
+		Roles     []string   `json:"roles"`
+		Endpoints []string   `json:"endpoints"`
+		Rate      rate.Limit `json:"rate"`
+		Burst     int        `json:"burst"`
+		Streams   int        `json:"streams"`
+	}
+
 	ConfigListener struct {
 		CRL            string            `json:"crl"`
 		Addr           string            `json:"addr"`
@@ -46,6 +105,7 @@ type (
 		DNSSockGID     string            `json:"dns_sock_gid"`
 		DNSSockUID     string            `json:"dns_sock_uid"`
 		RateLimiter    RateLimiterConfig `json:"rate_limiter"`
+		RateLimits     []ConfigRateLimit `json:"rate_limits"`
 	}
 )
 
@@ -77,8 +137,11 @@ func (t *Config) DeepCopy() *Config {
 		Nodes:      append(Nodes{}, t.Nodes...),
 		DNS:        append([]string{}, t.DNS...),
 		CASecPaths: append([]string{}, t.CASecPaths...),
-		Listener:   t.Listener,
+		Listener:   t.Listener.DeepCopy(),
 		Quorum:     t.Quorum,
+		Rebalance:  t.Rebalance,
+		Roles:      t.Roles.DeepCopy(),
+		LDAP:       t.LDAP.DeepCopy(),
 		secret:     t.secret,
 		sshKeyFile: t.sshKeyFile,
 	}
@@ -90,3 +153,83 @@ func (t *Config) SSHKeyFile() (string, bool) {
 	ok, _ := file.ExistsAndRegular(t.sshKeyFile)
 	return t.sshKeyFile, ok
 }
+
+func (t ConfigLDAP) BindPassword() string {
+	return t.bindPassword
+}
+
+func (t *ConfigLDAP) SetBindPassword(s string) {
+	t.bindPassword = s
+}
+
+// IsEnabled returns true if a ldap directory url is configured.
+func (t ConfigLDAP) IsEnabled() bool {
+	return t.URL != ""
+}
+
+// ValidateTransport returns an error if the credentials would be sent in
+// clear text to the directory: a ldap:// url requires start_tls, unless
+// insecure is explicitly set.
+func (t ConfigLDAP) ValidateTransport() error {
+	u, err := url.Parse(t.URL)
+	if err != nil {
+		return fmt.Errorf("invalid ldap url %s: %w", t.URL, err)
+	}
+	switch u.Scheme {
+	case "ldaps":
+		if t.StartTLS {
+			return fmt.Errorf("invalid ldap url %s: start_tls is not supported with the ldaps scheme", t.URL)
+		}
+	case "ldap":
+		if !t.StartTLS && !t.Insecure {
+			return fmt.Errorf("invalid ldap url %s: the ldap scheme requires start_tls, or insecure to allow clear text credentials", t.URL)
+		}
+	default:
+		return fmt.Errorf("invalid ldap url %s: expecting the ldap or ldaps scheme", t.URL)
+	}
+	return nil
+}
+
+// Grants returns the grants of the configured groups having their dn in
+// the groups list. The dn comparison is case insensitive.
+func (t ConfigLDAP) Grants(groups []string) []string {
+	grants := make([]string, 0)
+	seen := make(map[string]any)
+	for _, group := range t.Groups {
+		for _, dn := range groups {
+			if !strings.EqualFold(group.DN, dn) {
+				continue
+			}
+			for _, grant := range group.Grants {
+				if _, ok := seen[grant]; ok {
+					continue
+				}
+				seen[grant] = nil
+				grants = append(grants, grant)
+			}
+		}
+	}
+	return grants
+}
+
+func (t ConfigListener) DeepCopy() ConfigListener {
+	n := t
+	n.RateLimits = make([]ConfigRateLimit, len(t.RateLimits))
+	for i, limit := range t.RateLimits {
+		limit.Users = append([]string{}, limit.Users...)
+		limit.Roles = append([]string{}, limit.Roles...)
+		limit.Endpoints = append([]string{}, limit.Endpoints...)
+		n.RateLimits[i] = limit
+	}
+	return n
+}
+
+func (t ConfigLDAP) DeepCopy() ConfigLDAP {
+	n := t
+	n.Groups = make([]ConfigLDAPGroup, len(t.Groups))
+	for i, group := range t.Groups {
+		group.Grants = append([]string{}, group.Grants...)
+		n.Groups[i] = group
+	}
+	return n
+}
@@ -13,6 +13,7 @@ type (
 		Insecure     bool          `json:"insecure"`
 		PingInterval time.Duration `json:"ping_interval"`
 		StatusDelay  time.Duration `json:"status_delay"`
+		Audit        bool          `json:"audit"`
 
 		// Hidden fields
 		Password string `json:"-"`
@@ -0,0 +1,80 @@
+package commoncmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+type (
+	CmdAuthCanI struct {
+		Color  string
+		Output string
+	}
+)
+
+func NewCmdAuth() *cobra.Command {
+	return &cobra.Command{
+		Use:   "auth",
+		Short: "inspect the api access control",
+	}
+}
+
+func NewCmdAuthCanI() *cobra.Command {
+	var options CmdAuthCanI
+	cmd := &cobra.Command{
+		Use:   "can-i <action> <path>",
+		Short: "report if the current user is allowed an action on an object",
+		Long: "Report if the current user is allowed an action on an object, " +
+			"and explain the decision: the grant of the builtin or custom role allowing the action, " +
+			"or why the custom roles granted to the user do not apply.",
+		Example: "om auth can-i restart prod/svc/web1",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(args[0], args[1])
+		},
+	}
+	flags := cmd.Flags()
+	FlagColor(flags, &options.Color)
+	FlagOutput(flags, &options.Output)
+	return cmd
+}
+
+func (t *CmdAuthCanI) Run(action, path string) error {
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	params := api.GetAuthCanIParams{
+		Action: action,
+		Path:   path,
+	}
+	resp, err := c.GetAuthCanIWithResponse(context.Background(), &params)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	case 400:
+		return fmt.Errorf("%s", *resp.JSON400)
+	case 401:
+		return fmt.Errorf("%s", *resp.JSON401)
+	default:
+		return fmt.Errorf("unexpected status [%d]", resp.StatusCode())
+	}
+	output.Renderer{
+		DefaultOutput: "tab=ALLOWED:allowed,GRANT:grant,REASON:reason",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          *resp.JSON200,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
@@ -34,6 +34,10 @@ type (
 		// Replay is used to enable replay event from the cached state
 		Replay bool
 
+		// Since is used to enable replay of the journaled events
+		// published after this duration ago or RFC3339 time.
+		Since string
+
 		Quiet  bool
 		templ  *template.Template
 		helper *templateHelper
@@ -261,6 +265,10 @@ func (t *CmdDaemonEvents) nodeEventLoop(ctx context.Context, nodename string) {
 	var (
 		retries    = 0
 		maxRetries = 600
+
+		// lastEventID is the id of the last received event, used to
+		// resume the event stream without loss on reconnect.
+		lastEventID uint64
 	)
 
 	if t.Duration > 0 {
@@ -268,7 +276,7 @@ func (t *CmdDaemonEvents) nodeEventLoop(ctx context.Context, nodename string) {
 		ctx, cancel = context.WithTimeout(ctx, t.Duration)
 		defer cancel()
 	}
-	evReader, err := t.getEvReader(ctx, nodename)
+	evReader, err := t.getEvReader(ctx, nodename, 0)
 	if err != nil {
 		t.errC <- fmt.Errorf("getEvReader %s: %w", nodename, err)
 		return
@@ -298,6 +306,7 @@ func (t *CmdDaemonEvents) nodeEventLoop(ctx context.Context, nodename string) {
 			if err != nil {
 				break
 			}
+			lastEventID = ev.ID
 			t.evC <- ev
 		}
 		for { // get reader retry loop
@@ -324,7 +333,7 @@ func (t *CmdDaemonEvents) nodeEventLoop(ctx context.Context, nodename string) {
 				_, _ = fmt.Fprintf(os.Stderr, "event read failed for node %s: '%s'\n", nodename, err)
 				_, _ = fmt.Fprintln(os.Stderr, "press ctrl+c to interrupt retries")
 			}
-			evReader, err = t.getEvReader(ctx, nodename)
+			evReader, err = t.getEvReader(ctx, nodename, lastEventID)
 			if err == nil {
 				_, _ = fmt.Fprintf(os.Stderr, "retry %d of %d ok for %s\n", retries, maxRetries, nodename)
 				retries = 0
@@ -335,16 +344,23 @@ func (t *CmdDaemonEvents) nodeEventLoop(ctx context.Context, nodename string) {
 	}
 }
 
-func (t *CmdDaemonEvents) getEvReader(ctx context.Context, nodename string) (event.ReadCloser, error) {
-	return t.cli.NewGetEvents().
+// getEvReader returns an event reader of the nodename event stream. A
+// non-zero lastEventID resumes the stream after this event id.
+func (t *CmdDaemonEvents) getEvReader(ctx context.Context, nodename string, lastEventID uint64) (event.ReadCloser, error) {
+	getter := t.cli.NewGetEvents().
 		SetRelatives(false).
 		SetLimit(t.Limit).
 		SetReplay(t.Wait || t.Replay).
 		SetFilters(t.Filters).
 		SetDuration(t.Duration).
 		SetNodename(nodename).
-		SetSelector(t.ObjectSelector).
-		GetReader(ctx)
+		SetSelector(t.ObjectSelector)
+	if lastEventID > 0 {
+		getter = getter.SetLastEventID(lastEventID)
+	} else if t.Since != "" {
+		getter = getter.SetSince(t.Since)
+	}
+	return getter.GetReader(ctx)
 }
 
 func (t *CmdDaemonEvents) doEvent(e event.Event) {
@@ -119,7 +119,7 @@ func (t *CmdDaemonHeartbeatStatus) Run() error {
 		return table[i].Peer < table[j].Peer
 	})
 	output.Renderer{
-		DefaultOutput: "tab=RUNNING:.state_icon,BEATING:.beating_icon,ID:.id,NODE:.node,PEER:.peer,TYPE:.type,DESC:.desc,CHANGED_AT:.changed_at",
+		DefaultOutput: "tab=RUNNING:.state_icon,BEATING:.beating_icon,ID:.id,NODE:.node,PEER:.peer,TYPE:.type,DESC:.desc,CODEC:.codec,MODE:.mode,LATENCY:.latency,JITTER:.jitter,LOSS:.loss,TIMEOUT:.timeout,CHANGED_AT:.changed_at",
 		Output:        t.Output,
 		Color:         t.Color,
 		Data:          table,
@@ -56,6 +56,13 @@ func FlagsAsync(flags *pflag.FlagSet, p *OptsAsync) {
 	FlagWatch(flags, &p.Watch)
 }
 
+// FlagsAsyncJob adds the async flags and the --wait-job flag, for the
+// actions tracked by the daemon job registry.
+func FlagsAsyncJob(flags *pflag.FlagSet, p *OptsAsync) {
+	FlagsAsync(flags, p)
+	FlagWaitJob(flags, &p.WaitJob)
+}
+
 func FlagsLogs(flags *pflag.FlagSet, p *OptsLogs) {
 	flags.BoolVarP(&p.Follow, "follow", "f", false, "follow the log feed")
 	flags.IntVarP(&p.Lines, "lines", "n", 50, "report the last n log entries")
@@ -185,6 +192,10 @@ func FlagEventReplay(flags *pflag.FlagSet, p *bool) {
 	flags.BoolVar(p, "replay", false, "enable replay event from the cluster known state")
 }
 
+func FlagEventSince(flags *pflag.FlagSet, p *string) {
+	flags.StringVar(p, "since", "", "replay the journaled events more recent than this duration (ex: 10m) or RFC3339 time")
+}
+
 func FlagEventFilters(flags *pflag.FlagSet, p *[]string) {
 	flags.StringArrayVar(p, "filter", []string{}, "filter events on kind, labels and data (see above)")
 }
@@ -551,6 +562,14 @@ func FlagMoveTo(flags *pflag.FlagSet, p *string) {
 	flags.StringVar(p, "move-to", "", "live-migrate capable resources destination")
 }
 
+func FlagSize(flags *pflag.FlagSet, p *string) {
+	flags.StringVar(p, "size", "", "the new volume size (ex: 10g)")
+}
+
+func FlagSnapshotName(flags *pflag.FlagSet, p *string) {
+	flags.StringVar(p, "name", "", "the volume snapshot name")
+}
+
 func FlagSubset(flags *pflag.FlagSet, p *string) {
 	flags.StringVar(p, "subset", "", "a subset selector expression (ex: g1,g2)")
 }
@@ -607,6 +626,10 @@ func FlagWait(flags *pflag.FlagSet, p *bool) {
 	flags.BoolVar(p, "wait", false, "wait for the object to reach the target state")
 }
 
+func FlagWaitJob(flags *pflag.FlagSet, p *bool) {
+	flags.BoolVar(p, "wait-job", false, "wait for the daemon jobs of the action to end, and fail if they did not succeed")
+}
+
 func FlagWaitLock(flags *pflag.FlagSet, p *time.Duration) {
 	flags.DurationVar(p, "waitlock", 30*time.Second, "lock acquire timeout")
 }
@@ -0,0 +1,269 @@
+package commoncmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/opensvc/om3/v3/core/actionrouter"
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+type (
+	CmdJobList struct {
+		Color  string
+		Output string
+		Node   string
+	}
+
+	CmdJobShow struct {
+		Color  string
+		Output string
+		Node   string
+	}
+
+	CmdJobCancel struct {
+		Color  string
+		Output string
+		Node   string
+	}
+
+	CmdJobWait struct {
+		Node string
+		Time time.Duration
+	}
+)
+
+const (
+	jobDefaultOutput = "tab=ID:id,KIND:kind,NODE:node,PATH:path,ACTION:action,USER:user,STATE:state,CREATED_AT:created_at,ENDED_AT:ended_at"
+)
+
+func NewCmdJob() *cobra.Command {
+	return &cobra.Command{
+		Use:   "job",
+		Short: "inspect the daemon jobs of the api-triggered actions",
+		Long: "The daemons record a job for each orchestration and action submitted via the api, " +
+			"with its state, per-node and per-resource steps, output excerpt and result. " +
+			"The ended jobs are kept for a retention window.",
+	}
+}
+
+func flagJobNode(cmd *cobra.Command, p *string) {
+	cmd.Flags().StringVar(p, "node", "", "the node hosting the job record (default: all nodes)")
+}
+
+func jobParseID(s string) (uuid.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid job id %s: %w", s, err)
+	}
+	return id, nil
+}
+
+func jobNodeParam(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func NewCmdJobList() *cobra.Command {
+	var options CmdJobList
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "list the daemon jobs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	FlagColor(flags, &options.Color)
+	FlagOutput(flags, &options.Output)
+	flagJobNode(cmd, &options.Node)
+	return cmd
+}
+
+func (t *CmdJobList) Run() error {
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	params := api.GetJobsParams{Node: jobNodeParam(t.Node)}
+	resp, err := c.GetJobsWithResponse(context.Background(), &params)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	case 400:
+		return fmt.Errorf("%s", *resp.JSON400)
+	case 401:
+		return fmt.Errorf("%s", *resp.JSON401)
+	case 403:
+		return fmt.Errorf("%s", *resp.JSON403)
+	default:
+		return fmt.Errorf("unexpected status [%d]", resp.StatusCode())
+	}
+	output.Renderer{
+		DefaultOutput: jobDefaultOutput,
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          *resp.JSON200,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
+
+func NewCmdJobShow() *cobra.Command {
+	var options CmdJobShow
+	cmd := &cobra.Command{
+		Use:   "show <id>",
+		Short: "show a daemon job, with its steps, output excerpt and result",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(args[0])
+		},
+	}
+	flags := cmd.Flags()
+	FlagColor(flags, &options.Color)
+	FlagOutput(flags, &options.Output)
+	flagJobNode(cmd, &options.Node)
+	return cmd
+}
+
+func (t *CmdJobShow) Run(s string) error {
+	id, err := jobParseID(s)
+	if err != nil {
+		return err
+	}
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	params := api.GetJobParams{Node: jobNodeParam(t.Node)}
+	resp, err := c.GetJobWithResponse(context.Background(), id, &params)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	case 400:
+		return fmt.Errorf("%s", *resp.JSON400)
+	case 401:
+		return fmt.Errorf("%s", *resp.JSON401)
+	case 403:
+		return fmt.Errorf("%s", *resp.JSON403)
+	case 404:
+		return fmt.Errorf("%s", *resp.JSON404)
+	default:
+		return fmt.Errorf("unexpected status [%d]", resp.StatusCode())
+	}
+	output.Renderer{
+		DefaultOutput: "json",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          *resp.JSON200,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
+
+func NewCmdJobCancel() *cobra.Command {
+	var options CmdJobCancel
+	cmd := &cobra.Command{
+		Use:   "cancel <id>",
+		Short: "cancel a running daemon job",
+		Long: "Cancel a running daemon job: the orchestration of an orchestration job is aborted, " +
+			"the process of an action job is terminated.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(args[0])
+		},
+	}
+	flags := cmd.Flags()
+	FlagColor(flags, &options.Color)
+	FlagOutput(flags, &options.Output)
+	flagJobNode(cmd, &options.Node)
+	return cmd
+}
+
+func (t *CmdJobCancel) Run(s string) error {
+	id, err := jobParseID(s)
+	if err != nil {
+		return err
+	}
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	params := api.PostJobCancelParams{Node: jobNodeParam(t.Node)}
+	resp, err := c.PostJobCancelWithResponse(context.Background(), id, &params)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	case 400:
+		return fmt.Errorf("%s", *resp.JSON400)
+	case 401:
+		return fmt.Errorf("%s", *resp.JSON401)
+	case 403:
+		return fmt.Errorf("%s", *resp.JSON403)
+	case 404:
+		return fmt.Errorf("%s", *resp.JSON404)
+	case 409:
+		return fmt.Errorf("%s", *resp.JSON409)
+	default:
+		return fmt.Errorf("unexpected status [%d]", resp.StatusCode())
+	}
+	output.Renderer{
+		DefaultOutput: jobDefaultOutput,
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          *resp.JSON200,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
+
+func NewCmdJobWait() *cobra.Command {
+	var options CmdJobWait
+	cmd := &cobra.Command{
+		Use:   "wait <id>",
+		Short: "wait for a daemon job to end, and fail if it did not succeed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(args[0])
+		},
+	}
+	flags := cmd.Flags()
+	flagJobNode(cmd, &options.Node)
+	flags.DurationVar(&options.Time, "time", 0, "stop waiting for the job to end after a duration")
+	return cmd
+}
+
+func (t *CmdJobWait) Run(s string) error {
+	id, err := jobParseID(s)
+	if err != nil {
+		return err
+	}
+	c, err := client.New(client.WithTimeout(0))
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	if t.Time > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.Time)
+		defer cancel()
+	}
+	return actionrouter.WaitJob(ctx, c, t.Node, id)
+}
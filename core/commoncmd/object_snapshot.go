@@ -0,0 +1,13 @@
+package commoncmd
+
+import "github.com/spf13/cobra"
+
+func NewCmdObjectSnapshot(kind string) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupIDSubsystems,
+		Use:     "snapshot",
+		Short:   "manage the volume data snapshots",
+		Aliases: []string{"snap"},
+	}
+	return cmd
+}
@@ -3,6 +3,7 @@ package commoncmd
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/opensvc/om3/v3/core/client"
 	"github.com/opensvc/om3/v3/core/output"
@@ -72,5 +73,23 @@ func (t *CmdPoolList) Run() error {
 	}
 
 	render(l)
+	printPoolWarnings(l)
 	return nil
 }
+
+// printPoolWarnings prints the pools warnings, like thin pools nearly
+// full, on stderr so they are noticed whatever the output format.
+func printPoolWarnings(items api.PoolItems) {
+	for _, item := range items {
+		if item.Warnings == nil {
+			continue
+		}
+		name := item.Name
+		if item.Node != "" {
+			name += "@" + item.Node
+		}
+		for _, s := range *item.Warnings {
+			fmt.Fprintf(os.Stderr, "warning: pool %s: %s\n", name, s)
+		}
+	}
+}
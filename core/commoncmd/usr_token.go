@@ -0,0 +1,271 @@
+package commoncmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/objectselector"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+type (
+	CmdUsrTokenAdd struct {
+		OptsGlobal
+		Name     string
+		Roles    []string
+		Scope    string
+		Duration string
+	}
+
+	CmdUsrTokenList struct {
+		OptsGlobal
+	}
+
+	CmdUsrTokenRevoke struct {
+		OptsGlobal
+		Name string
+	}
+)
+
+const (
+	usrTokenCols = "OBJECT:object,NAME:name,ID:id,GRANT:grant,CREATED_AT:created_at,EXPIRE_AT:expire_at,LAST_USED_AT:last_used_at,REVOKED_AT:revoked_at"
+)
+
+func NewCmdUsrToken() *cobra.Command {
+	return &cobra.Command{
+		GroupID: GroupIDSubsystems,
+		Use:     "token",
+		Short:   "manage the long-lived api tokens of the user",
+		Long: "The api tokens are signed by the cluster and bound to the usr object. " +
+			"They can be revoked before their expiry, and are meant to be used by unattended " +
+			"clients like ci pipelines, instead of the user password.",
+	}
+}
+
+func NewCmdUsrTokenAdd(kind string) *cobra.Command {
+	var options CmdUsrTokenAdd
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "create a new api token and print its value",
+		Long:  "The token value is printed only once. The token grants are the user grants matching the --role and --scope filters.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	FlagObjectSelector(flags, &options.ObjectSelector)
+	FlagColor(flags, &options.Color)
+	FlagOutput(flags, &options.Output)
+	FlagRoles(flags, &options.Roles)
+	flags.StringVar(&options.Name, "name", "", "the token name")
+	flags.StringVar(&options.Scope, "scope", "", "the scope of the token grant")
+	flags.StringVar(&options.Duration, "duration", "", "the token validity duration (default 90d, max 3650d)")
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func NewCmdUsrTokenList(kind string) *cobra.Command {
+	var options CmdUsrTokenList
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "list the api tokens",
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	FlagObjectSelector(flags, &options.ObjectSelector)
+	FlagColor(flags, &options.Color)
+	FlagOutput(flags, &options.Output)
+	return cmd
+}
+
+func NewCmdUsrTokenRevoke(kind string) *cobra.Command {
+	var options CmdUsrTokenRevoke
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "revoke an api token",
+		Long:  "The revoked token is rejected by all the cluster nodes, even before its expiry.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	FlagObjectSelector(flags, &options.ObjectSelector)
+	FlagColor(flags, &options.Color)
+	FlagOutput(flags, &options.Output)
+	flags.StringVar(&options.Name, "name", "", "the token name")
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func usrTokenPaths(c *client.T, selector, kind string) (naming.Paths, error) {
+	mergedSelector := MergeSelector("", selector, kind, "")
+	return objectselector.New(mergedSelector, objectselector.WithClient(c)).MustExpand()
+}
+
+func (t *CmdUsrTokenAdd) Run(kind string) error {
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	paths, err := usrTokenPaths(c, t.ObjectSelector, kind)
+	if err != nil {
+		return err
+	}
+	params := api.PostObjectTokenParams{
+		Name: t.Name,
+	}
+	if t.Scope != "" {
+		params.Scope = &t.Scope
+	}
+	if t.Duration != "" {
+		params.Duration = &t.Duration
+	}
+	if len(t.Roles) > 0 {
+		roles := make(api.Roles, len(t.Roles))
+		for i, s := range t.Roles {
+			roles[i] = api.Role(s)
+		}
+		params.Role = &roles
+	}
+	l := make([]api.UsrToken, 0, len(paths))
+	var errs error
+	for _, p := range paths {
+		resp, err := c.PostObjectTokenWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, &params)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", p, err))
+			continue
+		}
+		switch resp.StatusCode() {
+		case http.StatusOK:
+			l = append(l, *resp.JSON200)
+		case 400:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON400))
+		case 401:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON401))
+		case 403:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON403))
+		case 404:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON404))
+		case 409:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON409))
+		case 500:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON500))
+		default:
+			errs = errors.Join(errs, fmt.Errorf("%s: unexpected status [%d]", p, resp.StatusCode()))
+		}
+	}
+	output.Renderer{
+		DefaultOutput: "tab=:token",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          l,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return errs
+}
+
+func (t *CmdUsrTokenList) Run(kind string) error {
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	paths, err := usrTokenPaths(c, t.ObjectSelector, kind)
+	if err != nil {
+		return err
+	}
+	l := make(api.UsrTokenItems, 0)
+	var errs error
+	for _, p := range paths {
+		resp, err := c.GetObjectTokensWithResponse(context.Background(), p.Namespace, p.Kind, p.Name)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", p, err))
+			continue
+		}
+		switch resp.StatusCode() {
+		case http.StatusOK:
+			l = append(l, resp.JSON200.Items...)
+		case 400:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON400))
+		case 401:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON401))
+		case 403:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON403))
+		case 404:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON404))
+		case 500:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON500))
+		default:
+			errs = errors.Join(errs, fmt.Errorf("%s: unexpected status [%d]", p, resp.StatusCode()))
+		}
+	}
+	output.Renderer{
+		DefaultOutput: "tab=" + usrTokenCols,
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          api.UsrTokenList{Kind: "UsrTokenList", Items: l},
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return errs
+}
+
+func (t *CmdUsrTokenRevoke) Run(kind string) error {
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	paths, err := usrTokenPaths(c, t.ObjectSelector, kind)
+	if err != nil {
+		return err
+	}
+	params := api.DeleteObjectTokenParams{
+		Name: t.Name,
+	}
+	l := make(api.UsrTokenItems, 0, len(paths))
+	var errs error
+	for _, p := range paths {
+		resp, err := c.DeleteObjectTokenWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, &params)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", p, err))
+			continue
+		}
+		switch resp.StatusCode() {
+		case http.StatusOK:
+			l = append(l, *resp.JSON200)
+		case 400:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON400))
+		case 401:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON401))
+		case 403:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON403))
+		case 404:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON404))
+		case 500:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", p, *resp.JSON500))
+		default:
+			errs = errors.Join(errs, fmt.Errorf("%s: unexpected status [%d]", p, resp.StatusCode()))
+		}
+	}
+	output.Renderer{
+		DefaultOutput: "tab=" + usrTokenCols,
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          api.UsrTokenList{Kind: "UsrTokenList", Items: l},
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return errs
+}
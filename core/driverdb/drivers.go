@@ -46,6 +46,10 @@ import (
 	_ "github.com/opensvc/om3/v3/drivers/ressynczfssnap"
 	_ "github.com/opensvc/om3/v3/drivers/restaskhost"
 	_ "github.com/opensvc/om3/v3/drivers/resvol"
+	_ "github.com/opensvc/om3/v3/drivers/stonithcommand"
+	_ "github.com/opensvc/om3/v3/drivers/stonithdummy"
+	_ "github.com/opensvc/om3/v3/drivers/stonithipmi"
+	_ "github.com/opensvc/om3/v3/drivers/stonithssh"
 )
 
 func init() {
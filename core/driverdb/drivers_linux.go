@@ -7,8 +7,10 @@ import (
 	_ "github.com/opensvc/om3/v3/drivers/networkbridge"
 	_ "github.com/opensvc/om3/v3/drivers/networklo"
 	_ "github.com/opensvc/om3/v3/drivers/networkroutedbridge"
+	_ "github.com/opensvc/om3/v3/drivers/poolbtrfs"
 	_ "github.com/opensvc/om3/v3/drivers/pooldrbd"
 	_ "github.com/opensvc/om3/v3/drivers/poolloop"
+	_ "github.com/opensvc/om3/v3/drivers/poollvmthin"
 	_ "github.com/opensvc/om3/v3/drivers/poolrados"
 	_ "github.com/opensvc/om3/v3/drivers/poolvg"
 	_ "github.com/opensvc/om3/v3/drivers/rescontainerdocker"
@@ -24,6 +26,7 @@ import (
 	_ "github.com/opensvc/om3/v3/drivers/resdiskzvol"
 	_ "github.com/opensvc/om3/v3/drivers/resipcni"
 	_ "github.com/opensvc/om3/v3/drivers/resipnetns"
+	_ "github.com/opensvc/om3/v3/drivers/ressyncbtrfs"
 	_ "github.com/opensvc/om3/v3/drivers/restaskdocker"
 	_ "github.com/opensvc/om3/v3/drivers/restaskoci"
 	_ "github.com/opensvc/om3/v3/drivers/restaskpodman"
@@ -56,6 +56,10 @@ type (
 		timeout  time.Duration
 		interval time.Duration
 
+		// maxMsgSize is the size limit of the messages the driver can
+		// transmit, zero if unlimited.
+		maxMsgSize int
+
 		// sig configured signature to detect conf changes
 		sig string
 	}
@@ -78,6 +82,8 @@ type (
 		Config() *xconfig.T
 		Interval() time.Duration
 		Timeout() time.Duration
+		MaxTimeout() time.Duration
+		MaxMsgSize() int
 		Tx() hbtype.Transmitter
 		Rx() hbtype.Receiver
 		Nodes() []string
@@ -90,6 +96,7 @@ type (
 		SetTx(transmitter hbtype.Transmitter)
 		SetInterval(time.Duration)
 		SetTimeout(time.Duration)
+		SetMaxMsgSize(int)
 		SetNodes([]string)
 		SetSignature(string)
 	}
@@ -225,6 +232,12 @@ func (t *T) SetTimeout(timeout time.Duration) {
 	t.timeout = timeout
 }
 
+// SetMaxMsgSize sets the size limit of the messages the driver can transmit.
+// Zero means unlimited.
+func (t *T) SetMaxMsgSize(size int) {
+	t.maxMsgSize = size
+}
+
 // SetSignature set a string that identifies config details
 func (t *T) SetSignature(s string) {
 	t.sig = s
@@ -242,3 +255,32 @@ func (t *T) Interval() time.Duration {
 func (t *T) Timeout() time.Duration {
 	return t.timeout
 }
+
+// MaxTimeout returns the upper bound of the peer adaptive timeout, or zero
+// if the adaptive timeout is disabled.
+//
+// The upper bound defaults to 3 times the timeout.
+func (t *T) MaxTimeout() time.Duration {
+	if !t.GetBool("adaptive_timeout") {
+		return 0
+	}
+	if d := t.GetDuration("timeout_max", 0); d > t.timeout {
+		return d
+	}
+	return 3 * t.timeout
+}
+
+// MaxMsgSize returns the size budget of the transmitted messages, or zero if
+// unlimited.
+//
+// The budget is the max_msg_size keyword value, bounded by the driver size
+// limit.
+func (t *T) MaxMsgSize() int {
+	size := t.maxMsgSize
+	if p := t.Config().GetSize(key.New(t.name, "max_msg_size")); p != nil && *p > 0 {
+		if size == 0 || int(*p) < size {
+			size = int(*p)
+		}
+	}
+	return size
+}
@@ -20,6 +20,11 @@ type (
 		Events    map[string][]event.Event `json:"events,omitempty"`
 		NodeData  node.Node                `json:"node_data,omitempty"`
 		Nodename  string                   `json:"nodename"`
+
+		// Codecs is the list of compression codecs the sender node can
+		// decode. The peers use it to negotiate the compression codec of
+		// the messages they send.
+		Codecs []string `json:"codecs,omitempty"`
 	}
 
 	// IDStopper is the interface to stop a hb driver
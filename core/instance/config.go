@@ -8,6 +8,7 @@ import (
 	"github.com/opensvc/om3/v3/core/priority"
 	"github.com/opensvc/om3/v3/core/schedule"
 	"github.com/opensvc/om3/v3/core/topology"
+	"github.com/opensvc/om3/v3/util/label"
 	"github.com/opensvc/om3/v3/util/stringslice"
 	"github.com/opensvc/om3/v3/util/xmap"
 )
@@ -18,6 +19,7 @@ type (
 	Config struct {
 		Path      naming.Path `json:"-"`
 		Checksum  string      `json:"csum"`
+		Labels    label.M     `json:"labels,omitempty"`
 		Priority  priority.T  `json:"priority"`
 		Scope     []string    `json:"scope"`
 		UpdatedAt time.Time   `json:"updated_at"`
@@ -27,21 +29,24 @@ type (
 	}
 
 	ActorConfig struct {
-		App              string            `json:"app,omitempty"`
-		Children         naming.Relations  `json:"children,omitempty"`
-		DRP              bool              `json:"drp,omitempty"`
-		Env              string            `json:"env,omitempty"`
-		MonitorAction    []MonitorAction   `json:"monitor_action,omitempty"`
-		PreMonitorAction string            `json:"pre_monitor_action,omitempty"`
-		Orchestrate      string            `json:"orchestrate"`
-		Parents          naming.Relations  `json:"parents,omitempty"`
-		PlacementPolicy  placement.Policy  `json:"placement_policy"`
-		Resources        ResourceConfigs   `json:"resources"`
-		Schedules        []schedule.Config `json:"schedules"`
-		Stonith          bool              `json:"stonith"`
-		Subsets          SubsetConfigs     `json:"subsets"`
-		Topology         topology.T        `json:"topology,omitempty"`
-		Flex             *FlexConfig       `json:"flex,omitempty"`
+		Affinity         *placement.Affinity  `json:"affinity,omitempty"`
+		App              string               `json:"app,omitempty"`
+		Children         naming.Relations     `json:"children,omitempty"`
+		DRP              bool                 `json:"drp,omitempty"`
+		Env              string               `json:"env,omitempty"`
+		MonitorAction    []MonitorAction      `json:"monitor_action,omitempty"`
+		PreMonitorAction string               `json:"pre_monitor_action,omitempty"`
+		Orchestrate      string               `json:"orchestrate"`
+		Parents          naming.Relations     `json:"parents,omitempty"`
+		PlacementPolicy  placement.Policy     `json:"placement_policy"`
+		PlacementWeights placement.Weights    `json:"placement_weights,omitempty"`
+		Requested        *placement.Resources `json:"requested,omitempty"`
+		Resources        ResourceConfigs      `json:"resources"`
+		Schedules        []schedule.Config    `json:"schedules"`
+		Stonith          bool                 `json:"stonith"`
+		Subsets          SubsetConfigs        `json:"subsets"`
+		Topology         topology.T           `json:"topology,omitempty"`
+		Flex             *FlexConfig          `json:"flex,omitempty"`
 
 		// IsDisabled is true when DEFAULT.disable is true
 		IsDisabled bool `json:"is_disabled"`
@@ -94,6 +99,9 @@ func (cfg *Config) DeepCopy() *Config {
 	}
 	newCfg := *cfg
 	newCfg.Scope = append([]string{}, cfg.Scope...)
+	if cfg.Labels != nil {
+		newCfg.Labels = cfg.Labels.DeepCopy()
+	}
 	newCfg.ActorConfig = cfg.ActorConfig.DeepCopy()
 	newCfg.VolConfig = cfg.VolConfig.DeepCopy()
 	return &newCfg
@@ -115,6 +123,15 @@ func (cfg *ActorConfig) DeepCopy() *ActorConfig {
 	newCfg.Subsets = cfg.Subsets.DeepCopy()
 	newCfg.Resources = cfg.Resources.DeepCopy()
 	newCfg.Schedules = append([]schedule.Config{}, cfg.Schedules...)
+	newCfg.PlacementWeights = cfg.PlacementWeights.DeepCopy()
+	if cfg.Affinity != nil {
+		affinity := cfg.Affinity.DeepCopy()
+		newCfg.Affinity = &affinity
+	}
+	if cfg.Requested != nil {
+		requested := *cfg.Requested
+		newCfg.Requested = &requested
+	}
 	return &newCfg
 }
 
@@ -150,7 +167,13 @@ func (t Config) Unstructured() map[string]any {
 		"scope":      t.Scope,
 		"updated_at": t.UpdatedAt,
 	}
+	if len(t.Labels) > 0 {
+		m["labels"] = t.Labels
+	}
 	if t.ActorConfig != nil {
+		if t.Affinity != nil {
+			m["affinity"] = *t.Affinity
+		}
 		m["app"] = t.App
 		m["children"] = t.Children
 		m["drp"] = t.DRP
@@ -161,6 +184,12 @@ func (t Config) Unstructured() map[string]any {
 		m["orchestrate"] = t.Orchestrate
 		m["parents"] = t.Parents
 		m["placement_policy"] = t.PlacementPolicy
+		if len(t.PlacementWeights) > 0 {
+			m["placement_weights"] = t.PlacementWeights.Strings()
+		}
+		if t.Requested != nil {
+			m["requested"] = *t.Requested
+		}
 		m["resources"] = t.Resources.Unstructured()
 		m["subsets"] = t.Subsets.Unstructured()
 		m["topology"] = t.Topology
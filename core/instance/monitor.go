@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/opensvc/om3/v3/core/placement"
 	"github.com/opensvc/om3/v3/core/status"
 	"github.com/opensvc/om3/v3/util/xsession"
 )
@@ -52,6 +53,14 @@ type (
 
 		Parents  map[string]status.T `json:"parents,omitempty"`
 		Children map[string]status.T `json:"children,omitempty"`
+
+		// PlacementScores is the candidate nodes score breakdown computed
+		// by the weighted placement policy.
+		PlacementScores placement.Scores `json:"placement_scores,omitempty"`
+
+		// PlacementReason explains why the affinity constraints prevent the
+		// instances from being placed on the preferred nodes.
+		PlacementReason string `json:"placement_reason,omitempty"`
 	}
 
 	ResourceMonitors map[string]ResourceMonitor
@@ -263,6 +272,7 @@ func (mon Monitor) DeepCopy() *Monitor {
 	} else {
 		v.Children = nil
 	}
+	v.PlacementScores = mon.PlacementScores.DeepCopy()
 	if mon.GlobalExpectOptions != nil {
 		switch mon.GlobalExpect {
 		case MonitorGlobalExpectPlacedAt:
@@ -325,6 +335,12 @@ func (t Monitor) Unstructured() map[string]any {
 	if len(t.Children) > 0 {
 		m["children"] = t.Children
 	}
+	if len(t.PlacementScores) > 0 {
+		m["placement_scores"] = t.PlacementScores
+	}
+	if t.PlacementReason != "" {
+		m["placement_reason"] = t.PlacementReason
+	}
 	return m
 }
 
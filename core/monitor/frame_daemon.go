@@ -158,6 +158,49 @@ func (f Frame) wDaemons() {
 	fmt.Fprintln(f.w, f.sHbQueueLine())
 	fmt.Fprintln(f.w, f.sHeartbeatLine("rx"))
 	fmt.Fprintln(f.w, f.sHeartbeatLine("tx"))
+	if f.hasQuotas() {
+		fmt.Fprintln(f.w, f.sQuotaLine())
+	}
 	fmt.Fprintln(f.w, f.info.empty)
 
 }
+
+// hasQuotas returns true if a node api listener has per-user rate limits
+// or stream quotas state.
+func (f Frame) hasQuotas() bool {
+	for _, val := range f.Current.Cluster.Node {
+		if len(val.Daemon.Listener.Quotas) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (f Frame) sQuotaLine() string {
+	s := fmt.Sprintf(" %s\t\t\t%s\t", bold("api quotas"), f.info.separator)
+	for _, node := range f.Current.Cluster.Config.Nodes {
+		s += f.StrQuota(node) + "\t"
+	}
+	return s
+}
+
+// StrQuota returns the number of open streams and the number of requests
+// denied by the node api listener per-user rate limits and stream quotas.
+func (f Frame) StrQuota(n string) string {
+	if val, ok := f.Current.Cluster.Node[n]; ok {
+		var (
+			streams int
+			denied  uint64
+		)
+		for _, quota := range val.Daemon.Listener.Quotas {
+			streams += quota.Streams
+			denied += quota.Denied
+		}
+		s := fmt.Sprintf("%d/%d", streams, denied)
+		if denied > 0 {
+			s = yellow(s)
+		}
+		return s
+	}
+	return iconUndef
+}
@@ -73,6 +73,7 @@ func (f Frame) StrNodeStates(n string) string {
 	var sb strings.Builder
 	sb.WriteString(f.sNodeMonState(n))
 	sb.WriteString(f.sNodeFrozen(n))
+	sb.WriteString(f.sNodeMaintenance(n))
 	sb.WriteString(f.sNodeMonTarget(n))
 	return sb.String()
 }
@@ -241,6 +242,15 @@ func (f Frame) sNodeFrozen(n string) string {
 	return ""
 }
 
+func (f Frame) sNodeMaintenance(n string) string {
+	if val, ok := f.Current.Cluster.Node[n]; ok {
+		if val.Status.Maintenance != nil {
+			return rawconfig.Colorize.Warning(" maintenance")
+		}
+	}
+	return ""
+}
+
 func (f Frame) sNodeMonTarget(n string) string {
 	if val, ok := f.Current.Cluster.Node[n]; ok {
 		var sb strings.Builder
@@ -178,7 +178,7 @@ func (m *T) watch(evReader event.ReadCloser, out io.Writer) error {
 		eventC = make(chan event.Event, 100)
 		dataC  = make(chan *clusterdump.Data)
 
-		nextEventID uint64
+		lastEventID uint64
 
 		displayInterval = 500 * time.Millisecond
 
@@ -234,14 +234,15 @@ func (m *T) watch(evReader event.ReadCloser, out io.Writer) error {
 		case err := <-errC:
 			return err
 		case e := <-eventC:
-			if nextEventID == 0 {
-				nextEventID = e.ID
-			} else if e.ID != nextEventID {
-				err := fmt.Errorf("broken event chain: received event id %d, expected %d", e.ID, nextEventID)
+			// the event ids are the daemon bus publication sequence
+			// numbers, so filtered streams have gaps, but the ids never
+			// decrease.
+			if e.ID < lastEventID {
+				err := fmt.Errorf("broken event chain: received event id %d after event id %d", e.ID, lastEventID)
 				_, _ = fmt.Fprintf(os.Stderr, "%s\n", err)
 				return err
 			}
-			nextEventID++
+			lastEventID = e.ID
 			changes = true
 			msg, err := msgbus.EventToMessage(e)
 			if err != nil {
@@ -0,0 +1,72 @@
+package node
+
+import (
+	"sort"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/placement"
+	"github.com/opensvc/om3/v3/core/status"
+)
+
+// Capacity returns the node resources capacity computed from its stats.
+func (t Stats) Capacity() placement.Capacity {
+	return placement.Capacity{
+		Total: placement.Resources{
+			CPUs: float64(t.CPUs),
+			Mem:  int64(t.MemTotalMB) * 1024 * 1024,
+		},
+		Instances: make([]string, 0),
+	}
+}
+
+// GetCapacities returns the resources capacity of the nodes reporting stats,
+// indexed by nodename, with the resources requested by the instances running
+// or starting on each node accounted.
+//
+// The instances of the object exclude are not accounted, so the capacity can
+// be used to verify the admission of this object instances.
+func GetCapacities(exclude naming.Path) map[string]placement.Capacity {
+	m := make(map[string]placement.Capacity)
+	for _, e := range StatsData.GetAll() {
+		m[e.Node] = e.Value.Capacity()
+	}
+	for _, e := range instance.ConfigData.GetAll() {
+		if e.Path == exclude {
+			continue
+		}
+		if e.Value.ActorConfig == nil || e.Value.Requested == nil {
+			continue
+		}
+		capacity, ok := m[e.Node]
+		if !ok {
+			continue
+		}
+		if !isCapacityConsumer(e.Path, e.Node) {
+			continue
+		}
+		capacity.Account(e.Path.String(), *e.Value.Requested)
+		m[e.Node] = capacity
+	}
+	for _, capacity := range m {
+		sort.Strings(capacity.Instances)
+	}
+	return m
+}
+
+// isCapacityConsumer returns true if the instance is up, or is starting, so
+// its requested resources are reserved on the node.
+func isCapacityConsumer(p naming.Path, nodename string) bool {
+	if instStatus := instance.StatusData.GetByPathAndNode(p, nodename); instStatus != nil {
+		if instStatus.Avail.Is(status.Up, status.Warn) {
+			return true
+		}
+	}
+	if instMonitor := instance.MonitorData.GetByPathAndNode(p, nodename); instMonitor != nil {
+		switch instMonitor.State {
+		case instance.MonitorStateStartProgress, instance.MonitorStateStartSuccess:
+			return true
+		}
+	}
+	return false
+}
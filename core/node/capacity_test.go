@@ -0,0 +1,50 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/placement"
+	"github.com/opensvc/om3/v3/core/status"
+)
+
+func TestGetCapacities(t *testing.T) {
+	InitData()
+	instance.InitData()
+	t.Cleanup(func() {
+		InitData()
+		instance.InitData()
+	})
+
+	StatsData.Set("n1", &Stats{CPUs: 4, MemTotalMB: 1024})
+	StatsData.Set("n2", &Stats{CPUs: 2, MemTotalMB: 2048})
+
+	setInstance := func(p naming.Path, nodename string, requested placement.Resources, avail status.T, state instance.MonitorState) {
+		instance.ConfigData.Set(p, nodename, &instance.Config{
+			Path:        p,
+			ActorConfig: &instance.ActorConfig{Requested: &requested},
+		})
+		instance.StatusData.Set(p, nodename, &instance.Status{Avail: avail})
+		instance.MonitorData.Set(p, nodename, &instance.Monitor{State: state})
+	}
+	up := naming.Path{Namespace: "test", Kind: naming.KindSvc, Name: "up"}
+	starting := naming.Path{Namespace: "test", Kind: naming.KindSvc, Name: "starting"}
+	down := naming.Path{Namespace: "test", Kind: naming.KindSvc, Name: "down"}
+	setInstance(up, "n1", placement.Resources{CPUs: 1, Mem: 256 * 1024 * 1024}, status.Up, instance.MonitorStateIdle)
+	setInstance(starting, "n1", placement.Resources{CPUs: 2}, status.Down, instance.MonitorStateStartProgress)
+	setInstance(down, "n1", placement.Resources{CPUs: 2}, status.Down, instance.MonitorStateIdle)
+	setInstance(up, "n2", placement.Resources{CPUs: 1, Mem: 256 * 1024 * 1024}, status.Down, instance.MonitorStateIdle)
+
+	capacities := GetCapacities(naming.Path{})
+	require.Len(t, capacities, 2)
+	require.Equal(t, []string{"test/svc/starting", "test/svc/up"}, capacities["n1"].Instances)
+	require.Equal(t, placement.Resources{CPUs: 1, Mem: 768 * 1024 * 1024}, capacities["n1"].Free())
+	require.Empty(t, capacities["n2"].Instances)
+	require.Equal(t, placement.Resources{CPUs: 2, Mem: 2048 * 1024 * 1024}, capacities["n2"].Free())
+
+	capacities = GetCapacities(up)
+	require.Equal(t, []string{"test/svc/starting"}, capacities["n1"].Instances)
+}
@@ -36,8 +36,18 @@ type (
 
 	Hook struct {
 		Name    string   `json:"name"`
+		Type    string   `json:"type"`
 		Events  []string `json:"events"`
+		Filters []string `json:"filters"`
 		Command []string `json:"command"`
+		URL     string   `json:"url"`
+
+		// Secret is the http hook payload signature key. It is not
+		// serialized, so it is not exposed to the peers and api clients.
+		Secret string `json:"-"`
+
+		Timeout time.Duration `json:"timeout"`
+		Retries int           `json:"retries"`
 	}
 )
 
@@ -104,10 +114,17 @@ func (t Hooks) DeepCopy() Hooks {
 }
 
 func (t *Hook) Equal(o *Hook) bool {
-	if t.Name != o.Name {
+	if t.Name != o.Name ||
+		t.Type != o.Type ||
+		t.URL != o.URL ||
+		t.Secret != o.Secret ||
+		t.Timeout != o.Timeout ||
+		t.Retries != o.Retries {
 		return false
 	} else if !slices.Equal(t.Events, o.Events) {
 		return false
+	} else if !slices.Equal(t.Filters, o.Filters) {
+		return false
 	} else if !slices.Equal(t.Command, o.Command) {
 		return false
 	}
@@ -117,6 +134,7 @@ func (t *Hook) Equal(o *Hook) bool {
 func (t *Hook) DeepCopy() *Hook {
 	n := *t
 	n.Events = append([]string{}, t.Events...)
+	n.Filters = append([]string{}, t.Filters...)
 	n.Command = append([]string{}, t.Command...)
 	return &n
 }
@@ -0,0 +1,22 @@
+package node
+
+import "time"
+
+type (
+	// Maintenance describes the maintenance state of a node. A node in
+	// maintenance is drained and frozen until the maintenance is left or
+	// expires.
+	Maintenance struct {
+		Reason string    `json:"reason"`
+		Since  time.Time `json:"since"`
+
+		// ExpireAt is the time the maintenance is automatically left. The
+		// zero value means the maintenance never expires.
+		ExpireAt time.Time `json:"expire_at"`
+	}
+)
+
+// IsExpired returns true if the maintenance has an expiry time before now.
+func (t Maintenance) IsExpired(now time.Time) bool {
+	return !t.ExpireAt.IsZero() && !now.Before(t.ExpireAt)
+}
@@ -4,6 +4,7 @@ type (
 	// Stats describes systems (cpu, mem, swap) resource usage of a node
 	// and an opensvc-specific score.
 	Stats struct {
+		CPUs         int     `json:"cpus"`
 		Load15M      float64 `json:"load_15m"`
 		MemAvailPct  int     `json:"mem_avail"`
 		MemTotalMB   uint64  `json:"mem_total"`
@@ -27,6 +27,9 @@ type (
 		// This happens either when the rejoin_grace_period expires or when
 		// we received data from all peers.
 		RejoinedAt time.Time `json:"rejoined_at"`
+
+		// Maintenance is set when the node is in maintenance.
+		Maintenance *Maintenance `json:"maintenance,omitempty"`
 	}
 
 	// Instances groups instances configuration digest and status
@@ -49,6 +52,11 @@ func (t Status) IsFrozen() bool {
 	return !t.FrozenAt.IsZero()
 }
 
+// IsInMaintenance returns true if the node is in maintenance.
+func (t Status) IsInMaintenance() bool {
+	return t.Maintenance != nil
+}
+
 func (t Status) IsUnfrozen() bool {
 	return t.FrozenAt.IsZero()
 }
@@ -67,5 +75,10 @@ func (t *Status) DeepCopy() *Status {
 	}
 	result.Gen = newGen
 
+	if t.Maintenance != nil {
+		maintenance := *t.Maintenance
+		result.Maintenance = &maintenance
+	}
+
 	return &result
 }
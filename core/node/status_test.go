@@ -28,6 +28,7 @@ func Test_TStatus_DeepCopy(t *testing.T) {
 		},
 		IsOverloaded: false,
 		IsLeader:     true,
+		Maintenance:  &Maintenance{Reason: "kernel upgrade", Since: t1},
 	}
 
 	copyValue := value.DeepCopy()
@@ -57,4 +58,14 @@ func Test_TStatus_DeepCopy(t *testing.T) {
 	require.True(t, copyValue.FrozenAt.Equal(t1))
 	copyValue.FrozenAt = time.Now()
 	require.True(t, copyValue.FrozenAt.After(value.FrozenAt))
+
+	copyValue.Maintenance.Reason = "firmware upgrade"
+	require.Equal(t, "kernel upgrade", value.Maintenance.Reason)
+}
+
+func TestMaintenanceIsExpired(t *testing.T) {
+	now := time.Now()
+	require.False(t, Maintenance{}.IsExpired(now), "expected no expiry by default")
+	require.False(t, Maintenance{ExpireAt: now.Add(time.Minute)}.IsExpired(now))
+	require.True(t, Maintenance{ExpireAt: now}.IsExpired(now))
 }
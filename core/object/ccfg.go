@@ -4,14 +4,20 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"golang.org/x/time/rate"
 
 	"github.com/opensvc/om3/v3/core/cluster"
 	"github.com/opensvc/om3/v3/core/keywords"
 	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/xconfig"
+	"github.com/opensvc/om3/v3/daemon/ratelimit"
+	"github.com/opensvc/om3/v3/daemon/rbac"
 	"github.com/opensvc/om3/v3/util/funcopt"
 	"github.com/opensvc/om3/v3/util/key"
+	"github.com/opensvc/om3/v3/util/label"
 )
 
 type (
@@ -92,6 +98,11 @@ func getClusterConfig() (*cluster.Config, error) {
 		keyCASecPaths = key.New("cluster", "ca")
 		keyQuorum     = key.New("cluster", "quorum")
 
+		keyRebalance            = key.New("cluster", "rebalance")
+		keyRebalanceInterval    = key.New("cluster", "rebalance_interval")
+		keyRebalanceMaxParallel = key.New("cluster", "rebalance_max_parallel")
+		keyRebalanceSchedule    = key.New("cluster", "rebalance_schedule")
+
 		keyListenerCRL            = key.New("listener", "crl")
 		keyListenerAddr           = key.New("listener", "addr")
 		keyListenerPort           = key.New("listener", "port")
@@ -121,6 +132,12 @@ func getClusterConfig() (*cluster.Config, error) {
 	cfg.SetSecret(c.GetString(keySecret))
 
 	cfg.Quorum = c.GetBool(keyQuorum)
+	cfg.Rebalance.Enabled = c.GetBool(keyRebalance)
+	cfg.Rebalance.MaxParallel = c.GetInt(keyRebalanceMaxParallel)
+	cfg.Rebalance.Schedule = c.GetString(keyRebalanceSchedule)
+	if interval := c.GetDuration(keyRebalanceInterval); interval != nil {
+		cfg.Rebalance.Interval = *interval
+	}
 	cfg.Listener.CRL = c.GetString(keyListenerCRL)
 	if v, err := c.Eval(keyListenerAddr); err != nil {
 		cfg.Issues = append(cfg.Issues, fmt.Sprintf("eval listener addr: %s", err))
@@ -145,6 +162,11 @@ func getClusterConfig() (*cluster.Config, error) {
 		cfg.Listener.RateLimiter.Expires = *expires
 	}
 
+	cfg.Listener.RateLimits = getClusterRateLimits(c, &cfg.Issues)
+
+	cfg.Roles = getClusterRoles(c, &cfg.Issues)
+	cfg.LDAP = getClusterLDAP(c, &cfg.Issues)
+
 	if homedir, err := os.UserHomeDir(); err != nil {
 		cfg.Issues = append(cfg.Issues, fmt.Sprintf("user home dir: %s", err))
 	} else {
@@ -152,3 +174,126 @@ func getClusterConfig() (*cluster.Config, error) {
 	}
 	return cfg, nil
 }
+
+// getClusterLDAP returns the ldap authentication strategy configuration
+// of the ldap section, with the groups to grants mapping of the
+// ldap_group#<name> sections. The invalid declarations are reported as
+// issues.
+func getClusterLDAP(c *xconfig.T, issues *[]string) cluster.ConfigLDAP {
+	cfg := cluster.ConfigLDAP{
+		URL:            c.GetString(key.New("ldap", "url")),
+		BindDN:         c.GetString(key.New("ldap", "bind_dn")),
+		BaseDN:         c.GetString(key.New("ldap", "base_dn")),
+		UserFilter:     c.GetString(key.New("ldap", "user_filter")),
+		GroupAttribute: c.GetString(key.New("ldap", "group_attribute")),
+		CA:             c.GetString(key.New("ldap", "ca")),
+		StartTLS:       c.GetBool(key.New("ldap", "start_tls")),
+		Insecure:       c.GetBool(key.New("ldap", "insecure")),
+		Groups:         make([]cluster.ConfigLDAPGroup, 0),
+	}
+	cfg.SetBindPassword(c.GetString(key.New("ldap", "bind_password")))
+	if cfg.URL != "" && !strings.Contains(cfg.UserFilter, "%s") {
+		*issues = append(*issues, fmt.Sprintf("ldap: invalid user_filter '%s', expecting a %%s username placeholder", cfg.UserFilter))
+	}
+	if cfg.URL != "" {
+		if err := cfg.ValidateTransport(); err != nil {
+			*issues = append(*issues, fmt.Sprintf("ldap: %s", err))
+		}
+	}
+	for _, section := range c.SectionStrings() {
+		name, found := strings.CutPrefix(section, "ldap_group#")
+		if !found {
+			continue
+		}
+		group := cluster.ConfigLDAPGroup{
+			Name:   name,
+			DN:     c.GetString(key.New(section, "dn")),
+			Grants: c.GetStrings(key.New(section, "grant")),
+		}
+		if group.DN == "" {
+			*issues = append(*issues, fmt.Sprintf("%s: missing dn", section))
+			continue
+		}
+		cfg.Groups = append(cfg.Groups, group)
+	}
+	return cfg
+}
+
+// getClusterRateLimits returns the api rate limits declared in the
+// rate_limit#<name> sections. The invalid declarations are reported as
+// issues.
+func getClusterRateLimits(c *xconfig.T, issues *[]string) []cluster.ConfigRateLimit {
+	limits := make([]cluster.ConfigRateLimit, 0)
+	for _, section := range c.SectionStrings() {
+		name, found := strings.CutPrefix(section, "rate_limit#")
+		if !found {
+			continue
+		}
+		limit := cluster.ConfigRateLimit{
+			Name:      name,
+			Users:     c.GetStrings(key.New(section, "users")),
+			Roles:     c.GetStrings(key.New(section, "roles")),
+			Endpoints: c.GetStrings(key.New(section, "endpoints")),
+			Rate:      rate.Limit(c.GetFloat64(key.New(section, "rate"))),
+			Burst:     c.GetInt(key.New(section, "burst")),
+			Streams:   c.GetInt(key.New(section, "streams")),
+		}
+		valid := true
+		for _, s := range limit.Endpoints {
+			if !slices.Contains(ratelimit.Groups, s) {
+				*issues = append(*issues, fmt.Sprintf("%s: invalid endpoint group '%s', expecting one of %s", section, s, strings.Join(ratelimit.Groups, ", ")))
+				valid = false
+			}
+		}
+		if limit.Rate < 0 || limit.Burst < 0 || limit.Streams < 0 {
+			*issues = append(*issues, fmt.Sprintf("%s: negative rate, burst or streams", section))
+			valid = false
+		} else if limit.Rate == 0 && limit.Streams == 0 {
+			*issues = append(*issues, fmt.Sprintf("%s: missing rate or streams", section))
+			valid = false
+		}
+		if valid {
+			limits = append(limits, limit)
+		}
+	}
+	return limits
+}
+
+// getClusterRoles returns the custom roles declared in the role#<name>
+// sections. The invalid declarations are reported as issues.
+func getClusterRoles(c *xconfig.T, issues *[]string) rbac.CustomRoles {
+	roles := make(rbac.CustomRoles)
+	for _, section := range c.SectionStrings() {
+		name, found := strings.CutPrefix(section, "role#")
+		if !found {
+			continue
+		}
+		if name == "" || strings.Contains(name, ":") || rbac.IsBuiltinRole(name) {
+			*issues = append(*issues, fmt.Sprintf("%s: invalid custom role name", section))
+			continue
+		}
+		role := rbac.CustomRole{
+			Name:     name,
+			Selector: c.GetStrings(key.New(section, "selector")),
+			Labels:   make(label.M),
+		}
+		for _, s := range c.GetStrings(key.New(section, "permissions")) {
+			perm, err := rbac.ParsePermission(s)
+			if err != nil {
+				*issues = append(*issues, fmt.Sprintf("%s: %s", section, err))
+				continue
+			}
+			role.Permissions = append(role.Permissions, perm)
+		}
+		for _, s := range c.GetStrings(key.New(section, "labels")) {
+			k, v, found := strings.Cut(s, "=")
+			if !found || k == "" {
+				*issues = append(*issues, fmt.Sprintf("%s: invalid label '%s', expecting <key>=<value>", section, s))
+				continue
+			}
+			role.Labels[k] = v
+		}
+		roles[name] = role
+	}
+	return roles
+}
@@ -0,0 +1,154 @@
+package object
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/rbac"
+	"github.com/opensvc/om3/v3/testhelper"
+	"github.com/opensvc/om3/v3/util/label"
+)
+
+func TestClusterConfigRoles(t *testing.T) {
+	env := testhelper.Setup(t)
+	env.InstallFile("../../testdata/nodes_info.json", "var/nodes_info.json")
+	env.InstallFile("../../testdata/cluster.conf", "etc/cluster.conf")
+	f, err := os.OpenFile(filepath.Join(rawconfig.Paths.Etc, "cluster.conf"), os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`
+[role#deployer]
+permissions = read start stop restart
+selector = */svc/web*
+
+[role#frontops]
+permissions = * delete
+labels = tier=front
+
+[role#admin]
+permissions = read
+`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	cfg, err := SetClusterConfig()
+	require.NoError(t, err)
+	require.Equal(t, rbac.CustomRoles{
+		"deployer": {
+			Name:        "deployer",
+			Permissions: []rbac.Permission{rbac.PermRead, rbac.PermStart, rbac.PermStop, rbac.PermRestart},
+			Selector:    []string{"*/svc/web*"},
+			Labels:      label.M{},
+		},
+		"frontops": {
+			Name:        "frontops",
+			Permissions: []rbac.Permission{rbac.PermAll},
+			Selector:    []string{},
+			Labels:      label.M{"tier": "front"},
+		},
+	}, cfg.Roles)
+	require.Contains(t, cfg.Issues, "role#frontops: unknown permission 'delete'")
+	require.Contains(t, cfg.Issues, "role#admin: invalid custom role name")
+}
+
+func TestClusterConfigLDAP(t *testing.T) {
+	env := testhelper.Setup(t)
+	env.InstallFile("../../testdata/nodes_info.json", "var/nodes_info.json")
+	env.InstallFile("../../testdata/cluster.conf", "etc/cluster.conf")
+	f, err := os.OpenFile(filepath.Join(rawconfig.Paths.Etc, "cluster.conf"), os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`
+[ldap]
+url = ldaps://ldap.example.com
+bind_dn = cn=om3,ou=services,dc=example,dc=com
+bind_password = s3rv1ce
+base_dn = ou=people,dc=example,dc=com
+
+[ldap_group#ops]
+dn = cn=ops,ou=groups,dc=example,dc=com
+grant = operator:prod guest:test
+
+[ldap_group#nodn]
+grant = root
+`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	cfg, err := SetClusterConfig()
+	require.NoError(t, err)
+	require.True(t, cfg.LDAP.IsEnabled())
+	require.Equal(t, "(uid=%s)", cfg.LDAP.UserFilter)
+	require.Equal(t, "memberOf", cfg.LDAP.GroupAttribute)
+	require.Equal(t, "s3rv1ce", cfg.LDAP.BindPassword())
+	require.Len(t, cfg.LDAP.Groups, 1)
+	require.Equal(t, []string{"operator:prod", "guest:test"}, cfg.LDAP.Grants([]string{"CN=Ops,OU=Groups,DC=example,DC=com"}))
+	require.Contains(t, cfg.Issues, "ldap_group#nodn: missing dn")
+	for _, issue := range cfg.Issues {
+		require.NotContains(t, issue, "ldap: invalid ldap url")
+	}
+}
+
+func TestClusterConfigLDAPClearText(t *testing.T) {
+	env := testhelper.Setup(t)
+	env.InstallFile("../../testdata/nodes_info.json", "var/nodes_info.json")
+	env.InstallFile("../../testdata/cluster.conf", "etc/cluster.conf")
+	f, err := os.OpenFile(filepath.Join(rawconfig.Paths.Etc, "cluster.conf"), os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`
+[ldap]
+url = ldap://ldap.example.com
+base_dn = ou=people,dc=example,dc=com
+`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	cfg, err := SetClusterConfig()
+	require.NoError(t, err)
+	require.False(t, cfg.LDAP.StartTLS)
+	require.Contains(t, cfg.Issues, "ldap: invalid ldap url ldap://ldap.example.com: the ldap scheme requires start_tls, or insecure to allow clear text credentials")
+}
+
+func TestClusterConfigRateLimits(t *testing.T) {
+	env := testhelper.Setup(t)
+	env.InstallFile("../../testdata/nodes_info.json", "var/nodes_info.json")
+	env.InstallFile("../../testdata/cluster.conf", "etc/cluster.conf")
+	f, err := os.OpenFile(filepath.Join(rawconfig.Paths.Etc, "cluster.conf"), os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`
+[rate_limit#dashboard]
+users = grafana
+endpoints = read stream
+rate = 0.5
+burst = 20
+streams = 2
+
+[rate_limit#bad]
+endpoints = delete
+rate = 1
+
+[rate_limit#empty]
+roles = guest
+`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	cfg, err := SetClusterConfig()
+	require.NoError(t, err)
+	require.Equal(t, []cluster.ConfigRateLimit{
+		{
+			Name:      "dashboard",
+			Users:     []string{"grafana"},
+			Roles:     []string{},
+			Endpoints: []string{"read", "stream"},
+			Rate:      0.5,
+			Burst:     20,
+			Streams:   2,
+		},
+	}, cfg.Listener.RateLimits)
+	require.Contains(t, cfg.Issues, "rate_limit#bad: invalid endpoint group 'delete', expecting one of action, read, stream, write")
+	require.Contains(t, cfg.Issues, "rate_limit#empty: missing rate or streams")
+}
@@ -281,6 +281,38 @@ var keywordStore = keywords.Store{
 		Section:    "DEFAULT",
 		Text:       keywords.NewText(fs, "text/kw/core/placement"),
 	},
+	{
+		Converter: "list",
+		Depends:   keyop.ParseList("placement=weighted"),
+		Example:   "label:rack:r1=10 load=1",
+		Inherit:   keywords.InheritHead,
+		Kind:      naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:    "placement_weights",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/placement_weights"),
+	},
+	{
+		Converter:   "float64",
+		DefaultText: keywords.NewText(fs, "text/kw/core/requested_cpus.default"),
+		Example:     "1.5",
+		Inherit:     keywords.InheritHead,
+		Kind:        naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:      "requested_cpus",
+		Scopable:    true,
+		Section:     "DEFAULT",
+		Text:        keywords.NewText(fs, "text/kw/core/requested_cpus"),
+	},
+	{
+		Converter:   "size",
+		DefaultText: keywords.NewText(fs, "text/kw/core/requested_mem.default"),
+		Example:     "512m",
+		Inherit:     keywords.InheritHead,
+		Kind:        naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:      "requested_mem",
+		Scopable:    true,
+		Section:     "DEFAULT",
+		Text:        keywords.NewText(fs, "text/kw/core/requested_mem"),
+	},
 	{
 		Aliases:    []string{"cluster_type"},
 		Candidates: []string{"failover", "flex"},
@@ -27,6 +27,7 @@ type (
 		uuid         string
 		pingInterval *time.Duration
 		statusDelay  *time.Duration
+		audit        bool
 	}
 
 	CollectorProblem struct {
@@ -64,6 +65,7 @@ func (t *Node) CollectorRawConfig() *CollectorConfigRaw {
 		insecure:     cfg.GetBool(key.Parse("node.dbinsecure")),
 		pingInterval: cfg.GetDuration(key.Parse(kwNodeCollectorPingInterval.String())),
 		statusDelay:  cfg.GetDuration(key.Parse(kwNodeCollectorStatusDelay.String())),
+		audit:        cfg.GetBool(key.Parse(kwNodeCollectorAudit.String())),
 
 		// uuid is loaded from node.conf
 		uuid: t.Config().GetString(key.Parse("node.uuid")),
@@ -113,6 +115,7 @@ func (t *CollectorConfigRaw) AsConfig() *collector.Config {
 		Password:     t.uuid,
 		PingInterval: pingInterval,
 		StatusDelay:  statusDelay,
+		Audit:        t.audit,
 	}
 }
 
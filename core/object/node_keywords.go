@@ -3,6 +3,7 @@ package object
 import (
 	"fmt"
 
+	"github.com/opensvc/om3/v3/core/keyop"
 	"github.com/opensvc/om3/v3/core/keywords"
 	"github.com/opensvc/om3/v3/core/kwoption"
 	"github.com/opensvc/om3/v3/core/naming"
@@ -380,6 +381,13 @@ var (
 		Text:        keywords.NewText(fs, "text/kw/node/node.collector_feeder"),
 		DefaultText: keywords.NewText(fs, "text/kw/node/node.collector_feeder.default"),
 	}
+	kwNodeCollectorAudit = keywords.Keyword{
+		Option:    "collector_audit",
+		Section:   "node",
+		Converter: "bool",
+		Default:   "false",
+		Text:      keywords.NewText(fs, "text/kw/node/node.collector_audit"),
+	}
 	kwNodeCollectorPingInterval = keywords.Keyword{
 		Example:   "120s",
 		Option:    "collector_ping_interval",
@@ -684,6 +692,33 @@ var (
 		Section:   "cluster",
 		Text:      keywords.NewText(fs, "text/kw/node/cluster.quorum"),
 	}
+	kwNodeClusterRebalance = keywords.Keyword{
+		Converter: "bool",
+		Default:   "false",
+		Option:    "rebalance",
+		Section:   "cluster",
+		Text:      keywords.NewText(fs, "text/kw/node/cluster.rebalance"),
+	}
+	kwNodeClusterRebalanceInterval = keywords.Keyword{
+		Converter: "duration",
+		Default:   "1m",
+		Option:    "rebalance_interval",
+		Section:   "cluster",
+		Text:      keywords.NewText(fs, "text/kw/node/cluster.rebalance_interval"),
+	}
+	kwNodeClusterRebalanceMaxParallel = keywords.Keyword{
+		Converter: "int",
+		Default:   "1",
+		Option:    "rebalance_max_parallel",
+		Section:   "cluster",
+		Text:      keywords.NewText(fs, "text/kw/node/cluster.rebalance_max_parallel"),
+	}
+	kwNodeClusterRebalanceSchedule = keywords.Keyword{
+		Example: "sat-sun 02:00-06:00",
+		Option:  "rebalance_schedule",
+		Section: "cluster",
+		Text:    keywords.NewText(fs, "text/kw/node/cluster.rebalance_schedule"),
+	}
 	kwNodeSSHKey = keywords.Keyword{
 		Default: "opensvc",
 		Option:  "sshkey",
@@ -698,13 +733,35 @@ var (
 		Section:    "node",
 		Text:       keywords.NewText(fs, "text/kw/node/node.split_action"),
 	}
+	kwNodeArbitratorType = keywords.Keyword{
+		Candidates: []string{"uri", "disk"},
+		Default:    "uri",
+		Option:     "type",
+		Section:    "arbitrator",
+		Text:       keywords.NewText(fs, "text/kw/node/arbitrator.type"),
+	}
 	kwNodeArbitratorURI = keywords.Keyword{
-		Aliases:  []string{"name"},
-		Example:  "http://www.opensvc.com",
-		Option:   "uri",
-		Required: true,
-		Section:  "arbitrator",
-		Text:     keywords.NewText(fs, "text/kw/node/arbitrator.uri"),
+		Aliases: []string{"name"},
+		Depends: keyop.ParseList("type=uri"),
+		Example: "http://www.opensvc.com",
+		Option:  "uri",
+		Section: "arbitrator",
+		Text:    keywords.NewText(fs, "text/kw/node/arbitrator.uri"),
+	}
+	kwNodeArbitratorDev = keywords.Keyword{
+		Depends: keyop.ParseList("type=disk"),
+		Example: "/dev/mapper/36589cfc000000e0b5e1ba1e2f4f2a3a1",
+		Option:  "dev",
+		Section: "arbitrator",
+		Text:    keywords.NewText(fs, "text/kw/node/arbitrator.dev"),
+	}
+	kwNodeArbitratorLock = keywords.Keyword{
+		Candidates: []string{"pr", "lease"},
+		Default:    "pr",
+		Depends:    keyop.ParseList("type=disk"),
+		Option:     "lock",
+		Section:    "arbitrator",
+		Text:       keywords.NewText(fs, "text/kw/node/arbitrator.lock"),
 	}
 	kwNodeArbitratorInsecure = keywords.Keyword{
 		Converter: "bool",
@@ -720,6 +777,62 @@ var (
 		Section:   "arbitrator",
 		Text:      keywords.NewText(fs, "text/kw/node/arbitrator.weight"),
 	}
+	kwNodeStonithType = keywords.Keyword{
+		Candidates: []string{"command", "ipmi", "ssh", "dummy"},
+		Default:    "command",
+		Option:     "type",
+		Section:    "stonith",
+		Text:       keywords.NewText(fs, "text/kw/node/stonith.type"),
+	}
+	kwNodeStonithNode = keywords.Keyword{
+		DefaultText: keywords.NewText(fs, "text/kw/node/stonith.node.default"),
+		Example:     "node2",
+		Option:      "node",
+		Section:     "stonith",
+		Text:        keywords.NewText(fs, "text/kw/node/stonith.node"),
+	}
+	kwNodeStonithOrder = keywords.Keyword{
+		Converter: "int",
+		Default:   "0",
+		Option:    "order",
+		Section:   "stonith",
+		Text:      keywords.NewText(fs, "text/kw/node/stonith.order"),
+	}
+	kwNodeStonithRetries = keywords.Keyword{
+		Converter: "int",
+		Default:   "0",
+		Option:    "retries",
+		Section:   "stonith",
+		Text:      keywords.NewText(fs, "text/kw/node/stonith.retries"),
+	}
+	kwNodeStonithRetryDelay = keywords.Keyword{
+		Converter: "duration",
+		Default:   "5s",
+		Option:    "retry_delay",
+		Section:   "stonith",
+		Text:      keywords.NewText(fs, "text/kw/node/stonith.retry_delay"),
+	}
+	kwNodeStonithTimeout = keywords.Keyword{
+		Converter: "duration",
+		Default:   "1m",
+		Option:    "timeout",
+		Section:   "stonith",
+		Text:      keywords.NewText(fs, "text/kw/node/stonith.timeout"),
+	}
+	kwNodeStonithVerify = keywords.Keyword{
+		Converter: "bool",
+		Default:   "true",
+		Option:    "verify",
+		Section:   "stonith",
+		Text:      keywords.NewText(fs, "text/kw/node/stonith.verify"),
+	}
+	kwNodeStonithVerifyTimeout = keywords.Keyword{
+		Converter: "duration",
+		Default:   "1m",
+		Option:    "verify_timeout",
+		Section:   "stonith",
+		Text:      keywords.NewText(fs, "text/kw/node/stonith.verify_timeout"),
+	}
 	kwNodeStonithCommand = keywords.Keyword{
 		Converter: "shlex",
 		Example:   "/bin/true",
@@ -729,9 +842,97 @@ var (
 		Scopable:  true,
 		Section:   "stonith",
 		Text:      keywords.NewText(fs, "text/kw/node/stonith.command"),
+		Types:     []string{"command"},
+	}
+	kwNodeStonithVerifyCommand = keywords.Keyword{
+		Converter: "shlex",
+		Example:   "/usr/sbin/fence_ipmilan -a node2-bmc -l admin -p secret -o status",
+		Option:    "verify_command",
+		Scopable:  true,
+		Section:   "stonith",
+		Text:      keywords.NewText(fs, "text/kw/node/stonith.verify_command"),
+		Types:     []string{"command"},
+	}
+	kwNodeStonithIPMIAddr = keywords.Keyword{
+		Example:  "node2-bmc",
+		Option:   "addr",
+		Required: true,
+		Scopable: true,
+		Section:  "stonith",
+		Text:     keywords.NewText(fs, "text/kw/node/stonith.ipmi.addr"),
+		Types:    []string{"ipmi"},
+	}
+	kwNodeStonithIPMIPort = keywords.Keyword{
+		Converter: "int",
+		Default:   "623",
+		Option:    "port",
+		Scopable:  true,
+		Section:   "stonith",
+		Text:      keywords.NewText(fs, "text/kw/node/stonith.ipmi.port"),
+		Types:     []string{"ipmi"},
+	}
+	kwNodeStonithIPMIUsername = keywords.Keyword{
+		Example:  "admin",
+		Option:   "username",
+		Scopable: true,
+		Section:  "stonith",
+		Text:     keywords.NewText(fs, "text/kw/node/stonith.ipmi.username"),
+		Types:    []string{"ipmi"},
+	}
+	kwNodeStonithIPMIPassword = keywords.Keyword{
+		Example:  "from system/sec/bmc key node2",
+		Option:   "password",
+		Scopable: true,
+		Section:  "stonith",
+		Text:     keywords.NewText(fs, "text/kw/node/stonith.ipmi.password"),
+		Types:    []string{"ipmi"},
+	}
+	kwNodeStonithIPMIInterface = keywords.Keyword{
+		Candidates: []string{"lan", "lanplus"},
+		Default:    "lanplus",
+		Option:     "interface",
+		Scopable:   true,
+		Section:    "stonith",
+		Text:       keywords.NewText(fs, "text/kw/node/stonith.ipmi.interface"),
+		Types:      []string{"ipmi"},
+	}
+	kwNodeStonithIPMICipherSuite = keywords.Keyword{
+		Candidates: []string{"3", "17"},
+		Converter:  "int",
+		Default:    "3",
+		Option:     "cipher_suite",
+		Scopable:   true,
+		Section:    "stonith",
+		Text:       keywords.NewText(fs, "text/kw/node/stonith.ipmi.cipher_suite"),
+		Types:      []string{"ipmi"},
+	}
+	kwNodeStonithSSHAddr = keywords.Keyword{
+		DefaultText: keywords.NewText(fs, "text/kw/node/stonith.ssh.addr.default"),
+		Example:     "node2-admin",
+		Option:      "addr",
+		Scopable:    true,
+		Section:     "stonith",
+		Text:        keywords.NewText(fs, "text/kw/node/stonith.ssh.addr"),
+		Types:       []string{"ssh"},
+	}
+	kwNodeStonithSSHUser = keywords.Keyword{
+		Default:  "root",
+		Option:   "user",
+		Scopable: true,
+		Section:  "stonith",
+		Text:     keywords.NewText(fs, "text/kw/node/stonith.ssh.user"),
+		Types:    []string{"ssh"},
+	}
+	kwNodeStonithSSHCommand = keywords.Keyword{
+		Default:  "poweroff -f",
+		Option:   "command",
+		Scopable: true,
+		Section:  "stonith",
+		Text:     keywords.NewText(fs, "text/kw/node/stonith.ssh.command"),
+		Types:    []string{"ssh"},
 	}
 	kwNodeHBType = keywords.Keyword{
-		Candidates: []string{"unicast", "multicast", "disk", "relay"},
+		Candidates: []string{"unicast", "multicast", "disk", "file", "relay"},
 		Option:     "type",
 		Required:   true,
 		Section:    "hb",
@@ -772,6 +973,31 @@ var (
 		Section:   "hb",
 		Text:      keywords.NewText(fs, "text/kw/node/hb.timeout"),
 	}
+	kwNodeHBAdaptiveTimeout = keywords.Keyword{
+		Converter: "bool",
+		Default:   "false",
+		Option:    "adaptive_timeout",
+		Scopable:  true,
+		Section:   "hb",
+		Text:      keywords.NewText(fs, "text/kw/node/hb.adaptive_timeout"),
+	}
+	kwNodeHBTimeoutMax = keywords.Keyword{
+		Converter:   "duration",
+		DefaultText: keywords.NewText(fs, "text/kw/node/hb.timeout_max.default"),
+		Option:      "timeout_max",
+		Scopable:    true,
+		Section:     "hb",
+		Text:        keywords.NewText(fs, "text/kw/node/hb.timeout_max"),
+	}
+	kwNodeHBMaxMsgSize = keywords.Keyword{
+		Converter:   "size",
+		DefaultText: keywords.NewText(fs, "text/kw/node/hb.max_msg_size.default"),
+		Example:     "512kb",
+		Option:      "max_msg_size",
+		Scopable:    true,
+		Section:     "hb",
+		Text:        keywords.NewText(fs, "text/kw/node/hb.max_msg_size"),
+	}
 	kwNodeHBInterval = keywords.Keyword{
 		Converter: "duration",
 		Default:   "5s",
@@ -833,6 +1059,15 @@ var (
 		Text:      keywords.NewText(fs, "text/kw/node/hb.disk.max_slots"),
 		Types:     []string{"disk"},
 	}
+	kwNodeHBFileDir = keywords.Keyword{
+		Example:  "/mnt/shared/opensvc/hb",
+		Option:   "dir",
+		Required: true,
+		Scopable: true,
+		Section:  "hb",
+		Text:     keywords.NewText(fs, "text/kw/node/hb.file.dir"),
+		Types:    []string{"file"},
+	}
 	kwNodeHBRelayInsecure = keywords.Keyword{
 		Converter: "bool",
 		Default:   "false",
@@ -879,7 +1114,7 @@ var (
 		Text:    keywords.NewText(fs, "text/kw/node/cni.config"),
 	}
 	kwNodePoolType = keywords.Keyword{
-		Candidates: []string{"directory", "loop", "vg", "zpool", "freenas", "share", "shm", "symmetrix", "truenas", "virtual", "dorado", "hoc", "drbd", "pure", "rados"},
+		Candidates: []string{"directory", "loop", "vg", "zpool", "freenas", "share", "shm", "symmetrix", "truenas", "virtual", "dorado", "hoc", "drbd", "pure", "rados", "lvmthin", "btrfs"},
 		Default:    "directory",
 		Option:     "type",
 		Section:    "pool",
@@ -1041,6 +1276,60 @@ var (
 		Text:     keywords.NewText(fs, "text/kw/node/pool.vg.name"),
 		Types:    []string{"vg"},
 	}
+	kwNodePoolLVMThinVG = keywords.Keyword{
+		Option:   "vg",
+		Required: true,
+		Section:  "pool",
+		Text:     keywords.NewText(fs, "text/kw/node/pool.lvmthin.vg"),
+		Types:    []string{"lvmthin"},
+	}
+	kwNodePoolLVMThinThinPool = keywords.Keyword{
+		Example:  "pool1",
+		Option:   "thin_pool",
+		Required: true,
+		Section:  "pool",
+		Text:     keywords.NewText(fs, "text/kw/node/pool.lvmthin.thin_pool"),
+		Types:    []string{"lvmthin"},
+	}
+	kwNodePoolLVMThinThinPoolSize = keywords.Keyword{
+		Example: "90%FREE",
+		Option:  "thin_pool_size",
+		Section: "pool",
+		Text:    keywords.NewText(fs, "text/kw/node/pool.lvmthin.thin_pool_size"),
+		Types:   []string{"lvmthin"},
+	}
+	kwNodePoolLVMThinOvercommit = keywords.Keyword{
+		Converter: "int",
+		Default:   "200",
+		Option:    "overcommit",
+		Section:   "pool",
+		Text:      keywords.NewText(fs, "text/kw/node/pool.lvmthin.overcommit"),
+		Types:     []string{"lvmthin"},
+	}
+	kwNodePoolLVMThinFillWarning = keywords.Keyword{
+		Converter: "int",
+		Default:   "80",
+		Option:    "fill_warning",
+		Section:   "pool",
+		Text:      keywords.NewText(fs, "text/kw/node/pool.lvmthin.fill_warning"),
+		Types:     []string{"lvmthin"},
+	}
+	kwNodePoolBtrfsDev = keywords.Keyword{
+		Example:  "/dev/disk/by-id/nvme-eui.002538ba11b75ec8",
+		Option:   "dev",
+		Required: true,
+		Scopable: true,
+		Section:  "pool",
+		Text:     keywords.NewText(fs, "text/kw/node/pool.btrfs.dev"),
+		Types:    []string{"btrfs"},
+	}
+	kwNodePoolBtrfsSubvol = keywords.Keyword{
+		Default: "volumes",
+		Option:  "subvol",
+		Section: "pool",
+		Text:    keywords.NewText(fs, "text/kw/node/pool.btrfs.subvol"),
+		Types:   []string{"btrfs"},
+	}
 	kwNodePoolDRBDAddr = keywords.Keyword{
 		DefaultText: keywords.NewText(fs, "text/kw/node/pool.drbd.addr.default"),
 		Example:     "1.2.3.4",
@@ -1158,7 +1447,7 @@ var (
 		Option:  "fs_type",
 		Section: "pool",
 		Text:    keywords.NewText(fs, "text/kw/node/pool.fs_type"),
-		Types:   []string{"freenas", "dorado", "hoc", "symmetrix", "drbd", "loop", "vg", "pure", "truenas", "rados"},
+		Types:   []string{"freenas", "dorado", "hoc", "symmetrix", "drbd", "loop", "vg", "pure", "truenas", "rados", "lvmthin"},
 	}
 	kwNodePoolMkfsOpt = keywords.Keyword{
 		Example: "-O largefile",
@@ -1172,17 +1461,195 @@ var (
 		Section: "pool",
 		Text:    keywords.NewText(fs, "text/kw/node/pool.mkblk_opt"),
 	}
+	kwNodeHookType = keywords.Keyword{
+		Candidates: []string{"exec", "http"},
+		Default:    "exec",
+		Option:     "type",
+		Section:    "hook",
+		Text:       keywords.NewText(fs, "text/kw/node/hook.type"),
+	}
 	kwNodeHookEvents = keywords.Keyword{
 		Converter: "list",
 		Option:    "events",
 		Section:   "hook",
 		Text:      keywords.NewText(fs, "text/kw/node/hook.events"),
 	}
+	kwNodeHookFilters = keywords.Keyword{
+		Converter: "list",
+		Example:   "InstanceStatusUpdated,path=ns1/svc/web1,.instance_status.overall=\"down\" ObjectOrchestrationRefused",
+		Option:    "filters",
+		Section:   "hook",
+		Text:      keywords.NewText(fs, "text/kw/node/hook.filters"),
+	}
 	kwNodeHookCommand = keywords.Keyword{
 		Converter: "shlex",
 		Option:    "command",
 		Section:   "hook",
 		Text:      keywords.NewText(fs, "text/kw/node/hook.command"),
+		Types:     []string{"exec"},
+	}
+	kwNodeHookURL = keywords.Keyword{
+		Example: "https://chatops.example.com/hooks/om3",
+		Option:  "url",
+		Section: "hook",
+		Text:    keywords.NewText(fs, "text/kw/node/hook.url"),
+		Types:   []string{"http"},
+	}
+	kwNodeHookSecret = keywords.Keyword{
+		Example: "from system/sec/hooks key secret",
+		Option:  "secret",
+		Section: "hook",
+		Text:    keywords.NewText(fs, "text/kw/node/hook.secret"),
+		Types:   []string{"http"},
+	}
+	kwNodeHookTimeout = keywords.Keyword{
+		Converter: "duration",
+		Example:   "10s",
+		Option:    "timeout",
+		Section:   "hook",
+		Text:      keywords.NewText(fs, "text/kw/node/hook.timeout"),
+	}
+	kwNodeHookRetries = keywords.Keyword{
+		Converter: "int",
+		Default:   "3",
+		Option:    "retries",
+		Section:   "hook",
+		Text:      keywords.NewText(fs, "text/kw/node/hook.retries"),
+		Types:     []string{"http"},
+	}
+	kwNodeLDAPURL = keywords.Keyword{
+		Example: "ldaps://ldap.example.com",
+		Option:  "url",
+		Section: "ldap",
+		Text:    keywords.NewText(fs, "text/kw/node/ldap.url"),
+	}
+	kwNodeLDAPBindDN = keywords.Keyword{
+		Example: "cn=om3,ou=services,dc=example,dc=com",
+		Option:  "bind_dn",
+		Section: "ldap",
+		Text:    keywords.NewText(fs, "text/kw/node/ldap.bind_dn"),
+	}
+	kwNodeLDAPBindPassword = keywords.Keyword{
+		Example: "from system/sec/ldap key bind_password",
+		Option:  "bind_password",
+		Section: "ldap",
+		Text:    keywords.NewText(fs, "text/kw/node/ldap.bind_password"),
+	}
+	kwNodeLDAPBaseDN = keywords.Keyword{
+		Example: "ou=people,dc=example,dc=com",
+		Option:  "base_dn",
+		Section: "ldap",
+		Text:    keywords.NewText(fs, "text/kw/node/ldap.base_dn"),
+	}
+	kwNodeLDAPUserFilter = keywords.Keyword{
+		Default: "(uid=%s)",
+		Example: "(&(objectClass=user)(sAMAccountName=%s))",
+		Option:  "user_filter",
+		Section: "ldap",
+		Text:    keywords.NewText(fs, "text/kw/node/ldap.user_filter"),
+	}
+	kwNodeLDAPGroupAttribute = keywords.Keyword{
+		Default: "memberOf",
+		Option:  "group_attribute",
+		Section: "ldap",
+		Text:    keywords.NewText(fs, "text/kw/node/ldap.group_attribute"),
+	}
+	kwNodeLDAPCA = keywords.Keyword{
+		Example: "/etc/ssl/certs/ldap-ca.pem",
+		Option:  "ca",
+		Section: "ldap",
+		Text:    keywords.NewText(fs, "text/kw/node/ldap.ca"),
+	}
+	kwNodeLDAPStartTLS = keywords.Keyword{
+		Converter: "bool",
+		Default:   "false",
+		Option:    "start_tls",
+		Section:   "ldap",
+		Text:      keywords.NewText(fs, "text/kw/node/ldap.start_tls"),
+	}
+	kwNodeLDAPInsecure = keywords.Keyword{
+		Converter: "bool",
+		Default:   "false",
+		Option:    "insecure",
+		Section:   "ldap",
+		Text:      keywords.NewText(fs, "text/kw/node/ldap.insecure"),
+	}
+	kwNodeLDAPGroupDN = keywords.Keyword{
+		Example: "cn=ops,ou=groups,dc=example,dc=com",
+		Option:  "dn",
+		Section: "ldap_group",
+		Text:    keywords.NewText(fs, "text/kw/node/ldap_group.dn"),
+	}
+	kwNodeLDAPGroupGrant = keywords.Keyword{
+		Converter: "list",
+		Example:   "operator:prod guest:test",
+		Option:    "grant",
+		Section:   "ldap_group",
+		Text:      keywords.NewText(fs, "text/kw/node/ldap_group.grant"),
+	}
+	kwNodeRolePermissions = keywords.Keyword{
+		Converter: "list",
+		Example:   "read start stop restart",
+		Option:    "permissions",
+		Section:   "role",
+		Text:      keywords.NewText(fs, "text/kw/node/role.permissions"),
+	}
+	kwNodeRoleSelector = keywords.Keyword{
+		Converter: "list",
+		Example:   "*/svc/web* */svc/api*",
+		Option:    "selector",
+		Section:   "role",
+		Text:      keywords.NewText(fs, "text/kw/node/role.selector"),
+	}
+	kwNodeRoleLabels = keywords.Keyword{
+		Converter: "list",
+		Example:   "tier=front env=prd",
+		Option:    "labels",
+		Section:   "role",
+		Text:      keywords.NewText(fs, "text/kw/node/role.labels"),
+	}
+	kwNodeRateLimitUsers = keywords.Keyword{
+		Converter: "list",
+		Example:   "dashboard grafana",
+		Option:    "users",
+		Section:   "rate_limit",
+		Text:      keywords.NewText(fs, "text/kw/node/rate_limit.users"),
+	}
+	kwNodeRateLimitRoles = keywords.Keyword{
+		Converter: "list",
+		Example:   "guest",
+		Option:    "roles",
+		Section:   "rate_limit",
+		Text:      keywords.NewText(fs, "text/kw/node/rate_limit.roles"),
+	}
+	kwNodeRateLimitEndpoints = keywords.Keyword{
+		Candidates: []string{"action", "read", "stream", "write"},
+		Converter:  "list",
+		Example:    "read stream",
+		Option:     "endpoints",
+		Section:    "rate_limit",
+		Text:       keywords.NewText(fs, "text/kw/node/rate_limit.endpoints"),
+	}
+	kwNodeRateLimitRate = keywords.Keyword{
+		Converter: "float64",
+		Example:   "0.5",
+		Option:    "rate",
+		Section:   "rate_limit",
+		Text:      keywords.NewText(fs, "text/kw/node/rate_limit.rate"),
+	}
+	kwNodeRateLimitBurst = keywords.Keyword{
+		Converter: "int",
+		Example:   "20",
+		Option:    "burst",
+		Section:   "rate_limit",
+		Text:      keywords.NewText(fs, "text/kw/node/rate_limit.burst"),
+	}
+	kwNodeRateLimitStreams = keywords.Keyword{
+		Converter: "int",
+		Example:   "2",
+		Option:    "streams",
+		Section:   "rate_limit",
+		Text:      keywords.NewText(fs, "text/kw/node/rate_limit.streams"),
 	}
 	kwNodeNetworkType = keywords.Keyword{
 		Candidates: []string{"bridge", "routed_bridge"},
@@ -1732,6 +2199,7 @@ var (
 		&kwNodeCollector,
 		&kwNodeCollectorServer,
 		&kwNodeCollectorFeeder,
+		&kwNodeCollectorAudit,
 		&kwNodeCollectorPingInterval,
 		&kwNodeCollectorStatusDelay,
 		&kwNodeCollectorTimeout,
@@ -1776,17 +2244,45 @@ var (
 		&kwNodeClusterDRPNodes,
 		&kwNodeClusterEnvs,
 		&kwNodeClusterQuorum,
+		&kwNodeClusterRebalance,
+		&kwNodeClusterRebalanceInterval,
+		&kwNodeClusterRebalanceMaxParallel,
+		&kwNodeClusterRebalanceSchedule,
 		&kwNodeSSHKey,
 		&kwNodeSplitAction,
+		&kwNodeArbitratorType,
 		&kwNodeArbitratorURI,
+		&kwNodeArbitratorDev,
+		&kwNodeArbitratorLock,
 		&kwNodeArbitratorInsecure,
 		&kwNodeArbitratorWeight,
+		&kwNodeStonithType,
+		&kwNodeStonithNode,
+		&kwNodeStonithOrder,
+		&kwNodeStonithRetries,
+		&kwNodeStonithRetryDelay,
+		&kwNodeStonithTimeout,
+		&kwNodeStonithVerify,
+		&kwNodeStonithVerifyTimeout,
 		&kwNodeStonithCommand,
+		&kwNodeStonithVerifyCommand,
+		&kwNodeStonithIPMIAddr,
+		&kwNodeStonithIPMIPort,
+		&kwNodeStonithIPMIUsername,
+		&kwNodeStonithIPMIPassword,
+		&kwNodeStonithIPMIInterface,
+		&kwNodeStonithIPMICipherSuite,
+		&kwNodeStonithSSHAddr,
+		&kwNodeStonithSSHUser,
+		&kwNodeStonithSSHCommand,
 		&kwNodeHBType,
 		&kwNodeHBUnicastAddr,
 		&kwNodeHBUnicastIntf,
 		&kwNodeHBUnicastPort,
 		&kwNodeHBTimeout,
+		&kwNodeHBAdaptiveTimeout,
+		&kwNodeHBTimeoutMax,
+		&kwNodeHBMaxMsgSize,
 		&kwNodeHBInterval,
 		&kwNodeHBMulticastAddr,
 		&kwNodeHBMulticastIntf,
@@ -1794,6 +2290,7 @@ var (
 		&kwNodeHBUnicastNodes,
 		&kwNodeHBDiskDev,
 		&kwNodeHBDiskMaxSlots,
+		&kwNodeHBFileDir,
 		&kwNodeHBRelayInsecure,
 		&kwNodeHBRelayRelay,
 		&kwNodeHBRelayUsername,
@@ -1824,6 +2321,13 @@ var (
 		&kwNodePoolTruenasSparse,
 		&kwNodePoolTruenasBlockSize,
 		&kwNodePoolName,
+		&kwNodePoolLVMThinVG,
+		&kwNodePoolLVMThinThinPool,
+		&kwNodePoolLVMThinThinPoolSize,
+		&kwNodePoolLVMThinOvercommit,
+		&kwNodePoolLVMThinFillWarning,
+		&kwNodePoolBtrfsDev,
+		&kwNodePoolBtrfsSubvol,
 		&kwNodePoolDRBDAddr,
 		&kwNodePoolDRBDMaxPeers,
 		&kwNodePoolDRBDTemplate,
@@ -1842,8 +2346,34 @@ var (
 		&kwNodePoolFSType,
 		&kwNodePoolMkfsOpt,
 		&kwNodePoolMkblkOpt,
+		&kwNodeHookType,
 		&kwNodeHookEvents,
+		&kwNodeHookFilters,
 		&kwNodeHookCommand,
+		&kwNodeHookURL,
+		&kwNodeHookSecret,
+		&kwNodeHookTimeout,
+		&kwNodeHookRetries,
+		&kwNodeLDAPURL,
+		&kwNodeLDAPBindDN,
+		&kwNodeLDAPBindPassword,
+		&kwNodeLDAPBaseDN,
+		&kwNodeLDAPUserFilter,
+		&kwNodeLDAPGroupAttribute,
+		&kwNodeLDAPCA,
+		&kwNodeLDAPStartTLS,
+		&kwNodeLDAPInsecure,
+		&kwNodeLDAPGroupDN,
+		&kwNodeLDAPGroupGrant,
+		&kwNodeRolePermissions,
+		&kwNodeRoleSelector,
+		&kwNodeRoleLabels,
+		&kwNodeRateLimitUsers,
+		&kwNodeRateLimitRoles,
+		&kwNodeRateLimitEndpoints,
+		&kwNodeRateLimitRate,
+		&kwNodeRateLimitBurst,
+		&kwNodeRateLimitStreams,
 		&kwNodeNetworkType,
 		&kwNodeNetworkRoutedBridgeSubnet,
 		&kwNodeNetworkRoutedBridgeGateway,
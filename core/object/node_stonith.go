@@ -1,43 +1,40 @@
 package object
 
 import (
+	"context"
 	"fmt"
 	"slices"
 
-	"github.com/rs/zerolog"
-
+	"github.com/opensvc/om3/v3/core/stonith"
 	_ "github.com/opensvc/om3/v3/drivers/chkfsidf"
 	_ "github.com/opensvc/om3/v3/drivers/chkfsudf"
-	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/funcopt"
 	"github.com/opensvc/om3/v3/util/hostname"
-	"github.com/opensvc/om3/v3/util/key"
 )
 
-func (t *Node) Stonith(nodename string) error {
+// Stonith fences the peer node nodename using the fencers configured for
+// it, and returns the results of the fencing attempts.
+//
+// The opts are the stonith.Fence options, for example to publish the
+// attempt results as they come.
+func (t *Node) Stonith(ctx context.Context, nodename string, opts ...funcopt.O) ([]stonith.Result, error) {
 	if nodename == "" {
-		return fmt.Errorf("node name is not set")
+		return nil, fmt.Errorf("node name is not set")
 	}
 	if nodename == hostname.Hostname() {
-		return fmt.Errorf("fencing the local node is not allowed")
+		return nil, fmt.Errorf("fencing the local node is not allowed")
 	}
 	nodenames, err := t.Nodes()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if !slices.Contains(nodenames, nodename) {
-		return fmt.Errorf("node %s is not a peer", nodename)
+		return nil, fmt.Errorf("node %s is not a peer", nodename)
 	}
-	argv := t.mergedConfig.GetStrings(key.New("stonith#"+nodename, "command"))
-	if len(argv) == 0 {
-		return fmt.Errorf("fencing command for node %s is not defined", nodename)
+	fencers, err := stonith.Fencers(t.mergedConfig, nodename, t.log)
+	if err != nil {
+		return nil, err
 	}
-	cmd := command.New(
-		command.WithName(argv[0]),
-		command.WithArgs(argv[1:]),
-		command.WithLogger(t.log),
-		command.WithCommandLogLevel(zerolog.InfoLevel),
-		command.WithStdoutLogLevel(zerolog.InfoLevel),
-		command.WithStderrLogLevel(zerolog.ErrorLevel),
-	)
-	return cmd.Run()
+	opts = append([]funcopt.O{stonith.WithLogger(t.log)}, opts...)
+	return stonith.Fence(ctx, nodename, fencers, opts...)
 }
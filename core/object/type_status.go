@@ -47,6 +47,8 @@ type (
 		Overall          status.T         `json:"overall"`
 		PlacementPolicy  placement.Policy `json:"placement_policy"`
 		PlacementState   placement.State  `json:"placement_state"`
+		PlacementReason  string           `json:"placement_reason,omitempty"`
+		PlacementScores  placement.Scores `json:"placement_scores,omitempty"`
 		Provisioned      provisioned.T    `json:"provisioned"`
 		Topology         topology.T       `json:"topology"`
 		UpInstancesCount int              `json:"up_instances_count"`
@@ -132,6 +134,9 @@ func (t Digest) ObjectWarningsString() string {
 	if t.Object.PlacementState == placement.NonOptimal {
 		l = append(l, rawconfig.Colorize.Warning(fmt.Sprintf("%s placement", t.Object.PlacementState)))
 	}
+	if t.Object.PlacementReason != "" {
+		l = append(l, rawconfig.Colorize.Warning(fmt.Sprintf("placement constrained: %s", t.Object.PlacementReason)))
+	}
 
 	// Agent compatibility
 	if !t.IsCompat {
@@ -154,6 +159,7 @@ func (s *ActorStatus) DeepCopy() *ActorStatus {
 	}
 	newStatus := *s
 	newStatus.Flex = s.Flex.DeepCopy()
+	newStatus.PlacementScores = s.PlacementScores.DeepCopy()
 	return &newStatus
 }
 
@@ -23,6 +23,10 @@ type (
 	//
 	Usr interface {
 		Sec
+		Token(name string) (UsrToken, error)
+		Tokens() (UsrTokens, error)
+		AddToken(UsrToken) error
+		RevokeToken(name string) (UsrToken, error)
 	}
 
 	// UsrDB implements UserGrants to authenticate user and get its grants
@@ -0,0 +1,183 @@
+package object
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/naming"
+)
+
+type (
+	// UsrToken is the record of a long-lived api token issued for a usr
+	// object. The record is stored in the usr object "token/<name>" key,
+	// so it is replicated with the usr object to all the cluster nodes.
+	// The token value itself is not stored.
+	UsrToken struct {
+		Name      string    `json:"name"`
+		ID        string    `json:"id"`
+		Grants    []string  `json:"grant"`
+		CreatedAt time.Time `json:"created_at"`
+		ExpireAt  time.Time `json:"expire_at"`
+
+		// RevokedAt is set when the token is revoked. The revoked records
+		// are kept until their expiry, so the token is rejected by all the
+		// cluster nodes.
+		RevokedAt time.Time `json:"revoked_at,omitzero"`
+	}
+
+	// UsrTokens is a list of UsrToken
+	UsrTokens []UsrToken
+)
+
+const (
+	usrTokenKeyPrefix = "token/"
+)
+
+var (
+	ErrTokenExist    = errors.New("token already exists")
+	ErrTokenNotExist = errors.New("token does not exist")
+	ErrTokenRevoked  = errors.New("token is revoked")
+	ErrTokenExpired  = errors.New("token is expired")
+)
+
+func usrTokenKey(name string) string {
+	return usrTokenKeyPrefix + name
+}
+
+// IsActive returns true if the token is neither revoked nor expired at now.
+func (t UsrToken) IsActive(now time.Time) bool {
+	return t.RevokedAt.IsZero() && now.Before(t.ExpireAt)
+}
+
+// Token returns the record of the token named name.
+func (t *usr) Token(name string) (UsrToken, error) {
+	var tk UsrToken
+	if name == "" || strings.Contains(name, "/") {
+		return tk, fmt.Errorf("invalid token name '%s'", name)
+	}
+	k := usrTokenKey(name)
+	if !t.HasKey(k) {
+		return tk, fmt.Errorf("%w: %s", ErrTokenNotExist, name)
+	}
+	b, err := t.DecodeKey(k)
+	if err != nil {
+		return tk, err
+	}
+	if err := json.Unmarshal(b, &tk); err != nil {
+		return tk, fmt.Errorf("token %s: %w", name, err)
+	}
+	return tk, nil
+}
+
+// Tokens returns the token records sorted by name.
+func (t *usr) Tokens() (UsrTokens, error) {
+	keys, err := t.MatchingKeys(usrTokenKeyPrefix + "*")
+	if err != nil {
+		return nil, err
+	}
+	l := make(UsrTokens, 0, len(keys))
+	for _, k := range keys {
+		tk, err := t.Token(strings.TrimPrefix(k, usrTokenKeyPrefix))
+		if err != nil {
+			return nil, err
+		}
+		l = append(l, tk)
+	}
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].Name < l[j].Name
+	})
+	return l, nil
+}
+
+// AddToken stores the tk record. An active token with the same name must
+// be revoked first. The expired token records are purged.
+func (t *usr) AddToken(tk UsrToken) error {
+	if tk.Name == "" || strings.Contains(tk.Name, "/") {
+		return fmt.Errorf("invalid token name '%s'", tk.Name)
+	}
+	if tk.ID == "" {
+		return fmt.Errorf("token %s: empty id", tk.Name)
+	}
+	now := time.Now()
+	l, err := t.Tokens()
+	if err != nil {
+		return err
+	}
+	for _, other := range l {
+		switch {
+		case other.Name == tk.Name && other.IsActive(now):
+			return fmt.Errorf("%w: %s", ErrTokenExist, tk.Name)
+		case !now.Before(other.ExpireAt):
+			if err := t.TransactionRemoveKey(usrTokenKey(other.Name)); err != nil {
+				return err
+			}
+		}
+	}
+	b, err := json.Marshal(tk)
+	if err != nil {
+		return err
+	}
+	if err := t.TransactionChangeOrAddKey(usrTokenKey(tk.Name), b); err != nil {
+		return err
+	}
+	return t.Config().Commit()
+}
+
+// RevokeToken marks the token named name as revoked, and returns the
+// updated record.
+func (t *usr) RevokeToken(name string) (UsrToken, error) {
+	tk, err := t.Token(name)
+	if err != nil {
+		return tk, err
+	}
+	if !tk.RevokedAt.IsZero() {
+		return tk, nil
+	}
+	tk.RevokedAt = time.Now()
+	b, err := json.Marshal(tk)
+	if err != nil {
+		return tk, err
+	}
+	return tk, t.ChangeKey(usrTokenKey(name), b)
+}
+
+// ValidateToken returns an error if the token identified by id is unknown,
+// revoked or expired.
+func (t UsrTokens) ValidateToken(id string, now time.Time) error {
+	for _, tk := range t {
+		if tk.ID != id {
+			continue
+		}
+		switch {
+		case !tk.RevokedAt.IsZero():
+			return fmt.Errorf("%w: %s", ErrTokenRevoked, tk.Name)
+		case !now.Before(tk.ExpireAt):
+			return fmt.Errorf("%w: %s", ErrTokenExpired, tk.Name)
+		default:
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: id %s", ErrTokenNotExist, id)
+}
+
+// ValidateAPIToken returns an error if the api token identified by id is not
+// an active token of the username usr object.
+func (_ *UsrDB) ValidateAPIToken(username, id string) error {
+	usrPath := naming.Path{Name: username, Namespace: naming.NsSys, Kind: naming.KindUsr}
+	if !usrPath.Exists() {
+		return fmt.Errorf("username '%s' does not exist", username)
+	}
+	user, err := NewUsr(usrPath, WithVolatile(true))
+	if err != nil {
+		return err
+	}
+	l, err := user.Tokens()
+	if err != nil {
+		return err
+	}
+	return l.ValidateToken(id, time.Now())
+}
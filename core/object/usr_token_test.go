@@ -0,0 +1,62 @@
+package object
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/testhelper"
+)
+
+func TestUsrToken(t *testing.T) {
+	env := testhelper.Setup(t)
+	env.InstallFile("../../testdata/nodes_info.json", "var/nodes_info.json")
+	env.InstallFile("../../testdata/cluster.conf", "etc/cluster.conf")
+	_, err := SetClusterConfig()
+	require.NoError(t, err)
+
+	p := naming.Path{Name: "ci", Namespace: naming.NsSys, Kind: naming.KindUsr}
+	o, err := NewUsr(p)
+	require.NoError(t, err)
+
+	now := time.Now()
+	tk := UsrToken{
+		Name:      "deploy",
+		ID:        "id1",
+		Grants:    []string{"operator:ns1"},
+		CreatedAt: now,
+		ExpireAt:  now.Add(time.Hour),
+	}
+	require.NoError(t, o.AddToken(tk))
+	require.ErrorIs(t, o.AddToken(tk), ErrTokenExist)
+	require.NoError(t, o.AddToken(UsrToken{Name: "old", ID: "id0", CreatedAt: now.Add(-2 * time.Hour), ExpireAt: now.Add(-time.Hour)}))
+
+	l, err := o.Tokens()
+	require.NoError(t, err)
+	require.Len(t, l, 2)
+	require.Equal(t, tk.Grants, l[0].Grants)
+	require.NoError(t, l.ValidateToken("id1", now))
+	require.ErrorIs(t, l.ValidateToken("id0", now), ErrTokenExpired)
+	require.ErrorIs(t, l.ValidateToken("unknown", now), ErrTokenNotExist)
+
+	revoked, err := o.RevokeToken("deploy")
+	require.NoError(t, err)
+	require.False(t, revoked.RevokedAt.IsZero())
+
+	l, err = o.Tokens()
+	require.NoError(t, err)
+	require.ErrorIs(t, l.ValidateToken("id1", now), ErrTokenRevoked)
+
+	t.Log("a revoked token name can be reused, and the expired records are purged")
+	require.NoError(t, o.AddToken(UsrToken{Name: "deploy", ID: "id2", CreatedAt: now, ExpireAt: now.Add(time.Hour)}))
+	l, err = o.Tokens()
+	require.NoError(t, err)
+	require.Len(t, l, 1)
+	require.ErrorIs(t, l.ValidateToken("id1", now), ErrTokenNotExist)
+	require.NoError(t, l.ValidateToken("id2", now))
+
+	_, err = o.RevokeToken("unknown")
+	require.ErrorIs(t, err, ErrTokenNotExist)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/opensvc/om3/v3/core/driver"
 	"github.com/opensvc/om3/v3/core/keywords"
 	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/pool"
 	"github.com/opensvc/om3/v3/core/status"
 	"github.com/opensvc/om3/v3/core/volaccess"
 	"github.com/opensvc/om3/v3/util/device"
@@ -38,6 +39,12 @@ type (
 		HoldersExcept(ctx context.Context, p naming.Path) (naming.Paths, error)
 		Access() (volaccess.T, error)
 		Children() (naming.Relations, error)
+		Pool() (pool.Pooler, error)
+		CreateSnapshot(ctx context.Context, name string) error
+		DeleteSnapshot(ctx context.Context, name string) error
+		ListSnapshots(ctx context.Context) (pool.Snapshots, error)
+		RollbackSnapshot(ctx context.Context, name string) error
+		Resize(ctx context.Context, size int64) error
 	}
 )
 
@@ -0,0 +1,107 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/keyop"
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/util/key"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+// Resize grows the volume disk to size through the pool driver, updates
+// the size keywords and, if the local instance is up, makes the local disk
+// and fs resources use the new size, growing the mounted filesystems
+// online.
+//
+// The size keywords are updated after the pool disk resize, so the pool
+// disk resize is skipped if the size keyword is already set to size. This
+// way, once executed on a node, Resize can be executed on the other nodes
+// having an instance of the volume, like the nodes where a flex volume is
+// also up, to only resize their disk and fs resources.
+func (t *vol) Resize(ctx context.Context, size int64) error {
+	if size <= 0 {
+		return fmt.Errorf("invalid size %d", size)
+	}
+	ctx = actioncontext.WithProps(ctx, actioncontext.Resize)
+	if err := t.validateAction(); err != nil {
+		return err
+	}
+	p, err := t.Pool()
+	if err != nil {
+		return err
+	}
+	r, err := pool.AsResizer(p)
+	if err != nil {
+		return err
+	}
+	diskName := pool.DiskName(p, t)
+
+	// The instance status is read before locking, as its evaluation
+	// takes the action lock.
+	instanceStatus, err := t.Status(ctx)
+	if err != nil {
+		return err
+	}
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if current := t.config.GetSize(key.Parse("size")); current != nil && size < *current {
+		return fmt.Errorf("%s: can not shrink the volume from %s to %s", t.path, sizeconv.BSizeCompact(float64(*current)), sizeconv.BSizeCompact(float64(size)))
+	}
+	if current := t.config.GetSize(key.Parse("size")); current != nil && size == *current {
+		t.log.Infof("skip the %s resize: already resized to %s", diskName, sizeconv.BSizeCompact(float64(size)))
+	} else {
+		t.log.Infof("resize %s to %s", diskName, sizeconv.BSizeCompact(float64(size)))
+		if err := r.ResizeDisk(ctx, diskName, size); err != nil {
+			return err
+		}
+	}
+	if err := t.setSizeKeywords(size); err != nil {
+		return err
+	}
+	if instanceStatus.Avail != status.Up {
+		t.log.Infof("skip the disk and fs resources resize: the instance is %s", instanceStatus.Avail)
+		return nil
+	}
+	return t.action(ctx, func(ctx context.Context, r resource.Driver) error {
+		t.log.Attr("rid", r.RID()).Tracef("resize resource")
+		return resource.Resize(ctx, r)
+	})
+}
+
+// setSizeKeywords sets the size keyword and the size keyword of the disk
+// and fs resources to size, if not already set to this value.
+func (t *vol) setSizeKeywords(size int64) error {
+	value := sizeconv.ExactBSizeCompact(float64(size))
+	kops := make([]keyop.T, 0)
+	add := func(k key.T) {
+		if v := t.config.GetSize(k); v != nil && *v == size {
+			return
+		}
+		kops = append(kops, keyop.T{
+			Key:   k,
+			Op:    keyop.Set,
+			Value: value,
+		})
+	}
+	add(key.Parse("size"))
+	for _, r := range t.ResourcesByDrivergroups([]driver.Group{driver.GroupDisk, driver.GroupFS}) {
+		k := key.New(r.RID(), "size")
+		if !t.config.HasKey(k) {
+			continue
+		}
+		add(k)
+	}
+	if len(kops) == 0 {
+		return nil
+	}
+	return t.config.Set(kops...)
+}
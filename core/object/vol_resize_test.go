@@ -0,0 +1,110 @@
+package object
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/testhelper"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/key"
+)
+
+type (
+	// testResizePool is a pool driver recording the disk resizes.
+	testResizePool struct {
+		pool.T
+	}
+)
+
+var testResizedDisks = make(map[string]int64)
+
+func init() {
+	driver.Register(driver.NewID(driver.GroupPool, "testresize"), func() pool.Pooler {
+		return &testResizePool{}
+	})
+}
+
+func (t *testResizePool) Head() string {
+	return ""
+}
+
+func (t *testResizePool) Capabilities() pool.Capabilities {
+	return pool.Capabilities{pool.CapBlk}
+}
+
+func (t *testResizePool) Usage(context.Context) (pool.Usage, error) {
+	return pool.Usage{}, nil
+}
+
+func (t *testResizePool) ResizeDisk(_ context.Context, name string, size int64) error {
+	testResizedDisks[name] = size
+	return nil
+}
+
+func TestVolResize(t *testing.T) {
+	env := testhelper.Setup(t)
+	env.InstallFile("../../testdata/nodes_info.json", "var/nodes_info.json")
+	env.InstallFile("../../testdata/cluster.conf", "etc/cluster.conf")
+	require.NoError(t, os.WriteFile(filepath.Join(rawconfig.Paths.Etc, "node.conf"), []byte("[pool#resize]\ntype = testresize\n"), 0o600))
+	_, err := SetClusterConfig()
+	require.NoError(t, err)
+
+	p := naming.Path{Name: "vol1", Namespace: naming.NsRoot, Kind: naming.KindVol}
+	newVol := func(t *testing.T) Vol {
+		o, err := NewVol(p, WithConfigData(map[string]map[string]any{
+			"DEFAULT": {
+				"nodes": hostname.Hostname(),
+				"pool":  "resize",
+				"size":  "1g",
+			},
+		}))
+		require.NoError(t, err)
+		require.NoError(t, o.Config().Recommit())
+		return o
+	}
+	readSize := func(t *testing.T) int64 {
+		o, err := NewVol(p)
+		require.NoError(t, err)
+		v := o.Config().GetSize(key.Parse("size"))
+		require.NotNil(t, v)
+		return *v
+	}
+	ctx := context.Background()
+
+	t.Run("grow updates the size keyword", func(t *testing.T) {
+		o := newVol(t)
+		clear(testResizedDisks)
+		require.NoError(t, o.Resize(ctx, 2*1024*1024*1024))
+		require.Equal(t, int64(2*1024*1024*1024), readSize(t))
+		require.Equal(t, map[string]int64{o.(pool.Volumer).FQDN(): 2 * 1024 * 1024 * 1024}, testResizedDisks)
+	})
+
+	t.Run("same size does not change the config nor resize the disk", func(t *testing.T) {
+		o := newVol(t)
+		clear(testResizedDisks)
+		before, err := os.ReadFile(p.ConfigFile())
+		require.NoError(t, err)
+		require.NoError(t, o.Resize(ctx, 1024*1024*1024))
+		after, err := os.ReadFile(p.ConfigFile())
+		require.NoError(t, err)
+		require.Equal(t, string(before), string(after))
+		require.Empty(t, testResizedDisks, "the pool disk is not resized again")
+	})
+
+	t.Run("shrink is refused", func(t *testing.T) {
+		o := newVol(t)
+		clear(testResizedDisks)
+		err := o.Resize(ctx, 512*1024*1024)
+		require.ErrorContains(t, err, "can not shrink the volume from 1gi to 512mi")
+		require.Equal(t, int64(1024*1024*1024), readSize(t))
+		require.Empty(t, testResizedDisks, "the pool disk is not resized")
+	})
+}
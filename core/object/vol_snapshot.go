@@ -0,0 +1,152 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/key"
+)
+
+// Pool returns the pool driver named by the volume pool keyword.
+func (t *vol) Pool() (pool.Pooler, error) {
+	poolName := t.config.GetString(key.Parse("pool"))
+	if poolName == "" {
+		return nil, fmt.Errorf("%s: the pool keyword is not set", t.path)
+	}
+	node, err := NewNode(WithVolatile(true))
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range node.Pools() {
+		if p.Name() == poolName {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: pool %s not found", t.path, poolName)
+}
+
+// snapshotter returns the Snapshotter interface of the volume pool and the
+// name of the volume disk in this pool.
+func (t *vol) snapshotter() (pool.Snapshotter, string, error) {
+	p, err := t.Pool()
+	if err != nil {
+		return nil, "", err
+	}
+	s, err := pool.AsSnapshotter(p)
+	if err != nil {
+		return nil, "", err
+	}
+	return s, pool.DiskName(p, t), nil
+}
+
+// CreateSnapshot creates the snapshot <name> of the volume disk.
+func (t *vol) CreateSnapshot(ctx context.Context, name string) error {
+	if err := pool.ValidateSnapshotName(name); err != nil {
+		return err
+	}
+	ctx = actioncontext.WithProps(ctx, actioncontext.SnapshotCreate)
+	s, diskName, err := t.snapshotter()
+	if err != nil {
+		return err
+	}
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	t.log.Infof("create snapshot %s of %s", name, diskName)
+	return s.CreateSnapshot(ctx, diskName, name)
+}
+
+// DeleteSnapshot deletes the snapshot <name> of the volume disk.
+func (t *vol) DeleteSnapshot(ctx context.Context, name string) error {
+	ctx = actioncontext.WithProps(ctx, actioncontext.SnapshotDelete)
+	s, diskName, err := t.snapshotter()
+	if err != nil {
+		return err
+	}
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	t.log.Infof("delete snapshot %s of %s", name, diskName)
+	return s.DeleteSnapshot(ctx, diskName, name)
+}
+
+// ListSnapshots returns the snapshots of the volume disk, sorted by
+// creation date.
+func (t *vol) ListSnapshots(ctx context.Context) (pool.Snapshots, error) {
+	s, diskName, err := t.snapshotter()
+	if err != nil {
+		return nil, err
+	}
+	l, err := s.ListSnapshots(ctx, diskName)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(l)
+	return l, nil
+}
+
+// RollbackSnapshot restores the volume disk data from the snapshot <name>.
+// The volume instances must be stopped on all nodes, as the disk of a
+// shared pool, like rados or pure, is accessible from the peer nodes.
+func (t *vol) RollbackSnapshot(ctx context.Context, name string) error {
+	ctx = actioncontext.WithProps(ctx, actioncontext.SnapshotRollback)
+	s, diskName, err := t.snapshotter()
+	if err != nil {
+		return err
+	}
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	instanceStatus, err := t.Status(ctx)
+	if err != nil {
+		return err
+	}
+	if !instanceStatus.Avail.Is(status.Down, status.StandbyDown, status.NotApplicable, status.Undef) {
+		return fmt.Errorf("%s: the instance is %s, stop it before rollback", t.path, instanceStatus.Avail)
+	}
+	if err := t.checkPeerInstancesStopped(ctx); err != nil {
+		return err
+	}
+	t.log.Infof("rollback %s to snapshot %s", diskName, name)
+	return s.RollbackSnapshot(ctx, diskName, name)
+}
+
+// checkPeerInstancesStopped returns an error if a peer instance of the
+// volume is not stopped, according to the instance status data of the
+// local daemon, or if the daemon can't tell.
+func (t *vol) checkPeerInstancesStopped(ctx context.Context) error {
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	path := t.path.String()
+	resp, err := c.GetInstancesWithResponse(ctx, &api.GetInstancesParams{Path: &path})
+	if err != nil {
+		return fmt.Errorf("%s: can't verify the peer instances are stopped: %w", t.path, err)
+	} else if resp.JSON200 == nil {
+		return fmt.Errorf("%s: can't verify the peer instances are stopped: unexpected get instances status: %s", t.path, resp.Status())
+	}
+	localhost := hostname.Hostname()
+	for _, item := range resp.JSON200.Items {
+		if item.Meta.Node == localhost || item.Data.Status == nil {
+			continue
+		}
+		if avail := item.Data.Status.Avail; !avail.Is(status.Down, status.StandbyDown, status.NotApplicable, status.Undef) {
+			return fmt.Errorf("%s: the instance on node %s is %s, stop it before rollback", t.path, item.Meta.Node, avail)
+		}
+	}
+	return nil
+}
@@ -214,6 +214,16 @@ func WithAsyncWait(v bool) funcopt.O {
 	})
 }
 
+// WithAsyncWaitJob waits for the daemon jobs of the submitted orchestrations
+// or actions to end, and fails if they did not succeed.
+func WithAsyncWaitJob(v bool) funcopt.O {
+	return funcopt.F(func(i any) error {
+		t := i.(*T)
+		t.WaitJob = v
+		return nil
+	})
+}
+
 // WithAsyncWatch runs a event-driven monitor on the selected objects after
 // setting a new target. So the operator can see the orchestration
 // unfolding.
@@ -626,6 +636,16 @@ func (t T) DoAsync() error {
 			}
 		}
 	}
+	if t.WaitJob {
+		for _, r := range rs {
+			if r.OrchestrationID == uuid.Nil {
+				continue
+			}
+			if err := actionrouter.WaitJob(ctx, c, "", r.OrchestrationID); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s: %w", r.Path, err))
+			}
+		}
+	}
 	if postErrCount > 0 {
 		errs = errors.Join(errs, fmt.Errorf("actions rejected: %d", postErrCount))
 	}
@@ -778,6 +798,17 @@ func (t T) DoRemote() error {
 			}
 		}
 	}
+	if t.WaitJob {
+		for _, result := range results {
+			accepted, ok := result.Data.(api.InstanceActionAccepted)
+			if !ok {
+				continue
+			}
+			if err := actionrouter.WaitJob(ctx, c, result.Nodename, accepted.SessionID); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s@%s: %w", result.Path, result.Nodename, err))
+			}
+		}
+	}
 	return errs
 }
 
@@ -13,6 +13,7 @@ const (
 	FeedInstanceResinfo = "/api/instance/resource_info"
 	FeedInstanceStatus  = "/api/instance/status"
 
+	FeedNodeAudit  = "/api/node/audit"
 	FeedNodeDisk   = "/api/node/disk"
 	FeedNodeSystem = "/api/node/system"
 
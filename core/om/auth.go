@@ -0,0 +1,18 @@
+package om
+
+import (
+	"github.com/opensvc/om3/v3/core/commoncmd"
+)
+
+var (
+	cmdAuth = commoncmd.NewCmdAuth()
+)
+
+func init() {
+	root.AddCommand(
+		cmdAuth,
+	)
+	cmdAuth.AddCommand(
+		commoncmd.NewCmdAuthCanI(),
+	)
+}
@@ -195,6 +195,7 @@ func newCmdDaemonEvents() *cobra.Command {
 	commoncmd.FlagColor(flags, &options.Color)
 	commoncmd.FlagDuration(flags, &options.Duration)
 	commoncmd.FlagEventReplay(flags, &options.Replay)
+	commoncmd.FlagEventSince(flags, &options.Since)
 	commoncmd.FlagEventFilters(flags, &options.Filters)
 	commoncmd.FlagEventOutput(flags, &options.Output)
 	commoncmd.FlagEventTemplate(flags, &options.Template)
@@ -514,6 +515,29 @@ func newCmdNodeAbort() *cobra.Command {
 	return cmd
 }
 
+func newCmdNodeAuditLog() *cobra.Command {
+	var options commands.CmdNodeAuditLog
+	cmd := &cobra.Command{
+		Use:   "log",
+		Short: "show the api requests audit records",
+		Long: `Show the audit records of the mutating api requests handled by the selected nodes.
+
+Each record has the user, the authentication strategy, the grants, the source ip,
+the operation id, the object path, the query parameters and the result status.
+
+The local node is selected by default.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	flags.StringVar(&options.Since, "since", "", "show the records more recent than this duration (ex: 1h) or RFC3339 time")
+	flags.Int64Var(&options.Limit, "limit", 0, "show only the most recent records, per node")
+	return cmd
+}
+
 func newCmdNodeCapabilitiesList() *cobra.Command {
 	var options commands.CmdNodeCapabilitiesList
 	cmd := &cobra.Command{
@@ -571,6 +595,23 @@ This command scans SCSI hosts for new block devices. You can specify specific HB
 	return cmd
 }
 
+func newCmdNodeCapacity() *cobra.Command {
+	var options commands.CmdNodeCapacity
+	cmd := &cobra.Command{
+		GroupID: commoncmd.GroupIDQuery,
+		Use:     "capacity",
+		Short:   "show the nodes cpu and memory capacity",
+		Long:    "Show the cpu and memory provided by each node, the resources requested by the instances running or starting there, and the free resources used by the placement admission control.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	return cmd
+}
+
 func newCmdNodeChecks() *cobra.Command {
 	var options commands.CmdNodeChecks
 	cmd := &cobra.Command{
@@ -1218,6 +1259,43 @@ func newCmdNodeScheduleList() *cobra.Command {
 	return cmd
 }
 
+func newCmdNodeMaintenanceEnter() *cobra.Command {
+	var options commands.CmdNodeMaintenanceEnter
+	cmd := &cobra.Command{
+		Use:   "enter",
+		Short: "drain the node and keep it in maintenance",
+		Long:  "If not specified with --node, the local node is selected. Entering again updates the reason and expiry of the current maintenance.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	flags.StringVar(&options.Reason, "reason", "", "the reason of the maintenance, displayed in the cluster status")
+	flags.StringVar(&options.Expire, "expire", "", "the maintenance duration, after which the maintenance is automatically left (ex: 2h)")
+	if err := cmd.MarkFlagRequired("reason"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func newCmdNodeMaintenanceLeave() *cobra.Command {
+	var options commands.CmdNodeMaintenanceLeave
+	cmd := &cobra.Command{
+		Use:   "leave",
+		Short: "unfreeze the node and give back its objects",
+		Long:  "The node stays frozen if it was frozen before entering the maintenance. If not specified with --node, the local node is selected.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	return cmd
+}
+
 func newCmdNodePushasset() *cobra.Command {
 	var options commands.CmdNodePushAsset
 	cmd := &cobra.Command{
@@ -1478,7 +1556,7 @@ func newCmdObjectAbort(kind string) *cobra.Command {
 		},
 	}
 	flags := cmd.Flags()
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	addFlagsGlobal(flags, &options.OptsGlobal)
 	return cmd
 }
@@ -2044,7 +2122,7 @@ func newCmdObjectCreate(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagCreateConfig(flags, &options.Config)
 	commoncmd.FlagCreateEnv(flags, &options.Env)
@@ -2075,7 +2153,7 @@ func newCmdObjectDelete(kind string) *cobra.Command {
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
 	hiddenFlagLocal(flags, &options.Local)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.HiddenFlagsLock(flags, &options.OptsLock)
 	commoncmd.HiddenFlagNodeSelector(flags, &options.NodeSelector)
 	return cmd
@@ -2095,7 +2173,7 @@ func newCmdObjectDeploy(kind string) *cobra.Command {
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
 	flagLocal(flags, &options.Local)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagCreateConfig(flags, &options.Config)
 	commoncmd.FlagCreateEnv(flags, &options.Env)
@@ -2206,7 +2284,7 @@ func newCmdObjectFreeze(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.HiddenFlagNodeSelector(flags, &options.NodeSelector)
 	hiddenFlagLocal(flags, &options.Local)
 	return cmd
@@ -2243,7 +2321,7 @@ func newCmdObjectGiveback(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	return cmd
 }
 
@@ -2329,6 +2407,88 @@ func newCmdObjectScheduleList(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectSnapshotCreate(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotCreate
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a snapshot of the volume data",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
+	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	commoncmd.FlagSnapshotName(flags, &options.Name)
+	hiddenFlagLocal(flags, &options.Local)
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func newCmdObjectSnapshotDelete(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotDelete
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "delete a snapshot of the volume data",
+		Aliases: []string{"del"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
+	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	commoncmd.FlagSnapshotName(flags, &options.Name)
+	hiddenFlagLocal(flags, &options.Local)
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func newCmdObjectSnapshotList(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotList
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "list the volume data snapshots",
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	flagLocal(flags, &options.Local)
+	return cmd
+}
+
+func newCmdObjectSnapshotRollback(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotRollback
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "restore the volume data from a snapshot",
+		Long:  "The local volume instance must be stopped. With lvm pools, the snapshot is consumed by the rollback.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
+	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	commoncmd.FlagSnapshotName(flags, &options.Name)
+	hiddenFlagLocal(flags, &options.Local)
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
 func newCmdObjectInstanceBoot(kind string) *cobra.Command {
 	var options commands.CmdObjectInstanceBoot
 	cmd := &cobra.Command{
@@ -2363,7 +2523,7 @@ func newCmdObjectInstanceDelete(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
 	return cmd
@@ -2415,7 +2575,7 @@ func newCmdObjectInstanceFreeze(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagSlave(flags, &options.Slaves)
 	commoncmd.FlagSlaves(flags, &options.AllSlaves)
 	commoncmd.FlagMaster(flags, &options.Master)
@@ -2436,7 +2596,7 @@ func newCmdObjectInstanceProvision(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2460,7 +2620,7 @@ func newCmdObjectInstancePRStart(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2485,7 +2645,7 @@ func newCmdObjectInstancePRStop(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2510,7 +2670,7 @@ func newCmdObjectInstancePGUpdate(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2529,7 +2689,7 @@ func newCmdObjectInstanceRestart(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2554,7 +2714,7 @@ func newCmdObjectInstanceRun(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2580,7 +2740,7 @@ func newCmdObjectInstanceStart(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2605,7 +2765,7 @@ func newCmdObjectInstanceStop(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2662,7 +2822,7 @@ func newCmdObjectInstanceUnfreeze(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagSlave(flags, &options.Slaves)
 	commoncmd.FlagSlaves(flags, &options.AllSlaves)
 	commoncmd.FlagMaster(flags, &options.Master)
@@ -2683,7 +2843,7 @@ func newCmdObjectInstanceUnprovision(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2709,7 +2869,7 @@ func newCmdObjectProvision(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.HiddenFlagsLock(flags, &options.OptsLock)
 	commoncmd.HiddenFlagsTo(flags, &options.OptTo)
 	commoncmd.HiddenFlagsResourceSelectorWithCompletion(cmd, &options.OptsResourceSelector)
@@ -2777,7 +2937,7 @@ func newCmdObjectPurge(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.HiddenFlagsLock(flags, &options.OptsLock)
 	commoncmd.HiddenFlagsEncap(flags, &options.OptsEncap)
 	commoncmd.HiddenFlagsResourceSelectorWithCompletion(cmd, &options.OptsResourceSelector)
@@ -2806,6 +2966,28 @@ func newCmdObjectPushResourceInfo(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectResize(kind string) *cobra.Command {
+	var options commands.CmdObjectResize
+	cmd := &cobra.Command{
+		Use:   "resize",
+		Short: "grow the volume disk and filesystem",
+		Long:  "Grow the volume disk through its pool driver, update the volume size keywords, and make the disk and fs resources of the volume instances use the new size. The pool disk is resized once, from a node where the volume is up if any, then the disk and fs resources are resized on the other nodes with an instance, or only on the --node selected nodes. The mounted filesystems are grown online on the nodes where the volume is up.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
+	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	commoncmd.FlagSize(flags, &options.Size)
+	hiddenFlagLocal(flags, &options.Local)
+	if err := cmd.MarkFlagRequired("size"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
 func newCmdObjectRestart(kind string) *cobra.Command {
 	var options commands.CmdObjectRestart
 	cmd := &cobra.Command{
@@ -2818,7 +3000,7 @@ func newCmdObjectRestart(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.HiddenFlagsEncap(flags, &options.OptsEncap)
 	commoncmd.HiddenFlagsLock(flags, &options.OptsLock)
 	commoncmd.HiddenFlagsResourceSelectorWithCompletion(cmd, &options.OptsResourceSelector)
@@ -2842,10 +3024,12 @@ func newCmdObjectInstanceSyncIngest(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
 	hiddenFlagLocal(flags, &options.Local)
+	flags.StringVar(&options.Stream, "stream", "", "ingest the named sync data stream read from stdin")
+	flags.Lookup("stream").Hidden = true
 	return cmd
 }
 
@@ -2861,7 +3045,7 @@ func newCmdObjectInstanceSyncFull(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
 	commoncmd.FlagForce(flags, &options.Force)
@@ -2882,7 +3066,7 @@ func newCmdObjectInstanceSyncResync(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
 	commoncmd.FlagForce(flags, &options.Force)
@@ -2902,7 +3086,7 @@ func newCmdObjectInstanceSyncSplit(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
 	commoncmd.FlagForce(flags, &options.Force)
@@ -2922,7 +3106,7 @@ func newCmdObjectInstanceSyncUpdate(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
 	commoncmd.FlagForce(flags, &options.Force)
@@ -3038,7 +3222,7 @@ func newCmdObjectInstanceShutdown(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -3065,7 +3249,7 @@ func newCmdObjectStart(kind string) *cobra.Command {
 
 	// hidden (backward compat)
 	hiddenFlagLocal(flags, &options.Local)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.HiddenFlagsEncap(flags, &options.OptsEncap)
 	commoncmd.HiddenFlagsLock(flags, &options.OptsLock)
 	commoncmd.HiddenFlagsResourceSelectorWithCompletion(cmd, &options.OptsResourceSelector)
@@ -3087,7 +3271,7 @@ func newCmdObjectInstanceStartStandby(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -3135,7 +3319,7 @@ func newCmdObjectStop(kind string) *cobra.Command {
 
 	// hidden (backward compat)
 	hiddenFlagLocal(flags, &options.Local)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.HiddenFlagsEncap(flags, &options.OptsEncap)
 	commoncmd.HiddenFlagsLock(flags, &options.OptsLock)
 	commoncmd.HiddenFlagsResourceSelectorWithCompletion(cmd, &options.OptsResourceSelector)
@@ -3158,7 +3342,7 @@ func newCmdObjectSwitch(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagSwitchTo(flags, &options.To)
 	commoncmd.FlagLive(flags, &options.Live)
 	return cmd
@@ -3177,7 +3361,7 @@ func newCmdObjectUnfreeze(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
 	hiddenFlagLocal(flags, &options.Local)
 	return cmd
@@ -3196,7 +3380,7 @@ func newCmdObjectTakeover(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagLive(flags, &options.Live)
 	return cmd
 }
@@ -3224,7 +3408,7 @@ func newCmdObjectUnprovision(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.HiddenFlagsEncap(flags, &options.OptsEncap)
 	commoncmd.HiddenFlagsLock(flags, &options.OptsLock)
 	commoncmd.HiddenFlagsResourceSelectorWithCompletion(cmd, &options.OptsResourceSelector)
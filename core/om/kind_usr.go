@@ -18,6 +18,7 @@ func init() {
 	cmdObjectPrint := newCmdObjectPrint(kind)
 	cmdObjectPrintConfig := newCmdObjectPrintConfig(kind)
 	cmdObjectValidate := newCmdObjectValidate(kind)
+	cmdObjectToken := commoncmd.NewCmdUsrToken()
 
 	root.AddCommand(
 		cmdObject,
@@ -35,6 +36,7 @@ func init() {
 		cmdObjectInstance,
 		cmdObjectPrint,
 		cmdObjectSet,
+		cmdObjectToken,
 		cmdObjectValidate,
 		newCmdDataStoreAdd(kind),
 		newCmdDataStoreChange(kind),
@@ -92,6 +94,11 @@ func init() {
 	cmdObjectPrintConfig.AddCommand(
 		newCmdObjectConfigMtime(kind),
 	)
+	cmdObjectToken.AddCommand(
+		commoncmd.NewCmdUsrTokenAdd(kind),
+		commoncmd.NewCmdUsrTokenList(kind),
+		commoncmd.NewCmdUsrTokenRevoke(kind),
+	)
 	cmdObjectValidate.AddCommand(
 		newCmdObjectValidateConfig(kind),
 	)
@@ -28,6 +28,7 @@ func init() {
 	cmdObjectResource := commoncmd.NewCmdObjectResource(kind)
 	cmdObjectSchedule := commoncmd.NewCmdObjectSchedule(kind)
 	cmdObjectSet := newCmdObjectSet(kind)
+	cmdObjectSnapshot := commoncmd.NewCmdObjectSnapshot(kind)
 	cmdObjectSync := commoncmd.NewCmdObjectSync(kind)
 	cmdObjectValidate := newCmdObjectValidate(kind)
 
@@ -50,6 +51,7 @@ func init() {
 		cmdObjectResource,
 		cmdObjectSet,
 		cmdObjectSchedule,
+		cmdObjectSnapshot,
 		cmdObjectSync,
 		cmdObjectValidate,
 		newCmdObjectAbort(kind),
@@ -68,6 +70,7 @@ func init() {
 		newCmdObjectProvision(kind),
 		newCmdObjectPRStart(kind),
 		newCmdObjectPRStop(kind),
+		newCmdObjectResize(kind),
 		newCmdObjectRestart(kind),
 		newCmdObjectRun(kind),
 		newCmdObjectShutdown(kind),
@@ -174,6 +177,12 @@ func init() {
 		newCmdObjectSetProvisioned(kind),
 		newCmdObjectSetUnprovisioned(kind),
 	)
+	cmdObjectSnapshot.AddCommand(
+		newCmdObjectSnapshotCreate(kind),
+		newCmdObjectSnapshotDelete(kind),
+		newCmdObjectSnapshotList(kind),
+		newCmdObjectSnapshotRollback(kind),
+	)
 	cmdObjectSync.AddCommand(
 		newCmdObjectInstanceSyncFull(kind),
 		newCmdObjectInstanceSyncIngest(kind),
@@ -9,7 +9,12 @@ import (
 )
 
 var (
-	cmdNode             = commoncmd.NewCmdNode()
+	cmdNode      = commoncmd.NewCmdNode()
+	cmdNodeAudit = &cobra.Command{
+		GroupID: commoncmd.GroupIDSubsystems,
+		Use:     "audit",
+		Short:   "api requests audit trail commands",
+	}
 	cmdNodeCapabilities = &cobra.Command{
 		GroupID: commoncmd.GroupIDSubsystems,
 		Use:     "capabilities",
@@ -39,6 +44,12 @@ var (
 		Short:   "configuration commands",
 		Aliases: []string{"conf", "c", "cf", "cfg"},
 	}
+	cmdNodeMaintenance = &cobra.Command{
+		GroupID: commoncmd.GroupIDSubsystems,
+		Use:     "maintenance",
+		Short:   "node maintenance mode commands",
+		Long:    "A node in maintenance is drained, and its objects are given back when the maintenance is left or expires.",
+	}
 	cmdNodeSCSI = &cobra.Command{
 		GroupID: commoncmd.GroupIDSubsystems,
 		Use:     "scsi",
@@ -135,6 +146,10 @@ func init() {
 	)
 
 	root.AddCommand(cmdNode)
+	cmdNode.AddCommand(cmdNodeAudit)
+	cmdNodeAudit.AddCommand(
+		newCmdNodeAuditLog(),
+	)
 	cmdNode.AddCommand(cmdNodeCapabilities)
 	cmdNodeCapabilities.AddCommand(
 		newCmdNodeCapabilitiesList(),
@@ -184,6 +199,7 @@ func init() {
 	cmdNode.AddCommand(
 		cmdNodeConfig,
 		cmdNodeEdit,
+		cmdNodeMaintenance,
 		cmdNodePrint,
 		cmdNodePush,
 		cmdNodeRelay,
@@ -194,6 +210,7 @@ func init() {
 		cmdNodeUpdate,
 		cmdNodeValidate,
 		newCmdNodeAbort(),
+		newCmdNodeCapacity(),
 		newCmdNodeChecks(),
 		newCmdNodeClear(),
 		newCmdNodeDequeue(),
@@ -217,6 +234,10 @@ func init() {
 		newCmdNodeUnfreeze(),
 		newCmdNodeUnset(),
 	)
+	cmdNodeMaintenance.AddCommand(
+		newCmdNodeMaintenanceEnter(),
+		newCmdNodeMaintenanceLeave(),
+	)
 	cmdNodeConfig.AddCommand(
 		omcmd.NewCmdNodeConfigDoc(),
 		newCmdNodeConfigEdit(),
@@ -0,0 +1,89 @@
+package omcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/nodeselector"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/util/hostname"
+)
+
+type (
+	CmdNodeAuditLog struct {
+		OptsGlobal
+		NodeSelector string
+		Since        string
+		Limit        int64
+	}
+)
+
+func (t *CmdNodeAuditLog) extract(c *client.T, nodename string) (api.AuditRecordList, error) {
+	params := api.GetNodeAuditLogParams{}
+	if t.Since != "" {
+		params.Since = &t.Since
+	}
+	if t.Limit > 0 {
+		params.Limit = &t.Limit
+	}
+	resp, err := c.GetNodeAuditLogWithResponse(context.Background(), nodename, &params)
+	if err != nil {
+		return api.AuditRecordList{}, err
+	}
+	switch resp.StatusCode() {
+	case 200:
+		return *resp.JSON200, nil
+	case 400:
+		return api.AuditRecordList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON400)
+	case 401:
+		return api.AuditRecordList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON401)
+	case 403:
+		return api.AuditRecordList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON403)
+	case 500:
+		return api.AuditRecordList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON500)
+	default:
+		return api.AuditRecordList{}, fmt.Errorf("%s: unexpected statuscode: %s", nodename, resp.Status())
+	}
+}
+
+func (t *CmdNodeAuditLog) Run() error {
+	var errs error
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	if t.NodeSelector == "" {
+		t.NodeSelector = hostname.Hostname()
+	}
+	nodenames, err := nodeselector.New(t.NodeSelector, nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return err
+	}
+	data := api.AuditRecordList{
+		Kind:  "AuditRecordList",
+		Items: make(api.AuditRecordItems, 0),
+	}
+	for _, nodename := range nodenames {
+		if d, err := t.extract(c, nodename); err != nil {
+			errs = errors.Join(errs, err)
+		} else {
+			data.Items = append(data.Items, d.Items...)
+		}
+	}
+	sort.SliceStable(data.Items, func(i, j int) bool {
+		return data.Items[i].At.Before(data.Items[j].At)
+	})
+	output.Renderer{
+		DefaultOutput: "tab=AT:at,NODE:node,USER:user,SOURCE:source_ip,OPERATION:operation_id,OBJECT:object,STATUS:status",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          data,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return errs
+}
@@ -0,0 +1,50 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+type (
+	CmdNodeCapacity struct {
+		OptsGlobal
+		NodeSelector string
+	}
+)
+
+func (t *CmdNodeCapacity) Run() error {
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	params := api.GetNodesCapacityParams{}
+	if t.NodeSelector != "" {
+		params.Node = &t.NodeSelector
+	}
+	resp, err := c.GetNodesCapacityWithResponse(context.Background(), &params)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case 200:
+	case 401:
+		return fmt.Errorf("%s", *resp.JSON401)
+	case 403:
+		return fmt.Errorf("%s", *resp.JSON403)
+	default:
+		return fmt.Errorf("unexpected statuscode: %s", resp.Status())
+	}
+	output.Renderer{
+		DefaultOutput: "tab=NODE:meta.node,CPUS:data.total.cpus,CPUS_REQUESTED:data.requested.cpus,CPUS_FREE:data.free.cpus,MEM:data.total.mem,MEM_REQUESTED:data.requested.mem,MEM_FREE:data.free.mem",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          *resp.JSON200,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
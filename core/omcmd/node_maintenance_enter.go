@@ -0,0 +1,78 @@
+package omcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/nodeselector"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/util/hostname"
+)
+
+type CmdNodeMaintenanceEnter struct {
+	OptsGlobal
+	NodeSelector string
+	Reason       string
+	Expire       string
+}
+
+func (t *CmdNodeMaintenanceEnter) Run() error {
+	if t.NodeSelector == "" {
+		t.NodeSelector = hostname.Hostname()
+	}
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	nodenames, err := nodeselector.New(t.NodeSelector, nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return err
+	}
+	body := api.PostNodeMaintenance{
+		Reason: t.Reason,
+	}
+	if t.Expire != "" {
+		body.Expire = &t.Expire
+	}
+	var errs error
+	for _, nodename := range nodenames {
+		errs = errors.Join(errs, t.enter(c, nodename, body))
+	}
+	return errs
+}
+
+func (t *CmdNodeMaintenanceEnter) enter(c *client.T, nodename string, body api.PostNodeMaintenance) error {
+	ctx := context.Background()
+	resp, err := c.PostNodeMaintenanceWithResponse(ctx, nodename, body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		m := resp.JSON200
+		if m.ExpireAt.IsZero() {
+			fmt.Printf("%s: in maintenance since %s: %s\n", nodename, m.Since.Format(time.RFC3339), m.Reason)
+		} else {
+			fmt.Printf("%s: in maintenance since %s until %s: %s\n", nodename, m.Since.Format(time.RFC3339), m.ExpireAt.Format(time.RFC3339), m.Reason)
+		}
+		return nil
+	case 400:
+		return fmt.Errorf("%s: %s", nodename, *resp.JSON400)
+	case 401:
+		return fmt.Errorf("%s: %s", nodename, *resp.JSON401)
+	case 403:
+		return fmt.Errorf("%s: %s", nodename, *resp.JSON403)
+	case 408:
+		return fmt.Errorf("%s: %s", nodename, *resp.JSON408)
+	case 409:
+		return fmt.Errorf("%s: %s", nodename, *resp.JSON409)
+	case 500:
+		return fmt.Errorf("%s: %s", nodename, *resp.JSON500)
+	default:
+		return fmt.Errorf("%s: unexpected status [%d]", nodename, resp.StatusCode())
+	}
+}
@@ -1,6 +1,8 @@
 package omcmd
 
 import (
+	"context"
+
 	"github.com/opensvc/om3/v3/core/nodeaction"
 	"github.com/opensvc/om3/v3/core/object"
 )
@@ -21,7 +23,7 @@ func (t *CmdNodeStonith) Run() error {
 			if err != nil {
 				return nil, err
 			}
-			return nil, n.Stonith(t.Node)
+			return n.Stonith(context.Background(), t.Node)
 		}),
 	).Do()
 }
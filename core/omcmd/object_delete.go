@@ -31,6 +31,7 @@ func (t *CmdObjectDelete) Run(kind string) error {
 		objectaction.WithAsyncTarget("deleted"),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 		objectaction.WithRemoteNodes(t.NodeSelector),
 		objectaction.WithRemoteFunc(commoncmd.ObjectInstanceDeleteRemoteFunc),
@@ -33,6 +33,7 @@ func (t *CmdObjectFreeze) Run(kind string) error {
 		objectaction.WithAsyncTarget("frozen"),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 		objectaction.WithRemoteNodes(t.NodeSelector),
 		objectaction.WithRemoteFunc(func(ctx context.Context, p naming.Path, nodename string) (interface{}, error) {
@@ -28,6 +28,7 @@ func (t *CmdObjectInstanceDelete) Run(kind string) error {
 		objectaction.WithObjectSelector(mergedSelector),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 		objectaction.WithRemoteNodes(t.NodeSelector),
 		objectaction.WithRemoteFunc(commoncmd.ObjectInstanceDeleteRemoteFunc),
@@ -31,6 +31,7 @@ func (t *CmdObjectInstanceFreeze) Run(kind string) error {
 		objectaction.WithIgnoreNotFound(t.IgnoreNotFound),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 		objectaction.WithAllSlaves(t.AllSlaves),
 		objectaction.WithMaster(t.Master),
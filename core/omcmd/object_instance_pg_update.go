@@ -33,6 +33,7 @@ func (t *CmdObjectInstancePGUpdate) Run(kind string) error {
 		objectaction.WithIgnoreNotFound(t.IgnoreNotFound),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 		objectaction.WithSlaves(t.Slaves),
 		objectaction.WithAllSlaves(t.AllSlaves),
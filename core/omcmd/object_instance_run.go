@@ -46,6 +46,7 @@ func (t *CmdObjectInstanceRun) Run(kind string) error {
 		objectaction.WithIgnoreNotFound(t.IgnoreNotFound),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 		objectaction.WithLocal(t.Local),
 		objectaction.WithRemoteNodes(t.NodeSelector),
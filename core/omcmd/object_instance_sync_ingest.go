@@ -2,6 +2,7 @@ package omcmd
 
 import (
 	"context"
+	"os"
 
 	"github.com/opensvc/om3/v3/core/actioncontext"
 	"github.com/opensvc/om3/v3/core/commoncmd"
@@ -17,6 +18,10 @@ type (
 		commoncmd.OptsLock
 		commoncmd.OptsResourceSelector
 		Local bool
+
+		// Stream is the name of the sync data stream read from stdin,
+		// set by the daemon api piping a sync data request body.
+		Stream string
 	}
 )
 
@@ -33,6 +38,7 @@ func (t *CmdObjectInstanceSyncIngest) Run(kind string) error {
 		objectaction.WithIgnoreNotFound(t.IgnoreNotFound),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (any, error) {
 			o, err := object.NewActor(p)
@@ -41,6 +47,9 @@ func (t *CmdObjectInstanceSyncIngest) Run(kind string) error {
 			}
 			ctx = actioncontext.WithLockDisabled(ctx, t.Disable)
 			ctx = actioncontext.WithLockTimeout(ctx, t.Timeout)
+			if t.Stream != "" {
+				ctx = actioncontext.WithSyncData(ctx, t.Stream, os.Stdin)
+			}
 			return nil, o.SyncIngest(ctx)
 		}),
 	).Do()
@@ -0,0 +1,170 @@
+package omcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/nodeselector"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/objectaction"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+	"github.com/opensvc/om3/v3/util/xsession"
+)
+
+type (
+	CmdObjectResize struct {
+		OptsGlobal
+		commoncmd.OptsAsync
+		Local        bool
+		NodeSelector string
+		Size         string
+	}
+)
+
+// Run resizes the selected volumes. The pool disk is shared by the volume
+// instances, so it is resized once, from a node where the volume is up if
+// any. The disk and fs resources are then resized on the other nodes with
+// an instance, where the pool disk resize is skipped. The --node selector
+// limits the nodes where the volumes are resized.
+func (t *CmdObjectResize) Run(kind string) error {
+	size, err := sizeconv.FromSize(t.Size)
+	if err != nil {
+		return fmt.Errorf("--size: %w", err)
+	}
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	if t.Local {
+		return t.do(mergedSelector, "", size, t.WaitJob)
+	}
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	var selected map[string]bool
+	if t.NodeSelector != "" {
+		nodenames, err := nodeselector.New(t.NodeSelector, nodeselector.WithClient(c)).Expand()
+		if err != nil {
+			return err
+		}
+		selected = make(map[string]bool)
+		for _, nodename := range nodenames {
+			selected[nodename] = true
+		}
+	}
+	resp, err := c.GetObjectsWithResponse(context.Background(), &api.GetObjectsParams{Path: &mergedSelector})
+	if err != nil {
+		return err
+	} else if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("get objects: unexpected response: %s", resp.Status())
+	}
+	var errs error
+	for _, item := range resp.JSON200.Items {
+		core, err := item.Data.AsObjectCore()
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", item.Meta.Object, err))
+			continue
+		}
+		nodenames, err := resizeNodes(core.Instances, selected)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", item.Meta.Object, err))
+			continue
+		}
+		// wait for the pool disk resize before resizing the resources of
+		// the other instances.
+		if err := t.do(item.Meta.Object, nodenames[0], size, true); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if len(nodenames) > 1 {
+			errs = errors.Join(errs, t.do(item.Meta.Object, strings.Join(nodenames[1:], ","), size, t.WaitJob))
+		}
+	}
+	return errs
+}
+
+// resizeNodes returns the nodes with an instance to resize the volume on,
+// in selected if not nil. The first node, resizing the pool disk, is a
+// node where the volume is up, or else the first node.
+func resizeNodes(instances api.InstanceMap, selected map[string]bool) ([]string, error) {
+	candidates := make([]string, 0, len(instances))
+	for nodename := range instances {
+		if selected == nil || selected[nodename] {
+			candidates = append(candidates, nodename)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no instance on the selected nodes")
+	}
+	sort.Strings(candidates)
+	for i, nodename := range candidates {
+		if st := instances[nodename].Status; st != nil && st.Avail == status.Up {
+			candidates[0], candidates[i] = candidates[i], candidates[0]
+			break
+		}
+	}
+	return candidates, nil
+}
+
+// do resizes the selected volumes on the nodename selected nodes, or
+// locally if nodename is empty. If waitJob is set, do returns when the
+// resize jobs are done.
+func (t *CmdObjectResize) do(selector, nodename string, size int64, waitJob bool) error {
+	return objectaction.New(
+		objectaction.WithColor(t.Color),
+		objectaction.WithIgnoreNotFound(t.IgnoreNotFound),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithObjectSelector(selector),
+		objectaction.WithLocal(nodename == ""),
+		objectaction.WithAsyncTime(t.Time),
+		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(waitJob),
+		objectaction.WithAsyncWatch(t.Watch),
+		objectaction.WithRemoteNodes(nodename),
+		objectaction.WithRemoteFunc(func(ctx context.Context, p naming.Path, nodename string) (any, error) {
+			c, err := client.New()
+			if err != nil {
+				return nil, err
+			}
+			params := api.PostInstanceActionResizeParams{
+				Size: t.Size,
+			}
+			{
+				sid := xsession.Sid().UUID()
+				params.SessionId = &sid
+			}
+			response, err := c.PostInstanceActionResizeWithResponse(ctx, nodename, p.Namespace, p.Kind, p.Name, &params)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case response.JSON200 != nil:
+				return *response.JSON200, nil
+			case response.JSON400 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON400)
+			case response.JSON401 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON401)
+			case response.JSON403 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON403)
+			case response.JSON500 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON500)
+			default:
+				return nil, fmt.Errorf("%s: node %s: unexpected response: %s", p, nodename, response.Status())
+			}
+		}),
+		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (any, error) {
+			o, err := object.NewVol(p)
+			if err != nil {
+				return nil, err
+			}
+			return nil, o.Resize(ctx, size)
+		}),
+	).Do()
+}
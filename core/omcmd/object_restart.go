@@ -52,6 +52,7 @@ func (t *CmdObjectRestart) Run(kind string) error {
 		objectaction.WithAsyncTarget("restarted"),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncTargetOptions(options),
 		objectaction.WithAsyncWatch(t.Watch),
 		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (interface{}, error) {
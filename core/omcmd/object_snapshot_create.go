@@ -0,0 +1,78 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/objectaction"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/util/xsession"
+)
+
+type (
+	CmdObjectSnapshotCreate struct {
+		OptsGlobal
+		commoncmd.OptsAsync
+		Local        bool
+		Name         string
+		NodeSelector string
+	}
+)
+
+func (t *CmdObjectSnapshotCreate) Run(kind string) error {
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	return objectaction.New(
+		objectaction.WithColor(t.Color),
+		objectaction.WithIgnoreNotFound(t.IgnoreNotFound),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithObjectSelector(mergedSelector),
+		objectaction.WithLocal(t.Local),
+		objectaction.WithAsyncTime(t.Time),
+		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
+		objectaction.WithAsyncWatch(t.Watch),
+		objectaction.WithRemoteNodes(t.NodeSelector),
+		objectaction.WithRemoteFunc(func(ctx context.Context, p naming.Path, nodename string) (any, error) {
+			c, err := client.New()
+			if err != nil {
+				return nil, err
+			}
+			params := api.PostInstanceActionSnapshotCreateParams{
+				Name: t.Name,
+			}
+			{
+				sid := xsession.Sid().UUID()
+				params.SessionId = &sid
+			}
+			response, err := c.PostInstanceActionSnapshotCreateWithResponse(ctx, nodename, p.Namespace, p.Kind, p.Name, &params)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case response.JSON200 != nil:
+				return *response.JSON200, nil
+			case response.JSON400 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON400)
+			case response.JSON401 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON401)
+			case response.JSON403 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON403)
+			case response.JSON500 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON500)
+			default:
+				return nil, fmt.Errorf("%s: node %s: unexpected response: %s", p, nodename, response.Status())
+			}
+		}),
+		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (any, error) {
+			o, err := object.NewVol(p)
+			if err != nil {
+				return nil, err
+			}
+			return nil, o.CreateSnapshot(ctx, t.Name)
+		}),
+	).Do()
+}
@@ -0,0 +1,153 @@
+package omcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/nodeselector"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/objectselector"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/util/hostname"
+)
+
+type (
+	CmdObjectSnapshotList struct {
+		OptsGlobal
+		Local        bool
+		NodeSelector string
+	}
+)
+
+func (t *CmdObjectSnapshotList) extract(selector string, c *client.T) (api.SnapshotList, error) {
+	if t.Local {
+		return t.extractLocal(selector)
+	}
+	if data, err := t.extractFromDaemons(selector, c); err == nil {
+		return data, nil
+	}
+	return t.extractLocal(selector)
+}
+
+func (t *CmdObjectSnapshotList) extractLocal(selector string) (api.SnapshotList, error) {
+	data := api.SnapshotList{
+		Kind:  "SnapshotList",
+		Items: api.SnapshotItems{},
+	}
+	sel := objectselector.New(
+		selector,
+		objectselector.WithLocal(true),
+	)
+	paths, err := sel.MustExpand()
+	if err != nil {
+		return data, err
+	}
+	var errs error
+	for _, p := range paths {
+		if p.Kind != naming.KindVol {
+			continue
+		}
+		o, err := object.NewVol(p, object.WithVolatile(true))
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		l, err := o.ListSnapshots(context.Background())
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		for _, e := range l {
+			item := api.SnapshotItem{
+				Kind: "SnapshotItem",
+				Meta: api.InstanceMeta{
+					Node:   hostname.Hostname(),
+					Object: p.String(),
+				},
+				Data: api.Snapshot{
+					CreatedAt: e.CreatedAt,
+					Name:      e.Name,
+					Used:      e.Used,
+				},
+			}
+			data.Items = append(data.Items, item)
+		}
+	}
+	return data, errs
+}
+
+func (t *CmdObjectSnapshotList) extractFromDaemons(selector string, c *client.T) (api.SnapshotList, error) {
+	var (
+		errs error
+		data api.SnapshotList
+	)
+	data.Kind = "SnapshotList"
+	data.Items = api.SnapshotItems{}
+	if t.NodeSelector == "" {
+		t.NodeSelector = hostname.Hostname()
+	}
+	nodenames, err := nodeselector.New(t.NodeSelector, nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return data, err
+	}
+	paths, err := objectselector.New(selector, objectselector.WithClient(c)).MustExpand()
+	if err != nil {
+		return data, err
+	}
+	for _, nodename := range nodenames {
+		for _, path := range paths {
+			if d, err := t.extractFromDaemon(nodename, path, c); err != nil {
+				errs = errors.Join(errs, err)
+			} else {
+				data.Items = append(data.Items, d.Items...)
+			}
+		}
+	}
+	return data, errs
+}
+
+func (t *CmdObjectSnapshotList) extractFromDaemon(nodename string, path naming.Path, c *client.T) (api.SnapshotList, error) {
+	resp, err := c.GetInstanceSnapshotsWithResponse(context.Background(), nodename, path.Namespace, path.Kind, path.Name)
+	if err != nil {
+		return api.SnapshotList{}, err
+	}
+	switch resp.StatusCode() {
+	case 200:
+		return *resp.JSON200, nil
+	case 400:
+		return api.SnapshotList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON400)
+	case 401:
+		return api.SnapshotList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON401)
+	case 403:
+		return api.SnapshotList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON403)
+	case 404:
+		return api.SnapshotList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON404)
+	case 500:
+		return api.SnapshotList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON500)
+	default:
+		return api.SnapshotList{}, fmt.Errorf("%s: unexpected statuscode: %s", nodename, resp.Status())
+	}
+}
+
+func (t *CmdObjectSnapshotList) Run(kind string) error {
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	data, err := t.extract(mergedSelector, c)
+	output.Renderer{
+		DefaultOutput: "tab=OBJECT:meta.object,NODE:meta.node,NAME:data.name,CREATED_AT:data.created_at,USED:data.used",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          data,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return err
+}
@@ -11,6 +11,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
+
+	"github.com/klauspost/compress/zstd"
 )
 
 type (
@@ -22,6 +25,11 @@ type (
 
 		// Gen is the generation of the key that was used to encrypt the message.
 		Gen uint64 `json:"gen"`
+
+		// Codec is the compression codec of the data before encryption. The
+		// zlib codec is assumed when empty, to decode messages from the
+		// nodes not supporting other codecs.
+		Codec string `json:"codec,omitempty"`
 	}
 
 	T struct {
@@ -40,6 +48,10 @@ type (
 
 		// AltVersion indicates the generation number of the next encryption key.
 		AltVersion uint64
+
+		// Codec is the compression codec used by Encrypt. The zlib codec is
+		// used when empty.
+		Codec string
 	}
 
 	Keyer interface {
@@ -62,9 +74,23 @@ type (
 	}
 )
 
+const (
+	CodecZlib = "zlib"
+	CodecZstd = "zstd"
+)
+
 var (
 	// assert T implements EncryptDecrypter interface
 	_ = EncryptDecrypter(&T{})
+
+	// Codecs is the list of compression codecs supported by Decrypt.
+	Codecs = []string{CodecZlib, CodecZstd}
+
+	// zstdMaxMemory is the maximum size of a zstd decompressed message.
+	zstdMaxMemory uint64 = 256 * 1024 * 1024
+
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(zstdMaxMemory), zstd.WithDecoderConcurrency(0))
 )
 
 func New(nodename, clusterName string, sec Keyer) *T {
@@ -78,6 +104,19 @@ func New(nodename, clusterName string, sec Keyer) *T {
 	}
 }
 
+// WithCodec returns a copy of m using the codec compression on Encrypt.
+func (m *T) WithCodec(codec string) *T {
+	c := *m
+	c.Codec = codec
+	return &c
+}
+
+// SupportCodec returns true if codec is one of the compression codecs
+// supported by Decrypt.
+func SupportCodec(codec string) bool {
+	return codec == "" || slices.Contains(Codecs, codec)
+}
+
 func (m *T) assertValid() {
 	if m.ClusterName == "" {
 		panic("NewMessage: unexpected empty cluster name")
@@ -112,7 +151,7 @@ func (m *T) DecryptWithNode(data []byte) ([]byte, string, error) {
 		return nil, "", fmt.Errorf("can't decrypt message with secret version %d", msg.Gen)
 	}
 	// TODO: test nodename and clustername, plug blacklist
-	b, err = decode(msg.Data, msg.IV, key)
+	b, err = decode(msg.Data, msg.IV, key, msg.Codec)
 	if err != nil {
 		return b, "", fmt.Errorf("analyse message decode failure: %w", err)
 	}
@@ -137,7 +176,7 @@ func (m *T) Encrypt(data []byte) ([]byte, error) {
 		err       error
 	)
 	key := []byte(m.Key)
-	if encoded, encodedIV, err = encode(data, key); err != nil {
+	if encoded, encodedIV, err = encode(data, key, m.Codec); err != nil {
 		return nil, err
 	}
 	msg := &encryptedMessage{
@@ -147,10 +186,13 @@ func (m *T) Encrypt(data []byte) ([]byte, error) {
 		Data:        encoded,
 		Gen:         m.Version,
 	}
+	if m.Codec != CodecZlib {
+		msg.Codec = m.Codec
+	}
 	return json.Marshal(msg)
 }
 
-func decode(encoded string, iv string, key []byte) ([]byte, error) {
+func decode(encoded string, iv string, key []byte, codec string) ([]byte, error) {
 	var (
 		decodedIV []byte
 		decoded   []byte
@@ -168,16 +210,16 @@ func decode(encoded string, iv string, key []byte) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	return decompress(decoded)
+	return decompress(decoded, codec)
 }
 
-func encode(data []byte, key []byte) (string, string, error) {
+func encode(data []byte, key []byte, codec string) (string, string, error) {
 	var (
 		b   []byte
 		iv  []byte
 		err error
 	)
-	b, err = compress(data)
+	b, err = compress(data, codec)
 	if err != nil {
 		return "", "", err
 	}
@@ -252,7 +294,14 @@ func newIV() []byte {
 	return b
 }
 
-func compress(b []byte) ([]byte, error) {
+func compress(b []byte, codec string) ([]byte, error) {
+	switch codec {
+	case CodecZlib, "":
+	case CodecZstd:
+		return zstdEncoder.EncodeAll(b, nil), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %s", codec)
+	}
 	var bb bytes.Buffer
 	w := zlib.NewWriter(&bb)
 	if _, err := w.Write(b); err != nil {
@@ -267,7 +316,14 @@ func compress(b []byte) ([]byte, error) {
 	return bb.Bytes(), nil
 }
 
-func decompress(b []byte) ([]byte, error) {
+func decompress(b []byte, codec string) ([]byte, error) {
+	switch codec {
+	case CodecZlib, "":
+	case CodecZstd:
+		return zstdDecoder.DecodeAll(b, nil)
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %s", codec)
+	}
 	bb := bytes.NewReader(b)
 	r, err := zlib.NewReader(bb)
 	if err != nil {
@@ -0,0 +1,50 @@
+package omcrypto
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCrypto() *T {
+	return &T{
+		NodeName:    "node1",
+		ClusterName: "cluster1",
+		Key:         "0123456789abcdef0123456789abcdef",
+		Version:     1,
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	data := bytes.Repeat([]byte(`{"kind":"full","events":[]}`), 1000)
+	for _, codec := range []string{"", CodecZlib, CodecZstd} {
+		t.Run("codec "+codec, func(t *testing.T) {
+			m := newTestCrypto().WithCodec(codec)
+			b, err := m.Encrypt(data)
+			require.NoError(t, err)
+			require.Less(t, len(b), len(data), "expected a compressed message")
+
+			var msg encryptedMessage
+			require.NoError(t, json.Unmarshal(b, &msg))
+			if codec == CodecZstd {
+				require.Equal(t, CodecZstd, msg.Codec)
+			} else {
+				require.Empty(t, msg.Codec, "expected no codec for the zlib messages, for compatibility")
+			}
+
+			decoded, nodename, err := newTestCrypto().DecryptWithNode(b)
+			require.NoError(t, err)
+			require.Equal(t, "node1", nodename)
+			require.Equal(t, data, decoded)
+		})
+	}
+}
+
+func TestEncryptUnsupportedCodec(t *testing.T) {
+	_, err := newTestCrypto().WithCodec("lz4").Encrypt([]byte("data"))
+	require.Error(t, err)
+	require.False(t, SupportCodec("lz4"))
+	require.True(t, SupportCodec(CodecZstd))
+}
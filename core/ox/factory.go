@@ -151,6 +151,7 @@ func newCmdDaemonEvents() *cobra.Command {
 	commoncmd.FlagColor(flags, &options.Color)
 	commoncmd.FlagDuration(flags, &options.Duration)
 	commoncmd.FlagEventReplay(flags, &options.Replay)
+	commoncmd.FlagEventSince(flags, &options.Since)
 	commoncmd.FlagEventFilters(flags, &options.Filters)
 	commoncmd.FlagEventOutput(flags, &options.Output)
 	commoncmd.FlagEventTemplate(flags, &options.Template)
@@ -477,6 +478,23 @@ This command scans SCSI hosts for new block devices. You can specify specific HB
 	return cmd
 }
 
+func newCmdNodeCapacity() *cobra.Command {
+	var options commands.CmdNodeCapacity
+	cmd := &cobra.Command{
+		GroupID: commoncmd.GroupIDQuery,
+		Use:     "capacity",
+		Short:   "show the nodes cpu and memory capacity",
+		Long:    "Show the cpu and memory provided by each node, the resources requested by the instances running or starting there, and the free resources used by the placement admission control.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	return cmd
+}
+
 func newCmdNodeChecks() *cobra.Command {
 	var options commands.CmdNodeChecks
 	cmd := &cobra.Command{
@@ -1221,6 +1239,43 @@ func newCmdNodeFreeze() *cobra.Command {
 	return cmd
 }
 
+func newCmdNodeMaintenanceEnter() *cobra.Command {
+	var options commands.CmdNodeMaintenanceEnter
+	cmd := &cobra.Command{
+		Use:   "enter",
+		Short: "drain the node and keep it in maintenance",
+		Long:  "Entering again updates the reason and expiry of the current maintenance.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	flags.StringVar(&options.Reason, "reason", "", "the reason of the maintenance, displayed in the cluster status")
+	flags.StringVar(&options.Expire, "expire", "", "the maintenance duration, after which the maintenance is automatically left (ex: 2h)")
+	if err := cmd.MarkFlagRequired("reason"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func newCmdNodeMaintenanceLeave() *cobra.Command {
+	var options commands.CmdNodeMaintenanceLeave
+	cmd := &cobra.Command{
+		Use:   "leave",
+		Short: "unfreeze the node and give back its objects",
+		Long:  "The node stays frozen if it was frozen before entering the maintenance.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	return cmd
+}
+
 func newCmdNodeLogs() *cobra.Command {
 	var options commands.CmdNodeLogs
 	cmd := &cobra.Command{
@@ -1434,7 +1489,7 @@ func newCmdObjectAbort(kind string) *cobra.Command {
 		},
 	}
 	flags := cmd.Flags()
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	addFlagsGlobal(flags, &options.OptsGlobal)
 	return cmd
 }
@@ -1977,7 +2032,7 @@ func newCmdObjectCreate(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagCreateConfig(flags, &options.Config)
 	commoncmd.FlagCreateEnv(flags, &options.Env)
@@ -2007,7 +2062,7 @@ func newCmdObjectDelete(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.HiddenFlagsLock(flags, &options.OptsLock)
 	commoncmd.HiddenFlagNodeSelector(flags, &options.NodeSelector)
 	return cmd
@@ -2026,7 +2081,7 @@ func newCmdObjectDeploy(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagCreateConfig(flags, &options.Config)
 	commoncmd.FlagCreateEnv(flags, &options.Env)
@@ -2100,7 +2155,7 @@ func newCmdObjectFreeze(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	return cmd
 }
 
@@ -2117,7 +2172,7 @@ func newCmdObjectGiveback(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	return cmd
 }
 
@@ -2237,7 +2292,7 @@ func newCmdObjectInstanceFreeze(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
 	return cmd
@@ -2272,7 +2327,7 @@ func newCmdObjectInstanceProvision(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2296,7 +2351,7 @@ func newCmdObjectInstancePRStart(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
 	commoncmd.FlagsTo(flags, &options.OptTo)
@@ -2320,7 +2375,7 @@ func newCmdObjectInstancePRStop(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2344,7 +2399,7 @@ func newCmdObjectInstanceRestart(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2369,7 +2424,7 @@ func newCmdObjectInstanceStart(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2394,7 +2449,7 @@ func newCmdObjectInstanceStop(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2418,7 +2473,7 @@ func newCmdObjectInstanceUnfreeze(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.HiddenFlagNodeSelector(flags, &options.NodeSelector)
 	return cmd
@@ -2437,7 +2492,7 @@ func newCmdObjectInstanceUnprovision(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2461,7 +2516,7 @@ func newCmdObjectInstancePGUpdate(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2513,7 +2568,7 @@ func newCmdObjectProvision(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	return cmd
 }
 
@@ -2573,7 +2628,7 @@ func newCmdObjectPurge(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	return cmd
 }
 
@@ -2606,7 +2661,7 @@ func newCmdObjectRestart(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	return cmd
 }
 
@@ -2622,7 +2677,7 @@ func newCmdObjectInstanceSyncIngest(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
 	return cmd
@@ -2640,7 +2695,7 @@ func newCmdObjectInstanceSyncFull(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
 	commoncmd.FlagForce(flags, &options.Force)
@@ -2660,7 +2715,7 @@ func newCmdObjectInstanceSyncResync(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
 	commoncmd.FlagForce(flags, &options.Force)
@@ -2679,7 +2734,7 @@ func newCmdObjectInstanceSyncSplit(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
 	commoncmd.FlagForce(flags, &options.Force)
@@ -2698,7 +2753,7 @@ func newCmdObjectInstanceSyncUpdate(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
 	commoncmd.FlagForce(flags, &options.Force)
@@ -2733,7 +2788,7 @@ func newCmdObjectInstanceResourceInfoPush(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
 	commoncmd.FlagIgnoreNoCollectorConfigured(flags, &options.IgnoreNoCollectorConfigured)
 	return cmd
@@ -2768,7 +2823,7 @@ func newCmdObjectInstanceRun(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2833,7 +2888,7 @@ func newCmdObjectInstanceShutdown(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2855,7 +2910,7 @@ func newCmdObjectStart(kind string) *cobra.Command {
 		},
 	}
 	flags := cmd.Flags()
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagColor(flags, &options.OptsGlobal.Color)
 	commoncmd.FlagOutput(flags, &options.OptsGlobal.Output)
 	commoncmd.FlagObjectSelector(flags, &options.OptsGlobal.ObjectSelector)
@@ -2874,7 +2929,7 @@ func newCmdObjectInstanceStartStandby(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagsEncap(flags, &options.OptsEncap)
 	commoncmd.FlagsLock(flags, &options.OptsLock)
 	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
@@ -2902,7 +2957,7 @@ func newCmdObjectStop(kind string) *cobra.Command {
 	commoncmd.FlagOutput(flags, &options.OptsGlobal.Output)
 	commoncmd.FlagObjectSelector(flags, &options.OptsGlobal.ObjectSelector)
 	commoncmd.FlagIgnoreNotFound(flags, &options.IgnoreNotFound)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	return cmd
 }
 
@@ -2919,7 +2974,7 @@ func newCmdObjectSwitch(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagSwitchTo(flags, &options.To)
 	commoncmd.FlagLive(flags, &options.Live)
 	return cmd
@@ -2937,7 +2992,7 @@ func newCmdObjectUnfreeze(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	return cmd
 }
 
@@ -2954,7 +3009,7 @@ func newCmdObjectTakeover(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	commoncmd.FlagLive(flags, &options.Live)
 	return cmd
 }
@@ -2980,7 +3035,7 @@ func newCmdObjectUnprovision(kind string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
-	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagsAsyncJob(flags, &options.OptsAsync)
 	return cmd
 }
 
@@ -0,0 +1,21 @@
+package ox
+
+import (
+	"github.com/opensvc/om3/v3/core/commoncmd"
+)
+
+var (
+	cmdJob = commoncmd.NewCmdJob()
+)
+
+func init() {
+	root.AddCommand(
+		cmdJob,
+	)
+	cmdJob.AddCommand(
+		commoncmd.NewCmdJobCancel(),
+		commoncmd.NewCmdJobList(),
+		commoncmd.NewCmdJobShow(),
+		commoncmd.NewCmdJobWait(),
+	)
+}
@@ -15,6 +15,7 @@ func init() {
 	cmdObjectPrint := newCmdObjectPrint(kind)
 	cmdObjectPrintConfig := newCmdObjectPrintConfig(kind)
 	cmdObjectValidate := newCmdObjectValidate(kind)
+	cmdObjectToken := commoncmd.NewCmdUsrToken()
 
 	root.AddCommand(
 		cmdObject,
@@ -32,6 +33,7 @@ func init() {
 		cmdObjectInstance,
 		cmdObjectPrint,
 		cmdObjectSet,
+		cmdObjectToken,
 		cmdObjectValidate,
 		newCmdDataStoreAdd(kind),
 		newCmdDataStoreChange(kind),
@@ -86,6 +88,11 @@ func init() {
 	cmdObjectPrint.AddCommand(
 		cmdObjectPrintConfig,
 	)
+	cmdObjectToken.AddCommand(
+		commoncmd.NewCmdUsrTokenAdd(kind),
+		commoncmd.NewCmdUsrTokenList(kind),
+		commoncmd.NewCmdUsrTokenRevoke(kind),
+	)
 	cmdObjectValidate.AddCommand(
 		newCmdObjectValidateConfig(kind),
 	)
@@ -57,6 +57,12 @@ var (
 		Use:     "config",
 		Short:   "node configuration commands",
 	}
+	cmdNodeMaintenance = &cobra.Command{
+		GroupID: commoncmd.GroupIDSubsystems,
+		Use:     "maintenance",
+		Short:   "node maintenance mode commands",
+		Long:    "A node in maintenance is drained, and its objects are given back when the maintenance is left or expires.",
+	}
 
 	// backward compat
 
@@ -184,6 +190,7 @@ func init() {
 		cmdNodeSystem,
 		cmdNodeConfig,
 		cmdNodeEdit,
+		cmdNodeMaintenance,
 		cmdNodePrint,
 		cmdNodePush,
 		cmdNodeRelay,
@@ -191,6 +198,7 @@ func init() {
 		cmdNodeSSH,
 		cmdNodeValidate,
 		newCmdNodeAbort(),
+		newCmdNodeCapacity(),
 		newCmdNodeChecks(),
 		newCmdNodeClear(),
 		newCmdNodeDequeue(),
@@ -210,6 +218,10 @@ func init() {
 		newCmdNodeUpdate(),
 		newCmdNodeUnset(),
 	)
+	cmdNodeMaintenance.AddCommand(
+		newCmdNodeMaintenanceEnter(),
+		newCmdNodeMaintenanceLeave(),
+	)
 	cmdNodePrint.AddCommand(
 		newCmdNodePrintConfig(),
 		newCmdNodePrintSchedule(),
@@ -0,0 +1,59 @@
+package oxcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/nodeselector"
+)
+
+type CmdNodeMaintenanceLeave struct {
+	OptsGlobal
+	NodeSelector string
+}
+
+func (t *CmdNodeMaintenanceLeave) Run() error {
+	if t.NodeSelector == "" {
+		return fmt.Errorf("--node must be specified")
+	}
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	nodenames, err := nodeselector.New(t.NodeSelector, nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return err
+	}
+	var errs error
+	for _, nodename := range nodenames {
+		errs = errors.Join(errs, t.leave(c, nodename))
+	}
+	return errs
+}
+
+func (t *CmdNodeMaintenanceLeave) leave(c *client.T, nodename string) error {
+	resp, err := c.DeleteNodeMaintenanceWithResponse(context.Background(), nodename)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusNoContent:
+		fmt.Printf("%s: maintenance left\n", nodename)
+		return nil
+	case 401:
+		return fmt.Errorf("%s: %s", nodename, *resp.JSON401)
+	case 403:
+		return fmt.Errorf("%s: %s", nodename, *resp.JSON403)
+	case 408:
+		return fmt.Errorf("%s: %s", nodename, *resp.JSON408)
+	case 409:
+		return fmt.Errorf("%s: %s", nodename, *resp.JSON409)
+	case 500:
+		return fmt.Errorf("%s: %s", nodename, *resp.JSON500)
+	default:
+		return fmt.Errorf("%s: unexpected status [%d]", nodename, resp.StatusCode())
+	}
+}
@@ -22,6 +22,7 @@ func (t *CmdObjectAbort) Run(kind string) error {
 		objectaction.WithAsyncTarget("aborted"),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 	).Do()
 }
@@ -22,6 +22,7 @@ func (t *CmdObjectFreeze) Run(kind string) error {
 		objectaction.WithAsyncTarget("frozen"),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 	).Do()
 }
@@ -22,6 +22,7 @@ func (t *CmdObjectProvision) Run(kind string) error {
 		objectaction.WithAsyncTarget("provisioned"),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 	).Do()
 }
@@ -22,6 +22,7 @@ func (t *CmdObjectStart) Run(kind string) error {
 		objectaction.WithAsyncTarget("started"),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 	).Do()
 }
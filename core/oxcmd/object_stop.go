@@ -22,6 +22,7 @@ func (t *CmdObjectStop) Run(kind string) error {
 		objectaction.WithAsyncTarget("stopped"),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 	).Do()
 }
@@ -37,6 +37,7 @@ func (t *CmdObjectSwitch) Run(kind string) error {
 		objectaction.WithAsyncTargetOptions(options),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 	).Do()
 }
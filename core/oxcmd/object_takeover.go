@@ -31,6 +31,7 @@ func (t *CmdObjectTakeover) Run(kind string) error {
 		objectaction.WithAsyncTargetOptions(options),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 	).Do()
 }
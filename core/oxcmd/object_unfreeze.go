@@ -23,6 +23,7 @@ func (t *CmdObjectUnfreeze) Run(kind string) error {
 		objectaction.WithAsyncTarget("unfrozen"),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 		objectaction.WithRemoteNodes(t.NodeSelector),
 	).Do()
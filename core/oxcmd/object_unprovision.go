@@ -22,6 +22,7 @@ func (t *CmdObjectUnprovision) Run(kind string) error {
 		objectaction.WithAsyncTarget("unprovisioned"),
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWaitJob(t.WaitJob),
 		objectaction.WithAsyncWatch(t.Watch),
 	).Do()
 }
@@ -0,0 +1,110 @@
+package placement
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+type (
+	// Affinity describes the placement constraints of an object relative to
+	// other objects.
+	//
+	// The hard constraints exclude candidates, the soft constraints only
+	// lower the candidates priority.
+	Affinity struct {
+		// Hard is the list of object paths that must run on a candidate.
+		Hard []string `json:"hard,omitempty"`
+
+		// HardAnti is the list of object paths that must not run on a candidate.
+		HardAnti []string `json:"hard_anti,omitempty"`
+
+		// Soft is the list of object paths that should run on a candidate.
+		Soft []string `json:"soft,omitempty"`
+
+		// SoftAnti is the list of object paths that should not run on a candidate.
+		SoftAnti []string `json:"soft_anti,omitempty"`
+	}
+
+	// Running is the list of nodes where an object is running, indexed by
+	// object path.
+	Running map[string][]string
+)
+
+// IsZero returns true if the affinity has no constraint.
+func (t Affinity) IsZero() bool {
+	return len(t.Hard)+len(t.HardAnti)+len(t.Soft)+len(t.SoftAnti) == 0
+}
+
+// Paths returns the sorted list of the object paths referenced by the
+// constraints.
+func (t Affinity) Paths() []string {
+	var l []string
+	for _, s := range [][]string{t.Hard, t.HardAnti, t.Soft, t.SoftAnti} {
+		for _, p := range s {
+			if !slices.Contains(l, p) {
+				l = append(l, p)
+			}
+		}
+	}
+	sort.Strings(l)
+	return l
+}
+
+// Apply returns the candidates not excluded by the hard constraints, sorted
+// by ascending soft constraints violation count. The order of the candidates
+// with the same violation count is preserved.
+//
+// The returned reasons map explains, for each excluded or penalized
+// candidate, the constraints it violates.
+func (t Affinity) Apply(candidates []string, running Running) ([]string, map[string]string) {
+	reasons := make(map[string]string)
+	penalties := make(map[string]int)
+	l := make([]string, 0, len(candidates))
+	for _, node := range candidates {
+		var hard, soft []string
+		for _, p := range t.Hard {
+			if !slices.Contains(running[p], node) {
+				hard = append(hard, fmt.Sprintf("hard_affinity %s not running", p))
+			}
+		}
+		for _, p := range t.HardAnti {
+			if slices.Contains(running[p], node) {
+				hard = append(hard, fmt.Sprintf("hard_anti_affinity %s running", p))
+			}
+		}
+		for _, p := range t.Soft {
+			if !slices.Contains(running[p], node) {
+				soft = append(soft, fmt.Sprintf("soft_affinity %s not running", p))
+			}
+		}
+		for _, p := range t.SoftAnti {
+			if slices.Contains(running[p], node) {
+				soft = append(soft, fmt.Sprintf("soft_anti_affinity %s running", p))
+			}
+		}
+		if len(hard) > 0 {
+			reasons[node] = strings.Join(append(hard, soft...), ", ")
+			continue
+		}
+		if len(soft) > 0 {
+			reasons[node] = strings.Join(soft, ", ")
+			penalties[node] = len(soft)
+		}
+		l = append(l, node)
+	}
+	sort.SliceStable(l, func(i, j int) bool {
+		return penalties[l[i]] < penalties[l[j]]
+	})
+	return l, reasons
+}
+
+func (t Affinity) DeepCopy() Affinity {
+	return Affinity{
+		Hard:     slices.Clone(t.Hard),
+		HardAnti: slices.Clone(t.HardAnti),
+		Soft:     slices.Clone(t.Soft),
+		SoftAnti: slices.Clone(t.SoftAnti),
+	}
+}
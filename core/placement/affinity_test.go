@@ -0,0 +1,55 @@
+package placement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAffinityApply(t *testing.T) {
+	candidates := []string{"n1", "n2", "n3"}
+	running := Running{
+		"test/svc/db":    {"n2", "n3"},
+		"test/svc/batch": {"n2"},
+		"test/svc/web":   {"n1"},
+	}
+
+	t.Run("hard constraints exclude candidates", func(t *testing.T) {
+		affinity := Affinity{
+			Hard:     []string{"test/svc/db"},
+			HardAnti: []string{"test/svc/batch"},
+		}
+		l, reasons := affinity.Apply(candidates, running)
+		require.Equal(t, []string{"n3"}, l)
+		require.Equal(t, "hard_affinity test/svc/db not running", reasons["n1"])
+		require.Equal(t, "hard_anti_affinity test/svc/batch running", reasons["n2"])
+		require.NotContains(t, reasons, "n3")
+	})
+
+	t.Run("soft constraints penalize candidates", func(t *testing.T) {
+		affinity := Affinity{
+			Soft:     []string{"test/svc/db"},
+			SoftAnti: []string{"test/svc/batch"},
+		}
+		l, reasons := affinity.Apply(candidates, running)
+		require.Equal(t, []string{"n3", "n1", "n2"}, l)
+		require.Equal(t, "soft_affinity test/svc/db not running", reasons["n1"])
+		require.Equal(t, "soft_anti_affinity test/svc/batch running", reasons["n2"])
+	})
+
+	t.Run("soft constraints can't exclude the only candidate", func(t *testing.T) {
+		affinity := Affinity{SoftAnti: []string{"test/svc/web"}}
+		l, _ := affinity.Apply([]string{"n1"}, running)
+		require.Equal(t, []string{"n1"}, l)
+	})
+
+	t.Run("paths", func(t *testing.T) {
+		affinity := Affinity{
+			Hard:     []string{"test/svc/db"},
+			SoftAnti: []string{"test/svc/batch", "test/svc/db"},
+		}
+		require.Equal(t, []string{"test/svc/batch", "test/svc/db"}, affinity.Paths())
+		require.False(t, affinity.IsZero())
+		require.True(t, Affinity{}.IsZero())
+	})
+}
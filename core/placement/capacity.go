@@ -0,0 +1,131 @@
+package placement
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+type (
+	// Resources is an amount of node resources, either requested by an
+	// instance or provided by a node.
+	Resources struct {
+		// CPUs is the number of cpu threads. Fractional values are allowed
+		// for requests.
+		CPUs float64 `json:"cpus"`
+
+		// Mem is the memory size in bytes.
+		Mem int64 `json:"mem"`
+	}
+
+	// Capacity is the resources accounting of a node.
+	Capacity struct {
+		// Total is the resources provided by the node. A zero value means
+		// the node did not report this resource, and it is not accounted.
+		Total Resources `json:"total"`
+
+		// Requested is the sum of the resources requested by the instances
+		// accounted on the node.
+		Requested Resources `json:"requested"`
+
+		// Instances is the list of the object paths of the accounted
+		// instances.
+		Instances []string `json:"instances"`
+	}
+)
+
+var (
+	// ErrInsufficientCapacity is returned by Capacity.Admit when the node
+	// free resources can't satisfy a request.
+	ErrInsufficientCapacity = errors.New("insufficient capacity")
+)
+
+// IsZero returns true if no resource is requested or provided.
+func (t Resources) IsZero() bool {
+	return t.CPUs == 0 && t.Mem == 0
+}
+
+// Add returns the sum of t and other.
+func (t Resources) Add(other Resources) Resources {
+	return Resources{
+		CPUs: t.CPUs + other.CPUs,
+		Mem:  t.Mem + other.Mem,
+	}
+}
+
+// String returns a human readable representation of the resources, like
+// "cpus=1.5 mem=512mi".
+func (t Resources) String() string {
+	return fmt.Sprintf("cpus=%s mem=%s",
+		strconv.FormatFloat(t.CPUs, 'f', -1, 64),
+		sizeconv.BSizeCompact(float64(t.Mem)))
+}
+
+// Account adds the resources requested by the instance of the object p to the
+// node capacity.
+func (t *Capacity) Account(p string, requested Resources) {
+	t.Requested = t.Requested.Add(requested)
+	t.Instances = append(t.Instances, p)
+}
+
+// Free returns the resources not yet requested. The values are negative when
+// the node is overcommitted.
+func (t Capacity) Free() Resources {
+	return Resources{
+		CPUs: t.Total.CPUs - t.Requested.CPUs,
+		Mem:  t.Total.Mem - t.Requested.Mem,
+	}
+}
+
+// Admit returns an error wrapping ErrInsufficientCapacity if the node free
+// resources can't satisfy the requested resources. The resources not reported
+// by the node are not verified.
+func (t Capacity) Admit(requested Resources) error {
+	var l []string
+	free := t.Free()
+	if t.Total.CPUs > 0 && requested.CPUs > free.CPUs {
+		l = append(l, fmt.Sprintf("cpus requested %s free %s",
+			strconv.FormatFloat(requested.CPUs, 'f', -1, 64),
+			strconv.FormatFloat(max(free.CPUs, 0), 'f', -1, 64)))
+	}
+	if t.Total.Mem > 0 && requested.Mem > free.Mem {
+		l = append(l, fmt.Sprintf("mem requested %s free %s",
+			sizeconv.BSizeCompact(float64(requested.Mem)),
+			sizeconv.BSizeCompact(float64(max(free.Mem, 0)))))
+	}
+	if len(l) > 0 {
+		return fmt.Errorf("%w: %s", ErrInsufficientCapacity, strings.Join(l, ", "))
+	}
+	return nil
+}
+
+// AdmitCandidates returns the candidates with enough free resources to
+// satisfy the requested resources, preserving their order. The returned
+// reasons map explains why each rejected candidate was rejected.
+//
+// The candidates with no capacity information are admitted.
+func AdmitCandidates(candidates []string, capacities map[string]Capacity, requested Resources) ([]string, map[string]string) {
+	reasons := make(map[string]string)
+	l := make([]string, 0, len(candidates))
+	for _, node := range candidates {
+		if capacity, ok := capacities[node]; ok {
+			if err := capacity.Admit(requested); err != nil {
+				reasons[node] = err.Error()
+				continue
+			}
+		}
+		l = append(l, node)
+	}
+	return l, reasons
+}
+
+func (t Capacity) DeepCopy() Capacity {
+	return Capacity{
+		Total:     t.Total,
+		Requested: t.Requested,
+		Instances: append([]string{}, t.Instances...),
+	}
+}
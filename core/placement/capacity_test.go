@@ -0,0 +1,41 @@
+package placement
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapacityAdmit(t *testing.T) {
+	capacity := Capacity{
+		Total: Resources{CPUs: 4, Mem: 8 * 1024 * 1024 * 1024},
+	}
+	capacity.Account("test/svc/s1", Resources{CPUs: 2.5, Mem: 6 * 1024 * 1024 * 1024})
+	require.Equal(t, []string{"test/svc/s1"}, capacity.Instances)
+	require.Equal(t, Resources{CPUs: 1.5, Mem: 2 * 1024 * 1024 * 1024}, capacity.Free())
+
+	require.NoError(t, capacity.Admit(Resources{CPUs: 1.5}))
+	require.NoError(t, capacity.Admit(Resources{Mem: 1024 * 1024 * 1024}))
+
+	err := capacity.Admit(Resources{CPUs: 2, Mem: 4 * 1024 * 1024 * 1024})
+	require.True(t, errors.Is(err, ErrInsufficientCapacity))
+	require.ErrorContains(t, err, "cpus requested 2 free 1.5")
+	require.ErrorContains(t, err, "mem requested 4gi free 2gi")
+
+	t.Run("unreported resources are not verified", func(t *testing.T) {
+		capacity := Capacity{Total: Resources{CPUs: 1}}
+		require.NoError(t, capacity.Admit(Resources{Mem: 1024}))
+	})
+}
+
+func TestAdmitCandidates(t *testing.T) {
+	capacities := map[string]Capacity{
+		"n1": {Total: Resources{CPUs: 2}, Requested: Resources{CPUs: 2}},
+		"n2": {Total: Resources{CPUs: 8}, Requested: Resources{CPUs: 2}},
+	}
+	l, reasons := AdmitCandidates([]string{"n3", "n1", "n2"}, capacities, Resources{CPUs: 1})
+	require.Equal(t, []string{"n3", "n2"}, l)
+	require.Contains(t, reasons["n1"], "insufficient capacity: cpus requested 1 free 0")
+	require.NotContains(t, reasons, "n2")
+}
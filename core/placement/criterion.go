@@ -0,0 +1,211 @@
+package placement
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Criterion evaluates how much a candidate node is preferred to host an
+	// instance. The returned value is expected in the [0, 1] range, where 1
+	// is the most preferred.
+	Criterion interface {
+		Score(node string, env *Env) float64
+	}
+
+	// CriterionFunc is an adapter to allow the use of ordinary functions as
+	// a Criterion.
+	CriterionFunc func(node string, env *Env) float64
+
+	// CriterionFactory returns a new Criterion from the optional argument
+	// of a weight definition, for example "rack" in "label:rack=2".
+	CriterionFactory func(arg string) (Criterion, error)
+
+	// Env is the dataset criteria can use to score candidates.
+	Env struct {
+		// Candidates is the list of nodes to score.
+		Candidates []string
+
+		// Nodes holds the per-node information, indexed by nodename.
+		Nodes map[string]NodeInfo
+	}
+
+	// NodeInfo is the per-node information used by the criteria.
+	NodeInfo struct {
+		// Instances is the number of up instances hosted by the node, all
+		// objects included.
+		Instances int
+
+		// Labels are the node labels.
+		Labels map[string]string
+
+		// LastStartedAt is the last start time of the scored object instance
+		// on the node.
+		LastStartedAt time.Time
+
+		Load15M     float64
+		MemAvailPct int
+		MemTotalMB  uint64
+	}
+
+	criterionRegistry struct {
+		sync.RWMutex
+		m map[string]CriterionFactory
+	}
+)
+
+var (
+	criteria = criterionRegistry{
+		m: make(map[string]CriterionFactory),
+	}
+)
+
+// Score implements the Criterion interface.
+func (f CriterionFunc) Score(node string, env *Env) float64 {
+	return f(node, env)
+}
+
+// RegisterCriterion makes a criterion available to the weighted placement
+// policy under the name <name>. Drivers and plugins can use this function from
+// their init() to add their own criteria. Registering an already registered
+// name replaces the previous factory.
+func RegisterCriterion(name string, factory CriterionFactory) {
+	criteria.Lock()
+	defer criteria.Unlock()
+	criteria.m[name] = factory
+}
+
+// NewCriterion returns the Criterion registered under <name>, configured with
+// <arg>.
+func NewCriterion(name, arg string) (Criterion, error) {
+	criteria.RLock()
+	factory, ok := criteria.m[name]
+	criteria.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown placement criterion '%s'", name)
+	}
+	return factory(arg)
+}
+
+// CriterionNames returns the sorted list of registered criterion names.
+func CriterionNames() []string {
+	criteria.RLock()
+	defer criteria.RUnlock()
+	l := make([]string, 0, len(criteria.m))
+	for name := range criteria.m {
+		l = append(l, name)
+	}
+	sort.Strings(l)
+	return l
+}
+
+// newFreeMemCriterion scores candidates on their available memory, relative
+// to the best candidate.
+func newFreeMemCriterion(arg string) (Criterion, error) {
+	if arg != "" {
+		return nil, fmt.Errorf("the mem criterion does not accept an argument")
+	}
+	freeMB := func(info NodeInfo) float64 {
+		return float64(info.MemTotalMB) * float64(info.MemAvailPct) / 100
+	}
+	return CriterionFunc(func(node string, env *Env) float64 {
+		var best float64
+		for _, candidate := range env.Candidates {
+			if v := freeMB(env.Nodes[candidate]); v > best {
+				best = v
+			}
+		}
+		if best == 0 {
+			return 0
+		}
+		return freeMB(env.Nodes[node]) / best
+	}), nil
+}
+
+// newLoadCriterion scores candidates on their 15 minutes load average, the
+// least loaded candidate scoring 1.
+func newLoadCriterion(arg string) (Criterion, error) {
+	if arg != "" {
+		return nil, fmt.Errorf("the load criterion does not accept an argument")
+	}
+	return CriterionFunc(func(node string, env *Env) float64 {
+		least := -1.0
+		for _, candidate := range env.Candidates {
+			if v := env.Nodes[candidate].Load15M; least < 0 || v < least {
+				least = v
+			}
+		}
+		return (1 + least) / (1 + env.Nodes[node].Load15M)
+	}), nil
+}
+
+// newLabelCriterion scores 1 the candidates with a label matching <arg>, and
+// 0 the others.
+//
+// The <arg> format is either <key> to match nodes having the label key set,
+// or <key>:<value> to match nodes having the label key set to value.
+func newLabelCriterion(arg string) (Criterion, error) {
+	if arg == "" {
+		return nil, fmt.Errorf("the label criterion requires a <key>[:<value>] argument")
+	}
+	k, v, hasValue := strings.Cut(arg, ":")
+	return CriterionFunc(func(node string, env *Env) float64 {
+		value, ok := env.Nodes[node].Labels[k]
+		switch {
+		case !ok:
+			return 0
+		case hasValue && value != v:
+			return 0
+		default:
+			return 1
+		}
+	}), nil
+}
+
+// newInstancesCriterion scores candidates on the number of instances they
+// already host, the candidate hosting the fewest instances scoring 1.
+func newInstancesCriterion(arg string) (Criterion, error) {
+	if arg != "" {
+		return nil, fmt.Errorf("the instances criterion does not accept an argument")
+	}
+	return CriterionFunc(func(node string, env *Env) float64 {
+		least := -1
+		for _, candidate := range env.Candidates {
+			if v := env.Nodes[candidate].Instances; least < 0 || v < least {
+				least = v
+			}
+		}
+		return float64(1+least) / float64(1+env.Nodes[node].Instances)
+	}), nil
+}
+
+// newLastStartCriterion scores 1 the candidate where the object instance was
+// started last, and 0 the others.
+func newLastStartCriterion(arg string) (Criterion, error) {
+	if arg != "" {
+		return nil, fmt.Errorf("the last_start criterion does not accept an argument")
+	}
+	return CriterionFunc(func(node string, env *Env) float64 {
+		var last time.Time
+		for _, candidate := range env.Candidates {
+			if v := env.Nodes[candidate].LastStartedAt; v.After(last) {
+				last = v
+			}
+		}
+		if last.IsZero() || !env.Nodes[node].LastStartedAt.Equal(last) {
+			return 0
+		}
+		return 1
+	}), nil
+}
+
+func init() {
+	RegisterCriterion("instances", newInstancesCriterion)
+	RegisterCriterion("label", newLabelCriterion)
+	RegisterCriterion("last_start", newLastStartCriterion)
+	RegisterCriterion("load", newLoadCriterion)
+	RegisterCriterion("mem", newFreeMemCriterion)
+}
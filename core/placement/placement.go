@@ -32,6 +32,8 @@ const (
 	Spread
 	// Score is the policy where node priorities are assigned to nodes based on score. The higher the score, the higher the priority.
 	Score
+	// Weighted is the policy where node priorities are assigned to nodes based on a weighted combination of criteria. The higher the weighted score, the higher the priority.
+	Weighted
 )
 
 const (
@@ -51,6 +53,7 @@ var (
 		Shift:      "shift",
 		Spread:     "spread",
 		Score:      "score",
+		Weighted:   "weighted",
 	}
 
 	policyToID = map[string]Policy{
@@ -62,6 +65,7 @@ var (
 		"shift":       Shift,
 		"spread":      Spread,
 		"score":       Score,
+		"weighted":    Weighted,
 	}
 
 	stateToString = map[State]string{
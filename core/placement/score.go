@@ -0,0 +1,98 @@
+package placement
+
+import (
+	"maps"
+	"math"
+	"sort"
+)
+
+type (
+	// NodeScore is the weighted placement score of a candidate node, with
+	// the breakdown of the criteria scores it is computed from.
+	NodeScore struct {
+		// Total is the weighted average of the criteria scores, in the
+		// [0, 1] range.
+		Total float64 `json:"total"`
+
+		// Criteria is the unweighted score of each criterion, indexed by the
+		// weight key.
+		Criteria map[string]float64 `json:"criteria,omitempty"`
+	}
+
+	// Scores is the placement scores of the candidate nodes, indexed by
+	// nodename.
+	Scores map[string]NodeScore
+)
+
+// Score returns the weighted scores of the env candidates. Weights referencing
+// unregistered criteria are ignored.
+func (t Weights) Score(env *Env) Scores {
+	type weighted struct {
+		Weight
+		criterion Criterion
+	}
+	var sum float64
+	l := make([]weighted, 0, len(t))
+	for _, w := range t {
+		criterion, err := w.Criterion()
+		if err != nil {
+			continue
+		}
+		l = append(l, weighted{Weight: w, criterion: criterion})
+		sum += w.Value
+	}
+	scores := make(Scores)
+	for _, node := range env.Candidates {
+		nodeScore := NodeScore{
+			Criteria: make(map[string]float64),
+		}
+		for _, w := range l {
+			v := w.criterion.Score(node, env)
+			v = math.Max(0, math.Min(1, v))
+			nodeScore.Criteria[w.Key()] = round(v)
+			if sum > 0 {
+				nodeScore.Total += v * w.Value / sum
+			}
+		}
+		nodeScore.Total = round(nodeScore.Total)
+		scores[node] = nodeScore
+	}
+	return scores
+}
+
+// Sort returns a copy of candidates sorted by descending total score. The
+// candidates order is preserved for equal scores.
+func (t Scores) Sort(candidates []string) []string {
+	l := append([]string{}, candidates...)
+	sort.SliceStable(l, func(i, j int) bool {
+		return t[l[i]].Total > t[l[j]].Total
+	})
+	return l
+}
+
+// Equal returns true if t and other have the same nodes and scores.
+func (t Scores) Equal(other Scores) bool {
+	return maps.EqualFunc(t, other, func(a, b NodeScore) bool {
+		return a.Total == b.Total && maps.Equal(a.Criteria, b.Criteria)
+	})
+}
+
+func (t Scores) DeepCopy() Scores {
+	if t == nil {
+		return nil
+	}
+	scores := make(Scores, len(t))
+	for node, nodeScore := range t {
+		scores[node] = NodeScore{
+			Total:    nodeScore.Total,
+			Criteria: maps.Clone(nodeScore.Criteria),
+		}
+	}
+	return scores
+}
+
+// round limits the score precision, so small variations of the node stats
+// don't cause a score change.
+func round(f float64) float64 {
+	return math.Round(f*1000) / 1000
+}
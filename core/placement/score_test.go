@@ -0,0 +1,80 @@
+package placement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWeights(t *testing.T) {
+	weights, err := ParseWeights([]string{"load", "mem=2", "label:rack:r1=0.5"})
+	require.NoError(t, err)
+	require.Equal(t, Weights{
+		{Name: "load", Value: 1},
+		{Name: "mem", Value: 2},
+		{Name: "label", Arg: "rack:r1", Value: 0.5},
+	}, weights)
+	require.Equal(t, []string{"load=1", "mem=2", "label:rack:r1=0.5"}, weights.Strings())
+
+	_, err = ParseWeights([]string{"foo=1"})
+	require.ErrorContains(t, err, "unknown placement criterion 'foo'")
+
+	_, err = ParseWeights([]string{"load=-1"})
+	require.ErrorContains(t, err, "negative value")
+
+	for _, s := range []string{"load=NaN", "load=Inf", "load=+Inf", "load=-Inf"} {
+		_, err = ParseWeights([]string{s})
+		require.ErrorContainsf(t, err, "not a finite value", "weight %s", s)
+	}
+
+	_, err = ParseWeights([]string{"label"})
+	require.ErrorContains(t, err, "requires a <key>[:<value>] argument")
+}
+
+func TestWeightsScore(t *testing.T) {
+	env := &Env{
+		Candidates: []string{"n1", "n2", "n3"},
+		Nodes: map[string]NodeInfo{
+			"n1": {Load15M: 3, Labels: map[string]string{"rack": "r2"}},
+			"n2": {Load15M: 1, Labels: map[string]string{"rack": "r1"}},
+			"n3": {Load15M: 0, Labels: map[string]string{"rack": "r1"}},
+		},
+	}
+
+	t.Run("least loaded node in the same rack", func(t *testing.T) {
+		weights, err := ParseWeights([]string{"label:rack:r1=10", "load=1"})
+		require.NoError(t, err)
+		scores := weights.Score(env)
+		require.Equal(t, []string{"n3", "n2", "n1"}, scores.Sort(env.Candidates))
+		require.Equal(t, 1.0, scores["n3"].Criteria["label:rack:r1"])
+		require.Equal(t, 0.0, scores["n1"].Criteria["label:rack:r1"])
+		require.Equal(t, 1.0, scores["n3"].Total)
+	})
+
+	t.Run("rack prevails over load", func(t *testing.T) {
+		env.Nodes["n1"] = NodeInfo{Load15M: 0, Labels: map[string]string{"rack": "r2"}}
+		env.Nodes["n3"] = NodeInfo{Load15M: 2, Labels: map[string]string{"rack": "r1"}}
+		weights, err := ParseWeights([]string{"label:rack:r1=10", "load=1"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"n2", "n3", "n1"}, weights.Score(env).Sort(env.Candidates))
+	})
+
+	t.Run("registered criterion", func(t *testing.T) {
+		RegisterCriterion("test_prefer_n1", func(arg string) (Criterion, error) {
+			return CriterionFunc(func(node string, env *Env) float64 {
+				if node == "n1" {
+					return 1
+				}
+				return 0
+			}), nil
+		})
+		t.Cleanup(func() {
+			criteria.Lock()
+			defer criteria.Unlock()
+			delete(criteria.m, "test_prefer_n1")
+		})
+		weights, err := ParseWeights([]string{"test_prefer_n1"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"n1", "n2", "n3"}, weights.Score(env).Sort(env.Candidates))
+	})
+}
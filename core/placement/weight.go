@@ -0,0 +1,119 @@
+package placement
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Weight associates a weight to a placement criterion.
+	//
+	// Its string representation is <name>[:<arg>][=<value>], for example
+	// "load", "mem=2" or "label:rack:r1=3". The value defaults to 1.
+	Weight struct {
+		Name  string
+		Arg   string
+		Value float64
+	}
+
+	// Weights is the list of weighted criteria used by the weighted placement
+	// policy to score the candidate nodes.
+	Weights []Weight
+)
+
+// ParseWeight returns the Weight parsed from its string representation,
+// and verifies the criterion is registered and accepts the argument.
+func ParseWeight(s string) (Weight, error) {
+	var w Weight
+	if err := w.UnmarshalText([]byte(s)); err != nil {
+		return w, err
+	}
+	if _, err := w.Criterion(); err != nil {
+		return w, err
+	}
+	return w, nil
+}
+
+// ParseWeights returns the Weights parsed from a list of weight string
+// representations. All invalid weights are reported in the returned error,
+// the valid weights are still returned.
+func ParseWeights(l []string) (Weights, error) {
+	var errs error
+	weights := make(Weights, 0, len(l))
+	for _, s := range l {
+		if w, err := ParseWeight(s); err != nil {
+			errs = errors.Join(errs, err)
+		} else {
+			weights = append(weights, w)
+		}
+	}
+	return weights, errs
+}
+
+// Key returns the <name>[:<arg>] identifier of the weighted criterion, used to
+// index the score breakdown.
+func (t Weight) Key() string {
+	if t.Arg == "" {
+		return t.Name
+	}
+	return t.Name + ":" + t.Arg
+}
+
+// Criterion returns the registered criterion configured by the weight.
+func (t Weight) Criterion() (Criterion, error) {
+	return NewCriterion(t.Name, t.Arg)
+}
+
+func (t Weight) String() string {
+	return t.Key() + "=" + strconv.FormatFloat(t.Value, 'f', -1, 64)
+}
+
+// MarshalText marshals the weight as its string representation.
+func (t Weight) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText unmarshals a weight string representation.
+func (t *Weight) UnmarshalText(b []byte) error {
+	s := strings.TrimSpace(string(b))
+	key, value, hasValue := strings.Cut(s, "=")
+	name, arg, _ := strings.Cut(key, ":")
+	if name == "" {
+		return fmt.Errorf("invalid placement weight '%s': empty criterion name", s)
+	}
+	weight := Weight{Name: name, Arg: arg, Value: 1}
+	if hasValue {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid placement weight '%s': %w", s, err)
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return fmt.Errorf("invalid placement weight '%s': not a finite value", s)
+		}
+		if f < 0 {
+			return fmt.Errorf("invalid placement weight '%s': negative value", s)
+		}
+		weight.Value = f
+	}
+	*t = weight
+	return nil
+}
+
+// Strings returns the list of weight string representations.
+func (t Weights) Strings() []string {
+	l := make([]string, len(t))
+	for i, w := range t {
+		l[i] = w.String()
+	}
+	return l
+}
+
+func (t Weights) DeepCopy() Weights {
+	if t == nil {
+		return nil
+	}
+	return append(Weights{}, t...)
+}
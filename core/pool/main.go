@@ -36,6 +36,13 @@ type (
 		Used int64 `json:"used"`
 		// Size unit is Bytes
 		Size int64 `json:"size"`
+		// Provisioned is the sum of the virtual sizes of the thin
+		// volumes allocated from the pool. Unit is Bytes.
+		Provisioned int64 `json:"provisioned,omitempty"`
+		// DataPercent is the fill ratio of a thin pool data area.
+		DataPercent float64 `json:"data_percent,omitempty"`
+		// MetadataPercent is the fill ratio of a thin pool metadata area.
+		MetadataPercent float64 `json:"metadata_percent,omitempty"`
 	}
 
 	Status struct {
@@ -46,6 +53,7 @@ type (
 		Type         string       `json:"type"`
 		UpdatedAt    time.Time    `json:"updated_at"`
 		VolumeCount  int          `json:"volume_count"`
+		Warnings     []string     `json:"warnings,omitempty"`
 	}
 	StatusItem struct {
 		Status
@@ -251,11 +259,35 @@ func GetStatus(ctx context.Context, t Pooler, withUsage bool) Status {
 			data.Usage.Free = usage.Free
 			data.Usage.Used = usage.Used
 			data.Usage.Size = usage.Size
+			data.Usage.Provisioned = usage.Provisioned
+			data.Usage.DataPercent = usage.DataPercent
+			data.Usage.MetadataPercent = usage.MetadataPercent
+			data.Warnings = fillWarnings(t, usage)
 		}
 	}
 	return data
 }
 
+// fillWarnings returns the warnings about a thin pool data or metadata
+// fill ratio reaching the pool fill_warning threshold.
+func fillWarnings(t Pooler, usage Usage) []string {
+	if usage.DataPercent == 0 && usage.MetadataPercent == 0 {
+		return nil
+	}
+	threshold := t.Config().GetInt(pKey(t, "fill_warning"))
+	if threshold <= 0 {
+		return nil
+	}
+	var l []string
+	if usage.DataPercent >= float64(threshold) {
+		l = append(l, fmt.Sprintf("data usage %.2f%% exceeds the %d%% fill warning threshold", usage.DataPercent, threshold))
+	}
+	if usage.MetadataPercent >= float64(threshold) {
+		l = append(l, fmt.Sprintf("metadata usage %.2f%% exceeds the %d%% fill warning threshold", usage.MetadataPercent, threshold))
+	}
+	return l
+}
+
 func pKey(p Pooler, s string) key.T {
 	return pk(p.Name(), s)
 }
@@ -529,11 +561,15 @@ func (t *Status) DeepCopy() *Status {
 		Capabilities: append(Capabilities{}, t.Capabilities...),
 		UpdatedAt:    t.UpdatedAt,
 		Usage: Usage{
-			Free: t.Usage.Free,
-			Size: t.Usage.Size,
-			Used: t.Usage.Used,
+			Free:            t.Usage.Free,
+			Size:            t.Usage.Size,
+			Used:            t.Usage.Used,
+			Provisioned:     t.Usage.Provisioned,
+			DataPercent:     t.Usage.DataPercent,
+			MetadataPercent: t.Usage.MetadataPercent,
 		},
-		Errors: append([]string{}, t.Errors...),
+		Errors:   append([]string{}, t.Errors...),
+		Warnings: append([]string{}, t.Warnings...),
 	}
 }
 
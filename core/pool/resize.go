@@ -0,0 +1,26 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+)
+
+type (
+	// Resizer is the optional interface of the pool drivers able to grow
+	// the disks they create. The name argument is the pool disk name, as
+	// returned by DiskName. ResizeDisk must be a noop if the disk size is
+	// already greater than or equal to size, so it can be called from
+	// every node sharing the disk.
+	Resizer interface {
+		ResizeDisk(ctx context.Context, name string, size int64) error
+	}
+)
+
+// AsResizer returns the Resizer interface of the pool p, or an error if the
+// pool driver does not implement it.
+func AsResizer(p Pooler) (Resizer, error) {
+	if o, ok := p.(Resizer); ok {
+		return o, nil
+	}
+	return nil, fmt.Errorf("pool %s does not support resize", p.Name())
+}
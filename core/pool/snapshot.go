@@ -0,0 +1,97 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type (
+	Snapshot struct {
+		Name      string    `json:"name"`
+		CreatedAt time.Time `json:"created_at"`
+		// Used unit is Bytes
+		Used int64 `json:"used"`
+	}
+	Snapshots []Snapshot
+
+	// Snapshotter is the optional interface of the pool drivers able to
+	// snapshot the disks they create. The name argument is the pool disk
+	// name, as returned by DiskName.
+	Snapshotter interface {
+		CreateSnapshot(ctx context.Context, name, snapshot string) error
+		DeleteSnapshot(ctx context.Context, name, snapshot string) error
+		ListSnapshots(ctx context.Context, name string) (Snapshots, error)
+		RollbackSnapshot(ctx context.Context, name, snapshot string) error
+	}
+
+	// Cloner is the optional interface of the pool drivers able to create
+	// a disk from the snapshot of another disk. The returned keywords are
+	// applied to the clone volume configuration after ConfigureVolume.
+	Cloner interface {
+		CloneSnapshot(ctx context.Context, name, snapshot, cloneName string, shared bool, nodes []string) ([]string, error)
+	}
+)
+
+var (
+	snapshotNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+)
+
+// ValidateSnapshotName returns an error if s can not be used as a snapshot
+// name by all the pool drivers.
+func ValidateSnapshotName(s string) error {
+	if len(s) > 63 {
+		return fmt.Errorf("invalid snapshot name %s: longer than 63 characters", s)
+	}
+	if !snapshotNameRegexp.MatchString(s) {
+		return fmt.Errorf("invalid snapshot name %s: must match %s", s, snapshotNameRegexp)
+	}
+	return nil
+}
+
+// ParseSourceSnapshot splits a <volname>@<snapshot> string.
+func ParseSourceSnapshot(s string) (string, string, error) {
+	volName, snapshot, found := strings.Cut(s, "@")
+	if !found || volName == "" {
+		return "", "", fmt.Errorf("invalid source snapshot %s: expected <volname>@<snapshot>", s)
+	}
+	if err := ValidateSnapshotName(snapshot); err != nil {
+		return "", "", err
+	}
+	return volName, snapshot, nil
+}
+
+// AsSnapshotter returns the Snapshotter interface of the pool p, or an
+// error if the pool driver does not implement it.
+func AsSnapshotter(p Pooler) (Snapshotter, error) {
+	if o, ok := p.(Snapshotter); ok {
+		return o, nil
+	}
+	return nil, fmt.Errorf("pool %s does not support snapshots", p.Name())
+}
+
+// AsCloner returns the Cloner interface of the pool p, or an error if the
+// pool driver does not implement it.
+func AsCloner(p Pooler) (Cloner, error) {
+	if o, ok := p.(Cloner); ok {
+		return o, nil
+	}
+	return nil, fmt.Errorf("pool %s does not support clones", p.Name())
+}
+
+func (t Snapshots) Len() int {
+	return len(t)
+}
+
+func (t Snapshots) Less(i, j int) bool {
+	if t[i].CreatedAt.Equal(t[j].CreatedAt) {
+		return t[i].Name < t[j].Name
+	}
+	return t[i].CreatedAt.Before(t[j].CreatedAt)
+}
+
+func (t Snapshots) Swap(i, j int) {
+	t[i], t[j] = t[j], t[i]
+}
@@ -0,0 +1,29 @@
+package pool
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSnapshotName(t *testing.T) {
+	for _, s := range []string{"snap1", "before-upgrade", "2024.03.01_10h"} {
+		require.Nil(t, ValidateSnapshotName(s), s)
+	}
+	for _, s := range []string{"", "-snap", "a@b", "a/b", "a b", strings.Repeat("a", 64)} {
+		require.NotNil(t, ValidateSnapshotName(s), s)
+	}
+}
+
+func TestParseSourceSnapshot(t *testing.T) {
+	volName, snapshot, err := ParseSourceSnapshot("db-vol-1@before-upgrade")
+	require.Nil(t, err)
+	require.Equal(t, "db-vol-1", volName)
+	require.Equal(t, "before-upgrade", snapshot)
+
+	for _, s := range []string{"db-vol-1", "@snap1", "db-vol-1@", "db-vol-1@a/b"} {
+		_, _, err := ParseSourceSnapshot(s)
+		require.NotNil(t, err, s)
+	}
+}
@@ -161,6 +161,9 @@ type (
 	ingester interface {
 		Ingest(context.Context) error
 	}
+	resizer interface {
+		Resize(context.Context) error
+	}
 	SubDeviceser interface {
 		SubDevices(context.Context) device.L
 	}
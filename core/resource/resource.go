@@ -1015,6 +1015,25 @@ func Ingest(ctx context.Context, r Driver) error {
 	return nil
 }
 
+// Resize execute the resource Resize function, if implemented by the driver.
+// Resize makes the resource use the new size of its backing device.
+func Resize(ctx context.Context, r Driver) error {
+	var i any = r
+	s, ok := i.(resizer)
+	if !ok {
+		return ErrActionNotSupported
+	}
+	defer EvalStatus(ctx, r)
+	if r.IsDisabled() || r.IsActionDisabled() {
+		return ErrDisabled
+	}
+	Setenv(r)
+	if err := s.Resize(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Shutdown deactivates a resource even if standby is true
 func Shutdown(ctx context.Context, r Driver) error {
 	defer EvalStatus(ctx, r)
@@ -0,0 +1,496 @@
+/*
+Package stonith provides the framework of the node fencing drivers, and the
+runner fencing a peer node through the fencers configured for it.
+
+A fencer is a stonith#<name> section of the node configuration. Its type
+keyword selects the driver, and its node keyword the peer it can fence.
+
+	[stonith#node2-ipmi]
+	type = ipmi
+	node = node2
+	addr = node2-bmc
+	order = 0
+
+	[stonith#node2-ssh]
+	type = ssh
+	node = node2
+	order = 1
+
+The runner tries the fencers of a node by ascending order, retrying each
+one on failure, and escalates to the next one when the retries are
+exhausted. A fencer succeeds when its driver reports the node is fenced and,
+if verification is enabled, the driver confirms the node power is off. A
+power state the driver can't tell fails the verification, unless the driver
+reports it has no way to verify, like a command fencer without verify
+command, in which case the fencing is trusted.
+
+Drivers register an allocator and compose the T struct:
+
+	type T struct {
+		stonith.T
+	}
+
+	func init() { stonith.Register("ipmi", New) }
+
+	func New() stonith.Confer {
+		return &T{}
+	}
+
+	func (t *T) Configure() error { ... }
+	func (t *T) Fence(ctx context.Context) error { ... }
+	func (t *T) PowerState(ctx context.Context) (stonith.PowerState, error) { ... }
+*/
+package stonith
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/xconfig"
+	"github.com/opensvc/om3/v3/util/funcopt"
+	"github.com/opensvc/om3/v3/util/key"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	// PowerState is the power state of a node as reported by a fencer.
+	PowerState string
+
+	// Driver is the interface a stonith driver implements.
+	Driver interface {
+		// Fence powers off or isolates the node.
+		Fence(ctx context.Context) error
+
+		// PowerState returns the power state of the node. Drivers unable
+		// to tell return PowerStateUnknown, which fails the fencing
+		// verification.
+		PowerState(ctx context.Context) (PowerState, error)
+	}
+
+	// Verifier is the optional interface of the drivers that may have no
+	// way to check the node power state. A driver whose CanVerify returns
+	// false is trusted when its Fence returns no error, even if the
+	// verification is enabled.
+	Verifier interface {
+		CanVerify() bool
+	}
+
+	// Confer is the interface a stonith driver allocator returns. The
+	// driver struct composes T and implements Configure and Driver.
+	Confer interface {
+		Driver
+		TConfer
+
+		// Configure loads the driver settings from the configuration.
+		Configure() error
+	}
+
+	// TConfer holds the getters and setters implemented by T.
+	TConfer interface {
+		Name() string
+		Type() string
+		Node() string
+		Config() *xconfig.T
+		Log() *plog.Logger
+
+		SetName(string)
+		SetDriver(string)
+		SetNode(string)
+		SetConfig(*xconfig.T)
+		SetLog(*plog.Logger)
+	}
+
+	// T is the base of the stonith drivers.
+	T struct {
+		name   string
+		driver string
+		node   string
+		config *xconfig.T
+		log    *plog.Logger
+	}
+
+	// Fencer is a configured driver with its retry and verification
+	// policy.
+	Fencer struct {
+		Name string
+		Type string
+		Node string
+
+		// Order sorts the fencers of a node. The lowest order fencer is
+		// tried first.
+		Order int
+
+		// Retries is the number of fencing attempts after the first one
+		// before escalating to the next fencer.
+		Retries int
+
+		// RetryDelay is the delay between two fencing attempts.
+		RetryDelay time.Duration
+
+		// Timeout is the maximum duration of a fencing attempt.
+		Timeout time.Duration
+
+		// Verify enables the confirmation the node power is off after
+		// fencing. If disabled, the fencer is trusted.
+		Verify bool
+
+		// VerifyTimeout is the maximum duration of the verification.
+		VerifyTimeout time.Duration
+
+		Driver Driver
+	}
+
+	// Result is the outcome of a fencing attempt.
+	Result struct {
+		Node       string        `json:"node"`
+		Fencer     string        `json:"fencer"`
+		Type       string        `json:"type"`
+		Attempt    int           `json:"attempt"`
+		Fenced     bool          `json:"fenced"`
+		Verified   bool          `json:"verified"`
+		PowerState PowerState    `json:"power_state"`
+		Error      string        `json:"error,omitempty"`
+		Duration   time.Duration `json:"duration"`
+	}
+
+	fenceOption struct {
+		log      *plog.Logger
+		onResult func(Result)
+	}
+)
+
+const (
+	PowerStateOn      PowerState = "on"
+	PowerStateOff     PowerState = "off"
+	PowerStateUnknown PowerState = "unknown"
+)
+
+var (
+	// ErrNoFencer is returned when no fencer is configured for the node.
+	ErrNoFencer = errors.New("no fencer configured")
+
+	// ErrPowerStateUnknown is returned by the fencing verification when
+	// the driver can't tell the node power state.
+	ErrPowerStateUnknown = errors.New("the fencer can't tell the node power state")
+
+	// VerifyInterval is the delay between two power state checks during
+	// the fencing verification.
+	VerifyInterval = time.Second
+)
+
+// Register registers a stonith driver allocator.
+func Register(driverName string, fn func() Confer) {
+	did := driver.NewID(driver.GroupStonith, driverName)
+	driver.Register(did, fn)
+}
+
+// Allocator returns the registered allocator of the driverName stonith
+// driver, or nil if not registered.
+func Allocator(driverName string) func() Confer {
+	did := driver.NewID(driver.GroupStonith, driverName)
+	drv, ok := driver.Get(did)
+	if !ok {
+		return nil
+	}
+	if a, ok := drv.Allocator.(func() Confer); ok {
+		return a
+	}
+	return nil
+}
+
+// WithLogger sets the logger of the fencing runner and drivers.
+func WithLogger(log *plog.Logger) funcopt.O {
+	return funcopt.F(func(i any) error {
+		t := i.(*fenceOption)
+		t.log = log
+		return nil
+	})
+}
+
+// WithResultFunc sets a function called with the result of each fencing
+// attempt, as soon as it is known.
+func WithResultFunc(fn func(Result)) funcopt.O {
+	return funcopt.F(func(i any) error {
+		t := i.(*fenceOption)
+		t.onResult = fn
+		return nil
+	})
+}
+
+// New returns the Fencer configured by the section s of config.
+func New(s string, config *xconfig.T, log *plog.Logger) (*Fencer, error) {
+	driverName := config.GetString(key.New(s, "type"))
+	fn := Allocator(driverName)
+	if fn == nil {
+		return nil, fmt.Errorf("%s: unsupported stonith type: %s", s, driverName)
+	}
+	nodename := sectionNode(config, s)
+	drv := fn()
+	drv.SetName(s)
+	drv.SetDriver(driverName)
+	drv.SetNode(nodename)
+	drv.SetConfig(config)
+	drv.SetLog(log)
+	if err := drv.Configure(); err != nil {
+		return nil, fmt.Errorf("%s: %w", s, err)
+	}
+	f := &Fencer{
+		Name:          s,
+		Type:          driverName,
+		Node:          nodename,
+		Order:         config.GetInt(key.New(s, "order")),
+		Retries:       config.GetInt(key.New(s, "retries")),
+		RetryDelay:    getDuration(config, s, "retry_delay"),
+		Timeout:       getDuration(config, s, "timeout"),
+		Verify:        config.GetBool(key.New(s, "verify")),
+		VerifyTimeout: getDuration(config, s, "verify_timeout"),
+		Driver:        drv,
+	}
+	return f, nil
+}
+
+// Fencers returns the fencers of the node nodename configured in config,
+// sorted by order.
+func Fencers(config *xconfig.T, nodename string, log *plog.Logger) ([]*Fencer, error) {
+	var (
+		l    []*Fencer
+		errs error
+	)
+	for _, s := range config.SectionStrings() {
+		if !strings.HasPrefix(s, "stonith#") {
+			continue
+		}
+		if sectionNode(config, s) != nodename {
+			continue
+		}
+		f, err := New(s, config, log)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		l = append(l, f)
+	}
+	if len(l) == 0 {
+		return nil, errors.Join(fmt.Errorf("%w for node %s", ErrNoFencer, nodename), errs)
+	}
+	if errs != nil && log != nil {
+		log.Warnf("stonith: %s", errs)
+	}
+	Sort(l)
+	return l, nil
+}
+
+// Sort sorts the fencers by order, then by name.
+func Sort(l []*Fencer) {
+	sort.SliceStable(l, func(i, j int) bool {
+		if l[i].Order != l[j].Order {
+			return l[i].Order < l[j].Order
+		}
+		return l[i].Name < l[j].Name
+	})
+}
+
+// Fence fences the node nodename using the fencers in the slice order, and
+// returns the results of all attempts. It returns a nil error as soon as a
+// fencer succeeds.
+func Fence(ctx context.Context, nodename string, fencers []*Fencer, opts ...funcopt.O) ([]Result, error) {
+	var (
+		o       fenceOption
+		results []Result
+		errs    error
+	)
+	if err := funcopt.Apply(&o, opts...); err != nil {
+		return nil, err
+	}
+	if len(fencers) == 0 {
+		return nil, fmt.Errorf("%w for node %s", ErrNoFencer, nodename)
+	}
+	for _, f := range fencers {
+		for attempt := 1; attempt <= f.Retries+1; attempt++ {
+			if attempt > 1 && f.RetryDelay > 0 {
+				select {
+				case <-ctx.Done():
+					return results, errors.Join(errs, ctx.Err())
+				case <-time.After(f.RetryDelay):
+				}
+			}
+			if err := ctx.Err(); err != nil {
+				return results, errors.Join(errs, err)
+			}
+			if o.log != nil {
+				o.log.Infof("stonith: %s: fence using %s (%s), attempt %d/%d", nodename, f.Name, f.Type, attempt, f.Retries+1)
+			}
+			result := f.fence(ctx, nodename, attempt)
+			results = append(results, result)
+			if o.onResult != nil {
+				o.onResult(result)
+			}
+			if result.Error == "" {
+				if o.log != nil {
+					o.log.Infof("stonith: %s: fenced using %s, power state %s", nodename, f.Name, result.PowerState)
+				}
+				return results, nil
+			}
+			if o.log != nil {
+				o.log.Warnf("stonith: %s: %s attempt %d: %s", nodename, f.Name, attempt, result.Error)
+			}
+			errs = errors.Join(errs, fmt.Errorf("%s attempt %d: %s", f.Name, attempt, result.Error))
+		}
+		if o.log != nil {
+			o.log.Warnf("stonith: %s: %s failed, escalate to the next fencer", nodename, f.Name)
+		}
+	}
+	return results, fmt.Errorf("fence node %s: all fencers failed: %w", nodename, errs)
+}
+
+func (t *Fencer) fence(ctx context.Context, nodename string, attempt int) Result {
+	begin := time.Now()
+	result := Result{
+		Node:       nodename,
+		Fencer:     t.Name,
+		Type:       t.Type,
+		Attempt:    attempt,
+		PowerState: PowerStateUnknown,
+	}
+	defer func() {
+		result.Duration = time.Since(begin)
+	}()
+	fenceCtx := ctx
+	if t.Timeout > 0 {
+		var cancel context.CancelFunc
+		fenceCtx, cancel = context.WithTimeout(ctx, t.Timeout)
+		defer cancel()
+	}
+	if err := t.Driver.Fence(fenceCtx); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Fenced = true
+	if !t.Verify {
+		return result
+	}
+	if i, ok := t.Driver.(Verifier); ok && !i.CanVerify() {
+		return result
+	}
+	state, err := t.verify(ctx)
+	result.PowerState = state
+	if err != nil {
+		result.Error = fmt.Sprintf("verify: %s", err)
+		return result
+	}
+	result.Verified = true
+	return result
+}
+
+// verify polls the driver power state until the node is off, the driver
+// reports it can't tell, or the verification timeout. Only the off state
+// returns a nil error.
+func (t *Fencer) verify(ctx context.Context) (PowerState, error) {
+	if t.VerifyTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.VerifyTimeout)
+		defer cancel()
+	}
+	state := PowerStateUnknown
+	for {
+		var err error
+		state, err = t.Driver.PowerState(ctx)
+		switch {
+		case err == nil && state == PowerStateOff:
+			return state, nil
+		case err == nil && state == PowerStateUnknown:
+			return state, ErrPowerStateUnknown
+		}
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return state, err
+			}
+			return state, fmt.Errorf("power state is still %s", state)
+		case <-time.After(VerifyInterval):
+		}
+	}
+}
+
+// sectionNode returns the node fenced by the section s. The node defaults
+// to the section name suffix, as in the single command fencer
+// configurations.
+func sectionNode(config *xconfig.T, s string) string {
+	if nodename := config.GetString(key.New(s, "node")); nodename != "" {
+		return nodename
+	}
+	return strings.TrimPrefix(s, "stonith#")
+}
+
+func getDuration(config *xconfig.T, s, option string) time.Duration {
+	if d := config.GetDuration(key.New(s, option)); d != nil {
+		return *d
+	}
+	return 0
+}
+
+func (t *T) Name() string {
+	return t.name
+}
+
+func (t *T) Type() string {
+	return t.driver
+}
+
+// Node returns the name of the node the driver fences.
+func (t *T) Node() string {
+	return t.node
+}
+
+func (t *T) Config() *xconfig.T {
+	return t.config
+}
+
+// Log returns the driver logger, never nil.
+func (t *T) Log() *plog.Logger {
+	if t.log == nil {
+		t.log = plog.NewDefaultLogger()
+	}
+	return t.log
+}
+
+func (t *T) SetName(name string) {
+	t.name = name
+}
+
+func (t *T) SetDriver(driver string) {
+	t.driver = driver
+}
+
+func (t *T) SetNode(node string) {
+	t.node = node
+}
+
+func (t *T) SetConfig(c *xconfig.T) {
+	t.config = c
+}
+
+func (t *T) SetLog(log *plog.Logger) {
+	t.log = log
+}
+
+func (t *T) GetBool(s string) bool {
+	return t.config.GetBool(key.New(t.name, s))
+}
+
+func (t *T) GetString(s string) string {
+	return t.config.GetString(key.New(t.name, s))
+}
+
+func (t *T) GetStrings(s string) []string {
+	return t.config.GetStrings(key.New(t.name, s))
+}
+
+func (t *T) GetInt(s string) int {
+	return t.config.GetInt(key.New(t.name, s))
+}
@@ -0,0 +1,128 @@
+package stonith
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// fakeDriver fails the first failures fencing attempts, then reports
+	// the state power state.
+	fakeDriver struct {
+		failures int
+		state    PowerState
+		calls    int
+	}
+
+	// unverifiableDriver is a fakeDriver with no way to check the power
+	// state, like a command fencer without verify command.
+	unverifiableDriver struct {
+		fakeDriver
+	}
+)
+
+func (t *fakeDriver) Fence(_ context.Context) error {
+	t.calls++
+	if t.calls <= t.failures {
+		return errors.New("fake failure")
+	}
+	return nil
+}
+
+func (t *fakeDriver) PowerState(_ context.Context) (PowerState, error) {
+	return t.state, nil
+}
+
+func (t *unverifiableDriver) CanVerify() bool {
+	return false
+}
+
+func newFakeFencer(name string, order, retries int, drv *fakeDriver) *Fencer {
+	return &Fencer{
+		Name:          name,
+		Type:          "fake",
+		Node:          "node2",
+		Order:         order,
+		Retries:       retries,
+		Verify:        true,
+		VerifyTimeout: 50 * time.Millisecond,
+		Driver:        drv,
+	}
+}
+
+func TestFenceEscalation(t *testing.T) {
+	VerifyInterval = 10 * time.Millisecond
+	ipmi := &fakeDriver{failures: 10, state: PowerStateOn}
+	ssh := &fakeDriver{failures: 1, state: PowerStateOff}
+	fencers := []*Fencer{
+		newFakeFencer("stonith#ssh", 1, 1, ssh),
+		newFakeFencer("stonith#ipmi", 0, 1, ipmi),
+	}
+	Sort(fencers)
+	require.Equal(t, "stonith#ipmi", fencers[0].Name)
+
+	var published []Result
+	results, err := Fence(context.Background(), "node2", fencers, WithResultFunc(func(r Result) {
+		published = append(published, r)
+	}))
+	require.NoError(t, err)
+	require.Equal(t, results, published)
+	require.Len(t, results, 4)
+	require.Equal(t, 2, ipmi.calls, "expected the ipmi fencer to be retried once")
+	require.Equal(t, 2, ssh.calls, "expected the escalation to the ssh fencer")
+
+	last := results[3]
+	require.Equal(t, "stonith#ssh", last.Fencer)
+	require.Equal(t, 2, last.Attempt)
+	require.True(t, last.Fenced)
+	require.True(t, last.Verified)
+	require.Equal(t, PowerStateOff, last.PowerState)
+	require.Empty(t, last.Error)
+}
+
+func TestFenceVerification(t *testing.T) {
+	VerifyInterval = 10 * time.Millisecond
+	t.Run("node still on", func(t *testing.T) {
+		drv := &fakeDriver{state: PowerStateOn}
+		results, err := Fence(context.Background(), "node2", []*Fencer{newFakeFencer("stonith#a", 0, 0, drv)})
+		require.Error(t, err)
+		require.Len(t, results, 1)
+		require.True(t, results[0].Fenced)
+		require.False(t, results[0].Verified)
+		require.Equal(t, PowerStateOn, results[0].PowerState)
+	})
+	t.Run("unknown power state", func(t *testing.T) {
+		drv := &fakeDriver{state: PowerStateUnknown}
+		results, err := Fence(context.Background(), "node2", []*Fencer{newFakeFencer("stonith#a", 0, 0, drv)})
+		require.Error(t, err, "expected a failure when the driver can't tell")
+		require.Len(t, results, 1)
+		require.True(t, results[0].Fenced)
+		require.False(t, results[0].Verified)
+		require.Contains(t, results[0].Error, ErrPowerStateUnknown.Error())
+	})
+	t.Run("verification disabled", func(t *testing.T) {
+		drv := &fakeDriver{state: PowerStateOn}
+		f := newFakeFencer("stonith#a", 0, 0, drv)
+		f.Verify = false
+		_, err := Fence(context.Background(), "node2", []*Fencer{f})
+		require.NoError(t, err)
+	})
+	t.Run("driver unable to verify is trusted", func(t *testing.T) {
+		drv := &unverifiableDriver{fakeDriver{state: PowerStateUnknown}}
+		f := &Fencer{Name: "stonith#a", Type: "command", Node: "node2", Verify: true, Driver: drv}
+		results, err := Fence(context.Background(), "node2", []*Fencer{f})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.True(t, results[0].Fenced)
+		require.False(t, results[0].Verified)
+	})
+}
+
+func TestFenceNoFencer(t *testing.T) {
+	_, err := Fence(context.Background(), "node2", nil)
+	require.ErrorIs(t, err, ErrNoFencer)
+}
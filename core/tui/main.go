@@ -604,7 +604,7 @@ func (t *App) do(evReader event.ReadCloser) error {
 		eventC = make(chan event.Event, 100)
 		dataC  = make(chan *clusterdump.Data)
 
-		nextEventID uint64
+		lastEventID uint64
 
 		wg = sync.WaitGroup{}
 	)
@@ -694,14 +694,15 @@ func (t *App) do(evReader event.ReadCloser) error {
 			if t.isInEventView.Load() {
 				t.events <- e
 			}
-			if nextEventID == 0 {
-				nextEventID = e.ID
-			} else if e.ID != nextEventID {
-				err := fmt.Errorf("broken event chain: received event id %d, expected %d", e.ID, nextEventID)
+			// the event ids are the daemon bus publication sequence
+			// numbers, so filtered streams have gaps, but the ids never
+			// decrease.
+			if e.ID < lastEventID {
+				err := fmt.Errorf("broken event chain: received event id %d after event id %d", e.ID, lastEventID)
 				t.errorf("%s", err)
 				return err
 			}
-			nextEventID++
+			lastEventID = e.ID
 			changes = true
 			msg, err := msgbus.EventToMessage(e)
 			if err != nil {
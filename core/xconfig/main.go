@@ -468,6 +468,25 @@ func (t *T) GetIntStrict(k key.T) (int, error) {
 	}
 }
 
+// GetFloat64 returns the evaluated float value associated with a key k.
+// On errors returns 0.
+func (t *T) GetFloat64(k key.T) float64 {
+	val, _ := t.GetFloat64Strict(k)
+	return val
+}
+
+// GetFloat64Strict returns the evaluated float value associated with a
+// key k. On errors returns 0 and an appropriate error.
+func (t *T) GetFloat64Strict(k key.T) (float64, error) {
+	if v, err := t.Eval(k); err != nil {
+		return 0, err
+	} else if f, ok := v.(float64); !ok {
+		return 0, fmt.Errorf("%w: expected float64, got %v", ErrType, v)
+	} else {
+		return f, nil
+	}
+}
+
 func (t *T) GetSize(k key.T) *int64 {
 	val, _ := t.GetSizeStrict(k)
 	return val
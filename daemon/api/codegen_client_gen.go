@@ -92,6 +92,9 @@ type ClientInterface interface {
 	// GetArray request
 	GetArray(ctx context.Context, params *GetArrayParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetAuthCanI request
+	GetAuthCanI(ctx context.Context, params *GetAuthCanIParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAuthInfo request
 	GetAuthInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -153,6 +156,15 @@ type ClientInterface interface {
 
 	PostInstanceStatus(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostInstanceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetJobs request
+	GetJobs(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJob request
+	GetJob(ctx context.Context, id InPathJobID, params *GetJobParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostJobCancel request
+	PostJobCancel(ctx context.Context, id InPathJobID, params *PostJobCancelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetNetworks request
 	GetNetworks(ctx context.Context, params *GetNetworksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -162,6 +174,9 @@ type ClientInterface interface {
 	// GetNodes request
 	GetNodes(ctx context.Context, params *GetNodesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetNodesCapacity request
+	GetNodesCapacity(ctx context.Context, params *GetNodesCapacityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetNodesInfo request
 	GetNodesInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -201,6 +216,9 @@ type ClientInterface interface {
 	// PostPeerActionUnfreeze request
 	PostPeerActionUnfreeze(ctx context.Context, nodename InPathNodeName, params *PostPeerActionUnfreezeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetNodeAuditLog request
+	GetNodeAuditLog(ctx context.Context, nodename InPathNodeName, params *GetNodeAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetNodeCapabilities request
 	GetNodeCapabilities(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -327,6 +345,9 @@ type ClientInterface interface {
 	// PostInstanceActionPushResourceInfo request
 	PostInstanceActionPushResourceInfo(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPushResourceInfoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostInstanceActionResize request
+	PostInstanceActionResize(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionResizeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostInstanceActionRestart request
 	PostInstanceActionRestart(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionRestartParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -336,6 +357,15 @@ type ClientInterface interface {
 	// PostInstanceActionShutdown request
 	PostInstanceActionShutdown(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionShutdownParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostInstanceActionSnapshotCreate request
+	PostInstanceActionSnapshotCreate(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotCreateParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostInstanceActionSnapshotDelete request
+	PostInstanceActionSnapshotDelete(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotDeleteParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostInstanceActionSnapshotRollback request
+	PostInstanceActionSnapshotRollback(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotRollbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostInstanceActionStart request
 	PostInstanceActionStart(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -381,12 +411,29 @@ type ClientInterface interface {
 	// GetInstanceSchedule request
 	GetInstanceSchedule(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetInstanceSnapshots request
+	GetInstanceSnapshots(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostInstanceStateFileWithBody request with any body
 	PostInstanceStateFileWithBody(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetInstanceSyncData request
+	GetInstanceSyncData(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceSyncDataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostInstanceSyncDataWithBody request with any body
+	PostInstanceSyncDataWithBody(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceSyncDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetNodeLogs request
 	GetNodeLogs(ctx context.Context, nodename InPathNodeName, params *GetNodeLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteNodeMaintenance request
+	DeleteNodeMaintenance(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostNodeMaintenanceWithBody request with any body
+	PostNodeMaintenanceWithBody(ctx context.Context, nodename InPathNodeName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostNodeMaintenance(ctx context.Context, nodename InPathNodeName, body PostNodeMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetNodeMetrics request
 	GetNodeMetrics(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -435,6 +482,11 @@ type ClientInterface interface {
 	// GetObjects request
 	GetObjects(ctx context.Context, params *GetObjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostObjectsActionWithBody request with any body
+	PostObjectsActionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostObjectsAction(ctx context.Context, body PostObjectsActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetObjectPaths request
 	GetObjectPaths(ctx context.Context, params *GetObjectPathsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -534,6 +586,15 @@ type ClientInterface interface {
 	// GetObjectSchedule request
 	GetObjectSchedule(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteObjectToken request
+	DeleteObjectToken(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *DeleteObjectTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetObjectTokens request
+	GetObjectTokens(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostObjectToken request
+	PostObjectToken(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostObjectTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSwagger request
 	GetSwagger(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -570,6 +631,18 @@ func (c *Client) GetArray(ctx context.Context, params *GetArrayParams, reqEditor
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetAuthCanI(ctx context.Context, params *GetAuthCanIParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthCanIRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetAuthInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetAuthInfoRequest(c.Server)
 	if err != nil {
@@ -822,6 +895,42 @@ func (c *Client) PostInstanceStatus(ctx context.Context, namespace InPathNamespa
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetJobs(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetJob(ctx context.Context, id InPathJobID, params *GetJobParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostJobCancel(ctx context.Context, id InPathJobID, params *PostJobCancelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostJobCancelRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetNetworks(ctx context.Context, params *GetNetworksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetNetworksRequest(c.Server, params)
 	if err != nil {
@@ -858,6 +967,18 @@ func (c *Client) GetNodes(ctx context.Context, params *GetNodesParams, reqEditor
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetNodesCapacity(ctx context.Context, params *GetNodesCapacityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNodesCapacityRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetNodesInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetNodesInfoRequest(c.Server)
 	if err != nil {
@@ -1014,6 +1135,18 @@ func (c *Client) PostPeerActionUnfreeze(ctx context.Context, nodename InPathNode
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetNodeAuditLog(ctx context.Context, nodename InPathNodeName, params *GetNodeAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNodeAuditLogRequest(c.Server, nodename, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetNodeCapabilities(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetNodeCapabilitiesRequest(c.Server, nodename)
 	if err != nil {
@@ -1530,6 +1663,18 @@ func (c *Client) PostInstanceActionPushResourceInfo(ctx context.Context, nodenam
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostInstanceActionResize(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionResizeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInstanceActionResizeRequest(c.Server, nodename, namespace, kind, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostInstanceActionRestart(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionRestartParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostInstanceActionRestartRequest(c.Server, nodename, namespace, kind, name, params)
 	if err != nil {
@@ -1566,6 +1711,42 @@ func (c *Client) PostInstanceActionShutdown(ctx context.Context, nodename InPath
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostInstanceActionSnapshotCreate(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotCreateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInstanceActionSnapshotCreateRequest(c.Server, nodename, namespace, kind, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostInstanceActionSnapshotDelete(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotDeleteParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInstanceActionSnapshotDeleteRequest(c.Server, nodename, namespace, kind, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostInstanceActionSnapshotRollback(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotRollbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInstanceActionSnapshotRollbackRequest(c.Server, nodename, namespace, kind, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostInstanceActionStart(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostInstanceActionStartRequest(c.Server, nodename, namespace, kind, name, params)
 	if err != nil {
@@ -1746,6 +1927,18 @@ func (c *Client) GetInstanceSchedule(ctx context.Context, nodename InPathNodeNam
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetInstanceSnapshots(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInstanceSnapshotsRequest(c.Server, nodename, namespace, kind, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostInstanceStateFileWithBody(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostInstanceStateFileRequestWithBody(c.Server, nodename, namespace, kind, name, contentType, body)
 	if err != nil {
@@ -1758,6 +1951,30 @@ func (c *Client) PostInstanceStateFileWithBody(ctx context.Context, nodename InP
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetInstanceSyncData(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceSyncDataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInstanceSyncDataRequest(c.Server, nodename, namespace, kind, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostInstanceSyncDataWithBody(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceSyncDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInstanceSyncDataRequestWithBody(c.Server, nodename, namespace, kind, name, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetNodeLogs(ctx context.Context, nodename InPathNodeName, params *GetNodeLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetNodeLogsRequest(c.Server, nodename, params)
 	if err != nil {
@@ -1770,6 +1987,42 @@ func (c *Client) GetNodeLogs(ctx context.Context, nodename InPathNodeName, param
 	return c.Client.Do(req)
 }
 
+func (c *Client) DeleteNodeMaintenance(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteNodeMaintenanceRequest(c.Server, nodename)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostNodeMaintenanceWithBody(ctx context.Context, nodename InPathNodeName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostNodeMaintenanceRequestWithBody(c.Server, nodename, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostNodeMaintenance(ctx context.Context, nodename InPathNodeName, body PostNodeMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostNodeMaintenanceRequest(c.Server, nodename, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetNodeMetrics(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetNodeMetricsRequest(c.Server, nodename)
 	if err != nil {
@@ -1962,6 +2215,30 @@ func (c *Client) GetObjects(ctx context.Context, params *GetObjectsParams, reqEd
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostObjectsActionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostObjectsActionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostObjectsAction(ctx context.Context, body PostObjectsActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostObjectsActionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetObjectPaths(ctx context.Context, params *GetObjectPathsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetObjectPathsRequest(c.Server, params)
 	if err != nil {
@@ -2370,6 +2647,42 @@ func (c *Client) GetObjectSchedule(ctx context.Context, namespace InPathNamespac
 	return c.Client.Do(req)
 }
 
+func (c *Client) DeleteObjectToken(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *DeleteObjectTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteObjectTokenRequest(c.Server, namespace, kind, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetObjectTokens(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetObjectTokensRequest(c.Server, namespace, kind, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostObjectToken(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostObjectTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostObjectTokenRequest(c.Server, namespace, kind, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetSwagger(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetSwaggerRequest(c.Server)
 	if err != nil {
@@ -2520,8 +2833,8 @@ func NewGetArrayRequest(server string, params *GetArrayParams) (*http.Request, e
 	return req, nil
 }
 
-// NewGetAuthInfoRequest generates requests for GetAuthInfo
-func NewGetAuthInfoRequest(server string) (*http.Request, error) {
+// NewGetAuthCanIRequest generates requests for GetAuthCanI
+func NewGetAuthCanIRequest(server string, params *GetAuthCanIParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2529,7 +2842,7 @@ func NewGetAuthInfoRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/auth/info")
+	operationPath := fmt.Sprintf("/api/auth/can-i")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2539,16 +2852,74 @@ func NewGetAuthInfoRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	return req, nil
-}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "action", params.Action, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
 
-// NewPostAuthRefreshRequest generates requests for PostAuthRefresh
-func NewPostAuthRefreshRequest(server string, params *PostAuthRefreshParams) (*http.Request, error) {
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuthInfoRequest generates requests for GetAuthInfo
+func NewGetAuthInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAuthRefreshRequest generates requests for PostAuthRefresh
+func NewPostAuthRefreshRequest(server string, params *PostAuthRefreshParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3518,8 +3889,8 @@ func NewPostInstanceStatusRequestWithBody(server string, namespace InPathNamespa
 	return req, nil
 }
 
-// NewGetNetworksRequest generates requests for GetNetworks
-func NewGetNetworksRequest(server string, params *GetNetworksParams) (*http.Request, error) {
+// NewGetJobsRequest generates requests for GetJobs
+func NewGetJobsRequest(server string, params *GetJobsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3527,7 +3898,7 @@ func NewGetNetworksRequest(server string, params *GetNetworksParams) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/network")
+	operationPath := fmt.Sprintf("/api/job")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3546,9 +3917,9 @@ func NewGetNetworksRequest(server string, params *GetNetworksParams) (*http.Requ
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Name != nil {
+		if params.Node != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "node", *params.Node, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -3572,16 +3943,23 @@ func NewGetNetworksRequest(server string, params *GetNetworksParams) (*http.Requ
 	return req, nil
 }
 
-// NewGetNetworkIPRequest generates requests for GetNetworkIP
-func NewGetNetworkIPRequest(server string, params *GetNetworkIPParams) (*http.Request, error) {
+// NewGetJobRequest generates requests for GetJob
+func NewGetJobRequest(server string, id InPathJobID, params *GetJobParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/network/ip")
+	operationPath := fmt.Sprintf("/api/job/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3600,9 +3978,9 @@ func NewGetNetworkIPRequest(server string, params *GetNetworkIPParams) (*http.Re
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Name != nil {
+		if params.Node != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "node", *params.Node, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -3626,16 +4004,23 @@ func NewGetNetworkIPRequest(server string, params *GetNetworkIPParams) (*http.Re
 	return req, nil
 }
 
-// NewGetNodesRequest generates requests for GetNodes
-func NewGetNodesRequest(server string, params *GetNodesParams) (*http.Request, error) {
+// NewPostJobCancelRequest generates requests for PostJobCancel
+func NewPostJobCancelRequest(server string, id InPathJobID, params *PostJobCancelParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node")
+	operationPath := fmt.Sprintf("/api/job/%s/cancel", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3672,7 +4057,7 @@ func NewGetNodesRequest(server string, params *GetNodesParams) (*http.Request, e
 		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3680,8 +4065,8 @@ func NewGetNodesRequest(server string, params *GetNodesParams) (*http.Request, e
 	return req, nil
 }
 
-// NewGetNodesInfoRequest generates requests for GetNodesInfo
-func NewGetNodesInfoRequest(server string) (*http.Request, error) {
+// NewGetNetworksRequest generates requests for GetNetworks
+func NewGetNetworksRequest(server string, params *GetNetworksParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3689,7 +4074,7 @@ func NewGetNodesInfoRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/info")
+	operationPath := fmt.Sprintf("/api/network")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3699,6 +4084,33 @@ func NewGetNodesInfoRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3707,23 +4119,16 @@ func NewGetNodesInfoRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewPostPeerActionAbortRequest generates requests for PostPeerActionAbort
-func NewPostPeerActionAbortRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewGetNetworkIPRequest generates requests for GetNetworkIP
+func NewGetNetworkIPRequest(server string, params *GetNetworkIPParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/action/abort", pathParam0)
+	operationPath := fmt.Sprintf("/api/network/ip")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3733,7 +4138,34 @@ func NewPostPeerActionAbortRequest(server string, nodename InPathNodeName) (*htt
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3741,23 +4173,16 @@ func NewPostPeerActionAbortRequest(server string, nodename InPathNodeName) (*htt
 	return req, nil
 }
 
-// NewPostNodeActionClearRequest generates requests for PostNodeActionClear
-func NewPostNodeActionClearRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewGetNodesRequest generates requests for GetNodes
+func NewGetNodesRequest(server string, params *GetNodesParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/action/clear", pathParam0)
+	operationPath := fmt.Sprintf("/api/node")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3767,7 +4192,34 @@ func NewPostNodeActionClearRequest(server string, nodename InPathNodeName) (*htt
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Node != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "node", *params.Node, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3775,23 +4227,16 @@ func NewPostNodeActionClearRequest(server string, nodename InPathNodeName) (*htt
 	return req, nil
 }
 
-// NewPostPeerActionDequeueRequest generates requests for PostPeerActionDequeue
-func NewPostPeerActionDequeueRequest(server string, nodename InPathNodeName, params *PostPeerActionDequeueParams) (*http.Request, error) {
+// NewGetNodesCapacityRequest generates requests for GetNodesCapacity
+func NewGetNodesCapacityRequest(server string, params *GetNodesCapacityParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/action/dequeue", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/capacity")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3810,9 +4255,9 @@ func NewPostPeerActionDequeueRequest(server string, nodename InPathNodeName, par
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.SessionId != nil {
+		if params.Node != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "session_id", *params.SessionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "uuid"}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "node", *params.Node, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -3828,7 +4273,7 @@ func NewPostPeerActionDequeueRequest(server string, nodename InPathNodeName, par
 		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3836,8 +4281,35 @@ func NewPostPeerActionDequeueRequest(server string, nodename InPathNodeName, par
 	return req, nil
 }
 
-// NewPostPeerActionDrainRequest generates requests for PostPeerActionDrain
-func NewPostPeerActionDrainRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewGetNodesInfoRequest generates requests for GetNodesInfo
+func NewGetNodesInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/node/info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPeerActionAbortRequest generates requests for PostPeerActionAbort
+func NewPostPeerActionAbortRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3852,7 +4324,7 @@ func NewPostPeerActionDrainRequest(server string, nodename InPathNodeName) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/action/drain", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/action/abort", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3870,8 +4342,8 @@ func NewPostPeerActionDrainRequest(server string, nodename InPathNodeName) (*htt
 	return req, nil
 }
 
-// NewPostPeerActionFreezeRequest generates requests for PostPeerActionFreeze
-func NewPostPeerActionFreezeRequest(server string, nodename InPathNodeName, params *PostPeerActionFreezeParams) (*http.Request, error) {
+// NewPostNodeActionClearRequest generates requests for PostNodeActionClear
+func NewPostNodeActionClearRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3886,7 +4358,7 @@ func NewPostPeerActionFreezeRequest(server string, nodename InPathNodeName, para
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/action/freeze", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/action/clear", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3896,8 +4368,137 @@ func NewPostPeerActionFreezeRequest(server string, nodename InPathNodeName, para
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPeerActionDequeueRequest generates requests for PostPeerActionDequeue
+func NewPostPeerActionDequeueRequest(server string, nodename InPathNodeName, params *PostPeerActionDequeueParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/node/name/%s/action/dequeue", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.SessionId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "session_id", *params.SessionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "uuid"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPeerActionDrainRequest generates requests for PostPeerActionDrain
+func NewPostPeerActionDrainRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/node/name/%s/action/drain", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPeerActionFreezeRequest generates requests for PostPeerActionFreeze
+func NewPostPeerActionFreezeRequest(server string, nodename InPathNodeName, params *PostPeerActionFreezeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/node/name/%s/action/freeze", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
 		// that are safe to round-trip through url.Values.Encode().
 		queryValues := queryURL.Query()
 		// rawQueryFragments collects pre-encoded query fragments from
@@ -4406,6 +5007,79 @@ func NewPostPeerActionUnfreezeRequest(server string, nodename InPathNodeName, pa
 	return req, nil
 }
 
+// NewGetNodeAuditLogRequest generates requests for GetNodeAuditLog
+func NewGetNodeAuditLogRequest(server string, nodename InPathNodeName, params *GetNodeAuditLogParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/node/name/%s/audit/log", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "since", *params.Since, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetNodeCapabilitiesRequest generates requests for GetNodeCapabilities
 func NewGetNodeCapabilitiesRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
@@ -5120,6 +5794,18 @@ func NewGetDaemonEventsRequest(server string, nodename InPathNodeName, params *G
 
 		}
 
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "since", *params.Since, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -5131,6 +5817,21 @@ func NewGetDaemonEventsRequest(server string, nodename InPathNodeName, params *G
 		return nil, err
 	}
 
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithOptions("simple", false, "Last-Event-ID", *params.LastEventID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "integer", Format: "uint64"})
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
 	return req, nil
 }
 
@@ -7338,8 +8039,8 @@ func NewPostInstanceActionPushResourceInfoRequest(server string, nodename InPath
 	return req, nil
 }
 
-// NewPostInstanceActionRestartRequest generates requests for PostInstanceActionRestart
-func NewPostInstanceActionRestartRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionRestartParams) (*http.Request, error) {
+// NewPostInstanceActionResizeRequest generates requests for PostInstanceActionResize
+func NewPostInstanceActionResizeRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionResizeParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7375,7 +8076,7 @@ func NewPostInstanceActionRestartRequest(server string, nodename InPathNodeName,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/restart", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/resize", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7394,21 +8095,17 @@ func NewPostInstanceActionRestartRequest(server string, nodename InPathNodeName,
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Slaves != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slaves", *params.Slaves, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "size", params.Size, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
 			}
-
 		}
 
-		if params.DisableRollback != nil {
+		if params.SessionId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "disable_rollback", *params.DisableRollback, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "session_id", *params.SessionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "uuid"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -7418,21 +8115,115 @@ func NewPostInstanceActionRestartRequest(server string, nodename InPathNodeName,
 
 		}
 
-		if params.Force != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "force", *params.Force, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-		if params.Master != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "master", *params.Master, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostInstanceActionRestartRequest generates requests for PostInstanceActionRestart
+func NewPostInstanceActionRestartRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionRestartParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/restart", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Slaves != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slaves", *params.Slaves, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.DisableRollback != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "disable_rollback", *params.DisableRollback, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "force", *params.Force, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Master != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "master", *params.Master, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -7920,8 +8711,8 @@ func NewPostInstanceActionShutdownRequest(server string, nodename InPathNodeName
 	return req, nil
 }
 
-// NewPostInstanceActionStartRequest generates requests for PostInstanceActionStart
-func NewPostInstanceActionStartRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartParams) (*http.Request, error) {
+// NewPostInstanceActionSnapshotCreateRequest generates requests for PostInstanceActionSnapshotCreate
+func NewPostInstanceActionSnapshotCreateRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotCreateParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7957,7 +8748,7 @@ func NewPostInstanceActionStartRequest(server string, nodename InPathNodeName, n
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/start", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/snapshot/create", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7976,21 +8767,17 @@ func NewPostInstanceActionStartRequest(server string, nodename InPathNodeName, n
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Slaves != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slaves", *params.Slaves, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
 			}
-
 		}
 
-		if params.DisableRollback != nil {
+		if params.SessionId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "disable_rollback", *params.DisableRollback, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "session_id", *params.SessionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "uuid"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -8000,93 +8787,87 @@ func NewPostInstanceActionStartRequest(server string, nodename InPathNodeName, n
 
 		}
 
-		if params.Force != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "force", *params.Force, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-		if params.Master != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "master", *params.Master, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.SessionId != nil {
+// NewPostInstanceActionSnapshotDeleteRequest generates requests for PostInstanceActionSnapshotDelete
+func NewPostInstanceActionSnapshotDeleteRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotDeleteParams) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "session_id", *params.SessionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "uuid"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Rid != nil {
+	var pathParam1 string
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", *params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam2 string
 
-		if params.Slave != nil {
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slave", *params.Slave, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	var pathParam3 string
 
-		}
+	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Subset != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "subset", *params.Subset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/snapshot/delete", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Tag != nil {
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tag", *params.Tag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
 			}
-
 		}
 
-		if params.To != nil {
+		if params.SessionId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "session_id", *params.SessionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "uuid"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -8110,8 +8891,8 @@ func NewPostInstanceActionStartRequest(server string, nodename InPathNodeName, n
 	return req, nil
 }
 
-// NewPostInstanceActionStartStandbyRequest generates requests for PostInstanceActionStartStandby
-func NewPostInstanceActionStartStandbyRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartStandbyParams) (*http.Request, error) {
+// NewPostInstanceActionSnapshotRollbackRequest generates requests for PostInstanceActionSnapshotRollback
+func NewPostInstanceActionSnapshotRollbackRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotRollbackParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8147,7 +8928,7 @@ func NewPostInstanceActionStartStandbyRequest(server string, nodename InPathNode
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/startstandby", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/snapshot/rollback", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8166,12 +8947,102 @@ func NewPostInstanceActionStartStandbyRequest(server string, nodename InPathNode
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Slaves != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slaves", *params.Slaves, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if params.SessionId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "session_id", *params.SessionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "uuid"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostInstanceActionStartRequest generates requests for PostInstanceActionStart
+func NewPostInstanceActionStartRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/start", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Slaves != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slaves", *params.Slaves, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
 					rawQueryFragments = append(rawQueryFragments, qp)
 				}
 			}
@@ -8300,8 +9171,8 @@ func NewPostInstanceActionStartStandbyRequest(server string, nodename InPathNode
 	return req, nil
 }
 
-// NewPostInstanceActionStatusRequest generates requests for PostInstanceActionStatus
-func NewPostInstanceActionStatusRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStatusParams) (*http.Request, error) {
+// NewPostInstanceActionStartStandbyRequest generates requests for PostInstanceActionStartStandby
+func NewPostInstanceActionStartStandbyRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartStandbyParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8337,7 +9208,7 @@ func NewPostInstanceActionStatusRequest(server string, nodename InPathNodeName,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/status", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/startstandby", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8356,9 +9227,9 @@ func NewPostInstanceActionStatusRequest(server string, nodename InPathNodeName,
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.SessionId != nil {
+		if params.Slaves != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "session_id", *params.SessionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "uuid"}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slaves", *params.Slaves, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -8368,79 +9239,9 @@ func NewPostInstanceActionStatusRequest(server string, nodename InPathNodeName,
 
 		}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewPostInstanceActionStopRequest generates requests for PostInstanceActionStop
-func NewPostInstanceActionStopRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStopParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/stop", pathParam0, pathParam1, pathParam2, pathParam3)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Slaves != nil {
+		if params.DisableRollback != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slaves", *params.Slaves, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "disable_rollback", *params.DisableRollback, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -8474,18 +9275,6 @@ func NewPostInstanceActionStopRequest(server string, nodename InPathNodeName, na
 
 		}
 
-		if params.MoveTo != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "move-to", *params.MoveTo, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
 		if params.SessionId != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "session_id", *params.SessionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "uuid"}); err != nil {
@@ -8572,8 +9361,8 @@ func NewPostInstanceActionStopRequest(server string, nodename InPathNodeName, na
 	return req, nil
 }
 
-// NewPostInstanceActionSyncIngestRequest generates requests for PostInstanceActionSyncIngest
-func NewPostInstanceActionSyncIngestRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSyncIngestParams) (*http.Request, error) {
+// NewPostInstanceActionStatusRequest generates requests for PostInstanceActionStatus
+func NewPostInstanceActionStatusRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStatusParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8609,7 +9398,7 @@ func NewPostInstanceActionSyncIngestRequest(server string, nodename InPathNodeNa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/sync/ingest", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/status", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8640,42 +9429,6 @@ func NewPostInstanceActionSyncIngestRequest(server string, nodename InPathNodeNa
 
 		}
 
-		if params.Rid != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", *params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Subset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "subset", *params.Subset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Tag != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tag", *params.Tag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -8690,8 +9443,8 @@ func NewPostInstanceActionSyncIngestRequest(server string, nodename InPathNodeNa
 	return req, nil
 }
 
-// NewPostInstanceActionUnfreezeRequest generates requests for PostInstanceActionUnfreeze
-func NewPostInstanceActionUnfreezeRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionUnfreezeParams) (*http.Request, error) {
+// NewPostInstanceActionStopRequest generates requests for PostInstanceActionStop
+func NewPostInstanceActionStopRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStopParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8727,7 +9480,7 @@ func NewPostInstanceActionUnfreezeRequest(server string, nodename InPathNodeName
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/unfreeze", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/stop", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8758,6 +9511,18 @@ func NewPostInstanceActionUnfreezeRequest(server string, nodename InPathNodeName
 
 		}
 
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "force", *params.Force, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if params.Master != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "master", *params.Master, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
@@ -8770,9 +9535,9 @@ func NewPostInstanceActionUnfreezeRequest(server string, nodename InPathNodeName
 
 		}
 
-		if params.Slave != nil {
+		if params.MoveTo != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slave", *params.Slave, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "move-to", *params.MoveTo, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -8794,6 +9559,66 @@ func NewPostInstanceActionUnfreezeRequest(server string, nodename InPathNodeName
 
 		}
 
+		if params.Rid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", *params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Slave != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slave", *params.Slave, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Subset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "subset", *params.Subset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tag", *params.Tag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -8808,8 +9633,8 @@ func NewPostInstanceActionUnfreezeRequest(server string, nodename InPathNodeName
 	return req, nil
 }
 
-// NewPostInstanceActionUnprovisionRequest generates requests for PostInstanceActionUnprovision
-func NewPostInstanceActionUnprovisionRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionUnprovisionParams) (*http.Request, error) {
+// NewPostInstanceActionSyncIngestRequest generates requests for PostInstanceActionSyncIngest
+func NewPostInstanceActionSyncIngestRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSyncIngestParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8845,7 +9670,7 @@ func NewPostInstanceActionUnprovisionRequest(server string, nodename InPathNodeN
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/unprovision", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/sync/ingest", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8864,54 +9689,6 @@ func NewPostInstanceActionUnprovisionRequest(server string, nodename InPathNodeN
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Slaves != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slaves", *params.Slaves, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Force != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "force", *params.Force, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Leader != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "leader", *params.Leader, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Master != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "master", *params.Master, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
 		if params.SessionId != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "session_id", *params.SessionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "uuid"}); err != nil {
@@ -8936,30 +9713,6 @@ func NewPostInstanceActionUnprovisionRequest(server string, nodename InPathNodeN
 
 		}
 
-		if params.StateOnly != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "state_only", *params.StateOnly, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Slave != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slave", *params.Slave, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
 		if params.Subset != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "subset", *params.Subset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
@@ -8984,18 +9737,6 @@ func NewPostInstanceActionUnprovisionRequest(server string, nodename InPathNodeN
 
 		}
 
-		if params.To != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -9010,8 +9751,8 @@ func NewPostInstanceActionUnprovisionRequest(server string, nodename InPathNodeN
 	return req, nil
 }
 
-// NewPostInstanceClearRequest generates requests for PostInstanceClear
-func NewPostInstanceClearRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewPostInstanceActionUnfreezeRequest generates requests for PostInstanceActionUnfreeze
+func NewPostInstanceActionUnfreezeRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionUnfreezeParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9047,7 +9788,7 @@ func NewPostInstanceClearRequest(server string, nodename InPathNodeName, namespa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/clear", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/unfreeze", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9057,62 +9798,70 @@ func NewPostInstanceClearRequest(server string, nodename InPathNodeName, namespa
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	return req, nil
-}
+		if params.Slaves != nil {
 
-// NewGetInstanceConfigFileRequest generates requests for GetInstanceConfigFile
-func NewGetInstanceConfigFileRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slaves", *params.Slaves, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
+		if params.Master != nil {
 
-	var pathParam1 string
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "master", *params.Master, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	var pathParam2 string
+		if params.Slave != nil {
 
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slave", *params.Slave, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	var pathParam3 string
+		}
 
-	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
+		if params.SessionId != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "session_id", *params.SessionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "uuid"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/config/file", pathParam0, pathParam1, pathParam2, pathParam3)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -9120,8 +9869,8 @@ func NewGetInstanceConfigFileRequest(server string, nodename InPathNodeName, nam
 	return req, nil
 }
 
-// NewPostInstanceResourceConsoleRequest generates requests for PostInstanceResourceConsole
-func NewPostInstanceResourceConsoleRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceResourceConsoleParams) (*http.Request, error) {
+// NewPostInstanceActionUnprovisionRequest generates requests for PostInstanceActionUnprovision
+func NewPostInstanceActionUnprovisionRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionUnprovisionParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9157,7 +9906,7 @@ func NewPostInstanceResourceConsoleRequest(server string, nodename InPathNodeNam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/console", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/action/unprovision", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9176,9 +9925,9 @@ func NewPostInstanceResourceConsoleRequest(server string, nodename InPathNodeNam
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Rid != nil {
+		if params.Slaves != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", *params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slaves", *params.Slaves, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -9188,9 +9937,9 @@ func NewPostInstanceResourceConsoleRequest(server string, nodename InPathNodeNam
 
 		}
 
-		if params.GreetTimeout != nil {
+		if params.Force != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "greet_timeout", *params.GreetTimeout, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "force", *params.Force, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -9200,9 +9949,105 @@ func NewPostInstanceResourceConsoleRequest(server string, nodename InPathNodeNam
 
 		}
 
-		if params.Seats != nil {
+		if params.Leader != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "seats", *params.Seats, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "leader", *params.Leader, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Master != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "master", *params.Master, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.SessionId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "session_id", *params.SessionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "uuid"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Rid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", *params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.StateOnly != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "state_only", *params.StateOnly, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Slave != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "slave", *params.Slave, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Subset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "subset", *params.Subset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tag", *params.Tag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -9226,8 +10071,8 @@ func NewPostInstanceResourceConsoleRequest(server string, nodename InPathNodeNam
 	return req, nil
 }
 
-// NewGetInstanceContainerLogRequest generates requests for GetInstanceContainerLog
-func NewGetInstanceContainerLogRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceContainerLogParams) (*http.Request, error) {
+// NewPostInstanceClearRequest generates requests for PostInstanceClear
+func NewPostInstanceClearRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9263,7 +10108,117 @@ func NewGetInstanceContainerLogRequest(server string, nodename InPathNodeName, n
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/container/log", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/clear", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInstanceConfigFileRequest generates requests for GetInstanceConfigFile
+func NewGetInstanceConfigFileRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/config/file", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostInstanceResourceConsoleRequest generates requests for PostInstanceResourceConsole
+func NewPostInstanceResourceConsoleRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceResourceConsoleParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/console", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9294,9 +10249,9 @@ func NewGetInstanceContainerLogRequest(server string, nodename InPathNodeName, n
 
 		}
 
-		if params.Follow != nil {
+		if params.GreetTimeout != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "follow", *params.Follow, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "greet_timeout", *params.GreetTimeout, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -9306,9 +10261,9 @@ func NewGetInstanceContainerLogRequest(server string, nodename InPathNodeName, n
 
 		}
 
-		if params.Lines != nil {
+		if params.Seats != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "lines", *params.Lines, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "seats", *params.Seats, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -9324,7 +10279,7 @@ func NewGetInstanceContainerLogRequest(server string, nodename InPathNodeName, n
 		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -9332,8 +10287,8 @@ func NewGetInstanceContainerLogRequest(server string, nodename InPathNodeName, n
 	return req, nil
 }
 
-// NewGetInstanceLogsRequest generates requests for GetInstanceLogs
-func NewGetInstanceLogsRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceLogsParams) (*http.Request, error) {
+// NewGetInstanceContainerLogRequest generates requests for GetInstanceContainerLog
+func NewGetInstanceContainerLogRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceContainerLogParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9369,7 +10324,7 @@ func NewGetInstanceLogsRequest(server string, nodename InPathNodeName, namespace
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/log", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/container/log", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9388,21 +10343,9 @@ func NewGetInstanceLogsRequest(server string, nodename InPathNodeName, namespace
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Filter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Grep != nil {
+		if params.Rid != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "grep", *params.Grep, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", *params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -9450,8 +10393,8 @@ func NewGetInstanceLogsRequest(server string, nodename InPathNodeName, namespace
 	return req, nil
 }
 
-// NewGetInstanceResourceFileRequest generates requests for GetInstanceResourceFile
-func NewGetInstanceResourceFileRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceResourceFileParams) (*http.Request, error) {
+// NewGetInstanceLogsRequest generates requests for GetInstanceLogs
+func NewGetInstanceLogsRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceLogsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9487,7 +10430,7 @@ func NewGetInstanceResourceFileRequest(server string, nodename InPathNodeName, n
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/resource/file", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/log", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9506,20 +10449,52 @@ func NewGetInstanceResourceFileRequest(server string, nodename InPathNodeName, n
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
 			}
+
 		}
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
+		if params.Grep != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "grep", *params.Grep, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Follow != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "follow", *params.Follow, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Lines != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "lines", *params.Lines, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
 			}
+
 		}
 
 		if encoded := queryValues.Encode(); encoded != "" {
@@ -9536,8 +10511,8 @@ func NewGetInstanceResourceFileRequest(server string, nodename InPathNodeName, n
 	return req, nil
 }
 
-// NewGetInstanceResourceInfoRequest generates requests for GetInstanceResourceInfo
-func NewGetInstanceResourceInfoRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewGetInstanceResourceFileRequest generates requests for GetInstanceResourceFile
+func NewGetInstanceResourceFileRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceResourceFileParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9573,7 +10548,7 @@ func NewGetInstanceResourceInfoRequest(server string, nodename InPathNodeName, n
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/resource/info", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/resource/file", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9583,6 +10558,37 @@ func NewGetInstanceResourceInfoRequest(server string, nodename InPathNodeName, n
 		return nil, err
 	}
 
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -9591,8 +10597,8 @@ func NewGetInstanceResourceInfoRequest(server string, nodename InPathNodeName, n
 	return req, nil
 }
 
-// NewGetInstanceScheduleRequest generates requests for GetInstanceSchedule
-func NewGetInstanceScheduleRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewGetInstanceResourceInfoRequest generates requests for GetInstanceResourceInfo
+func NewGetInstanceResourceInfoRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9628,7 +10634,7 @@ func NewGetInstanceScheduleRequest(server string, nodename InPathNodeName, names
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/schedule", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/resource/info", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9646,8 +10652,8 @@ func NewGetInstanceScheduleRequest(server string, nodename InPathNodeName, names
 	return req, nil
 }
 
-// NewPostInstanceStateFileRequestWithBody generates requests for PostInstanceStateFile with any type of body
-func NewPostInstanceStateFileRequestWithBody(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetInstanceScheduleRequest generates requests for GetInstanceSchedule
+func NewGetInstanceScheduleRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9683,7 +10689,7 @@ func NewPostInstanceStateFileRequestWithBody(server string, nodename InPathNodeN
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/state/file", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/schedule", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9693,18 +10699,16 @@ func NewPostInstanceStateFileRequestWithBody(server string, nodename InPathNodeN
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetNodeLogsRequest generates requests for GetNodeLogs
-func NewGetNodeLogsRequest(server string, nodename InPathNodeName, params *GetNodeLogsParams) (*http.Request, error) {
+// NewGetInstanceSnapshotsRequest generates requests for GetInstanceSnapshots
+func NewGetInstanceSnapshotsRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9714,111 +10718,23 @@ func NewGetNodeLogsRequest(server string, nodename InPathNodeName, params *GetNo
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/node/name/%s/log", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam1 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Filter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Grep != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "grep", *params.Grep, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Follow != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "follow", *params.Follow, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Lines != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "lines", *params.Lines, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Paths != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "paths", *params.Paths, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
+	var pathParam2 string
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewGetNodeMetricsRequest generates requests for GetNodeMetrics
-func NewGetNodeMetricsRequest(server string, nodename InPathNodeName) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	var pathParam3 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9828,7 +10744,7 @@ func NewGetNodeMetricsRequest(server string, nodename InPathNodeName) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/snapshot", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9846,8 +10762,8 @@ func NewGetNodeMetricsRequest(server string, nodename InPathNodeName) (*http.Req
 	return req, nil
 }
 
-// NewGetNodePingRequest generates requests for GetNodePing
-func NewGetNodePingRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewPostInstanceStateFileRequestWithBody generates requests for PostInstanceStateFile with any type of body
+func NewPostInstanceStateFileRequestWithBody(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9857,36 +10773,23 @@ func NewGetNodePingRequest(server string, nodename InPathNodeName) (*http.Reques
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/node/name/%s/ping", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam1 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewGetNodeScheduleRequest generates requests for GetNodeSchedule
-func NewGetNodeScheduleRequest(server string, nodename InPathNodeName) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	var pathParam3 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9896,7 +10799,7 @@ func NewGetNodeScheduleRequest(server string, nodename InPathNodeName) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/schedule", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/state/file", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9906,16 +10809,18 @@ func NewGetNodeScheduleRequest(server string, nodename InPathNodeName) (*http.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetNodeSSHHostkeysRequest generates requests for GetNodeSSHHostkeys
-func NewGetNodeSSHHostkeysRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewGetInstanceSyncDataRequest generates requests for GetInstanceSyncData
+func NewGetInstanceSyncDataRequest(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceSyncDataParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9925,36 +10830,23 @@ func NewGetNodeSSHHostkeysRequest(server string, nodename InPathNodeName) (*http
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/ssh/hostkeys", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	var pathParam2 string
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewGetNodeSSHKeyRequest generates requests for GetNodeSSHKey
-func NewGetNodeSSHKeyRequest(server string, nodename InPathNodeName) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	var pathParam3 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9964,7 +10856,7 @@ func NewGetNodeSSHKeyRequest(server string, nodename InPathNodeName) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/ssh/key", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/sync/data", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9974,6 +10866,29 @@ func NewGetNodeSSHKeyRequest(server string, nodename InPathNodeName) (*http.Requ
 		return nil, err
 	}
 
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -9982,8 +10897,8 @@ func NewGetNodeSSHKeyRequest(server string, nodename InPathNodeName) (*http.Requ
 	return req, nil
 }
 
-// NewPutNodeSSHTrustRequest generates requests for PutNodeSSHTrust
-func NewPutNodeSSHTrustRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewPostInstanceSyncDataRequestWithBody generates requests for PostInstanceSyncData with any type of body
+func NewPostInstanceSyncDataRequestWithBody(server string, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceSyncDataParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9993,12 +10908,33 @@ func NewPutNodeSSHTrustRequest(server string, nodename InPathNodeName) (*http.Re
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/ssh/trust", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/instance/path/%s/%s/%s/sync/data", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10008,16 +10944,49 @@ func NewPutNodeSSHTrustRequest(server string, nodename InPathNodeName) (*http.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), nil)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetNodeSystemDiskRequest generates requests for GetNodeSystemDisk
-func NewGetNodeSystemDiskRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewGetNodeLogsRequest generates requests for GetNodeLogs
+func NewGetNodeLogsRequest(server string, nodename InPathNodeName, params *GetNodeLogsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10032,7 +11001,7 @@ func NewGetNodeSystemDiskRequest(server string, nodename InPathNodeName) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/system/disk", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/log", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10042,6 +11011,81 @@ func NewGetNodeSystemDiskRequest(server string, nodename InPathNodeName) (*http.
 		return nil, err
 	}
 
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Grep != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "grep", *params.Grep, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Follow != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "follow", *params.Follow, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Lines != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "lines", *params.Lines, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Paths != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "paths", *params.Paths, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -10050,8 +11094,8 @@ func NewGetNodeSystemDiskRequest(server string, nodename InPathNodeName) (*http.
 	return req, nil
 }
 
-// NewGetNodeSystemGroupRequest generates requests for GetNodeSystemGroup
-func NewGetNodeSystemGroupRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewDeleteNodeMaintenanceRequest generates requests for DeleteNodeMaintenance
+func NewDeleteNodeMaintenanceRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10066,7 +11110,7 @@ func NewGetNodeSystemGroupRequest(server string, nodename InPathNodeName) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/system/group", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/maintenance", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10076,7 +11120,7 @@ func NewGetNodeSystemGroupRequest(server string, nodename InPathNodeName) (*http
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10084,8 +11128,19 @@ func NewGetNodeSystemGroupRequest(server string, nodename InPathNodeName) (*http
 	return req, nil
 }
 
-// NewGetNodeSystemHardwareRequest generates requests for GetNodeSystemHardware
-func NewGetNodeSystemHardwareRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewPostNodeMaintenanceRequest calls the generic PostNodeMaintenance builder with application/json body
+func NewPostNodeMaintenanceRequest(server string, nodename InPathNodeName, body PostNodeMaintenanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostNodeMaintenanceRequestWithBody(server, nodename, "application/json", bodyReader)
+}
+
+// NewPostNodeMaintenanceRequestWithBody generates requests for PostNodeMaintenance with any type of body
+func NewPostNodeMaintenanceRequestWithBody(server string, nodename InPathNodeName, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10100,7 +11155,7 @@ func NewGetNodeSystemHardwareRequest(server string, nodename InPathNodeName) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/system/hardware", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/maintenance", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10110,16 +11165,18 @@ func NewGetNodeSystemHardwareRequest(server string, nodename InPathNodeName) (*h
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetNodeSystemIPAddressRequest generates requests for GetNodeSystemIPAddress
-func NewGetNodeSystemIPAddressRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewGetNodeMetricsRequest generates requests for GetNodeMetrics
+func NewGetNodeMetricsRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10134,7 +11191,7 @@ func NewGetNodeSystemIPAddressRequest(server string, nodename InPathNodeName) (*
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/system/ipaddress", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/metrics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10152,8 +11209,8 @@ func NewGetNodeSystemIPAddressRequest(server string, nodename InPathNodeName) (*
 	return req, nil
 }
 
-// NewGetNodeSystemPackageRequest generates requests for GetNodeSystemPackage
-func NewGetNodeSystemPackageRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewGetNodePingRequest generates requests for GetNodePing
+func NewGetNodePingRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10168,7 +11225,7 @@ func NewGetNodeSystemPackageRequest(server string, nodename InPathNodeName) (*ht
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/system/package", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/ping", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10186,8 +11243,8 @@ func NewGetNodeSystemPackageRequest(server string, nodename InPathNodeName) (*ht
 	return req, nil
 }
 
-// NewGetNodeSystemPropertyRequest generates requests for GetNodeSystemProperty
-func NewGetNodeSystemPropertyRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewGetNodeScheduleRequest generates requests for GetNodeSchedule
+func NewGetNodeScheduleRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10202,7 +11259,7 @@ func NewGetNodeSystemPropertyRequest(server string, nodename InPathNodeName) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/system/property", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/schedule", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10220,8 +11277,8 @@ func NewGetNodeSystemPropertyRequest(server string, nodename InPathNodeName) (*h
 	return req, nil
 }
 
-// NewGetNodeSystemSANInitiatorRequest generates requests for GetNodeSystemSANInitiator
-func NewGetNodeSystemSANInitiatorRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewGetNodeSSHHostkeysRequest generates requests for GetNodeSSHHostkeys
+func NewGetNodeSSHHostkeysRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10236,7 +11293,7 @@ func NewGetNodeSystemSANInitiatorRequest(server string, nodename InPathNodeName)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/system/san/initiator", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/ssh/hostkeys", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10254,8 +11311,8 @@ func NewGetNodeSystemSANInitiatorRequest(server string, nodename InPathNodeName)
 	return req, nil
 }
 
-// NewGetNodeSystemSANPathRequest generates requests for GetNodeSystemSANPath
-func NewGetNodeSystemSANPathRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewGetNodeSSHKeyRequest generates requests for GetNodeSSHKey
+func NewGetNodeSSHKeyRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10270,7 +11327,7 @@ func NewGetNodeSystemSANPathRequest(server string, nodename InPathNodeName) (*ht
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/system/san/path", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/ssh/key", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10288,8 +11345,8 @@ func NewGetNodeSystemSANPathRequest(server string, nodename InPathNodeName) (*ht
 	return req, nil
 }
 
-// NewGetNodeSystemUserRequest generates requests for GetNodeSystemUser
-func NewGetNodeSystemUserRequest(server string, nodename InPathNodeName) (*http.Request, error) {
+// NewPutNodeSSHTrustRequest generates requests for PutNodeSSHTrust
+func NewPutNodeSSHTrustRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10304,7 +11361,7 @@ func NewGetNodeSystemUserRequest(server string, nodename InPathNodeName) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/node/name/%s/system/user", pathParam0)
+	operationPath := fmt.Sprintf("/api/node/name/%s/ssh/trust", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10314,7 +11371,7 @@ func NewGetNodeSystemUserRequest(server string, nodename InPathNodeName) (*http.
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10322,16 +11379,23 @@ func NewGetNodeSystemUserRequest(server string, nodename InPathNodeName) (*http.
 	return req, nil
 }
 
-// NewGetObjectsRequest generates requests for GetObjects
-func NewGetObjectsRequest(server string, params *GetObjectsParams) (*http.Request, error) {
+// NewGetNodeSystemDiskRequest generates requests for GetNodeSystemDisk
+func NewGetNodeSystemDiskRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object")
+	operationPath := fmt.Sprintf("/api/node/name/%s/system/disk", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10341,33 +11405,6 @@ func NewGetObjectsRequest(server string, params *GetObjectsParams) (*http.Reques
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Path != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", *params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -10376,16 +11413,23 @@ func NewGetObjectsRequest(server string, params *GetObjectsParams) (*http.Reques
 	return req, nil
 }
 
-// NewGetObjectPathsRequest generates requests for GetObjectPaths
-func NewGetObjectPathsRequest(server string, params *GetObjectPathsParams) (*http.Request, error) {
+// NewGetNodeSystemGroupRequest generates requests for GetNodeSystemGroup
+func NewGetNodeSystemGroupRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path")
+	operationPath := fmt.Sprintf("/api/node/name/%s/system/group", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10395,29 +11439,6 @@ func NewGetObjectPathsRequest(server string, params *GetObjectPathsParams) (*htt
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -10426,20 +11447,13 @@ func NewGetObjectPathsRequest(server string, params *GetObjectPathsParams) (*htt
 	return req, nil
 }
 
-// NewPostSvcDisableRequest generates requests for PostSvcDisable
-func NewPostSvcDisableRequest(server string, namespace InPathNamespace, name InPathName, params *PostSvcDisableParams) (*http.Request, error) {
+// NewGetNodeSystemHardwareRequest generates requests for GetNodeSystemHardware
+func NewGetNodeSystemHardwareRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10449,7 +11463,7 @@ func NewPostSvcDisableRequest(server string, namespace InPathNamespace, name InP
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/svc/%s/disable", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/node/name/%s/system/hardware", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10459,58 +11473,7 @@ func NewPostSvcDisableRequest(server string, namespace InPathNamespace, name InP
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Rid != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", *params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Subset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "subset", *params.Subset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Tag != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tag", *params.Tag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10518,20 +11481,13 @@ func NewPostSvcDisableRequest(server string, namespace InPathNamespace, name InP
 	return req, nil
 }
 
-// NewPostSvcEnableRequest generates requests for PostSvcEnable
-func NewPostSvcEnableRequest(server string, namespace InPathNamespace, name InPathName, params *PostSvcEnableParams) (*http.Request, error) {
+// NewGetNodeSystemIPAddressRequest generates requests for GetNodeSystemIPAddress
+func NewGetNodeSystemIPAddressRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10541,7 +11497,7 @@ func NewPostSvcEnableRequest(server string, namespace InPathNamespace, name InPa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/svc/%s/enable", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/node/name/%s/system/ipaddress", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10551,58 +11507,7 @@ func NewPostSvcEnableRequest(server string, namespace InPathNamespace, name InPa
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Rid != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", *params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Subset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "subset", *params.Subset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Tag != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tag", *params.Tag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10610,27 +11515,13 @@ func NewPostSvcEnableRequest(server string, namespace InPathNamespace, name InPa
 	return req, nil
 }
 
-// NewGetObjectRequest generates requests for GetObject
-func NewGetObjectRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewGetNodeSystemPackageRequest generates requests for GetNodeSystemPackage
+func NewGetNodeSystemPackageRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10640,7 +11531,7 @@ func NewGetObjectRequest(server string, namespace InPathNamespace, kind InPathKi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/node/name/%s/system/package", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10658,27 +11549,13 @@ func NewGetObjectRequest(server string, namespace InPathNamespace, kind InPathKi
 	return req, nil
 }
 
-// NewPostObjectActionAbortRequest generates requests for PostObjectActionAbort
-func NewPostObjectActionAbortRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewGetNodeSystemPropertyRequest generates requests for GetNodeSystemProperty
+func NewGetNodeSystemPropertyRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10688,7 +11565,7 @@ func NewPostObjectActionAbortRequest(server string, namespace InPathNamespace, k
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/abort", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/node/name/%s/system/property", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10698,7 +11575,7 @@ func NewPostObjectActionAbortRequest(server string, namespace InPathNamespace, k
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10706,37 +11583,23 @@ func NewPostObjectActionAbortRequest(server string, namespace InPathNamespace, k
 	return req, nil
 }
 
-// NewPostObjectActionDeleteRequest generates requests for PostObjectActionDelete
-func NewPostObjectActionDeleteRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewGetNodeSystemSANInitiatorRequest generates requests for GetNodeSystemSANInitiator
+func NewGetNodeSystemSANInitiatorRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/delete", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/node/name/%s/system/san/initiator", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10746,7 +11609,7 @@ func NewPostObjectActionDeleteRequest(server string, namespace InPathNamespace,
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10754,27 +11617,13 @@ func NewPostObjectActionDeleteRequest(server string, namespace InPathNamespace,
 	return req, nil
 }
 
-// NewPostObjectActionFreezeRequest generates requests for PostObjectActionFreeze
-func NewPostObjectActionFreezeRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewGetNodeSystemSANPathRequest generates requests for GetNodeSystemSANPath
+func NewGetNodeSystemSANPathRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10784,7 +11633,7 @@ func NewPostObjectActionFreezeRequest(server string, namespace InPathNamespace,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/freeze", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/node/name/%s/system/san/path", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10794,7 +11643,7 @@ func NewPostObjectActionFreezeRequest(server string, namespace InPathNamespace,
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10802,27 +11651,13 @@ func NewPostObjectActionFreezeRequest(server string, namespace InPathNamespace,
 	return req, nil
 }
 
-// NewPostObjectActionGivebackRequest generates requests for PostObjectActionGiveback
-func NewPostObjectActionGivebackRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewGetNodeSystemUserRequest generates requests for GetNodeSystemUser
+func NewGetNodeSystemUserRequest(server string, nodename InPathNodeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "nodename", nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10832,7 +11667,7 @@ func NewPostObjectActionGivebackRequest(server string, namespace InPathNamespace
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/giveback", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/node/name/%s/system/user", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10842,7 +11677,7 @@ func NewPostObjectActionGivebackRequest(server string, namespace InPathNamespace
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10850,37 +11685,16 @@ func NewPostObjectActionGivebackRequest(server string, namespace InPathNamespace
 	return req, nil
 }
 
-// NewPostObjectActionProvisionRequest generates requests for PostObjectActionProvision
-func NewPostObjectActionProvisionRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewGetObjectsRequest generates requests for GetObjects
+func NewGetObjectsRequest(server string, params *GetObjectsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/provision", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10890,55 +11704,34 @@ func NewPostObjectActionProvisionRequest(server string, namespace InPathNamespac
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewPostObjectActionPurgeRequest generates requests for PostObjectActionPurge
-func NewPostObjectActionPurgeRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
+		if params.Path != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", *params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/purge", pathParam0, pathParam1, pathParam2)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10946,48 +11739,27 @@ func NewPostObjectActionPurgeRequest(server string, namespace InPathNamespace, k
 	return req, nil
 }
 
-// NewPostObjectActionRestartRequest calls the generic PostObjectActionRestart builder with application/json body
-func NewPostObjectActionRestartRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, body PostObjectActionRestartJSONRequestBody) (*http.Request, error) {
+// NewPostObjectsActionRequest calls the generic PostObjectsAction builder with application/json body
+func NewPostObjectsActionRequest(server string, body PostObjectsActionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostObjectActionRestartRequestWithBody(server, namespace, kind, name, "application/json", bodyReader)
+	return NewPostObjectsActionRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostObjectActionRestartRequestWithBody generates requests for PostObjectActionRestart with any type of body
-func NewPostObjectActionRestartRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostObjectsActionRequestWithBody generates requests for PostObjectsAction with any type of body
+func NewPostObjectsActionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/restart", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/action")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11007,37 +11779,16 @@ func NewPostObjectActionRestartRequestWithBody(server string, namespace InPathNa
 	return req, nil
 }
 
-// NewPostObjectActionStartRequest generates requests for PostObjectActionStart
-func NewPostObjectActionStartRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewGetObjectPathsRequest generates requests for GetObjectPaths
+func NewGetObjectPathsRequest(server string, params *GetObjectPathsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/start", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11047,7 +11798,30 @@ func NewPostObjectActionStartRequest(server string, namespace InPathNamespace, k
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -11055,8 +11829,8 @@ func NewPostObjectActionStartRequest(server string, namespace InPathNamespace, k
 	return req, nil
 }
 
-// NewPostObjectActionStopRequest generates requests for PostObjectActionStop
-func NewPostObjectActionStopRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewPostSvcDisableRequest generates requests for PostSvcDisable
+func NewPostSvcDisableRequest(server string, namespace InPathNamespace, name InPathName, params *PostSvcDisableParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11068,14 +11842,7 @@ func NewPostObjectActionStopRequest(server string, namespace InPathNamespace, ki
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11085,7 +11852,7 @@ func NewPostObjectActionStopRequest(server string, namespace InPathNamespace, ki
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/stop", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/svc/%s/disable", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11095,27 +11862,67 @@ func NewPostObjectActionStopRequest(server string, namespace InPathNamespace, ki
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewPostObjectActionSwitchRequest calls the generic PostObjectActionSwitch builder with application/json body
-func NewPostObjectActionSwitchRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, body PostObjectActionSwitchJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Rid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", *params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Subset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "subset", *params.Subset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tag", *params.Tag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostObjectActionSwitchRequestWithBody(server, namespace, kind, name, "application/json", bodyReader)
+
+	return req, nil
 }
 
-// NewPostObjectActionSwitchRequestWithBody generates requests for PostObjectActionSwitch with any type of body
-func NewPostObjectActionSwitchRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostSvcEnableRequest generates requests for PostSvcEnable
+func NewPostSvcEnableRequest(server string, namespace InPathNamespace, name InPathName, params *PostSvcEnableParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11127,14 +11934,7 @@ func NewPostObjectActionSwitchRequestWithBody(server string, namespace InPathNam
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11144,7 +11944,7 @@ func NewPostObjectActionSwitchRequestWithBody(server string, namespace InPathNam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/switch", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/svc/%s/enable", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11154,18 +11954,67 @@ func NewPostObjectActionSwitchRequestWithBody(server string, namespace InPathNam
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Rid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rid", *params.Rid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Subset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "subset", *params.Subset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tag", *params.Tag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPostObjectActionUnfreezeRequest generates requests for PostObjectActionUnfreeze
-func NewPostObjectActionUnfreezeRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewGetObjectRequest generates requests for GetObject
+func NewGetObjectRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11194,7 +12043,7 @@ func NewPostObjectActionUnfreezeRequest(server string, namespace InPathNamespace
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/unfreeze", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11204,7 +12053,7 @@ func NewPostObjectActionUnfreezeRequest(server string, namespace InPathNamespace
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -11212,8 +12061,8 @@ func NewPostObjectActionUnfreezeRequest(server string, namespace InPathNamespace
 	return req, nil
 }
 
-// NewPostObjectActionUnprovisionRequest generates requests for PostObjectActionUnprovision
-func NewPostObjectActionUnprovisionRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewPostObjectActionAbortRequest generates requests for PostObjectActionAbort
+func NewPostObjectActionAbortRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11242,7 +12091,7 @@ func NewPostObjectActionUnprovisionRequest(server string, namespace InPathNamesp
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/unprovision", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/abort", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11260,8 +12109,8 @@ func NewPostObjectActionUnprovisionRequest(server string, namespace InPathNamesp
 	return req, nil
 }
 
-// NewGetObjectConfigRequest generates requests for GetObjectConfig
-func NewGetObjectConfigRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectConfigParams) (*http.Request, error) {
+// NewPostObjectActionDeleteRequest generates requests for PostObjectActionDelete
+func NewPostObjectActionDeleteRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11290,7 +12139,7 @@ func NewGetObjectConfigRequest(server string, namespace InPathNamespace, kind In
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/config", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/delete", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11300,58 +12149,55 @@ func NewGetObjectConfigRequest(server string, namespace InPathNamespace, kind In
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Evaluate != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "evaluate", *params.Evaluate, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+// NewPostObjectActionFreezeRequest generates requests for PostObjectActionFreeze
+func NewPostObjectActionFreezeRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.Impersonate != nil {
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "impersonate", *params.Impersonate, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	var pathParam1 string
 
-		}
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Kw != nil {
+	var pathParam2 string
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "kw", *params.Kw, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/freeze", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -11359,8 +12205,8 @@ func NewGetObjectConfigRequest(server string, namespace InPathNamespace, kind In
 	return req, nil
 }
 
-// NewPatchObjectConfigRequest generates requests for PatchObjectConfig
-func NewPatchObjectConfigRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *PatchObjectConfigParams) (*http.Request, error) {
+// NewPostObjectActionGivebackRequest generates requests for PostObjectActionGiveback
+func NewPostObjectActionGivebackRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11389,7 +12235,7 @@ func NewPatchObjectConfigRequest(server string, namespace InPathNamespace, kind
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/config", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/giveback", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11399,67 +12245,16 @@ func NewPatchObjectConfigRequest(server string, namespace InPathNamespace, kind
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Delete != nil {
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "delete", *params.Delete, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	return req, nil
+}
 
-		}
-
-		if params.Unset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "unset", *params.Unset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Set != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "set", *params.Set, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewGetObjectConfigFileRequest generates requests for GetObjectConfigFile
-func NewGetObjectConfigFileRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewPostObjectActionProvisionRequest generates requests for PostObjectActionProvision
+func NewPostObjectActionProvisionRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11488,7 +12283,7 @@ func NewGetObjectConfigFileRequest(server string, namespace InPathNamespace, kin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/config/file", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/provision", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11498,7 +12293,7 @@ func NewGetObjectConfigFileRequest(server string, namespace InPathNamespace, kin
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -11506,8 +12301,8 @@ func NewGetObjectConfigFileRequest(server string, namespace InPathNamespace, kin
 	return req, nil
 }
 
-// NewPostObjectConfigFileRequestWithBody generates requests for PostObjectConfigFile with any type of body
-func NewPostObjectConfigFileRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostObjectActionPurgeRequest generates requests for PostObjectActionPurge
+func NewPostObjectActionPurgeRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11536,7 +12331,7 @@ func NewPostObjectConfigFileRequestWithBody(server string, namespace InPathNames
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/config/file", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/purge", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11546,18 +12341,27 @@ func NewPostObjectConfigFileRequestWithBody(server string, namespace InPathNames
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPutObjectConfigFileRequestWithBody generates requests for PutObjectConfigFile with any type of body
-func NewPutObjectConfigFileRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostObjectActionRestartRequest calls the generic PostObjectActionRestart builder with application/json body
+func NewPostObjectActionRestartRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, body PostObjectActionRestartJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostObjectActionRestartRequestWithBody(server, namespace, kind, name, "application/json", bodyReader)
+}
+
+// NewPostObjectActionRestartRequestWithBody generates requests for PostObjectActionRestart with any type of body
+func NewPostObjectActionRestartRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11586,7 +12390,7 @@ func NewPutObjectConfigFileRequestWithBody(server string, namespace InPathNamesp
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/config/file", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/restart", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11596,7 +12400,7 @@ func NewPutObjectConfigFileRequestWithBody(server string, namespace InPathNamesp
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -11606,8 +12410,8 @@ func NewPutObjectConfigFileRequestWithBody(server string, namespace InPathNamesp
 	return req, nil
 }
 
-// NewGetObjectConfigKeywordsRequest generates requests for GetObjectConfigKeywords
-func NewGetObjectConfigKeywordsRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectConfigKeywordsParams) (*http.Request, error) {
+// NewPostObjectActionStartRequest generates requests for PostObjectActionStart
+func NewPostObjectActionStartRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11636,7 +12440,7 @@ func NewGetObjectConfigKeywordsRequest(server string, namespace InPathNamespace,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/config/keywords", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/start", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11646,58 +12450,7 @@ func NewGetObjectConfigKeywordsRequest(server string, namespace InPathNamespace,
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Driver != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "driver", *params.Driver, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Section != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "section", *params.Section, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Option != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "option", *params.Option, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -11705,8 +12458,8 @@ func NewGetObjectConfigKeywordsRequest(server string, namespace InPathNamespace,
 	return req, nil
 }
 
-// NewGetObjectDataRequest generates requests for GetObjectData
-func NewGetObjectDataRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectDataParams) (*http.Request, error) {
+// NewPostObjectActionStopRequest generates requests for PostObjectActionStop
+func NewPostObjectActionStopRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11735,7 +12488,7 @@ func NewGetObjectDataRequest(server string, namespace InPathNamespace, kind InPa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/stop", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11745,34 +12498,7 @@ func NewGetObjectDataRequest(server string, namespace InPathNamespace, kind InPa
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Names != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Names, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -11780,19 +12506,19 @@ func NewGetObjectDataRequest(server string, namespace InPathNamespace, kind InPa
 	return req, nil
 }
 
-// NewPatchObjectDataRequest calls the generic PatchObjectData builder with application/json body
-func NewPatchObjectDataRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, body PatchObjectDataJSONRequestBody) (*http.Request, error) {
+// NewPostObjectActionSwitchRequest calls the generic PostObjectActionSwitch builder with application/json body
+func NewPostObjectActionSwitchRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, body PostObjectActionSwitchJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchObjectDataRequestWithBody(server, namespace, kind, name, "application/json", bodyReader)
+	return NewPostObjectActionSwitchRequestWithBody(server, namespace, kind, name, "application/json", bodyReader)
 }
 
-// NewPatchObjectDataRequestWithBody generates requests for PatchObjectData with any type of body
-func NewPatchObjectDataRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostObjectActionSwitchRequestWithBody generates requests for PostObjectActionSwitch with any type of body
+func NewPostObjectActionSwitchRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11821,7 +12547,7 @@ func NewPatchObjectDataRequestWithBody(server string, namespace InPathNamespace,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/switch", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11831,7 +12557,7 @@ func NewPatchObjectDataRequestWithBody(server string, namespace InPathNamespace,
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -11841,8 +12567,8 @@ func NewPatchObjectDataRequestWithBody(server string, namespace InPathNamespace,
 	return req, nil
 }
 
-// NewDeleteObjectDataKeyRequest generates requests for DeleteObjectDataKey
-func NewDeleteObjectDataKeyRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *DeleteObjectDataKeyParams) (*http.Request, error) {
+// NewPostObjectActionUnfreezeRequest generates requests for PostObjectActionUnfreeze
+func NewPostObjectActionUnfreezeRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11871,7 +12597,7 @@ func NewDeleteObjectDataKeyRequest(server string, namespace InPathNamespace, kin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data/key", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/unfreeze", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11881,30 +12607,7 @@ func NewDeleteObjectDataKeyRequest(server string, namespace InPathNamespace, kin
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -11912,8 +12615,8 @@ func NewDeleteObjectDataKeyRequest(server string, namespace InPathNamespace, kin
 	return req, nil
 }
 
-// NewGetObjectDataKeyRequest generates requests for GetObjectDataKey
-func NewGetObjectDataKeyRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectDataKeyParams) (*http.Request, error) {
+// NewPostObjectActionUnprovisionRequest generates requests for PostObjectActionUnprovision
+func NewPostObjectActionUnprovisionRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11942,7 +12645,7 @@ func NewGetObjectDataKeyRequest(server string, namespace InPathNamespace, kind I
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data/key", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/action/unprovision", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11952,30 +12655,7 @@ func NewGetObjectDataKeyRequest(server string, namespace InPathNamespace, kind I
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -11983,8 +12663,8 @@ func NewGetObjectDataKeyRequest(server string, namespace InPathNamespace, kind I
 	return req, nil
 }
 
-// NewPostObjectDataKeyRequestWithBody generates requests for PostObjectDataKey with any type of body
-func NewPostObjectDataKeyRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostObjectDataKeyParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetObjectConfigRequest generates requests for GetObjectConfig
+func NewGetObjectConfigRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectConfigParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -12013,7 +12693,7 @@ func NewPostObjectDataKeyRequestWithBody(server string, namespace InPathNamespac
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data/key", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/config", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12032,12 +12712,40 @@ func NewPostObjectDataKeyRequestWithBody(server string, namespace InPathNamespac
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
+		if params.Evaluate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "evaluate", *params.Evaluate, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Impersonate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "impersonate", *params.Impersonate, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Kw != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "kw", *params.Kw, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
 			}
+
 		}
 
 		if encoded := queryValues.Encode(); encoded != "" {
@@ -12046,18 +12754,16 @@ func NewPostObjectDataKeyRequestWithBody(server string, namespace InPathNamespac
 		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPutObjectDataKeyRequestWithBody generates requests for PutObjectDataKey with any type of body
-func NewPutObjectDataKeyRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *PutObjectDataKeyParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchObjectConfigRequest generates requests for PatchObjectConfig
+func NewPatchObjectConfigRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *PatchObjectConfigParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -12086,7 +12792,7 @@ func NewPutObjectDataKeyRequestWithBody(server string, namespace InPathNamespace
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data/key", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/config", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12105,12 +12811,40 @@ func NewPutObjectDataKeyRequestWithBody(server string, namespace InPathNamespace
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
+		if params.Delete != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "delete", *params.Delete, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Unset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "unset", *params.Unset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Set != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "set", *params.Set, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
 			}
+
 		}
 
 		if encoded := queryValues.Encode(); encoded != "" {
@@ -12119,18 +12853,16 @@ func NewPutObjectDataKeyRequestWithBody(server string, namespace InPathNamespace
 		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetObjectDataKeysRequest generates requests for GetObjectDataKeys
-func NewGetObjectDataKeysRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewGetObjectConfigFileRequest generates requests for GetObjectConfigFile
+func NewGetObjectConfigFileRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -12159,7 +12891,7 @@ func NewGetObjectDataKeysRequest(server string, namespace InPathNamespace, kind
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data/keys", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/config/file", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12177,8 +12909,8 @@ func NewGetObjectDataKeysRequest(server string, namespace InPathNamespace, kind
 	return req, nil
 }
 
-// NewGetObjectResourceInfoRequest generates requests for GetObjectResourceInfo
-func NewGetObjectResourceInfoRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewPostObjectConfigFileRequestWithBody generates requests for PostObjectConfigFile with any type of body
+func NewPostObjectConfigFileRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -12207,7 +12939,7 @@ func NewGetObjectResourceInfoRequest(server string, namespace InPathNamespace, k
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/resource/info", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/config/file", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12217,16 +12949,18 @@ func NewGetObjectResourceInfoRequest(server string, namespace InPathNamespace, k
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetObjectScheduleRequest generates requests for GetObjectSchedule
-func NewGetObjectScheduleRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+// NewPutObjectConfigFileRequestWithBody generates requests for PutObjectConfigFile with any type of body
+func NewPutObjectConfigFileRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -12255,7 +12989,7 @@ func NewGetObjectScheduleRequest(server string, namespace InPathNamespace, kind
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/schedule", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/config/file", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12265,51 +12999,47 @@ func NewGetObjectScheduleRequest(server string, namespace InPathNamespace, kind
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetSwaggerRequest generates requests for GetSwagger
-func NewGetSwaggerRequest(server string) (*http.Request, error) {
+// NewGetObjectConfigKeywordsRequest generates requests for GetObjectConfigKeywords
+func NewGetObjectConfigKeywordsRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectConfigKeywordsParams) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/openapi")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam1 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewGetPoolsRequest generates requests for GetPools
-func NewGetPoolsRequest(server string, params *GetPoolsParams) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/pool")
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/config/keywords", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12328,9 +13058,9 @@ func NewGetPoolsRequest(server string, params *GetPoolsParams) (*http.Request, e
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Name != nil {
+		if params.Driver != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "driver", *params.Driver, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -12340,9 +13070,21 @@ func NewGetPoolsRequest(server string, params *GetPoolsParams) (*http.Request, e
 
 		}
 
-		if params.Node != nil {
+		if params.Section != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "node", *params.Node, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "section", *params.Section, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Option != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "option", *params.Option, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -12366,70 +13108,37 @@ func NewGetPoolsRequest(server string, params *GetPoolsParams) (*http.Request, e
 	return req, nil
 }
 
-// NewGetPoolVolumesRequest generates requests for GetPoolVolumes
-func NewGetPoolVolumesRequest(server string, params *GetPoolVolumesParams) (*http.Request, error) {
+// NewGetObjectDataRequest generates requests for GetObjectData
+func NewGetObjectDataRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectDataParams) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/pool/volume")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam1 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Name != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
+	var pathParam2 string
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewGetRelayMessageRequest generates requests for GetRelayMessage
-func NewGetRelayMessageRequest(server string, params *GetRelayMessageParams) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/relay/message")
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12448,25 +13157,9 @@ func NewGetRelayMessageRequest(server string, params *GetRelayMessageParams) (*h
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "nodename", params.Nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cluster_id", params.ClusterID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if params.Username != nil {
+		if params.Names != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "username", *params.Username, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Names, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -12490,37 +13183,58 @@ func NewGetRelayMessageRequest(server string, params *GetRelayMessageParams) (*h
 	return req, nil
 }
 
-// NewPostRelayMessageRequest calls the generic PostRelayMessage builder with application/json body
-func NewPostRelayMessageRequest(server string, body PostRelayMessageJSONRequestBody) (*http.Request, error) {
+// NewPatchObjectDataRequest calls the generic PatchObjectData builder with application/json body
+func NewPatchObjectDataRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, body PatchObjectDataJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostRelayMessageRequestWithBody(server, "application/json", bodyReader)
+	return NewPatchObjectDataRequestWithBody(server, namespace, kind, name, "application/json", bodyReader)
 }
 
-// NewPostRelayMessageRequestWithBody generates requests for PostRelayMessage with any type of body
-func NewPostRelayMessageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchObjectDataRequestWithBody generates requests for PatchObjectData with any type of body
+func NewPatchObjectDataRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/relay/message")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam1 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -12530,16 +13244,37 @@ func NewPostRelayMessageRequestWithBody(server string, contentType string, body
 	return req, nil
 }
 
-// NewGetRelayStatusRequest generates requests for GetRelayStatus
-func NewGetRelayStatusRequest(server string, params *GetRelayStatusParams) (*http.Request, error) {
+// NewDeleteObjectDataKeyRequest generates requests for DeleteObjectDataKey
+func NewDeleteObjectDataKeyRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *DeleteObjectDataKeyParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/relay/status")
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data/key", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12558,28 +13293,12 @@ func NewGetRelayStatusRequest(server string, params *GetRelayStatusParams) (*htt
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Relays != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "relay", *params.Relays, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Remote != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "remote", *params.Remote, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
 			}
-
 		}
 
 		if encoded := queryValues.Encode(); encoded != "" {
@@ -12588,7 +13307,7 @@ func NewGetRelayStatusRequest(server string, params *GetRelayStatusParams) (*htt
 		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -12596,16 +13315,37 @@ func NewGetRelayStatusRequest(server string, params *GetRelayStatusParams) (*htt
 	return req, nil
 }
 
-// NewGetResourcesRequest generates requests for GetResources
-func NewGetResourcesRequest(server string, params *GetResourcesParams) (*http.Request, error) {
+// NewGetObjectDataKeyRequest generates requests for GetObjectDataKey
+func NewGetObjectDataKeyRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectDataKeyParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/resource")
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data/key", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12624,40 +13364,12 @@ func NewGetResourcesRequest(server string, params *GetResourcesParams) (*http.Re
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Path != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", *params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Node != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "node", *params.Node, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Resource != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "resource", *params.Resource, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
 			}
-
 		}
 
 		if encoded := queryValues.Encode(); encoded != "" {
@@ -12674,699 +13386,2557 @@ func NewGetResourcesRequest(server string, params *GetResourcesParams) (*http.Re
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewPostObjectDataKeyRequestWithBody generates requests for PostObjectDataKey with any type of body
+func NewPostObjectDataKeyRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostObjectDataKeyParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam2 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetArrayWithResponse request
-	GetArrayWithResponse(ctx context.Context, params *GetArrayParams, reqEditors ...RequestEditorFn) (*GetArrayResponse, error)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data/key", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetAuthInfoWithResponse request
-	GetAuthInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthInfoResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostAuthRefreshWithResponse request
-	PostAuthRefreshWithResponse(ctx context.Context, params *PostAuthRefreshParams, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// PostAuthTokenWithResponse request
-	PostAuthTokenWithResponse(ctx context.Context, params *PostAuthTokenParams, reqEditors ...RequestEditorFn) (*PostAuthTokenResponse, error)
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
 
-	// GetAuthWhoAmIWithResponse request
-	GetAuthWhoAmIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthWhoAmIResponse, error)
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-	// PostClusterActionAbortWithResponse request
-	PostClusterActionAbortWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterActionAbortResponse, error)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostClusterActionFreezeWithResponse request
-	PostClusterActionFreezeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterActionFreezeResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// PostClusterActionUnfreezeWithResponse request
-	PostClusterActionUnfreezeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterActionUnfreezeResponse, error)
+	return req, nil
+}
 
-	// GetClusterConfigWithResponse request
-	GetClusterConfigWithResponse(ctx context.Context, params *GetClusterConfigParams, reqEditors ...RequestEditorFn) (*GetClusterConfigResponse, error)
+// NewPutObjectDataKeyRequestWithBody generates requests for PutObjectDataKey with any type of body
+func NewPutObjectDataKeyRequestWithBody(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *PutObjectDataKeyParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// PatchClusterConfigWithResponse request
-	PatchClusterConfigWithResponse(ctx context.Context, params *PatchClusterConfigParams, reqEditors ...RequestEditorFn) (*PatchClusterConfigResponse, error)
+	var pathParam0 string
 
-	// GetClusterConfigFileWithResponse request
-	GetClusterConfigFileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClusterConfigFileResponse, error)
-
-	// PutClusterConfigFileWithBodyWithResponse request with any body
-	PutClusterConfigFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutClusterConfigFileResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// GetClusterConfigKeywordsWithResponse request
-	GetClusterConfigKeywordsWithResponse(ctx context.Context, params *GetClusterConfigKeywordsParams, reqEditors ...RequestEditorFn) (*GetClusterConfigKeywordsResponse, error)
+	var pathParam1 string
 
-	// PostClusterHeartbeatRotateWithResponse request
-	PostClusterHeartbeatRotateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterHeartbeatRotateResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostClusterJoinWithResponse request
-	PostClusterJoinWithResponse(ctx context.Context, params *PostClusterJoinParams, reqEditors ...RequestEditorFn) (*PostClusterJoinResponse, error)
+	var pathParam2 string
 
-	// PostClusterLeaveWithResponse request
-	PostClusterLeaveWithResponse(ctx context.Context, params *PostClusterLeaveParams, reqEditors ...RequestEditorFn) (*PostClusterLeaveResponse, error)
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// GetClusterStatusWithResponse request
-	GetClusterStatusWithResponse(ctx context.Context, params *GetClusterStatusParams, reqEditors ...RequestEditorFn) (*GetClusterStatusResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetInstancesWithResponse request
-	GetInstancesWithResponse(ctx context.Context, params *GetInstancesParams, reqEditors ...RequestEditorFn) (*GetInstancesResponse, error)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data/key", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostInstanceProgressWithBodyWithResponse request with any body
-	PostInstanceProgressWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceProgressResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PostInstanceProgressWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostInstanceProgressJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInstanceProgressResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// PostInstanceStatusWithBodyWithResponse request with any body
-	PostInstanceStatusWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceStatusResponse, error)
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
 
-	PostInstanceStatusWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostInstanceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInstanceStatusResponse, error)
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-	// GetNetworksWithResponse request
-	GetNetworksWithResponse(ctx context.Context, params *GetNetworksParams, reqEditors ...RequestEditorFn) (*GetNetworksResponse, error)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetNetworkIPWithResponse request
-	GetNetworkIPWithResponse(ctx context.Context, params *GetNetworkIPParams, reqEditors ...RequestEditorFn) (*GetNetworkIPResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetNodesWithResponse request
-	GetNodesWithResponse(ctx context.Context, params *GetNodesParams, reqEditors ...RequestEditorFn) (*GetNodesResponse, error)
+	return req, nil
+}
 
-	// GetNodesInfoWithResponse request
-	GetNodesInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNodesInfoResponse, error)
+// NewGetObjectDataKeysRequest generates requests for GetObjectDataKeys
+func NewGetObjectDataKeysRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+	var err error
 
-	// PostPeerActionAbortWithResponse request
-	PostPeerActionAbortWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostPeerActionAbortResponse, error)
+	var pathParam0 string
 
-	// PostNodeActionClearWithResponse request
-	PostNodeActionClearWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostNodeActionClearResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostPeerActionDequeueWithResponse request
-	PostPeerActionDequeueWithResponse(ctx context.Context, nodename InPathNodeName, params *PostPeerActionDequeueParams, reqEditors ...RequestEditorFn) (*PostPeerActionDequeueResponse, error)
+	var pathParam1 string
 
-	// PostPeerActionDrainWithResponse request
-	PostPeerActionDrainWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostPeerActionDrainResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostPeerActionFreezeWithResponse request
-	PostPeerActionFreezeWithResponse(ctx context.Context, nodename InPathNodeName, params *PostPeerActionFreezeParams, reqEditors ...RequestEditorFn) (*PostPeerActionFreezeResponse, error)
+	var pathParam2 string
 
-	// PostNodeActionPushAssetWithResponse request
-	PostNodeActionPushAssetWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionPushAssetParams, reqEditors ...RequestEditorFn) (*PostNodeActionPushAssetResponse, error)
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostNodeActionPushDiskWithResponse request
-	PostNodeActionPushDiskWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionPushDiskParams, reqEditors ...RequestEditorFn) (*PostNodeActionPushDiskResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostNodeActionPushPkgWithResponse request
-	PostNodeActionPushPkgWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionPushPkgParams, reqEditors ...RequestEditorFn) (*PostNodeActionPushPkgResponse, error)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/data/keys", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostNodeActionScanCapabilitiesWithResponse request
-	PostNodeActionScanCapabilitiesWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionScanCapabilitiesParams, reqEditors ...RequestEditorFn) (*PostNodeActionScanCapabilitiesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostNodeActionSCSIScanWithResponse request
-	PostNodeActionSCSIScanWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionSCSIScanParams, reqEditors ...RequestEditorFn) (*PostNodeActionSCSIScanResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostNodeActionSysreportWithResponse request
-	PostNodeActionSysreportWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionSysreportParams, reqEditors ...RequestEditorFn) (*PostNodeActionSysreportResponse, error)
+	return req, nil
+}
 
-	// PostPeerActionUnfreezeWithResponse request
-	PostPeerActionUnfreezeWithResponse(ctx context.Context, nodename InPathNodeName, params *PostPeerActionUnfreezeParams, reqEditors ...RequestEditorFn) (*PostPeerActionUnfreezeResponse, error)
+// NewGetObjectResourceInfoRequest generates requests for GetObjectResourceInfo
+func NewGetObjectResourceInfoRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+	var err error
 
-	// GetNodeCapabilitiesWithResponse request
-	GetNodeCapabilitiesWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeCapabilitiesResponse, error)
+	var pathParam0 string
 
-	// GetNodeConfigWithResponse request
-	GetNodeConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeConfigParams, reqEditors ...RequestEditorFn) (*GetNodeConfigResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PatchNodeConfigWithResponse request
-	PatchNodeConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *PatchNodeConfigParams, reqEditors ...RequestEditorFn) (*PatchNodeConfigResponse, error)
+	var pathParam1 string
 
-	// GetNodeConfigFileWithResponse request
-	GetNodeConfigFileWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeConfigFileResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PutNodeConfigFileWithBodyWithResponse request with any body
-	PutNodeConfigFileWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutNodeConfigFileResponse, error)
+	var pathParam2 string
 
-	// GetNodeConfigKeywordsWithResponse request
-	GetNodeConfigKeywordsWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeConfigKeywordsParams, reqEditors ...RequestEditorFn) (*GetNodeConfigKeywordsResponse, error)
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostDaemonRestartWithResponse request
-	PostDaemonRestartWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostDaemonRestartResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostDaemonShutdownWithResponse request
-	PostDaemonShutdownWithResponse(ctx context.Context, nodename InPathNodeName, params *PostDaemonShutdownParams, reqEditors ...RequestEditorFn) (*PostDaemonShutdownResponse, error)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/resource/info", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostDaemonStopWithResponse request
-	PostDaemonStopWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostDaemonStopResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostDaemonAuditWithResponse request
-	PostDaemonAuditWithResponse(ctx context.Context, nodename InPathNodeName, params *PostDaemonAuditParams, reqEditors ...RequestEditorFn) (*PostDaemonAuditResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDaemonDNSDumpWithResponse request
-	GetDaemonDNSDumpWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetDaemonDNSDumpResponse, error)
+	return req, nil
+}
 
-	// GetDaemonEventsWithResponse request
-	GetDaemonEventsWithResponse(ctx context.Context, nodename InPathNodeName, params *GetDaemonEventsParams, reqEditors ...RequestEditorFn) (*GetDaemonEventsResponse, error)
+// NewGetObjectScheduleRequest generates requests for GetObjectSchedule
+func NewGetObjectScheduleRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+	var err error
 
-	// PostDaemonHeartbeatRestartWithResponse request
-	PostDaemonHeartbeatRestartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatRestartResponse, error)
+	var pathParam0 string
 
-	// PostDaemonHeartbeatSignWithResponse request
-	PostDaemonHeartbeatSignWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatSignResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostDaemonHeartbeatStartWithResponse request
-	PostDaemonHeartbeatStartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatStartResponse, error)
+	var pathParam1 string
 
-	// PostDaemonHeartbeatStopWithResponse request
-	PostDaemonHeartbeatStopWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatStopResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostDaemonHeartbeatWipeWithResponse request
-	PostDaemonHeartbeatWipeWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatWipeResponse, error)
+	var pathParam2 string
 
-	// PostDaemonListenerRestartWithResponse request
-	PostDaemonListenerRestartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, reqEditors ...RequestEditorFn) (*PostDaemonListenerRestartResponse, error)
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostDaemonListenerStartWithResponse request
-	PostDaemonListenerStartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, reqEditors ...RequestEditorFn) (*PostDaemonListenerStartResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostDaemonListenerStopWithResponse request
-	PostDaemonListenerStopWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, reqEditors ...RequestEditorFn) (*PostDaemonListenerStopResponse, error)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/schedule", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostDaemonListenerLogControlWithBodyWithResponse request with any body
-	PostDaemonListenerLogControlWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDaemonListenerLogControlResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PostDaemonListenerLogControlWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, body PostDaemonListenerLogControlJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDaemonListenerLogControlResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostDaemonLogControlWithBodyWithResponse request with any body
-	PostDaemonLogControlWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDaemonLogControlResponse, error)
-
-	PostDaemonLogControlWithResponse(ctx context.Context, nodename InPathNodeName, body PostDaemonLogControlJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDaemonLogControlResponse, error)
+	return req, nil
+}
 
-	// DeleteDaemonProcessWithResponse request
-	DeleteDaemonProcessWithResponse(ctx context.Context, nodename InPathNodeName, params *DeleteDaemonProcessParams, reqEditors ...RequestEditorFn) (*DeleteDaemonProcessResponse, error)
+// NewDeleteObjectTokenRequest generates requests for DeleteObjectToken
+func NewDeleteObjectTokenRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *DeleteObjectTokenParams) (*http.Request, error) {
+	var err error
 
-	// GetDaemonProcessWithResponse request
-	GetDaemonProcessWithResponse(ctx context.Context, nodename InPathNodeName, params *GetDaemonProcessParams, reqEditors ...RequestEditorFn) (*GetDaemonProcessResponse, error)
+	var pathParam0 string
 
-	// GetNodeDRBDAllocationWithResponse request
-	GetNodeDRBDAllocationWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeDRBDAllocationResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// GetNodeDRBDConfigWithResponse request
-	GetNodeDRBDConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeDRBDConfigParams, reqEditors ...RequestEditorFn) (*GetNodeDRBDConfigResponse, error)
+	var pathParam1 string
 
-	// PostNodeDRBDConfigWithBodyWithResponse request with any body
-	PostNodeDRBDConfigWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNodeDRBDConfigResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	PostNodeDRBDConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDConfigParams, body PostNodeDRBDConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNodeDRBDConfigResponse, error)
+	var pathParam2 string
 
-	// PostNodeDRBDConnectWithResponse request
-	PostNodeDRBDConnectWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDConnectParams, reqEditors ...RequestEditorFn) (*PostNodeDRBDConnectResponse, error)
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostNodeDRBDPrimaryWithResponse request
-	PostNodeDRBDPrimaryWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDPrimaryParams, reqEditors ...RequestEditorFn) (*PostNodeDRBDPrimaryResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostNodeDRBDSecondaryWithResponse request
-	PostNodeDRBDSecondaryWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDSecondaryParams, reqEditors ...RequestEditorFn) (*PostNodeDRBDSecondaryResponse, error)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/token", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetNodeDriverWithResponse request
-	GetNodeDriverWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeDriverResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetInstanceWithResponse request
-	GetInstanceWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// PostInstanceActionBootWithResponse request
-	PostInstanceActionBootWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionBootParams, reqEditors ...RequestEditorFn) (*PostInstanceActionBootResponse, error)
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
 
-	// PostInstanceActionDeleteWithResponse request
-	PostInstanceActionDeleteWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionDeleteParams, reqEditors ...RequestEditorFn) (*PostInstanceActionDeleteResponse, error)
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-	// PostInstanceActionFreezeWithResponse request
-	PostInstanceActionFreezeWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionFreezeParams, reqEditors ...RequestEditorFn) (*PostInstanceActionFreezeResponse, error)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostInstanceActionPGUpdateWithResponse request
-	PostInstanceActionPGUpdateWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPGUpdateParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPGUpdateResponse, error)
+	return req, nil
+}
 
-	// PostInstanceActionProvisionWithResponse request
-	PostInstanceActionProvisionWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionProvisionParams, reqEditors ...RequestEditorFn) (*PostInstanceActionProvisionResponse, error)
+// NewGetObjectTokensRequest generates requests for GetObjectTokens
+func NewGetObjectTokensRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName) (*http.Request, error) {
+	var err error
 
-	// PostInstanceActionPRStartWithResponse request
-	PostInstanceActionPRStartWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPRStartParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPRStartResponse, error)
+	var pathParam0 string
 
-	// PostInstanceActionPRStopWithResponse request
-	PostInstanceActionPRStopWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPRStopParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPRStopResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostInstanceActionPushResourceInfoWithResponse request
-	PostInstanceActionPushResourceInfoWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPushResourceInfoParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPushResourceInfoResponse, error)
+	var pathParam1 string
 
-	// PostInstanceActionRestartWithResponse request
-	PostInstanceActionRestartWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionRestartParams, reqEditors ...RequestEditorFn) (*PostInstanceActionRestartResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostInstanceActionRunWithResponse request
-	PostInstanceActionRunWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionRunParams, reqEditors ...RequestEditorFn) (*PostInstanceActionRunResponse, error)
+	var pathParam2 string
 
-	// PostInstanceActionShutdownWithResponse request
-	PostInstanceActionShutdownWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionShutdownParams, reqEditors ...RequestEditorFn) (*PostInstanceActionShutdownResponse, error)
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostInstanceActionStartWithResponse request
-	PostInstanceActionStartWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStartResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostInstanceActionStartStandbyWithResponse request
-	PostInstanceActionStartStandbyWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartStandbyParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStartStandbyResponse, error)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/token", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostInstanceActionStatusWithResponse request
-	PostInstanceActionStatusWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStatusParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStatusResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostInstanceActionStopWithResponse request
-	PostInstanceActionStopWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStopParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStopResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostInstanceActionSyncIngestWithResponse request
-	PostInstanceActionSyncIngestWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSyncIngestParams, reqEditors ...RequestEditorFn) (*PostInstanceActionSyncIngestResponse, error)
+	return req, nil
+}
 
-	// PostInstanceActionUnfreezeWithResponse request
-	PostInstanceActionUnfreezeWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionUnfreezeParams, reqEditors ...RequestEditorFn) (*PostInstanceActionUnfreezeResponse, error)
+// NewPostObjectTokenRequest generates requests for PostObjectToken
+func NewPostObjectTokenRequest(server string, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostObjectTokenParams) (*http.Request, error) {
+	var err error
 
-	// PostInstanceActionUnprovisionWithResponse request
-	PostInstanceActionUnprovisionWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionUnprovisionParams, reqEditors ...RequestEditorFn) (*PostInstanceActionUnprovisionResponse, error)
+	var pathParam0 string
 
-	// PostInstanceClearWithResponse request
-	PostInstanceClearWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostInstanceClearResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "namespace", namespace, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// GetInstanceConfigFileWithResponse request
-	GetInstanceConfigFileWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceConfigFileResponse, error)
+	var pathParam1 string
 
-	// PostInstanceResourceConsoleWithResponse request
-	PostInstanceResourceConsoleWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceResourceConsoleParams, reqEditors ...RequestEditorFn) (*PostInstanceResourceConsoleResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "kind", kind, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// GetInstanceContainerLogWithResponse request
-	GetInstanceContainerLogWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceContainerLogParams, reqEditors ...RequestEditorFn) (*GetInstanceContainerLogResponse, error)
+	var pathParam2 string
 
-	// GetInstanceLogsWithResponse request
-	GetInstanceLogsWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceLogsParams, reqEditors ...RequestEditorFn) (*GetInstanceLogsResponse, error)
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// GetInstanceResourceFileWithResponse request
-	GetInstanceResourceFileWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceResourceFileParams, reqEditors ...RequestEditorFn) (*GetInstanceResourceFileResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetInstanceResourceInfoWithResponse request
-	GetInstanceResourceInfoWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceResourceInfoResponse, error)
+	operationPath := fmt.Sprintf("/api/object/path/%s/%s/%s/token", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetInstanceScheduleWithResponse request
-	GetInstanceScheduleWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceScheduleResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostInstanceStateFileWithBodyWithResponse request with any body
-	PostInstanceStateFileWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceStateFileResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// GetNodeLogsWithResponse request
-	GetNodeLogsWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeLogsParams, reqEditors ...RequestEditorFn) (*GetNodeLogsResponse, error)
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
 
-	// GetNodeMetricsWithResponse request
-	GetNodeMetricsWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeMetricsResponse, error)
+		if params.Role != nil {
 
-	// GetNodePingWithResponse request
-	GetNodePingWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodePingResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "role", *params.Role, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// GetNodeScheduleWithResponse request
-	GetNodeScheduleWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeScheduleResponse, error)
+		}
 
-	// GetNodeSSHHostkeysWithResponse request
-	GetNodeSSHHostkeysWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSSHHostkeysResponse, error)
+		if params.Scope != nil {
 
-	// GetNodeSSHKeyWithResponse request
-	GetNodeSSHKeyWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSSHKeyResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "scope", *params.Scope, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// PutNodeSSHTrustWithResponse request
-	PutNodeSSHTrustWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PutNodeSSHTrustResponse, error)
+		}
 
-	// GetNodeSystemDiskWithResponse request
-	GetNodeSystemDiskWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemDiskResponse, error)
+		if params.Duration != nil {
 
-	// GetNodeSystemGroupWithResponse request
-	GetNodeSystemGroupWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemGroupResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "duration", *params.Duration, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// GetNodeSystemHardwareWithResponse request
-	GetNodeSystemHardwareWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemHardwareResponse, error)
+		}
 
-	// GetNodeSystemIPAddressWithResponse request
-	GetNodeSystemIPAddressWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemIPAddressResponse, error)
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-	// GetNodeSystemPackageWithResponse request
-	GetNodeSystemPackageWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemPackageResponse, error)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetNodeSystemPropertyWithResponse request
-	GetNodeSystemPropertyWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemPropertyResponse, error)
+	return req, nil
+}
 
-	// GetNodeSystemSANInitiatorWithResponse request
-	GetNodeSystemSANInitiatorWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemSANInitiatorResponse, error)
+// NewGetSwaggerRequest generates requests for GetSwagger
+func NewGetSwaggerRequest(server string) (*http.Request, error) {
+	var err error
 
-	// GetNodeSystemSANPathWithResponse request
-	GetNodeSystemSANPathWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemSANPathResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetNodeSystemUserWithResponse request
-	GetNodeSystemUserWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemUserResponse, error)
+	operationPath := fmt.Sprintf("/api/openapi")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetObjectsWithResponse request
-	GetObjectsWithResponse(ctx context.Context, params *GetObjectsParams, reqEditors ...RequestEditorFn) (*GetObjectsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetObjectPathsWithResponse request
-	GetObjectPathsWithResponse(ctx context.Context, params *GetObjectPathsParams, reqEditors ...RequestEditorFn) (*GetObjectPathsResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostSvcDisableWithResponse request
-	PostSvcDisableWithResponse(ctx context.Context, namespace InPathNamespace, name InPathName, params *PostSvcDisableParams, reqEditors ...RequestEditorFn) (*PostSvcDisableResponse, error)
+	return req, nil
+}
 
-	// PostSvcEnableWithResponse request
-	PostSvcEnableWithResponse(ctx context.Context, namespace InPathNamespace, name InPathName, params *PostSvcEnableParams, reqEditors ...RequestEditorFn) (*PostSvcEnableResponse, error)
+// NewGetPoolsRequest generates requests for GetPools
+func NewGetPoolsRequest(server string, params *GetPoolsParams) (*http.Request, error) {
+	var err error
 
-	// GetObjectWithResponse request
-	GetObjectWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostObjectActionAbortWithResponse request
-	PostObjectActionAbortWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionAbortResponse, error)
+	operationPath := fmt.Sprintf("/api/pool")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostObjectActionDeleteWithResponse request
-	PostObjectActionDeleteWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionDeleteResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostObjectActionFreezeWithResponse request
-	PostObjectActionFreezeWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionFreezeResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// PostObjectActionGivebackWithResponse request
-	PostObjectActionGivebackWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionGivebackResponse, error)
+		if params.Name != nil {
 
-	// PostObjectActionProvisionWithResponse request
-	PostObjectActionProvisionWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionProvisionResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// PostObjectActionPurgeWithResponse request
-	PostObjectActionPurgeWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionPurgeResponse, error)
+		}
 
-	// PostObjectActionRestartWithBodyWithResponse request with any body
-	PostObjectActionRestartWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectActionRestartResponse, error)
+		if params.Node != nil {
 
-	PostObjectActionRestartWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostObjectActionRestartJSONRequestBody, reqEditors ...RequestEditorFn) (*PostObjectActionRestartResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "node", *params.Node, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// PostObjectActionStartWithResponse request
-	PostObjectActionStartWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionStartResponse, error)
+		}
 
-	// PostObjectActionStopWithResponse request
-	PostObjectActionStopWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionStopResponse, error)
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-	// PostObjectActionSwitchWithBodyWithResponse request with any body
-	PostObjectActionSwitchWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectActionSwitchResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PostObjectActionSwitchWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostObjectActionSwitchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostObjectActionSwitchResponse, error)
+	return req, nil
+}
 
-	// PostObjectActionUnfreezeWithResponse request
-	PostObjectActionUnfreezeWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionUnfreezeResponse, error)
+// NewGetPoolVolumesRequest generates requests for GetPoolVolumes
+func NewGetPoolVolumesRequest(server string, params *GetPoolVolumesParams) (*http.Request, error) {
+	var err error
 
-	// PostObjectActionUnprovisionWithResponse request
-	PostObjectActionUnprovisionWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionUnprovisionResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetObjectConfigWithResponse request
-	GetObjectConfigWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectConfigParams, reqEditors ...RequestEditorFn) (*GetObjectConfigResponse, error)
+	operationPath := fmt.Sprintf("/api/pool/volume")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PatchObjectConfigWithResponse request
-	PatchObjectConfigWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PatchObjectConfigParams, reqEditors ...RequestEditorFn) (*PatchObjectConfigResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetObjectConfigFileWithResponse request
-	GetObjectConfigFileWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectConfigFileResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// PostObjectConfigFileWithBodyWithResponse request with any body
-	PostObjectConfigFileWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectConfigFileResponse, error)
+		if params.Name != nil {
 
-	// PutObjectConfigFileWithBodyWithResponse request with any body
-	PutObjectConfigFileWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutObjectConfigFileResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// GetObjectConfigKeywordsWithResponse request
-	GetObjectConfigKeywordsWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectConfigKeywordsParams, reqEditors ...RequestEditorFn) (*GetObjectConfigKeywordsResponse, error)
+		}
 
-	// GetObjectDataWithResponse request
-	GetObjectDataWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectDataParams, reqEditors ...RequestEditorFn) (*GetObjectDataResponse, error)
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-	// PatchObjectDataWithBodyWithResponse request with any body
-	PatchObjectDataWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchObjectDataResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PatchObjectDataWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PatchObjectDataJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchObjectDataResponse, error)
+	return req, nil
+}
 
-	// DeleteObjectDataKeyWithResponse request
-	DeleteObjectDataKeyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *DeleteObjectDataKeyParams, reqEditors ...RequestEditorFn) (*DeleteObjectDataKeyResponse, error)
+// NewGetRelayMessageRequest generates requests for GetRelayMessage
+func NewGetRelayMessageRequest(server string, params *GetRelayMessageParams) (*http.Request, error) {
+	var err error
 
-	// GetObjectDataKeyWithResponse request
-	GetObjectDataKeyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectDataKeyParams, reqEditors ...RequestEditorFn) (*GetObjectDataKeyResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostObjectDataKeyWithBodyWithResponse request with any body
-	PostObjectDataKeyWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostObjectDataKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectDataKeyResponse, error)
+	operationPath := fmt.Sprintf("/api/relay/message")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PutObjectDataKeyWithBodyWithResponse request with any body
-	PutObjectDataKeyWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PutObjectDataKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutObjectDataKeyResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetObjectDataKeysWithResponse request
-	GetObjectDataKeysWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectDataKeysResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// GetObjectResourceInfoWithResponse request
-	GetObjectResourceInfoWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectResourceInfoResponse, error)
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "nodename", params.Nodename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
 
-	// GetObjectScheduleWithResponse request
-	GetObjectScheduleWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectScheduleResponse, error)
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cluster_id", params.ClusterID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
 
-	// GetSwaggerWithResponse request
-	GetSwaggerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSwaggerResponse, error)
+		if params.Username != nil {
 
-	// GetPoolsWithResponse request
-	GetPoolsWithResponse(ctx context.Context, params *GetPoolsParams, reqEditors ...RequestEditorFn) (*GetPoolsResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "username", *params.Username, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// GetPoolVolumesWithResponse request
-	GetPoolVolumesWithResponse(ctx context.Context, params *GetPoolVolumesParams, reqEditors ...RequestEditorFn) (*GetPoolVolumesResponse, error)
-
-	// GetRelayMessageWithResponse request
-	GetRelayMessageWithResponse(ctx context.Context, params *GetRelayMessageParams, reqEditors ...RequestEditorFn) (*GetRelayMessageResponse, error)
-
-	// PostRelayMessageWithBodyWithResponse request with any body
-	PostRelayMessageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRelayMessageResponse, error)
-
-	PostRelayMessageWithResponse(ctx context.Context, body PostRelayMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRelayMessageResponse, error)
+		}
 
-	// GetRelayStatusWithResponse request
-	GetRelayStatusWithResponse(ctx context.Context, params *GetRelayStatusParams, reqEditors ...RequestEditorFn) (*GetRelayStatusResponse, error)
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-	// GetResourcesWithResponse request
-	GetResourcesWithResponse(ctx context.Context, params *GetResourcesParams, reqEditors ...RequestEditorFn) (*GetResourcesResponse, error)
-}
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-type GetArrayResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ArrayList
-	JSON401      *N401
-	JSON403      *N403
-	JSON500      *N500
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetArrayResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostRelayMessageRequest calls the generic PostRelayMessage builder with application/json body
+func NewPostRelayMessageRequest(server string, body PostRelayMessageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRelayMessageRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetArrayResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewPostRelayMessageRequestWithBody generates requests for PostRelayMessage with any type of body
+func NewPostRelayMessageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetArrayResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type GetAuthInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthInfo
-}
-
-// Status returns HTTPResponse.Status
-func (r GetAuthInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/relay/message")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAuthInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetAuthInfoResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type PostAuthRefreshResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthAccessToken
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON500      *N500
-	JSON503      *N503
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostAuthRefreshResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetRelayStatusRequest generates requests for GetRelayStatus
+func NewGetRelayStatusRequest(server string, params *GetRelayStatusParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthRefreshResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/relay/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostAuthRefreshResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type PostAuthTokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthToken
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON500      *N500
-	JSON503      *N503
-}
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-// Status returns HTTPResponse.Status
-func (r PostAuthTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Relays != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "relay", *params.Relays, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Remote != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "remote", *params.Remote, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostAuthTokenResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type GetAuthWhoAmIResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserIdentity
-	JSON401      *N401
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAuthWhoAmIResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetResourcesRequest generates requests for GetResources
+func NewGetResourcesRequest(server string, params *GetResourcesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAuthWhoAmIResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/resource")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetAuthWhoAmIResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type PostClusterActionAbortResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON408      *N408
-	JSON409      *N409
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Path != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", *params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Node != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "node", *params.Node, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Resource != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "resource", *params.Resource, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetArrayWithResponse request
+	GetArrayWithResponse(ctx context.Context, params *GetArrayParams, reqEditors ...RequestEditorFn) (*GetArrayResponse, error)
+
+	// GetAuthCanIWithResponse request
+	GetAuthCanIWithResponse(ctx context.Context, params *GetAuthCanIParams, reqEditors ...RequestEditorFn) (*GetAuthCanIResponse, error)
+
+	// GetAuthInfoWithResponse request
+	GetAuthInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthInfoResponse, error)
+
+	// PostAuthRefreshWithResponse request
+	PostAuthRefreshWithResponse(ctx context.Context, params *PostAuthRefreshParams, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error)
+
+	// PostAuthTokenWithResponse request
+	PostAuthTokenWithResponse(ctx context.Context, params *PostAuthTokenParams, reqEditors ...RequestEditorFn) (*PostAuthTokenResponse, error)
+
+	// GetAuthWhoAmIWithResponse request
+	GetAuthWhoAmIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthWhoAmIResponse, error)
+
+	// PostClusterActionAbortWithResponse request
+	PostClusterActionAbortWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterActionAbortResponse, error)
+
+	// PostClusterActionFreezeWithResponse request
+	PostClusterActionFreezeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterActionFreezeResponse, error)
+
+	// PostClusterActionUnfreezeWithResponse request
+	PostClusterActionUnfreezeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterActionUnfreezeResponse, error)
+
+	// GetClusterConfigWithResponse request
+	GetClusterConfigWithResponse(ctx context.Context, params *GetClusterConfigParams, reqEditors ...RequestEditorFn) (*GetClusterConfigResponse, error)
+
+	// PatchClusterConfigWithResponse request
+	PatchClusterConfigWithResponse(ctx context.Context, params *PatchClusterConfigParams, reqEditors ...RequestEditorFn) (*PatchClusterConfigResponse, error)
+
+	// GetClusterConfigFileWithResponse request
+	GetClusterConfigFileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClusterConfigFileResponse, error)
+
+	// PutClusterConfigFileWithBodyWithResponse request with any body
+	PutClusterConfigFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutClusterConfigFileResponse, error)
+
+	// GetClusterConfigKeywordsWithResponse request
+	GetClusterConfigKeywordsWithResponse(ctx context.Context, params *GetClusterConfigKeywordsParams, reqEditors ...RequestEditorFn) (*GetClusterConfigKeywordsResponse, error)
+
+	// PostClusterHeartbeatRotateWithResponse request
+	PostClusterHeartbeatRotateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterHeartbeatRotateResponse, error)
+
+	// PostClusterJoinWithResponse request
+	PostClusterJoinWithResponse(ctx context.Context, params *PostClusterJoinParams, reqEditors ...RequestEditorFn) (*PostClusterJoinResponse, error)
+
+	// PostClusterLeaveWithResponse request
+	PostClusterLeaveWithResponse(ctx context.Context, params *PostClusterLeaveParams, reqEditors ...RequestEditorFn) (*PostClusterLeaveResponse, error)
+
+	// GetClusterStatusWithResponse request
+	GetClusterStatusWithResponse(ctx context.Context, params *GetClusterStatusParams, reqEditors ...RequestEditorFn) (*GetClusterStatusResponse, error)
+
+	// GetInstancesWithResponse request
+	GetInstancesWithResponse(ctx context.Context, params *GetInstancesParams, reqEditors ...RequestEditorFn) (*GetInstancesResponse, error)
+
+	// PostInstanceProgressWithBodyWithResponse request with any body
+	PostInstanceProgressWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceProgressResponse, error)
+
+	PostInstanceProgressWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostInstanceProgressJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInstanceProgressResponse, error)
+
+	// PostInstanceStatusWithBodyWithResponse request with any body
+	PostInstanceStatusWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceStatusResponse, error)
+
+	PostInstanceStatusWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostInstanceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInstanceStatusResponse, error)
+
+	// GetJobsWithResponse request
+	GetJobsWithResponse(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*GetJobsResponse, error)
+
+	// GetJobWithResponse request
+	GetJobWithResponse(ctx context.Context, id InPathJobID, params *GetJobParams, reqEditors ...RequestEditorFn) (*GetJobResponse, error)
+
+	// PostJobCancelWithResponse request
+	PostJobCancelWithResponse(ctx context.Context, id InPathJobID, params *PostJobCancelParams, reqEditors ...RequestEditorFn) (*PostJobCancelResponse, error)
+
+	// GetNetworksWithResponse request
+	GetNetworksWithResponse(ctx context.Context, params *GetNetworksParams, reqEditors ...RequestEditorFn) (*GetNetworksResponse, error)
+
+	// GetNetworkIPWithResponse request
+	GetNetworkIPWithResponse(ctx context.Context, params *GetNetworkIPParams, reqEditors ...RequestEditorFn) (*GetNetworkIPResponse, error)
+
+	// GetNodesWithResponse request
+	GetNodesWithResponse(ctx context.Context, params *GetNodesParams, reqEditors ...RequestEditorFn) (*GetNodesResponse, error)
+
+	// GetNodesCapacityWithResponse request
+	GetNodesCapacityWithResponse(ctx context.Context, params *GetNodesCapacityParams, reqEditors ...RequestEditorFn) (*GetNodesCapacityResponse, error)
+
+	// GetNodesInfoWithResponse request
+	GetNodesInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNodesInfoResponse, error)
+
+	// PostPeerActionAbortWithResponse request
+	PostPeerActionAbortWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostPeerActionAbortResponse, error)
+
+	// PostNodeActionClearWithResponse request
+	PostNodeActionClearWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostNodeActionClearResponse, error)
+
+	// PostPeerActionDequeueWithResponse request
+	PostPeerActionDequeueWithResponse(ctx context.Context, nodename InPathNodeName, params *PostPeerActionDequeueParams, reqEditors ...RequestEditorFn) (*PostPeerActionDequeueResponse, error)
+
+	// PostPeerActionDrainWithResponse request
+	PostPeerActionDrainWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostPeerActionDrainResponse, error)
+
+	// PostPeerActionFreezeWithResponse request
+	PostPeerActionFreezeWithResponse(ctx context.Context, nodename InPathNodeName, params *PostPeerActionFreezeParams, reqEditors ...RequestEditorFn) (*PostPeerActionFreezeResponse, error)
+
+	// PostNodeActionPushAssetWithResponse request
+	PostNodeActionPushAssetWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionPushAssetParams, reqEditors ...RequestEditorFn) (*PostNodeActionPushAssetResponse, error)
+
+	// PostNodeActionPushDiskWithResponse request
+	PostNodeActionPushDiskWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionPushDiskParams, reqEditors ...RequestEditorFn) (*PostNodeActionPushDiskResponse, error)
+
+	// PostNodeActionPushPkgWithResponse request
+	PostNodeActionPushPkgWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionPushPkgParams, reqEditors ...RequestEditorFn) (*PostNodeActionPushPkgResponse, error)
+
+	// PostNodeActionScanCapabilitiesWithResponse request
+	PostNodeActionScanCapabilitiesWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionScanCapabilitiesParams, reqEditors ...RequestEditorFn) (*PostNodeActionScanCapabilitiesResponse, error)
+
+	// PostNodeActionSCSIScanWithResponse request
+	PostNodeActionSCSIScanWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionSCSIScanParams, reqEditors ...RequestEditorFn) (*PostNodeActionSCSIScanResponse, error)
+
+	// PostNodeActionSysreportWithResponse request
+	PostNodeActionSysreportWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionSysreportParams, reqEditors ...RequestEditorFn) (*PostNodeActionSysreportResponse, error)
+
+	// PostPeerActionUnfreezeWithResponse request
+	PostPeerActionUnfreezeWithResponse(ctx context.Context, nodename InPathNodeName, params *PostPeerActionUnfreezeParams, reqEditors ...RequestEditorFn) (*PostPeerActionUnfreezeResponse, error)
+
+	// GetNodeAuditLogWithResponse request
+	GetNodeAuditLogWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeAuditLogParams, reqEditors ...RequestEditorFn) (*GetNodeAuditLogResponse, error)
+
+	// GetNodeCapabilitiesWithResponse request
+	GetNodeCapabilitiesWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeCapabilitiesResponse, error)
+
+	// GetNodeConfigWithResponse request
+	GetNodeConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeConfigParams, reqEditors ...RequestEditorFn) (*GetNodeConfigResponse, error)
+
+	// PatchNodeConfigWithResponse request
+	PatchNodeConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *PatchNodeConfigParams, reqEditors ...RequestEditorFn) (*PatchNodeConfigResponse, error)
+
+	// GetNodeConfigFileWithResponse request
+	GetNodeConfigFileWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeConfigFileResponse, error)
+
+	// PutNodeConfigFileWithBodyWithResponse request with any body
+	PutNodeConfigFileWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutNodeConfigFileResponse, error)
+
+	// GetNodeConfigKeywordsWithResponse request
+	GetNodeConfigKeywordsWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeConfigKeywordsParams, reqEditors ...RequestEditorFn) (*GetNodeConfigKeywordsResponse, error)
+
+	// PostDaemonRestartWithResponse request
+	PostDaemonRestartWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostDaemonRestartResponse, error)
+
+	// PostDaemonShutdownWithResponse request
+	PostDaemonShutdownWithResponse(ctx context.Context, nodename InPathNodeName, params *PostDaemonShutdownParams, reqEditors ...RequestEditorFn) (*PostDaemonShutdownResponse, error)
+
+	// PostDaemonStopWithResponse request
+	PostDaemonStopWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostDaemonStopResponse, error)
+
+	// PostDaemonAuditWithResponse request
+	PostDaemonAuditWithResponse(ctx context.Context, nodename InPathNodeName, params *PostDaemonAuditParams, reqEditors ...RequestEditorFn) (*PostDaemonAuditResponse, error)
+
+	// GetDaemonDNSDumpWithResponse request
+	GetDaemonDNSDumpWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetDaemonDNSDumpResponse, error)
+
+	// GetDaemonEventsWithResponse request
+	GetDaemonEventsWithResponse(ctx context.Context, nodename InPathNodeName, params *GetDaemonEventsParams, reqEditors ...RequestEditorFn) (*GetDaemonEventsResponse, error)
+
+	// PostDaemonHeartbeatRestartWithResponse request
+	PostDaemonHeartbeatRestartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatRestartResponse, error)
+
+	// PostDaemonHeartbeatSignWithResponse request
+	PostDaemonHeartbeatSignWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatSignResponse, error)
+
+	// PostDaemonHeartbeatStartWithResponse request
+	PostDaemonHeartbeatStartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatStartResponse, error)
+
+	// PostDaemonHeartbeatStopWithResponse request
+	PostDaemonHeartbeatStopWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatStopResponse, error)
+
+	// PostDaemonHeartbeatWipeWithResponse request
+	PostDaemonHeartbeatWipeWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatWipeResponse, error)
+
+	// PostDaemonListenerRestartWithResponse request
+	PostDaemonListenerRestartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, reqEditors ...RequestEditorFn) (*PostDaemonListenerRestartResponse, error)
+
+	// PostDaemonListenerStartWithResponse request
+	PostDaemonListenerStartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, reqEditors ...RequestEditorFn) (*PostDaemonListenerStartResponse, error)
+
+	// PostDaemonListenerStopWithResponse request
+	PostDaemonListenerStopWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, reqEditors ...RequestEditorFn) (*PostDaemonListenerStopResponse, error)
+
+	// PostDaemonListenerLogControlWithBodyWithResponse request with any body
+	PostDaemonListenerLogControlWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDaemonListenerLogControlResponse, error)
+
+	PostDaemonListenerLogControlWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, body PostDaemonListenerLogControlJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDaemonListenerLogControlResponse, error)
+
+	// PostDaemonLogControlWithBodyWithResponse request with any body
+	PostDaemonLogControlWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDaemonLogControlResponse, error)
+
+	PostDaemonLogControlWithResponse(ctx context.Context, nodename InPathNodeName, body PostDaemonLogControlJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDaemonLogControlResponse, error)
+
+	// DeleteDaemonProcessWithResponse request
+	DeleteDaemonProcessWithResponse(ctx context.Context, nodename InPathNodeName, params *DeleteDaemonProcessParams, reqEditors ...RequestEditorFn) (*DeleteDaemonProcessResponse, error)
+
+	// GetDaemonProcessWithResponse request
+	GetDaemonProcessWithResponse(ctx context.Context, nodename InPathNodeName, params *GetDaemonProcessParams, reqEditors ...RequestEditorFn) (*GetDaemonProcessResponse, error)
+
+	// GetNodeDRBDAllocationWithResponse request
+	GetNodeDRBDAllocationWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeDRBDAllocationResponse, error)
+
+	// GetNodeDRBDConfigWithResponse request
+	GetNodeDRBDConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeDRBDConfigParams, reqEditors ...RequestEditorFn) (*GetNodeDRBDConfigResponse, error)
+
+	// PostNodeDRBDConfigWithBodyWithResponse request with any body
+	PostNodeDRBDConfigWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNodeDRBDConfigResponse, error)
+
+	PostNodeDRBDConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDConfigParams, body PostNodeDRBDConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNodeDRBDConfigResponse, error)
+
+	// PostNodeDRBDConnectWithResponse request
+	PostNodeDRBDConnectWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDConnectParams, reqEditors ...RequestEditorFn) (*PostNodeDRBDConnectResponse, error)
+
+	// PostNodeDRBDPrimaryWithResponse request
+	PostNodeDRBDPrimaryWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDPrimaryParams, reqEditors ...RequestEditorFn) (*PostNodeDRBDPrimaryResponse, error)
+
+	// PostNodeDRBDSecondaryWithResponse request
+	PostNodeDRBDSecondaryWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDSecondaryParams, reqEditors ...RequestEditorFn) (*PostNodeDRBDSecondaryResponse, error)
+
+	// GetNodeDriverWithResponse request
+	GetNodeDriverWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeDriverResponse, error)
+
+	// GetInstanceWithResponse request
+	GetInstanceWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceResponse, error)
+
+	// PostInstanceActionBootWithResponse request
+	PostInstanceActionBootWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionBootParams, reqEditors ...RequestEditorFn) (*PostInstanceActionBootResponse, error)
+
+	// PostInstanceActionDeleteWithResponse request
+	PostInstanceActionDeleteWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionDeleteParams, reqEditors ...RequestEditorFn) (*PostInstanceActionDeleteResponse, error)
+
+	// PostInstanceActionFreezeWithResponse request
+	PostInstanceActionFreezeWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionFreezeParams, reqEditors ...RequestEditorFn) (*PostInstanceActionFreezeResponse, error)
+
+	// PostInstanceActionPGUpdateWithResponse request
+	PostInstanceActionPGUpdateWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPGUpdateParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPGUpdateResponse, error)
+
+	// PostInstanceActionProvisionWithResponse request
+	PostInstanceActionProvisionWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionProvisionParams, reqEditors ...RequestEditorFn) (*PostInstanceActionProvisionResponse, error)
+
+	// PostInstanceActionPRStartWithResponse request
+	PostInstanceActionPRStartWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPRStartParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPRStartResponse, error)
+
+	// PostInstanceActionPRStopWithResponse request
+	PostInstanceActionPRStopWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPRStopParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPRStopResponse, error)
+
+	// PostInstanceActionPushResourceInfoWithResponse request
+	PostInstanceActionPushResourceInfoWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPushResourceInfoParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPushResourceInfoResponse, error)
+
+	// PostInstanceActionResizeWithResponse request
+	PostInstanceActionResizeWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionResizeParams, reqEditors ...RequestEditorFn) (*PostInstanceActionResizeResponse, error)
+
+	// PostInstanceActionRestartWithResponse request
+	PostInstanceActionRestartWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionRestartParams, reqEditors ...RequestEditorFn) (*PostInstanceActionRestartResponse, error)
+
+	// PostInstanceActionRunWithResponse request
+	PostInstanceActionRunWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionRunParams, reqEditors ...RequestEditorFn) (*PostInstanceActionRunResponse, error)
+
+	// PostInstanceActionShutdownWithResponse request
+	PostInstanceActionShutdownWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionShutdownParams, reqEditors ...RequestEditorFn) (*PostInstanceActionShutdownResponse, error)
+
+	// PostInstanceActionSnapshotCreateWithResponse request
+	PostInstanceActionSnapshotCreateWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotCreateParams, reqEditors ...RequestEditorFn) (*PostInstanceActionSnapshotCreateResponse, error)
+
+	// PostInstanceActionSnapshotDeleteWithResponse request
+	PostInstanceActionSnapshotDeleteWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotDeleteParams, reqEditors ...RequestEditorFn) (*PostInstanceActionSnapshotDeleteResponse, error)
+
+	// PostInstanceActionSnapshotRollbackWithResponse request
+	PostInstanceActionSnapshotRollbackWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotRollbackParams, reqEditors ...RequestEditorFn) (*PostInstanceActionSnapshotRollbackResponse, error)
+
+	// PostInstanceActionStartWithResponse request
+	PostInstanceActionStartWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStartResponse, error)
+
+	// PostInstanceActionStartStandbyWithResponse request
+	PostInstanceActionStartStandbyWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartStandbyParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStartStandbyResponse, error)
+
+	// PostInstanceActionStatusWithResponse request
+	PostInstanceActionStatusWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStatusParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStatusResponse, error)
+
+	// PostInstanceActionStopWithResponse request
+	PostInstanceActionStopWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStopParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStopResponse, error)
+
+	// PostInstanceActionSyncIngestWithResponse request
+	PostInstanceActionSyncIngestWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSyncIngestParams, reqEditors ...RequestEditorFn) (*PostInstanceActionSyncIngestResponse, error)
+
+	// PostInstanceActionUnfreezeWithResponse request
+	PostInstanceActionUnfreezeWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionUnfreezeParams, reqEditors ...RequestEditorFn) (*PostInstanceActionUnfreezeResponse, error)
+
+	// PostInstanceActionUnprovisionWithResponse request
+	PostInstanceActionUnprovisionWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionUnprovisionParams, reqEditors ...RequestEditorFn) (*PostInstanceActionUnprovisionResponse, error)
+
+	// PostInstanceClearWithResponse request
+	PostInstanceClearWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostInstanceClearResponse, error)
+
+	// GetInstanceConfigFileWithResponse request
+	GetInstanceConfigFileWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceConfigFileResponse, error)
+
+	// PostInstanceResourceConsoleWithResponse request
+	PostInstanceResourceConsoleWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceResourceConsoleParams, reqEditors ...RequestEditorFn) (*PostInstanceResourceConsoleResponse, error)
+
+	// GetInstanceContainerLogWithResponse request
+	GetInstanceContainerLogWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceContainerLogParams, reqEditors ...RequestEditorFn) (*GetInstanceContainerLogResponse, error)
+
+	// GetInstanceLogsWithResponse request
+	GetInstanceLogsWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceLogsParams, reqEditors ...RequestEditorFn) (*GetInstanceLogsResponse, error)
+
+	// GetInstanceResourceFileWithResponse request
+	GetInstanceResourceFileWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceResourceFileParams, reqEditors ...RequestEditorFn) (*GetInstanceResourceFileResponse, error)
+
+	// GetInstanceResourceInfoWithResponse request
+	GetInstanceResourceInfoWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceResourceInfoResponse, error)
+
+	// GetInstanceScheduleWithResponse request
+	GetInstanceScheduleWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceScheduleResponse, error)
+
+	// GetInstanceSnapshotsWithResponse request
+	GetInstanceSnapshotsWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceSnapshotsResponse, error)
+
+	// PostInstanceStateFileWithBodyWithResponse request with any body
+	PostInstanceStateFileWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceStateFileResponse, error)
+
+	// GetInstanceSyncDataWithResponse request
+	GetInstanceSyncDataWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceSyncDataParams, reqEditors ...RequestEditorFn) (*GetInstanceSyncDataResponse, error)
+
+	// PostInstanceSyncDataWithBodyWithResponse request with any body
+	PostInstanceSyncDataWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceSyncDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceSyncDataResponse, error)
+
+	// GetNodeLogsWithResponse request
+	GetNodeLogsWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeLogsParams, reqEditors ...RequestEditorFn) (*GetNodeLogsResponse, error)
+
+	// DeleteNodeMaintenanceWithResponse request
+	DeleteNodeMaintenanceWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*DeleteNodeMaintenanceResponse, error)
+
+	// PostNodeMaintenanceWithBodyWithResponse request with any body
+	PostNodeMaintenanceWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNodeMaintenanceResponse, error)
+
+	PostNodeMaintenanceWithResponse(ctx context.Context, nodename InPathNodeName, body PostNodeMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNodeMaintenanceResponse, error)
+
+	// GetNodeMetricsWithResponse request
+	GetNodeMetricsWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeMetricsResponse, error)
+
+	// GetNodePingWithResponse request
+	GetNodePingWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodePingResponse, error)
+
+	// GetNodeScheduleWithResponse request
+	GetNodeScheduleWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeScheduleResponse, error)
+
+	// GetNodeSSHHostkeysWithResponse request
+	GetNodeSSHHostkeysWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSSHHostkeysResponse, error)
+
+	// GetNodeSSHKeyWithResponse request
+	GetNodeSSHKeyWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSSHKeyResponse, error)
+
+	// PutNodeSSHTrustWithResponse request
+	PutNodeSSHTrustWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PutNodeSSHTrustResponse, error)
+
+	// GetNodeSystemDiskWithResponse request
+	GetNodeSystemDiskWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemDiskResponse, error)
+
+	// GetNodeSystemGroupWithResponse request
+	GetNodeSystemGroupWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemGroupResponse, error)
+
+	// GetNodeSystemHardwareWithResponse request
+	GetNodeSystemHardwareWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemHardwareResponse, error)
+
+	// GetNodeSystemIPAddressWithResponse request
+	GetNodeSystemIPAddressWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemIPAddressResponse, error)
+
+	// GetNodeSystemPackageWithResponse request
+	GetNodeSystemPackageWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemPackageResponse, error)
+
+	// GetNodeSystemPropertyWithResponse request
+	GetNodeSystemPropertyWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemPropertyResponse, error)
+
+	// GetNodeSystemSANInitiatorWithResponse request
+	GetNodeSystemSANInitiatorWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemSANInitiatorResponse, error)
+
+	// GetNodeSystemSANPathWithResponse request
+	GetNodeSystemSANPathWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemSANPathResponse, error)
+
+	// GetNodeSystemUserWithResponse request
+	GetNodeSystemUserWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemUserResponse, error)
+
+	// GetObjectsWithResponse request
+	GetObjectsWithResponse(ctx context.Context, params *GetObjectsParams, reqEditors ...RequestEditorFn) (*GetObjectsResponse, error)
+
+	// PostObjectsActionWithBodyWithResponse request with any body
+	PostObjectsActionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectsActionResponse, error)
+
+	PostObjectsActionWithResponse(ctx context.Context, body PostObjectsActionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostObjectsActionResponse, error)
+
+	// GetObjectPathsWithResponse request
+	GetObjectPathsWithResponse(ctx context.Context, params *GetObjectPathsParams, reqEditors ...RequestEditorFn) (*GetObjectPathsResponse, error)
+
+	// PostSvcDisableWithResponse request
+	PostSvcDisableWithResponse(ctx context.Context, namespace InPathNamespace, name InPathName, params *PostSvcDisableParams, reqEditors ...RequestEditorFn) (*PostSvcDisableResponse, error)
+
+	// PostSvcEnableWithResponse request
+	PostSvcEnableWithResponse(ctx context.Context, namespace InPathNamespace, name InPathName, params *PostSvcEnableParams, reqEditors ...RequestEditorFn) (*PostSvcEnableResponse, error)
+
+	// GetObjectWithResponse request
+	GetObjectWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectResponse, error)
+
+	// PostObjectActionAbortWithResponse request
+	PostObjectActionAbortWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionAbortResponse, error)
+
+	// PostObjectActionDeleteWithResponse request
+	PostObjectActionDeleteWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionDeleteResponse, error)
+
+	// PostObjectActionFreezeWithResponse request
+	PostObjectActionFreezeWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionFreezeResponse, error)
+
+	// PostObjectActionGivebackWithResponse request
+	PostObjectActionGivebackWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionGivebackResponse, error)
+
+	// PostObjectActionProvisionWithResponse request
+	PostObjectActionProvisionWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionProvisionResponse, error)
+
+	// PostObjectActionPurgeWithResponse request
+	PostObjectActionPurgeWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionPurgeResponse, error)
+
+	// PostObjectActionRestartWithBodyWithResponse request with any body
+	PostObjectActionRestartWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectActionRestartResponse, error)
+
+	PostObjectActionRestartWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostObjectActionRestartJSONRequestBody, reqEditors ...RequestEditorFn) (*PostObjectActionRestartResponse, error)
+
+	// PostObjectActionStartWithResponse request
+	PostObjectActionStartWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionStartResponse, error)
+
+	// PostObjectActionStopWithResponse request
+	PostObjectActionStopWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionStopResponse, error)
+
+	// PostObjectActionSwitchWithBodyWithResponse request with any body
+	PostObjectActionSwitchWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectActionSwitchResponse, error)
+
+	PostObjectActionSwitchWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostObjectActionSwitchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostObjectActionSwitchResponse, error)
+
+	// PostObjectActionUnfreezeWithResponse request
+	PostObjectActionUnfreezeWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionUnfreezeResponse, error)
+
+	// PostObjectActionUnprovisionWithResponse request
+	PostObjectActionUnprovisionWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionUnprovisionResponse, error)
+
+	// GetObjectConfigWithResponse request
+	GetObjectConfigWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectConfigParams, reqEditors ...RequestEditorFn) (*GetObjectConfigResponse, error)
+
+	// PatchObjectConfigWithResponse request
+	PatchObjectConfigWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PatchObjectConfigParams, reqEditors ...RequestEditorFn) (*PatchObjectConfigResponse, error)
+
+	// GetObjectConfigFileWithResponse request
+	GetObjectConfigFileWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectConfigFileResponse, error)
+
+	// PostObjectConfigFileWithBodyWithResponse request with any body
+	PostObjectConfigFileWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectConfigFileResponse, error)
+
+	// PutObjectConfigFileWithBodyWithResponse request with any body
+	PutObjectConfigFileWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutObjectConfigFileResponse, error)
+
+	// GetObjectConfigKeywordsWithResponse request
+	GetObjectConfigKeywordsWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectConfigKeywordsParams, reqEditors ...RequestEditorFn) (*GetObjectConfigKeywordsResponse, error)
+
+	// GetObjectDataWithResponse request
+	GetObjectDataWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectDataParams, reqEditors ...RequestEditorFn) (*GetObjectDataResponse, error)
+
+	// PatchObjectDataWithBodyWithResponse request with any body
+	PatchObjectDataWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchObjectDataResponse, error)
+
+	PatchObjectDataWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PatchObjectDataJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchObjectDataResponse, error)
+
+	// DeleteObjectDataKeyWithResponse request
+	DeleteObjectDataKeyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *DeleteObjectDataKeyParams, reqEditors ...RequestEditorFn) (*DeleteObjectDataKeyResponse, error)
+
+	// GetObjectDataKeyWithResponse request
+	GetObjectDataKeyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectDataKeyParams, reqEditors ...RequestEditorFn) (*GetObjectDataKeyResponse, error)
+
+	// PostObjectDataKeyWithBodyWithResponse request with any body
+	PostObjectDataKeyWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostObjectDataKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectDataKeyResponse, error)
+
+	// PutObjectDataKeyWithBodyWithResponse request with any body
+	PutObjectDataKeyWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PutObjectDataKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutObjectDataKeyResponse, error)
+
+	// GetObjectDataKeysWithResponse request
+	GetObjectDataKeysWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectDataKeysResponse, error)
+
+	// GetObjectResourceInfoWithResponse request
+	GetObjectResourceInfoWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectResourceInfoResponse, error)
+
+	// GetObjectScheduleWithResponse request
+	GetObjectScheduleWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectScheduleResponse, error)
+
+	// DeleteObjectTokenWithResponse request
+	DeleteObjectTokenWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *DeleteObjectTokenParams, reqEditors ...RequestEditorFn) (*DeleteObjectTokenResponse, error)
+
+	// GetObjectTokensWithResponse request
+	GetObjectTokensWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectTokensResponse, error)
+
+	// PostObjectTokenWithResponse request
+	PostObjectTokenWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostObjectTokenParams, reqEditors ...RequestEditorFn) (*PostObjectTokenResponse, error)
+
+	// GetSwaggerWithResponse request
+	GetSwaggerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSwaggerResponse, error)
+
+	// GetPoolsWithResponse request
+	GetPoolsWithResponse(ctx context.Context, params *GetPoolsParams, reqEditors ...RequestEditorFn) (*GetPoolsResponse, error)
+
+	// GetPoolVolumesWithResponse request
+	GetPoolVolumesWithResponse(ctx context.Context, params *GetPoolVolumesParams, reqEditors ...RequestEditorFn) (*GetPoolVolumesResponse, error)
+
+	// GetRelayMessageWithResponse request
+	GetRelayMessageWithResponse(ctx context.Context, params *GetRelayMessageParams, reqEditors ...RequestEditorFn) (*GetRelayMessageResponse, error)
+
+	// PostRelayMessageWithBodyWithResponse request with any body
+	PostRelayMessageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRelayMessageResponse, error)
+
+	PostRelayMessageWithResponse(ctx context.Context, body PostRelayMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRelayMessageResponse, error)
+
+	// GetRelayStatusWithResponse request
+	GetRelayStatusWithResponse(ctx context.Context, params *GetRelayStatusParams, reqEditors ...RequestEditorFn) (*GetRelayStatusResponse, error)
+
+	// GetResourcesWithResponse request
+	GetResourcesWithResponse(ctx context.Context, params *GetResourcesParams, reqEditors ...RequestEditorFn) (*GetResourcesResponse, error)
+}
+
+type GetArrayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ArrayList
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetArrayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetArrayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetArrayResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetAuthCanIResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthCanI
+	JSON400      *N400
+	JSON401      *N401
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthCanIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthCanIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetAuthCanIResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetAuthInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetAuthInfoResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostAuthRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthAccessToken
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostAuthRefreshResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostAuthTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthToken
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostAuthTokenResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetAuthWhoAmIResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserIdentity
+	JSON401      *N401
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthWhoAmIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthWhoAmIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetAuthWhoAmIResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostClusterActionAbortResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrchestrationQueued
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostClusterActionAbortResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostClusterActionAbortResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostClusterActionAbortResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostClusterActionFreezeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrchestrationQueued
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON408      *N408
+	JSON409      *N409
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostClusterActionFreezeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostClusterActionFreezeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostClusterActionFreezeResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostClusterActionUnfreezeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrchestrationQueued
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON408      *N408
+	JSON409      *N409
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostClusterActionUnfreezeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostClusterActionUnfreezeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostClusterActionUnfreezeResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetClusterConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KeywordList
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetClusterConfigResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PatchClusterConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Committed
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchClusterConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchClusterConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PatchClusterConfigResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetClusterConfigFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterConfigFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterConfigFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetClusterConfigFileResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PutClusterConfigFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PutClusterConfigFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutClusterConfigFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutClusterConfigFileResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetClusterConfigKeywordsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KeywordDefinitionList
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterConfigKeywordsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterConfigKeywordsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetClusterConfigKeywordsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostClusterHeartbeatRotateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HeartbeatRotateResponse
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON409      *N409
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostClusterHeartbeatRotateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostClusterHeartbeatRotateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostClusterHeartbeatRotateResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostClusterJoinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostClusterJoinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostClusterJoinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostClusterJoinResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostClusterLeaveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+}
+
+// Status returns HTTPResponse.Status
+func (r PostClusterLeaveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostClusterLeaveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostClusterLeaveResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetClusterStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterStatus
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetClusterStatusResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetInstancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InstanceList
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInstancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInstancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetInstancesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostInstanceProgressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostInstanceProgressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostInstanceProgressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostInstanceProgressResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostInstanceStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostInstanceStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostInstanceStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostInstanceStatusResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobList
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetJobsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetJobResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostJobCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostJobCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostJobCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostJobCancelResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetNetworksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NetworkList
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNetworksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNetworksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetNetworksResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetNetworkIPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NetworkIPList
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNetworkIPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNetworkIPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetNetworkIPResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetNodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeList
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetNodesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetNodesCapacityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeCapacityList
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNodesCapacityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNodesCapacityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetNodesCapacityResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetNodesInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodesInfo
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNodesInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNodesInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetNodesInfoResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostPeerActionAbortResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrchestrationQueued
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON408      *N408
+	JSON409      *N409
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPeerActionAbortResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPeerActionAbortResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostPeerActionAbortResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostNodeActionClearResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *N200
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNodeActionClearResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNodeActionClearResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostNodeActionClearResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostPeerActionDequeueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeActionAccepted
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostClusterActionAbortResponse) Status() string {
+func (r PostPeerActionDequeueResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13374,7 +15944,7 @@ func (r PostClusterActionAbortResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostClusterActionAbortResponse) StatusCode() int {
+func (r PostPeerActionDequeueResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13382,14 +15952,14 @@ func (r PostClusterActionAbortResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostClusterActionAbortResponse) ContentType() string {
+func (r PostPeerActionDequeueResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostClusterActionFreezeResponse struct {
+type PostPeerActionDrainResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *OrchestrationQueued
@@ -13402,7 +15972,7 @@ type PostClusterActionFreezeResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostClusterActionFreezeResponse) Status() string {
+func (r PostPeerActionDrainResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13410,7 +15980,7 @@ func (r PostClusterActionFreezeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostClusterActionFreezeResponse) StatusCode() int {
+func (r PostPeerActionDrainResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13418,27 +15988,258 @@ func (r PostClusterActionFreezeResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostClusterActionFreezeResponse) ContentType() string {
+func (r PostPeerActionDrainResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostClusterActionUnfreezeResponse struct {
+type PostPeerActionFreezeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeActionAccepted
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPeerActionFreezeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPeerActionFreezeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostPeerActionFreezeResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostNodeActionPushAssetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeActionAccepted
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNodeActionPushAssetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNodeActionPushAssetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostNodeActionPushAssetResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostNodeActionPushDiskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeActionAccepted
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNodeActionPushDiskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNodeActionPushDiskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostNodeActionPushDiskResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostNodeActionPushPkgResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeActionAccepted
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNodeActionPushPkgResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNodeActionPushPkgResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostNodeActionPushPkgResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostNodeActionScanCapabilitiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeActionAccepted
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNodeActionScanCapabilitiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNodeActionScanCapabilitiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostNodeActionScanCapabilitiesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostNodeActionSCSIScanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeActionAccepted
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNodeActionSCSIScanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNodeActionSCSIScanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostNodeActionSCSIScanResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostNodeActionSysreportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeActionAccepted
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNodeActionSysreportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNodeActionSysreportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostNodeActionSysreportResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostPeerActionUnfreezeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
+	JSON200      *NodeActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON408      *N408
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostClusterActionUnfreezeResponse) Status() string {
+func (r PostPeerActionUnfreezeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13446,7 +16247,7 @@ func (r PostClusterActionUnfreezeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostClusterActionUnfreezeResponse) StatusCode() int {
+func (r PostPeerActionUnfreezeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13454,17 +16255,17 @@ func (r PostClusterActionUnfreezeResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostClusterActionUnfreezeResponse) ContentType() string {
+func (r PostPeerActionUnfreezeResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetClusterConfigResponse struct {
+type GetNodeAuditLogResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *KeywordList
+	JSON200      *AuditRecordList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -13472,7 +16273,7 @@ type GetClusterConfigResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetClusterConfigResponse) Status() string {
+func (r GetNodeAuditLogResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13480,7 +16281,7 @@ func (r GetClusterConfigResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetClusterConfigResponse) StatusCode() int {
+func (r GetNodeAuditLogResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13488,17 +16289,17 @@ func (r GetClusterConfigResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetClusterConfigResponse) ContentType() string {
+func (r GetNodeAuditLogResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PatchClusterConfigResponse struct {
+type GetNodeCapabilitiesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Committed
+	JSON200      *CapabilityList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -13506,7 +16307,7 @@ type PatchClusterConfigResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchClusterConfigResponse) Status() string {
+func (r GetNodeCapabilitiesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13514,7 +16315,7 @@ func (r PatchClusterConfigResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PatchClusterConfigResponse) StatusCode() int {
+func (r GetNodeCapabilitiesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13522,16 +16323,17 @@ func (r PatchClusterConfigResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PatchClusterConfigResponse) ContentType() string {
+func (r GetNodeCapabilitiesResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetClusterConfigFileResponse struct {
+type GetNodeConfigResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *KeywordList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -13539,7 +16341,7 @@ type GetClusterConfigFileResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetClusterConfigFileResponse) Status() string {
+func (r GetNodeConfigResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13547,7 +16349,7 @@ func (r GetClusterConfigFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetClusterConfigFileResponse) StatusCode() int {
+func (r GetNodeConfigResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13555,26 +16357,25 @@ func (r GetClusterConfigFileResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetClusterConfigFileResponse) ContentType() string {
+func (r GetNodeConfigResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PutClusterConfigFileResponse struct {
+type PatchNodeConfigResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Committed
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PutClusterConfigFileResponse) Status() string {
+func (r PatchNodeConfigResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13582,7 +16383,7 @@ func (r PutClusterConfigFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PutClusterConfigFileResponse) StatusCode() int {
+func (r PatchNodeConfigResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13590,24 +16391,24 @@ func (r PutClusterConfigFileResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PutClusterConfigFileResponse) ContentType() string {
+func (r PatchNodeConfigResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetClusterConfigKeywordsResponse struct {
+type GetNodeConfigFileResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *KeywordDefinitionList
 	JSON400      *N400
 	JSON401      *N401
+	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetClusterConfigKeywordsResponse) Status() string {
+func (r GetNodeConfigFileResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13615,7 +16416,7 @@ func (r GetClusterConfigKeywordsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetClusterConfigKeywordsResponse) StatusCode() int {
+func (r GetNodeConfigFileResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13623,26 +16424,26 @@ func (r GetClusterConfigKeywordsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetClusterConfigKeywordsResponse) ContentType() string {
+func (r GetNodeConfigFileResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostClusterHeartbeatRotateResponse struct {
+type PutNodeConfigFileResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *HeartbeatRotateResponse
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
 	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostClusterHeartbeatRotateResponse) Status() string {
+func (r PutNodeConfigFileResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13650,7 +16451,7 @@ func (r PostClusterHeartbeatRotateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostClusterHeartbeatRotateResponse) StatusCode() int {
+func (r PutNodeConfigFileResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13658,24 +16459,24 @@ func (r PostClusterHeartbeatRotateResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostClusterHeartbeatRotateResponse) ContentType() string {
+func (r PutNodeConfigFileResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostClusterJoinResponse struct {
+type GetNodeConfigKeywordsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *KeywordDefinitionList
 	JSON400      *N400
 	JSON401      *N401
-	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostClusterJoinResponse) Status() string {
+func (r GetNodeConfigKeywordsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13683,7 +16484,7 @@ func (r PostClusterJoinResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostClusterJoinResponse) StatusCode() int {
+func (r GetNodeConfigKeywordsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13691,21 +16492,25 @@ func (r PostClusterJoinResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostClusterJoinResponse) ContentType() string {
+func (r GetNodeConfigKeywordsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostClusterLeaveResponse struct {
+type PostDaemonRestartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *N200
 	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostClusterLeaveResponse) Status() string {
+func (r PostDaemonRestartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13713,7 +16518,7 @@ func (r PostClusterLeaveResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostClusterLeaveResponse) StatusCode() int {
+func (r PostDaemonRestartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13721,24 +16526,25 @@ func (r PostClusterLeaveResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostClusterLeaveResponse) ContentType() string {
+func (r PostDaemonRestartResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetClusterStatusResponse struct {
+type PostDaemonShutdownResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ClusterStatus
+	JSON200      *DaemonPid
+	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetClusterStatusResponse) Status() string {
+func (r PostDaemonShutdownResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13746,7 +16552,7 @@ func (r GetClusterStatusResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetClusterStatusResponse) StatusCode() int {
+func (r PostDaemonShutdownResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13754,17 +16560,17 @@ func (r GetClusterStatusResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetClusterStatusResponse) ContentType() string {
+func (r PostDaemonShutdownResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetInstancesResponse struct {
+type PostDaemonStopResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceList
+	JSON200      *DaemonPid
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -13772,7 +16578,7 @@ type GetInstancesResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetInstancesResponse) Status() string {
+func (r PostDaemonStopResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13780,7 +16586,7 @@ func (r GetInstancesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetInstancesResponse) StatusCode() int {
+func (r PostDaemonStopResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13788,24 +16594,25 @@ func (r GetInstancesResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetInstancesResponse) ContentType() string {
+func (r PostDaemonStopResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceProgressResponse struct {
+type PostDaemonAuditResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceProgressResponse) Status() string {
+func (r PostDaemonAuditResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13813,7 +16620,7 @@ func (r PostInstanceProgressResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceProgressResponse) StatusCode() int {
+func (r PostDaemonAuditResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13821,24 +16628,24 @@ func (r PostInstanceProgressResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceProgressResponse) ContentType() string {
+func (r PostDaemonAuditResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceStatusResponse struct {
+type GetDaemonDNSDumpResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *N400
+	JSON200      *DNSZone
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceStatusResponse) Status() string {
+func (r GetDaemonDNSDumpResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13846,7 +16653,7 @@ func (r PostInstanceStatusResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceStatusResponse) StatusCode() int {
+func (r GetDaemonDNSDumpResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13854,24 +16661,24 @@ func (r PostInstanceStatusResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceStatusResponse) ContentType() string {
+func (r GetDaemonDNSDumpResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNetworksResponse struct {
+type GetDaemonEventsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NetworkList
+	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNetworksResponse) Status() string {
+func (r GetDaemonEventsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13879,7 +16686,7 @@ func (r GetNetworksResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNetworksResponse) StatusCode() int {
+func (r GetDaemonEventsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13887,24 +16694,25 @@ func (r GetNetworksResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNetworksResponse) ContentType() string {
+func (r GetDaemonEventsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNetworkIPResponse struct {
+type PostDaemonHeartbeatRestartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NetworkIPList
+	JSON200      *N200
+	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNetworkIPResponse) Status() string {
+func (r PostDaemonHeartbeatRestartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13912,7 +16720,7 @@ func (r GetNetworkIPResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNetworkIPResponse) StatusCode() int {
+func (r PostDaemonHeartbeatRestartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13920,17 +16728,17 @@ func (r GetNetworkIPResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNetworkIPResponse) ContentType() string {
+func (r PostDaemonHeartbeatRestartResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodesResponse struct {
+type PostDaemonHeartbeatSignResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NodeList
+	JSON200      *N200
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -13938,7 +16746,7 @@ type GetNodesResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodesResponse) Status() string {
+func (r PostDaemonHeartbeatSignResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13946,7 +16754,7 @@ func (r GetNodesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodesResponse) StatusCode() int {
+func (r PostDaemonHeartbeatSignResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13954,24 +16762,25 @@ func (r GetNodesResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodesResponse) ContentType() string {
+func (r PostDaemonHeartbeatSignResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodesInfoResponse struct {
+type PostDaemonHeartbeatStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NodesInfo
+	JSON200      *N200
+	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodesInfoResponse) Status() string {
+func (r PostDaemonHeartbeatStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13979,7 +16788,7 @@ func (r GetNodesInfoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodesInfoResponse) StatusCode() int {
+func (r PostDaemonHeartbeatStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13987,27 +16796,25 @@ func (r GetNodesInfoResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodesInfoResponse) ContentType() string {
+func (r PostDaemonHeartbeatStartResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostPeerActionAbortResponse struct {
+type PostDaemonHeartbeatStopResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
+	JSON200      *N200
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON408      *N408
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostPeerActionAbortResponse) Status() string {
+func (r PostDaemonHeartbeatStopResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14015,7 +16822,7 @@ func (r PostPeerActionAbortResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostPeerActionAbortResponse) StatusCode() int {
+func (r PostDaemonHeartbeatStopResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14023,14 +16830,14 @@ func (r PostPeerActionAbortResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostPeerActionAbortResponse) ContentType() string {
+func (r PostDaemonHeartbeatStopResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostNodeActionClearResponse struct {
+type PostDaemonHeartbeatWipeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *N200
@@ -14041,7 +16848,7 @@ type PostNodeActionClearResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostNodeActionClearResponse) Status() string {
+func (r PostDaemonHeartbeatWipeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14049,7 +16856,7 @@ func (r PostNodeActionClearResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostNodeActionClearResponse) StatusCode() int {
+func (r PostDaemonHeartbeatWipeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14057,17 +16864,17 @@ func (r PostNodeActionClearResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostNodeActionClearResponse) ContentType() string {
+func (r PostDaemonHeartbeatWipeResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostPeerActionDequeueResponse struct {
+type PostDaemonListenerRestartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NodeActionAccepted
+	JSON200      *N200
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14075,7 +16882,7 @@ type PostPeerActionDequeueResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostPeerActionDequeueResponse) Status() string {
+func (r PostDaemonListenerRestartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14083,7 +16890,7 @@ func (r PostPeerActionDequeueResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostPeerActionDequeueResponse) StatusCode() int {
+func (r PostDaemonListenerRestartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14091,27 +16898,25 @@ func (r PostPeerActionDequeueResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostPeerActionDequeueResponse) ContentType() string {
+func (r PostDaemonListenerRestartResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostPeerActionDrainResponse struct {
+type PostDaemonListenerStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
+	JSON200      *N200
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON408      *N408
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostPeerActionDrainResponse) Status() string {
+func (r PostDaemonListenerStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14119,7 +16924,7 @@ func (r PostPeerActionDrainResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostPeerActionDrainResponse) StatusCode() int {
+func (r PostDaemonListenerStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14127,17 +16932,17 @@ func (r PostPeerActionDrainResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostPeerActionDrainResponse) ContentType() string {
+func (r PostDaemonListenerStartResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostPeerActionFreezeResponse struct {
+type PostDaemonListenerStopResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NodeActionAccepted
+	JSON200      *N200
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14145,7 +16950,7 @@ type PostPeerActionFreezeResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostPeerActionFreezeResponse) Status() string {
+func (r PostDaemonListenerStopResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14153,7 +16958,7 @@ func (r PostPeerActionFreezeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostPeerActionFreezeResponse) StatusCode() int {
+func (r PostDaemonListenerStopResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14161,24 +16966,25 @@ func (r PostPeerActionFreezeResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostPeerActionFreezeResponse) ContentType() string {
+func (r PostDaemonListenerStopResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostNodeActionPushAssetResponse struct {
+type PostDaemonListenerLogControlResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NodeActionAccepted
+	JSON200      *N200
+	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostNodeActionPushAssetResponse) Status() string {
+func (r PostDaemonListenerLogControlResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14186,7 +16992,7 @@ func (r PostNodeActionPushAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostNodeActionPushAssetResponse) StatusCode() int {
+func (r PostDaemonListenerLogControlResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14194,24 +17000,25 @@ func (r PostNodeActionPushAssetResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostNodeActionPushAssetResponse) ContentType() string {
+func (r PostDaemonListenerLogControlResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostNodeActionPushDiskResponse struct {
+type PostDaemonLogControlResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NodeActionAccepted
+	JSON200      *N200
+	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostNodeActionPushDiskResponse) Status() string {
+func (r PostDaemonLogControlResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14219,7 +17026,7 @@ func (r PostNodeActionPushDiskResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostNodeActionPushDiskResponse) StatusCode() int {
+func (r PostDaemonLogControlResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14227,24 +17034,24 @@ func (r PostNodeActionPushDiskResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostNodeActionPushDiskResponse) ContentType() string {
+func (r PostDaemonLogControlResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostNodeActionPushPkgResponse struct {
+type DeleteDaemonProcessResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NodeActionAccepted
-	JSON401      *N401
+	JSON400      *N400
 	JSON403      *N403
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostNodeActionPushPkgResponse) Status() string {
+func (r DeleteDaemonProcessResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14252,7 +17059,7 @@ func (r PostNodeActionPushPkgResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostNodeActionPushPkgResponse) StatusCode() int {
+func (r DeleteDaemonProcessResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14260,24 +17067,23 @@ func (r PostNodeActionPushPkgResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostNodeActionPushPkgResponse) ContentType() string {
+func (r DeleteDaemonProcessResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostNodeActionScanCapabilitiesResponse struct {
+type GetDaemonProcessResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NodeActionAccepted
-	JSON401      *N401
+	JSON200      *ProcessList
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostNodeActionScanCapabilitiesResponse) Status() string {
+func (r GetDaemonProcessResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14285,7 +17091,7 @@ func (r PostNodeActionScanCapabilitiesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostNodeActionScanCapabilitiesResponse) StatusCode() int {
+func (r GetDaemonProcessResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14293,24 +17099,23 @@ func (r PostNodeActionScanCapabilitiesResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostNodeActionScanCapabilitiesResponse) ContentType() string {
+func (r GetDaemonProcessResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostNodeActionSCSIScanResponse struct {
+type GetNodeDRBDAllocationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NodeActionAccepted
-	JSON401      *N401
-	JSON403      *N403
+	JSON200      *DRBDAllocation
+	JSON400      *N400
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostNodeActionSCSIScanResponse) Status() string {
+func (r GetNodeDRBDAllocationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14318,7 +17123,7 @@ func (r PostNodeActionSCSIScanResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostNodeActionSCSIScanResponse) StatusCode() int {
+func (r GetNodeDRBDAllocationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14326,17 +17131,17 @@ func (r PostNodeActionSCSIScanResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostNodeActionSCSIScanResponse) ContentType() string {
+func (r GetNodeDRBDAllocationResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostNodeActionSysreportResponse struct {
+type GetNodeDRBDConfigResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NodeActionAccepted
+	JSON200      *DRBDConfig
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14344,7 +17149,7 @@ type PostNodeActionSysreportResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostNodeActionSysreportResponse) Status() string {
+func (r GetNodeDRBDConfigResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14352,7 +17157,7 @@ func (r PostNodeActionSysreportResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostNodeActionSysreportResponse) StatusCode() int {
+func (r GetNodeDRBDConfigResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14360,17 +17165,16 @@ func (r PostNodeActionSysreportResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostNodeActionSysreportResponse) ContentType() string {
+func (r GetNodeDRBDConfigResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostPeerActionUnfreezeResponse struct {
+type PostNodeDRBDConfigResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *NodeActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14378,7 +17182,7 @@ type PostPeerActionUnfreezeResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostPeerActionUnfreezeResponse) Status() string {
+func (r PostNodeDRBDConfigResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14386,7 +17190,7 @@ func (r PostPeerActionUnfreezeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostPeerActionUnfreezeResponse) StatusCode() int {
+func (r PostNodeDRBDConfigResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14394,25 +17198,25 @@ func (r PostPeerActionUnfreezeResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostPeerActionUnfreezeResponse) ContentType() string {
+func (r PostNodeDRBDConfigResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeCapabilitiesResponse struct {
+type PostNodeDRBDConnectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CapabilityList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeCapabilitiesResponse) Status() string {
+func (r PostNodeDRBDConnectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14420,7 +17224,7 @@ func (r GetNodeCapabilitiesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeCapabilitiesResponse) StatusCode() int {
+func (r PostNodeDRBDConnectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14428,25 +17232,25 @@ func (r GetNodeCapabilitiesResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeCapabilitiesResponse) ContentType() string {
+func (r PostNodeDRBDConnectResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeConfigResponse struct {
+type PostNodeDRBDPrimaryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *KeywordList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeConfigResponse) Status() string {
+func (r PostNodeDRBDPrimaryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14454,7 +17258,7 @@ func (r GetNodeConfigResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeConfigResponse) StatusCode() int {
+func (r PostNodeDRBDPrimaryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14462,25 +17266,25 @@ func (r GetNodeConfigResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeConfigResponse) ContentType() string {
+func (r PostNodeDRBDPrimaryResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PatchNodeConfigResponse struct {
+type PostNodeDRBDSecondaryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Committed
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchNodeConfigResponse) Status() string {
+func (r PostNodeDRBDSecondaryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14488,7 +17292,7 @@ func (r PatchNodeConfigResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PatchNodeConfigResponse) StatusCode() int {
+func (r PostNodeDRBDSecondaryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14496,16 +17300,17 @@ func (r PatchNodeConfigResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PatchNodeConfigResponse) ContentType() string {
+func (r PostNodeDRBDSecondaryResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeConfigFileResponse struct {
+type GetNodeDriverResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *DriverList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14513,7 +17318,7 @@ type GetNodeConfigFileResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeConfigFileResponse) Status() string {
+func (r GetNodeDriverResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14521,7 +17326,7 @@ func (r GetNodeConfigFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeConfigFileResponse) StatusCode() int {
+func (r GetNodeDriverResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14529,26 +17334,25 @@ func (r GetNodeConfigFileResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeConfigFileResponse) ContentType() string {
+func (r GetNodeDriverResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PutNodeConfigFileResponse struct {
+type GetInstanceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *InstanceItem
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PutNodeConfigFileResponse) Status() string {
+func (r GetInstanceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14556,7 +17360,7 @@ func (r PutNodeConfigFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PutNodeConfigFileResponse) StatusCode() int {
+func (r GetInstanceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14564,24 +17368,25 @@ func (r PutNodeConfigFileResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PutNodeConfigFileResponse) ContentType() string {
+func (r GetInstanceResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeConfigKeywordsResponse struct {
+type PostInstanceActionBootResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *KeywordDefinitionList
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
+	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeConfigKeywordsResponse) Status() string {
+func (r PostInstanceActionBootResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14589,7 +17394,7 @@ func (r GetNodeConfigKeywordsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeConfigKeywordsResponse) StatusCode() int {
+func (r PostInstanceActionBootResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14597,17 +17402,17 @@ func (r GetNodeConfigKeywordsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeConfigKeywordsResponse) ContentType() string {
+func (r PostInstanceActionBootResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonRestartResponse struct {
+type PostInstanceActionDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *N200
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14615,7 +17420,7 @@ type PostDaemonRestartResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonRestartResponse) Status() string {
+func (r PostInstanceActionDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14623,7 +17428,7 @@ func (r PostDaemonRestartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonRestartResponse) StatusCode() int {
+func (r PostInstanceActionDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14631,17 +17436,17 @@ func (r PostDaemonRestartResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonRestartResponse) ContentType() string {
+func (r PostInstanceActionDeleteResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonShutdownResponse struct {
+type PostInstanceActionFreezeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DaemonPid
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14649,7 +17454,7 @@ type PostDaemonShutdownResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonShutdownResponse) Status() string {
+func (r PostInstanceActionFreezeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14657,7 +17462,7 @@ func (r PostDaemonShutdownResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonShutdownResponse) StatusCode() int {
+func (r PostInstanceActionFreezeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14665,17 +17470,17 @@ func (r PostDaemonShutdownResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonShutdownResponse) ContentType() string {
+func (r PostInstanceActionFreezeResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonStopResponse struct {
+type PostInstanceActionPGUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DaemonPid
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14683,7 +17488,7 @@ type PostDaemonStopResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonStopResponse) Status() string {
+func (r PostInstanceActionPGUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14691,7 +17496,7 @@ func (r PostDaemonStopResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonStopResponse) StatusCode() int {
+func (r PostInstanceActionPGUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14699,25 +17504,25 @@ func (r PostDaemonStopResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonStopResponse) ContentType() string {
+func (r PostInstanceActionPGUpdateResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonAuditResponse struct {
+type PostInstanceActionProvisionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonAuditResponse) Status() string {
+func (r PostInstanceActionProvisionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14725,7 +17530,7 @@ func (r PostDaemonAuditResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonAuditResponse) StatusCode() int {
+func (r PostInstanceActionProvisionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14733,24 +17538,25 @@ func (r PostDaemonAuditResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonAuditResponse) ContentType() string {
+func (r PostInstanceActionProvisionResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetDaemonDNSDumpResponse struct {
+type PostInstanceActionPRStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DNSZone
+	JSON200      *InstanceActionAccepted
+	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetDaemonDNSDumpResponse) Status() string {
+func (r PostInstanceActionPRStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14758,7 +17564,7 @@ func (r GetDaemonDNSDumpResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetDaemonDNSDumpResponse) StatusCode() int {
+func (r PostInstanceActionPRStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14766,16 +17572,17 @@ func (r GetDaemonDNSDumpResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetDaemonDNSDumpResponse) ContentType() string {
+func (r PostInstanceActionPRStartResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetDaemonEventsResponse struct {
+type PostInstanceActionPRStopResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14783,7 +17590,7 @@ type GetDaemonEventsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetDaemonEventsResponse) Status() string {
+func (r PostInstanceActionPRStopResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14791,7 +17598,7 @@ func (r GetDaemonEventsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetDaemonEventsResponse) StatusCode() int {
+func (r PostInstanceActionPRStopResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14799,25 +17606,24 @@ func (r GetDaemonEventsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetDaemonEventsResponse) ContentType() string {
+func (r PostInstanceActionPRStopResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonHeartbeatRestartResponse struct {
+type PostInstanceActionPushResourceInfoResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *N200
-	JSON400      *N400
+	JSON200      *InstanceActionAccepted
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonHeartbeatRestartResponse) Status() string {
+func (r PostInstanceActionPushResourceInfoResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14825,7 +17631,7 @@ func (r PostDaemonHeartbeatRestartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonHeartbeatRestartResponse) StatusCode() int {
+func (r PostInstanceActionPushResourceInfoResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14833,17 +17639,17 @@ func (r PostDaemonHeartbeatRestartResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonHeartbeatRestartResponse) ContentType() string {
+func (r PostInstanceActionPushResourceInfoResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonHeartbeatSignResponse struct {
+type PostInstanceActionResizeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *N200
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14851,7 +17657,7 @@ type PostDaemonHeartbeatSignResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonHeartbeatSignResponse) Status() string {
+func (r PostInstanceActionResizeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14859,7 +17665,7 @@ func (r PostDaemonHeartbeatSignResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonHeartbeatSignResponse) StatusCode() int {
+func (r PostInstanceActionResizeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14867,17 +17673,17 @@ func (r PostDaemonHeartbeatSignResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonHeartbeatSignResponse) ContentType() string {
+func (r PostInstanceActionResizeResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonHeartbeatStartResponse struct {
+type PostInstanceActionRestartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *N200
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14885,7 +17691,7 @@ type PostDaemonHeartbeatStartResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonHeartbeatStartResponse) Status() string {
+func (r PostInstanceActionRestartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14893,7 +17699,7 @@ func (r PostDaemonHeartbeatStartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonHeartbeatStartResponse) StatusCode() int {
+func (r PostInstanceActionRestartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14901,17 +17707,17 @@ func (r PostDaemonHeartbeatStartResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonHeartbeatStartResponse) ContentType() string {
+func (r PostInstanceActionRestartResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonHeartbeatStopResponse struct {
+type PostInstanceActionRunResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *N200
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14919,7 +17725,7 @@ type PostDaemonHeartbeatStopResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonHeartbeatStopResponse) Status() string {
+func (r PostInstanceActionRunResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14927,7 +17733,7 @@ func (r PostDaemonHeartbeatStopResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonHeartbeatStopResponse) StatusCode() int {
+func (r PostInstanceActionRunResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14935,17 +17741,17 @@ func (r PostDaemonHeartbeatStopResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonHeartbeatStopResponse) ContentType() string {
+func (r PostInstanceActionRunResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonHeartbeatWipeResponse struct {
+type PostInstanceActionShutdownResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *N200
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14953,7 +17759,7 @@ type PostDaemonHeartbeatWipeResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonHeartbeatWipeResponse) Status() string {
+func (r PostInstanceActionShutdownResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14961,7 +17767,7 @@ func (r PostDaemonHeartbeatWipeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonHeartbeatWipeResponse) StatusCode() int {
+func (r PostInstanceActionShutdownResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -14969,17 +17775,17 @@ func (r PostDaemonHeartbeatWipeResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonHeartbeatWipeResponse) ContentType() string {
+func (r PostInstanceActionShutdownResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonListenerRestartResponse struct {
+type PostInstanceActionSnapshotCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *N200
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -14987,7 +17793,7 @@ type PostDaemonListenerRestartResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonListenerRestartResponse) Status() string {
+func (r PostInstanceActionSnapshotCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -14995,7 +17801,7 @@ func (r PostDaemonListenerRestartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonListenerRestartResponse) StatusCode() int {
+func (r PostInstanceActionSnapshotCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15003,17 +17809,17 @@ func (r PostDaemonListenerRestartResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonListenerRestartResponse) ContentType() string {
+func (r PostInstanceActionSnapshotCreateResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonListenerStartResponse struct {
+type PostInstanceActionSnapshotDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *N200
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15021,7 +17827,7 @@ type PostDaemonListenerStartResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonListenerStartResponse) Status() string {
+func (r PostInstanceActionSnapshotDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15029,7 +17835,7 @@ func (r PostDaemonListenerStartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonListenerStartResponse) StatusCode() int {
+func (r PostInstanceActionSnapshotDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15037,17 +17843,17 @@ func (r PostDaemonListenerStartResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonListenerStartResponse) ContentType() string {
+func (r PostInstanceActionSnapshotDeleteResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonListenerStopResponse struct {
+type PostInstanceActionSnapshotRollbackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *N200
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15055,7 +17861,7 @@ type PostDaemonListenerStopResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonListenerStopResponse) Status() string {
+func (r PostInstanceActionSnapshotRollbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15063,7 +17869,7 @@ func (r PostDaemonListenerStopResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonListenerStopResponse) StatusCode() int {
+func (r PostInstanceActionSnapshotRollbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15071,17 +17877,17 @@ func (r PostDaemonListenerStopResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonListenerStopResponse) ContentType() string {
+func (r PostInstanceActionSnapshotRollbackResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonListenerLogControlResponse struct {
+type PostInstanceActionStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *N200
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15089,7 +17895,7 @@ type PostDaemonListenerLogControlResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonListenerLogControlResponse) Status() string {
+func (r PostInstanceActionStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15097,7 +17903,7 @@ func (r PostDaemonListenerLogControlResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonListenerLogControlResponse) StatusCode() int {
+func (r PostInstanceActionStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15105,17 +17911,17 @@ func (r PostDaemonListenerLogControlResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonListenerLogControlResponse) ContentType() string {
+func (r PostInstanceActionStartResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostDaemonLogControlResponse struct {
+type PostInstanceActionStartStandbyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *N200
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15123,7 +17929,7 @@ type PostDaemonLogControlResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostDaemonLogControlResponse) Status() string {
+func (r PostInstanceActionStartStandbyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15131,7 +17937,7 @@ func (r PostDaemonLogControlResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostDaemonLogControlResponse) StatusCode() int {
+func (r PostInstanceActionStartStandbyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15139,24 +17945,25 @@ func (r PostDaemonLogControlResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostDaemonLogControlResponse) ContentType() string {
+func (r PostInstanceActionStartStandbyResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type DeleteDaemonProcessResponse struct {
+type PostInstanceActionStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
+	JSON401      *N401
 	JSON403      *N403
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteDaemonProcessResponse) Status() string {
+func (r PostInstanceActionStatusResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15164,7 +17971,7 @@ func (r DeleteDaemonProcessResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDaemonProcessResponse) StatusCode() int {
+func (r PostInstanceActionStatusResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15172,23 +17979,25 @@ func (r DeleteDaemonProcessResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteDaemonProcessResponse) ContentType() string {
+func (r PostInstanceActionStatusResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetDaemonProcessResponse struct {
+type PostInstanceActionStopResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ProcessList
+	JSON200      *InstanceActionAccepted
+	JSON400      *N400
+	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetDaemonProcessResponse) Status() string {
+func (r PostInstanceActionStopResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15196,7 +18005,7 @@ func (r GetDaemonProcessResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetDaemonProcessResponse) StatusCode() int {
+func (r PostInstanceActionStopResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15204,23 +18013,25 @@ func (r GetDaemonProcessResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetDaemonProcessResponse) ContentType() string {
+func (r PostInstanceActionStopResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeDRBDAllocationResponse struct {
+type PostInstanceActionSyncIngestResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DRBDAllocation
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeDRBDAllocationResponse) Status() string {
+func (r PostInstanceActionSyncIngestResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15228,7 +18039,7 @@ func (r GetNodeDRBDAllocationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeDRBDAllocationResponse) StatusCode() int {
+func (r PostInstanceActionSyncIngestResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15236,17 +18047,17 @@ func (r GetNodeDRBDAllocationResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeDRBDAllocationResponse) ContentType() string {
+func (r PostInstanceActionSyncIngestResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeDRBDConfigResponse struct {
+type PostInstanceActionUnfreezeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DRBDConfig
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15254,7 +18065,7 @@ type GetNodeDRBDConfigResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeDRBDConfigResponse) Status() string {
+func (r PostInstanceActionUnfreezeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15262,7 +18073,7 @@ func (r GetNodeDRBDConfigResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeDRBDConfigResponse) StatusCode() int {
+func (r PostInstanceActionUnfreezeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15270,16 +18081,17 @@ func (r GetNodeDRBDConfigResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeDRBDConfigResponse) ContentType() string {
+func (r PostInstanceActionUnfreezeResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostNodeDRBDConfigResponse struct {
+type PostInstanceActionUnprovisionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15287,7 +18099,7 @@ type PostNodeDRBDConfigResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostNodeDRBDConfigResponse) Status() string {
+func (r PostInstanceActionUnprovisionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15295,7 +18107,7 @@ func (r PostNodeDRBDConfigResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostNodeDRBDConfigResponse) StatusCode() int {
+func (r PostInstanceActionUnprovisionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15303,25 +18115,24 @@ func (r PostNodeDRBDConfigResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostNodeDRBDConfigResponse) ContentType() string {
+func (r PostInstanceActionUnprovisionResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostNodeDRBDConnectResponse struct {
+type PostInstanceClearResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostNodeDRBDConnectResponse) Status() string {
+func (r PostInstanceClearResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15329,7 +18140,7 @@ func (r PostNodeDRBDConnectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostNodeDRBDConnectResponse) StatusCode() int {
+func (r PostInstanceClearResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15337,25 +18148,24 @@ func (r PostNodeDRBDConnectResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostNodeDRBDConnectResponse) ContentType() string {
+func (r PostInstanceClearResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostNodeDRBDPrimaryResponse struct {
+type GetInstanceConfigFileResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostNodeDRBDPrimaryResponse) Status() string {
+func (r GetInstanceConfigFileResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15363,7 +18173,7 @@ func (r PostNodeDRBDPrimaryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostNodeDRBDPrimaryResponse) StatusCode() int {
+func (r GetInstanceConfigFileResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15371,14 +18181,14 @@ func (r PostNodeDRBDPrimaryResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostNodeDRBDPrimaryResponse) ContentType() string {
+func (r GetInstanceConfigFileResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostNodeDRBDSecondaryResponse struct {
+type PostInstanceResourceConsoleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *N400
@@ -15389,7 +18199,7 @@ type PostNodeDRBDSecondaryResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostNodeDRBDSecondaryResponse) Status() string {
+func (r PostInstanceResourceConsoleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15397,7 +18207,7 @@ func (r PostNodeDRBDSecondaryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostNodeDRBDSecondaryResponse) StatusCode() int {
+func (r PostInstanceResourceConsoleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15405,17 +18215,16 @@ func (r PostNodeDRBDSecondaryResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostNodeDRBDSecondaryResponse) ContentType() string {
+func (r PostInstanceResourceConsoleResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeDriverResponse struct {
+type GetInstanceContainerLogResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DriverList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15423,7 +18232,7 @@ type GetNodeDriverResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeDriverResponse) Status() string {
+func (r GetInstanceContainerLogResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15431,7 +18240,7 @@ func (r GetNodeDriverResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeDriverResponse) StatusCode() int {
+func (r GetInstanceContainerLogResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15439,17 +18248,16 @@ func (r GetNodeDriverResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeDriverResponse) ContentType() string {
+func (r GetInstanceContainerLogResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetInstanceResponse struct {
+type GetInstanceLogsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceItem
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15457,7 +18265,7 @@ type GetInstanceResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetInstanceResponse) Status() string {
+func (r GetInstanceLogsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15465,7 +18273,7 @@ func (r GetInstanceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetInstanceResponse) StatusCode() int {
+func (r GetInstanceLogsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15473,17 +18281,16 @@ func (r GetInstanceResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetInstanceResponse) ContentType() string {
+func (r GetInstanceLogsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionBootResponse struct {
+type GetInstanceResourceFileResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15491,7 +18298,7 @@ type PostInstanceActionBootResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionBootResponse) Status() string {
+func (r GetInstanceResourceFileResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15499,7 +18306,7 @@ func (r PostInstanceActionBootResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionBootResponse) StatusCode() int {
+func (r GetInstanceResourceFileResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15507,17 +18314,17 @@ func (r PostInstanceActionBootResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionBootResponse) ContentType() string {
+func (r GetInstanceResourceFileResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionDeleteResponse struct {
+type GetInstanceResourceInfoResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
+	JSON200      *ResourceInfoList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15525,7 +18332,7 @@ type PostInstanceActionDeleteResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionDeleteResponse) Status() string {
+func (r GetInstanceResourceInfoResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15533,7 +18340,7 @@ func (r PostInstanceActionDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionDeleteResponse) StatusCode() int {
+func (r GetInstanceResourceInfoResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15541,25 +18348,26 @@ func (r PostInstanceActionDeleteResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionDeleteResponse) ContentType() string {
+func (r GetInstanceResourceInfoResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionFreezeResponse struct {
+type GetInstanceScheduleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
+	JSON200      *ScheduleList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionFreezeResponse) Status() string {
+func (r GetInstanceScheduleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15567,7 +18375,7 @@ func (r PostInstanceActionFreezeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionFreezeResponse) StatusCode() int {
+func (r GetInstanceScheduleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15575,25 +18383,26 @@ func (r PostInstanceActionFreezeResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionFreezeResponse) ContentType() string {
+func (r GetInstanceScheduleResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionPGUpdateResponse struct {
+type GetInstanceSnapshotsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
+	JSON200      *SnapshotList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionPGUpdateResponse) Status() string {
+func (r GetInstanceSnapshotsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15601,7 +18410,7 @@ func (r PostInstanceActionPGUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionPGUpdateResponse) StatusCode() int {
+func (r GetInstanceSnapshotsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15609,25 +18418,26 @@ func (r PostInstanceActionPGUpdateResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionPGUpdateResponse) ContentType() string {
+func (r GetInstanceSnapshotsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionProvisionResponse struct {
+type PostInstanceStateFileResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionProvisionResponse) Status() string {
+func (r PostInstanceStateFileResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15635,7 +18445,7 @@ func (r PostInstanceActionProvisionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionProvisionResponse) StatusCode() int {
+func (r PostInstanceStateFileResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15643,25 +18453,26 @@ func (r PostInstanceActionProvisionResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionProvisionResponse) ContentType() string {
+func (r PostInstanceStateFileResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionPRStartResponse struct {
+type GetInstanceSyncDataResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
+	JSON200      *SyncDataState
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionPRStartResponse) Status() string {
+func (r GetInstanceSyncDataResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15669,7 +18480,7 @@ func (r PostInstanceActionPRStartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionPRStartResponse) StatusCode() int {
+func (r GetInstanceSyncDataResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15677,25 +18488,26 @@ func (r PostInstanceActionPRStartResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionPRStartResponse) ContentType() string {
+func (r GetInstanceSyncDataResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionPRStopResponse struct {
+type PostInstanceSyncDataResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
+	JSON200      *SyncData
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionPRStopResponse) Status() string {
+func (r PostInstanceSyncDataResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15703,7 +18515,7 @@ func (r PostInstanceActionPRStopResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionPRStopResponse) StatusCode() int {
+func (r PostInstanceSyncDataResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15711,24 +18523,23 @@ func (r PostInstanceActionPRStopResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionPRStopResponse) ContentType() string {
+func (r PostInstanceSyncDataResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionPushResourceInfoResponse struct {
+type GetNodeLogsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionPushResourceInfoResponse) Status() string {
+func (r GetNodeLogsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15736,7 +18547,7 @@ func (r PostInstanceActionPushResourceInfoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionPushResourceInfoResponse) StatusCode() int {
+func (r GetNodeLogsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15744,25 +18555,25 @@ func (r PostInstanceActionPushResourceInfoResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionPushResourceInfoResponse) ContentType() string {
+func (r GetNodeLogsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionRestartResponse struct {
+type DeleteNodeMaintenanceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
-	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionRestartResponse) Status() string {
+func (r DeleteNodeMaintenanceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15770,7 +18581,7 @@ func (r PostInstanceActionRestartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionRestartResponse) StatusCode() int {
+func (r DeleteNodeMaintenanceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15778,25 +18589,27 @@ func (r PostInstanceActionRestartResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionRestartResponse) ContentType() string {
+func (r DeleteNodeMaintenanceResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionRunResponse struct {
+type PostNodeMaintenanceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
+	JSON200      *NodeMaintenance
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionRunResponse) Status() string {
+func (r PostNodeMaintenanceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15804,7 +18617,7 @@ func (r PostInstanceActionRunResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionRunResponse) StatusCode() int {
+func (r PostNodeMaintenanceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15812,17 +18625,16 @@ func (r PostInstanceActionRunResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionRunResponse) ContentType() string {
+func (r PostNodeMaintenanceResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionShutdownResponse struct {
+type GetNodeMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15830,7 +18642,7 @@ type PostInstanceActionShutdownResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionShutdownResponse) Status() string {
+func (r GetNodeMetricsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15838,7 +18650,7 @@ func (r PostInstanceActionShutdownResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionShutdownResponse) StatusCode() int {
+func (r GetNodeMetricsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15846,17 +18658,16 @@ func (r PostInstanceActionShutdownResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionShutdownResponse) ContentType() string {
+func (r GetNodeMetricsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionStartResponse struct {
+type GetNodePingResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15864,7 +18675,7 @@ type PostInstanceActionStartResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionStartResponse) Status() string {
+func (r GetNodePingResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15872,7 +18683,7 @@ func (r PostInstanceActionStartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionStartResponse) StatusCode() int {
+func (r GetNodePingResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15880,17 +18691,17 @@ func (r PostInstanceActionStartResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionStartResponse) ContentType() string {
+func (r GetNodePingResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionStartStandbyResponse struct {
+type GetNodeScheduleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
+	JSON200      *ScheduleList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15898,7 +18709,7 @@ type PostInstanceActionStartStandbyResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionStartStandbyResponse) Status() string {
+func (r GetNodeScheduleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15906,7 +18717,7 @@ func (r PostInstanceActionStartStandbyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionStartStandbyResponse) StatusCode() int {
+func (r GetNodeScheduleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15914,17 +18725,16 @@ func (r PostInstanceActionStartStandbyResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionStartStandbyResponse) ContentType() string {
+func (r GetNodeScheduleResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionStatusResponse struct {
+type GetNodeSSHHostkeysResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15932,7 +18742,7 @@ type PostInstanceActionStatusResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionStatusResponse) Status() string {
+func (r GetNodeSSHHostkeysResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15940,7 +18750,7 @@ func (r PostInstanceActionStatusResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionStatusResponse) StatusCode() int {
+func (r GetNodeSSHHostkeysResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15948,17 +18758,16 @@ func (r PostInstanceActionStatusResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionStatusResponse) ContentType() string {
+func (r GetNodeSSHHostkeysResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionStopResponse struct {
+type GetNodeSSHKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -15966,7 +18775,7 @@ type PostInstanceActionStopResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionStopResponse) Status() string {
+func (r GetNodeSSHKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -15974,7 +18783,7 @@ func (r PostInstanceActionStopResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionStopResponse) StatusCode() int {
+func (r GetNodeSSHKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -15982,25 +18791,23 @@ func (r PostInstanceActionStopResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionStopResponse) ContentType() string {
+func (r GetNodeSSHKeyResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionSyncIngestResponse struct {
+type PutNodeSSHTrustResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
-	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionSyncIngestResponse) Status() string {
+func (r PutNodeSSHTrustResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16008,7 +18815,7 @@ func (r PostInstanceActionSyncIngestResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionSyncIngestResponse) StatusCode() int {
+func (r PutNodeSSHTrustResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16016,17 +18823,17 @@ func (r PostInstanceActionSyncIngestResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionSyncIngestResponse) ContentType() string {
+func (r PutNodeSSHTrustResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionUnfreezeResponse struct {
+type GetNodeSystemDiskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
+	JSON200      *DiskList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -16034,7 +18841,7 @@ type PostInstanceActionUnfreezeResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionUnfreezeResponse) Status() string {
+func (r GetNodeSystemDiskResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16042,7 +18849,7 @@ func (r PostInstanceActionUnfreezeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionUnfreezeResponse) StatusCode() int {
+func (r GetNodeSystemDiskResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16050,17 +18857,17 @@ func (r PostInstanceActionUnfreezeResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionUnfreezeResponse) ContentType() string {
+func (r GetNodeSystemDiskResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceActionUnprovisionResponse struct {
+type GetNodeSystemGroupResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceActionAccepted
+	JSON200      *GroupList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -16068,7 +18875,7 @@ type PostInstanceActionUnprovisionResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceActionUnprovisionResponse) Status() string {
+func (r GetNodeSystemGroupResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16076,7 +18883,7 @@ func (r PostInstanceActionUnprovisionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceActionUnprovisionResponse) StatusCode() int {
+func (r GetNodeSystemGroupResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16084,16 +18891,17 @@ func (r PostInstanceActionUnprovisionResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceActionUnprovisionResponse) ContentType() string {
+func (r GetNodeSystemGroupResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceClearResponse struct {
+type GetNodeSystemHardwareResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *HardwareList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -16101,7 +18909,7 @@ type PostInstanceClearResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceClearResponse) Status() string {
+func (r GetNodeSystemHardwareResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16109,7 +18917,7 @@ func (r PostInstanceClearResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceClearResponse) StatusCode() int {
+func (r GetNodeSystemHardwareResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16117,16 +18925,17 @@ func (r PostInstanceClearResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceClearResponse) ContentType() string {
+func (r GetNodeSystemHardwareResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetInstanceConfigFileResponse struct {
+type GetNodeSystemIPAddressResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *IPAddressList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -16134,7 +18943,7 @@ type GetInstanceConfigFileResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetInstanceConfigFileResponse) Status() string {
+func (r GetNodeSystemIPAddressResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16142,7 +18951,7 @@ func (r GetInstanceConfigFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetInstanceConfigFileResponse) StatusCode() int {
+func (r GetNodeSystemIPAddressResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16150,25 +18959,25 @@ func (r GetInstanceConfigFileResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetInstanceConfigFileResponse) ContentType() string {
+func (r GetNodeSystemIPAddressResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceResourceConsoleResponse struct {
+type GetNodeSystemPackageResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *PackageList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceResourceConsoleResponse) Status() string {
+func (r GetNodeSystemPackageResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16176,7 +18985,7 @@ func (r PostInstanceResourceConsoleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceResourceConsoleResponse) StatusCode() int {
+func (r GetNodeSystemPackageResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16184,16 +18993,17 @@ func (r PostInstanceResourceConsoleResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceResourceConsoleResponse) ContentType() string {
+func (r GetNodeSystemPackageResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetInstanceContainerLogResponse struct {
+type GetNodeSystemPropertyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *PropertyList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -16201,7 +19011,7 @@ type GetInstanceContainerLogResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetInstanceContainerLogResponse) Status() string {
+func (r GetNodeSystemPropertyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16209,7 +19019,7 @@ func (r GetInstanceContainerLogResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetInstanceContainerLogResponse) StatusCode() int {
+func (r GetNodeSystemPropertyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16217,16 +19027,17 @@ func (r GetInstanceContainerLogResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetInstanceContainerLogResponse) ContentType() string {
+func (r GetNodeSystemPropertyResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetInstanceLogsResponse struct {
+type GetNodeSystemSANInitiatorResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *SANPathInitiatorList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -16234,7 +19045,7 @@ type GetInstanceLogsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetInstanceLogsResponse) Status() string {
+func (r GetNodeSystemSANInitiatorResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16242,7 +19053,7 @@ func (r GetInstanceLogsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetInstanceLogsResponse) StatusCode() int {
+func (r GetNodeSystemSANInitiatorResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16250,16 +19061,17 @@ func (r GetInstanceLogsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetInstanceLogsResponse) ContentType() string {
+func (r GetNodeSystemSANInitiatorResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetInstanceResourceFileResponse struct {
+type GetNodeSystemSANPathResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *SANPathList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -16267,7 +19079,7 @@ type GetInstanceResourceFileResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetInstanceResourceFileResponse) Status() string {
+func (r GetNodeSystemSANPathResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16275,7 +19087,7 @@ func (r GetInstanceResourceFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetInstanceResourceFileResponse) StatusCode() int {
+func (r GetNodeSystemSANPathResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16283,17 +19095,17 @@ func (r GetInstanceResourceFileResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetInstanceResourceFileResponse) ContentType() string {
+func (r GetNodeSystemSANPathResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetInstanceResourceInfoResponse struct {
+type GetNodeSystemUserResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ResourceInfoList
+	JSON200      *UserList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -16301,7 +19113,7 @@ type GetInstanceResourceInfoResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetInstanceResourceInfoResponse) Status() string {
+func (r GetNodeSystemUserResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16309,7 +19121,7 @@ func (r GetInstanceResourceInfoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetInstanceResourceInfoResponse) StatusCode() int {
+func (r GetNodeSystemUserResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16317,26 +19129,25 @@ func (r GetInstanceResourceInfoResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetInstanceResourceInfoResponse) ContentType() string {
+func (r GetNodeSystemUserResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetInstanceScheduleResponse struct {
+type GetObjectsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ScheduleList
+	JSON200      *ObjectList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetInstanceScheduleResponse) Status() string {
+func (r GetObjectsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16344,7 +19155,7 @@ func (r GetInstanceScheduleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetInstanceScheduleResponse) StatusCode() int {
+func (r GetObjectsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16352,26 +19163,25 @@ func (r GetInstanceScheduleResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetInstanceScheduleResponse) ContentType() string {
+func (r GetObjectsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostInstanceStateFileResponse struct {
+type PostObjectsActionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Job
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostInstanceStateFileResponse) Status() string {
+func (r PostObjectsActionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16379,7 +19189,7 @@ func (r PostInstanceStateFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostInstanceStateFileResponse) StatusCode() int {
+func (r PostObjectsActionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16387,23 +19197,24 @@ func (r PostInstanceStateFileResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostInstanceStateFileResponse) ContentType() string {
+func (r PostObjectsActionResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeLogsResponse struct {
+type GetObjectPathsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ObjectPaths
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeLogsResponse) Status() string {
+func (r GetObjectPathsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16411,7 +19222,7 @@ func (r GetNodeLogsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeLogsResponse) StatusCode() int {
+func (r GetObjectPathsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16419,14 +19230,14 @@ func (r GetNodeLogsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeLogsResponse) ContentType() string {
+func (r GetObjectPathsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeMetricsResponse struct {
+type PostSvcDisableResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *N400
@@ -16436,7 +19247,7 @@ type GetNodeMetricsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeMetricsResponse) Status() string {
+func (r PostSvcDisableResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16444,7 +19255,7 @@ func (r GetNodeMetricsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeMetricsResponse) StatusCode() int {
+func (r PostSvcDisableResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16452,14 +19263,14 @@ func (r GetNodeMetricsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeMetricsResponse) ContentType() string {
+func (r PostSvcDisableResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodePingResponse struct {
+type PostSvcEnableResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *N400
@@ -16469,7 +19280,7 @@ type GetNodePingResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodePingResponse) Status() string {
+func (r PostSvcEnableResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16477,7 +19288,7 @@ func (r GetNodePingResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodePingResponse) StatusCode() int {
+func (r PostSvcEnableResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16485,25 +19296,24 @@ func (r GetNodePingResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodePingResponse) ContentType() string {
+func (r PostSvcEnableResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeScheduleResponse struct {
+type GetObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ScheduleList
-	JSON400      *N400
+	JSON200      *ObjectItem
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeScheduleResponse) Status() string {
+func (r GetObjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16511,7 +19321,7 @@ func (r GetNodeScheduleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeScheduleResponse) StatusCode() int {
+func (r GetObjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16519,24 +19329,28 @@ func (r GetNodeScheduleResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeScheduleResponse) ContentType() string {
+func (r GetObjectResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeSSHHostkeysResponse struct {
+type PostObjectActionAbortResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeSSHHostkeysResponse) Status() string {
+func (r PostObjectActionAbortResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16544,7 +19358,7 @@ func (r GetNodeSSHHostkeysResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeSSHHostkeysResponse) StatusCode() int {
+func (r PostObjectActionAbortResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16552,24 +19366,28 @@ func (r GetNodeSSHHostkeysResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeSSHHostkeysResponse) ContentType() string {
+func (r PostObjectActionAbortResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeSSHKeyResponse struct {
+type PostObjectActionDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeSSHKeyResponse) Status() string {
+func (r PostObjectActionDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16577,7 +19395,7 @@ func (r GetNodeSSHKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeSSHKeyResponse) StatusCode() int {
+func (r PostObjectActionDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16585,23 +19403,28 @@ func (r GetNodeSSHKeyResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeSSHKeyResponse) ContentType() string {
+func (r PostObjectActionDeleteResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PutNodeSSHTrustResponse struct {
+type PostObjectActionFreezeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *OrchestrationQueued
+	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PutNodeSSHTrustResponse) Status() string {
+func (r PostObjectActionFreezeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16609,7 +19432,7 @@ func (r PutNodeSSHTrustResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PutNodeSSHTrustResponse) StatusCode() int {
+func (r PostObjectActionFreezeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16617,25 +19440,28 @@ func (r PutNodeSSHTrustResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PutNodeSSHTrustResponse) ContentType() string {
+func (r PostObjectActionFreezeResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeSystemDiskResponse struct {
+type PostObjectActionGivebackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DiskList
+	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeSystemDiskResponse) Status() string {
+func (r PostObjectActionGivebackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16643,7 +19469,7 @@ func (r GetNodeSystemDiskResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeSystemDiskResponse) StatusCode() int {
+func (r PostObjectActionGivebackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16651,25 +19477,28 @@ func (r GetNodeSystemDiskResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeSystemDiskResponse) ContentType() string {
+func (r PostObjectActionGivebackResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeSystemGroupResponse struct {
+type PostObjectActionProvisionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GroupList
+	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeSystemGroupResponse) Status() string {
+func (r PostObjectActionProvisionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16677,7 +19506,7 @@ func (r GetNodeSystemGroupResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeSystemGroupResponse) StatusCode() int {
+func (r PostObjectActionProvisionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16685,25 +19514,28 @@ func (r GetNodeSystemGroupResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeSystemGroupResponse) ContentType() string {
+func (r PostObjectActionProvisionResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeSystemHardwareResponse struct {
+type PostObjectActionPurgeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *HardwareList
+	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeSystemHardwareResponse) Status() string {
+func (r PostObjectActionPurgeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16711,7 +19543,7 @@ func (r GetNodeSystemHardwareResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeSystemHardwareResponse) StatusCode() int {
+func (r PostObjectActionPurgeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16719,25 +19551,28 @@ func (r GetNodeSystemHardwareResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeSystemHardwareResponse) ContentType() string {
+func (r PostObjectActionPurgeResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeSystemIPAddressResponse struct {
+type PostObjectActionRestartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *IPAddressList
+	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeSystemIPAddressResponse) Status() string {
+func (r PostObjectActionRestartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16745,7 +19580,7 @@ func (r GetNodeSystemIPAddressResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeSystemIPAddressResponse) StatusCode() int {
+func (r PostObjectActionRestartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16753,25 +19588,28 @@ func (r GetNodeSystemIPAddressResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeSystemIPAddressResponse) ContentType() string {
+func (r PostObjectActionRestartResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeSystemPackageResponse struct {
+type PostObjectActionStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PackageList
+	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeSystemPackageResponse) Status() string {
+func (r PostObjectActionStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16779,7 +19617,7 @@ func (r GetNodeSystemPackageResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeSystemPackageResponse) StatusCode() int {
+func (r PostObjectActionStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16787,25 +19625,28 @@ func (r GetNodeSystemPackageResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeSystemPackageResponse) ContentType() string {
+func (r PostObjectActionStartResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeSystemPropertyResponse struct {
+type PostObjectActionStopResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PropertyList
+	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeSystemPropertyResponse) Status() string {
+func (r PostObjectActionStopResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16813,7 +19654,7 @@ func (r GetNodeSystemPropertyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeSystemPropertyResponse) StatusCode() int {
+func (r PostObjectActionStopResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16821,25 +19662,28 @@ func (r GetNodeSystemPropertyResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeSystemPropertyResponse) ContentType() string {
+func (r PostObjectActionStopResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeSystemSANInitiatorResponse struct {
+type PostObjectActionSwitchResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SANPathInitiatorList
+	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeSystemSANInitiatorResponse) Status() string {
+func (r PostObjectActionSwitchResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16847,7 +19691,7 @@ func (r GetNodeSystemSANInitiatorResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeSystemSANInitiatorResponse) StatusCode() int {
+func (r PostObjectActionSwitchResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16855,25 +19699,28 @@ func (r GetNodeSystemSANInitiatorResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeSystemSANInitiatorResponse) ContentType() string {
+func (r PostObjectActionSwitchResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeSystemSANPathResponse struct {
+type PostObjectActionUnfreezeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SANPathList
+	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeSystemSANPathResponse) Status() string {
+func (r PostObjectActionUnfreezeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16881,7 +19728,7 @@ func (r GetNodeSystemSANPathResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeSystemSANPathResponse) StatusCode() int {
+func (r PostObjectActionUnfreezeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16889,25 +19736,28 @@ func (r GetNodeSystemSANPathResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeSystemSANPathResponse) ContentType() string {
+func (r PostObjectActionUnfreezeResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetNodeSystemUserResponse struct {
+type PostObjectActionUnprovisionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *UserList
+	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON408      *N408
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetNodeSystemUserResponse) Status() string {
+func (r PostObjectActionUnprovisionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16915,7 +19765,7 @@ func (r GetNodeSystemUserResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetNodeSystemUserResponse) StatusCode() int {
+func (r PostObjectActionUnprovisionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16923,17 +19773,17 @@ func (r GetNodeSystemUserResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodeSystemUserResponse) ContentType() string {
+func (r PostObjectActionUnprovisionResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetObjectsResponse struct {
+type GetObjectConfigResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ObjectList
+	JSON200      *KeywordList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
@@ -16941,7 +19791,7 @@ type GetObjectsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetObjectsResponse) Status() string {
+func (r GetObjectConfigResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16949,7 +19799,7 @@ func (r GetObjectsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetObjectsResponse) StatusCode() int {
+func (r GetObjectConfigResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16957,24 +19807,26 @@ func (r GetObjectsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetObjectsResponse) ContentType() string {
+func (r GetObjectConfigResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetObjectPathsResponse struct {
+type PatchObjectConfigResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ObjectPaths
+	JSON200      *Committed
+	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetObjectPathsResponse) Status() string {
+func (r PatchObjectConfigResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -16982,7 +19834,7 @@ func (r GetObjectPathsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetObjectPathsResponse) StatusCode() int {
+func (r PatchObjectConfigResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -16990,14 +19842,14 @@ func (r GetObjectPathsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetObjectPathsResponse) ContentType() string {
+func (r PatchObjectConfigResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostSvcDisableResponse struct {
+type GetObjectConfigFileResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *N400
@@ -17007,7 +19859,7 @@ type PostSvcDisableResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PostSvcDisableResponse) Status() string {
+func (r GetObjectConfigFileResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17015,7 +19867,7 @@ func (r PostSvcDisableResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostSvcDisableResponse) StatusCode() int {
+func (r GetObjectConfigFileResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17023,24 +19875,26 @@ func (r PostSvcDisableResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostSvcDisableResponse) ContentType() string {
+func (r GetObjectConfigFileResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostSvcEnableResponse struct {
+type PostObjectConfigFileResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostSvcEnableResponse) Status() string {
+func (r PostObjectConfigFileResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17048,7 +19902,7 @@ func (r PostSvcEnableResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostSvcEnableResponse) StatusCode() int {
+func (r PostObjectConfigFileResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17056,24 +19910,26 @@ func (r PostSvcEnableResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostSvcEnableResponse) ContentType() string {
+func (r PostObjectConfigFileResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetObjectResponse struct {
+type PutObjectConfigFileResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ObjectItem
+	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetObjectResponse) Status() string {
+func (r PutObjectConfigFileResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17081,7 +19937,7 @@ func (r GetObjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetObjectResponse) StatusCode() int {
+func (r PutObjectConfigFileResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17089,28 +19945,24 @@ func (r GetObjectResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetObjectResponse) ContentType() string {
+func (r PutObjectConfigFileResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectActionAbortResponse struct {
+type GetObjectConfigKeywordsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
+	JSON200      *KeywordDefinitionList
 	JSON400      *N400
 	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON408      *N408
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectActionAbortResponse) Status() string {
+func (r GetObjectConfigKeywordsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17118,7 +19970,7 @@ func (r PostObjectActionAbortResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectActionAbortResponse) StatusCode() int {
+func (r GetObjectConfigKeywordsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17126,28 +19978,25 @@ func (r PostObjectActionAbortResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectActionAbortResponse) ContentType() string {
+func (r GetObjectConfigKeywordsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectActionDeleteResponse struct {
+type GetObjectDataResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
+	JSON200      *DataKeys
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
-	JSON408      *N408
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectActionDeleteResponse) Status() string {
+func (r GetObjectDataResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17155,7 +20004,7 @@ func (r PostObjectActionDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectActionDeleteResponse) StatusCode() int {
+func (r GetObjectDataResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17163,28 +20012,27 @@ func (r PostObjectActionDeleteResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectActionDeleteResponse) ContentType() string {
+func (r GetObjectDataResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectActionFreezeResponse struct {
+type PatchObjectDataResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
 	JSON408      *N408
 	JSON409      *N409
+	JSON413      *N413
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectActionFreezeResponse) Status() string {
+func (r PatchObjectDataResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17192,7 +20040,7 @@ func (r PostObjectActionFreezeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectActionFreezeResponse) StatusCode() int {
+func (r PatchObjectDataResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17200,28 +20048,24 @@ func (r PostObjectActionFreezeResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectActionFreezeResponse) ContentType() string {
+func (r PatchObjectDataResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectActionGivebackResponse struct {
+type DeleteObjectDataKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
-	JSON408      *N408
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectActionGivebackResponse) Status() string {
+func (r DeleteObjectDataKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17229,7 +20073,7 @@ func (r PostObjectActionGivebackResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectActionGivebackResponse) StatusCode() int {
+func (r DeleteObjectDataKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17237,28 +20081,25 @@ func (r PostObjectActionGivebackResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectActionGivebackResponse) ContentType() string {
+func (r DeleteObjectDataKeyResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectActionProvisionResponse struct {
+type GetObjectDataKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
-	JSON408      *N408
-	JSON409      *N409
+	JSON404      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectActionProvisionResponse) Status() string {
+func (r GetObjectDataKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17266,7 +20107,7 @@ func (r PostObjectActionProvisionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectActionProvisionResponse) StatusCode() int {
+func (r GetObjectDataKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17274,28 +20115,25 @@ func (r PostObjectActionProvisionResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectActionProvisionResponse) ContentType() string {
+func (r GetObjectDataKeyResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectActionPurgeResponse struct {
+type PostObjectDataKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
-	JSON408      *N408
-	JSON409      *N409
+	JSON413      *N413
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectActionPurgeResponse) Status() string {
+func (r PostObjectDataKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17303,7 +20141,7 @@ func (r PostObjectActionPurgeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectActionPurgeResponse) StatusCode() int {
+func (r PostObjectDataKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17311,28 +20149,25 @@ func (r PostObjectActionPurgeResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectActionPurgeResponse) ContentType() string {
+func (r PostObjectDataKeyResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectActionRestartResponse struct {
+type PutObjectDataKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
-	JSON408      *N408
-	JSON409      *N409
+	JSON413      *N413
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectActionRestartResponse) Status() string {
+func (r PutObjectDataKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17340,7 +20175,7 @@ func (r PostObjectActionRestartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectActionRestartResponse) StatusCode() int {
+func (r PutObjectDataKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17348,28 +20183,26 @@ func (r PostObjectActionRestartResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectActionRestartResponse) ContentType() string {
+func (r PutObjectDataKeyResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectActionStartResponse struct {
+type GetObjectDataKeysResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
+	JSON200      *DataKeyList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON404      *N404
-	JSON408      *N408
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectActionStartResponse) Status() string {
+func (r GetObjectDataKeysResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17377,7 +20210,7 @@ func (r PostObjectActionStartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectActionStartResponse) StatusCode() int {
+func (r GetObjectDataKeysResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17385,28 +20218,26 @@ func (r PostObjectActionStartResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectActionStartResponse) ContentType() string {
+func (r GetObjectDataKeysResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectActionStopResponse struct {
+type GetObjectResourceInfoResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
+	JSON200      *ResourceInfoList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON404      *N404
-	JSON408      *N408
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectActionStopResponse) Status() string {
+func (r GetObjectResourceInfoResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17414,7 +20245,7 @@ func (r PostObjectActionStopResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectActionStopResponse) StatusCode() int {
+func (r GetObjectResourceInfoResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17422,28 +20253,26 @@ func (r PostObjectActionStopResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectActionStopResponse) ContentType() string {
+func (r GetObjectResourceInfoResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectActionSwitchResponse struct {
+type GetObjectScheduleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
+	JSON200      *ScheduleList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON404      *N404
-	JSON408      *N408
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectActionSwitchResponse) Status() string {
+func (r GetObjectScheduleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17451,7 +20280,7 @@ func (r PostObjectActionSwitchResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectActionSwitchResponse) StatusCode() int {
+func (r GetObjectScheduleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17459,28 +20288,26 @@ func (r PostObjectActionSwitchResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectActionSwitchResponse) ContentType() string {
+func (r GetObjectScheduleResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectActionUnfreezeResponse struct {
+type DeleteObjectTokenResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
+	JSON200      *UsrTokenItem
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON404      *N404
-	JSON408      *N408
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectActionUnfreezeResponse) Status() string {
+func (r DeleteObjectTokenResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17488,7 +20315,7 @@ func (r PostObjectActionUnfreezeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectActionUnfreezeResponse) StatusCode() int {
+func (r DeleteObjectTokenResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17496,28 +20323,26 @@ func (r PostObjectActionUnfreezeResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectActionUnfreezeResponse) ContentType() string {
+func (r DeleteObjectTokenResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectActionUnprovisionResponse struct {
+type GetObjectTokensResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrchestrationQueued
+	JSON200      *UsrTokenList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON404      *N404
-	JSON408      *N408
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectActionUnprovisionResponse) Status() string {
+func (r GetObjectTokensResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17525,7 +20350,7 @@ func (r PostObjectActionUnprovisionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectActionUnprovisionResponse) StatusCode() int {
+func (r GetObjectTokensResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17533,25 +20358,27 @@ func (r PostObjectActionUnprovisionResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectActionUnprovisionResponse) ContentType() string {
+func (r GetObjectTokensResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetObjectConfigResponse struct {
+type PostObjectTokenResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *KeywordList
+	JSON200      *UsrToken
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
+	JSON404      *N404
+	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetObjectConfigResponse) Status() string {
+func (r PostObjectTokenResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17559,7 +20386,7 @@ func (r GetObjectConfigResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetObjectConfigResponse) StatusCode() int {
+func (r PostObjectTokenResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17567,26 +20394,24 @@ func (r GetObjectConfigResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetObjectConfigResponse) ContentType() string {
+func (r PostObjectTokenResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PatchObjectConfigResponse struct {
+type GetSwaggerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Committed
-	JSON400      *N400
+	JSON200      *map[string]interface{}
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchObjectConfigResponse) Status() string {
+func (r GetSwaggerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17594,7 +20419,7 @@ func (r PatchObjectConfigResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PatchObjectConfigResponse) StatusCode() int {
+func (r GetSwaggerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17602,24 +20427,24 @@ func (r PatchObjectConfigResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PatchObjectConfigResponse) ContentType() string {
+func (r GetSwaggerResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetObjectConfigFileResponse struct {
+type GetPoolsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *N400
+	JSON200      *PoolList
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetObjectConfigFileResponse) Status() string {
+func (r GetPoolsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17627,7 +20452,7 @@ func (r GetObjectConfigFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetObjectConfigFileResponse) StatusCode() int {
+func (r GetPoolsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17635,26 +20460,24 @@ func (r GetObjectConfigFileResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetObjectConfigFileResponse) ContentType() string {
+func (r GetPoolsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostObjectConfigFileResponse struct {
+type GetPoolVolumesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *N400
+	JSON200      *PoolVolumeList
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostObjectConfigFileResponse) Status() string {
+func (r GetPoolVolumesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17662,7 +20485,7 @@ func (r PostObjectConfigFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectConfigFileResponse) StatusCode() int {
+func (r GetPoolVolumesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17670,26 +20493,24 @@ func (r PostObjectConfigFileResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectConfigFileResponse) ContentType() string {
+func (r GetPoolVolumesResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PutObjectConfigFileResponse struct {
+type GetRelayMessageResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *N400
+	JSON200      *RelayMessage
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
-	JSON409      *N409
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PutObjectConfigFileResponse) Status() string {
+func (r GetRelayMessageResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17697,7 +20518,7 @@ func (r PutObjectConfigFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PutObjectConfigFileResponse) StatusCode() int {
+func (r GetRelayMessageResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17705,24 +20526,25 @@ func (r PutObjectConfigFileResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PutObjectConfigFileResponse) ContentType() string {
+func (r GetRelayMessageResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetObjectConfigKeywordsResponse struct {
+type PostRelayMessageResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *KeywordDefinitionList
+	JSON200      *N200
 	JSON400      *N400
 	JSON401      *N401
+	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetObjectConfigKeywordsResponse) Status() string {
+func (r PostRelayMessageResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17730,7 +20552,7 @@ func (r GetObjectConfigKeywordsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetObjectConfigKeywordsResponse) StatusCode() int {
+func (r PostRelayMessageResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17738,25 +20560,24 @@ func (r GetObjectConfigKeywordsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetObjectConfigKeywordsResponse) ContentType() string {
+func (r PostRelayMessageResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetObjectDataResponse struct {
+type GetRelayStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DataKeys
-	JSON400      *N400
+	JSON200      *RelayStatusList
 	JSON401      *N401
 	JSON403      *N403
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetObjectDataResponse) Status() string {
+func (r GetRelayStatusResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17764,7 +20585,7 @@ func (r GetObjectDataResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetObjectDataResponse) StatusCode() int {
+func (r GetRelayStatusResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17772,27 +20593,25 @@ func (r GetObjectDataResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetObjectDataResponse) ContentType() string {
+func (r GetRelayStatusResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PatchObjectDataResponse struct {
+type GetResourcesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ResourceList
 	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON408      *N408
-	JSON409      *N409
-	JSON413      *N413
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchObjectDataResponse) Status() string {
+func (r GetResourcesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -17800,7 +20619,7 @@ func (r PatchObjectDataResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PatchObjectDataResponse) StatusCode() int {
+func (r GetResourcesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -17808,1928 +20627,2459 @@ func (r PatchObjectDataResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PatchObjectDataResponse) ContentType() string {
+func (r GetResourcesResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type DeleteObjectDataKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON500      *N500
+// GetArrayWithResponse request returning *GetArrayResponse
+func (c *ClientWithResponses) GetArrayWithResponse(ctx context.Context, params *GetArrayParams, reqEditors ...RequestEditorFn) (*GetArrayResponse, error) {
+	rsp, err := c.GetArray(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetArrayResponse(rsp)
+}
+
+// GetAuthCanIWithResponse request returning *GetAuthCanIResponse
+func (c *ClientWithResponses) GetAuthCanIWithResponse(ctx context.Context, params *GetAuthCanIParams, reqEditors ...RequestEditorFn) (*GetAuthCanIResponse, error) {
+	rsp, err := c.GetAuthCanI(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthCanIResponse(rsp)
+}
+
+// GetAuthInfoWithResponse request returning *GetAuthInfoResponse
+func (c *ClientWithResponses) GetAuthInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthInfoResponse, error) {
+	rsp, err := c.GetAuthInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthInfoResponse(rsp)
+}
+
+// PostAuthRefreshWithResponse request returning *PostAuthRefreshResponse
+func (c *ClientWithResponses) PostAuthRefreshWithResponse(ctx context.Context, params *PostAuthRefreshParams, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error) {
+	rsp, err := c.PostAuthRefresh(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRefreshResponse(rsp)
+}
+
+// PostAuthTokenWithResponse request returning *PostAuthTokenResponse
+func (c *ClientWithResponses) PostAuthTokenWithResponse(ctx context.Context, params *PostAuthTokenParams, reqEditors ...RequestEditorFn) (*PostAuthTokenResponse, error) {
+	rsp, err := c.PostAuthToken(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthTokenResponse(rsp)
+}
+
+// GetAuthWhoAmIWithResponse request returning *GetAuthWhoAmIResponse
+func (c *ClientWithResponses) GetAuthWhoAmIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthWhoAmIResponse, error) {
+	rsp, err := c.GetAuthWhoAmI(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthWhoAmIResponse(rsp)
+}
+
+// PostClusterActionAbortWithResponse request returning *PostClusterActionAbortResponse
+func (c *ClientWithResponses) PostClusterActionAbortWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterActionAbortResponse, error) {
+	rsp, err := c.PostClusterActionAbort(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostClusterActionAbortResponse(rsp)
+}
+
+// PostClusterActionFreezeWithResponse request returning *PostClusterActionFreezeResponse
+func (c *ClientWithResponses) PostClusterActionFreezeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterActionFreezeResponse, error) {
+	rsp, err := c.PostClusterActionFreeze(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostClusterActionFreezeResponse(rsp)
+}
+
+// PostClusterActionUnfreezeWithResponse request returning *PostClusterActionUnfreezeResponse
+func (c *ClientWithResponses) PostClusterActionUnfreezeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterActionUnfreezeResponse, error) {
+	rsp, err := c.PostClusterActionUnfreeze(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostClusterActionUnfreezeResponse(rsp)
+}
+
+// GetClusterConfigWithResponse request returning *GetClusterConfigResponse
+func (c *ClientWithResponses) GetClusterConfigWithResponse(ctx context.Context, params *GetClusterConfigParams, reqEditors ...RequestEditorFn) (*GetClusterConfigResponse, error) {
+	rsp, err := c.GetClusterConfig(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterConfigResponse(rsp)
+}
+
+// PatchClusterConfigWithResponse request returning *PatchClusterConfigResponse
+func (c *ClientWithResponses) PatchClusterConfigWithResponse(ctx context.Context, params *PatchClusterConfigParams, reqEditors ...RequestEditorFn) (*PatchClusterConfigResponse, error) {
+	rsp, err := c.PatchClusterConfig(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchClusterConfigResponse(rsp)
+}
+
+// GetClusterConfigFileWithResponse request returning *GetClusterConfigFileResponse
+func (c *ClientWithResponses) GetClusterConfigFileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClusterConfigFileResponse, error) {
+	rsp, err := c.GetClusterConfigFile(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterConfigFileResponse(rsp)
+}
+
+// PutClusterConfigFileWithBodyWithResponse request with arbitrary body returning *PutClusterConfigFileResponse
+func (c *ClientWithResponses) PutClusterConfigFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutClusterConfigFileResponse, error) {
+	rsp, err := c.PutClusterConfigFileWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutClusterConfigFileResponse(rsp)
+}
+
+// GetClusterConfigKeywordsWithResponse request returning *GetClusterConfigKeywordsResponse
+func (c *ClientWithResponses) GetClusterConfigKeywordsWithResponse(ctx context.Context, params *GetClusterConfigKeywordsParams, reqEditors ...RequestEditorFn) (*GetClusterConfigKeywordsResponse, error) {
+	rsp, err := c.GetClusterConfigKeywords(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterConfigKeywordsResponse(rsp)
+}
+
+// PostClusterHeartbeatRotateWithResponse request returning *PostClusterHeartbeatRotateResponse
+func (c *ClientWithResponses) PostClusterHeartbeatRotateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterHeartbeatRotateResponse, error) {
+	rsp, err := c.PostClusterHeartbeatRotate(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostClusterHeartbeatRotateResponse(rsp)
+}
+
+// PostClusterJoinWithResponse request returning *PostClusterJoinResponse
+func (c *ClientWithResponses) PostClusterJoinWithResponse(ctx context.Context, params *PostClusterJoinParams, reqEditors ...RequestEditorFn) (*PostClusterJoinResponse, error) {
+	rsp, err := c.PostClusterJoin(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostClusterJoinResponse(rsp)
+}
+
+// PostClusterLeaveWithResponse request returning *PostClusterLeaveResponse
+func (c *ClientWithResponses) PostClusterLeaveWithResponse(ctx context.Context, params *PostClusterLeaveParams, reqEditors ...RequestEditorFn) (*PostClusterLeaveResponse, error) {
+	rsp, err := c.PostClusterLeave(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostClusterLeaveResponse(rsp)
+}
+
+// GetClusterStatusWithResponse request returning *GetClusterStatusResponse
+func (c *ClientWithResponses) GetClusterStatusWithResponse(ctx context.Context, params *GetClusterStatusParams, reqEditors ...RequestEditorFn) (*GetClusterStatusResponse, error) {
+	rsp, err := c.GetClusterStatus(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterStatusResponse(rsp)
+}
+
+// GetInstancesWithResponse request returning *GetInstancesResponse
+func (c *ClientWithResponses) GetInstancesWithResponse(ctx context.Context, params *GetInstancesParams, reqEditors ...RequestEditorFn) (*GetInstancesResponse, error) {
+	rsp, err := c.GetInstances(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInstancesResponse(rsp)
+}
+
+// PostInstanceProgressWithBodyWithResponse request with arbitrary body returning *PostInstanceProgressResponse
+func (c *ClientWithResponses) PostInstanceProgressWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceProgressResponse, error) {
+	rsp, err := c.PostInstanceProgressWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInstanceProgressResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostInstanceProgressWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostInstanceProgressJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInstanceProgressResponse, error) {
+	rsp, err := c.PostInstanceProgress(ctx, namespace, kind, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInstanceProgressResponse(rsp)
+}
+
+// PostInstanceStatusWithBodyWithResponse request with arbitrary body returning *PostInstanceStatusResponse
+func (c *ClientWithResponses) PostInstanceStatusWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceStatusResponse, error) {
+	rsp, err := c.PostInstanceStatusWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInstanceStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostInstanceStatusWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostInstanceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInstanceStatusResponse, error) {
+	rsp, err := c.PostInstanceStatus(ctx, namespace, kind, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInstanceStatusResponse(rsp)
+}
+
+// GetJobsWithResponse request returning *GetJobsResponse
+func (c *ClientWithResponses) GetJobsWithResponse(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*GetJobsResponse, error) {
+	rsp, err := c.GetJobs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobsResponse(rsp)
+}
+
+// GetJobWithResponse request returning *GetJobResponse
+func (c *ClientWithResponses) GetJobWithResponse(ctx context.Context, id InPathJobID, params *GetJobParams, reqEditors ...RequestEditorFn) (*GetJobResponse, error) {
+	rsp, err := c.GetJob(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobResponse(rsp)
+}
+
+// PostJobCancelWithResponse request returning *PostJobCancelResponse
+func (c *ClientWithResponses) PostJobCancelWithResponse(ctx context.Context, id InPathJobID, params *PostJobCancelParams, reqEditors ...RequestEditorFn) (*PostJobCancelResponse, error) {
+	rsp, err := c.PostJobCancel(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostJobCancelResponse(rsp)
+}
+
+// GetNetworksWithResponse request returning *GetNetworksResponse
+func (c *ClientWithResponses) GetNetworksWithResponse(ctx context.Context, params *GetNetworksParams, reqEditors ...RequestEditorFn) (*GetNetworksResponse, error) {
+	rsp, err := c.GetNetworks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNetworksResponse(rsp)
+}
+
+// GetNetworkIPWithResponse request returning *GetNetworkIPResponse
+func (c *ClientWithResponses) GetNetworkIPWithResponse(ctx context.Context, params *GetNetworkIPParams, reqEditors ...RequestEditorFn) (*GetNetworkIPResponse, error) {
+	rsp, err := c.GetNetworkIP(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNetworkIPResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteObjectDataKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetNodesWithResponse request returning *GetNodesResponse
+func (c *ClientWithResponses) GetNodesWithResponse(ctx context.Context, params *GetNodesParams, reqEditors ...RequestEditorFn) (*GetNodesResponse, error) {
+	rsp, err := c.GetNodes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetNodesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteObjectDataKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetNodesCapacityWithResponse request returning *GetNodesCapacityResponse
+func (c *ClientWithResponses) GetNodesCapacityWithResponse(ctx context.Context, params *GetNodesCapacityParams, reqEditors ...RequestEditorFn) (*GetNodesCapacityResponse, error) {
+	rsp, err := c.GetNodesCapacity(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetNodesCapacityResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteObjectDataKeyResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// GetNodesInfoWithResponse request returning *GetNodesInfoResponse
+func (c *ClientWithResponses) GetNodesInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNodesInfoResponse, error) {
+	rsp, err := c.GetNodesInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParseGetNodesInfoResponse(rsp)
 }
 
-type GetObjectDataKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N403
-	JSON500      *N500
+// PostPeerActionAbortWithResponse request returning *PostPeerActionAbortResponse
+func (c *ClientWithResponses) PostPeerActionAbortWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostPeerActionAbortResponse, error) {
+	rsp, err := c.PostPeerActionAbort(ctx, nodename, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPeerActionAbortResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetObjectDataKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostNodeActionClearWithResponse request returning *PostNodeActionClearResponse
+func (c *ClientWithResponses) PostNodeActionClearWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostNodeActionClearResponse, error) {
+	rsp, err := c.PostNodeActionClear(ctx, nodename, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostNodeActionClearResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetObjectDataKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostPeerActionDequeueWithResponse request returning *PostPeerActionDequeueResponse
+func (c *ClientWithResponses) PostPeerActionDequeueWithResponse(ctx context.Context, nodename InPathNodeName, params *PostPeerActionDequeueParams, reqEditors ...RequestEditorFn) (*PostPeerActionDequeueResponse, error) {
+	rsp, err := c.PostPeerActionDequeue(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostPeerActionDequeueResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetObjectDataKeyResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// PostPeerActionDrainWithResponse request returning *PostPeerActionDrainResponse
+func (c *ClientWithResponses) PostPeerActionDrainWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostPeerActionDrainResponse, error) {
+	rsp, err := c.PostPeerActionDrain(ctx, nodename, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParsePostPeerActionDrainResponse(rsp)
 }
 
-type PostObjectDataKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON413      *N413
-	JSON500      *N500
+// PostPeerActionFreezeWithResponse request returning *PostPeerActionFreezeResponse
+func (c *ClientWithResponses) PostPeerActionFreezeWithResponse(ctx context.Context, nodename InPathNodeName, params *PostPeerActionFreezeParams, reqEditors ...RequestEditorFn) (*PostPeerActionFreezeResponse, error) {
+	rsp, err := c.PostPeerActionFreeze(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPeerActionFreezeResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostObjectDataKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostNodeActionPushAssetWithResponse request returning *PostNodeActionPushAssetResponse
+func (c *ClientWithResponses) PostNodeActionPushAssetWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionPushAssetParams, reqEditors ...RequestEditorFn) (*PostNodeActionPushAssetResponse, error) {
+	rsp, err := c.PostNodeActionPushAsset(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostNodeActionPushAssetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostObjectDataKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostNodeActionPushDiskWithResponse request returning *PostNodeActionPushDiskResponse
+func (c *ClientWithResponses) PostNodeActionPushDiskWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionPushDiskParams, reqEditors ...RequestEditorFn) (*PostNodeActionPushDiskResponse, error) {
+	rsp, err := c.PostNodeActionPushDisk(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostNodeActionPushDiskResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostObjectDataKeyResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// PostNodeActionPushPkgWithResponse request returning *PostNodeActionPushPkgResponse
+func (c *ClientWithResponses) PostNodeActionPushPkgWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionPushPkgParams, reqEditors ...RequestEditorFn) (*PostNodeActionPushPkgResponse, error) {
+	rsp, err := c.PostNodeActionPushPkg(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParsePostNodeActionPushPkgResponse(rsp)
 }
 
-type PutObjectDataKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON413      *N413
-	JSON500      *N500
+// PostNodeActionScanCapabilitiesWithResponse request returning *PostNodeActionScanCapabilitiesResponse
+func (c *ClientWithResponses) PostNodeActionScanCapabilitiesWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionScanCapabilitiesParams, reqEditors ...RequestEditorFn) (*PostNodeActionScanCapabilitiesResponse, error) {
+	rsp, err := c.PostNodeActionScanCapabilities(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNodeActionScanCapabilitiesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PutObjectDataKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostNodeActionSCSIScanWithResponse request returning *PostNodeActionSCSIScanResponse
+func (c *ClientWithResponses) PostNodeActionSCSIScanWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionSCSIScanParams, reqEditors ...RequestEditorFn) (*PostNodeActionSCSIScanResponse, error) {
+	rsp, err := c.PostNodeActionSCSIScan(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostNodeActionSCSIScanResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutObjectDataKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostNodeActionSysreportWithResponse request returning *PostNodeActionSysreportResponse
+func (c *ClientWithResponses) PostNodeActionSysreportWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionSysreportParams, reqEditors ...RequestEditorFn) (*PostNodeActionSysreportResponse, error) {
+	rsp, err := c.PostNodeActionSysreport(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostNodeActionSysreportResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PutObjectDataKeyResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// PostPeerActionUnfreezeWithResponse request returning *PostPeerActionUnfreezeResponse
+func (c *ClientWithResponses) PostPeerActionUnfreezeWithResponse(ctx context.Context, nodename InPathNodeName, params *PostPeerActionUnfreezeParams, reqEditors ...RequestEditorFn) (*PostPeerActionUnfreezeResponse, error) {
+	rsp, err := c.PostPeerActionUnfreeze(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParsePostPeerActionUnfreezeResponse(rsp)
 }
 
-type GetObjectDataKeysResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DataKeyList
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON500      *N500
+// GetNodeAuditLogWithResponse request returning *GetNodeAuditLogResponse
+func (c *ClientWithResponses) GetNodeAuditLogWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeAuditLogParams, reqEditors ...RequestEditorFn) (*GetNodeAuditLogResponse, error) {
+	rsp, err := c.GetNodeAuditLog(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNodeAuditLogResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetObjectDataKeysResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetNodeCapabilitiesWithResponse request returning *GetNodeCapabilitiesResponse
+func (c *ClientWithResponses) GetNodeCapabilitiesWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeCapabilitiesResponse, error) {
+	rsp, err := c.GetNodeCapabilities(ctx, nodename, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetNodeCapabilitiesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetObjectDataKeysResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetNodeConfigWithResponse request returning *GetNodeConfigResponse
+func (c *ClientWithResponses) GetNodeConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeConfigParams, reqEditors ...RequestEditorFn) (*GetNodeConfigResponse, error) {
+	rsp, err := c.GetNodeConfig(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetNodeConfigResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetObjectDataKeysResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// PatchNodeConfigWithResponse request returning *PatchNodeConfigResponse
+func (c *ClientWithResponses) PatchNodeConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *PatchNodeConfigParams, reqEditors ...RequestEditorFn) (*PatchNodeConfigResponse, error) {
+	rsp, err := c.PatchNodeConfig(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParsePatchNodeConfigResponse(rsp)
 }
 
-type GetObjectResourceInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResourceInfoList
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON500      *N500
+// GetNodeConfigFileWithResponse request returning *GetNodeConfigFileResponse
+func (c *ClientWithResponses) GetNodeConfigFileWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeConfigFileResponse, error) {
+	rsp, err := c.GetNodeConfigFile(ctx, nodename, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNodeConfigFileResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetObjectResourceInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PutNodeConfigFileWithBodyWithResponse request with arbitrary body returning *PutNodeConfigFileResponse
+func (c *ClientWithResponses) PutNodeConfigFileWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutNodeConfigFileResponse, error) {
+	rsp, err := c.PutNodeConfigFileWithBody(ctx, nodename, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePutNodeConfigFileResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetObjectResourceInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetNodeConfigKeywordsWithResponse request returning *GetNodeConfigKeywordsResponse
+func (c *ClientWithResponses) GetNodeConfigKeywordsWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeConfigKeywordsParams, reqEditors ...RequestEditorFn) (*GetNodeConfigKeywordsResponse, error) {
+	rsp, err := c.GetNodeConfigKeywords(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetNodeConfigKeywordsResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetObjectResourceInfoResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// PostDaemonRestartWithResponse request returning *PostDaemonRestartResponse
+func (c *ClientWithResponses) PostDaemonRestartWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostDaemonRestartResponse, error) {
+	rsp, err := c.PostDaemonRestart(ctx, nodename, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParsePostDaemonRestartResponse(rsp)
 }
 
-type GetObjectScheduleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScheduleList
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON500      *N500
+// PostDaemonShutdownWithResponse request returning *PostDaemonShutdownResponse
+func (c *ClientWithResponses) PostDaemonShutdownWithResponse(ctx context.Context, nodename InPathNodeName, params *PostDaemonShutdownParams, reqEditors ...RequestEditorFn) (*PostDaemonShutdownResponse, error) {
+	rsp, err := c.PostDaemonShutdown(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDaemonShutdownResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetObjectScheduleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostDaemonStopWithResponse request returning *PostDaemonStopResponse
+func (c *ClientWithResponses) PostDaemonStopWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostDaemonStopResponse, error) {
+	rsp, err := c.PostDaemonStop(ctx, nodename, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostDaemonStopResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetObjectScheduleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostDaemonAuditWithResponse request returning *PostDaemonAuditResponse
+func (c *ClientWithResponses) PostDaemonAuditWithResponse(ctx context.Context, nodename InPathNodeName, params *PostDaemonAuditParams, reqEditors ...RequestEditorFn) (*PostDaemonAuditResponse, error) {
+	rsp, err := c.PostDaemonAudit(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostDaemonAuditResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetObjectScheduleResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// GetDaemonDNSDumpWithResponse request returning *GetDaemonDNSDumpResponse
+func (c *ClientWithResponses) GetDaemonDNSDumpWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetDaemonDNSDumpResponse, error) {
+	rsp, err := c.GetDaemonDNSDump(ctx, nodename, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParseGetDaemonDNSDumpResponse(rsp)
 }
 
-type GetSwaggerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
-	JSON401      *N401
-	JSON403      *N403
-	JSON500      *N500
+// GetDaemonEventsWithResponse request returning *GetDaemonEventsResponse
+func (c *ClientWithResponses) GetDaemonEventsWithResponse(ctx context.Context, nodename InPathNodeName, params *GetDaemonEventsParams, reqEditors ...RequestEditorFn) (*GetDaemonEventsResponse, error) {
+	rsp, err := c.GetDaemonEvents(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDaemonEventsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSwaggerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostDaemonHeartbeatRestartWithResponse request returning *PostDaemonHeartbeatRestartResponse
+func (c *ClientWithResponses) PostDaemonHeartbeatRestartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatRestartResponse, error) {
+	rsp, err := c.PostDaemonHeartbeatRestart(ctx, nodename, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostDaemonHeartbeatRestartResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSwaggerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostDaemonHeartbeatSignWithResponse request returning *PostDaemonHeartbeatSignResponse
+func (c *ClientWithResponses) PostDaemonHeartbeatSignWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatSignResponse, error) {
+	rsp, err := c.PostDaemonHeartbeatSign(ctx, nodename, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostDaemonHeartbeatSignResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetSwaggerResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// PostDaemonHeartbeatStartWithResponse request returning *PostDaemonHeartbeatStartResponse
+func (c *ClientWithResponses) PostDaemonHeartbeatStartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatStartResponse, error) {
+	rsp, err := c.PostDaemonHeartbeatStart(ctx, nodename, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParsePostDaemonHeartbeatStartResponse(rsp)
 }
 
-type GetPoolsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PoolList
-	JSON401      *N401
-	JSON403      *N403
-	JSON500      *N500
+// PostDaemonHeartbeatStopWithResponse request returning *PostDaemonHeartbeatStopResponse
+func (c *ClientWithResponses) PostDaemonHeartbeatStopWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatStopResponse, error) {
+	rsp, err := c.PostDaemonHeartbeatStop(ctx, nodename, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDaemonHeartbeatStopResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPoolsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostDaemonHeartbeatWipeWithResponse request returning *PostDaemonHeartbeatWipeResponse
+func (c *ClientWithResponses) PostDaemonHeartbeatWipeWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatWipeResponse, error) {
+	rsp, err := c.PostDaemonHeartbeatWipe(ctx, nodename, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostDaemonHeartbeatWipeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPoolsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostDaemonListenerRestartWithResponse request returning *PostDaemonListenerRestartResponse
+func (c *ClientWithResponses) PostDaemonListenerRestartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, reqEditors ...RequestEditorFn) (*PostDaemonListenerRestartResponse, error) {
+	rsp, err := c.PostDaemonListenerRestart(ctx, nodename, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostDaemonListenerRestartResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetPoolsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// PostDaemonListenerStartWithResponse request returning *PostDaemonListenerStartResponse
+func (c *ClientWithResponses) PostDaemonListenerStartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, reqEditors ...RequestEditorFn) (*PostDaemonListenerStartResponse, error) {
+	rsp, err := c.PostDaemonListenerStart(ctx, nodename, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParsePostDaemonListenerStartResponse(rsp)
 }
 
-type GetPoolVolumesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PoolVolumeList
-	JSON401      *N401
-	JSON403      *N403
-	JSON500      *N500
+// PostDaemonListenerStopWithResponse request returning *PostDaemonListenerStopResponse
+func (c *ClientWithResponses) PostDaemonListenerStopWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, reqEditors ...RequestEditorFn) (*PostDaemonListenerStopResponse, error) {
+	rsp, err := c.PostDaemonListenerStop(ctx, nodename, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDaemonListenerStopResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPoolVolumesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostDaemonListenerLogControlWithBodyWithResponse request with arbitrary body returning *PostDaemonListenerLogControlResponse
+func (c *ClientWithResponses) PostDaemonListenerLogControlWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDaemonListenerLogControlResponse, error) {
+	rsp, err := c.PostDaemonListenerLogControlWithBody(ctx, nodename, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostDaemonListenerLogControlResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPoolVolumesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostDaemonListenerLogControlWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, body PostDaemonListenerLogControlJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDaemonListenerLogControlResponse, error) {
+	rsp, err := c.PostDaemonListenerLogControl(ctx, nodename, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostDaemonListenerLogControlResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetPoolVolumesResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// PostDaemonLogControlWithBodyWithResponse request with arbitrary body returning *PostDaemonLogControlResponse
+func (c *ClientWithResponses) PostDaemonLogControlWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDaemonLogControlResponse, error) {
+	rsp, err := c.PostDaemonLogControlWithBody(ctx, nodename, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParsePostDaemonLogControlResponse(rsp)
 }
 
-type GetRelayMessageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RelayMessage
-	JSON401      *N401
-	JSON403      *N403
-	JSON500      *N500
+func (c *ClientWithResponses) PostDaemonLogControlWithResponse(ctx context.Context, nodename InPathNodeName, body PostDaemonLogControlJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDaemonLogControlResponse, error) {
+	rsp, err := c.PostDaemonLogControl(ctx, nodename, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDaemonLogControlResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetRelayMessageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteDaemonProcessWithResponse request returning *DeleteDaemonProcessResponse
+func (c *ClientWithResponses) DeleteDaemonProcessWithResponse(ctx context.Context, nodename InPathNodeName, params *DeleteDaemonProcessParams, reqEditors ...RequestEditorFn) (*DeleteDaemonProcessResponse, error) {
+	rsp, err := c.DeleteDaemonProcess(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteDaemonProcessResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetRelayMessageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetDaemonProcessWithResponse request returning *GetDaemonProcessResponse
+func (c *ClientWithResponses) GetDaemonProcessWithResponse(ctx context.Context, nodename InPathNodeName, params *GetDaemonProcessParams, reqEditors ...RequestEditorFn) (*GetDaemonProcessResponse, error) {
+	rsp, err := c.GetDaemonProcess(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetDaemonProcessResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetRelayMessageResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// GetNodeDRBDAllocationWithResponse request returning *GetNodeDRBDAllocationResponse
+func (c *ClientWithResponses) GetNodeDRBDAllocationWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeDRBDAllocationResponse, error) {
+	rsp, err := c.GetNodeDRBDAllocation(ctx, nodename, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParseGetNodeDRBDAllocationResponse(rsp)
 }
 
-type PostRelayMessageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *N200
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON500      *N500
+// GetNodeDRBDConfigWithResponse request returning *GetNodeDRBDConfigResponse
+func (c *ClientWithResponses) GetNodeDRBDConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeDRBDConfigParams, reqEditors ...RequestEditorFn) (*GetNodeDRBDConfigResponse, error) {
+	rsp, err := c.GetNodeDRBDConfig(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNodeDRBDConfigResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostRelayMessageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostNodeDRBDConfigWithBodyWithResponse request with arbitrary body returning *PostNodeDRBDConfigResponse
+func (c *ClientWithResponses) PostNodeDRBDConfigWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNodeDRBDConfigResponse, error) {
+	rsp, err := c.PostNodeDRBDConfigWithBody(ctx, nodename, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostNodeDRBDConfigResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostRelayMessageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostNodeDRBDConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDConfigParams, body PostNodeDRBDConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNodeDRBDConfigResponse, error) {
+	rsp, err := c.PostNodeDRBDConfig(ctx, nodename, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostNodeDRBDConfigResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostRelayMessageResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// PostNodeDRBDConnectWithResponse request returning *PostNodeDRBDConnectResponse
+func (c *ClientWithResponses) PostNodeDRBDConnectWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDConnectParams, reqEditors ...RequestEditorFn) (*PostNodeDRBDConnectResponse, error) {
+	rsp, err := c.PostNodeDRBDConnect(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParsePostNodeDRBDConnectResponse(rsp)
 }
 
-type GetRelayStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RelayStatusList
-	JSON401      *N401
-	JSON403      *N403
-	JSON500      *N500
+// PostNodeDRBDPrimaryWithResponse request returning *PostNodeDRBDPrimaryResponse
+func (c *ClientWithResponses) PostNodeDRBDPrimaryWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDPrimaryParams, reqEditors ...RequestEditorFn) (*PostNodeDRBDPrimaryResponse, error) {
+	rsp, err := c.PostNodeDRBDPrimary(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNodeDRBDPrimaryResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetRelayStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostNodeDRBDSecondaryWithResponse request returning *PostNodeDRBDSecondaryResponse
+func (c *ClientWithResponses) PostNodeDRBDSecondaryWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDSecondaryParams, reqEditors ...RequestEditorFn) (*PostNodeDRBDSecondaryResponse, error) {
+	rsp, err := c.PostNodeDRBDSecondary(ctx, nodename, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostNodeDRBDSecondaryResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetRelayStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetNodeDriverWithResponse request returning *GetNodeDriverResponse
+func (c *ClientWithResponses) GetNodeDriverWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeDriverResponse, error) {
+	rsp, err := c.GetNodeDriver(ctx, nodename, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetNodeDriverResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetRelayStatusResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// GetInstanceWithResponse request returning *GetInstanceResponse
+func (c *ClientWithResponses) GetInstanceWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceResponse, error) {
+	rsp, err := c.GetInstance(ctx, nodename, namespace, kind, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParseGetInstanceResponse(rsp)
 }
 
-type GetResourcesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResourceList
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON500      *N500
+// PostInstanceActionBootWithResponse request returning *PostInstanceActionBootResponse
+func (c *ClientWithResponses) PostInstanceActionBootWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionBootParams, reqEditors ...RequestEditorFn) (*PostInstanceActionBootResponse, error) {
+	rsp, err := c.PostInstanceActionBoot(ctx, nodename, namespace, kind, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInstanceActionBootResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetResourcesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostInstanceActionDeleteWithResponse request returning *PostInstanceActionDeleteResponse
+func (c *ClientWithResponses) PostInstanceActionDeleteWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionDeleteParams, reqEditors ...RequestEditorFn) (*PostInstanceActionDeleteResponse, error) {
+	rsp, err := c.PostInstanceActionDelete(ctx, nodename, namespace, kind, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostInstanceActionDeleteResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetResourcesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostInstanceActionFreezeWithResponse request returning *PostInstanceActionFreezeResponse
+func (c *ClientWithResponses) PostInstanceActionFreezeWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionFreezeParams, reqEditors ...RequestEditorFn) (*PostInstanceActionFreezeResponse, error) {
+	rsp, err := c.PostInstanceActionFreeze(ctx, nodename, namespace, kind, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostInstanceActionFreezeResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetResourcesResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// PostInstanceActionPGUpdateWithResponse request returning *PostInstanceActionPGUpdateResponse
+func (c *ClientWithResponses) PostInstanceActionPGUpdateWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPGUpdateParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPGUpdateResponse, error) {
+	rsp, err := c.PostInstanceActionPGUpdate(ctx, nodename, namespace, kind, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParsePostInstanceActionPGUpdateResponse(rsp)
 }
 
-// GetArrayWithResponse request returning *GetArrayResponse
-func (c *ClientWithResponses) GetArrayWithResponse(ctx context.Context, params *GetArrayParams, reqEditors ...RequestEditorFn) (*GetArrayResponse, error) {
-	rsp, err := c.GetArray(ctx, params, reqEditors...)
+// PostInstanceActionProvisionWithResponse request returning *PostInstanceActionProvisionResponse
+func (c *ClientWithResponses) PostInstanceActionProvisionWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionProvisionParams, reqEditors ...RequestEditorFn) (*PostInstanceActionProvisionResponse, error) {
+	rsp, err := c.PostInstanceActionProvision(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetArrayResponse(rsp)
+	return ParsePostInstanceActionProvisionResponse(rsp)
 }
 
-// GetAuthInfoWithResponse request returning *GetAuthInfoResponse
-func (c *ClientWithResponses) GetAuthInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthInfoResponse, error) {
-	rsp, err := c.GetAuthInfo(ctx, reqEditors...)
+// PostInstanceActionPRStartWithResponse request returning *PostInstanceActionPRStartResponse
+func (c *ClientWithResponses) PostInstanceActionPRStartWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPRStartParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPRStartResponse, error) {
+	rsp, err := c.PostInstanceActionPRStart(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAuthInfoResponse(rsp)
+	return ParsePostInstanceActionPRStartResponse(rsp)
 }
 
-// PostAuthRefreshWithResponse request returning *PostAuthRefreshResponse
-func (c *ClientWithResponses) PostAuthRefreshWithResponse(ctx context.Context, params *PostAuthRefreshParams, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error) {
-	rsp, err := c.PostAuthRefresh(ctx, params, reqEditors...)
+// PostInstanceActionPRStopWithResponse request returning *PostInstanceActionPRStopResponse
+func (c *ClientWithResponses) PostInstanceActionPRStopWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPRStopParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPRStopResponse, error) {
+	rsp, err := c.PostInstanceActionPRStop(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostAuthRefreshResponse(rsp)
+	return ParsePostInstanceActionPRStopResponse(rsp)
 }
 
-// PostAuthTokenWithResponse request returning *PostAuthTokenResponse
-func (c *ClientWithResponses) PostAuthTokenWithResponse(ctx context.Context, params *PostAuthTokenParams, reqEditors ...RequestEditorFn) (*PostAuthTokenResponse, error) {
-	rsp, err := c.PostAuthToken(ctx, params, reqEditors...)
+// PostInstanceActionPushResourceInfoWithResponse request returning *PostInstanceActionPushResourceInfoResponse
+func (c *ClientWithResponses) PostInstanceActionPushResourceInfoWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPushResourceInfoParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPushResourceInfoResponse, error) {
+	rsp, err := c.PostInstanceActionPushResourceInfo(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostAuthTokenResponse(rsp)
+	return ParsePostInstanceActionPushResourceInfoResponse(rsp)
 }
 
-// GetAuthWhoAmIWithResponse request returning *GetAuthWhoAmIResponse
-func (c *ClientWithResponses) GetAuthWhoAmIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthWhoAmIResponse, error) {
-	rsp, err := c.GetAuthWhoAmI(ctx, reqEditors...)
+// PostInstanceActionResizeWithResponse request returning *PostInstanceActionResizeResponse
+func (c *ClientWithResponses) PostInstanceActionResizeWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionResizeParams, reqEditors ...RequestEditorFn) (*PostInstanceActionResizeResponse, error) {
+	rsp, err := c.PostInstanceActionResize(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAuthWhoAmIResponse(rsp)
+	return ParsePostInstanceActionResizeResponse(rsp)
 }
 
-// PostClusterActionAbortWithResponse request returning *PostClusterActionAbortResponse
-func (c *ClientWithResponses) PostClusterActionAbortWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterActionAbortResponse, error) {
-	rsp, err := c.PostClusterActionAbort(ctx, reqEditors...)
+// PostInstanceActionRestartWithResponse request returning *PostInstanceActionRestartResponse
+func (c *ClientWithResponses) PostInstanceActionRestartWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionRestartParams, reqEditors ...RequestEditorFn) (*PostInstanceActionRestartResponse, error) {
+	rsp, err := c.PostInstanceActionRestart(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClusterActionAbortResponse(rsp)
+	return ParsePostInstanceActionRestartResponse(rsp)
 }
 
-// PostClusterActionFreezeWithResponse request returning *PostClusterActionFreezeResponse
-func (c *ClientWithResponses) PostClusterActionFreezeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterActionFreezeResponse, error) {
-	rsp, err := c.PostClusterActionFreeze(ctx, reqEditors...)
+// PostInstanceActionRunWithResponse request returning *PostInstanceActionRunResponse
+func (c *ClientWithResponses) PostInstanceActionRunWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionRunParams, reqEditors ...RequestEditorFn) (*PostInstanceActionRunResponse, error) {
+	rsp, err := c.PostInstanceActionRun(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClusterActionFreezeResponse(rsp)
+	return ParsePostInstanceActionRunResponse(rsp)
 }
 
-// PostClusterActionUnfreezeWithResponse request returning *PostClusterActionUnfreezeResponse
-func (c *ClientWithResponses) PostClusterActionUnfreezeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterActionUnfreezeResponse, error) {
-	rsp, err := c.PostClusterActionUnfreeze(ctx, reqEditors...)
+// PostInstanceActionShutdownWithResponse request returning *PostInstanceActionShutdownResponse
+func (c *ClientWithResponses) PostInstanceActionShutdownWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionShutdownParams, reqEditors ...RequestEditorFn) (*PostInstanceActionShutdownResponse, error) {
+	rsp, err := c.PostInstanceActionShutdown(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClusterActionUnfreezeResponse(rsp)
+	return ParsePostInstanceActionShutdownResponse(rsp)
 }
 
-// GetClusterConfigWithResponse request returning *GetClusterConfigResponse
-func (c *ClientWithResponses) GetClusterConfigWithResponse(ctx context.Context, params *GetClusterConfigParams, reqEditors ...RequestEditorFn) (*GetClusterConfigResponse, error) {
-	rsp, err := c.GetClusterConfig(ctx, params, reqEditors...)
+// PostInstanceActionSnapshotCreateWithResponse request returning *PostInstanceActionSnapshotCreateResponse
+func (c *ClientWithResponses) PostInstanceActionSnapshotCreateWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotCreateParams, reqEditors ...RequestEditorFn) (*PostInstanceActionSnapshotCreateResponse, error) {
+	rsp, err := c.PostInstanceActionSnapshotCreate(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetClusterConfigResponse(rsp)
+	return ParsePostInstanceActionSnapshotCreateResponse(rsp)
 }
 
-// PatchClusterConfigWithResponse request returning *PatchClusterConfigResponse
-func (c *ClientWithResponses) PatchClusterConfigWithResponse(ctx context.Context, params *PatchClusterConfigParams, reqEditors ...RequestEditorFn) (*PatchClusterConfigResponse, error) {
-	rsp, err := c.PatchClusterConfig(ctx, params, reqEditors...)
+// PostInstanceActionSnapshotDeleteWithResponse request returning *PostInstanceActionSnapshotDeleteResponse
+func (c *ClientWithResponses) PostInstanceActionSnapshotDeleteWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotDeleteParams, reqEditors ...RequestEditorFn) (*PostInstanceActionSnapshotDeleteResponse, error) {
+	rsp, err := c.PostInstanceActionSnapshotDelete(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchClusterConfigResponse(rsp)
+	return ParsePostInstanceActionSnapshotDeleteResponse(rsp)
 }
 
-// GetClusterConfigFileWithResponse request returning *GetClusterConfigFileResponse
-func (c *ClientWithResponses) GetClusterConfigFileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClusterConfigFileResponse, error) {
-	rsp, err := c.GetClusterConfigFile(ctx, reqEditors...)
+// PostInstanceActionSnapshotRollbackWithResponse request returning *PostInstanceActionSnapshotRollbackResponse
+func (c *ClientWithResponses) PostInstanceActionSnapshotRollbackWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSnapshotRollbackParams, reqEditors ...RequestEditorFn) (*PostInstanceActionSnapshotRollbackResponse, error) {
+	rsp, err := c.PostInstanceActionSnapshotRollback(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetClusterConfigFileResponse(rsp)
+	return ParsePostInstanceActionSnapshotRollbackResponse(rsp)
 }
 
-// PutClusterConfigFileWithBodyWithResponse request with arbitrary body returning *PutClusterConfigFileResponse
-func (c *ClientWithResponses) PutClusterConfigFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutClusterConfigFileResponse, error) {
-	rsp, err := c.PutClusterConfigFileWithBody(ctx, contentType, body, reqEditors...)
+// PostInstanceActionStartWithResponse request returning *PostInstanceActionStartResponse
+func (c *ClientWithResponses) PostInstanceActionStartWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStartResponse, error) {
+	rsp, err := c.PostInstanceActionStart(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutClusterConfigFileResponse(rsp)
+	return ParsePostInstanceActionStartResponse(rsp)
 }
 
-// GetClusterConfigKeywordsWithResponse request returning *GetClusterConfigKeywordsResponse
-func (c *ClientWithResponses) GetClusterConfigKeywordsWithResponse(ctx context.Context, params *GetClusterConfigKeywordsParams, reqEditors ...RequestEditorFn) (*GetClusterConfigKeywordsResponse, error) {
-	rsp, err := c.GetClusterConfigKeywords(ctx, params, reqEditors...)
+// PostInstanceActionStartStandbyWithResponse request returning *PostInstanceActionStartStandbyResponse
+func (c *ClientWithResponses) PostInstanceActionStartStandbyWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartStandbyParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStartStandbyResponse, error) {
+	rsp, err := c.PostInstanceActionStartStandby(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetClusterConfigKeywordsResponse(rsp)
+	return ParsePostInstanceActionStartStandbyResponse(rsp)
 }
 
-// PostClusterHeartbeatRotateWithResponse request returning *PostClusterHeartbeatRotateResponse
-func (c *ClientWithResponses) PostClusterHeartbeatRotateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostClusterHeartbeatRotateResponse, error) {
-	rsp, err := c.PostClusterHeartbeatRotate(ctx, reqEditors...)
+// PostInstanceActionStatusWithResponse request returning *PostInstanceActionStatusResponse
+func (c *ClientWithResponses) PostInstanceActionStatusWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStatusParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStatusResponse, error) {
+	rsp, err := c.PostInstanceActionStatus(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClusterHeartbeatRotateResponse(rsp)
+	return ParsePostInstanceActionStatusResponse(rsp)
 }
 
-// PostClusterJoinWithResponse request returning *PostClusterJoinResponse
-func (c *ClientWithResponses) PostClusterJoinWithResponse(ctx context.Context, params *PostClusterJoinParams, reqEditors ...RequestEditorFn) (*PostClusterJoinResponse, error) {
-	rsp, err := c.PostClusterJoin(ctx, params, reqEditors...)
+// PostInstanceActionStopWithResponse request returning *PostInstanceActionStopResponse
+func (c *ClientWithResponses) PostInstanceActionStopWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStopParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStopResponse, error) {
+	rsp, err := c.PostInstanceActionStop(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClusterJoinResponse(rsp)
+	return ParsePostInstanceActionStopResponse(rsp)
 }
 
-// PostClusterLeaveWithResponse request returning *PostClusterLeaveResponse
-func (c *ClientWithResponses) PostClusterLeaveWithResponse(ctx context.Context, params *PostClusterLeaveParams, reqEditors ...RequestEditorFn) (*PostClusterLeaveResponse, error) {
-	rsp, err := c.PostClusterLeave(ctx, params, reqEditors...)
+// PostInstanceActionSyncIngestWithResponse request returning *PostInstanceActionSyncIngestResponse
+func (c *ClientWithResponses) PostInstanceActionSyncIngestWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSyncIngestParams, reqEditors ...RequestEditorFn) (*PostInstanceActionSyncIngestResponse, error) {
+	rsp, err := c.PostInstanceActionSyncIngest(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClusterLeaveResponse(rsp)
+	return ParsePostInstanceActionSyncIngestResponse(rsp)
 }
 
-// GetClusterStatusWithResponse request returning *GetClusterStatusResponse
-func (c *ClientWithResponses) GetClusterStatusWithResponse(ctx context.Context, params *GetClusterStatusParams, reqEditors ...RequestEditorFn) (*GetClusterStatusResponse, error) {
-	rsp, err := c.GetClusterStatus(ctx, params, reqEditors...)
+// PostInstanceActionUnfreezeWithResponse request returning *PostInstanceActionUnfreezeResponse
+func (c *ClientWithResponses) PostInstanceActionUnfreezeWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionUnfreezeParams, reqEditors ...RequestEditorFn) (*PostInstanceActionUnfreezeResponse, error) {
+	rsp, err := c.PostInstanceActionUnfreeze(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetClusterStatusResponse(rsp)
+	return ParsePostInstanceActionUnfreezeResponse(rsp)
 }
 
-// GetInstancesWithResponse request returning *GetInstancesResponse
-func (c *ClientWithResponses) GetInstancesWithResponse(ctx context.Context, params *GetInstancesParams, reqEditors ...RequestEditorFn) (*GetInstancesResponse, error) {
-	rsp, err := c.GetInstances(ctx, params, reqEditors...)
+// PostInstanceActionUnprovisionWithResponse request returning *PostInstanceActionUnprovisionResponse
+func (c *ClientWithResponses) PostInstanceActionUnprovisionWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionUnprovisionParams, reqEditors ...RequestEditorFn) (*PostInstanceActionUnprovisionResponse, error) {
+	rsp, err := c.PostInstanceActionUnprovision(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetInstancesResponse(rsp)
+	return ParsePostInstanceActionUnprovisionResponse(rsp)
 }
 
-// PostInstanceProgressWithBodyWithResponse request with arbitrary body returning *PostInstanceProgressResponse
-func (c *ClientWithResponses) PostInstanceProgressWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceProgressResponse, error) {
-	rsp, err := c.PostInstanceProgressWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
+// PostInstanceClearWithResponse request returning *PostInstanceClearResponse
+func (c *ClientWithResponses) PostInstanceClearWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostInstanceClearResponse, error) {
+	rsp, err := c.PostInstanceClear(ctx, nodename, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceProgressResponse(rsp)
+	return ParsePostInstanceClearResponse(rsp)
 }
 
-func (c *ClientWithResponses) PostInstanceProgressWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostInstanceProgressJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInstanceProgressResponse, error) {
-	rsp, err := c.PostInstanceProgress(ctx, namespace, kind, name, body, reqEditors...)
+// GetInstanceConfigFileWithResponse request returning *GetInstanceConfigFileResponse
+func (c *ClientWithResponses) GetInstanceConfigFileWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceConfigFileResponse, error) {
+	rsp, err := c.GetInstanceConfigFile(ctx, nodename, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceProgressResponse(rsp)
+	return ParseGetInstanceConfigFileResponse(rsp)
 }
 
-// PostInstanceStatusWithBodyWithResponse request with arbitrary body returning *PostInstanceStatusResponse
-func (c *ClientWithResponses) PostInstanceStatusWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceStatusResponse, error) {
-	rsp, err := c.PostInstanceStatusWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
+// PostInstanceResourceConsoleWithResponse request returning *PostInstanceResourceConsoleResponse
+func (c *ClientWithResponses) PostInstanceResourceConsoleWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceResourceConsoleParams, reqEditors ...RequestEditorFn) (*PostInstanceResourceConsoleResponse, error) {
+	rsp, err := c.PostInstanceResourceConsole(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceStatusResponse(rsp)
+	return ParsePostInstanceResourceConsoleResponse(rsp)
 }
 
-func (c *ClientWithResponses) PostInstanceStatusWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostInstanceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInstanceStatusResponse, error) {
-	rsp, err := c.PostInstanceStatus(ctx, namespace, kind, name, body, reqEditors...)
+// GetInstanceContainerLogWithResponse request returning *GetInstanceContainerLogResponse
+func (c *ClientWithResponses) GetInstanceContainerLogWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceContainerLogParams, reqEditors ...RequestEditorFn) (*GetInstanceContainerLogResponse, error) {
+	rsp, err := c.GetInstanceContainerLog(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceStatusResponse(rsp)
+	return ParseGetInstanceContainerLogResponse(rsp)
 }
 
-// GetNetworksWithResponse request returning *GetNetworksResponse
-func (c *ClientWithResponses) GetNetworksWithResponse(ctx context.Context, params *GetNetworksParams, reqEditors ...RequestEditorFn) (*GetNetworksResponse, error) {
-	rsp, err := c.GetNetworks(ctx, params, reqEditors...)
+// GetInstanceLogsWithResponse request returning *GetInstanceLogsResponse
+func (c *ClientWithResponses) GetInstanceLogsWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceLogsParams, reqEditors ...RequestEditorFn) (*GetInstanceLogsResponse, error) {
+	rsp, err := c.GetInstanceLogs(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNetworksResponse(rsp)
+	return ParseGetInstanceLogsResponse(rsp)
 }
 
-// GetNetworkIPWithResponse request returning *GetNetworkIPResponse
-func (c *ClientWithResponses) GetNetworkIPWithResponse(ctx context.Context, params *GetNetworkIPParams, reqEditors ...RequestEditorFn) (*GetNetworkIPResponse, error) {
-	rsp, err := c.GetNetworkIP(ctx, params, reqEditors...)
+// GetInstanceResourceFileWithResponse request returning *GetInstanceResourceFileResponse
+func (c *ClientWithResponses) GetInstanceResourceFileWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceResourceFileParams, reqEditors ...RequestEditorFn) (*GetInstanceResourceFileResponse, error) {
+	rsp, err := c.GetInstanceResourceFile(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNetworkIPResponse(rsp)
+	return ParseGetInstanceResourceFileResponse(rsp)
 }
 
-// GetNodesWithResponse request returning *GetNodesResponse
-func (c *ClientWithResponses) GetNodesWithResponse(ctx context.Context, params *GetNodesParams, reqEditors ...RequestEditorFn) (*GetNodesResponse, error) {
-	rsp, err := c.GetNodes(ctx, params, reqEditors...)
+// GetInstanceResourceInfoWithResponse request returning *GetInstanceResourceInfoResponse
+func (c *ClientWithResponses) GetInstanceResourceInfoWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceResourceInfoResponse, error) {
+	rsp, err := c.GetInstanceResourceInfo(ctx, nodename, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodesResponse(rsp)
+	return ParseGetInstanceResourceInfoResponse(rsp)
 }
 
-// GetNodesInfoWithResponse request returning *GetNodesInfoResponse
-func (c *ClientWithResponses) GetNodesInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNodesInfoResponse, error) {
-	rsp, err := c.GetNodesInfo(ctx, reqEditors...)
+// GetInstanceScheduleWithResponse request returning *GetInstanceScheduleResponse
+func (c *ClientWithResponses) GetInstanceScheduleWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceScheduleResponse, error) {
+	rsp, err := c.GetInstanceSchedule(ctx, nodename, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodesInfoResponse(rsp)
+	return ParseGetInstanceScheduleResponse(rsp)
 }
 
-// PostPeerActionAbortWithResponse request returning *PostPeerActionAbortResponse
-func (c *ClientWithResponses) PostPeerActionAbortWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostPeerActionAbortResponse, error) {
-	rsp, err := c.PostPeerActionAbort(ctx, nodename, reqEditors...)
+// GetInstanceSnapshotsWithResponse request returning *GetInstanceSnapshotsResponse
+func (c *ClientWithResponses) GetInstanceSnapshotsWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceSnapshotsResponse, error) {
+	rsp, err := c.GetInstanceSnapshots(ctx, nodename, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPeerActionAbortResponse(rsp)
+	return ParseGetInstanceSnapshotsResponse(rsp)
 }
 
-// PostNodeActionClearWithResponse request returning *PostNodeActionClearResponse
-func (c *ClientWithResponses) PostNodeActionClearWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostNodeActionClearResponse, error) {
-	rsp, err := c.PostNodeActionClear(ctx, nodename, reqEditors...)
+// PostInstanceStateFileWithBodyWithResponse request with arbitrary body returning *PostInstanceStateFileResponse
+func (c *ClientWithResponses) PostInstanceStateFileWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceStateFileResponse, error) {
+	rsp, err := c.PostInstanceStateFileWithBody(ctx, nodename, namespace, kind, name, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNodeActionClearResponse(rsp)
+	return ParsePostInstanceStateFileResponse(rsp)
 }
 
-// PostPeerActionDequeueWithResponse request returning *PostPeerActionDequeueResponse
-func (c *ClientWithResponses) PostPeerActionDequeueWithResponse(ctx context.Context, nodename InPathNodeName, params *PostPeerActionDequeueParams, reqEditors ...RequestEditorFn) (*PostPeerActionDequeueResponse, error) {
-	rsp, err := c.PostPeerActionDequeue(ctx, nodename, params, reqEditors...)
+// GetInstanceSyncDataWithResponse request returning *GetInstanceSyncDataResponse
+func (c *ClientWithResponses) GetInstanceSyncDataWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceSyncDataParams, reqEditors ...RequestEditorFn) (*GetInstanceSyncDataResponse, error) {
+	rsp, err := c.GetInstanceSyncData(ctx, nodename, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPeerActionDequeueResponse(rsp)
+	return ParseGetInstanceSyncDataResponse(rsp)
 }
 
-// PostPeerActionDrainWithResponse request returning *PostPeerActionDrainResponse
-func (c *ClientWithResponses) PostPeerActionDrainWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostPeerActionDrainResponse, error) {
-	rsp, err := c.PostPeerActionDrain(ctx, nodename, reqEditors...)
+// PostInstanceSyncDataWithBodyWithResponse request with arbitrary body returning *PostInstanceSyncDataResponse
+func (c *ClientWithResponses) PostInstanceSyncDataWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceSyncDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceSyncDataResponse, error) {
+	rsp, err := c.PostInstanceSyncDataWithBody(ctx, nodename, namespace, kind, name, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPeerActionDrainResponse(rsp)
+	return ParsePostInstanceSyncDataResponse(rsp)
 }
 
-// PostPeerActionFreezeWithResponse request returning *PostPeerActionFreezeResponse
-func (c *ClientWithResponses) PostPeerActionFreezeWithResponse(ctx context.Context, nodename InPathNodeName, params *PostPeerActionFreezeParams, reqEditors ...RequestEditorFn) (*PostPeerActionFreezeResponse, error) {
-	rsp, err := c.PostPeerActionFreeze(ctx, nodename, params, reqEditors...)
+// GetNodeLogsWithResponse request returning *GetNodeLogsResponse
+func (c *ClientWithResponses) GetNodeLogsWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeLogsParams, reqEditors ...RequestEditorFn) (*GetNodeLogsResponse, error) {
+	rsp, err := c.GetNodeLogs(ctx, nodename, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPeerActionFreezeResponse(rsp)
+	return ParseGetNodeLogsResponse(rsp)
 }
 
-// PostNodeActionPushAssetWithResponse request returning *PostNodeActionPushAssetResponse
-func (c *ClientWithResponses) PostNodeActionPushAssetWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionPushAssetParams, reqEditors ...RequestEditorFn) (*PostNodeActionPushAssetResponse, error) {
-	rsp, err := c.PostNodeActionPushAsset(ctx, nodename, params, reqEditors...)
+// DeleteNodeMaintenanceWithResponse request returning *DeleteNodeMaintenanceResponse
+func (c *ClientWithResponses) DeleteNodeMaintenanceWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*DeleteNodeMaintenanceResponse, error) {
+	rsp, err := c.DeleteNodeMaintenance(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNodeActionPushAssetResponse(rsp)
+	return ParseDeleteNodeMaintenanceResponse(rsp)
 }
 
-// PostNodeActionPushDiskWithResponse request returning *PostNodeActionPushDiskResponse
-func (c *ClientWithResponses) PostNodeActionPushDiskWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionPushDiskParams, reqEditors ...RequestEditorFn) (*PostNodeActionPushDiskResponse, error) {
-	rsp, err := c.PostNodeActionPushDisk(ctx, nodename, params, reqEditors...)
+// PostNodeMaintenanceWithBodyWithResponse request with arbitrary body returning *PostNodeMaintenanceResponse
+func (c *ClientWithResponses) PostNodeMaintenanceWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNodeMaintenanceResponse, error) {
+	rsp, err := c.PostNodeMaintenanceWithBody(ctx, nodename, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNodeActionPushDiskResponse(rsp)
+	return ParsePostNodeMaintenanceResponse(rsp)
 }
 
-// PostNodeActionPushPkgWithResponse request returning *PostNodeActionPushPkgResponse
-func (c *ClientWithResponses) PostNodeActionPushPkgWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionPushPkgParams, reqEditors ...RequestEditorFn) (*PostNodeActionPushPkgResponse, error) {
-	rsp, err := c.PostNodeActionPushPkg(ctx, nodename, params, reqEditors...)
+func (c *ClientWithResponses) PostNodeMaintenanceWithResponse(ctx context.Context, nodename InPathNodeName, body PostNodeMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNodeMaintenanceResponse, error) {
+	rsp, err := c.PostNodeMaintenance(ctx, nodename, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNodeActionPushPkgResponse(rsp)
+	return ParsePostNodeMaintenanceResponse(rsp)
 }
 
-// PostNodeActionScanCapabilitiesWithResponse request returning *PostNodeActionScanCapabilitiesResponse
-func (c *ClientWithResponses) PostNodeActionScanCapabilitiesWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionScanCapabilitiesParams, reqEditors ...RequestEditorFn) (*PostNodeActionScanCapabilitiesResponse, error) {
-	rsp, err := c.PostNodeActionScanCapabilities(ctx, nodename, params, reqEditors...)
+// GetNodeMetricsWithResponse request returning *GetNodeMetricsResponse
+func (c *ClientWithResponses) GetNodeMetricsWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeMetricsResponse, error) {
+	rsp, err := c.GetNodeMetrics(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNodeActionScanCapabilitiesResponse(rsp)
+	return ParseGetNodeMetricsResponse(rsp)
 }
 
-// PostNodeActionSCSIScanWithResponse request returning *PostNodeActionSCSIScanResponse
-func (c *ClientWithResponses) PostNodeActionSCSIScanWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionSCSIScanParams, reqEditors ...RequestEditorFn) (*PostNodeActionSCSIScanResponse, error) {
-	rsp, err := c.PostNodeActionSCSIScan(ctx, nodename, params, reqEditors...)
+// GetNodePingWithResponse request returning *GetNodePingResponse
+func (c *ClientWithResponses) GetNodePingWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodePingResponse, error) {
+	rsp, err := c.GetNodePing(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNodeActionSCSIScanResponse(rsp)
+	return ParseGetNodePingResponse(rsp)
 }
 
-// PostNodeActionSysreportWithResponse request returning *PostNodeActionSysreportResponse
-func (c *ClientWithResponses) PostNodeActionSysreportWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeActionSysreportParams, reqEditors ...RequestEditorFn) (*PostNodeActionSysreportResponse, error) {
-	rsp, err := c.PostNodeActionSysreport(ctx, nodename, params, reqEditors...)
+// GetNodeScheduleWithResponse request returning *GetNodeScheduleResponse
+func (c *ClientWithResponses) GetNodeScheduleWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeScheduleResponse, error) {
+	rsp, err := c.GetNodeSchedule(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNodeActionSysreportResponse(rsp)
+	return ParseGetNodeScheduleResponse(rsp)
 }
 
-// PostPeerActionUnfreezeWithResponse request returning *PostPeerActionUnfreezeResponse
-func (c *ClientWithResponses) PostPeerActionUnfreezeWithResponse(ctx context.Context, nodename InPathNodeName, params *PostPeerActionUnfreezeParams, reqEditors ...RequestEditorFn) (*PostPeerActionUnfreezeResponse, error) {
-	rsp, err := c.PostPeerActionUnfreeze(ctx, nodename, params, reqEditors...)
+// GetNodeSSHHostkeysWithResponse request returning *GetNodeSSHHostkeysResponse
+func (c *ClientWithResponses) GetNodeSSHHostkeysWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSSHHostkeysResponse, error) {
+	rsp, err := c.GetNodeSSHHostkeys(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPeerActionUnfreezeResponse(rsp)
+	return ParseGetNodeSSHHostkeysResponse(rsp)
 }
 
-// GetNodeCapabilitiesWithResponse request returning *GetNodeCapabilitiesResponse
-func (c *ClientWithResponses) GetNodeCapabilitiesWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeCapabilitiesResponse, error) {
-	rsp, err := c.GetNodeCapabilities(ctx, nodename, reqEditors...)
+// GetNodeSSHKeyWithResponse request returning *GetNodeSSHKeyResponse
+func (c *ClientWithResponses) GetNodeSSHKeyWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSSHKeyResponse, error) {
+	rsp, err := c.GetNodeSSHKey(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodeCapabilitiesResponse(rsp)
+	return ParseGetNodeSSHKeyResponse(rsp)
 }
 
-// GetNodeConfigWithResponse request returning *GetNodeConfigResponse
-func (c *ClientWithResponses) GetNodeConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeConfigParams, reqEditors ...RequestEditorFn) (*GetNodeConfigResponse, error) {
-	rsp, err := c.GetNodeConfig(ctx, nodename, params, reqEditors...)
+// PutNodeSSHTrustWithResponse request returning *PutNodeSSHTrustResponse
+func (c *ClientWithResponses) PutNodeSSHTrustWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PutNodeSSHTrustResponse, error) {
+	rsp, err := c.PutNodeSSHTrust(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodeConfigResponse(rsp)
+	return ParsePutNodeSSHTrustResponse(rsp)
 }
 
-// PatchNodeConfigWithResponse request returning *PatchNodeConfigResponse
-func (c *ClientWithResponses) PatchNodeConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *PatchNodeConfigParams, reqEditors ...RequestEditorFn) (*PatchNodeConfigResponse, error) {
-	rsp, err := c.PatchNodeConfig(ctx, nodename, params, reqEditors...)
+// GetNodeSystemDiskWithResponse request returning *GetNodeSystemDiskResponse
+func (c *ClientWithResponses) GetNodeSystemDiskWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemDiskResponse, error) {
+	rsp, err := c.GetNodeSystemDisk(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchNodeConfigResponse(rsp)
+	return ParseGetNodeSystemDiskResponse(rsp)
 }
 
-// GetNodeConfigFileWithResponse request returning *GetNodeConfigFileResponse
-func (c *ClientWithResponses) GetNodeConfigFileWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeConfigFileResponse, error) {
-	rsp, err := c.GetNodeConfigFile(ctx, nodename, reqEditors...)
+// GetNodeSystemGroupWithResponse request returning *GetNodeSystemGroupResponse
+func (c *ClientWithResponses) GetNodeSystemGroupWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemGroupResponse, error) {
+	rsp, err := c.GetNodeSystemGroup(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodeConfigFileResponse(rsp)
+	return ParseGetNodeSystemGroupResponse(rsp)
 }
 
-// PutNodeConfigFileWithBodyWithResponse request with arbitrary body returning *PutNodeConfigFileResponse
-func (c *ClientWithResponses) PutNodeConfigFileWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutNodeConfigFileResponse, error) {
-	rsp, err := c.PutNodeConfigFileWithBody(ctx, nodename, contentType, body, reqEditors...)
+// GetNodeSystemHardwareWithResponse request returning *GetNodeSystemHardwareResponse
+func (c *ClientWithResponses) GetNodeSystemHardwareWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemHardwareResponse, error) {
+	rsp, err := c.GetNodeSystemHardware(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutNodeConfigFileResponse(rsp)
+	return ParseGetNodeSystemHardwareResponse(rsp)
 }
 
-// GetNodeConfigKeywordsWithResponse request returning *GetNodeConfigKeywordsResponse
-func (c *ClientWithResponses) GetNodeConfigKeywordsWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeConfigKeywordsParams, reqEditors ...RequestEditorFn) (*GetNodeConfigKeywordsResponse, error) {
-	rsp, err := c.GetNodeConfigKeywords(ctx, nodename, params, reqEditors...)
+// GetNodeSystemIPAddressWithResponse request returning *GetNodeSystemIPAddressResponse
+func (c *ClientWithResponses) GetNodeSystemIPAddressWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemIPAddressResponse, error) {
+	rsp, err := c.GetNodeSystemIPAddress(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodeConfigKeywordsResponse(rsp)
+	return ParseGetNodeSystemIPAddressResponse(rsp)
 }
 
-// PostDaemonRestartWithResponse request returning *PostDaemonRestartResponse
-func (c *ClientWithResponses) PostDaemonRestartWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostDaemonRestartResponse, error) {
-	rsp, err := c.PostDaemonRestart(ctx, nodename, reqEditors...)
+// GetNodeSystemPackageWithResponse request returning *GetNodeSystemPackageResponse
+func (c *ClientWithResponses) GetNodeSystemPackageWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemPackageResponse, error) {
+	rsp, err := c.GetNodeSystemPackage(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonRestartResponse(rsp)
+	return ParseGetNodeSystemPackageResponse(rsp)
 }
 
-// PostDaemonShutdownWithResponse request returning *PostDaemonShutdownResponse
-func (c *ClientWithResponses) PostDaemonShutdownWithResponse(ctx context.Context, nodename InPathNodeName, params *PostDaemonShutdownParams, reqEditors ...RequestEditorFn) (*PostDaemonShutdownResponse, error) {
-	rsp, err := c.PostDaemonShutdown(ctx, nodename, params, reqEditors...)
+// GetNodeSystemPropertyWithResponse request returning *GetNodeSystemPropertyResponse
+func (c *ClientWithResponses) GetNodeSystemPropertyWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemPropertyResponse, error) {
+	rsp, err := c.GetNodeSystemProperty(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonShutdownResponse(rsp)
+	return ParseGetNodeSystemPropertyResponse(rsp)
 }
 
-// PostDaemonStopWithResponse request returning *PostDaemonStopResponse
-func (c *ClientWithResponses) PostDaemonStopWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PostDaemonStopResponse, error) {
-	rsp, err := c.PostDaemonStop(ctx, nodename, reqEditors...)
+// GetNodeSystemSANInitiatorWithResponse request returning *GetNodeSystemSANInitiatorResponse
+func (c *ClientWithResponses) GetNodeSystemSANInitiatorWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemSANInitiatorResponse, error) {
+	rsp, err := c.GetNodeSystemSANInitiator(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonStopResponse(rsp)
+	return ParseGetNodeSystemSANInitiatorResponse(rsp)
 }
 
-// PostDaemonAuditWithResponse request returning *PostDaemonAuditResponse
-func (c *ClientWithResponses) PostDaemonAuditWithResponse(ctx context.Context, nodename InPathNodeName, params *PostDaemonAuditParams, reqEditors ...RequestEditorFn) (*PostDaemonAuditResponse, error) {
-	rsp, err := c.PostDaemonAudit(ctx, nodename, params, reqEditors...)
+// GetNodeSystemSANPathWithResponse request returning *GetNodeSystemSANPathResponse
+func (c *ClientWithResponses) GetNodeSystemSANPathWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemSANPathResponse, error) {
+	rsp, err := c.GetNodeSystemSANPath(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonAuditResponse(rsp)
+	return ParseGetNodeSystemSANPathResponse(rsp)
 }
 
-// GetDaemonDNSDumpWithResponse request returning *GetDaemonDNSDumpResponse
-func (c *ClientWithResponses) GetDaemonDNSDumpWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetDaemonDNSDumpResponse, error) {
-	rsp, err := c.GetDaemonDNSDump(ctx, nodename, reqEditors...)
+// GetNodeSystemUserWithResponse request returning *GetNodeSystemUserResponse
+func (c *ClientWithResponses) GetNodeSystemUserWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemUserResponse, error) {
+	rsp, err := c.GetNodeSystemUser(ctx, nodename, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDaemonDNSDumpResponse(rsp)
+	return ParseGetNodeSystemUserResponse(rsp)
 }
 
-// GetDaemonEventsWithResponse request returning *GetDaemonEventsResponse
-func (c *ClientWithResponses) GetDaemonEventsWithResponse(ctx context.Context, nodename InPathNodeName, params *GetDaemonEventsParams, reqEditors ...RequestEditorFn) (*GetDaemonEventsResponse, error) {
-	rsp, err := c.GetDaemonEvents(ctx, nodename, params, reqEditors...)
+// GetObjectsWithResponse request returning *GetObjectsResponse
+func (c *ClientWithResponses) GetObjectsWithResponse(ctx context.Context, params *GetObjectsParams, reqEditors ...RequestEditorFn) (*GetObjectsResponse, error) {
+	rsp, err := c.GetObjects(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDaemonEventsResponse(rsp)
+	return ParseGetObjectsResponse(rsp)
 }
 
-// PostDaemonHeartbeatRestartWithResponse request returning *PostDaemonHeartbeatRestartResponse
-func (c *ClientWithResponses) PostDaemonHeartbeatRestartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatRestartResponse, error) {
-	rsp, err := c.PostDaemonHeartbeatRestart(ctx, nodename, name, reqEditors...)
+// PostObjectsActionWithBodyWithResponse request with arbitrary body returning *PostObjectsActionResponse
+func (c *ClientWithResponses) PostObjectsActionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectsActionResponse, error) {
+	rsp, err := c.PostObjectsActionWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonHeartbeatRestartResponse(rsp)
+	return ParsePostObjectsActionResponse(rsp)
 }
 
-// PostDaemonHeartbeatSignWithResponse request returning *PostDaemonHeartbeatSignResponse
-func (c *ClientWithResponses) PostDaemonHeartbeatSignWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatSignResponse, error) {
-	rsp, err := c.PostDaemonHeartbeatSign(ctx, nodename, name, reqEditors...)
+func (c *ClientWithResponses) PostObjectsActionWithResponse(ctx context.Context, body PostObjectsActionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostObjectsActionResponse, error) {
+	rsp, err := c.PostObjectsAction(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonHeartbeatSignResponse(rsp)
+	return ParsePostObjectsActionResponse(rsp)
 }
 
-// PostDaemonHeartbeatStartWithResponse request returning *PostDaemonHeartbeatStartResponse
-func (c *ClientWithResponses) PostDaemonHeartbeatStartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatStartResponse, error) {
-	rsp, err := c.PostDaemonHeartbeatStart(ctx, nodename, name, reqEditors...)
+// GetObjectPathsWithResponse request returning *GetObjectPathsResponse
+func (c *ClientWithResponses) GetObjectPathsWithResponse(ctx context.Context, params *GetObjectPathsParams, reqEditors ...RequestEditorFn) (*GetObjectPathsResponse, error) {
+	rsp, err := c.GetObjectPaths(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonHeartbeatStartResponse(rsp)
+	return ParseGetObjectPathsResponse(rsp)
 }
 
-// PostDaemonHeartbeatStopWithResponse request returning *PostDaemonHeartbeatStopResponse
-func (c *ClientWithResponses) PostDaemonHeartbeatStopWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatStopResponse, error) {
-	rsp, err := c.PostDaemonHeartbeatStop(ctx, nodename, name, reqEditors...)
+// PostSvcDisableWithResponse request returning *PostSvcDisableResponse
+func (c *ClientWithResponses) PostSvcDisableWithResponse(ctx context.Context, namespace InPathNamespace, name InPathName, params *PostSvcDisableParams, reqEditors ...RequestEditorFn) (*PostSvcDisableResponse, error) {
+	rsp, err := c.PostSvcDisable(ctx, namespace, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonHeartbeatStopResponse(rsp)
+	return ParsePostSvcDisableResponse(rsp)
 }
 
-// PostDaemonHeartbeatWipeWithResponse request returning *PostDaemonHeartbeatWipeResponse
-func (c *ClientWithResponses) PostDaemonHeartbeatWipeWithResponse(ctx context.Context, nodename InPathNodeName, name InPathHeartbeatName, reqEditors ...RequestEditorFn) (*PostDaemonHeartbeatWipeResponse, error) {
-	rsp, err := c.PostDaemonHeartbeatWipe(ctx, nodename, name, reqEditors...)
+// PostSvcEnableWithResponse request returning *PostSvcEnableResponse
+func (c *ClientWithResponses) PostSvcEnableWithResponse(ctx context.Context, namespace InPathNamespace, name InPathName, params *PostSvcEnableParams, reqEditors ...RequestEditorFn) (*PostSvcEnableResponse, error) {
+	rsp, err := c.PostSvcEnable(ctx, namespace, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonHeartbeatWipeResponse(rsp)
+	return ParsePostSvcEnableResponse(rsp)
 }
 
-// PostDaemonListenerRestartWithResponse request returning *PostDaemonListenerRestartResponse
-func (c *ClientWithResponses) PostDaemonListenerRestartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, reqEditors ...RequestEditorFn) (*PostDaemonListenerRestartResponse, error) {
-	rsp, err := c.PostDaemonListenerRestart(ctx, nodename, name, reqEditors...)
+// GetObjectWithResponse request returning *GetObjectResponse
+func (c *ClientWithResponses) GetObjectWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectResponse, error) {
+	rsp, err := c.GetObject(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonListenerRestartResponse(rsp)
+	return ParseGetObjectResponse(rsp)
 }
 
-// PostDaemonListenerStartWithResponse request returning *PostDaemonListenerStartResponse
-func (c *ClientWithResponses) PostDaemonListenerStartWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, reqEditors ...RequestEditorFn) (*PostDaemonListenerStartResponse, error) {
-	rsp, err := c.PostDaemonListenerStart(ctx, nodename, name, reqEditors...)
+// PostObjectActionAbortWithResponse request returning *PostObjectActionAbortResponse
+func (c *ClientWithResponses) PostObjectActionAbortWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionAbortResponse, error) {
+	rsp, err := c.PostObjectActionAbort(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonListenerStartResponse(rsp)
+	return ParsePostObjectActionAbortResponse(rsp)
 }
 
-// PostDaemonListenerStopWithResponse request returning *PostDaemonListenerStopResponse
-func (c *ClientWithResponses) PostDaemonListenerStopWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, reqEditors ...RequestEditorFn) (*PostDaemonListenerStopResponse, error) {
-	rsp, err := c.PostDaemonListenerStop(ctx, nodename, name, reqEditors...)
+// PostObjectActionDeleteWithResponse request returning *PostObjectActionDeleteResponse
+func (c *ClientWithResponses) PostObjectActionDeleteWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionDeleteResponse, error) {
+	rsp, err := c.PostObjectActionDelete(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonListenerStopResponse(rsp)
+	return ParsePostObjectActionDeleteResponse(rsp)
 }
 
-// PostDaemonListenerLogControlWithBodyWithResponse request with arbitrary body returning *PostDaemonListenerLogControlResponse
-func (c *ClientWithResponses) PostDaemonListenerLogControlWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDaemonListenerLogControlResponse, error) {
-	rsp, err := c.PostDaemonListenerLogControlWithBody(ctx, nodename, name, contentType, body, reqEditors...)
+// PostObjectActionFreezeWithResponse request returning *PostObjectActionFreezeResponse
+func (c *ClientWithResponses) PostObjectActionFreezeWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionFreezeResponse, error) {
+	rsp, err := c.PostObjectActionFreeze(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonListenerLogControlResponse(rsp)
+	return ParsePostObjectActionFreezeResponse(rsp)
 }
 
-func (c *ClientWithResponses) PostDaemonListenerLogControlWithResponse(ctx context.Context, nodename InPathNodeName, name InPathListenerName, body PostDaemonListenerLogControlJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDaemonListenerLogControlResponse, error) {
-	rsp, err := c.PostDaemonListenerLogControl(ctx, nodename, name, body, reqEditors...)
+// PostObjectActionGivebackWithResponse request returning *PostObjectActionGivebackResponse
+func (c *ClientWithResponses) PostObjectActionGivebackWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionGivebackResponse, error) {
+	rsp, err := c.PostObjectActionGiveback(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonListenerLogControlResponse(rsp)
+	return ParsePostObjectActionGivebackResponse(rsp)
 }
 
-// PostDaemonLogControlWithBodyWithResponse request with arbitrary body returning *PostDaemonLogControlResponse
-func (c *ClientWithResponses) PostDaemonLogControlWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDaemonLogControlResponse, error) {
-	rsp, err := c.PostDaemonLogControlWithBody(ctx, nodename, contentType, body, reqEditors...)
+// PostObjectActionProvisionWithResponse request returning *PostObjectActionProvisionResponse
+func (c *ClientWithResponses) PostObjectActionProvisionWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionProvisionResponse, error) {
+	rsp, err := c.PostObjectActionProvision(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonLogControlResponse(rsp)
+	return ParsePostObjectActionProvisionResponse(rsp)
 }
 
-func (c *ClientWithResponses) PostDaemonLogControlWithResponse(ctx context.Context, nodename InPathNodeName, body PostDaemonLogControlJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDaemonLogControlResponse, error) {
-	rsp, err := c.PostDaemonLogControl(ctx, nodename, body, reqEditors...)
+// PostObjectActionPurgeWithResponse request returning *PostObjectActionPurgeResponse
+func (c *ClientWithResponses) PostObjectActionPurgeWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionPurgeResponse, error) {
+	rsp, err := c.PostObjectActionPurge(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDaemonLogControlResponse(rsp)
+	return ParsePostObjectActionPurgeResponse(rsp)
 }
 
-// DeleteDaemonProcessWithResponse request returning *DeleteDaemonProcessResponse
-func (c *ClientWithResponses) DeleteDaemonProcessWithResponse(ctx context.Context, nodename InPathNodeName, params *DeleteDaemonProcessParams, reqEditors ...RequestEditorFn) (*DeleteDaemonProcessResponse, error) {
-	rsp, err := c.DeleteDaemonProcess(ctx, nodename, params, reqEditors...)
+// PostObjectActionRestartWithBodyWithResponse request with arbitrary body returning *PostObjectActionRestartResponse
+func (c *ClientWithResponses) PostObjectActionRestartWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectActionRestartResponse, error) {
+	rsp, err := c.PostObjectActionRestartWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteDaemonProcessResponse(rsp)
+	return ParsePostObjectActionRestartResponse(rsp)
 }
 
-// GetDaemonProcessWithResponse request returning *GetDaemonProcessResponse
-func (c *ClientWithResponses) GetDaemonProcessWithResponse(ctx context.Context, nodename InPathNodeName, params *GetDaemonProcessParams, reqEditors ...RequestEditorFn) (*GetDaemonProcessResponse, error) {
-	rsp, err := c.GetDaemonProcess(ctx, nodename, params, reqEditors...)
+func (c *ClientWithResponses) PostObjectActionRestartWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostObjectActionRestartJSONRequestBody, reqEditors ...RequestEditorFn) (*PostObjectActionRestartResponse, error) {
+	rsp, err := c.PostObjectActionRestart(ctx, namespace, kind, name, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDaemonProcessResponse(rsp)
+	return ParsePostObjectActionRestartResponse(rsp)
 }
 
-// GetNodeDRBDAllocationWithResponse request returning *GetNodeDRBDAllocationResponse
-func (c *ClientWithResponses) GetNodeDRBDAllocationWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeDRBDAllocationResponse, error) {
-	rsp, err := c.GetNodeDRBDAllocation(ctx, nodename, reqEditors...)
+// PostObjectActionStartWithResponse request returning *PostObjectActionStartResponse
+func (c *ClientWithResponses) PostObjectActionStartWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionStartResponse, error) {
+	rsp, err := c.PostObjectActionStart(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodeDRBDAllocationResponse(rsp)
+	return ParsePostObjectActionStartResponse(rsp)
 }
 
-// GetNodeDRBDConfigWithResponse request returning *GetNodeDRBDConfigResponse
-func (c *ClientWithResponses) GetNodeDRBDConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeDRBDConfigParams, reqEditors ...RequestEditorFn) (*GetNodeDRBDConfigResponse, error) {
-	rsp, err := c.GetNodeDRBDConfig(ctx, nodename, params, reqEditors...)
+// PostObjectActionStopWithResponse request returning *PostObjectActionStopResponse
+func (c *ClientWithResponses) PostObjectActionStopWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionStopResponse, error) {
+	rsp, err := c.PostObjectActionStop(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodeDRBDConfigResponse(rsp)
+	return ParsePostObjectActionStopResponse(rsp)
 }
 
-// PostNodeDRBDConfigWithBodyWithResponse request with arbitrary body returning *PostNodeDRBDConfigResponse
-func (c *ClientWithResponses) PostNodeDRBDConfigWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNodeDRBDConfigResponse, error) {
-	rsp, err := c.PostNodeDRBDConfigWithBody(ctx, nodename, params, contentType, body, reqEditors...)
+// PostObjectActionSwitchWithBodyWithResponse request with arbitrary body returning *PostObjectActionSwitchResponse
+func (c *ClientWithResponses) PostObjectActionSwitchWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectActionSwitchResponse, error) {
+	rsp, err := c.PostObjectActionSwitchWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNodeDRBDConfigResponse(rsp)
+	return ParsePostObjectActionSwitchResponse(rsp)
 }
 
-func (c *ClientWithResponses) PostNodeDRBDConfigWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDConfigParams, body PostNodeDRBDConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNodeDRBDConfigResponse, error) {
-	rsp, err := c.PostNodeDRBDConfig(ctx, nodename, params, body, reqEditors...)
+func (c *ClientWithResponses) PostObjectActionSwitchWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostObjectActionSwitchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostObjectActionSwitchResponse, error) {
+	rsp, err := c.PostObjectActionSwitch(ctx, namespace, kind, name, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNodeDRBDConfigResponse(rsp)
+	return ParsePostObjectActionSwitchResponse(rsp)
 }
 
-// PostNodeDRBDConnectWithResponse request returning *PostNodeDRBDConnectResponse
-func (c *ClientWithResponses) PostNodeDRBDConnectWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDConnectParams, reqEditors ...RequestEditorFn) (*PostNodeDRBDConnectResponse, error) {
-	rsp, err := c.PostNodeDRBDConnect(ctx, nodename, params, reqEditors...)
+// PostObjectActionUnfreezeWithResponse request returning *PostObjectActionUnfreezeResponse
+func (c *ClientWithResponses) PostObjectActionUnfreezeWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionUnfreezeResponse, error) {
+	rsp, err := c.PostObjectActionUnfreeze(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNodeDRBDConnectResponse(rsp)
+	return ParsePostObjectActionUnfreezeResponse(rsp)
 }
 
-// PostNodeDRBDPrimaryWithResponse request returning *PostNodeDRBDPrimaryResponse
-func (c *ClientWithResponses) PostNodeDRBDPrimaryWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDPrimaryParams, reqEditors ...RequestEditorFn) (*PostNodeDRBDPrimaryResponse, error) {
-	rsp, err := c.PostNodeDRBDPrimary(ctx, nodename, params, reqEditors...)
+// PostObjectActionUnprovisionWithResponse request returning *PostObjectActionUnprovisionResponse
+func (c *ClientWithResponses) PostObjectActionUnprovisionWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionUnprovisionResponse, error) {
+	rsp, err := c.PostObjectActionUnprovision(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNodeDRBDPrimaryResponse(rsp)
+	return ParsePostObjectActionUnprovisionResponse(rsp)
 }
 
-// PostNodeDRBDSecondaryWithResponse request returning *PostNodeDRBDSecondaryResponse
-func (c *ClientWithResponses) PostNodeDRBDSecondaryWithResponse(ctx context.Context, nodename InPathNodeName, params *PostNodeDRBDSecondaryParams, reqEditors ...RequestEditorFn) (*PostNodeDRBDSecondaryResponse, error) {
-	rsp, err := c.PostNodeDRBDSecondary(ctx, nodename, params, reqEditors...)
+// GetObjectConfigWithResponse request returning *GetObjectConfigResponse
+func (c *ClientWithResponses) GetObjectConfigWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectConfigParams, reqEditors ...RequestEditorFn) (*GetObjectConfigResponse, error) {
+	rsp, err := c.GetObjectConfig(ctx, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNodeDRBDSecondaryResponse(rsp)
+	return ParseGetObjectConfigResponse(rsp)
 }
 
-// GetNodeDriverWithResponse request returning *GetNodeDriverResponse
-func (c *ClientWithResponses) GetNodeDriverWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeDriverResponse, error) {
-	rsp, err := c.GetNodeDriver(ctx, nodename, reqEditors...)
+// PatchObjectConfigWithResponse request returning *PatchObjectConfigResponse
+func (c *ClientWithResponses) PatchObjectConfigWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PatchObjectConfigParams, reqEditors ...RequestEditorFn) (*PatchObjectConfigResponse, error) {
+	rsp, err := c.PatchObjectConfig(ctx, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodeDriverResponse(rsp)
+	return ParsePatchObjectConfigResponse(rsp)
 }
 
-// GetInstanceWithResponse request returning *GetInstanceResponse
-func (c *ClientWithResponses) GetInstanceWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceResponse, error) {
-	rsp, err := c.GetInstance(ctx, nodename, namespace, kind, name, reqEditors...)
+// GetObjectConfigFileWithResponse request returning *GetObjectConfigFileResponse
+func (c *ClientWithResponses) GetObjectConfigFileWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectConfigFileResponse, error) {
+	rsp, err := c.GetObjectConfigFile(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetInstanceResponse(rsp)
+	return ParseGetObjectConfigFileResponse(rsp)
 }
 
-// PostInstanceActionBootWithResponse request returning *PostInstanceActionBootResponse
-func (c *ClientWithResponses) PostInstanceActionBootWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionBootParams, reqEditors ...RequestEditorFn) (*PostInstanceActionBootResponse, error) {
-	rsp, err := c.PostInstanceActionBoot(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// PostObjectConfigFileWithBodyWithResponse request with arbitrary body returning *PostObjectConfigFileResponse
+func (c *ClientWithResponses) PostObjectConfigFileWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectConfigFileResponse, error) {
+	rsp, err := c.PostObjectConfigFileWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionBootResponse(rsp)
+	return ParsePostObjectConfigFileResponse(rsp)
 }
 
-// PostInstanceActionDeleteWithResponse request returning *PostInstanceActionDeleteResponse
-func (c *ClientWithResponses) PostInstanceActionDeleteWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionDeleteParams, reqEditors ...RequestEditorFn) (*PostInstanceActionDeleteResponse, error) {
-	rsp, err := c.PostInstanceActionDelete(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// PutObjectConfigFileWithBodyWithResponse request with arbitrary body returning *PutObjectConfigFileResponse
+func (c *ClientWithResponses) PutObjectConfigFileWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutObjectConfigFileResponse, error) {
+	rsp, err := c.PutObjectConfigFileWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionDeleteResponse(rsp)
+	return ParsePutObjectConfigFileResponse(rsp)
 }
 
-// PostInstanceActionFreezeWithResponse request returning *PostInstanceActionFreezeResponse
-func (c *ClientWithResponses) PostInstanceActionFreezeWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionFreezeParams, reqEditors ...RequestEditorFn) (*PostInstanceActionFreezeResponse, error) {
-	rsp, err := c.PostInstanceActionFreeze(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// GetObjectConfigKeywordsWithResponse request returning *GetObjectConfigKeywordsResponse
+func (c *ClientWithResponses) GetObjectConfigKeywordsWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectConfigKeywordsParams, reqEditors ...RequestEditorFn) (*GetObjectConfigKeywordsResponse, error) {
+	rsp, err := c.GetObjectConfigKeywords(ctx, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionFreezeResponse(rsp)
+	return ParseGetObjectConfigKeywordsResponse(rsp)
 }
 
-// PostInstanceActionPGUpdateWithResponse request returning *PostInstanceActionPGUpdateResponse
-func (c *ClientWithResponses) PostInstanceActionPGUpdateWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPGUpdateParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPGUpdateResponse, error) {
-	rsp, err := c.PostInstanceActionPGUpdate(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// GetObjectDataWithResponse request returning *GetObjectDataResponse
+func (c *ClientWithResponses) GetObjectDataWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectDataParams, reqEditors ...RequestEditorFn) (*GetObjectDataResponse, error) {
+	rsp, err := c.GetObjectData(ctx, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionPGUpdateResponse(rsp)
+	return ParseGetObjectDataResponse(rsp)
 }
 
-// PostInstanceActionProvisionWithResponse request returning *PostInstanceActionProvisionResponse
-func (c *ClientWithResponses) PostInstanceActionProvisionWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionProvisionParams, reqEditors ...RequestEditorFn) (*PostInstanceActionProvisionResponse, error) {
-	rsp, err := c.PostInstanceActionProvision(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// PatchObjectDataWithBodyWithResponse request with arbitrary body returning *PatchObjectDataResponse
+func (c *ClientWithResponses) PatchObjectDataWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchObjectDataResponse, error) {
+	rsp, err := c.PatchObjectDataWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionProvisionResponse(rsp)
+	return ParsePatchObjectDataResponse(rsp)
 }
 
-// PostInstanceActionPRStartWithResponse request returning *PostInstanceActionPRStartResponse
-func (c *ClientWithResponses) PostInstanceActionPRStartWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPRStartParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPRStartResponse, error) {
-	rsp, err := c.PostInstanceActionPRStart(ctx, nodename, namespace, kind, name, params, reqEditors...)
+func (c *ClientWithResponses) PatchObjectDataWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PatchObjectDataJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchObjectDataResponse, error) {
+	rsp, err := c.PatchObjectData(ctx, namespace, kind, name, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionPRStartResponse(rsp)
+	return ParsePatchObjectDataResponse(rsp)
 }
 
-// PostInstanceActionPRStopWithResponse request returning *PostInstanceActionPRStopResponse
-func (c *ClientWithResponses) PostInstanceActionPRStopWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPRStopParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPRStopResponse, error) {
-	rsp, err := c.PostInstanceActionPRStop(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// DeleteObjectDataKeyWithResponse request returning *DeleteObjectDataKeyResponse
+func (c *ClientWithResponses) DeleteObjectDataKeyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *DeleteObjectDataKeyParams, reqEditors ...RequestEditorFn) (*DeleteObjectDataKeyResponse, error) {
+	rsp, err := c.DeleteObjectDataKey(ctx, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionPRStopResponse(rsp)
+	return ParseDeleteObjectDataKeyResponse(rsp)
 }
 
-// PostInstanceActionPushResourceInfoWithResponse request returning *PostInstanceActionPushResourceInfoResponse
-func (c *ClientWithResponses) PostInstanceActionPushResourceInfoWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionPushResourceInfoParams, reqEditors ...RequestEditorFn) (*PostInstanceActionPushResourceInfoResponse, error) {
-	rsp, err := c.PostInstanceActionPushResourceInfo(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// GetObjectDataKeyWithResponse request returning *GetObjectDataKeyResponse
+func (c *ClientWithResponses) GetObjectDataKeyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectDataKeyParams, reqEditors ...RequestEditorFn) (*GetObjectDataKeyResponse, error) {
+	rsp, err := c.GetObjectDataKey(ctx, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionPushResourceInfoResponse(rsp)
+	return ParseGetObjectDataKeyResponse(rsp)
 }
 
-// PostInstanceActionRestartWithResponse request returning *PostInstanceActionRestartResponse
-func (c *ClientWithResponses) PostInstanceActionRestartWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionRestartParams, reqEditors ...RequestEditorFn) (*PostInstanceActionRestartResponse, error) {
-	rsp, err := c.PostInstanceActionRestart(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// PostObjectDataKeyWithBodyWithResponse request with arbitrary body returning *PostObjectDataKeyResponse
+func (c *ClientWithResponses) PostObjectDataKeyWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostObjectDataKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectDataKeyResponse, error) {
+	rsp, err := c.PostObjectDataKeyWithBody(ctx, namespace, kind, name, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionRestartResponse(rsp)
+	return ParsePostObjectDataKeyResponse(rsp)
 }
 
-// PostInstanceActionRunWithResponse request returning *PostInstanceActionRunResponse
-func (c *ClientWithResponses) PostInstanceActionRunWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionRunParams, reqEditors ...RequestEditorFn) (*PostInstanceActionRunResponse, error) {
-	rsp, err := c.PostInstanceActionRun(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// PutObjectDataKeyWithBodyWithResponse request with arbitrary body returning *PutObjectDataKeyResponse
+func (c *ClientWithResponses) PutObjectDataKeyWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PutObjectDataKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutObjectDataKeyResponse, error) {
+	rsp, err := c.PutObjectDataKeyWithBody(ctx, namespace, kind, name, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionRunResponse(rsp)
+	return ParsePutObjectDataKeyResponse(rsp)
 }
 
-// PostInstanceActionShutdownWithResponse request returning *PostInstanceActionShutdownResponse
-func (c *ClientWithResponses) PostInstanceActionShutdownWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionShutdownParams, reqEditors ...RequestEditorFn) (*PostInstanceActionShutdownResponse, error) {
-	rsp, err := c.PostInstanceActionShutdown(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// GetObjectDataKeysWithResponse request returning *GetObjectDataKeysResponse
+func (c *ClientWithResponses) GetObjectDataKeysWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectDataKeysResponse, error) {
+	rsp, err := c.GetObjectDataKeys(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionShutdownResponse(rsp)
+	return ParseGetObjectDataKeysResponse(rsp)
 }
 
-// PostInstanceActionStartWithResponse request returning *PostInstanceActionStartResponse
-func (c *ClientWithResponses) PostInstanceActionStartWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStartResponse, error) {
-	rsp, err := c.PostInstanceActionStart(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// GetObjectResourceInfoWithResponse request returning *GetObjectResourceInfoResponse
+func (c *ClientWithResponses) GetObjectResourceInfoWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectResourceInfoResponse, error) {
+	rsp, err := c.GetObjectResourceInfo(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionStartResponse(rsp)
+	return ParseGetObjectResourceInfoResponse(rsp)
 }
 
-// PostInstanceActionStartStandbyWithResponse request returning *PostInstanceActionStartStandbyResponse
-func (c *ClientWithResponses) PostInstanceActionStartStandbyWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStartStandbyParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStartStandbyResponse, error) {
-	rsp, err := c.PostInstanceActionStartStandby(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// GetObjectScheduleWithResponse request returning *GetObjectScheduleResponse
+func (c *ClientWithResponses) GetObjectScheduleWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectScheduleResponse, error) {
+	rsp, err := c.GetObjectSchedule(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionStartStandbyResponse(rsp)
+	return ParseGetObjectScheduleResponse(rsp)
 }
 
-// PostInstanceActionStatusWithResponse request returning *PostInstanceActionStatusResponse
-func (c *ClientWithResponses) PostInstanceActionStatusWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStatusParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStatusResponse, error) {
-	rsp, err := c.PostInstanceActionStatus(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// DeleteObjectTokenWithResponse request returning *DeleteObjectTokenResponse
+func (c *ClientWithResponses) DeleteObjectTokenWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *DeleteObjectTokenParams, reqEditors ...RequestEditorFn) (*DeleteObjectTokenResponse, error) {
+	rsp, err := c.DeleteObjectToken(ctx, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionStatusResponse(rsp)
+	return ParseDeleteObjectTokenResponse(rsp)
 }
 
-// PostInstanceActionStopWithResponse request returning *PostInstanceActionStopResponse
-func (c *ClientWithResponses) PostInstanceActionStopWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionStopParams, reqEditors ...RequestEditorFn) (*PostInstanceActionStopResponse, error) {
-	rsp, err := c.PostInstanceActionStop(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// GetObjectTokensWithResponse request returning *GetObjectTokensResponse
+func (c *ClientWithResponses) GetObjectTokensWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectTokensResponse, error) {
+	rsp, err := c.GetObjectTokens(ctx, namespace, kind, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionStopResponse(rsp)
+	return ParseGetObjectTokensResponse(rsp)
 }
 
-// PostInstanceActionSyncIngestWithResponse request returning *PostInstanceActionSyncIngestResponse
-func (c *ClientWithResponses) PostInstanceActionSyncIngestWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionSyncIngestParams, reqEditors ...RequestEditorFn) (*PostInstanceActionSyncIngestResponse, error) {
-	rsp, err := c.PostInstanceActionSyncIngest(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// PostObjectTokenWithResponse request returning *PostObjectTokenResponse
+func (c *ClientWithResponses) PostObjectTokenWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostObjectTokenParams, reqEditors ...RequestEditorFn) (*PostObjectTokenResponse, error) {
+	rsp, err := c.PostObjectToken(ctx, namespace, kind, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionSyncIngestResponse(rsp)
+	return ParsePostObjectTokenResponse(rsp)
 }
 
-// PostInstanceActionUnfreezeWithResponse request returning *PostInstanceActionUnfreezeResponse
-func (c *ClientWithResponses) PostInstanceActionUnfreezeWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionUnfreezeParams, reqEditors ...RequestEditorFn) (*PostInstanceActionUnfreezeResponse, error) {
-	rsp, err := c.PostInstanceActionUnfreeze(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// GetSwaggerWithResponse request returning *GetSwaggerResponse
+func (c *ClientWithResponses) GetSwaggerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSwaggerResponse, error) {
+	rsp, err := c.GetSwagger(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionUnfreezeResponse(rsp)
+	return ParseGetSwaggerResponse(rsp)
 }
 
-// PostInstanceActionUnprovisionWithResponse request returning *PostInstanceActionUnprovisionResponse
-func (c *ClientWithResponses) PostInstanceActionUnprovisionWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceActionUnprovisionParams, reqEditors ...RequestEditorFn) (*PostInstanceActionUnprovisionResponse, error) {
-	rsp, err := c.PostInstanceActionUnprovision(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// GetPoolsWithResponse request returning *GetPoolsResponse
+func (c *ClientWithResponses) GetPoolsWithResponse(ctx context.Context, params *GetPoolsParams, reqEditors ...RequestEditorFn) (*GetPoolsResponse, error) {
+	rsp, err := c.GetPools(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceActionUnprovisionResponse(rsp)
+	return ParseGetPoolsResponse(rsp)
 }
 
-// PostInstanceClearWithResponse request returning *PostInstanceClearResponse
-func (c *ClientWithResponses) PostInstanceClearWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostInstanceClearResponse, error) {
-	rsp, err := c.PostInstanceClear(ctx, nodename, namespace, kind, name, reqEditors...)
+// GetPoolVolumesWithResponse request returning *GetPoolVolumesResponse
+func (c *ClientWithResponses) GetPoolVolumesWithResponse(ctx context.Context, params *GetPoolVolumesParams, reqEditors ...RequestEditorFn) (*GetPoolVolumesResponse, error) {
+	rsp, err := c.GetPoolVolumes(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceClearResponse(rsp)
+	return ParseGetPoolVolumesResponse(rsp)
 }
 
-// GetInstanceConfigFileWithResponse request returning *GetInstanceConfigFileResponse
-func (c *ClientWithResponses) GetInstanceConfigFileWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceConfigFileResponse, error) {
-	rsp, err := c.GetInstanceConfigFile(ctx, nodename, namespace, kind, name, reqEditors...)
+// GetRelayMessageWithResponse request returning *GetRelayMessageResponse
+func (c *ClientWithResponses) GetRelayMessageWithResponse(ctx context.Context, params *GetRelayMessageParams, reqEditors ...RequestEditorFn) (*GetRelayMessageResponse, error) {
+	rsp, err := c.GetRelayMessage(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetInstanceConfigFileResponse(rsp)
+	return ParseGetRelayMessageResponse(rsp)
 }
 
-// PostInstanceResourceConsoleWithResponse request returning *PostInstanceResourceConsoleResponse
-func (c *ClientWithResponses) PostInstanceResourceConsoleWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostInstanceResourceConsoleParams, reqEditors ...RequestEditorFn) (*PostInstanceResourceConsoleResponse, error) {
-	rsp, err := c.PostInstanceResourceConsole(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// PostRelayMessageWithBodyWithResponse request with arbitrary body returning *PostRelayMessageResponse
+func (c *ClientWithResponses) PostRelayMessageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRelayMessageResponse, error) {
+	rsp, err := c.PostRelayMessageWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceResourceConsoleResponse(rsp)
+	return ParsePostRelayMessageResponse(rsp)
 }
 
-// GetInstanceContainerLogWithResponse request returning *GetInstanceContainerLogResponse
-func (c *ClientWithResponses) GetInstanceContainerLogWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceContainerLogParams, reqEditors ...RequestEditorFn) (*GetInstanceContainerLogResponse, error) {
-	rsp, err := c.GetInstanceContainerLog(ctx, nodename, namespace, kind, name, params, reqEditors...)
+func (c *ClientWithResponses) PostRelayMessageWithResponse(ctx context.Context, body PostRelayMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRelayMessageResponse, error) {
+	rsp, err := c.PostRelayMessage(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetInstanceContainerLogResponse(rsp)
+	return ParsePostRelayMessageResponse(rsp)
 }
 
-// GetInstanceLogsWithResponse request returning *GetInstanceLogsResponse
-func (c *ClientWithResponses) GetInstanceLogsWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceLogsParams, reqEditors ...RequestEditorFn) (*GetInstanceLogsResponse, error) {
-	rsp, err := c.GetInstanceLogs(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// GetRelayStatusWithResponse request returning *GetRelayStatusResponse
+func (c *ClientWithResponses) GetRelayStatusWithResponse(ctx context.Context, params *GetRelayStatusParams, reqEditors ...RequestEditorFn) (*GetRelayStatusResponse, error) {
+	rsp, err := c.GetRelayStatus(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetInstanceLogsResponse(rsp)
+	return ParseGetRelayStatusResponse(rsp)
 }
 
-// GetInstanceResourceFileWithResponse request returning *GetInstanceResourceFileResponse
-func (c *ClientWithResponses) GetInstanceResourceFileWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetInstanceResourceFileParams, reqEditors ...RequestEditorFn) (*GetInstanceResourceFileResponse, error) {
-	rsp, err := c.GetInstanceResourceFile(ctx, nodename, namespace, kind, name, params, reqEditors...)
+// GetResourcesWithResponse request returning *GetResourcesResponse
+func (c *ClientWithResponses) GetResourcesWithResponse(ctx context.Context, params *GetResourcesParams, reqEditors ...RequestEditorFn) (*GetResourcesResponse, error) {
+	rsp, err := c.GetResources(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetInstanceResourceFileResponse(rsp)
+	return ParseGetResourcesResponse(rsp)
 }
 
-// GetInstanceResourceInfoWithResponse request returning *GetInstanceResourceInfoResponse
-func (c *ClientWithResponses) GetInstanceResourceInfoWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceResourceInfoResponse, error) {
-	rsp, err := c.GetInstanceResourceInfo(ctx, nodename, namespace, kind, name, reqEditors...)
+// ParseGetArrayResponse parses an HTTP response from a GetArrayWithResponse call
+func ParseGetArrayResponse(rsp *http.Response) (*GetArrayResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetInstanceResourceInfoResponse(rsp)
-}
 
-// GetInstanceScheduleWithResponse request returning *GetInstanceScheduleResponse
-func (c *ClientWithResponses) GetInstanceScheduleWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetInstanceScheduleResponse, error) {
-	rsp, err := c.GetInstanceSchedule(ctx, nodename, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetArrayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetInstanceScheduleResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArrayList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// PostInstanceStateFileWithBodyWithResponse request with arbitrary body returning *PostInstanceStateFileResponse
-func (c *ClientWithResponses) PostInstanceStateFileWithBodyWithResponse(ctx context.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInstanceStateFileResponse, error) {
-	rsp, err := c.PostInstanceStateFileWithBody(ctx, nodename, namespace, kind, name, contentType, body, reqEditors...)
+// ParseGetAuthCanIResponse parses an HTTP response from a GetAuthCanIWithResponse call
+func ParseGetAuthCanIResponse(rsp *http.Response) (*GetAuthCanIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostInstanceStateFileResponse(rsp)
+
+	response := &GetAuthCanIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthCanI
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetNodeLogsWithResponse request returning *GetNodeLogsResponse
-func (c *ClientWithResponses) GetNodeLogsWithResponse(ctx context.Context, nodename InPathNodeName, params *GetNodeLogsParams, reqEditors ...RequestEditorFn) (*GetNodeLogsResponse, error) {
-	rsp, err := c.GetNodeLogs(ctx, nodename, params, reqEditors...)
+// ParseGetAuthInfoResponse parses an HTTP response from a GetAuthInfoWithResponse call
+func ParseGetAuthInfoResponse(rsp *http.Response) (*GetAuthInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodeLogsResponse(rsp)
+
+	response := &GetAuthInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetNodeMetricsWithResponse request returning *GetNodeMetricsResponse
-func (c *ClientWithResponses) GetNodeMetricsWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeMetricsResponse, error) {
-	rsp, err := c.GetNodeMetrics(ctx, nodename, reqEditors...)
+// ParsePostAuthRefreshResponse parses an HTTP response from a PostAuthRefreshWithResponse call
+func ParsePostAuthRefreshResponse(rsp *http.Response) (*PostAuthRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodeMetricsResponse(rsp)
-}
 
-// GetNodePingWithResponse request returning *GetNodePingResponse
-func (c *ClientWithResponses) GetNodePingWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodePingResponse, error) {
-	rsp, err := c.GetNodePing(ctx, nodename, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostAuthRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthAccessToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
-	return ParseGetNodePingResponse(rsp)
-}
 
-// GetNodeScheduleWithResponse request returning *GetNodeScheduleResponse
-func (c *ClientWithResponses) GetNodeScheduleWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeScheduleResponse, error) {
-	rsp, err := c.GetNodeSchedule(ctx, nodename, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetNodeScheduleResponse(rsp)
+	return response, nil
 }
 
-// GetNodeSSHHostkeysWithResponse request returning *GetNodeSSHHostkeysResponse
-func (c *ClientWithResponses) GetNodeSSHHostkeysWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSSHHostkeysResponse, error) {
-	rsp, err := c.GetNodeSSHHostkeys(ctx, nodename, reqEditors...)
+// ParsePostAuthTokenResponse parses an HTTP response from a PostAuthTokenWithResponse call
+func ParsePostAuthTokenResponse(rsp *http.Response) (*PostAuthTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodeSSHHostkeysResponse(rsp)
-}
 
-// GetNodeSSHKeyWithResponse request returning *GetNodeSSHKeyResponse
-func (c *ClientWithResponses) GetNodeSSHKeyWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSSHKeyResponse, error) {
-	rsp, err := c.GetNodeSSHKey(ctx, nodename, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostAuthTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetNodeSSHKeyResponse(rsp)
-}
 
-// PutNodeSSHTrustWithResponse request returning *PutNodeSSHTrustResponse
-func (c *ClientWithResponses) PutNodeSSHTrustWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*PutNodeSSHTrustResponse, error) {
-	rsp, err := c.PutNodeSSHTrust(ctx, nodename, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutNodeSSHTrustResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// GetNodeSystemDiskWithResponse request returning *GetNodeSystemDiskResponse
-func (c *ClientWithResponses) GetNodeSystemDiskWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemDiskResponse, error) {
-	rsp, err := c.GetNodeSystemDisk(ctx, nodename, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetNodeSystemDiskResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// GetNodeSystemGroupWithResponse request returning *GetNodeSystemGroupResponse
-func (c *ClientWithResponses) GetNodeSystemGroupWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemGroupResponse, error) {
-	rsp, err := c.GetNodeSystemGroup(ctx, nodename, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetNodeSystemGroupResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// GetNodeSystemHardwareWithResponse request returning *GetNodeSystemHardwareResponse
-func (c *ClientWithResponses) GetNodeSystemHardwareWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemHardwareResponse, error) {
-	rsp, err := c.GetNodeSystemHardware(ctx, nodename, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetNodeSystemHardwareResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// GetNodeSystemIPAddressWithResponse request returning *GetNodeSystemIPAddressResponse
-func (c *ClientWithResponses) GetNodeSystemIPAddressWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemIPAddressResponse, error) {
-	rsp, err := c.GetNodeSystemIPAddress(ctx, nodename, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetNodeSystemIPAddressResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// GetNodeSystemPackageWithResponse request returning *GetNodeSystemPackageResponse
-func (c *ClientWithResponses) GetNodeSystemPackageWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemPackageResponse, error) {
-	rsp, err := c.GetNodeSystemPackage(ctx, nodename, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetNodeSystemPackageResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
-// GetNodeSystemPropertyWithResponse request returning *GetNodeSystemPropertyResponse
-func (c *ClientWithResponses) GetNodeSystemPropertyWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemPropertyResponse, error) {
-	rsp, err := c.GetNodeSystemProperty(ctx, nodename, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetNodeSystemPropertyResponse(rsp)
+
+	return response, nil
 }
 
-// GetNodeSystemSANInitiatorWithResponse request returning *GetNodeSystemSANInitiatorResponse
-func (c *ClientWithResponses) GetNodeSystemSANInitiatorWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemSANInitiatorResponse, error) {
-	rsp, err := c.GetNodeSystemSANInitiator(ctx, nodename, reqEditors...)
+// ParseGetAuthWhoAmIResponse parses an HTTP response from a GetAuthWhoAmIWithResponse call
+func ParseGetAuthWhoAmIResponse(rsp *http.Response) (*GetAuthWhoAmIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNodeSystemSANInitiatorResponse(rsp)
-}
 
-// GetNodeSystemSANPathWithResponse request returning *GetNodeSystemSANPathResponse
-func (c *ClientWithResponses) GetNodeSystemSANPathWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemSANPathResponse, error) {
-	rsp, err := c.GetNodeSystemSANPath(ctx, nodename, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAuthWhoAmIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetNodeSystemSANPathResponse(rsp)
-}
 
-// GetNodeSystemUserWithResponse request returning *GetNodeSystemUserResponse
-func (c *ClientWithResponses) GetNodeSystemUserWithResponse(ctx context.Context, nodename InPathNodeName, reqEditors ...RequestEditorFn) (*GetNodeSystemUserResponse, error) {
-	rsp, err := c.GetNodeSystemUser(ctx, nodename, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserIdentity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseGetNodeSystemUserResponse(rsp)
+
+	return response, nil
 }
 
-// GetObjectsWithResponse request returning *GetObjectsResponse
-func (c *ClientWithResponses) GetObjectsWithResponse(ctx context.Context, params *GetObjectsParams, reqEditors ...RequestEditorFn) (*GetObjectsResponse, error) {
-	rsp, err := c.GetObjects(ctx, params, reqEditors...)
+// ParsePostClusterActionAbortResponse parses an HTTP response from a PostClusterActionAbortWithResponse call
+func ParsePostClusterActionAbortResponse(rsp *http.Response) (*PostClusterActionAbortResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetObjectsResponse(rsp)
-}
 
-// GetObjectPathsWithResponse request returning *GetObjectPathsResponse
-func (c *ClientWithResponses) GetObjectPathsWithResponse(ctx context.Context, params *GetObjectPathsParams, reqEditors ...RequestEditorFn) (*GetObjectPathsResponse, error) {
-	rsp, err := c.GetObjectPaths(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostClusterActionAbortResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetObjectPathsResponse(rsp)
-}
 
-// PostSvcDisableWithResponse request returning *PostSvcDisableResponse
-func (c *ClientWithResponses) PostSvcDisableWithResponse(ctx context.Context, namespace InPathNamespace, name InPathName, params *PostSvcDisableParams, reqEditors ...RequestEditorFn) (*PostSvcDisableResponse, error) {
-	rsp, err := c.PostSvcDisable(ctx, namespace, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrchestrationQueued
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest N408
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParsePostSvcDisableResponse(rsp)
-}
 
-// PostSvcEnableWithResponse request returning *PostSvcEnableResponse
-func (c *ClientWithResponses) PostSvcEnableWithResponse(ctx context.Context, namespace InPathNamespace, name InPathName, params *PostSvcEnableParams, reqEditors ...RequestEditorFn) (*PostSvcEnableResponse, error) {
-	rsp, err := c.PostSvcEnable(ctx, namespace, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostSvcEnableResponse(rsp)
+	return response, nil
 }
 
-// GetObjectWithResponse request returning *GetObjectResponse
-func (c *ClientWithResponses) GetObjectWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectResponse, error) {
-	rsp, err := c.GetObject(ctx, namespace, kind, name, reqEditors...)
+// ParsePostClusterActionFreezeResponse parses an HTTP response from a PostClusterActionFreezeWithResponse call
+func ParsePostClusterActionFreezeResponse(rsp *http.Response) (*PostClusterActionFreezeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetObjectResponse(rsp)
-}
 
-// PostObjectActionAbortWithResponse request returning *PostObjectActionAbortResponse
-func (c *ClientWithResponses) PostObjectActionAbortWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionAbortResponse, error) {
-	rsp, err := c.PostObjectActionAbort(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostClusterActionFreezeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostObjectActionAbortResponse(rsp)
-}
 
-// PostObjectActionDeleteWithResponse request returning *PostObjectActionDeleteResponse
-func (c *ClientWithResponses) PostObjectActionDeleteWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionDeleteResponse, error) {
-	rsp, err := c.PostObjectActionDelete(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostObjectActionDeleteResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrchestrationQueued
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// PostObjectActionFreezeWithResponse request returning *PostObjectActionFreezeResponse
-func (c *ClientWithResponses) PostObjectActionFreezeWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionFreezeResponse, error) {
-	rsp, err := c.PostObjectActionFreeze(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostObjectActionFreezeResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// PostObjectActionGivebackWithResponse request returning *PostObjectActionGivebackResponse
-func (c *ClientWithResponses) PostObjectActionGivebackWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionGivebackResponse, error) {
-	rsp, err := c.PostObjectActionGiveback(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostObjectActionGivebackResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// PostObjectActionProvisionWithResponse request returning *PostObjectActionProvisionResponse
-func (c *ClientWithResponses) PostObjectActionProvisionWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionProvisionResponse, error) {
-	rsp, err := c.PostObjectActionProvision(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostObjectActionProvisionResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// PostObjectActionPurgeWithResponse request returning *PostObjectActionPurgeResponse
-func (c *ClientWithResponses) PostObjectActionPurgeWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionPurgeResponse, error) {
-	rsp, err := c.PostObjectActionPurge(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostObjectActionPurgeResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest N408
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
 
-// PostObjectActionRestartWithBodyWithResponse request with arbitrary body returning *PostObjectActionRestartResponse
-func (c *ClientWithResponses) PostObjectActionRestartWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectActionRestartResponse, error) {
-	rsp, err := c.PostObjectActionRestartWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostObjectActionRestartResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-func (c *ClientWithResponses) PostObjectActionRestartWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostObjectActionRestartJSONRequestBody, reqEditors ...RequestEditorFn) (*PostObjectActionRestartResponse, error) {
-	rsp, err := c.PostObjectActionRestart(ctx, namespace, kind, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostObjectActionRestartResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// PostObjectActionStartWithResponse request returning *PostObjectActionStartResponse
-func (c *ClientWithResponses) PostObjectActionStartWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionStartResponse, error) {
-	rsp, err := c.PostObjectActionStart(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePostObjectActionStartResponse(rsp)
-}
 
-// PostObjectActionStopWithResponse request returning *PostObjectActionStopResponse
-func (c *ClientWithResponses) PostObjectActionStopWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionStopResponse, error) {
-	rsp, err := c.PostObjectActionStop(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostObjectActionStopResponse(rsp)
+	return response, nil
 }
 
-// PostObjectActionSwitchWithBodyWithResponse request with arbitrary body returning *PostObjectActionSwitchResponse
-func (c *ClientWithResponses) PostObjectActionSwitchWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectActionSwitchResponse, error) {
-	rsp, err := c.PostObjectActionSwitchWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
+// ParsePostClusterActionUnfreezeResponse parses an HTTP response from a PostClusterActionUnfreezeWithResponse call
+func ParsePostClusterActionUnfreezeResponse(rsp *http.Response) (*PostClusterActionUnfreezeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostObjectActionSwitchResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostObjectActionSwitchWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PostObjectActionSwitchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostObjectActionSwitchResponse, error) {
-	rsp, err := c.PostObjectActionSwitch(ctx, namespace, kind, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostClusterActionUnfreezeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostObjectActionSwitchResponse(rsp)
-}
 
-// PostObjectActionUnfreezeWithResponse request returning *PostObjectActionUnfreezeResponse
-func (c *ClientWithResponses) PostObjectActionUnfreezeWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionUnfreezeResponse, error) {
-	rsp, err := c.PostObjectActionUnfreeze(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostObjectActionUnfreezeResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrchestrationQueued
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// PostObjectActionUnprovisionWithResponse request returning *PostObjectActionUnprovisionResponse
-func (c *ClientWithResponses) PostObjectActionUnprovisionWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*PostObjectActionUnprovisionResponse, error) {
-	rsp, err := c.PostObjectActionUnprovision(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostObjectActionUnprovisionResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// GetObjectConfigWithResponse request returning *GetObjectConfigResponse
-func (c *ClientWithResponses) GetObjectConfigWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectConfigParams, reqEditors ...RequestEditorFn) (*GetObjectConfigResponse, error) {
-	rsp, err := c.GetObjectConfig(ctx, namespace, kind, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetObjectConfigResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// PatchObjectConfigWithResponse request returning *PatchObjectConfigResponse
-func (c *ClientWithResponses) PatchObjectConfigWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PatchObjectConfigParams, reqEditors ...RequestEditorFn) (*PatchObjectConfigResponse, error) {
-	rsp, err := c.PatchObjectConfig(ctx, namespace, kind, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchObjectConfigResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// GetObjectConfigFileWithResponse request returning *GetObjectConfigFileResponse
-func (c *ClientWithResponses) GetObjectConfigFileWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectConfigFileResponse, error) {
-	rsp, err := c.GetObjectConfigFile(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetObjectConfigFileResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest N408
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
 
-// PostObjectConfigFileWithBodyWithResponse request with arbitrary body returning *PostObjectConfigFileResponse
-func (c *ClientWithResponses) PostObjectConfigFileWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectConfigFileResponse, error) {
-	rsp, err := c.PostObjectConfigFileWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostObjectConfigFileResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// PutObjectConfigFileWithBodyWithResponse request with arbitrary body returning *PutObjectConfigFileResponse
-func (c *ClientWithResponses) PutObjectConfigFileWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutObjectConfigFileResponse, error) {
-	rsp, err := c.PutObjectConfigFileWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePutObjectConfigFileResponse(rsp)
-}
 
-// GetObjectConfigKeywordsWithResponse request returning *GetObjectConfigKeywordsResponse
-func (c *ClientWithResponses) GetObjectConfigKeywordsWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectConfigKeywordsParams, reqEditors ...RequestEditorFn) (*GetObjectConfigKeywordsResponse, error) {
-	rsp, err := c.GetObjectConfigKeywords(ctx, namespace, kind, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetObjectConfigKeywordsResponse(rsp)
+	return response, nil
 }
 
-// GetObjectDataWithResponse request returning *GetObjectDataResponse
-func (c *ClientWithResponses) GetObjectDataWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectDataParams, reqEditors ...RequestEditorFn) (*GetObjectDataResponse, error) {
-	rsp, err := c.GetObjectData(ctx, namespace, kind, name, params, reqEditors...)
+// ParseGetClusterConfigResponse parses an HTTP response from a GetClusterConfigWithResponse call
+func ParseGetClusterConfigResponse(rsp *http.Response) (*GetClusterConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetObjectDataResponse(rsp)
-}
 
-// PatchObjectDataWithBodyWithResponse request with arbitrary body returning *PatchObjectDataResponse
-func (c *ClientWithResponses) PatchObjectDataWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchObjectDataResponse, error) {
-	rsp, err := c.PatchObjectDataWithBody(ctx, namespace, kind, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetClusterConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchObjectDataResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchObjectDataWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, body PatchObjectDataJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchObjectDataResponse, error) {
-	rsp, err := c.PatchObjectData(ctx, namespace, kind, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KeywordList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParsePatchObjectDataResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteObjectDataKeyWithResponse request returning *DeleteObjectDataKeyResponse
-func (c *ClientWithResponses) DeleteObjectDataKeyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *DeleteObjectDataKeyParams, reqEditors ...RequestEditorFn) (*DeleteObjectDataKeyResponse, error) {
-	rsp, err := c.DeleteObjectDataKey(ctx, namespace, kind, name, params, reqEditors...)
+// ParsePatchClusterConfigResponse parses an HTTP response from a PatchClusterConfigWithResponse call
+func ParsePatchClusterConfigResponse(rsp *http.Response) (*PatchClusterConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteObjectDataKeyResponse(rsp)
-}
 
-// GetObjectDataKeyWithResponse request returning *GetObjectDataKeyResponse
-func (c *ClientWithResponses) GetObjectDataKeyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *GetObjectDataKeyParams, reqEditors ...RequestEditorFn) (*GetObjectDataKeyResponse, error) {
-	rsp, err := c.GetObjectDataKey(ctx, namespace, kind, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PatchClusterConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetObjectDataKeyResponse(rsp)
-}
 
-// PostObjectDataKeyWithBodyWithResponse request with arbitrary body returning *PostObjectDataKeyResponse
-func (c *ClientWithResponses) PostObjectDataKeyWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PostObjectDataKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostObjectDataKeyResponse, error) {
-	rsp, err := c.PostObjectDataKeyWithBody(ctx, namespace, kind, name, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Committed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParsePostObjectDataKeyResponse(rsp)
+
+	return response, nil
 }
 
-// PutObjectDataKeyWithBodyWithResponse request with arbitrary body returning *PutObjectDataKeyResponse
-func (c *ClientWithResponses) PutObjectDataKeyWithBodyWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params *PutObjectDataKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutObjectDataKeyResponse, error) {
-	rsp, err := c.PutObjectDataKeyWithBody(ctx, namespace, kind, name, params, contentType, body, reqEditors...)
+// ParseGetClusterConfigFileResponse parses an HTTP response from a GetClusterConfigFileWithResponse call
+func ParseGetClusterConfigFileResponse(rsp *http.Response) (*GetClusterConfigFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutObjectDataKeyResponse(rsp)
-}
 
-// GetObjectDataKeysWithResponse request returning *GetObjectDataKeysResponse
-func (c *ClientWithResponses) GetObjectDataKeysWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectDataKeysResponse, error) {
-	rsp, err := c.GetObjectDataKeys(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetClusterConfigFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetObjectDataKeysResponse(rsp)
-}
 
-// GetObjectResourceInfoWithResponse request returning *GetObjectResourceInfoResponse
-func (c *ClientWithResponses) GetObjectResourceInfoWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectResourceInfoResponse, error) {
-	rsp, err := c.GetObjectResourceInfo(ctx, namespace, kind, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetObjectResourceInfoResponse(rsp)
+
+	return response, nil
 }
 
-// GetObjectScheduleWithResponse request returning *GetObjectScheduleResponse
-func (c *ClientWithResponses) GetObjectScheduleWithResponse(ctx context.Context, namespace InPathNamespace, kind InPathKind, name InPathName, reqEditors ...RequestEditorFn) (*GetObjectScheduleResponse, error) {
-	rsp, err := c.GetObjectSchedule(ctx, namespace, kind, name, reqEditors...)
+// ParsePutClusterConfigFileResponse parses an HTTP response from a PutClusterConfigFileWithResponse call
+func ParsePutClusterConfigFileResponse(rsp *http.Response) (*PutClusterConfigFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetObjectScheduleResponse(rsp)
-}
 
-// GetSwaggerWithResponse request returning *GetSwaggerResponse
-func (c *ClientWithResponses) GetSwaggerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSwaggerResponse, error) {
-	rsp, err := c.GetSwagger(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutClusterConfigFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetSwaggerResponse(rsp)
-}
 
-// GetPoolsWithResponse request returning *GetPoolsResponse
-func (c *ClientWithResponses) GetPoolsWithResponse(ctx context.Context, params *GetPoolsParams, reqEditors ...RequestEditorFn) (*GetPoolsResponse, error) {
-	rsp, err := c.GetPools(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetPoolsResponse(rsp)
+
+	return response, nil
 }
 
-// GetPoolVolumesWithResponse request returning *GetPoolVolumesResponse
-func (c *ClientWithResponses) GetPoolVolumesWithResponse(ctx context.Context, params *GetPoolVolumesParams, reqEditors ...RequestEditorFn) (*GetPoolVolumesResponse, error) {
-	rsp, err := c.GetPoolVolumes(ctx, params, reqEditors...)
+// ParseGetClusterConfigKeywordsResponse parses an HTTP response from a GetClusterConfigKeywordsWithResponse call
+func ParseGetClusterConfigKeywordsResponse(rsp *http.Response) (*GetClusterConfigKeywordsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetPoolVolumesResponse(rsp)
-}
 
-// GetRelayMessageWithResponse request returning *GetRelayMessageResponse
-func (c *ClientWithResponses) GetRelayMessageWithResponse(ctx context.Context, params *GetRelayMessageParams, reqEditors ...RequestEditorFn) (*GetRelayMessageResponse, error) {
-	rsp, err := c.GetRelayMessage(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetClusterConfigKeywordsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetRelayMessageResponse(rsp)
-}
 
-// PostRelayMessageWithBodyWithResponse request with arbitrary body returning *PostRelayMessageResponse
-func (c *ClientWithResponses) PostRelayMessageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRelayMessageResponse, error) {
-	rsp, err := c.PostRelayMessageWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KeywordDefinitionList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParsePostRelayMessageResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostRelayMessageWithResponse(ctx context.Context, body PostRelayMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRelayMessageResponse, error) {
-	rsp, err := c.PostRelayMessage(ctx, body, reqEditors...)
+// ParsePostClusterHeartbeatRotateResponse parses an HTTP response from a PostClusterHeartbeatRotateWithResponse call
+func ParsePostClusterHeartbeatRotateResponse(rsp *http.Response) (*PostClusterHeartbeatRotateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostRelayMessageResponse(rsp)
-}
 
-// GetRelayStatusWithResponse request returning *GetRelayStatusResponse
-func (c *ClientWithResponses) GetRelayStatusWithResponse(ctx context.Context, params *GetRelayStatusParams, reqEditors ...RequestEditorFn) (*GetRelayStatusResponse, error) {
-	rsp, err := c.GetRelayStatus(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostClusterHeartbeatRotateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetRelayStatusResponse(rsp)
-}
 
-// GetResourcesWithResponse request returning *GetResourcesResponse
-func (c *ClientWithResponses) GetResourcesWithResponse(ctx context.Context, params *GetResourcesParams, reqEditors ...RequestEditorFn) (*GetResourcesResponse, error) {
-	rsp, err := c.GetResources(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HeartbeatRotateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetResourcesResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetArrayResponse parses an HTTP response from a GetArrayWithResponse call
-func ParseGetArrayResponse(rsp *http.Response) (*GetArrayResponse, error) {
+// ParsePostClusterJoinResponse parses an HTTP response from a PostClusterJoinWithResponse call
+func ParsePostClusterJoinResponse(rsp *http.Response) (*PostClusterJoinResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetArrayResponse{
+	response := &PostClusterJoinResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ArrayList
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
@@ -19757,60 +23107,53 @@ func ParseGetArrayResponse(rsp *http.Response) (*GetArrayResponse, error) {
 	return response, nil
 }
 
-// ParseGetAuthInfoResponse parses an HTTP response from a GetAuthInfoWithResponse call
-func ParseGetAuthInfoResponse(rsp *http.Response) (*GetAuthInfoResponse, error) {
+// ParsePostClusterLeaveResponse parses an HTTP response from a PostClusterLeaveWithResponse call
+func ParsePostClusterLeaveResponse(rsp *http.Response) (*PostClusterLeaveResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAuthInfoResponse{
+	response := &PostClusterLeaveResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthInfo
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePostAuthRefreshResponse parses an HTTP response from a PostAuthRefreshWithResponse call
-func ParsePostAuthRefreshResponse(rsp *http.Response) (*PostAuthRefreshResponse, error) {
+// ParseGetClusterStatusResponse parses an HTTP response from a GetClusterStatusWithResponse call
+func ParseGetClusterStatusResponse(rsp *http.Response) (*GetClusterStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostAuthRefreshResponse{
+	response := &GetClusterStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthAccessToken
+		var dest ClusterStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -19825,13 +23168,6 @@ func ParsePostAuthRefreshResponse(rsp *http.Response) (*PostAuthRefreshResponse,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -19839,34 +23175,27 @@ func ParsePostAuthRefreshResponse(rsp *http.Response) (*PostAuthRefreshResponse,
 		}
 		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest N503
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParsePostAuthTokenResponse parses an HTTP response from a PostAuthTokenWithResponse call
-func ParsePostAuthTokenResponse(rsp *http.Response) (*PostAuthTokenResponse, error) {
+// ParseGetInstancesResponse parses an HTTP response from a GetInstancesWithResponse call
+func ParseGetInstancesResponse(rsp *http.Response) (*GetInstancesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostAuthTokenResponse{
+	response := &GetInstancesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthToken
+		var dest InstanceList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -19893,52 +23222,85 @@ func ParsePostAuthTokenResponse(rsp *http.Response) (*PostAuthTokenResponse, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
+	}
+
+	return response, nil
+}
+
+// ParsePostInstanceProgressResponse parses an HTTP response from a PostInstanceProgressWithResponse call
+func ParsePostInstanceProgressResponse(rsp *http.Response) (*PostInstanceProgressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostInstanceProgressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest N503
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON503 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetAuthWhoAmIResponse parses an HTTP response from a GetAuthWhoAmIWithResponse call
-func ParseGetAuthWhoAmIResponse(rsp *http.Response) (*GetAuthWhoAmIResponse, error) {
+// ParsePostInstanceStatusResponse parses an HTTP response from a PostInstanceStatusWithResponse call
+func ParsePostInstanceStatusResponse(rsp *http.Response) (*PostInstanceStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAuthWhoAmIResponse{
+	response := &PostInstanceStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserIdentity
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
@@ -19947,27 +23309,41 @@ func ParseGetAuthWhoAmIResponse(rsp *http.Response) (*GetAuthWhoAmIResponse, err
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePostClusterActionAbortResponse parses an HTTP response from a PostClusterActionAbortWithResponse call
-func ParsePostClusterActionAbortResponse(rsp *http.Response) (*PostClusterActionAbortResponse, error) {
+// ParseGetJobsResponse parses an HTTP response from a GetJobsWithResponse call
+func ParseGetJobsResponse(rsp *http.Response) (*GetJobsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostClusterActionAbortResponse{
+	response := &GetJobsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrchestrationQueued
+		var dest JobList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -19994,27 +23370,6 @@ func ParsePostClusterActionAbortResponse(rsp *http.Response) (*PostClusterAction
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
-		var dest N408
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON408 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -20027,22 +23382,22 @@ func ParsePostClusterActionAbortResponse(rsp *http.Response) (*PostClusterAction
 	return response, nil
 }
 
-// ParsePostClusterActionFreezeResponse parses an HTTP response from a PostClusterActionFreezeWithResponse call
-func ParsePostClusterActionFreezeResponse(rsp *http.Response) (*PostClusterActionFreezeResponse, error) {
+// ParseGetJobResponse parses an HTTP response from a GetJobWithResponse call
+func ParseGetJobResponse(rsp *http.Response) (*GetJobResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostClusterActionFreezeResponse{
+	response := &GetJobResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrchestrationQueued
+		var dest Job
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -20069,19 +23424,12 @@ func ParsePostClusterActionFreezeResponse(rsp *http.Response) (*PostClusterActio
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
-		var dest N408
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON408 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
@@ -20095,22 +23443,22 @@ func ParsePostClusterActionFreezeResponse(rsp *http.Response) (*PostClusterActio
 	return response, nil
 }
 
-// ParsePostClusterActionUnfreezeResponse parses an HTTP response from a PostClusterActionUnfreezeWithResponse call
-func ParsePostClusterActionUnfreezeResponse(rsp *http.Response) (*PostClusterActionUnfreezeResponse, error) {
+// ParsePostJobCancelResponse parses an HTTP response from a PostJobCancelWithResponse call
+func ParsePostJobCancelResponse(rsp *http.Response) (*PostJobCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostClusterActionUnfreezeResponse{
+	response := &PostJobCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrchestrationQueued
+		var dest Job
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -20137,12 +23485,12 @@ func ParsePostClusterActionUnfreezeResponse(rsp *http.Response) (*PostClusterAct
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
-		var dest N408
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON408 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest N409
@@ -20163,34 +23511,27 @@ func ParsePostClusterActionUnfreezeResponse(rsp *http.Response) (*PostClusterAct
 	return response, nil
 }
 
-// ParseGetClusterConfigResponse parses an HTTP response from a GetClusterConfigWithResponse call
-func ParseGetClusterConfigResponse(rsp *http.Response) (*GetClusterConfigResponse, error) {
+// ParseGetNetworksResponse parses an HTTP response from a GetNetworksWithResponse call
+func ParseGetNetworksResponse(rsp *http.Response) (*GetNetworksResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterConfigResponse{
+	response := &GetNetworksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KeywordList
+		var dest NetworkList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -20217,34 +23558,27 @@ func ParseGetClusterConfigResponse(rsp *http.Response) (*GetClusterConfigRespons
 	return response, nil
 }
 
-// ParsePatchClusterConfigResponse parses an HTTP response from a PatchClusterConfigWithResponse call
-func ParsePatchClusterConfigResponse(rsp *http.Response) (*PatchClusterConfigResponse, error) {
+// ParseGetNetworkIPResponse parses an HTTP response from a GetNetworkIPWithResponse call
+func ParseGetNetworkIPResponse(rsp *http.Response) (*GetNetworkIPResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchClusterConfigResponse{
+	response := &GetNetworkIPResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Committed
+		var dest NetworkIPList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -20271,20 +23605,27 @@ func ParsePatchClusterConfigResponse(rsp *http.Response) (*PatchClusterConfigRes
 	return response, nil
 }
 
-// ParseGetClusterConfigFileResponse parses an HTTP response from a GetClusterConfigFileWithResponse call
-func ParseGetClusterConfigFileResponse(rsp *http.Response) (*GetClusterConfigFileResponse, error) {
+// ParseGetNodesResponse parses an HTTP response from a GetNodesWithResponse call
+func ParseGetNodesResponse(rsp *http.Response) (*GetNodesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterConfigFileResponse{
+	response := &GetNodesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -20318,20 +23659,27 @@ func ParseGetClusterConfigFileResponse(rsp *http.Response) (*GetClusterConfigFil
 	return response, nil
 }
 
-// ParsePutClusterConfigFileResponse parses an HTTP response from a PutClusterConfigFileWithResponse call
-func ParsePutClusterConfigFileResponse(rsp *http.Response) (*PutClusterConfigFileResponse, error) {
+// ParseGetNodesCapacityResponse parses an HTTP response from a GetNodesCapacityWithResponse call
+func ParseGetNodesCapacityResponse(rsp *http.Response) (*GetNodesCapacityResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutClusterConfigFileResponse{
+	response := &GetNodesCapacityResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeCapacityList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -20353,20 +23701,6 @@ func ParsePutClusterConfigFileResponse(rsp *http.Response) (*PutClusterConfigFil
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -20379,40 +23713,40 @@ func ParsePutClusterConfigFileResponse(rsp *http.Response) (*PutClusterConfigFil
 	return response, nil
 }
 
-// ParseGetClusterConfigKeywordsResponse parses an HTTP response from a GetClusterConfigKeywordsWithResponse call
-func ParseGetClusterConfigKeywordsResponse(rsp *http.Response) (*GetClusterConfigKeywordsResponse, error) {
+// ParseGetNodesInfoResponse parses an HTTP response from a GetNodesInfoWithResponse call
+func ParseGetNodesInfoResponse(rsp *http.Response) (*GetNodesInfoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterConfigKeywordsResponse{
+	response := &GetNodesInfoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KeywordDefinitionList
+		var dest NodesInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
@@ -20426,22 +23760,22 @@ func ParseGetClusterConfigKeywordsResponse(rsp *http.Response) (*GetClusterConfi
 	return response, nil
 }
 
-// ParsePostClusterHeartbeatRotateResponse parses an HTTP response from a PostClusterHeartbeatRotateWithResponse call
-func ParsePostClusterHeartbeatRotateResponse(rsp *http.Response) (*PostClusterHeartbeatRotateResponse, error) {
+// ParsePostPeerActionAbortResponse parses an HTTP response from a PostPeerActionAbortWithResponse call
+func ParsePostPeerActionAbortResponse(rsp *http.Response) (*PostPeerActionAbortResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostClusterHeartbeatRotateResponse{
+	response := &PostPeerActionAbortResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HeartbeatRotateResponse
+		var dest OrchestrationQueued
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -20468,6 +23802,13 @@ func ParsePostClusterHeartbeatRotateResponse(rsp *http.Response) (*PostClusterHe
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest N408
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest N409
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -20487,20 +23828,27 @@ func ParsePostClusterHeartbeatRotateResponse(rsp *http.Response) (*PostClusterHe
 	return response, nil
 }
 
-// ParsePostClusterJoinResponse parses an HTTP response from a PostClusterJoinWithResponse call
-func ParsePostClusterJoinResponse(rsp *http.Response) (*PostClusterJoinResponse, error) {
+// ParsePostNodeActionClearResponse parses an HTTP response from a PostNodeActionClearWithResponse call
+func ParsePostNodeActionClearResponse(rsp *http.Response) (*PostNodeActionClearResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostClusterJoinResponse{
+	response := &PostNodeActionClearResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest N200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -20534,52 +23882,33 @@ func ParsePostClusterJoinResponse(rsp *http.Response) (*PostClusterJoinResponse,
 	return response, nil
 }
 
-// ParsePostClusterLeaveResponse parses an HTTP response from a PostClusterLeaveWithResponse call
-func ParsePostClusterLeaveResponse(rsp *http.Response) (*PostClusterLeaveResponse, error) {
+// ParsePostPeerActionDequeueResponse parses an HTTP response from a PostPeerActionDequeueWithResponse call
+func ParsePostPeerActionDequeueResponse(rsp *http.Response) (*PostPeerActionDequeueResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostClusterLeaveResponse{
+	response := &PostPeerActionDequeueResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseGetClusterStatusResponse parses an HTTP response from a GetClusterStatusWithResponse call
-func ParseGetClusterStatusResponse(rsp *http.Response) (*GetClusterStatusResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetClusterStatusResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		response.JSON200 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterStatus
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
@@ -20607,22 +23936,22 @@ func ParseGetClusterStatusResponse(rsp *http.Response) (*GetClusterStatusRespons
 	return response, nil
 }
 
-// ParseGetInstancesResponse parses an HTTP response from a GetInstancesWithResponse call
-func ParseGetInstancesResponse(rsp *http.Response) (*GetInstancesResponse, error) {
+// ParsePostPeerActionDrainResponse parses an HTTP response from a PostPeerActionDrainWithResponse call
+func ParsePostPeerActionDrainResponse(rsp *http.Response) (*PostPeerActionDrainResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetInstancesResponse{
+	response := &PostPeerActionDrainResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InstanceList
+		var dest OrchestrationQueued
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -20649,6 +23978,20 @@ func ParseGetInstancesResponse(rsp *http.Response) (*GetInstancesResponse, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest N408
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -20661,20 +24004,27 @@ func ParseGetInstancesResponse(rsp *http.Response) (*GetInstancesResponse, error
 	return response, nil
 }
 
-// ParsePostInstanceProgressResponse parses an HTTP response from a PostInstanceProgressWithResponse call
-func ParsePostInstanceProgressResponse(rsp *http.Response) (*PostInstanceProgressResponse, error) {
+// ParsePostPeerActionFreezeResponse parses an HTTP response from a PostPeerActionFreezeWithResponse call
+func ParsePostPeerActionFreezeResponse(rsp *http.Response) (*PostPeerActionFreezeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceProgressResponse{
+	response := &PostPeerActionFreezeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeActionAccepted
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -20708,26 +24058,26 @@ func ParsePostInstanceProgressResponse(rsp *http.Response) (*PostInstanceProgres
 	return response, nil
 }
 
-// ParsePostInstanceStatusResponse parses an HTTP response from a PostInstanceStatusWithResponse call
-func ParsePostInstanceStatusResponse(rsp *http.Response) (*PostInstanceStatusResponse, error) {
+// ParsePostNodeActionPushAssetResponse parses an HTTP response from a PostNodeActionPushAssetWithResponse call
+func ParsePostNodeActionPushAssetResponse(rsp *http.Response) (*PostNodeActionPushAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceStatusResponse{
+	response := &PostNodeActionPushAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
@@ -20755,22 +24105,22 @@ func ParsePostInstanceStatusResponse(rsp *http.Response) (*PostInstanceStatusRes
 	return response, nil
 }
 
-// ParseGetNetworksResponse parses an HTTP response from a GetNetworksWithResponse call
-func ParseGetNetworksResponse(rsp *http.Response) (*GetNetworksResponse, error) {
+// ParsePostNodeActionPushDiskResponse parses an HTTP response from a PostNodeActionPushDiskWithResponse call
+func ParsePostNodeActionPushDiskResponse(rsp *http.Response) (*PostNodeActionPushDiskResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNetworksResponse{
+	response := &PostNodeActionPushDiskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NetworkList
+		var dest NodeActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -20802,22 +24152,22 @@ func ParseGetNetworksResponse(rsp *http.Response) (*GetNetworksResponse, error)
 	return response, nil
 }
 
-// ParseGetNetworkIPResponse parses an HTTP response from a GetNetworkIPWithResponse call
-func ParseGetNetworkIPResponse(rsp *http.Response) (*GetNetworkIPResponse, error) {
+// ParsePostNodeActionPushPkgResponse parses an HTTP response from a PostNodeActionPushPkgWithResponse call
+func ParsePostNodeActionPushPkgResponse(rsp *http.Response) (*PostNodeActionPushPkgResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNetworkIPResponse{
+	response := &PostNodeActionPushPkgResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NetworkIPList
+		var dest NodeActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -20849,34 +24199,27 @@ func ParseGetNetworkIPResponse(rsp *http.Response) (*GetNetworkIPResponse, error
 	return response, nil
 }
 
-// ParseGetNodesResponse parses an HTTP response from a GetNodesWithResponse call
-func ParseGetNodesResponse(rsp *http.Response) (*GetNodesResponse, error) {
+// ParsePostNodeActionScanCapabilitiesResponse parses an HTTP response from a PostNodeActionScanCapabilitiesWithResponse call
+func ParsePostNodeActionScanCapabilitiesResponse(rsp *http.Response) (*PostNodeActionScanCapabilitiesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNodesResponse{
+	response := &PostNodeActionScanCapabilitiesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodeList
+		var dest NodeActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -20903,22 +24246,22 @@ func ParseGetNodesResponse(rsp *http.Response) (*GetNodesResponse, error) {
 	return response, nil
 }
 
-// ParseGetNodesInfoResponse parses an HTTP response from a GetNodesInfoWithResponse call
-func ParseGetNodesInfoResponse(rsp *http.Response) (*GetNodesInfoResponse, error) {
+// ParsePostNodeActionSCSIScanResponse parses an HTTP response from a PostNodeActionSCSIScanWithResponse call
+func ParsePostNodeActionSCSIScanResponse(rsp *http.Response) (*PostNodeActionSCSIScanResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNodesInfoResponse{
+	response := &PostNodeActionSCSIScanResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodesInfo
+		var dest NodeActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -20950,22 +24293,22 @@ func ParseGetNodesInfoResponse(rsp *http.Response) (*GetNodesInfoResponse, error
 	return response, nil
 }
 
-// ParsePostPeerActionAbortResponse parses an HTTP response from a PostPeerActionAbortWithResponse call
-func ParsePostPeerActionAbortResponse(rsp *http.Response) (*PostPeerActionAbortResponse, error) {
+// ParsePostNodeActionSysreportResponse parses an HTTP response from a PostNodeActionSysreportWithResponse call
+func ParsePostNodeActionSysreportResponse(rsp *http.Response) (*PostNodeActionSysreportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPeerActionAbortResponse{
+	response := &PostNodeActionSysreportResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrchestrationQueued
+		var dest NodeActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -20992,20 +24335,6 @@ func ParsePostPeerActionAbortResponse(rsp *http.Response) (*PostPeerActionAbortR
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
-		var dest N408
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON408 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -21018,22 +24347,22 @@ func ParsePostPeerActionAbortResponse(rsp *http.Response) (*PostPeerActionAbortR
 	return response, nil
 }
 
-// ParsePostNodeActionClearResponse parses an HTTP response from a PostNodeActionClearWithResponse call
-func ParsePostNodeActionClearResponse(rsp *http.Response) (*PostNodeActionClearResponse, error) {
+// ParsePostPeerActionUnfreezeResponse parses an HTTP response from a PostPeerActionUnfreezeWithResponse call
+func ParsePostPeerActionUnfreezeResponse(rsp *http.Response) (*PostPeerActionUnfreezeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostNodeActionClearResponse{
+	response := &PostPeerActionUnfreezeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest N200
+		var dest NodeActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -21072,22 +24401,22 @@ func ParsePostNodeActionClearResponse(rsp *http.Response) (*PostNodeActionClearR
 	return response, nil
 }
 
-// ParsePostPeerActionDequeueResponse parses an HTTP response from a PostPeerActionDequeueWithResponse call
-func ParsePostPeerActionDequeueResponse(rsp *http.Response) (*PostPeerActionDequeueResponse, error) {
+// ParseGetNodeAuditLogResponse parses an HTTP response from a GetNodeAuditLogWithResponse call
+func ParseGetNodeAuditLogResponse(rsp *http.Response) (*GetNodeAuditLogResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPeerActionDequeueResponse{
+	response := &GetNodeAuditLogResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodeActionAccepted
+		var dest AuditRecordList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -21126,22 +24455,22 @@ func ParsePostPeerActionDequeueResponse(rsp *http.Response) (*PostPeerActionDequ
 	return response, nil
 }
 
-// ParsePostPeerActionDrainResponse parses an HTTP response from a PostPeerActionDrainWithResponse call
-func ParsePostPeerActionDrainResponse(rsp *http.Response) (*PostPeerActionDrainResponse, error) {
+// ParseGetNodeCapabilitiesResponse parses an HTTP response from a GetNodeCapabilitiesWithResponse call
+func ParseGetNodeCapabilitiesResponse(rsp *http.Response) (*GetNodeCapabilitiesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPeerActionDrainResponse{
+	response := &GetNodeCapabilitiesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrchestrationQueued
+		var dest CapabilityList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -21168,20 +24497,6 @@ func ParsePostPeerActionDrainResponse(rsp *http.Response) (*PostPeerActionDrainR
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
-		var dest N408
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON408 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -21194,22 +24509,22 @@ func ParsePostPeerActionDrainResponse(rsp *http.Response) (*PostPeerActionDrainR
 	return response, nil
 }
 
-// ParsePostPeerActionFreezeResponse parses an HTTP response from a PostPeerActionFreezeWithResponse call
-func ParsePostPeerActionFreezeResponse(rsp *http.Response) (*PostPeerActionFreezeResponse, error) {
+// ParseGetNodeConfigResponse parses an HTTP response from a GetNodeConfigWithResponse call
+func ParseGetNodeConfigResponse(rsp *http.Response) (*GetNodeConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPeerActionFreezeResponse{
+	response := &GetNodeConfigResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodeActionAccepted
+		var dest KeywordList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -21248,27 +24563,34 @@ func ParsePostPeerActionFreezeResponse(rsp *http.Response) (*PostPeerActionFreez
 	return response, nil
 }
 
-// ParsePostNodeActionPushAssetResponse parses an HTTP response from a PostNodeActionPushAssetWithResponse call
-func ParsePostNodeActionPushAssetResponse(rsp *http.Response) (*PostNodeActionPushAssetResponse, error) {
+// ParsePatchNodeConfigResponse parses an HTTP response from a PatchNodeConfigWithResponse call
+func ParsePatchNodeConfigResponse(rsp *http.Response) (*PatchNodeConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostNodeActionPushAssetResponse{
+	response := &PatchNodeConfigResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodeActionAccepted
+		var dest Committed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -21295,26 +24617,26 @@ func ParsePostNodeActionPushAssetResponse(rsp *http.Response) (*PostNodeActionPu
 	return response, nil
 }
 
-// ParsePostNodeActionPushDiskResponse parses an HTTP response from a PostNodeActionPushDiskWithResponse call
-func ParsePostNodeActionPushDiskResponse(rsp *http.Response) (*PostNodeActionPushDiskResponse, error) {
+// ParseGetNodeConfigFileResponse parses an HTTP response from a GetNodeConfigFileWithResponse call
+func ParseGetNodeConfigFileResponse(rsp *http.Response) (*GetNodeConfigFileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostNodeActionPushDiskResponse{
+	response := &GetNodeConfigFileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodeActionAccepted
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
@@ -21342,26 +24664,26 @@ func ParsePostNodeActionPushDiskResponse(rsp *http.Response) (*PostNodeActionPus
 	return response, nil
 }
 
-// ParsePostNodeActionPushPkgResponse parses an HTTP response from a PostNodeActionPushPkgWithResponse call
-func ParsePostNodeActionPushPkgResponse(rsp *http.Response) (*PostNodeActionPushPkgResponse, error) {
+// ParsePutNodeConfigFileResponse parses an HTTP response from a PutNodeConfigFileWithResponse call
+func ParsePutNodeConfigFileResponse(rsp *http.Response) (*PutNodeConfigFileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostNodeActionPushPkgResponse{
+	response := &PutNodeConfigFileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodeActionAccepted
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
@@ -21377,6 +24699,20 @@ func ParsePostNodeActionPushPkgResponse(rsp *http.Response) (*PostNodeActionPush
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -21389,40 +24725,40 @@ func ParsePostNodeActionPushPkgResponse(rsp *http.Response) (*PostNodeActionPush
 	return response, nil
 }
 
-// ParsePostNodeActionScanCapabilitiesResponse parses an HTTP response from a PostNodeActionScanCapabilitiesWithResponse call
-func ParsePostNodeActionScanCapabilitiesResponse(rsp *http.Response) (*PostNodeActionScanCapabilitiesResponse, error) {
+// ParseGetNodeConfigKeywordsResponse parses an HTTP response from a GetNodeConfigKeywordsWithResponse call
+func ParseGetNodeConfigKeywordsResponse(rsp *http.Response) (*GetNodeConfigKeywordsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostNodeActionScanCapabilitiesResponse{
+	response := &GetNodeConfigKeywordsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodeActionAccepted
+		var dest KeywordDefinitionList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
@@ -21436,27 +24772,34 @@ func ParsePostNodeActionScanCapabilitiesResponse(rsp *http.Response) (*PostNodeA
 	return response, nil
 }
 
-// ParsePostNodeActionSCSIScanResponse parses an HTTP response from a PostNodeActionSCSIScanWithResponse call
-func ParsePostNodeActionSCSIScanResponse(rsp *http.Response) (*PostNodeActionSCSIScanResponse, error) {
+// ParsePostDaemonRestartResponse parses an HTTP response from a PostDaemonRestartWithResponse call
+func ParsePostDaemonRestartResponse(rsp *http.Response) (*PostDaemonRestartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostNodeActionSCSIScanResponse{
+	response := &PostDaemonRestartResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodeActionAccepted
+		var dest N200
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -21483,22 +24826,22 @@ func ParsePostNodeActionSCSIScanResponse(rsp *http.Response) (*PostNodeActionSCS
 	return response, nil
 }
 
-// ParsePostNodeActionSysreportResponse parses an HTTP response from a PostNodeActionSysreportWithResponse call
-func ParsePostNodeActionSysreportResponse(rsp *http.Response) (*PostNodeActionSysreportResponse, error) {
+// ParsePostDaemonShutdownResponse parses an HTTP response from a PostDaemonShutdownWithResponse call
+func ParsePostDaemonShutdownResponse(rsp *http.Response) (*PostDaemonShutdownResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostNodeActionSysreportResponse{
+	response := &PostDaemonShutdownResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodeActionAccepted
+		var dest DaemonPid
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -21537,22 +24880,22 @@ func ParsePostNodeActionSysreportResponse(rsp *http.Response) (*PostNodeActionSy
 	return response, nil
 }
 
-// ParsePostPeerActionUnfreezeResponse parses an HTTP response from a PostPeerActionUnfreezeWithResponse call
-func ParsePostPeerActionUnfreezeResponse(rsp *http.Response) (*PostPeerActionUnfreezeResponse, error) {
+// ParsePostDaemonStopResponse parses an HTTP response from a PostDaemonStopWithResponse call
+func ParsePostDaemonStopResponse(rsp *http.Response) (*PostDaemonStopResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPeerActionUnfreezeResponse{
+	response := &PostDaemonStopResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodeActionAccepted
+		var dest DaemonPid
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -21591,27 +24934,20 @@ func ParsePostPeerActionUnfreezeResponse(rsp *http.Response) (*PostPeerActionUnf
 	return response, nil
 }
 
-// ParseGetNodeCapabilitiesResponse parses an HTTP response from a GetNodeCapabilitiesWithResponse call
-func ParseGetNodeCapabilitiesResponse(rsp *http.Response) (*GetNodeCapabilitiesResponse, error) {
+// ParsePostDaemonAuditResponse parses an HTTP response from a PostDaemonAuditWithResponse call
+func ParsePostDaemonAuditResponse(rsp *http.Response) (*PostDaemonAuditResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNodeCapabilitiesResponse{
+	response := &PostDaemonAuditResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CapabilityList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -21633,6 +24969,13 @@ func ParseGetNodeCapabilitiesResponse(rsp *http.Response) (*GetNodeCapabilitiesR
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -21645,33 +24988,26 @@ func ParseGetNodeCapabilitiesResponse(rsp *http.Response) (*GetNodeCapabilitiesR
 	return response, nil
 }
 
-// ParseGetNodeConfigResponse parses an HTTP response from a GetNodeConfigWithResponse call
-func ParseGetNodeConfigResponse(rsp *http.Response) (*GetNodeConfigResponse, error) {
+// ParseGetDaemonDNSDumpResponse parses an HTTP response from a GetDaemonDNSDumpWithResponse call
+func ParseGetDaemonDNSDumpResponse(rsp *http.Response) (*GetDaemonDNSDumpResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNodeConfigResponse{
+	response := &GetDaemonDNSDumpResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KeywordList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+		var dest DNSZone
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
@@ -21699,27 +25035,20 @@ func ParseGetNodeConfigResponse(rsp *http.Response) (*GetNodeConfigResponse, err
 	return response, nil
 }
 
-// ParsePatchNodeConfigResponse parses an HTTP response from a PatchNodeConfigWithResponse call
-func ParsePatchNodeConfigResponse(rsp *http.Response) (*PatchNodeConfigResponse, error) {
+// ParseGetDaemonEventsResponse parses an HTTP response from a GetDaemonEventsWithResponse call
+func ParseGetDaemonEventsResponse(rsp *http.Response) (*GetDaemonEventsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchNodeConfigResponse{
+	response := &GetDaemonEventsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Committed
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -21753,20 +25082,27 @@ func ParsePatchNodeConfigResponse(rsp *http.Response) (*PatchNodeConfigResponse,
 	return response, nil
 }
 
-// ParseGetNodeConfigFileResponse parses an HTTP response from a GetNodeConfigFileWithResponse call
-func ParseGetNodeConfigFileResponse(rsp *http.Response) (*GetNodeConfigFileResponse, error) {
+// ParsePostDaemonHeartbeatRestartResponse parses an HTTP response from a PostDaemonHeartbeatRestartWithResponse call
+func ParsePostDaemonHeartbeatRestartResponse(rsp *http.Response) (*PostDaemonHeartbeatRestartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNodeConfigFileResponse{
+	response := &PostDaemonHeartbeatRestartResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest N200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -21800,20 +25136,27 @@ func ParseGetNodeConfigFileResponse(rsp *http.Response) (*GetNodeConfigFileRespo
 	return response, nil
 }
 
-// ParsePutNodeConfigFileResponse parses an HTTP response from a PutNodeConfigFileWithResponse call
-func ParsePutNodeConfigFileResponse(rsp *http.Response) (*PutNodeConfigFileResponse, error) {
+// ParsePostDaemonHeartbeatSignResponse parses an HTTP response from a PostDaemonHeartbeatSignWithResponse call
+func ParsePostDaemonHeartbeatSignResponse(rsp *http.Response) (*PostDaemonHeartbeatSignResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutNodeConfigFileResponse{
+	response := &PostDaemonHeartbeatSignResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest N200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -21835,20 +25178,6 @@ func ParsePutNodeConfigFileResponse(rsp *http.Response) (*PutNodeConfigFileRespo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -21861,22 +25190,22 @@ func ParsePutNodeConfigFileResponse(rsp *http.Response) (*PutNodeConfigFileRespo
 	return response, nil
 }
 
-// ParseGetNodeConfigKeywordsResponse parses an HTTP response from a GetNodeConfigKeywordsWithResponse call
-func ParseGetNodeConfigKeywordsResponse(rsp *http.Response) (*GetNodeConfigKeywordsResponse, error) {
+// ParsePostDaemonHeartbeatStartResponse parses an HTTP response from a PostDaemonHeartbeatStartWithResponse call
+func ParsePostDaemonHeartbeatStartResponse(rsp *http.Response) (*PostDaemonHeartbeatStartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNodeConfigKeywordsResponse{
+	response := &PostDaemonHeartbeatStartResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KeywordDefinitionList
+		var dest N200
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -21896,6 +25225,13 @@ func ParseGetNodeConfigKeywordsResponse(rsp *http.Response) (*GetNodeConfigKeywo
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -21908,15 +25244,15 @@ func ParseGetNodeConfigKeywordsResponse(rsp *http.Response) (*GetNodeConfigKeywo
 	return response, nil
 }
 
-// ParsePostDaemonRestartResponse parses an HTTP response from a PostDaemonRestartWithResponse call
-func ParsePostDaemonRestartResponse(rsp *http.Response) (*PostDaemonRestartResponse, error) {
+// ParsePostDaemonHeartbeatStopResponse parses an HTTP response from a PostDaemonHeartbeatStopWithResponse call
+func ParsePostDaemonHeartbeatStopResponse(rsp *http.Response) (*PostDaemonHeartbeatStopResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonRestartResponse{
+	response := &PostDaemonHeartbeatStopResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -21962,22 +25298,22 @@ func ParsePostDaemonRestartResponse(rsp *http.Response) (*PostDaemonRestartRespo
 	return response, nil
 }
 
-// ParsePostDaemonShutdownResponse parses an HTTP response from a PostDaemonShutdownWithResponse call
-func ParsePostDaemonShutdownResponse(rsp *http.Response) (*PostDaemonShutdownResponse, error) {
+// ParsePostDaemonHeartbeatWipeResponse parses an HTTP response from a PostDaemonHeartbeatWipeWithResponse call
+func ParsePostDaemonHeartbeatWipeResponse(rsp *http.Response) (*PostDaemonHeartbeatWipeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonShutdownResponse{
+	response := &PostDaemonHeartbeatWipeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DaemonPid
+		var dest N200
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -22016,22 +25352,22 @@ func ParsePostDaemonShutdownResponse(rsp *http.Response) (*PostDaemonShutdownRes
 	return response, nil
 }
 
-// ParsePostDaemonStopResponse parses an HTTP response from a PostDaemonStopWithResponse call
-func ParsePostDaemonStopResponse(rsp *http.Response) (*PostDaemonStopResponse, error) {
+// ParsePostDaemonListenerRestartResponse parses an HTTP response from a PostDaemonListenerRestartWithResponse call
+func ParsePostDaemonListenerRestartResponse(rsp *http.Response) (*PostDaemonListenerRestartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonStopResponse{
+	response := &PostDaemonListenerRestartResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DaemonPid
+		var dest N200
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -22070,20 +25406,27 @@ func ParsePostDaemonStopResponse(rsp *http.Response) (*PostDaemonStopResponse, e
 	return response, nil
 }
 
-// ParsePostDaemonAuditResponse parses an HTTP response from a PostDaemonAuditWithResponse call
-func ParsePostDaemonAuditResponse(rsp *http.Response) (*PostDaemonAuditResponse, error) {
+// ParsePostDaemonListenerStartResponse parses an HTTP response from a PostDaemonListenerStartWithResponse call
+func ParsePostDaemonListenerStartResponse(rsp *http.Response) (*PostDaemonListenerStartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonAuditResponse{
+	response := &PostDaemonListenerStartResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest N200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22105,13 +25448,6 @@ func ParsePostDaemonAuditResponse(rsp *http.Response) (*PostDaemonAuditResponse,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22124,27 +25460,34 @@ func ParsePostDaemonAuditResponse(rsp *http.Response) (*PostDaemonAuditResponse,
 	return response, nil
 }
 
-// ParseGetDaemonDNSDumpResponse parses an HTTP response from a GetDaemonDNSDumpWithResponse call
-func ParseGetDaemonDNSDumpResponse(rsp *http.Response) (*GetDaemonDNSDumpResponse, error) {
+// ParsePostDaemonListenerStopResponse parses an HTTP response from a PostDaemonListenerStopWithResponse call
+func ParsePostDaemonListenerStopResponse(rsp *http.Response) (*PostDaemonListenerStopResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDaemonDNSDumpResponse{
+	response := &PostDaemonListenerStopResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DNSZone
+		var dest N200
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22171,20 +25514,27 @@ func ParseGetDaemonDNSDumpResponse(rsp *http.Response) (*GetDaemonDNSDumpRespons
 	return response, nil
 }
 
-// ParseGetDaemonEventsResponse parses an HTTP response from a GetDaemonEventsWithResponse call
-func ParseGetDaemonEventsResponse(rsp *http.Response) (*GetDaemonEventsResponse, error) {
+// ParsePostDaemonListenerLogControlResponse parses an HTTP response from a PostDaemonListenerLogControlWithResponse call
+func ParsePostDaemonListenerLogControlResponse(rsp *http.Response) (*PostDaemonListenerLogControlResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDaemonEventsResponse{
+	response := &PostDaemonListenerLogControlResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest N200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22218,15 +25568,15 @@ func ParseGetDaemonEventsResponse(rsp *http.Response) (*GetDaemonEventsResponse,
 	return response, nil
 }
 
-// ParsePostDaemonHeartbeatRestartResponse parses an HTTP response from a PostDaemonHeartbeatRestartWithResponse call
-func ParsePostDaemonHeartbeatRestartResponse(rsp *http.Response) (*PostDaemonHeartbeatRestartResponse, error) {
+// ParsePostDaemonLogControlResponse parses an HTTP response from a PostDaemonLogControlWithResponse call
+func ParsePostDaemonLogControlResponse(rsp *http.Response) (*PostDaemonLogControlResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonHeartbeatRestartResponse{
+	response := &PostDaemonLogControlResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -22272,27 +25622,20 @@ func ParsePostDaemonHeartbeatRestartResponse(rsp *http.Response) (*PostDaemonHea
 	return response, nil
 }
 
-// ParsePostDaemonHeartbeatSignResponse parses an HTTP response from a PostDaemonHeartbeatSignWithResponse call
-func ParsePostDaemonHeartbeatSignResponse(rsp *http.Response) (*PostDaemonHeartbeatSignResponse, error) {
+// ParseDeleteDaemonProcessResponse parses an HTTP response from a DeleteDaemonProcessWithResponse call
+func ParseDeleteDaemonProcessResponse(rsp *http.Response) (*DeleteDaemonProcessResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonHeartbeatSignResponse{
+	response := &DeleteDaemonProcessResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest N200
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22300,19 +25643,19 @@ func ParsePostDaemonHeartbeatSignResponse(rsp *http.Response) (*PostDaemonHeartb
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
@@ -22326,41 +25669,27 @@ func ParsePostDaemonHeartbeatSignResponse(rsp *http.Response) (*PostDaemonHeartb
 	return response, nil
 }
 
-// ParsePostDaemonHeartbeatStartResponse parses an HTTP response from a PostDaemonHeartbeatStartWithResponse call
-func ParsePostDaemonHeartbeatStartResponse(rsp *http.Response) (*PostDaemonHeartbeatStartResponse, error) {
+// ParseGetDaemonProcessResponse parses an HTTP response from a GetDaemonProcessWithResponse call
+func ParseGetDaemonProcessResponse(rsp *http.Response) (*GetDaemonProcessResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonHeartbeatStartResponse{
+	response := &GetDaemonProcessResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest N200
+		var dest ProcessList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest N403
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22380,22 +25709,22 @@ func ParsePostDaemonHeartbeatStartResponse(rsp *http.Response) (*PostDaemonHeart
 	return response, nil
 }
 
-// ParsePostDaemonHeartbeatStopResponse parses an HTTP response from a PostDaemonHeartbeatStopWithResponse call
-func ParsePostDaemonHeartbeatStopResponse(rsp *http.Response) (*PostDaemonHeartbeatStopResponse, error) {
+// ParseGetNodeDRBDAllocationResponse parses an HTTP response from a GetNodeDRBDAllocationWithResponse call
+func ParseGetNodeDRBDAllocationResponse(rsp *http.Response) (*GetNodeDRBDAllocationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonHeartbeatStopResponse{
+	response := &GetNodeDRBDAllocationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest N200
+		var dest DRBDAllocation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -22408,20 +25737,6 @@ func ParsePostDaemonHeartbeatStopResponse(rsp *http.Response) (*PostDaemonHeartb
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22434,22 +25749,22 @@ func ParsePostDaemonHeartbeatStopResponse(rsp *http.Response) (*PostDaemonHeartb
 	return response, nil
 }
 
-// ParsePostDaemonHeartbeatWipeResponse parses an HTTP response from a PostDaemonHeartbeatWipeWithResponse call
-func ParsePostDaemonHeartbeatWipeResponse(rsp *http.Response) (*PostDaemonHeartbeatWipeResponse, error) {
+// ParseGetNodeDRBDConfigResponse parses an HTTP response from a GetNodeDRBDConfigWithResponse call
+func ParseGetNodeDRBDConfigResponse(rsp *http.Response) (*GetNodeDRBDConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonHeartbeatWipeResponse{
+	response := &GetNodeDRBDConfigResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest N200
+		var dest DRBDConfig
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -22488,27 +25803,20 @@ func ParsePostDaemonHeartbeatWipeResponse(rsp *http.Response) (*PostDaemonHeartb
 	return response, nil
 }
 
-// ParsePostDaemonListenerRestartResponse parses an HTTP response from a PostDaemonListenerRestartWithResponse call
-func ParsePostDaemonListenerRestartResponse(rsp *http.Response) (*PostDaemonListenerRestartResponse, error) {
+// ParsePostNodeDRBDConfigResponse parses an HTTP response from a PostNodeDRBDConfigWithResponse call
+func ParsePostNodeDRBDConfigResponse(rsp *http.Response) (*PostNodeDRBDConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonListenerRestartResponse{
+	response := &PostNodeDRBDConfigResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest N200
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22542,27 +25850,20 @@ func ParsePostDaemonListenerRestartResponse(rsp *http.Response) (*PostDaemonList
 	return response, nil
 }
 
-// ParsePostDaemonListenerStartResponse parses an HTTP response from a PostDaemonListenerStartWithResponse call
-func ParsePostDaemonListenerStartResponse(rsp *http.Response) (*PostDaemonListenerStartResponse, error) {
+// ParsePostNodeDRBDConnectResponse parses an HTTP response from a PostNodeDRBDConnectWithResponse call
+func ParsePostNodeDRBDConnectResponse(rsp *http.Response) (*PostNodeDRBDConnectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonListenerStartResponse{
+	response := &PostNodeDRBDConnectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest N200
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22577,12 +25878,19 @@ func ParsePostDaemonListenerStartResponse(rsp *http.Response) (*PostDaemonListen
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
@@ -22596,27 +25904,20 @@ func ParsePostDaemonListenerStartResponse(rsp *http.Response) (*PostDaemonListen
 	return response, nil
 }
 
-// ParsePostDaemonListenerStopResponse parses an HTTP response from a PostDaemonListenerStopWithResponse call
-func ParsePostDaemonListenerStopResponse(rsp *http.Response) (*PostDaemonListenerStopResponse, error) {
+// ParsePostNodeDRBDPrimaryResponse parses an HTTP response from a PostNodeDRBDPrimaryWithResponse call
+func ParsePostNodeDRBDPrimaryResponse(rsp *http.Response) (*PostNodeDRBDPrimaryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonListenerStopResponse{
+	response := &PostNodeDRBDPrimaryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest N200
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22638,6 +25939,13 @@ func ParsePostDaemonListenerStopResponse(rsp *http.Response) (*PostDaemonListene
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22650,27 +25958,20 @@ func ParsePostDaemonListenerStopResponse(rsp *http.Response) (*PostDaemonListene
 	return response, nil
 }
 
-// ParsePostDaemonListenerLogControlResponse parses an HTTP response from a PostDaemonListenerLogControlWithResponse call
-func ParsePostDaemonListenerLogControlResponse(rsp *http.Response) (*PostDaemonListenerLogControlResponse, error) {
+// ParsePostNodeDRBDSecondaryResponse parses an HTTP response from a PostNodeDRBDSecondaryWithResponse call
+func ParsePostNodeDRBDSecondaryResponse(rsp *http.Response) (*PostNodeDRBDSecondaryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonListenerLogControlResponse{
+	response := &PostNodeDRBDSecondaryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest N200
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22692,6 +25993,13 @@ func ParsePostDaemonListenerLogControlResponse(rsp *http.Response) (*PostDaemonL
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22704,22 +26012,22 @@ func ParsePostDaemonListenerLogControlResponse(rsp *http.Response) (*PostDaemonL
 	return response, nil
 }
 
-// ParsePostDaemonLogControlResponse parses an HTTP response from a PostDaemonLogControlWithResponse call
-func ParsePostDaemonLogControlResponse(rsp *http.Response) (*PostDaemonLogControlResponse, error) {
+// ParseGetNodeDriverResponse parses an HTTP response from a GetNodeDriverWithResponse call
+func ParseGetNodeDriverResponse(rsp *http.Response) (*GetNodeDriverResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDaemonLogControlResponse{
+	response := &GetNodeDriverResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest N200
+		var dest DriverList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -22758,20 +26066,27 @@ func ParsePostDaemonLogControlResponse(rsp *http.Response) (*PostDaemonLogContro
 	return response, nil
 }
 
-// ParseDeleteDaemonProcessResponse parses an HTTP response from a DeleteDaemonProcessWithResponse call
-func ParseDeleteDaemonProcessResponse(rsp *http.Response) (*DeleteDaemonProcessResponse, error) {
+// ParseGetInstanceResponse parses an HTTP response from a GetInstanceWithResponse call
+func ParseGetInstanceResponse(rsp *http.Response) (*GetInstanceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDaemonProcessResponse{
+	response := &GetInstanceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InstanceItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22779,19 +26094,19 @@ func ParseDeleteDaemonProcessResponse(rsp *http.Response) (*DeleteDaemonProcessR
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
@@ -22805,27 +26120,41 @@ func ParseDeleteDaemonProcessResponse(rsp *http.Response) (*DeleteDaemonProcessR
 	return response, nil
 }
 
-// ParseGetDaemonProcessResponse parses an HTTP response from a GetDaemonProcessWithResponse call
-func ParseGetDaemonProcessResponse(rsp *http.Response) (*GetDaemonProcessResponse, error) {
+// ParsePostInstanceActionBootResponse parses an HTTP response from a PostInstanceActionBootWithResponse call
+func ParsePostInstanceActionBootResponse(rsp *http.Response) (*PostInstanceActionBootResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDaemonProcessResponse{
+	response := &PostInstanceActionBootResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProcessList
+		var dest InstanceActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest N403
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22845,22 +26174,22 @@ func ParseGetDaemonProcessResponse(rsp *http.Response) (*GetDaemonProcessRespons
 	return response, nil
 }
 
-// ParseGetNodeDRBDAllocationResponse parses an HTTP response from a GetNodeDRBDAllocationWithResponse call
-func ParseGetNodeDRBDAllocationResponse(rsp *http.Response) (*GetNodeDRBDAllocationResponse, error) {
+// ParsePostInstanceActionDeleteResponse parses an HTTP response from a PostInstanceActionDeleteWithResponse call
+func ParsePostInstanceActionDeleteResponse(rsp *http.Response) (*PostInstanceActionDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNodeDRBDAllocationResponse{
+	response := &PostInstanceActionDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DRBDAllocation
+		var dest InstanceActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -22873,6 +26202,20 @@ func ParseGetNodeDRBDAllocationResponse(rsp *http.Response) (*GetNodeDRBDAllocat
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22885,22 +26228,22 @@ func ParseGetNodeDRBDAllocationResponse(rsp *http.Response) (*GetNodeDRBDAllocat
 	return response, nil
 }
 
-// ParseGetNodeDRBDConfigResponse parses an HTTP response from a GetNodeDRBDConfigWithResponse call
-func ParseGetNodeDRBDConfigResponse(rsp *http.Response) (*GetNodeDRBDConfigResponse, error) {
+// ParsePostInstanceActionFreezeResponse parses an HTTP response from a PostInstanceActionFreezeWithResponse call
+func ParsePostInstanceActionFreezeResponse(rsp *http.Response) (*PostInstanceActionFreezeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNodeDRBDConfigResponse{
+	response := &PostInstanceActionFreezeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DRBDConfig
+		var dest InstanceActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -22939,20 +26282,27 @@ func ParseGetNodeDRBDConfigResponse(rsp *http.Response) (*GetNodeDRBDConfigRespo
 	return response, nil
 }
 
-// ParsePostNodeDRBDConfigResponse parses an HTTP response from a PostNodeDRBDConfigWithResponse call
-func ParsePostNodeDRBDConfigResponse(rsp *http.Response) (*PostNodeDRBDConfigResponse, error) {
+// ParsePostInstanceActionPGUpdateResponse parses an HTTP response from a PostInstanceActionPGUpdateWithResponse call
+func ParsePostInstanceActionPGUpdateResponse(rsp *http.Response) (*PostInstanceActionPGUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostNodeDRBDConfigResponse{
+	response := &PostInstanceActionPGUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InstanceActionAccepted
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -22986,20 +26336,27 @@ func ParsePostNodeDRBDConfigResponse(rsp *http.Response) (*PostNodeDRBDConfigRes
 	return response, nil
 }
 
-// ParsePostNodeDRBDConnectResponse parses an HTTP response from a PostNodeDRBDConnectWithResponse call
-func ParsePostNodeDRBDConnectResponse(rsp *http.Response) (*PostNodeDRBDConnectResponse, error) {
+// ParsePostInstanceActionProvisionResponse parses an HTTP response from a PostInstanceActionProvisionWithResponse call
+func ParsePostInstanceActionProvisionResponse(rsp *http.Response) (*PostInstanceActionProvisionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostNodeDRBDConnectResponse{
+	response := &PostInstanceActionProvisionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InstanceActionAccepted
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -23021,13 +26378,6 @@ func ParsePostNodeDRBDConnectResponse(rsp *http.Response) (*PostNodeDRBDConnectR
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -23040,20 +26390,27 @@ func ParsePostNodeDRBDConnectResponse(rsp *http.Response) (*PostNodeDRBDConnectR
 	return response, nil
 }
 
-// ParsePostNodeDRBDPrimaryResponse parses an HTTP response from a PostNodeDRBDPrimaryWithResponse call
-func ParsePostNodeDRBDPrimaryResponse(rsp *http.Response) (*PostNodeDRBDPrimaryResponse, error) {
+// ParsePostInstanceActionPRStartResponse parses an HTTP response from a PostInstanceActionPRStartWithResponse call
+func ParsePostInstanceActionPRStartResponse(rsp *http.Response) (*PostInstanceActionPRStartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostNodeDRBDPrimaryResponse{
+	response := &PostInstanceActionPRStartResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InstanceActionAccepted
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -23075,13 +26432,6 @@ func ParsePostNodeDRBDPrimaryResponse(rsp *http.Response) (*PostNodeDRBDPrimaryR
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -23094,20 +26444,27 @@ func ParsePostNodeDRBDPrimaryResponse(rsp *http.Response) (*PostNodeDRBDPrimaryR
 	return response, nil
 }
 
-// ParsePostNodeDRBDSecondaryResponse parses an HTTP response from a PostNodeDRBDSecondaryWithResponse call
-func ParsePostNodeDRBDSecondaryResponse(rsp *http.Response) (*PostNodeDRBDSecondaryResponse, error) {
+// ParsePostInstanceActionPRStopResponse parses an HTTP response from a PostInstanceActionPRStopWithResponse call
+func ParsePostInstanceActionPRStopResponse(rsp *http.Response) (*PostInstanceActionPRStopResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostNodeDRBDSecondaryResponse{
+	response := &PostInstanceActionPRStopResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InstanceActionAccepted
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -23129,13 +26486,6 @@ func ParsePostNodeDRBDSecondaryResponse(rsp *http.Response) (*PostNodeDRBDSecond
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -23148,34 +26498,27 @@ func ParsePostNodeDRBDSecondaryResponse(rsp *http.Response) (*PostNodeDRBDSecond
 	return response, nil
 }
 
-// ParseGetNodeDriverResponse parses an HTTP response from a GetNodeDriverWithResponse call
-func ParseGetNodeDriverResponse(rsp *http.Response) (*GetNodeDriverResponse, error) {
+// ParsePostInstanceActionPushResourceInfoResponse parses an HTTP response from a PostInstanceActionPushResourceInfoWithResponse call
+func ParsePostInstanceActionPushResourceInfoResponse(rsp *http.Response) (*PostInstanceActionPushResourceInfoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNodeDriverResponse{
+	response := &PostInstanceActionPushResourceInfoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DriverList
+		var dest InstanceActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -23202,22 +26545,22 @@ func ParseGetNodeDriverResponse(rsp *http.Response) (*GetNodeDriverResponse, err
 	return response, nil
 }
 
-// ParseGetInstanceResponse parses an HTTP response from a GetInstanceWithResponse call
-func ParseGetInstanceResponse(rsp *http.Response) (*GetInstanceResponse, error) {
+// ParsePostInstanceActionResizeResponse parses an HTTP response from a PostInstanceActionResizeWithResponse call
+func ParsePostInstanceActionResizeResponse(rsp *http.Response) (*PostInstanceActionResizeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetInstanceResponse{
+	response := &PostInstanceActionResizeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InstanceItem
+		var dest InstanceActionAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -23256,15 +26599,15 @@ func ParseGetInstanceResponse(rsp *http.Response) (*GetInstanceResponse, error)
 	return response, nil
 }
 
-// ParsePostInstanceActionBootResponse parses an HTTP response from a PostInstanceActionBootWithResponse call
-func ParsePostInstanceActionBootResponse(rsp *http.Response) (*PostInstanceActionBootResponse, error) {
+// ParsePostInstanceActionRestartResponse parses an HTTP response from a PostInstanceActionRestartWithResponse call
+func ParsePostInstanceActionRestartResponse(rsp *http.Response) (*PostInstanceActionRestartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionBootResponse{
+	response := &PostInstanceActionRestartResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23310,15 +26653,15 @@ func ParsePostInstanceActionBootResponse(rsp *http.Response) (*PostInstanceActio
 	return response, nil
 }
 
-// ParsePostInstanceActionDeleteResponse parses an HTTP response from a PostInstanceActionDeleteWithResponse call
-func ParsePostInstanceActionDeleteResponse(rsp *http.Response) (*PostInstanceActionDeleteResponse, error) {
+// ParsePostInstanceActionRunResponse parses an HTTP response from a PostInstanceActionRunWithResponse call
+func ParsePostInstanceActionRunResponse(rsp *http.Response) (*PostInstanceActionRunResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionDeleteResponse{
+	response := &PostInstanceActionRunResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23364,15 +26707,15 @@ func ParsePostInstanceActionDeleteResponse(rsp *http.Response) (*PostInstanceAct
 	return response, nil
 }
 
-// ParsePostInstanceActionFreezeResponse parses an HTTP response from a PostInstanceActionFreezeWithResponse call
-func ParsePostInstanceActionFreezeResponse(rsp *http.Response) (*PostInstanceActionFreezeResponse, error) {
+// ParsePostInstanceActionShutdownResponse parses an HTTP response from a PostInstanceActionShutdownWithResponse call
+func ParsePostInstanceActionShutdownResponse(rsp *http.Response) (*PostInstanceActionShutdownResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionFreezeResponse{
+	response := &PostInstanceActionShutdownResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23418,15 +26761,15 @@ func ParsePostInstanceActionFreezeResponse(rsp *http.Response) (*PostInstanceAct
 	return response, nil
 }
 
-// ParsePostInstanceActionPGUpdateResponse parses an HTTP response from a PostInstanceActionPGUpdateWithResponse call
-func ParsePostInstanceActionPGUpdateResponse(rsp *http.Response) (*PostInstanceActionPGUpdateResponse, error) {
+// ParsePostInstanceActionSnapshotCreateResponse parses an HTTP response from a PostInstanceActionSnapshotCreateWithResponse call
+func ParsePostInstanceActionSnapshotCreateResponse(rsp *http.Response) (*PostInstanceActionSnapshotCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionPGUpdateResponse{
+	response := &PostInstanceActionSnapshotCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23472,15 +26815,15 @@ func ParsePostInstanceActionPGUpdateResponse(rsp *http.Response) (*PostInstanceA
 	return response, nil
 }
 
-// ParsePostInstanceActionProvisionResponse parses an HTTP response from a PostInstanceActionProvisionWithResponse call
-func ParsePostInstanceActionProvisionResponse(rsp *http.Response) (*PostInstanceActionProvisionResponse, error) {
+// ParsePostInstanceActionSnapshotDeleteResponse parses an HTTP response from a PostInstanceActionSnapshotDeleteWithResponse call
+func ParsePostInstanceActionSnapshotDeleteResponse(rsp *http.Response) (*PostInstanceActionSnapshotDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionProvisionResponse{
+	response := &PostInstanceActionSnapshotDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23526,15 +26869,15 @@ func ParsePostInstanceActionProvisionResponse(rsp *http.Response) (*PostInstance
 	return response, nil
 }
 
-// ParsePostInstanceActionPRStartResponse parses an HTTP response from a PostInstanceActionPRStartWithResponse call
-func ParsePostInstanceActionPRStartResponse(rsp *http.Response) (*PostInstanceActionPRStartResponse, error) {
+// ParsePostInstanceActionSnapshotRollbackResponse parses an HTTP response from a PostInstanceActionSnapshotRollbackWithResponse call
+func ParsePostInstanceActionSnapshotRollbackResponse(rsp *http.Response) (*PostInstanceActionSnapshotRollbackResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionPRStartResponse{
+	response := &PostInstanceActionSnapshotRollbackResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23580,15 +26923,15 @@ func ParsePostInstanceActionPRStartResponse(rsp *http.Response) (*PostInstanceAc
 	return response, nil
 }
 
-// ParsePostInstanceActionPRStopResponse parses an HTTP response from a PostInstanceActionPRStopWithResponse call
-func ParsePostInstanceActionPRStopResponse(rsp *http.Response) (*PostInstanceActionPRStopResponse, error) {
+// ParsePostInstanceActionStartResponse parses an HTTP response from a PostInstanceActionStartWithResponse call
+func ParsePostInstanceActionStartResponse(rsp *http.Response) (*PostInstanceActionStartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionPRStopResponse{
+	response := &PostInstanceActionStartResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23634,15 +26977,15 @@ func ParsePostInstanceActionPRStopResponse(rsp *http.Response) (*PostInstanceAct
 	return response, nil
 }
 
-// ParsePostInstanceActionPushResourceInfoResponse parses an HTTP response from a PostInstanceActionPushResourceInfoWithResponse call
-func ParsePostInstanceActionPushResourceInfoResponse(rsp *http.Response) (*PostInstanceActionPushResourceInfoResponse, error) {
+// ParsePostInstanceActionStartStandbyResponse parses an HTTP response from a PostInstanceActionStartStandbyWithResponse call
+func ParsePostInstanceActionStartStandbyResponse(rsp *http.Response) (*PostInstanceActionStartStandbyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionPushResourceInfoResponse{
+	response := &PostInstanceActionStartStandbyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23655,6 +26998,13 @@ func ParsePostInstanceActionPushResourceInfoResponse(rsp *http.Response) (*PostI
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -23681,15 +27031,15 @@ func ParsePostInstanceActionPushResourceInfoResponse(rsp *http.Response) (*PostI
 	return response, nil
 }
 
-// ParsePostInstanceActionRestartResponse parses an HTTP response from a PostInstanceActionRestartWithResponse call
-func ParsePostInstanceActionRestartResponse(rsp *http.Response) (*PostInstanceActionRestartResponse, error) {
+// ParsePostInstanceActionStatusResponse parses an HTTP response from a PostInstanceActionStatusWithResponse call
+func ParsePostInstanceActionStatusResponse(rsp *http.Response) (*PostInstanceActionStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionRestartResponse{
+	response := &PostInstanceActionStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23735,15 +27085,15 @@ func ParsePostInstanceActionRestartResponse(rsp *http.Response) (*PostInstanceAc
 	return response, nil
 }
 
-// ParsePostInstanceActionRunResponse parses an HTTP response from a PostInstanceActionRunWithResponse call
-func ParsePostInstanceActionRunResponse(rsp *http.Response) (*PostInstanceActionRunResponse, error) {
+// ParsePostInstanceActionStopResponse parses an HTTP response from a PostInstanceActionStopWithResponse call
+func ParsePostInstanceActionStopResponse(rsp *http.Response) (*PostInstanceActionStopResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionRunResponse{
+	response := &PostInstanceActionStopResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23789,15 +27139,15 @@ func ParsePostInstanceActionRunResponse(rsp *http.Response) (*PostInstanceAction
 	return response, nil
 }
 
-// ParsePostInstanceActionShutdownResponse parses an HTTP response from a PostInstanceActionShutdownWithResponse call
-func ParsePostInstanceActionShutdownResponse(rsp *http.Response) (*PostInstanceActionShutdownResponse, error) {
+// ParsePostInstanceActionSyncIngestResponse parses an HTTP response from a PostInstanceActionSyncIngestWithResponse call
+func ParsePostInstanceActionSyncIngestResponse(rsp *http.Response) (*PostInstanceActionSyncIngestResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionShutdownResponse{
+	response := &PostInstanceActionSyncIngestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23843,15 +27193,15 @@ func ParsePostInstanceActionShutdownResponse(rsp *http.Response) (*PostInstanceA
 	return response, nil
 }
 
-// ParsePostInstanceActionStartResponse parses an HTTP response from a PostInstanceActionStartWithResponse call
-func ParsePostInstanceActionStartResponse(rsp *http.Response) (*PostInstanceActionStartResponse, error) {
+// ParsePostInstanceActionUnfreezeResponse parses an HTTP response from a PostInstanceActionUnfreezeWithResponse call
+func ParsePostInstanceActionUnfreezeResponse(rsp *http.Response) (*PostInstanceActionUnfreezeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionStartResponse{
+	response := &PostInstanceActionUnfreezeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23897,15 +27247,15 @@ func ParsePostInstanceActionStartResponse(rsp *http.Response) (*PostInstanceActi
 	return response, nil
 }
 
-// ParsePostInstanceActionStartStandbyResponse parses an HTTP response from a PostInstanceActionStartStandbyWithResponse call
-func ParsePostInstanceActionStartStandbyResponse(rsp *http.Response) (*PostInstanceActionStartStandbyResponse, error) {
+// ParsePostInstanceActionUnprovisionResponse parses an HTTP response from a PostInstanceActionUnprovisionWithResponse call
+func ParsePostInstanceActionUnprovisionResponse(rsp *http.Response) (*PostInstanceActionUnprovisionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionStartStandbyResponse{
+	response := &PostInstanceActionUnprovisionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -23951,27 +27301,20 @@ func ParsePostInstanceActionStartStandbyResponse(rsp *http.Response) (*PostInsta
 	return response, nil
 }
 
-// ParsePostInstanceActionStatusResponse parses an HTTP response from a PostInstanceActionStatusWithResponse call
-func ParsePostInstanceActionStatusResponse(rsp *http.Response) (*PostInstanceActionStatusResponse, error) {
+// ParsePostInstanceClearResponse parses an HTTP response from a PostInstanceClearWithResponse call
+func ParsePostInstanceClearResponse(rsp *http.Response) (*PostInstanceClearResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionStatusResponse{
+	response := &PostInstanceClearResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InstanceActionAccepted
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24005,27 +27348,20 @@ func ParsePostInstanceActionStatusResponse(rsp *http.Response) (*PostInstanceAct
 	return response, nil
 }
 
-// ParsePostInstanceActionStopResponse parses an HTTP response from a PostInstanceActionStopWithResponse call
-func ParsePostInstanceActionStopResponse(rsp *http.Response) (*PostInstanceActionStopResponse, error) {
+// ParseGetInstanceConfigFileResponse parses an HTTP response from a GetInstanceConfigFileWithResponse call
+func ParseGetInstanceConfigFileResponse(rsp *http.Response) (*GetInstanceConfigFileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionStopResponse{
+	response := &GetInstanceConfigFileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InstanceActionAccepted
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24059,27 +27395,20 @@ func ParsePostInstanceActionStopResponse(rsp *http.Response) (*PostInstanceActio
 	return response, nil
 }
 
-// ParsePostInstanceActionSyncIngestResponse parses an HTTP response from a PostInstanceActionSyncIngestWithResponse call
-func ParsePostInstanceActionSyncIngestResponse(rsp *http.Response) (*PostInstanceActionSyncIngestResponse, error) {
+// ParsePostInstanceResourceConsoleResponse parses an HTTP response from a PostInstanceResourceConsoleWithResponse call
+func ParsePostInstanceResourceConsoleResponse(rsp *http.Response) (*PostInstanceResourceConsoleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionSyncIngestResponse{
+	response := &PostInstanceResourceConsoleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InstanceActionAccepted
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24101,6 +27430,13 @@ func ParsePostInstanceActionSyncIngestResponse(rsp *http.Response) (*PostInstanc
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24113,27 +27449,20 @@ func ParsePostInstanceActionSyncIngestResponse(rsp *http.Response) (*PostInstanc
 	return response, nil
 }
 
-// ParsePostInstanceActionUnfreezeResponse parses an HTTP response from a PostInstanceActionUnfreezeWithResponse call
-func ParsePostInstanceActionUnfreezeResponse(rsp *http.Response) (*PostInstanceActionUnfreezeResponse, error) {
+// ParseGetInstanceContainerLogResponse parses an HTTP response from a GetInstanceContainerLogWithResponse call
+func ParseGetInstanceContainerLogResponse(rsp *http.Response) (*GetInstanceContainerLogResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionUnfreezeResponse{
+	response := &GetInstanceContainerLogResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InstanceActionAccepted
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24167,27 +27496,20 @@ func ParsePostInstanceActionUnfreezeResponse(rsp *http.Response) (*PostInstanceA
 	return response, nil
 }
 
-// ParsePostInstanceActionUnprovisionResponse parses an HTTP response from a PostInstanceActionUnprovisionWithResponse call
-func ParsePostInstanceActionUnprovisionResponse(rsp *http.Response) (*PostInstanceActionUnprovisionResponse, error) {
+// ParseGetInstanceLogsResponse parses an HTTP response from a GetInstanceLogsWithResponse call
+func ParseGetInstanceLogsResponse(rsp *http.Response) (*GetInstanceLogsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceActionUnprovisionResponse{
+	response := &GetInstanceLogsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InstanceActionAccepted
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24221,15 +27543,15 @@ func ParsePostInstanceActionUnprovisionResponse(rsp *http.Response) (*PostInstan
 	return response, nil
 }
 
-// ParsePostInstanceClearResponse parses an HTTP response from a PostInstanceClearWithResponse call
-func ParsePostInstanceClearResponse(rsp *http.Response) (*PostInstanceClearResponse, error) {
+// ParseGetInstanceResourceFileResponse parses an HTTP response from a GetInstanceResourceFileWithResponse call
+func ParseGetInstanceResourceFileResponse(rsp *http.Response) (*GetInstanceResourceFileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceClearResponse{
+	response := &GetInstanceResourceFileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -24268,20 +27590,27 @@ func ParsePostInstanceClearResponse(rsp *http.Response) (*PostInstanceClearRespo
 	return response, nil
 }
 
-// ParseGetInstanceConfigFileResponse parses an HTTP response from a GetInstanceConfigFileWithResponse call
-func ParseGetInstanceConfigFileResponse(rsp *http.Response) (*GetInstanceConfigFileResponse, error) {
+// ParseGetInstanceResourceInfoResponse parses an HTTP response from a GetInstanceResourceInfoWithResponse call
+func ParseGetInstanceResourceInfoResponse(rsp *http.Response) (*GetInstanceResourceInfoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetInstanceConfigFileResponse{
+	response := &GetInstanceResourceInfoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResourceInfoList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24315,20 +27644,27 @@ func ParseGetInstanceConfigFileResponse(rsp *http.Response) (*GetInstanceConfigF
 	return response, nil
 }
 
-// ParsePostInstanceResourceConsoleResponse parses an HTTP response from a PostInstanceResourceConsoleWithResponse call
-func ParsePostInstanceResourceConsoleResponse(rsp *http.Response) (*PostInstanceResourceConsoleResponse, error) {
+// ParseGetInstanceScheduleResponse parses an HTTP response from a GetInstanceScheduleWithResponse call
+func ParseGetInstanceScheduleResponse(rsp *http.Response) (*GetInstanceScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceResourceConsoleResponse{
+	response := &GetInstanceScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduleList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24369,20 +27705,27 @@ func ParsePostInstanceResourceConsoleResponse(rsp *http.Response) (*PostInstance
 	return response, nil
 }
 
-// ParseGetInstanceContainerLogResponse parses an HTTP response from a GetInstanceContainerLogWithResponse call
-func ParseGetInstanceContainerLogResponse(rsp *http.Response) (*GetInstanceContainerLogResponse, error) {
+// ParseGetInstanceSnapshotsResponse parses an HTTP response from a GetInstanceSnapshotsWithResponse call
+func ParseGetInstanceSnapshotsResponse(rsp *http.Response) (*GetInstanceSnapshotsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetInstanceContainerLogResponse{
+	response := &GetInstanceSnapshotsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SnapshotList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24404,6 +27747,13 @@ func ParseGetInstanceContainerLogResponse(rsp *http.Response) (*GetInstanceConta
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24416,15 +27766,15 @@ func ParseGetInstanceContainerLogResponse(rsp *http.Response) (*GetInstanceConta
 	return response, nil
 }
 
-// ParseGetInstanceLogsResponse parses an HTTP response from a GetInstanceLogsWithResponse call
-func ParseGetInstanceLogsResponse(rsp *http.Response) (*GetInstanceLogsResponse, error) {
+// ParsePostInstanceStateFileResponse parses an HTTP response from a PostInstanceStateFileWithResponse call
+func ParsePostInstanceStateFileResponse(rsp *http.Response) (*PostInstanceStateFileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetInstanceLogsResponse{
+	response := &PostInstanceStateFileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -24451,6 +27801,20 @@ func ParseGetInstanceLogsResponse(rsp *http.Response) (*GetInstanceLogsResponse,
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24463,20 +27827,27 @@ func ParseGetInstanceLogsResponse(rsp *http.Response) (*GetInstanceLogsResponse,
 	return response, nil
 }
 
-// ParseGetInstanceResourceFileResponse parses an HTTP response from a GetInstanceResourceFileWithResponse call
-func ParseGetInstanceResourceFileResponse(rsp *http.Response) (*GetInstanceResourceFileResponse, error) {
+// ParseGetInstanceSyncDataResponse parses an HTTP response from a GetInstanceSyncDataWithResponse call
+func ParseGetInstanceSyncDataResponse(rsp *http.Response) (*GetInstanceSyncDataResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetInstanceResourceFileResponse{
+	response := &GetInstanceSyncDataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncDataState
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24498,6 +27869,13 @@ func ParseGetInstanceResourceFileResponse(rsp *http.Response) (*GetInstanceResou
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24510,22 +27888,22 @@ func ParseGetInstanceResourceFileResponse(rsp *http.Response) (*GetInstanceResou
 	return response, nil
 }
 
-// ParseGetInstanceResourceInfoResponse parses an HTTP response from a GetInstanceResourceInfoWithResponse call
-func ParseGetInstanceResourceInfoResponse(rsp *http.Response) (*GetInstanceResourceInfoResponse, error) {
+// ParsePostInstanceSyncDataResponse parses an HTTP response from a PostInstanceSyncDataWithResponse call
+func ParsePostInstanceSyncDataResponse(rsp *http.Response) (*PostInstanceSyncDataResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetInstanceResourceInfoResponse{
+	response := &PostInstanceSyncDataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceInfoList
+		var dest SyncData
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24552,6 +27930,13 @@ func ParseGetInstanceResourceInfoResponse(rsp *http.Response) (*GetInstanceResou
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24564,34 +27949,20 @@ func ParseGetInstanceResourceInfoResponse(rsp *http.Response) (*GetInstanceResou
 	return response, nil
 }
 
-// ParseGetInstanceScheduleResponse parses an HTTP response from a GetInstanceScheduleWithResponse call
-func ParseGetInstanceScheduleResponse(rsp *http.Response) (*GetInstanceScheduleResponse, error) {
+// ParseGetNodeLogsResponse parses an HTTP response from a GetNodeLogsWithResponse call
+func ParseGetNodeLogsResponse(rsp *http.Response) (*GetNodeLogsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetInstanceScheduleResponse{
+	response := &GetNodeLogsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScheduleList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24606,13 +27977,6 @@ func ParseGetInstanceScheduleResponse(rsp *http.Response) (*GetInstanceScheduleR
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24625,27 +27989,20 @@ func ParseGetInstanceScheduleResponse(rsp *http.Response) (*GetInstanceScheduleR
 	return response, nil
 }
 
-// ParsePostInstanceStateFileResponse parses an HTTP response from a PostInstanceStateFileWithResponse call
-func ParsePostInstanceStateFileResponse(rsp *http.Response) (*PostInstanceStateFileResponse, error) {
+// ParseDeleteNodeMaintenanceResponse parses an HTTP response from a DeleteNodeMaintenanceWithResponse call
+func ParseDeleteNodeMaintenanceResponse(rsp *http.Response) (*DeleteNodeMaintenanceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostInstanceStateFileResponse{
+	response := &DeleteNodeMaintenanceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24660,12 +28017,12 @@ func ParsePostInstanceStateFileResponse(rsp *http.Response) (*PostInstanceStateF
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest N408
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON408 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest N409
@@ -24686,20 +28043,34 @@ func ParsePostInstanceStateFileResponse(rsp *http.Response) (*PostInstanceStateF
 	return response, nil
 }
 
-// ParseGetNodeLogsResponse parses an HTTP response from a GetNodeLogsWithResponse call
-func ParseGetNodeLogsResponse(rsp *http.Response) (*GetNodeLogsResponse, error) {
+// ParsePostNodeMaintenanceResponse parses an HTTP response from a PostNodeMaintenanceWithResponse call
+func ParsePostNodeMaintenanceResponse(rsp *http.Response) (*PostNodeMaintenanceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNodeLogsResponse{
+	response := &PostNodeMaintenanceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeMaintenance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24714,6 +28085,20 @@ func ParseGetNodeLogsResponse(rsp *http.Response) (*GetNodeLogsResponse, error)
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest N408
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25548,6 +28933,60 @@ func ParseGetObjectsResponse(rsp *http.Response) (*GetObjectsResponse, error) {
 	return response, nil
 }
 
+// ParsePostObjectsActionResponse parses an HTTP response from a PostObjectsActionWithResponse call
+func ParsePostObjectsActionResponse(rsp *http.Response) (*PostObjectsActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostObjectsActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetObjectPathsResponse parses an HTTP response from a GetObjectPathsWithResponse call
 func ParseGetObjectPathsResponse(rsp *http.Response) (*GetObjectPathsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -27481,6 +30920,196 @@ func ParseGetObjectScheduleResponse(rsp *http.Response) (*GetObjectScheduleRespo
 	return response, nil
 }
 
+// ParseDeleteObjectTokenResponse parses an HTTP response from a DeleteObjectTokenWithResponse call
+func ParseDeleteObjectTokenResponse(rsp *http.Response) (*DeleteObjectTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteObjectTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsrTokenItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetObjectTokensResponse parses an HTTP response from a GetObjectTokensWithResponse call
+func ParseGetObjectTokensResponse(rsp *http.Response) (*GetObjectTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetObjectTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsrTokenList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostObjectTokenResponse parses an HTTP response from a PostObjectTokenWithResponse call
+func ParsePostObjectTokenResponse(rsp *http.Response) (*PostObjectTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostObjectTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsrToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetSwaggerResponse parses an HTTP response from a GetSwaggerWithResponse call
 func ParseGetSwaggerResponse(rsp *http.Response) (*GetSwaggerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
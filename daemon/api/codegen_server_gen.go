@@ -24,6 +24,9 @@ type ServerInterface interface {
 	// (GET /api/array)
 	GetArray(ctx echo.Context, params GetArrayParams) error
 
+	// (GET /api/auth/can-i)
+	GetAuthCanI(ctx echo.Context, params GetAuthCanIParams) error
+
 	// (GET /api/auth/info)
 	GetAuthInfo(ctx echo.Context) error
 	// Refresh access token
@@ -81,6 +84,15 @@ type ServerInterface interface {
 	// (POST /api/instance/path/{namespace}/{kind}/{name}/status)
 	PostInstanceStatus(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (GET /api/job)
+	GetJobs(ctx echo.Context, params GetJobsParams) error
+
+	// (GET /api/job/{id})
+	GetJob(ctx echo.Context, id InPathJobID, params GetJobParams) error
+
+	// (POST /api/job/{id}/cancel)
+	PostJobCancel(ctx echo.Context, id InPathJobID, params PostJobCancelParams) error
+
 	// (GET /api/network)
 	GetNetworks(ctx echo.Context, params GetNetworksParams) error
 
@@ -90,6 +102,9 @@ type ServerInterface interface {
 	// (GET /api/node)
 	GetNodes(ctx echo.Context, params GetNodesParams) error
 
+	// (GET /api/node/capacity)
+	GetNodesCapacity(ctx echo.Context, params GetNodesCapacityParams) error
+
 	// (GET /api/node/info)
 	GetNodesInfo(ctx echo.Context) error
 
@@ -129,6 +144,9 @@ type ServerInterface interface {
 	// (POST /api/node/name/{nodename}/action/unfreeze)
 	PostPeerActionUnfreeze(ctx echo.Context, nodename InPathNodeName, params PostPeerActionUnfreezeParams) error
 
+	// (GET /api/node/name/{nodename}/audit/log)
+	GetNodeAuditLog(ctx echo.Context, nodename InPathNodeName, params GetNodeAuditLogParams) error
+
 	// (GET /api/node/name/{nodename}/capabilities)
 	GetNodeCapabilities(ctx echo.Context, nodename InPathNodeName) error
 
@@ -249,6 +267,9 @@ type ServerInterface interface {
 	// (POST /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/action/push/resource/info)
 	PostInstanceActionPushResourceInfo(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceActionPushResourceInfoParams) error
 
+	// (POST /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/action/resize)
+	PostInstanceActionResize(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceActionResizeParams) error
+
 	// (POST /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/action/restart)
 	PostInstanceActionRestart(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceActionRestartParams) error
 
@@ -258,6 +279,15 @@ type ServerInterface interface {
 	// (POST /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/action/shutdown)
 	PostInstanceActionShutdown(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceActionShutdownParams) error
 
+	// (POST /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/action/snapshot/create)
+	PostInstanceActionSnapshotCreate(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceActionSnapshotCreateParams) error
+
+	// (POST /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/action/snapshot/delete)
+	PostInstanceActionSnapshotDelete(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceActionSnapshotDeleteParams) error
+
+	// (POST /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/action/snapshot/rollback)
+	PostInstanceActionSnapshotRollback(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceActionSnapshotRollbackParams) error
+
 	// (POST /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/action/start)
 	PostInstanceActionStart(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceActionStartParams) error
 
@@ -303,12 +333,27 @@ type ServerInterface interface {
 	// (GET /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/schedule)
 	GetInstanceSchedule(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (GET /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/snapshot)
+	GetInstanceSnapshots(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) error
+
 	// (POST /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/state/file)
 	PostInstanceStateFile(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (GET /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/sync/data)
+	GetInstanceSyncData(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params GetInstanceSyncDataParams) error
+
+	// (POST /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/sync/data)
+	PostInstanceSyncData(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceSyncDataParams) error
+
 	// (GET /api/node/name/{nodename}/log)
 	GetNodeLogs(ctx echo.Context, nodename InPathNodeName, params GetNodeLogsParams) error
 
+	// (DELETE /api/node/name/{nodename}/maintenance)
+	DeleteNodeMaintenance(ctx echo.Context, nodename InPathNodeName) error
+
+	// (POST /api/node/name/{nodename}/maintenance)
+	PostNodeMaintenance(ctx echo.Context, nodename InPathNodeName) error
+
 	// (GET /api/node/name/{nodename}/metrics)
 	GetNodeMetrics(ctx echo.Context, nodename InPathNodeName) error
 
@@ -357,6 +402,9 @@ type ServerInterface interface {
 	// (GET /api/object)
 	GetObjects(ctx echo.Context, params GetObjectsParams) error
 
+	// (POST /api/object/action)
+	PostObjectsAction(ctx echo.Context) error
+
 	// (GET /api/object/path)
 	GetObjectPaths(ctx echo.Context, params GetObjectPathsParams) error
 
@@ -450,6 +498,15 @@ type ServerInterface interface {
 	// (GET /api/object/path/{namespace}/{kind}/{name}/schedule)
 	GetObjectSchedule(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (DELETE /api/object/path/{namespace}/{kind}/{name}/token)
+	DeleteObjectToken(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params DeleteObjectTokenParams) error
+
+	// (GET /api/object/path/{namespace}/{kind}/{name}/token)
+	GetObjectTokens(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
+
+	// (POST /api/object/path/{namespace}/{kind}/{name}/token)
+	PostObjectToken(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostObjectTokenParams) error
+
 	// (GET /api/openapi)
 	GetSwagger(ctx echo.Context) error
 
@@ -499,6 +556,35 @@ func (w *ServerInterfaceWrapper) GetArray(ctx echo.Context) error {
 	return err
 }
 
+// GetAuthCanI converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuthCanI(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthCanIParams
+	// ------------- Required query parameter "action" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "action", ctx.QueryParams(), &params.Action, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
+
+	// ------------- Required query parameter "path" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", ctx.QueryParams(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter path: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuthCanI(ctx, params)
+	return err
+}
+
 // GetAuthInfo converts echo context to params.
 func (w *ServerInterfaceWrapper) GetAuthInfo(ctx echo.Context) error {
 	var err error
@@ -972,6 +1058,86 @@ func (w *ServerInterfaceWrapper) PostInstanceStatus(ctx echo.Context) error {
 	return err
 }
 
+// GetJobs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJobs(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetJobsParams
+	// ------------- Optional query parameter "node" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "node", ctx.QueryParams(), &params.Node, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetJobs(ctx, params)
+	return err
+}
+
+// GetJob converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJob(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id InPathJobID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetJobParams
+	// ------------- Optional query parameter "node" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "node", ctx.QueryParams(), &params.Node, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetJob(ctx, id, params)
+	return err
+}
+
+// PostJobCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) PostJobCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id InPathJobID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostJobCancelParams
+	// ------------- Optional query parameter "node" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "node", ctx.QueryParams(), &params.Node, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostJobCancel(ctx, id, params)
+	return err
+}
+
 // GetNetworks converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNetworks(ctx echo.Context) error {
 	var err error
@@ -1038,6 +1204,28 @@ func (w *ServerInterfaceWrapper) GetNodes(ctx echo.Context) error {
 	return err
 }
 
+// GetNodesCapacity converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodesCapacity(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodesCapacityParams
+	// ------------- Optional query parameter "node" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "node", ctx.QueryParams(), &params.Node, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodesCapacity(ctx, params)
+	return err
+}
+
 // GetNodesInfo converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNodesInfo(ctx echo.Context) error {
 	var err error
@@ -1400,6 +1588,42 @@ func (w *ServerInterfaceWrapper) PostPeerActionUnfreeze(ctx echo.Context) error
 	return err
 }
 
+// GetNodeAuditLog converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeAuditLog(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeAuditLogParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "since", ctx.QueryParams(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeAuditLog(ctx, nodename, params)
+	return err
+}
+
 // GetNodeCapabilities converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNodeCapabilities(ctx echo.Context) error {
 	var err error
@@ -1780,6 +2004,30 @@ func (w *ServerInterfaceWrapper) GetDaemonEvents(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter selector: %s", err))
 	}
 
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "since", ctx.QueryParams(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID EventLastID
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "integer", Format: "uint64"})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetDaemonEvents(ctx, nodename, params)
 	return err
@@ -3104,6 +3352,66 @@ func (w *ServerInterfaceWrapper) PostInstanceActionPushResourceInfo(ctx echo.Con
 	return err
 }
 
+// PostInstanceActionResize converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInstanceActionResize(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostInstanceActionResizeParams
+	// ------------- Required query parameter "size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "size", ctx.QueryParams(), &params.Size, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "session_id", ctx.QueryParams(), &params.SessionId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostInstanceActionResize(ctx, nodename, namespace, kind, name, params)
+	return err
+}
+
 // PostInstanceActionRestart converts echo context to params.
 func (w *ServerInterfaceWrapper) PostInstanceActionRestart(ctx echo.Context) error {
 	var err error
@@ -3459,8 +3767,8 @@ func (w *ServerInterfaceWrapper) PostInstanceActionShutdown(ctx echo.Context) er
 	return err
 }
 
-// PostInstanceActionStart converts echo context to params.
-func (w *ServerInterfaceWrapper) PostInstanceActionStart(ctx echo.Context) error {
+// PostInstanceActionSnapshotCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInstanceActionSnapshotCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "nodename" -------------
 	var nodename InPathNodeName
@@ -3499,45 +3807,225 @@ func (w *ServerInterfaceWrapper) PostInstanceActionStart(ctx echo.Context) error
 	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostInstanceActionStartParams
-	// ------------- Optional query parameter "slaves" -------------
+	var params PostInstanceActionSnapshotCreateParams
+	// ------------- Required query parameter "name" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "slaves", ctx.QueryParams(), &params.Slaves, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "name", ctx.QueryParams(), &params.Name, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slaves: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	// ------------- Optional query parameter "disable_rollback" -------------
+	// ------------- Optional query parameter "session_id" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "disable_rollback", ctx.QueryParams(), &params.DisableRollback, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "session_id", ctx.QueryParams(), &params.SessionId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter disable_rollback: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "force" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostInstanceActionSnapshotCreate(ctx, nodename, namespace, kind, name, params)
+	return err
+}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "force", ctx.QueryParams(), &params.Force, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+// PostInstanceActionSnapshotDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInstanceActionSnapshotDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
 	}
 
-	// ------------- Optional query parameter "master" -------------
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "master", ctx.QueryParams(), &params.Master, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter master: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
 	}
 
-	// ------------- Optional query parameter "session_id" -------------
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "session_id", ctx.QueryParams(), &params.SessionId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
 	}
 
-	// ------------- Optional query parameter "rid" -------------
+	// ------------- Path parameter "name" -------------
+	var name InPathName
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "rid", ctx.QueryParams(), &params.Rid, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostInstanceActionSnapshotDeleteParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "name", ctx.QueryParams(), &params.Name, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "session_id", ctx.QueryParams(), &params.SessionId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostInstanceActionSnapshotDelete(ctx, nodename, namespace, kind, name, params)
+	return err
+}
+
+// PostInstanceActionSnapshotRollback converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInstanceActionSnapshotRollback(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostInstanceActionSnapshotRollbackParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "name", ctx.QueryParams(), &params.Name, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "session_id", ctx.QueryParams(), &params.SessionId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostInstanceActionSnapshotRollback(ctx, nodename, namespace, kind, name, params)
+	return err
+}
+
+// PostInstanceActionStart converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInstanceActionStart(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostInstanceActionStartParams
+	// ------------- Optional query parameter "slaves" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "slaves", ctx.QueryParams(), &params.Slaves, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slaves: %s", err))
+	}
+
+	// ------------- Optional query parameter "disable_rollback" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "disable_rollback", ctx.QueryParams(), &params.DisableRollback, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter disable_rollback: %s", err))
+	}
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "force", ctx.QueryParams(), &params.Force, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	// ------------- Optional query parameter "master" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "master", ctx.QueryParams(), &params.Master, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter master: %s", err))
+	}
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "session_id", ctx.QueryParams(), &params.SessionId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "rid" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "rid", ctx.QueryParams(), &params.Rid, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rid: %s", err))
 	}
@@ -4575,6 +5063,50 @@ func (w *ServerInterfaceWrapper) GetInstanceSchedule(ctx echo.Context) error {
 	return err
 }
 
+// GetInstanceSnapshots converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstanceSnapshots(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInstanceSnapshots(ctx, nodename, namespace, kind, name)
+	return err
+}
+
 // PostInstanceStateFile converts echo context to params.
 func (w *ServerInterfaceWrapper) PostInstanceStateFile(ctx echo.Context) error {
 	var err error
@@ -4619,6 +5151,119 @@ func (w *ServerInterfaceWrapper) PostInstanceStateFile(ctx echo.Context) error {
 	return err
 }
 
+// GetInstanceSyncData converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstanceSyncData(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInstanceSyncDataParams
+	// ------------- Required query parameter "rid" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "rid", ctx.QueryParams(), &params.Rid, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInstanceSyncData(ctx, nodename, namespace, kind, name, params)
+	return err
+}
+
+// PostInstanceSyncData converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInstanceSyncData(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostInstanceSyncDataParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "name", ctx.QueryParams(), &params.Name, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Required query parameter "rid" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "rid", ctx.QueryParams(), &params.Rid, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostInstanceSyncData(ctx, nodename, namespace, kind, name, params)
+	return err
+}
+
 // GetNodeLogs converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNodeLogs(ctx echo.Context) error {
 	var err error
@@ -4676,6 +5321,46 @@ func (w *ServerInterfaceWrapper) GetNodeLogs(ctx echo.Context) error {
 	return err
 }
 
+// DeleteNodeMaintenance converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNodeMaintenance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteNodeMaintenance(ctx, nodename)
+	return err
+}
+
+// PostNodeMaintenance converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodeMaintenance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostNodeMaintenance(ctx, nodename)
+	return err
+}
+
 // GetNodeMetrics converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNodeMetrics(ctx echo.Context) error {
 	var err error
@@ -4998,6 +5683,19 @@ func (w *ServerInterfaceWrapper) GetObjects(ctx echo.Context) error {
 	return err
 }
 
+// PostObjectsAction converts echo context to params.
+func (w *ServerInterfaceWrapper) PostObjectsAction(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostObjectsAction(ctx)
+	return err
+}
+
 // GetObjectPaths converts echo context to params.
 func (w *ServerInterfaceWrapper) GetObjectPaths(ctx echo.Context) error {
 	var err error
@@ -6244,6 +6942,153 @@ func (w *ServerInterfaceWrapper) GetObjectSchedule(ctx echo.Context) error {
 	return err
 }
 
+// DeleteObjectToken converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteObjectToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteObjectTokenParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "name", ctx.QueryParams(), &params.Name, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteObjectToken(ctx, namespace, kind, name, params)
+	return err
+}
+
+// GetObjectTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) GetObjectTokens(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetObjectTokens(ctx, namespace, kind, name)
+	return err
+}
+
+// PostObjectToken converts echo context to params.
+func (w *ServerInterfaceWrapper) PostObjectToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostObjectTokenParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "name", ctx.QueryParams(), &params.Name, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "role", ctx.QueryParams(), &params.Role, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role: %s", err))
+	}
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "scope", ctx.QueryParams(), &params.Scope, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scope: %s", err))
+	}
+
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "duration", ctx.QueryParams(), &params.Duration, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostObjectToken(ctx, namespace, kind, name, params)
+	return err
+}
+
 // GetSwagger converts echo context to params.
 func (w *ServerInterfaceWrapper) GetSwagger(ctx echo.Context) error {
 	var err error
@@ -6466,6 +7311,7 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	}
 
 	router.GET(options.BaseURL+"/api/array", wrapper.GetArray, options.OperationMiddlewares["GetArray"]...)
+	router.GET(options.BaseURL+"/api/auth/can-i", wrapper.GetAuthCanI, options.OperationMiddlewares["GetAuthCanI"]...)
 	router.GET(options.BaseURL+"/api/auth/info", wrapper.GetAuthInfo, options.OperationMiddlewares["GetAuthInfo"]...)
 	router.POST(options.BaseURL+"/api/auth/refresh", wrapper.PostAuthRefresh, options.OperationMiddlewares["PostAuthRefresh"]...)
 	router.POST(options.BaseURL+"/api/auth/token", wrapper.PostAuthToken, options.OperationMiddlewares["PostAuthToken"]...)
@@ -6485,9 +7331,13 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/api/instance", wrapper.GetInstances, options.OperationMiddlewares["GetInstances"]...)
 	router.POST(options.BaseURL+"/api/instance/path/:namespace/:kind/:name/progress", wrapper.PostInstanceProgress, options.OperationMiddlewares["PostInstanceProgress"]...)
 	router.POST(options.BaseURL+"/api/instance/path/:namespace/:kind/:name/status", wrapper.PostInstanceStatus, options.OperationMiddlewares["PostInstanceStatus"]...)
+	router.GET(options.BaseURL+"/api/job", wrapper.GetJobs, options.OperationMiddlewares["GetJobs"]...)
+	router.GET(options.BaseURL+"/api/job/:id", wrapper.GetJob, options.OperationMiddlewares["GetJob"]...)
+	router.POST(options.BaseURL+"/api/job/:id/cancel", wrapper.PostJobCancel, options.OperationMiddlewares["PostJobCancel"]...)
 	router.GET(options.BaseURL+"/api/network", wrapper.GetNetworks, options.OperationMiddlewares["GetNetworks"]...)
 	router.GET(options.BaseURL+"/api/network/ip", wrapper.GetNetworkIP, options.OperationMiddlewares["GetNetworkIP"]...)
 	router.GET(options.BaseURL+"/api/node", wrapper.GetNodes, options.OperationMiddlewares["GetNodes"]...)
+	router.GET(options.BaseURL+"/api/node/capacity", wrapper.GetNodesCapacity, options.OperationMiddlewares["GetNodesCapacity"]...)
 	router.GET(options.BaseURL+"/api/node/info", wrapper.GetNodesInfo, options.OperationMiddlewares["GetNodesInfo"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/action/abort", wrapper.PostPeerActionAbort, options.OperationMiddlewares["PostPeerActionAbort"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/action/clear", wrapper.PostNodeActionClear, options.OperationMiddlewares["PostNodeActionClear"]...)
@@ -6501,6 +7351,7 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/api/node/name/:nodename/action/scsi/scan", wrapper.PostNodeActionSCSIScan, options.OperationMiddlewares["PostNodeActionSCSIScan"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/action/sysreport", wrapper.PostNodeActionSysreport, options.OperationMiddlewares["PostNodeActionSysreport"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/action/unfreeze", wrapper.PostPeerActionUnfreeze, options.OperationMiddlewares["PostPeerActionUnfreeze"]...)
+	router.GET(options.BaseURL+"/api/node/name/:nodename/audit/log", wrapper.GetNodeAuditLog, options.OperationMiddlewares["GetNodeAuditLog"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/capabilities", wrapper.GetNodeCapabilities, options.OperationMiddlewares["GetNodeCapabilities"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/config", wrapper.GetNodeConfig, options.OperationMiddlewares["GetNodeConfig"]...)
 	router.PATCH(options.BaseURL+"/api/node/name/:nodename/config", wrapper.PatchNodeConfig, options.OperationMiddlewares["PatchNodeConfig"]...)
@@ -6541,9 +7392,13 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/prstart", wrapper.PostInstanceActionPRStart, options.OperationMiddlewares["PostInstanceActionPRStart"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/prstop", wrapper.PostInstanceActionPRStop, options.OperationMiddlewares["PostInstanceActionPRStop"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/push/resource/info", wrapper.PostInstanceActionPushResourceInfo, options.OperationMiddlewares["PostInstanceActionPushResourceInfo"]...)
+	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/resize", wrapper.PostInstanceActionResize, options.OperationMiddlewares["PostInstanceActionResize"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/restart", wrapper.PostInstanceActionRestart, options.OperationMiddlewares["PostInstanceActionRestart"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/run", wrapper.PostInstanceActionRun, options.OperationMiddlewares["PostInstanceActionRun"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/shutdown", wrapper.PostInstanceActionShutdown, options.OperationMiddlewares["PostInstanceActionShutdown"]...)
+	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/snapshot/create", wrapper.PostInstanceActionSnapshotCreate, options.OperationMiddlewares["PostInstanceActionSnapshotCreate"]...)
+	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/snapshot/delete", wrapper.PostInstanceActionSnapshotDelete, options.OperationMiddlewares["PostInstanceActionSnapshotDelete"]...)
+	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/snapshot/rollback", wrapper.PostInstanceActionSnapshotRollback, options.OperationMiddlewares["PostInstanceActionSnapshotRollback"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/start", wrapper.PostInstanceActionStart, options.OperationMiddlewares["PostInstanceActionStart"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/startstandby", wrapper.PostInstanceActionStartStandby, options.OperationMiddlewares["PostInstanceActionStartStandby"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/status", wrapper.PostInstanceActionStatus, options.OperationMiddlewares["PostInstanceActionStatus"]...)
@@ -6559,8 +7414,13 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/resource/file", wrapper.GetInstanceResourceFile, options.OperationMiddlewares["GetInstanceResourceFile"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/resource/info", wrapper.GetInstanceResourceInfo, options.OperationMiddlewares["GetInstanceResourceInfo"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/schedule", wrapper.GetInstanceSchedule, options.OperationMiddlewares["GetInstanceSchedule"]...)
+	router.GET(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/snapshot", wrapper.GetInstanceSnapshots, options.OperationMiddlewares["GetInstanceSnapshots"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/state/file", wrapper.PostInstanceStateFile, options.OperationMiddlewares["PostInstanceStateFile"]...)
+	router.GET(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/sync/data", wrapper.GetInstanceSyncData, options.OperationMiddlewares["GetInstanceSyncData"]...)
+	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/sync/data", wrapper.PostInstanceSyncData, options.OperationMiddlewares["PostInstanceSyncData"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/log", wrapper.GetNodeLogs, options.OperationMiddlewares["GetNodeLogs"]...)
+	router.DELETE(options.BaseURL+"/api/node/name/:nodename/maintenance", wrapper.DeleteNodeMaintenance, options.OperationMiddlewares["DeleteNodeMaintenance"]...)
+	router.POST(options.BaseURL+"/api/node/name/:nodename/maintenance", wrapper.PostNodeMaintenance, options.OperationMiddlewares["PostNodeMaintenance"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/metrics", wrapper.GetNodeMetrics, options.OperationMiddlewares["GetNodeMetrics"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/ping", wrapper.GetNodePing, options.OperationMiddlewares["GetNodePing"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/schedule", wrapper.GetNodeSchedule, options.OperationMiddlewares["GetNodeSchedule"]...)
@@ -6577,6 +7437,7 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/api/node/name/:nodename/system/san/path", wrapper.GetNodeSystemSANPath, options.OperationMiddlewares["GetNodeSystemSANPath"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/system/user", wrapper.GetNodeSystemUser, options.OperationMiddlewares["GetNodeSystemUser"]...)
 	router.GET(options.BaseURL+"/api/object", wrapper.GetObjects, options.OperationMiddlewares["GetObjects"]...)
+	router.POST(options.BaseURL+"/api/object/action", wrapper.PostObjectsAction, options.OperationMiddlewares["PostObjectsAction"]...)
 	router.GET(options.BaseURL+"/api/object/path", wrapper.GetObjectPaths, options.OperationMiddlewares["GetObjectPaths"]...)
 	router.POST(options.BaseURL+"/api/object/path/:namespace/svc/:name/disable", wrapper.PostSvcDisable, options.OperationMiddlewares["PostSvcDisable"]...)
 	router.POST(options.BaseURL+"/api/object/path/:namespace/svc/:name/enable", wrapper.PostSvcEnable, options.OperationMiddlewares["PostSvcEnable"]...)
@@ -6608,6 +7469,9 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/api/object/path/:namespace/:kind/:name/data/keys", wrapper.GetObjectDataKeys, options.OperationMiddlewares["GetObjectDataKeys"]...)
 	router.GET(options.BaseURL+"/api/object/path/:namespace/:kind/:name/resource/info", wrapper.GetObjectResourceInfo, options.OperationMiddlewares["GetObjectResourceInfo"]...)
 	router.GET(options.BaseURL+"/api/object/path/:namespace/:kind/:name/schedule", wrapper.GetObjectSchedule, options.OperationMiddlewares["GetObjectSchedule"]...)
+	router.DELETE(options.BaseURL+"/api/object/path/:namespace/:kind/:name/token", wrapper.DeleteObjectToken, options.OperationMiddlewares["DeleteObjectToken"]...)
+	router.GET(options.BaseURL+"/api/object/path/:namespace/:kind/:name/token", wrapper.GetObjectTokens, options.OperationMiddlewares["GetObjectTokens"]...)
+	router.POST(options.BaseURL+"/api/object/path/:namespace/:kind/:name/token", wrapper.PostObjectToken, options.OperationMiddlewares["PostObjectToken"]...)
 	router.GET(options.BaseURL+"/api/openapi", wrapper.GetSwagger, options.OperationMiddlewares["GetSwagger"]...)
 	router.GET(options.BaseURL+"/api/pool", wrapper.GetPools, options.OperationMiddlewares["GetPools"]...)
 	router.GET(options.BaseURL+"/api/pool/volume", wrapper.GetPoolVolumes, options.OperationMiddlewares["GetPoolVolumes"]...)
@@ -6623,296 +7487,365 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"7L17cxs3sjj6VVA6p8qbcylKsp3dxLdSpxQrTnTih1ayd+tu5CODMyCJ1QwwATCUmJSq7te4X+9+kl+h",
-	"AcyDBIYzJCXrMf9EMQePBtDd6G7048+diKcZZ4QpufPqz50MC5wSRQT86+j0x6NTInkuIvIep0T/FhMZ",
-	"CZopytnOq51YjGIkbBPEdJvBDtVffs+JmO8MduC3Vzv2kyC/51SQeOeVEjkZ7MhoSlKsx1XzTLeTSlA2",
-	"2bm5GdRn5zE5Plo1f8QZI5H+hBiPyS6NQ9DwmFzA10YAcoHNPIvTpvgaxe6rf4rK53IOco3TLNGfv5U7",
-	"A8+UP80IU69xNLV7nQkSYVXu18Lqi+8IJxRLxMfoiyBZgudfhuifNEnQiCBBUj4jMaIMYTTOVS4ImhEh",
-	"KWfDAPARQFCFPCZjnCdq59UYJ5IUoI84TwhmJexvaKKIWN6xhEqlwSO6ERqbVv7Ji4/l7FSRVC4Paloi",
-	"cp0JIvV6XqHfLimLP/82SPCIJD/McJKTz//12zDGCl9fX9sfzvWplGfxYfRvEqkzhVUuP2Wx3s9BhtX0",
-	"hzHny6dU/ICFwPNy5aew78tAmvNAaqrxM81wpI/LbMOUSsWF/oYVigTBikjTMBdCN4iSXOoVavAlUcNz",
-	"ZpZM2QRhFiNJEhIpLiTCgiCcZQklMVK8abbheQhlDaRdj/0tTanyHXhKFYKDQxHPmQpMCu38RHIw2Blz",
-	"kWK182qHMvXXl+VhUKbIhAgDAJ+swrqET7aFcxh5sK6CbXXUGw6HNVSTNP7he/wd2X9J/ro7ig6e7758",
-	"Qf66+92L+GB3TA72429f/PUFwX9rhXZ64TxJ+JWHMuB3QIOET2Ro1aa3hwvWDphPfhYka97dlEiJJwSV",
-	"+JlhpYhgobknesg6qtW3WTeobHJ9H2OOhv/l5aBv+eQtZUR6CZELhdSUSsTydESEBj7DUqEE/sMniDAl",
-	"KJFBXGXwbWm7quior6oPMCdOloHQN09BtgvLC91UgSuEPR/gP34g+YF3H06wmi5Pz4HVdQFAM8LGi7ty",
-	"KKODwRUZ/VcQnvC2rA3XWnDIMC5bQPToUjNSSVgMJITGXDSAItvwjsrgda4wiw4GSM6i563o/pQkeP7a",
-	"XA0+oQiYv705aIwKCU+vT3+TCVf6A2fwT0EM1/cKAmYYIyu1Ft4GO9e7E75rxyghdbBrEmFeeVLDw+zX",
-	"jQB3g3SUOQG8U5Jy5QHueIxgBFRwEoIkSA0aQIDGXN+SiJnee4mihBr4h+h4jOASRVwgxjWuq8BIlSFI",
-	"OiJxTGIz+jB4cQPAK/g4rO2TJMK/9XZ1IFeY3f09J4BDU2yWJThXaCIwA8CxaVYwfsFTA3lGIjrWckgu",
-	"iTCAowwLRUEwp0wq3deus5jlmSwbhdaZO+BbHGIDjbuT4oiyKMljokVjA4zMOJPEyVvB7V4Ukwp6X0G8",
-	"dcKwcGqIaRzmjYV604E7uj4BDjmW/3EwoJmXQZ7yhDRsHs4oEjwJ6Xn2k2dr/lOQ8c6rnf/YKzXOPdNM",
-	"7uk5vayOMs2vfyFYqBHByimhMLNlo20VzKb5jzBJOatPU07/K2VxYFatbaw9K4xbTvOWSkUYEbe7yNos",
-	"5eSbTLqMQ+WYMsNR08Dmezv5QhGpvChrp+MxaVpGmxuhjvMfKxcpCG6KW35WYV1I8SH6cvEFGOeXhEc4",
-	"mXKpviBBxkQglZ0zd6sZRU+QiFCtkFcGGS6opMUwgfX+XVPdYZKcJXhmqNVHjNJ8DS/wMFL6MsVJggiL",
-	"cAbcGbOIyAEsJ+bsmULYtNLgapCKRoiO4SbD8pLEWj7SjCmhEVUkgbvKcwk50DWFvw+LAPr618wGjXB0",
-	"qUUwrbrqa8awhkYbUzNiwvSvORtTkYb2LbKfV1yobjBhTETekcSCBSg8zBFJiAqfZQyf20iZH2sbKK1B",
-	"THFkhhigK6qmPFdoJPTmKllXrRSWl/+RsyvMFIlbyaNuAVTiUUJOeZLoUwsuxDS7EK5dy+0RdOZT8eWU",
-	"XyHOkjm6JPMrLmIrQlGJYtMlYJ9zH/3345Bcq5dNxPcTmwXPirBZm4M6ZIiwGRWcpVpInGFB9c4YtUM5",
-	"oUSfhxaFU8xiicg1iXI40IgzRa5V/fDe/T//ODz9IZ3PcNLl6H6a4STHigQX5L6HWckRAX5HWEQGSEY8",
-	"M5JkxNmMWAnXHhAS+AqBeaSZR7zhIgpCNOaL0k14oJ8FIeojTQnPVWi8iW5zoWwjrwnMZ7OtC3S1iSoA",
-	"/PLj4fKGnYGcPEcYTUcYzjzCDLgoI1dolPDoEsVkRiMiQ1LedIT9CPzt/v7Byxff7e8/f/ni+csX+w14",
-	"fJxmREjOGk6fVpo0X5ZwyQHv0cJ12Q1dTQlDFovAeOmQYYjOiIKfas0th3J49wNoJoKoXDCJMPoRx+jU",
-	"Xr9ECC6GTaT6K5nX5IKuTxMLVGu0A8UFYLR79Fg1u1wx/Wpu0WreZo3DAFKDDVhmCLbLq3aQOcoG+Sjl",
-	"M1LnSoTNhuvcKG8/vW+im4RPaIQTlDOqnEVvLTpK8tAzzUHTyb4lODZXkndQ87Udi3qHpQoPlZqvK+W4",
-	"ZQmtYk2gmjEvCXV1sW8Die4dn5GPPLgCPiO7ireTzgq9ocGMWlEdQkTlvreZkcfkzGrXPlt0yGqLEnpJ",
-	"0Bf228HzF5+/DNAX9l/6v+ncvAHAPZyTL21tu0H4PmT+d0gQfmpXq7uDYzSaF7KfPnWeNTxWFh8DxoLn",
-	"TWRwwnmyjiifcZ5sLMm7B+I3NAk8Uet7SWuCBogxTUgVqZGcYmF2C5cPyUY4NOpa3RammZg0fA60OPis",
-	"GTPi4+H23r49qzulsX9xgsYGUqrFw4zAk5/i+v+5JDX4Y7N+vR1By1E3U28FVh98lT31kE8jCC2mPCNY",
-	"hZVf+OiV5A6W3hHr16QZtzZR1ECAMs8yLvTuLqkgoElOrCuAo8fAssuv61ChY19hnukOIDh98bnV1sMJ",
-	"mucH/3DQYNHLonjQzXMaN6+n6WhVN7mEZ8QegUU+EiMs0Xm+v/8iuryCv+Q380/KYnJtfvlsfuGZ+af5",
-	"F7B084NRpRHPzD3wA/q/fkC7PyzLPgSrH8Yip0p2kX5a2HZa7UIpGyzYeCqm+tEcBAc99jYtPy0WqbAi",
-	"H1gyD65TN7jQCn5LUeosH2n8CA1nvrbC8Y94EhpG4UnbMcSEqCYpVkGL9QRX0zeoA+7vf/+3F99+d/Dd",
-	"t/vffddAa2G5ra3I9onJBnrNWUuKrZqujNxaGK+MXrFt49WNvurMow+A83x/X/8B2wqDYwOnmgiYx96/",
-	"pbkD2tnbTwQfJSQ1s9TX+eFXDcvz/ZfLW/Ceo9d29pvBzsu7gaeiT5tZD+5i1k8M52rKBf2DxGbaF3cx",
-	"7RsuRjSOCTNzvryLOd9zhd7wnNl1fncXczoDSWGQ0jN/fxczv+ZsnNDITHlwJ4f6I4/nSHGOEs0S9cTf",
-	"3g3pHDNFBMMJOjMv9j8JwYWZ/04WfmZUe/SJ4RmmCR4l5lnPdtUjH4oRVQIrLoyPIzj6Ci0TKWrYnix+",
-	"b4LC9r4Z7OQi8b52XxE6maqAY1SpU/wGAwzctEW/zwV/Np4yekh4KTpWJF2G2vkxBJi877qqwlA1oDXO",
-	"LFu/Y5fAegxx8PEtlWp5Jd0GhyO4tI/ShOWpXg18rawjsGgzk+3uXXWupodRRKT8yC8JW4YVw8cLcp3p",
-	"MS+wqon1MVZkV1G/adJ2VW7gZlCXJ1oYIQT+MRvzZbhToqY8rm+32zyeEQbqyAhLGmlt8Nv97zWCWj31",
-	"cws7qx1jaV7jAnRhPi2NQqXMjeFvxbmZdoPKcJ8X27gVhvbllIwFkdPAuQrzda2DdX0bTtYLUQEKTpIP",
-	"451Xv62ggAXcvBmsbl9b9M3nm8HOa5zhEU2omrdmKT7O4dvlcmg/x4qxWsnrK+B5yHxhBh9ipmT1JO95",
-	"TN7pdotLsw4tMMbAwLt6oe152AL4HjIqW2zAKhfBa9xImGcl47QbY6b3bolxFCmv2OUWPE2pgmiOpVXJ",
-	"i2iK2YTEAeW2zguKxj5Ajt6fnZKICy8vwtLvp+Ywc+lD+JJVie+iX+f2HVjAzKANaHf0/uxfnJHWeFBu",
-	"hQfTjk5/PDpMEh4VQT71zVqHERou32zkqtsajVtsShkX/u3MuGgjUUEzN9Bgp3Zr0gCinP54BJ4wkzCn",
-	"KpYymiv/M2cViPDBeRzsllTf4jMydiir1qNnB0Nx/QwsI9OiibW/gw//s+noPw6eVcyrlQe8obj2HVTd",
-	"Ga79FWT6neUjOZeKpIVIvCQlxbHwks3CcYZkH93dNl7ez8+LSkB9Nch8HNlgog8ZYWf/eI1iaIQS10q6",
-	"RYDrMRmcs6spjaaISucIS0cJgW2H90M2geEOT46H52x5D/1nWsBk6b08malS2S5lRIWP58QnT2U1SSpI",
-	"DiGc956fx5vF7SBe3L9i214Zrwaq0BWWJoTExHDFg3PmDP8kRpih3EaVoSt4p1GyiPCCrddbjlkMH2hs",
-	"fAEXGHcxXCd2ZOFZg4WtZlkAuRfF7Wo7zLpwePXV1pZRGx2AdZD4T1vhX4lHztPcTLbgb4MuEuHADtsA",
-	"yQYyTWWEoFBTnWVziWZhxg4KOLxs+z7YwX2fJP2DtCBs+2huBxoUT8W6d4tFrL3fXgnCNOk8pncsKi89",
-	"1zCZZTaQa21CTXlM/OYaQSaUs/bgn0J7H/Tu8ErJJxSwGZQPBzszwmLeRhfWaOt2xs5d9HbrLURLt0gv",
-	"clB5ub6mBkfmo0I36m1pZwCcHappWR0Q04EcwMxN+FYBTGCrtsWtCgfhrar0ZtgNkMSA5Vs7fJFfF1OK",
-	"1XU40HJHfNgCXzfBlwpI4V3bEtJA5L4DdinsCt4FiyYgpSEXByWlZi6l+EAZhifPpWN8k5DrkJaV4ut6",
-	"xPu+j2GmlNVaPfdy1eKhuXwBHqy6TPXIA4CiGMC3Sz8Lnmee4/QJ4r4bqB0JAlsP0iHAsD4ZmiV48Kkc",
-	"92vRYAFBexopgfZQIHzcgAAr8IT2a0vU9wsW8RUWpJOhqkqkvu/FNbCseockqXYWKytuVAEoDVdFOEnw",
-	"Ocktdn0cLrbLcyy10b8WJleBaI9vNdA9+Oy+b4DSdcAatm9biO3MVKdc66an9ioJsdCu9sJlxukD4vjk",
-	"MI4FkZ73Xlx+WEKUcYIn1cRDS+boOjxvEjw5KpuDS5Aae0dOcRT43ag+a9KlHnZQLGlpARYgO00DgRb7",
-	"tT6FllvuwbH6+F+LRmtQtKegOvAeKi0abECmC7D59vCoOsvmhHpsHRo9N1AhsjVCbPtbAQ+0bUZtFEGb",
-	"ju9s85tBSycM19FZnm8aVnUI9vDDKCKZ99Wp4qPbkQuV7r+LW14Zs2nDQxIxzjIvK4imJLqUeRr4SJNY",
-	"mJfk9qkYYpH5HtsGEL7p54zketXxVKT9EhkucOE+3h48VkmSsmxHE9GUSCWsCbYJog+VpiAECZfsrz0s",
-	"QckpS3BEUsLURcYTGs1XOjK59iemOTyIcL91KhPkYnkDPc0oF/Yxf1ktcuEH7tqjxjv+pIZ0zTYvM0B5",
-	"qEs4rZvGedKB0Z3ZHkuDlga1iGek2yEt2eDCJjip9L5O/ehvXKRXr8E0qyyBZzzhk5U48NG128ZTgeYX",
-	"Fe5Q4QWGwAc2GHsBkbzYNajmiKhS2MDJ/o54PIhfQcQq1lWxw51qucWVTVt42HAntMRDLR8uztSw06E9",
-	"hsrXXZq6t0ZDvjsTqqb5aBjxdI9nhMlZtMfTF3uzF3sRF2TPjQV77Pj0BrJQMZznGq+Ovq4kVFyhG/ix",
-	"VAHpIKdUwffJQvb7JqJQDbCGLWwnCK10+ys2E2frcsrqgYfHtwe7YKPt/F60sL7yOUiP1LjAUj5bEPgq",
-	"QsRS70nCRzi5MOF1XkhrLS5MQKVcPdZFdw442KHyYoovkiL8eJmHU7nqcyYI5BiL/S0gAU3TeqsN1lpE",
-	"nflemMQWHccomXQpxjaJrR+q7Y1tcmEIeRFbB6PlPamITkuHujU5o6IQLAsaNXm9pXze9FJvgqvWOb2N",
-	"L+46RTVQRYi0qki+QBIL6BtGVg8GhTBisBDRqGw2jMUdbETsBcqrCwq1QUpJo+BLbUUBh0FblwVC0QMQ",
-	"eNA+eCAKqXEQnrjp7VPOs0Q+Y8H/IKwrp60xysV8yPUnI9cUUWlyKVIbBmxzO06xhLDJESGFTxCKc8iW",
-	"gs9Z6dwW8yumQUIRn5EiPD3FWoRnEICZEUF5PDxn4IMEiRiXviLCYjmoJpeUU54nMRoRlDPrQjo4Z5jF",
-	"qAD9ymYNlyYmEdZpPJI8lwSW6kIqLDrz7UqQcjuk0fuAkw4dMsFnVNOrObgV0TVF022y8gZUFDljei9a",
-	"e16Y9hDZ4NUWcUL8CvDmGhZQtyVbR6RVYlrGg8oBlye3xPuqJ1TnhG533MJqq2jLBs9ccNGWuKDNlXNE",
-	"xpQBSvhVI0jETzraVyLMYqpX2LWfya4VeO8qmFX424eGhzTT4iO5Do2Qaf7S0ebme1io3ADu6drn7sSm",
-	"RFA/LE45ag9IShlNceIX9HjWYG2ySGupd7lzSTte40rEM4jR838lYTuXCh2E/qHTMSxZbJ35o5IGxkJZ",
-	"RTELQh0zyjMr8W0Ru2r4XSLOwCnptT116xkUlFQefQ1/Kosoj9On+XkJt70m7qd7D0otNdxA/Q/A7LED",
-	"+Gfd/GXEjutnci4vG0BS5b6YwVYU3y+wHyFtYo6tOY9CUo4WXl4LzqMOjAWI3Xgr9qXzca7AnM3xZRWW",
-	"bAs3FkaXs0jvGaRzisZwbxP9Sy4112DS/BbpP58D70r2R4ZTyibDXw0E69/cZhxXEOI1Z0rw5Ecee1zB",
-	"EzIjST3RI9Vi1qBYXkxG+QTYEPx8hQXEmECg92BnjBXIORlmEDrKtLa4co/NrCtkmRL0HVfZoslTzTZY",
-	"00/tPVFXXHh8kGGhHe/5sSAkaKNw9x6dDI9NmouwI3kJ1CqH8VVzBN2Oc0ni1uM0RrE5aPWYeAL7rvfB",
-	"TtHgpG73/vjEQ/7ZSi/vk4WdanQfcDO5425iuMFnP7HaAnXqc1TJnIW2eFExub4c8I17043lllvqQc/i",
-	"4wYsdwEuD9Otz7K5jX7p7DoEZDTQ0Trxmm0ObJ3jajisLRzVioPa1jFZclrHn0T37exLAi5BXf1IIOVl",
-	"gw+J/n4P/UcqG+TZ4qRM4dlul18XXRr8PqacX3ZAtmLwXzj3IjQkB220Li1jYMW6dzEROCIXxsa3KIAr",
-	"mpJhUbgQOl5fZFjgJCGBeO2Usgsw8lykJL3IIrWqmbzCWbhdJi7JfNXtcHJqw6AEwfG87VoE+TenrNv6",
-	"ZZZQ1eQ/IuW0BcBnZ78AxAvYapwLDIIUB9twWgvn4dt8704vbJR/KxYWWyzNnUkzPb2uUk+dsMaExERc",
-	"hLL+UCZJlIugTUPMGjqrMi97wzkubHsFoMr0tbnKkZuXDUTqYSWQb7+b0AtlFjsKyu1CIxw4xRwNgRJQ",
-	"KtWbAafkOwulfeB3eLSYkkJxgJzH5tOw0AZb8L63uouX7VWC7VtGS9Zi9H23FAtWEiuqiNllVdMKwtKu",
-	"pkSY7Lh2/fAMAnXfsIDiY5RNoKyVN6Fl5q8jZwbwbaXi9SImSGJm5mu9vWeH76Gs3yqLXsGHKv5Mrkhd",
-	"cQpB3Fnb4wcEH5945Ub9WtlqHADdLvGQtaZE8qD8vZysvEAJ3RGQ0YtVhR2rPgL8XB9isYBGs9getmfB",
-	"ajYQrYutDRz8FoXqTr5DPstfcOCQT1BXt591PClu39Pmbr1knqiTytf0OGl6HbUoHvTdmNjklMvJ+jK6",
-	"6gAPT46hZZFfcu0n86UUlb7LHgpaq7ZpBtbw9pjYZHSBBayetZXnHZ8RkXAct8r1Zc7HnEZ9p4v9qL/F",
-	"T8D1ecE1qjJlCEGkkxnbs/26M4LXgq3ZrqRszIfvNrPnu3Fgg0zB9kOnrLTL3GQ6veYQP7mhC1P3SBBz",
-	"RCtjNQDGN6btRmEe3f1lthDJUQxRMPNWI0AO+M18dtaLPbgoUuFfmBL11ej5Fyuj551njD3bxZiB0vHF",
-	"FyywsFeL7jC1uIAlOL0pwZbI2mJ8NJ6sRyWfyzG2MAT3xXkXC2vt7I+zxaCfZjSx7aohNc2RObrRkuzQ",
-	"1OWTaXm4/DpUrq4WHeKiQFZc22bfjqzihdm89d4fWotmJ4bYoqnGpJZNW7c8I1HblrO2LT/Jtqv/Bzyt",
-	"tmzpuHmJ1G8Krr6QYh5+dwobnkwEmZj6I3xcqb9hGIfJAScrD84FQ0npNXADtqf125zZD5+refSKxkvi",
-	"jIFxfX2+goAe5a4y+rp6vRliE82+BKK9yloB3KPdm68baMRVkILbtiWtuLKBS8B2DJ0JD3/iLF3trZol",
-	"aW94a2ia7zhEd+ZXTqcZx4YQ/8NEs94uxOtzrPLH5cSaNhC3ZC1ymvr4inO8qKQt+tuLv708+O75y/3B",
-	"6vDTpcS14DcU9I34UJeBSy8dVvXRmWIwdxr9WCgvS6rZNf6ek9z3pukzlnR52VwyniyS2+L4vjWf4OgS",
-	"TzzyEhbRNPQGo3CSkHhZ38V+fXfBicT1P1xU4eAl5qMeoclRR9JJF+eCwc6MCOl/kgtYMG37gdmDwhOh",
-	"unADRsOGrn8XuhPxcPTq2F8rq0cFhvY3VRVwDxO3nze4CmtQhXduS16JJ1hF02DG1/IF2M2O4xjCqzCb",
-	"mDyRKZ+Z/1l4WyuPcuO0sQP3f15a4W2KYrhQ9dD7X3UbuhxVZfN8yLCg7i9wYkZ2FoOhDESoUHRRofu6",
-	"A4CUyYZdD3YSjmOEZxP7aiURF8Z8ZQeXETdPvJkgGOyhUzr28/kFw8KSdL4EmVPBy3IgCrzJYfLdyr+s",
-	"GB6TsX9ie4MuvCW7SgO0a3jHJp6XLbJATPVGdsr5H/QUNFVUAw8C7fNShH0017H+W7/OFvPOeJKnpDQC",
-	"rcpBbK4k68loL6KpQcvaaS+MXOyT1zF0pUFAo1dHDs+59yle/74JXy8A8TF1N/bm6o0e6h+wgc3h+u2p",
-	"g8oLLrIpZqEI71Cem1CSmtbI7Zd6rRdsJWlJCWGDTFxuTHd8sBsawArzdUPcqIIWwJDKPNvAE6mcgfBE",
-	"8Ik/6R2VFxkWioaivrbizRh+yAz7OTZlsNdL08JhWavD1Wn0RB66WiZrLKEshGJWsV79jzoIDXYbvaxC",
-	"+aWcnRIjByy7nnERkcA72cpRz66oMvrSYlZ1qSjDq3NypdQFix34/JlmpMULXnUy2ym0I6ckwfN3REqv",
-	"7heZAkMtHtZtKSJzkq5b8FJP5SR42bd0TyshW5jPjF4Zy7v0ijF/oXoIvyICOdM5+GVV3lhiNKZCqloF",
-	"2G+9GZRd/UQPJij78LdYOnqap5jtalkTj2zJeMxsGW9TNjlCipvwfh6Zch6R8zA7Z5mZsRY5X3dpyAP1",
-	"b3/5+PHExetHPCboL7+dvnn9t+cvDj4PkC1Ejv76DZoQRswujOZmTi7ohDJknCChbosfOuQDriqFUZUQ",
-	"357IKRdqsLg1Mk9TLOYLgyM97hChY4XOfvnw6e3ROXv/4SMy6ha41VUBUzwM5gCR64hk6pzpJWW5yLgk",
-	"UGIdnCzoH+ZU/kKGk+EA5ZKyie6qNaUZQbb+5jljZMIVhbb/N5KEIM+2vhi+/MZ7ZEs0rczTX1HB0uyZ",
-	"H7t5FEwqGqWBQOgEZ4via+y8YQcr3JC2FlUZKLUTiP8BaSgOufB0zhEh81HrgM7MuNE4D4RaKgK3lWZE",
-	"A+NgybNHH4RZ14oz7CAJVQ7eJ22Zz5uIWlWofHJWZYYtmFcMgPNAgGA3RdLkegg4hKtABoBakO9B9fLT",
-	"PzzfebXD8nRUZOF+0XApu+BNVz3GgOMmb/KXdNuwgSnRbWTlyL6KW2x1KZ2wrtwAP17D980QuwKYH7PL",
-	"ObaC2lW/k/q1J02B5UHxbou4QC5bCap4bSyZkSB3ztIrrhK537hoq+t0qgE0cZUZ1q4O1KIiUyu/t4YC",
-	"PSVfNmYOA7TvIO6fAHwRLOTXmJ9X6IVs05Il2kniZt4q6IMu0vlCErJi3uBZGf+xgGxze8d1kdTyNFYk",
-	"k1s5M7lY0/UeHydsTYsjLVbV4my7VAirI4Xnfqg02eCKWILQc0sszrS5ocll9lo3vHc5mXTLEF9Pdsh2",
-	"Yb6LuchuGlYVcgyg8iKmUut6cdCp2a6joYW+POPRPJQ0qbD/eHN4648XsSPQFirRcsnkYgkL8NaAKyFp",
-	"m2ZsYfO2lm7MjfuGJj50C+VQTIHptGZFLe07JvVbakdpuBJKmLuQcmWlXoZhvh+zMfffNN743TV13pBq",
-	"u2aGIZPfwkThhpWKxSV237xic1Zs4EYsdxFIL89dmGt7THd9jatg200Ab+K+UbDnDbSxKiBrHMqKs9/G",
-	"ua868y2f91s+6QzjWz75iSkxb9wK1yacCsqDBIVO0iavU9mhaYF+v01IsHkRZF1b42mr0/VUIBl4GVvj",
-	"4kIxlZWrvoPE4x6Ibm463stbz4YcAMwTbS9VJ7VAkBTThbySIQ27bDsoJmo6jcLCEYoD7Cg3tAvwqcbo",
-	"LD5/WluJmbcJ9BDEVp5bNthMKVPG8b6w0tAJ44JIhJPEVlxXAjMJUX7IuFRJb4bjIiV1fQrKYhphBQX2",
-	"sVqYS6IpZnFSPMwgGETmCTzWQECftFmXDVwxsmNM5xkRMyq5QMBHAmmXx068aitVSeMhauL76iu5JPNd",
-	"E12eYSqksWfFlE2QRj0Bb5f6/w1a6O1SHNlEO+d6B8nuFY0JwiOeK/Pe5HaiCn15rImLnPfEOU86sPkF",
-	"5am+KkWSxKCALdpPx4gql/5aCTqZEIEwsgNYFEAul/Y5q54m4wrlWeAsqpmsF3Ck3An3nOcCQUisd5ej",
-	"DyZCDCyLBMeIj9HhDNOkNDWajsNz9hO4giHKkJuxHD3m7JlCUvEM4RB6B8DvEHEXYiWGGzjVbikzod0A",
-	"s/M4ucJzCfnHswEiM8IQHis4CgC/G/DtNOAKmFB1x4MtC+lBTLs6MkNSRSnphJEYKe7jiQpPOvrqtcu8",
-	"5hhdJfc2TWxeXEhIaUjKEFBJFLUk3PXgwlLbLd4v7d7YVYSqJNbvWrc320i1LQoRXbN+bvh66alrChWP",
-	"EhxdJlQq98MEPGHA985kzt8Z7Pybw6eEYPDm1VcGNvth/QjoH/BEJDgHm/TvOVaqlg+lYpKvpF1f9rfp",
-	"cLd3f0ltyKKwJAwYH6KqQ5F5EA0IBS6NjCcakyqKW5ij7AjHRftaAeoWPT+axssBk27AxnrUS9N7Lmj7",
-	"ycXgTblUSOqbyqXdQYTFGacM/Ee6pHHB6IqLJIZrL2f0d7g7K+MhGhOm6JgSUXNN2aG/s+Hz/f2Xuwf7",
-	"mg6G+ShnKn+1f/CK/HUUv8QvRt9++9LLWSyfWGBb86zICVPMDV4X9VllJGnbPDHB4qiLW76+Mu7DnUWN",
-	"0jvb14qt8AHTodSfbymeu2Cx3QYKux/gFtu8pedUN+w6+9SwNVvYkRUbsd31fywY4gLdwu+Ochdygt0L",
-	"DvX97sEBcCh7Uw+lmL2Kyew5OxhaeIdmFcOD7vwK3xHHsnUXm0KBfHnp/bqJ1rFF3i2dzOrUm4xcdx/W",
-	"bkLgDRO+XdRSoQZrVFwsiP++chXlJrYMTCq6OLP3QsbL6lbWd6Bcmm8hfqibTj5YY7j7+a8+ygd+Ktvd",
-	"+Q2kAwfnbZnqt1E1tLrM7kV/gxKA/b7JPVcDzHfRVefY3FR/5lKqFMzbvIAdWIPxc92rvT58FnCLPiVQ",
-	"N4opfXk4TbHukGVj+ow+O0DGF/hZnj0boGcxv2L67xUW+u9wOBxWvLRyrVHrJmVph2qcn9aR49EcQTPz",
-	"v9C4loMDPi4tz1RIDobbL7OTkLdi0bR17avqzFuzfNcrPrfGySosnkP/WEndVIaUjjFN+AwUdW/wZiU/",
-	"UuluV3SB/Fw+DlHm6qklOni+//zbXS32fP9x/6+vXuy/2t//V7VqRvg+boiV/ySJ5/nDawjwOea1e5o3",
-	"5RNCD/IahGOQ9Xx+uzgPehViEwcZyoTX1cRVzdgbjC3FKZEZDngFC3x1UYDVSjAse7gFVecI7tbaNxcc",
-	"t4flFqN+Lf3VAdD+GilA9hyo/rbBDVUCE9iqrehgppRaLqia6xsvNQCOsKTRoUV6AAiYrv61pOupUpBh",
-	"bESwIMK1Nv964/jB//zzo5WpzBDwdXGMm8qji3Vq37E81rwCIZPUsciEsfNyeDD81rwqEAb5N3deDPeH",
-	"+zuVdNN7OKN75jRe/bljFUxj5KScHcc7r3Z+JuoQGkAZWZwSRYQM5qIpm+xR9veciDl0fq+p6ObzoKgu",
-	"BLM/39+33m7K5g3FWZZQE/O3929pxGpz2KtzfgpsPLhhq+ps/sOveh9e7h+ERinA2tONoO2LNm1f6Lbf",
-	"mmU0t9WNqpgEO1jBod8+3wz+rOHJb58hDx+8A/xmSeazHsIcWq6mew4hvJYBqN4EGcNyNdVc2+wrSoma",
-	"8lgimWf6+i5fFk2ol4lYWsaBXE2PzQvB7Z2hmyNwhDeV7dBbtLAbgowFkcYSzX2lrU6JygVDGDFyhXAU",
-	"ESmR4pe25G2UUE1GEWYolwRhLR5qiLiwQWFQUzcmAlGGqJJozJOEX1E2QcJE0crhOftonnxArLAvQLWZ",
-	"nKEGpzCF/n/OisciuwTTFuL+ZjSGBz77Geapg4UMVL5zO+ESDu7U7kxXEj7l5rl3cSNTfF1flXOdHKAU",
-	"X9M0T01GcfT85RRelnZe7fyumYETL17tmO4XFZ/LEkdKUepgP/WZbnxvbpAH0U6bS3hXQ5Eg8AQ4JRZO",
-	"uLpRlGCaBuBy6RR90DDpMVDdLlfL1fQQduqjhr+Jt+234Vf7t8kHX+6/bNP2ZTeeqdu+aNP2hYe/LrFT",
-	"G14KzMCQWhWPd5oZjGnz9djLOTtnx4ZRfLGc4gsqyFWzFqvZQvUJW3P7ixI5+TIAXbfGXKA2N04kRyOC",
-	"KIuSvMZpzMYO9ZwfS9ZDYiQ0U4DoaZKOSKw7wWKeAXE9M9SF6BilWEVTDb8eMJfinLkmtlJmE8v6aM/j",
-	"8TIsA4i7K2DXBijNpdLngRki19T4y9iwDI02IsS18iIqygPUmPN7z0WXoDkeF6hbRUhTUt6i6yJSa+x1",
-	"WqaJp6/fvkN0PEY8pUrjMRfoCwTVfRkgzpK53vPFq1oASROLqb6ViuJqLddaGB40/AOPPcaHnvWFhPDz",
-	"xT6K8Vw2A7MKSQ2S3/U91t9g69xgqzWE8kr7mSjP7bPiUruacpzSRvUvV9N/Tvlhenybwn/NurQFHW4T",
-	"Xau+TZb/7pnnjz08ckZPrxRwqD8blmX8fRz/tq6NxjmwlrgTLtlTYtzEbEEp50xoUgsgk1rAMgHOwPcU",
-	"MtuF7lAbBWmLLgLIt3h4vmSoj4bSX+5/16btd6bt923afn9ndgOLfGF0HgtCTGS2H5/fwHdAOCPGGmHE",
-	"Id85OxFQRs64Q5vwdoe9EsUkgic+OYAUMvYOcu0kUviScGN1OGdQOcR5d46Iy2g+ImMutEg0R5VSiKjA",
-	"eU0PILvMpSLp4JxV4LwyWX7ge4oZnmhptUTzduRjtqCnnxr9PGaayNkqqvhkWzTQxSmRSuNtkCY08sP9",
-	"4JI8ztchEpfH35FJQvDMKV0m96lzig4RjyEYSz2oA/EMkOQoZ1gpwrQa6N7MEJXnjDAIkEV4gilrRWZu",
-	"T3tCe/yEVka4h6ROixrFs/Najw8/aYHJ1ANq2+U4zYiQnHXr9auxaMjbfeSws6x65vj6WHvH2AUvWiY3",
-	"Y31HjkhClOakkWVYOdNStjOPWTuUdFYv6w5gkNPq0GhME3ApXOBeesKt4KiBUXZAtk96EV06nEHz28TM",
-	"1zw1ZpUeL1dyvb2xzcLgfbazVuSqTFHDx8D7XA0VIflBp9PmkSJqVypBcFo/9TJnK2UYjE2LhiPfeZsM",
-	"1sTkG//wbvctlmr3HY/pmC4mIqx4w2QQPKOH+N/z8/jPlze7+s9z9+ej+fOq9ucv5+dD/X8Hg+9vvvnv",
-	"f/33f/ohfJpcMffcrSd5AFnAwP8jj+d3iCc3S1jaQi9/7vTyh2ZHeGDi2Z67H9swK1eevHQrqN6uduCh",
-	"HrgFAyvEqXXvVEFnRHS6IY1vc/seH8we3IW8d0TGEIJmytff/Q37lZFxOtoT3KUICBipuDAh5gwn8LzK",
-	"WTIHddmGI5WXaRHdqaVCQRSCsZ0V9iO3764uZ3BEJOQAtg4aZW8DknsXHcCsukX5bGvMYmOaaLQZnLNd",
-	"9IvrfQqdz3Iw0w+GNP7h+vra0wICtcvvTTr0Qs/bVKIXpjq189x3Rfq+ct/BzvWuQ17zZrhEAhCGvAb2",
-	"H8axfRGCRwX7JOpIoXA6ck/7OKPQcOnVXxQP0/D2S2Lo+Exwrp4hLtAzDeAz4xpQdF6mHt2qcGKCGPg5",
-	"i6aCM56X3SBlefHcSyUCjwaXTaE+hiGxKZZoRAhDWT5KqJzCe+3HKZX2O5UIotpJDKv74Tzf338R4YxC",
-	"Ohr4F2lF/dW521H8/3DKHJkH5x7gOCbxReV7+Q39BU4Ms5hqSdmcY7Fg6Ahv9FXz4zdu5mOTEaRh5mLg",
-	"DrNfYYlwIgiO5wjXZi4mNnxrg2kxQ5BV2SRyR3GuZUhkkk/WpgS545tm1vg/Joh/QZJYTpa/sE7F9f4u",
-	"7W7g7d2mNCr9is3jv+/9vZhn13ZKKXtL2ETziOetH+ZX6lxnRMxIvPvj3F8aoLooiPWE7DMW6S2FW1zv",
-	"VarWnNpkimjwEZtQaczx0LLgZIojUzNugaRQStIRmP478eO3evDVDLkOw5ocuT7IHbPk2uTteDLszWqm",
-	"bI4jyJbrjNg29rNimHALvBimtDmEPIwXprlfnPetzZuykvW6V6vqBJszWt10V/HdohrjdhhtJ953KypR",
-	"mazIq5Yf5WlWvExW023hGaYJ1FmxoqDJaNVsUywS8qylir93UVIfXAKhLlq5CRouu96qDbu23McZRrKM",
-	"UkVQZsNbnAtz7o4EJ1hNuxz8ex6Tuzltt6aQSQWyariYYGMPG5Qp3lhso4Of1MtGgSvL6LOXYTXd+7OI",
-	"ibzZ+/OSsvjG/HSzl1Ur6XXUYj/JMkjp9ek7EMwZ47bUUyUxn/H3BU5HQcaAbJrgDcGd7DBAdGyysbk8",
-	"fdjcqDaRXzlVmDd6SwR254+aOAr22I4t6i6/Uha3b10JvWtj4O9GRN6N8BDTa1Ooy1xHlqYcLdk0fVr+",
-	"HScQjW0EPRhMi3k2pWb1oGMaw5nZ2kzDJXng5jau8kdDvQ16TFt6LiWQW6Vmm+vSCvsF7tgUp4RBmkvs",
-	"UjuuotW1JZmHT6kLW+ChUX2O9cwXPVWtdycyoq64uGySqN6bJnKVblTNJlqqfCMcXWrcdxMFFCVbmaXA",
-	"j7uM+LALfMQR2W7zl859j2Ytjv745LGf/fHJ0zp9m0t/1UO5lXsGRR5nFlv9AsVYYTjtpugOjULWDt3t",
-	"Grs73UrP5M7+Kd0FgAJ1jFjM0eA/y9vOrFBO8kip0bPxmgXu/eleN246h2+ZAsLK2DoX47W8YuYJWQy4",
-	"WkvO5DG5/XwpvSP8Xb71d8DPKCFYhPHztf4sjYleor9U4kUGEH9B4m+cN3MtjhC07BDiapQziAvD3xbi",
-	"rvLq29956pdFACdirRDmtWfFJu5zZJtveowd7PSQLf746PbFCstfo4hkvbd5VzQSuO5G1IhE0Li/wvor",
-	"rDOetQwpdnfUcIUwVYTf9tys52YllmW5nO5haYvwhNxtbJInCPhiceEB6dJRmycjPQiKqYz4jIj5cIWM",
-	"dJLL6aE0BW6eMko+ITSLqbzcFMv0GN2Q7EjP2uPYE8Gx7HKyKYplOLrEE9INy04uJz2SPQEkkxFme0Wu",
-	"CZc3vhHbCitCtRuKcDQlw3P2ushbgfTYjAiTFrDIWmofeSPI3jJxeQlHc0Q0blZKDkK0lhsR22n0UC4F",
-	"nUkmgbhAtn4dGhOsckEkGmHdxj4XO5udxXk2sWkt2po/ziJcLosS2RPGkyAMSYE6wgSh8cL4I0SSQvk3",
-	"iGqUBItoivgYQmz0BS9b4Njrs2M93lfBrdZ9fvnxsENrV4GvdYe3n973iH7niD6XgmSNzx+vjTxRyhkm",
-	"eqzouUqgOCumuDPsfsNF1Kv3jw5ZO+TgamtIqiSY6k1JPa6RmyVxeGVGlqocbJ0SbcDso5CGrT/CVkXg",
-	"Ww3ZKDa9z4nVHulX5l4DHFg3qdWarLLMoDa4q+xufaq2O0bLbeVpMzaJtlnavgY290ndnixjbZ3ebRmL",
-	"oT5BEY9vI30hyplH2LiEgkfwAMUQz3Q9b7rEq9m97vIK73PJPT62HUgkdxt41qehe2Jp6Dqw1u0lpIOU",
-	"Byt45wZZ6NYVG/q8dQ8tb10b7DUBjc6yJQiErTY9v0GDaiwkaPQgEFSyc4E8AAVAWYxmPCniI6WWD7Ts",
-	"EJm4W6fv2zQwxGUnAasC4wpuUk0rPBe1NPEmNlfCW/PclF5iXJ0zJebwAm0T05ep6m26EFuxSa8i9CBy",
-	"BAuzS+09ju8KVdGeRaluOCunuYJK5eEnsmmuoJh5kXgkjJ5QZYAhqXhWj7Q/ZydLyFlD0HoVg4wIyuNB",
-	"HUGVmJ8zL3JiiSTnzNbdpKJS892+7tlVWoCeyXPmcu7on5tR+cxtUVdcPnLVutpHCt+Jcc0s64T26t9m",
-	"pKN41kA2HhpYi7dvzNk1risP1eRM0cTWACn6X0wEjsiFIUBNH+Q6o4LEK0hEb8V9tif3KL8hyucxbRBs",
-	"PpoUK5CwQbd0fBemak63Yk7mEMbfgji+HGltAErIjCSBmGr3rZJpzJaah2isncHOFRamTiREc8ZklE+0",
-	"AqpJxVuFPhTxXbwtyXxknmwkpMmwqw9UAA1Ue9S/xXlSLSkfhiAThKRZPQDS7AwdQwUuKl1du2EAEjuE",
-	"vyImFNz0lMT8/LAyS9xLHborscZM7sV5mjWnkasmDD56f4b+4AyslprZBqyPhlaP3p/pAe43v39/9i/O",
-	"yCN2DeqKFJAuM4gRWo8nrMqvTX5N2YQIP0GLOzCidBGk39KUtnJYA+jfQPrQ1s1PSZbgeevmr3E0Jbec",
-	"FlGRa2UO12s2bSISgLHBgtMx73DxiuEuuSLdE+TkF5BGdQrFl20N8t4K35mMpyP3pepT1dryZI9kOkJf",
-	"9ABftKD2xU3ypVlGK6sDbMm201YrLibuTUJfAbcknTRZh+iEIVwpnxFTedkOjXTXHoeeBg41c6ez7fGm",
-	"s54zPSGsWmmA2xJObcG61aPUQ0CpK5o1OKb/k2ZkzctOd+1x6JHhUAJaMxHbEMndWGswqre2613L5W7e",
-	"Hsu+GpZ1Eay2gGFnPX49NfxqK2JtBbvuUM7qkevrIVfCJ3sRZ0rwpDlrWR0/3vLJa9vrK2LJ9lO4l+uC",
-	"YT3G2DOiEF6itIRPzLumIa9WKd17jN4Yozsi7/aQ9quhH2TRssjncK5HuLtCOFvfxly/CTHlkusH9Cu1",
-	"rnn2lGwX37VrApisP4wd+Vb8LjIau4cgCw5SHF3SJAk6GNC45lxAFUllJcc9ZYpM4LXO/YKFwHOvl8Ft",
-	"ufk/IleCgf8p+GeiPKhUrTbX6B1wqzjV4ENjCkFW0S1Y0XVtl5p1X3Fbdz2l8e36RdjTWeW2/6C5phjF",
-	"ezhJuNmgoMtDp0JGE0cUYhTbQH6UUsYFYnk6gpQALEYZF6pSQtnAUIbtWx//UGzK0emPR4cl3PfavaYO",
-	"6lZ8Ku8wqKOhTFYYpZai6zdApzFR0RSNBU8RNn4T2KDWcuwzGgs8ScM+WQ5z7iwQWk92anNa3A2m2aX1",
-	"nrtB7B1so1ibSz/ZGiN1Y/BeT5Km7Gj3ATtvp0JifXWntqa6B0+PVu2kvj3KOwvRvvLhHbBzRiK1rUKH",
-	"8tLRzZgLhNEXPQmOU2Tn+YIinqb6mMk1iXI9x2qSAQC/Bs107MNj4s+E9XiDre87emeCpljMbx297Tzd",
-	"0fvEAng/BJYeUb8WokoScRbfBaoWM3VH1rMCyB5dnzC6arU/nKLCGc5MEIVtHNLYTD6Ie63jA4h9nrPW",
-	"ySBaVthuys/nijnf2fvmXda+viU8dXt2rEjqw9QJUWU+AKODDYrqeZCIzlbOfnrPUMW27CE5i3YGnt9n",
-	"8G65/Hs0nnh/l8Q/Ti7FlujHuaaMOG/Q3n7kNsOaLXzuBh821pE3uXZ138dGga2fIA6T5CzBs04pDt9h",
-	"qTpmN+qeuB7eRtrP0HUNZ/lIdsp0/xFPurTmd8MF+2zRG7G67bKo8rnez6RsdtQ12ZTp/WQZ1R2lYO8J",
-	"69ZkiJCsEJItmAx92b500aHU5Rqke4eVL5+ujNFZAugZypO9qbPJXp7FuOmy/gTfa/5sE8HzDEmiFGUT",
-	"CfbGNRnCyc9m+J4l9GpHC7Wj50/3gj+FBZI75FyCz6i0jm5+znXimni5E/qnSdmJFbngLJmjAucQlUiJ",
-	"nAzgwaV8gimmJLFNN0ilzVoYt2J2Bcg9t+uQQtqUBDrlSTLC0eUtFlJ7C1l/nhoj1oj8gSXz3mbUc/qv",
-	"ys9Xxo1PKCTtwyxGgkBiLWDsBVhQyzQmNsurdHm7AfpLMg/56i0w6dO7DfbtWTTpRd+ee/bcc2Pu2RSx",
-	"fiR4ZpkmnLm0XFSzVGF/mZKkcCpyPNOFcHh5bHuGeofx7T0/7flpz097frohP83ldM9VsN2D/OcNgulY",
-	"EDktS4wrbgvjJiYg0md+KMvjVgNM27DTXE6dm+Sxycvev4PeK/LsSW4tkutURWqNp4a7zhLWCyK9INIL",
-	"Ir0gsiFXzBseOE5z79MGUlhetmKJef8U0YXAId5VpF16iE61LHuueStcs30BfjaTPZN9cky2XTFIKKS4",
-	"pvC5di3Fp8xue27Yy5A9e9sCe2uTLHldxtbr1L1O3fPDnh8+NH6oe8Sj+RpsEVFwG9S9Ucrj9mzyzE7Z",
-	"c8ueW/bcsueWD4Zbqlyufv70cUrTtyWD1LP0j5k9gT09AltZa2Rt5ax3vLpfFqd3fEY6WaR7IaPngU+B",
-	"B85ZtEfZhMgGQ9UxfC89p2ZYQDpZiQSJCJ2VOfH0qLOq1+qcRcgEuiIzYyv2OWeRmbOXS26P/fSBoD2D",
-	"WM0gcrYqM8Un22JdYcn17wWmPjtFT/T3hOhbRHl/KhvdkzjvCkQ9M+njtbcfft1rbD1v/mq8OUoIFmF2",
-	"/Fp/RpghIgQX6C/nO8Zrf4xpQuLzHcgWZCuPfYPoQnihy08LbHdVfCFM9URSBvd4fitpexsy2dx+Ql+T",
-	"lHlvTBMSTK5+SlQuaoKNt5wOT5Gbf4iOx8U/tOTCbEbghEc4gS8DFHMt5VzPA8W1CgqDud5oAJ90Zm4e",
-	"KaJ2pRIEp/V7y8Tu7bzaGVFm6iQs1k/0XVKDnSnILjD1h3e7b7FUu+94TMeUxLVhY6zIrqKpOQClJdSd",
-	"Vzv/e34e//nyZlf/ee7+fDR/XtX+/OX8fKj/72Dw/c03//2v//5PP4Q9K3kIGcAjziRPyCqfFYzklCSJ",
-	"u1w1TmPKiCgtp6YGSMYlQVQzB8HzyRRhlIsEqSlWKMIMjQjiGWHGqorRSPArSQQyxUWUmu/KKRbkC4oS",
-	"GijTV72sXczqa7uGp6oYdVMPfhaEqI80JTxXnfQWrLyBDAcegU0QrMXpGk96W6kiek/Zxb2srrLMWrZH",
-	"+oaI9xIeLsZ5BkmRSoJP+ESuvOFN27d80tNkc+u3fPKGJwm/atn4LWWkVTiRItdqj8wI88sYK4rY96Vq",
-	"vp4yvJoYGblqQYZv+eQJOj9pgoLy5S0b/yxI1hNqL4J/RRG8yAnTqLWHK/cZfV4WgjlhytX1t9V6SWyU",
-	"eiztr2bf0YjH8wG6osq4Wuo2////+/9JlBKFY6ww+otUWFE25t8gyqIkj0nsVIBiECviDdHHKZWoYEdI",
-	"/wPeRojQqqfuaYCSGYlAKzVA6d3RjWdEmF+xtIqE0RLYcoKbFSYGpxc8RiNDewGksgkbdAU55n5ZNn4W",
-	"PM88WsTgq5s9AIITItIQdJ8kEfdY/7mPQlXX0pKdua7LxLXKVlrLroUUHiXEsdnF9+F27Okxptq6zTe7",
-	"6r71cs/XU1D0ecR5uwcG13YTejlz8/W00ppW3J7dfzp5IDa326YphVWpADhLfF3uESTBis7Irh7LJ0U0",
-	"2crBKeTRvrqBlvMjj+d3KJbePKFS4i/3v2/T9vuHSaSbWtw0bdyRte2embdWt9ULvAd2sEdeLz0lStAo",
-	"XE//RPDrOVIcXDWeSeQ6IMLijFOmBmgMaEXZBLlvIyxJjDgrrEjimUSnPx6+RhOBmZLDc/ZzDtEzXIt2",
-	"RuArbjh41E2S8gepfxkRZb1kZT4e04gSpjRcOIIqcFYwtBAMz9kp53Z8KhEjuhEW80qPGJOUs0qPEIG+",
-	"s1u0KY22xOQswXRBXmt5qTwp5WUVYmd6q0JYjeWlqSqgONINAd+iJIeSLvpDEz6c6JG3jwwPSwa4N+e8",
-	"uU6px2o47q0pkb3Wdr8QR073plyqSzKXrZBHTlGWjxIaId0N6X5Imuz6GSEC3JSUyCU4OKaIMkSVRJeM",
-	"X7EL3UPCq0UTpp398osDqL9sHhouXZJ5RzS6JHMUkzG1Xm3Ah6Sc6p/9eEWVwyqcqykX9A8SXwAersas",
-	"X8m8R6oHh1Rw7mDYyT1o9dFxmwWskvpqA9wJyjInuUMMGOQryzOP/STnUpF0L6byMsgi/kHJFRwltArR",
-	"MQx0ZFrcX2lEA9hLIl3RY+Jep5vxwzRrRJCfbZP7iyEAYY8iXVFkikV8hQVZjSWupWzGlF/cgPcZWRyQ",
-	"Pb50xRea4TgWRMqtsJXjk0M72n3GlgLKHl26okuGo0s8acFdXMNGdDkpGt1fZLEw9qjSGVWEPnk1b4Er",
-	"rmUzspSt7jG2WCB7dOmKLhKzPcqoolhxsRpnyqaNSHN2+P640vIem2cP3+vJCmB7BFoHgZz3SjPuKCwm",
-	"RMmVmKMP5CEgTY8rXXElt77SzXiiW63AEnC6vs8oogHs8cOHH8YfIIgFetPg0de0k0V4unkDDpjSP5jG",
-	"nVFCI8QHmBont4sQBsIeJQAlLA4sIkXzPVJ5qkk0kvCx8y3R3SRKsYqmlE2M2Z3YotrkOhMmPRea0Blh",
-	"LvcrhCgVmNCIVsbfaR3UuguUst5Yj9JPqglPap63chY5t9vYlAIJZ7+wtUIAC66mPCFIziLEBZI8BdcD",
-	"qmQRGRKoSnA2i+ww695C3T1pbzWDxLby6/a+MgEkXsrz0AKVCWvG5J/YNhDZjNLjcY/HW8XjWjBE5VIP",
-	"XLJ3h3/3La7HrP9YkfRR3+KFP3/xTxO3X/zThOuXjUmtcT04vxXSufzAeMTrhS2X2aA5A5s5FJo/XXQU",
-	"0ZRIZTbo7znJ73sO1W5BL9+1afvdvQyQWY+OXO7KWyCsmCREkfaUdWTa96TVk1ZPWs2ktVzGopm03mxU",
-	"lKInrZ60vgZprUkcEzojUOa1NXn87Hr0BNITyH0mkDUpwlsApZkkTjYtPtLTRE8TD+jSyHIxIe3qAxU2",
-	"U8iAbbScSpKb4Tk7ovISPo4rFlY05UmMYqzwEP1IrrAgA1SpTYRymeMkmdsBTd4+aH3OTnIxgWhXMOHG",
-	"nJh8/AAztJvx8kU0l2UJQzmLQim1a8QOi+8JvSf0x0/ogkApmfY34antcP/Jo01OnI6Ok4G9AOrQE1JB",
-	"YpujryfQXjpdiyI70uPZA6HGnhZ6WliDFupl/FeRwvql+XtK6CnhXlPCFVXRtAMtmPa9lFZsRS+k9eS4",
-	"NXJcXTr9MEn4FcK54ilWNIJanXxGBOJjMFtA0YEvvMAS8sMUfxmeM9NPTQn6PeciT9GMKwIFPtUUCg9C",
-	"lqeylavuaQBDV1PC0Bf74w8ayb9ULTSCoJhMBI5JDBYZxhWyKiAeJaSNdWTTmu79TduT9gMykHSul163",
-	"h14SkgUrjd6CbbQCicdEmi/Udd/QULqFouw9N+i5wUPgBoZuV3vmmuq+95saWrt7/zTDSY5Vly7HaUaE",
-	"5Kxbr1/J/IqLWN4updpZ+rCyW/figgJDRl1dCCcyz4OSwP0h9bUmiYILUP+9tHjg4hiD5bk98Rl6wkdI",
-	"g2bHZIcen/SWyk6VbdUtU95rnqZUqcd0Mz4xT8vt1tXHzOQJNVowRjHJEj6H2nq2Ig56y/mlVXuJbxwr",
-	"wZYF+NGYCqmgUv/ChynW0m9ZE6FWhGdl3f4qT9mkfkhfg7+vwf9gb/MVNucHRR19rZwnVivnlmkj95FG",
-	"3lNGTxlPmjLWki+dAtglr4nMs4wLReKa+mimXS3SFaaHR6IuCjprVxyrUP5AFe/Qw6QAuhNTzREZQw49",
-	"zr6O0eaJEWGMFV5FeRhJJfJI5YLEBQlekjnYcGY4yYmrYiUbFaojPdfjoLlfyRxAuuVs9FjhX8kcshc9",
-	"Sc1mI8PjIZKUTRKyqwRm0j6WRzzVsgr8Px8jHMcDFE0xm0DxNhvKUOCvdDaHSzLfBUxHUnEB//YXpyhN",
-	"kvcf22/LGUfvQRV1V/vgPDT573ZeyF4etIHh4J7SYfd7xxUeKtMkeF8OMNw1YET0kKKPCk3Hkgw3qCB0",
-	"P++dPiPTbdwjK2QguEwAFw36YeOF4cBFIx7PV8o/TwIVb83+/LAMAPdXYPJ6NL0WBAO7ZeQK0Jyytgy3",
-	"NAs/Zhy/A1vZIxOUHrRAM/CXrntttAXwpQOq0GoEQ+SaSkXZpCvl5D3h9ITzuAhnPU1ANqc8t/QkO9DW",
-	"ouAln67Hqt0BZ1LtnW/uHM2dp/ceZWPe5q3DdUC6Q1n3u0z9X3i3NFtdT+04x3reJ0sA1V24/96gD8or",
-	"rSslbFb3XuN/xd2sHQ1sWgn/4eP/wymz/1BxPyMMZ7QpXODsCk8mUJdno2O20q+t/XC/U2K7PTQVvivb",
-	"lXGeNO3VCefJOvIaaB+6c0eFBUon2Zoot1yKj/NkFRU+YJsrHGz9nPdmPMlTsuq4/wGttnDot316BtCn",
-	"c4aCJHi+lxIp6yVWl07xVDd8Z9t1PUbo/N5WRGtDudDhtSl7dXzUuscnSQS7A3mzshWPE0sALVb4Ci9g",
-	"xG3lfli12xpAhE1oQIwVlkTZqAQEq0BTgoUaEax2WiaMWGVK2n9SD20OFeocQyqs8rBZ52eikGUq0kn2",
-	"0LEemGygjCmbuEwIHyHWY0LZXoalvOIiNh0UR2OioilozCI1Th5YGFutxKn5n+KoYZqA2gAIdWbgX4uR",
-	"ydb86JSkXN0FNzLLecTX1jIWGp2/+cqyAfgblkZcfdj6auvS/pTGd1N50W1BCDMmRJXGKOO0OyhzkLAY",
-	"WTp/WgzPotbnm5ubm/8TAAD//w==",
+	"7b2LcuM20ij8KizvVzXJHlm2Zya7Sc5mtxw7k3gzF3+2Z7fOxvN5KBGSGFOkQpC2ldRU/a/xv955ktPd",
+	"AEiQBHiR5MvY3NrKzIi4NBrdjUajL39sjaP5IgpZmPCtb//YWrixO2cJi+lfhyffH54wHqXxmL2F3/E3",
+	"j/Fx7C8SPwq3vt3y4pHnxLKJE2KbwZaPX35LWbyEf9Bv327JTzH7LfVj5m19m8QpG2zx8YzNXRw3WS6w",
+	"HU9iP5xuffo0KM4eeezosGn+cRSGbIyfnBA6bPueDRr4ekFfawFIY1fMU5527t44nvpqnkL7nM/Bbtz5",
+	"IsDPX3H4UJ3yhyvYiQMXOohJFzEbu0mOr9Lqs++OG/gud6KJ8zFmi8Bdfhw6//aDwBkxQM88uoImfui4",
+	"ziRN0pg5V7DDMMbQAvyYINAh99jETYNk69uJG3CWgT6KooC5YQ77Kz8A6qliLPB5guAxbORMRCvz5NnH",
+	"fHY/YXNeHVS0dNgNoIHjer51frn0Q+/DL4PAHbHguys3SNmHP/8y9NzEvbm5kT+c467ke/Fu9CtQzWni",
+	"Jil/v/AQn4OFm8y+m0RRdZeyH9w4dpf5yl+7PDGRaDJjju/h2vFvAbSSSICtYz5szNA5gw+/AgmHbgD7",
+	"RF+5c+0nM9iwacxcXKTvnYdujLuJ2wvNRmwSwb9pUBhGdhueZwQ5Y65HeJR4RQC3CdRtgFNHLww0d2Fv",
+	"t1I/TP7yMl8z/JNNYYxskSc0e3WRAioCBgWKO07UQpwZ7HwU4zc3cca0Gi4apnGMDcZBynGFuEecJbAC",
+	"sa+AbccNPYezALYnirmD63cXi8CHwZOobjYNDSXqEpB2pe3X/txPTFQNPztEnQBJGiaWSamdWRLsDXL0",
+	"12D/dTRtYq0gmm6KsVzHwFoaSxX5azgcFviJ+95337hfs92X7C/bo/He8+2XL+BvX7/w9rYnbG/X++rF",
+	"X14w96+teAsXHgVBdG1gf/pdcEA05bZVi94GUV/Y4Gj6I5BGPXbngAp3ypycPkFMwN9C29xTHLJIakU0",
+	"YwMNyUU8epEz/LPxmABoX/sh40ZGjOIEcOJzJ0znI9hDAH6BUodEDy4D+CT2GbfSakjfKujSyRHP43c0",
+	"pxtUgcDjNWPb0vJsx7HlnAyfD9zfv2PpnhEPxyClq9NHJM+7AIDSvlY70TZltDe4ZqM/W+Gxo2VluFaC",
+	"g9tpWQKCo3MUpJyBmEUWckAU1YDC28gObfCiVLga7w0c+O/zVnx/wkBMH4ijwXasqpMDjtdMjVUHLQ+i",
+	"hM7dkP4ZMyH1jdqOGEYohK011MHWzfY02pZj5JAq2JFFQqPSjPCE8utagKtBOirWBN4JaIWJAbgjgAJH",
+	"cDJJAiCRaoQAEjTi+OYsvkLcw8kHBzLBP3SgNx2iDpB3GCGtJ5aRtCEYiCnPY54YfWg9uAngBjlOa3sP",
+	"A5tRL1dHeoXALsxCNDRzxbLiCMCexm5IgLuiWSb442guIF+wsT9BPSSFuQTgQPJx4tPtww9hTDdT+rJZ",
+	"nvG8kW2dqQK+xSbW8LjaqQiAAQIHgewrguJAcLBFUt+yorusJmX83sC8RcaQcCLEvmeXjdkdroN0VH0s",
+	"EnLC/7Q38BdGAXkCFFODPHfhAyEEtsus/GRAzX/FbAIN/rSTX6t3RDO+g3MaRZ0forz+iQFljEA9Vjdt",
+	"mlmK0ba36Lr5D13goLA4TT79P6ORELOGaRsEY359SKllFd1iip/hamaZAW9tKy+Mxs2neQ2byEIW3y4e",
+	"C7Pkk68zqQ1vOCaHW07dwOJ7OxUGLmBJzTbhwVW3jDaHTpGtzrSzmnRDkEpCZGrSEX4cOh8vPpJs/hhE",
+	"YzeYRTz5CN8n0C9ZnIfq4BR3SXV/1gcZlq722TCW9f43MvZ+EJwG7pUQCCZ+5+KrfYH7oPCA2HeDAJTr",
+	"sbugA8ANx4wPaDleFD5LHFe0QnARpKyR40/osHT5JaxmImRf4I9BqgR0HBrOOQU6CpG3di0DNQyUZ87I",
+	"HV+iloe3YzzJhPSptdXVEyZNfxDBXT2e2/A2lp8bzmw1WCxMbcaR4pIlzT7MIRwgiX0vPfrcRpE9KyCQ",
+	"S8MikK4YYkAWmihNnFGMyE148fYGh+vln9LwGjQJ5rVSedUCfO6OAgbHRYC7Zl2IaHYRq3Yt0RMDzxis",
+	"CHwGV+koDJbOJVteR7EntTTQ7zzRxWLnVB/NR/CQ3SQv65jvh/DKulcsvGqzUfshcN2VDyQyRz30yo19",
+	"xIy42SRK78H9QG17DgzJgcPYOKUNBSJNAMji5r35P//aP/luvrwCXaXD1v2ABhE3YdYFqe92UXLISN6B",
+	"GAEK4+NoIZRVgBLQLJRouUFO7F47ZIGplxGvonhshWgSlRUo+0A/xowlZ/6cAdHbxptim4tENjJa2Uy2",
+	"76LOWJhIA+Cn7/erCDslVXwJLDobubTnYzckKRqya2cEJ8AlcOyVD8LYpkhCRzMBf7W7u/fyxde7u89f",
+	"voD/79bQ8dF8wWIOyq19932tSf1hSYccyR7U3/NuzvWMAbELKiL7qCKGoXPKEvqp0FxKKEV339HlJ2ZJ",
+	"GoccOn8Pd5QTefyyOI7iYR2rgoqIukENyHisIQwJGbRHeEQTbEeT/DaIh2RSUgi4sG0jvuBOZTfeVgxF",
+	"Vlh/ZsuCDtP1OaokYcRlKUGDOyBcPXQ1zc4bpm+WbK3mrb+ACUAKsJF4t8F2ed0OMiWFSJfDF6aiBAWJ",
+	"PFzl9Hv9/m0djwfR1AeVzklDP1EGzpV4PkhtT3N7dTv7WjyqWHAXqCeXNuL0jcsT+1Bz8bVR56xqk5px",
+	"xcdDpKKAFlXUNbTPN7DrZ5F1BfB1O4na8Wt2x6mxKmvXHBtTqe9tZgRZciqNDSbTvM2I7QT+JXM+hr/s",
+	"PX/x4eMA/vZn/O98KZ5ESGdI2ce1JZh4lVQW7pWs1hU1qWibtU+9MD97k45Y0ECUquI5o2WmIiPBRYua",
+	"t/Hso8Vs87wOuGMWz33aCRte3LEcvgNm0OIDupZ4sx+IPUbz3wBNlnGCf0QLtGoCFeAPtRACp6xyJ1tA",
+	"v7WvZMpj4pUfWHw28LRGChBATKCdzvEOn8FRTPvp5p4VQssX9+6i3RQlPBeHAF3H6TOeWmgP3pwziGF1",
+	"J75nXlzsewJSH/V8ODoS8V4Mf484K8DvifUjOqxWxm7PAhqsJvg0nBpkSy0ILaY8ZW5it2LQR6NKvld5",
+	"cy7qEGLcwkTjGhHB0wVa/AG7lbskmQSm0jdGSQzLsvOvq8gJJdvtB4raAOv02edWqKcd1GyoleGoQdnt",
+	"qI3xVE5Qt7VJN6UtE3WK+NCFiDt/u7z++y9/80OP3fz9w9+ixd//RofZ34WtA3oJwfid87++c7a/qyp8",
+	"QCbfTeLUT3gXle/UD+0XVE4fa1VzRU1fsBtnb/YlSmnXOXl18OLFi28cvIqCxJ4vhs47tG4I7h8TUc4j",
+	"cqgZo9VA+NbgxQguILX74P9eA+zv3a4V2EHjf3nw7O1OcRFf7T2f+7WgNFstW5FFrkmWrJfaOxecB6hm",
+	"4tibtGm2oI7QXYBUSTZ6nbuKghSOYS7HbrzQoXMYQ/qxIhsbXKD9rKX2f5qOkGttw4mv7STPMhwfwoFr",
+	"P+2VykHXAmgtzmcYibnzgYMiPwYKTMQuZ41Kh//mD3MFeO1BroOdQSR0DWVpwNVs/PQ+c6e2zUnc6VbL",
+	"MeIpS+quswm1WO0GK/paDVe7u9/89cVXX+99/dXu11/XkLb9Atf27nYWgRq7UfZMeUwaeYIjNzLn+5DX",
+	"nI1p2PJ0rHBKbvEXBo5NW/w/IUrEYzyB83x3F/8gg3RIZEPOjmM63XZ+5ULfavdIeRxHo4DNxSzFdb77",
+	"GWF5vvuyioK3kXMgZ4cmL+8GHs0IKWbdu4tZ34duCpsZwwHsiWlf3MW0r6J45HseC8WcL+9izrdwwL2K",
+	"0lCu8+u7mFNZlTMrPs78zV3MjG+SMKqYcu9ONvX7yINzM4qcAEUyTvzV3bDOER7dcLdxToUn1Q9owxfz",
+	"38nCT4WN0QFmunL9AF/dSDDLrjjyPtB7Agp6FAsHe4oyifH+kfhC7PHs9zooZG8YPI0DoxfSNfOns8Ti",
+	"sJqfPb/QAAM1bdbvQyafhZUMh6Tn9SM4LqpQK/8yi5A3HZc6DPqZVjszb+1flANreBGgj+izUl1Jt8Fp",
+	"Cy6lJw8L0zmuhr5q67AsWswkuxtXnXp+ckK3syqcblK4L2Ocxjbe7kwnLTnwdXNamzM4Djxj01C+elU+",
+	"SMiNn9QN+8I3D0qhVmJhnucLG8WxeWMaYa8gUtq3bHMLNfrCX5i/ZgxZZiT8Bqti06Wxo40z0aWxmSVc",
+	"1NKkfVyDX3bXplbbq68j27+BZO8C/rM1NRBdR4bTqNXEcvnndRivDJ2J/UozNTKidLcTAJhxksz2x3D5",
+	"4KTcG5iRPl7ApR7HvOjCm7JrogZuoIrKRKURbOAfuOGRCW5lt6zChXEizDNd2zWBUr0zkAcwfXdoCHUt",
+	"zSjQaO5YyIedyge4lPOoDWIkuIP8vSN7EKIRbIg5CidRFTGCfYp0qegL2oXERSOX+2O0DX8FutxAmTQN",
+	"9FblBjlGZV7hPG4TVD7naRvZIdsNtOE+lNuoFdrwcsImcB2bWQg+Fl9XonjVt4bkjRBloMBevwMJ8UuT",
+	"qCgy7adBc/vCoj99gIkP3IU78gM/WbZWeky6jQnL+dBmnQoNOU3yUAPPIAlLM5gIE8igcRJ8G36D7SyC",
+	"k8YYCHibF9pe2JfAN7BR3mKNM6UMXi0iN3SiyACZ07JuobWI5nM/SZhBSPj8Yjxzw6lZOldkQdbYBMjh",
+	"21ObhjkOXG5WuBRlVlVD6zUgCcwaVPf7wUACJgatITtY2n9gq1vTQY4KA6Vh+P1+gA7U6sQsImsVQSik",
+	"fP2TV/HlUQRUzf0wis3oxIfGFnc+aqYGGmwV1AnfQiiAAHJwntolVbaU0TIx2yh1IOwbZwjNqOga2WdH",
+	"PMJIw6PzbG8Y3zwj2/EsayJf4yn689ls9Ke9Z9pjq+brBF1NG1WMcWh/BIl++LaxhK7z7NJeUcM8Lzbf",
+	"iorbadN9sLtsXMXnh7KZorgaR3wcyTD0d6CYnP7rwPGokROoVlwtgoLW2OA8vJ754xk6FUirrY9Oxoh2",
+	"crWSWt/+8RH6L1ZwaN7TDCbJ7/nOzJJkse2HLLFvz7FJn1oUNCkrO9ho3rh/BidlhUG3jL8Mbd8KZ1U/",
+	"ca5dLoKPRfS/B6hUbgD47Bw6qUy6AD3QayPhWW4AQj2iHF8c8YMvvUNLgjsbrpM4kvCsIMKaRRZBbr4N",
+	"i9V2mLW0ecXVFpZRGH2wlV19mWW3E/dnZtDzUJrxFvJt0EUjHMhhayBZQ6fRRrAqNfos62s0pRk7mAhX",
+	"sSdx6XbQwNjSfiIHGmSOY9i7xSJWxrdRgxBNOo9pHMvnl4ZjmF1Z79FtGXUOGAssN/EpiLv24J9QexP0",
+	"avOaE61Y9cPB1hULvajNXRjJVmFmoHxSZG+13ky1VIs0EgcgffWbGm2ZiQvVqLd1OyPg5FB1y+pAmApk",
+	"C2WuI7cyYCyo2pS0yuK+NnqlF8OuQSQCLNPa6Qu/X0rJVtdhQ3OMmKiFvq5DLxpIdqxtiGhEYisJbCVg",
+	"nzwXsiakpTkqgp5zFC65+uCHLjllVLbxVcBubLesuXtTzJW0axKYcz8stHpulKqZK07uIzNoOkxx5AFB",
+	"kQ1gwtKPcZQuDNtpUsRNJ1A7FiSxbuVDgmF1NhRLMNBTPu598WAGQXseyYE2cCB9XIMBNXhs+NoQ9/3k",
+	"xt61G7NOhiqdSU3fs2Og/YtEO4uVVDd0AHLDVRYlbH3wVotdnYYzdBm2pTD6fVGyDkR7eiuAbqBn9X0N",
+	"ki4CVoO+TRG2MlOdRHg3PZFHiU2EdrUXVgWnCYij433PQ79rwyNh/qFCKJPAnep5OSvm6CI8r6D5Yd6c",
+	"nBaTiXHkuTu2/C6uPivyJQ47yJZUWYAESE5Tw6AZvlbn0BzlBhorjn9fPFqAoj0HFYE3cGnWYA02LcFm",
+	"wuGhPsv6jHokvfkNJ1CmstVCLPtLBY9u26EvAy7bdHwjmxe8Utp0VJbnTzWr2id7OL6YLoyvTlrETkcp",
+	"lAcDlVGujVmHcJtG7C7MLjuw+vElT+eWj34AVBF28yny4oXZFQKzcpglI7tp2h5N24ceFDFb6wJlgzLH",
+	"mCSoi9yxo/0SQy1FX9UWFwPMwueoaVXvtKbCs0vl024Pi1X7WgTumGFik4tFFPjjZaO7pmp/LJrTo0pk",
+	"tnDBKXBRRaChmR/F0iGgerVSURm1G1lvNxMD5IRR2WVs6qVBB2F5KntUBs2NcphcpdsmVex4djMeTxCv",
+	"MzMLifCe5jWIZtoSIqCBaNpIA2eq3SaeG1DmaBJGkydCSAxknp4SIRmpa6CnD9M5LHNeUsxjIHyNEHWq",
+	"06lD7WqOYg1ppccRtUMVOSxlebanQiQP5TZoX7f9uXqvFOy7NYUB09EQtmQHHZ741Xgnmr/YuXoBuxSz",
+	"HTUW4VjJ+jX0qWw4gyqgj76qNpUdw2v4wuiAdNB1dPBN+pT8vo46VQCsBoXtlKlG5+YMme5iVUmpb7h9",
+	"fLmxJTtv5zen0vryJyUcqXaBuY5XUho1RaTSexpEIze4EAH7RkgLLS5EEgnePNZFdwmIjocXM/ciyLK9",
+	"VGU4tGj4jFG9GJdhcSml3IR169UbrLSIovC9EDnPOo6RC2mbL3tR9X2ntxf2zdIQ/MKTTkpVnGiqU2VT",
+	"8/PA6iKrN+IocXlrbelUNN+kPqNdXqoKTeFu0fIuUedVIAKQV6GStRWEIufWcJ+NhXVmKrFeiU3sTGGg",
+	"VBvlDUq5GBKZkK2MwVoGKnF4USEpDJJrNJn8a6tyKArauM5hi8WiMK72oVhj25WT8gise8rl81TYZxJH",
+	"v7Owq0QvCORy1Y/i85Zqiq9alDTPlwlMZAZzTBeO+Q1GjGX+S46XUsI+9zzMHfG86DpEkJxxdMWyxDpz",
+	"F68KIWVKAKz4Ebo1kb8UpRuvfHVY6PGBnkKdz6I0wCowThpKd9fBeYhuUhno17IAEBcR5rRO4T1lOIxc",
+	"DiITMxt1PR+09CrtiAbx4AYdOgCJXvnIr2LjGmIVs6abFOU1pBinYYi4aO0lItpTFIbxVuoGzHzRXv8m",
+	"R9wt2VYxqc5MVTrQNjjfuYrs03eoKAkVdtTCCqtoKwZPVajmhqTgP6NRp8igVfwEgWFX8iysxhcVDjFZ",
+	"SsoNSz//Go2keJBZ6qgdCgRHLAxbCObv7n5dvhgVptYDkEZuMp4Zb5twAe9um+ueadQooYi2LAkfgAC2",
+	"ZVY8IFs4D7jKzQYrIZxlBvIGvgaiepcJBZt1z7C7eUq+gcPmi2RJLsUy/6DA7NAcVMTp+LLpgvqGaVyY",
+	"jseMecSnE5AGIoBsRNm6iHUnKae/jZFbgqAQRKHPwBbtb9qAmlPoYELMSgprq3BSom1po5BekdLElJFr",
+	"FlgqFEC7H61YrqBi080Xiyd0Mm+gBDJgA35ew6CRQWFgWTXy+m9COZ1bbQwWnk1yci8KL3QQ5+lIRP9g",
+	"HYC2F9EuosxwMbXb3JsM3o2cV0q5ElJWGTiXPGg4cCQ/DpyMHQeO4EYsOkMsOHAkV6K3vOJF6HDpLxaA",
+	"JMxEGeFPmESyoNTVu2doFlS7X7ji17VC8K0Gp9ia7kmld5JnnJYqEaApSMcwTzSG34wEY7sr14Sd21Ei",
+	"syYfsokfkhZpttpSGc6u0fuwvZ6PuOzaT+SEt7jzZPcb+7d3NX5CosUZu7GNgMTcEWCj34SmMinPPJM3",
+	"N1ypYt8Mi5J1HXI9wCbO3cBsg4oWNQ9hUs+VCn+1c05ZxnefcbSgJCnmr8yugia2jcAfOm1D5UFanYZa",
+	"Vl4JpU5iEoQiZeR7ltNbmboK9J0TTnY2F3Cq1jPIOCnf+gL9aIvIt7M147Y/U818byCpSsM1DnILzIZj",
+	"3Tzr+oe8HNcs5FQ1AYJEv7C5IaEi+37hmglSZiHdWGwMJSlt4cReio1RYJQgVuM14KXzdjZQzvr00kQl",
+	"m6KN0uhw1UacUe7q8YQuGWxM2jUVH+bitzH+8cGioskfQVODH4c/CwhWv+yLcVSlVMxoB7oSJumq4jdg",
+	"Vywolifx0TIzyJbnsVE6JTFEP1+7MYXQUqYtvEMlZBpZuCFlxgjRwNyIYzFrg/kjB31LlXytc8SXDVZ0",
+	"w3/LEiARQ4gVLbTjOT+JGbM+a6hzz58Oj0SeQXucXA5UUzxc0xzWqCq67rYdpzZIX0GLY7pTwjviQU5R",
+	"E4MncX90bGD/RWMQ23EJU7XekWomtd11Ateeoab50erE5Ie7qNzERXZWBXwtbrqJ3BylBvLMPq4hcktw",
+	"GYRucZb13Qcqe9ch3rSGj1ZJR9Fmw1bZrprN2sBWNWzUprZJstMq7rLYt7OrLHk8d3WTpeInNS6y+P0B",
+	"uscSgtyFOzZmJ1LHTtPCT7L3CbpYylzn5modoka2zAgsLckiGb5ug3DH4yjFrL8qF7oUc+2PzKwgRecF",
+	"JFHS/PpW6lXCujynclTo8KgZmvZjdTe2wq6aGLQ8y32ldSoD0kEGlZdgknRam3XEXQXIBpRuUPDZ3NbH",
+	"aKoctxRmYpCDrEuNy/ksii47YoYG/wn6mbags0M6hedkj/UX09iF/4on+/LlGO2kw0NZq0LE9dxcYBJQ",
+	"WKclVRTcYi7ozfZizuYXi3HS1Ixfuwt7u0V8yZZNmtvxiczAgNWPlm3XErNfI4Ci0/r5IvCTOrdzzmct",
+	"AD49/YkgLtGr8EkWBJJtbM1ulfbDhHwjpkuIMqOitNhsaWpP6vnpQOee0pGHjwfxhS3xKkh0Nk5jq70R",
+	"k0ZbOyd5pc+afSwfJTlA2vSFufKR65dNTGoQJVTBtduFlF11D8iY+EHCut58rSp4zOCvzJJUtx2m6y6x",
+	"xi2spA0SmMvQka9xkJdoEGDmMGVKP85h2zBzStFcmhb1KvE7eVaBqqRMFfREKD61fnPHuV9jF6Mw17KX",
+	"tUw/U0h6ZtKL9YihUnVC+UUtS68kQUu7nrFYvIHK9ZOvFqmXWHsI4EF/hkkcza35Yg24FAOYUIk1RfRi",
+	"3w4HjZWat0bv6f5bqj/Y9IaQSVctuEPAq+2ClXbW0httys2964nd9cM6vVAQufXGXy2UmZEEdiRiNFJV",
+	"ZjkvjkA/F4coF5quNxTYLei0mvW021qtdoPa7JtcV7Clv5R+K9V74+8sjqj2mHAcLfp0hgwzM4oRiBvb",
+	"phG2ur1zVUJtBa9AOewgK7WWL82KmS4hJqZXGOvAttCRrtEhq/gV3X5Axt0GUzzRGIP7DBioc26VJH6i",
+	"+ySXdNtFajFDyUrXcLZDG5AneOfgBcERpeLFXj2lUYfSNh8cv+fiDJybp4EPUbwUVQn90BH5GgfN4a5l",
+	"TXNB3rrzwhFcxII1AGEq69VU0/Mv/CYy3j8+opZZyZmV/b4rVWtMyiD2KxF0TV6/FUIWpjL7u2UBzbO2",
+	"ClNDr+4gcj2b08q8eAw2Kk5a84qvFe2u2MviPmXYLLqjTynKuBQdpAFsIy+ubiTtlYqiP77xRRaPLo7v",
+	"wMM3671Pq3EIQcKbcl9d8NslWhadDiJKd7RmFE/3xA1iixrTIhCMr0TbtTIqdA8Z2UDSBEO4YVViuhPy",
+	"vFliYWOE18dq2IuYbtjKPz0vp4qlBNNQPRIsqHw6xgQRQRhvfeuHM2pDJC0wn4+QqHQWK4ffrJau4CLD",
+	"1wW9qhSS9r1oPHxUkIuk0XKagTyGxZRfoISrcmRLIZVABU5jJvKKeJKci+4wK3H7h3yMDQwRmdLLFd7E",
+	"WuUHcBdlt+l6MpHt9Cwc9ck8sFFFj6zr8l603K96bejPXLo7tEwc0aDCCbwdSvOEGy5b435fvmZ0Euwt",
+	"miIltWzauuUpG7dtedW25XvedvX/Ipenli3VqZQT9avsdCrV3qTflVnDnU5jNhUBCKBX50JaCA6Rep5r",
+	"jmCZQJn7NyQNwh20AqWh/PBBT9+fNa6IdAHj6lYvjQANJhBt9FWtX2KIdexfORDtDTsa4AYbmPi6ht1I",
+	"B8mKtg3ZjjQEVoDtmG3DPvyxsge3f5zIWXvNUwN5vuMQ3YVfPh0KjjUh/pdIgHW7EK8usfIfq/U8ZO6u",
+	"XLTw2dwY7iIdIrVsyX998deXe18/f7nb/QpP09b4LL4r6vK592yo+87OXHoUELaSODGKpIKN679Tlpp8",
+	"jTYe/FVmt/L4pjUfu+NLdOysGi2gt+39NcH3ZK9663fNt/6Sc6fqv1++itLbIBZXrn115P60i9MfVgmI",
+	"ud+m+qCy88v2A4GD7LFQX7gAowahq5+FakcMEl0f+76SiWowtD+pdMANQlx+XuMoLEBlx9yGogWOMZDa",
+	"Wmgm9/5Qs7seRR9TRgt6kJ7DjY3+Usp9nm/l2tVqBupvRl6J2hQpVdntbPnZdTR02SoNeSZiKJktSpI4",
+	"ZFvlvCYq3Fx1dLK7r9oAqtQkxDUaz13Pca+m8m2XO1EszHBycLJKkHsLWqTxLzN/kmRFtS3R42UrRY2N",
+	"s2Qgh7sai323lVXUYhI3WXIzH8rGzqWNt7tGVk1FCiUa8gl9zghwd4l5YgZwC/HYjUgSo72l19kks8GG",
+	"EpnrGCazwWihJVNQ5T5VoSVlNMnrxiYUl0fksq39S16cgFbN9CF1ntLmq5KUnctjo5xGV6wxCy3PtcnM",
+	"Dx3UeRxs60wwcw4pAy3eWD4N1gqSaZFLdIa8ZUwNDidPl8Wp9p0X2L2UVclqWIWKp3PlH0MAXkVBOgcJ",
+	"c+XHSeoG9AjFO71CofRx7bG37XO32v2sVstW4bWcV6Agt3pWW2D0GYy7TsCvlCnSQ13qazMhvQssVoIn",
+	"w64xrqnRboY83VERiiKjXxf+vo76kwFi0n3U2OtbAXCofxEC6xNhtpcY+PQVL0AzsuVOtGWzsKV/bs0S",
+	"5suhDOLS0gHnENZcHXPEdKcHiVALVYiva9KGDpqFQrR5NkEnPFF2dNBkpuaSFJhwC1Qy35a0YCPBOK1z",
+	"ZhjcIWxLwztUXkn3RISVmBJnqErDKywhL1MsVrFadd4iCDXmTbWsFv5hFjcLzRfMk/69AyfwL5nzfDZ0",
+	"zkzuYueh9BdDhzLMPMhZIvK+5Jah57N6t7EqJOKbOoe1SYdt3cZsCMqMaDDZCRP3iar7ehSPmaWkd+Oo",
+	"p9d+Iuwu5aKQmBrMbS4HMPdVMog9k/fwFWtRbFyfTHaqxwjfH5vraedXYWPuIMoGNHA8FrCEDRw8gn+H",
+	"P6cwI6YAGjiZwgV/TeMpfCOkD85BtkSLgYPvFNgHUwelYdbamDsI028EIMovgNBm8mJchOoUxlTv2BM/",
+	"Rr9jcRvANEYpXGrQuxkzFDkInR49dw43NgqZwORmFDGHpawxyBBaRRx6ys++GF2mryMpcx6O2CSSbtSU",
+	"om3ovAuDpQBDokRDhMCAQwg4DzMMIGwaCuQUmINqgfclkegT62TasmWKW7A5oZx8UXSu3St1Xx46Lh9r",
+	"Oa9k5ekgumZx1uE8VOGFhM8BlammdjO4N2oNnVI7XA1ex2EAfQbYwULQIqDTBaU6nAaMYBs6h8JUQG4J",
+	"ONl5iLmVsI+isoyQYLMtSBvQ2mTHc6QXAFV+s9CWHvRUReDcvfHncDfIXd7UCrTleY6biHSH6OtMxtDz",
+	"UF/P3m5hcv2OwPJgGms6PtUInXRjcdgNC6L2zzt4d/5z86GSRfyoaW3i4YQF7vINzGU0MY+DlCcsbuHL",
+	"eSBaipNQdbPe3+Z8ar3XtatLqEFWmk+Mro1lXLrmM1CqjV5gDwqSKFAAkX9hV74y1ocEpQputSYLM9yG",
+	"fQMV7juzdO6G2yiGMA8SEkHgCiHv8AUb+xN/jERGciIai2LlYxXucY5MgjNayJ9biqoj+f10dnasMvyO",
+	"MYrji19OXh389fmLvQ8D51SkanL+8qUzxZAKwsJoKRkv9qfA4iLOSvKjCTrHBFwhFikJmAknfEYHUAk1",
+	"cIufu/GyNLiD4w4d5yhxTn969/714Xn49t2ZI6y6FOOiAwaYtIKpzgYhm0AeLeCIoIBs8uv1fxe78gUb",
+	"TodwxqF8w67IdlcYVIP6DHQO2TQC5Rnb/m/AEXMMaH0xfPllm0x6kmyyjVQ4M1N3NLaWTBvPLXnQAndR",
+	"NhooNdHoJtroG79KUqWFsX6pLf0H3SY9m9d456zSPB21zue0EJ7byjhaSF6sUClGFDAOKs7kuBFiXQ17",
+	"2OEmqW286bYqPq9zVdWhMt1TtRk28IojAFxa8gOZic762EL+75aY08SSALCQ42tPP/zwh+e6yzv+8KJG",
+	"Z1e5m+QhJcFRk9cFLyk0rPFiqRCpbdm9xKjpS+lEdTkCzHRN39cjbA0wM2Xnc2yEtO2GajxO/TEb5Kk+",
+	"MPGqSkuq2bgrbx+Ubb/iLIalBIxPHidsarwVeuzKatebqrrTllTizYm9a8R/xTaYtjQOCtuJBFuXy8JM",
+	"LIA2bcTDU4AvsGpqV/UYsQEL2eT7QdxOExfz6qAPumjnpZzX2bzWvRLu9hbd5va26yIoVJDSNJNb2bNc",
+	"XX/420moabGl2apa7G17yV0mCsP5oDVZ44ioQGg4JcozrW+oV4F7q2b3qpa5bJnhy1BPql2Wr3L1kk81",
+	"q7L5H2IMpM/xrudZI8jkOmpa4OHpjZa2nMmZediYbB0/XniKQVtcico7qy2hBG8BuByStoVJSsjbWIES",
+	"Ne4rPzCRm63q0pyETvsM7e3sO6JYzFyOUnMk5DB3YWVtpUaBIb5jeJ75pDGmCFrxzmu72q6YYFiktxSJ",
+	"fuyXivISuyMvQ04DAtcSuWUgjTK3NNfmhO7qN65MbNcBvI6XaCae17iN6YCssCkNe7+JfW/a8w3v9+to",
+	"2hlG6PNDmMTLWlSoNvZM0AYiyO4kbdI65x3qFmgOD6GSXBdW0bUxmdacrVeDZGAUbLWLs6Xx0I76DhqP",
+	"ej/+9Knjubzx+okWwAypr3jS6VoQM3x8L/og227YedtBNlHdbmQWDlvShY56Q7s4Yj0UuOw+Im0lYt46",
+	"0G0QS32uarCZUQB2UqgmMw3RMZYeRGlmJ4ndkJPzsHqpNL7yZkUsi1OA6PLH8hFXvkLmc2FlyNALsocZ",
+	"hwbhaSAeLTH/AZd1GgVc2UvmDOaPAWVR7JAcsTw9T5R61VarklluRTqE4kpAM9kWqZ4Wrh9zYc/CCkUO",
+	"kl7MRIk17ItkgehKIkfm8jxHDLLtax+rlI2iVL6aK0zo0OfbGqg0Vrb6cC3FfOnyVPJrYUEgSMCjhG7o",
+	"MOMnqmAmzDid4hO1IwdQDgaq+uZ5qO8metqkC5sbgFb7skQjXC9eRM95Kt4UdhywGznvRCA6WRaZSxWP",
+	"9jF0Xa9shB2H5+EP5F6ML/hqxnx0LwqfJfKF3kbeFvA7BPbbRImQBupqVylMIBEgMO8G1+6SU8VSLOZ0",
+	"Beh2JwltBYHfDfh2N2ANzHTEmcVBWs/VJ9oViZlqKnAODEvlyUwyMXGnHf2/2yVeV4JOq9ZJPJ3VoxAs",
+	"JRgoZ4pC2c5iDoP8tpu9X0rcyFVI0BruwAo3myjOGWcqOor+KGDFgKC5T7UlA3d8idkE1Q9T8iQk32VR",
+	"axf+jqlXERnMpaAhPDJcgQ/pR+D/Tk9EcRSRTfq31E2SQnJCzSSvFWpdqxJa95fUmsRdFWVA+GDqDpni",
+	"QdSiFKicjoakDz6+kDebo+QIR1l7Iv94ypKWPc9E42peBjVgNl7NAo50cMsHtPykQv2xWKjD8aRSOTCx",
+	"nvICaIX8R7rkVHSd6ygOPDr20tD/LWXF8Rz4ECb+xGdx0WHI/y0cPt/dfbm9t4t8MExHaZik3+7ufcv+",
+	"MvJeui9GX3310ihZpJwoiS34VS0vm5u8Loqz8jH32yZtNLO9AeWrX8ZNtFO+URpnu68QThMw7W/PxqUY",
+	"zoJyuzUu7GaAW6B5Q8+pathV8FSDmg1gpAERm13/WSYQS3xLvyvOLSXofRAS6pvtvT2SUPKkHvL46luP",
+	"XT0P94YS3qFYxXCvu7xy70hiwd57qcl4XpNv3mZJpjt2nHbL3dec3T9kN92HlUiwvGHSt4tCtQVricqL",
+	"kvpvqlaZI7Gtl6vqoszepaT6OiqLGMiXZlqIGeq6nbe9Z62w/81b+ZnvymYxv4Z2oOC8LVN9lq9sDVO9",
+	"vswOh5COHNMxJ7+vc84VADMddPoc65vqT1Xmtkx4ixewPWkwfo692t+HT0N3wWdRYor6Z53dLKyOHioc",
+	"1xCQLAFwsMnaOXDlCVWsKc9trCOnXoN1FPZM+66Pfq+sowHSga518GsIZx3WKQBWg8JNsY4lBOAECxxz",
+	"BE2rfl50PlQBKjTCwBF+78/SxTP4L+aQwD8xShz+HML/NI/EFK1H2CSvYqonYkB7kDdaOtRM/JUaF9La",
+	"0ccK/ZyS/ciawap6dNo8c7OmbR0wCjNv7JVHjMrzBbUjIh0WE50uw7HKG9lGoT8rWSYB2VSRzeEwkMhT",
+	"AVvAXHO9EOW/WR2zINmqg2dDrijxrHHfav1ZNpHK0yAmG0iiS1PuRgScPlEOXWoq4U5YHKcLE16AO+aL",
+	"ZKkqMIgfnTFcsNCqP2JyHK9NrKsGm2ltZ1qm2zwDD8ZCYr5ZWxYeLZ1s7jacdaG0zCZxnac2LeSFe777",
+	"/KttvL59c7b7l29f7H67u/ufdrUlalKLvefM8IxrNGiaHIzbuRiJKrA2xyIE4YjurKb4Aze1eke7IouG",
+	"LVFQV1O9XgbImg4FPvCFa4luiN3riwysVhfcvIdakD6HFVsrqxG03YbzLxv1vuxwCoD2Z3oGsmFD8dsa",
+	"6kIOjAVVG7IlvefxmRKHVTCboRS9MySooeqhEs0EWLVQWRy/V1DWC7V0WpZGUGzUIX1Ky6AIslGgqr6Z",
+	"C0edaw67AkSuURUlU46kuBDRbVJOFK4e9TV99C3twgYlAqtwmfi+FqfpgBm5TZtjXY6jp7dxiuHGeFee",
+	"C0BHLvfH+/KYIcBIhcVf8w2aJQmlQB8xN2axai3+9Upt7D//fSatMWII+loe45PmriHD4bakxir8RxxR",
+	"PSNL1bn1crg3/Er4I7CQyqRsvRjuDne3tKpxO/D7jtgZrKYkTNPieRTTpgB2tn5kyT41EBH6IIqp/KEl",
+	"WW7eBHTn/05ZvKTOb5EQMe89qEzQlAsUPt/dlX7yicxS5i4WgS+yrez8KtOTiE1vLs0C09B2E6pKyTJ+",
+	"Rjy83N2zjZKBtYONqO2LNm1fYNuvxDLq22IjnZIIgxoN/fIBEwXrdAK/fFAeBL9I9vmAQ4hNgzY7oLRu",
+	"+9rOVa6NmLZC6rnYAbWlMfmaYMwHGd4DTDfhYdnqbNsxgQf+m8hfJJK4nvnjmUMiRHThjo95Zs5Dyo6Q",
+	"9dRGlI5Oo9QPErhWxHC1g4spXFXlh3HKk2gufnc8Ng4wi5jK7iFjWBwRQiE9+p2P2PhPf0Op9vePDmd6",
+	"Ookq0cJ6D9zwaFW6PWbx3Kfnc2sK52qnPAn3LVO7WlwNse+2IeDdboyxDgHjbyX6VQLNSL7IzGXKRSoA",
+	"jM8iL0vLkpOaSHJQQw5HwjfmVndFFAwy78qnemzAyACa8MGIuJGhkzTGfC0hu8bi8oxzdfslnvHxGMCL",
+	"bIr5ctBYhBBFsUyHcB7OqFwSMpmPiWIiZFX05ZNl3ZGVzoSzE11EpRGgMJN6okQLhCv+HoWZMUIuQbQ9",
+	"F2lhvJyt5TxFsBwBlWnfMAEKtj2RmOnKyug0xIl9i4icuzfFVeXptlSeGeEA+fzljHyqoM9vyN9KpwJ1",
+	"hrpfaNFGOY3kl++93blJWzPamdFuLqclUzM6VZKuJpJhEpxCAoOs9OcWuFS9EhM0ITc8zd66nNonTIkb",
+	"yx2Lq27n+Mvdl23avux25mPbF23avjCI14o0lYlVSBgIVtPpeKtewGRXvXsSL6gvHAlB8VFKio9Oxq4o",
+	"WqSdm3QO8reMnI8YHP9xQJbvgnC5xmS1bsAjtNn5IagMBUkjEJvpKBIG5kmNA/MGsfmIediJFvOMmOuZ",
+	"4C7UmuaYSUxV60p5fB6qJpdseR3FXp3IOpP78XgFlgBEnRWENYAG9DbcD6ASduMLT3GlzKHOaZNaaZYP",
+	"wADUJIoevBStQAOErkhXJ0iHyFaSa5moKRubtEuKTFLF03fowKjR3E8oQ17sfKR0EjBDhNnu3LByVMfE",
+	"0kxSqmmlcXa05mvNTNUI/8DwOmMiz+JCbPT5Ytfx3CWvB6aJSAWR3/U51p9gq5xgzReE/EgDNd1w+jQc",
+	"atezyJ37teYLaPbvWbQ/P7pN5b/wHrEBG8TmrlpS/u4Ix58dShlq1wL2RyLXJcOalKEmv2VQjwiLKVTG",
+	"oUP2hIkACUYlCrIwGpFUyxFJtaQQQPsCCEEqHWE7Q2X+D5EWlWC6zc0zVRt6NJz+cvfrNm2/Fm2/adP2",
+	"mzuze0nis5OzSN5qp+dXMrmrzHuqlBFFfOfhsSjLKgIBRWInRb1kmCLnNj6g5InyDFLtQEK5lywSVofz",
+	"kEoMq7imEVMlA2VyWjdcFvI3F+xtpLssAbL54DzU4LwW+S1lHubQnaK2mpN5O/YRKOj5p8A/j5knVEpj",
+	"O1e8z5IeW/kCo5iRbq08gcRP54MqD7FchUlUoUzFJhikpS5doriQCge0MY9gGMk9TgfmGThweUxDjPUK",
+	"8RqovCxAcz6HPaPUMI47hWlbsZnCac9oj5/R8txONq1TkkbmhLbSI8QPqDCJgtttuxzNARKgqW69fhYW",
+	"DX67zxZylqZnuvun2jumLnqRFUULihg5pIzr2VMXputHLVuZx6Qdiiurl3QgK76aYWTwsCq9cMKN0KiA",
+	"kXcgtve4iC4dTqn5bVLmQTQXZpWeLhul3s5E5h+zvDqTFdn6imt5nyuQIqX96rTb0Thhybbw5Szuel7t",
+	"xQ9dMjaVDUem/Ra1r5io3vbuzfZrlyfbbyIPA+Q8q1vOgsLGcYj/OT/3/nj5aRv/eK7+OBN/fFv444vz",
+	"8yH+bW/wzacv//Gff/yXGcKnKRVTw9l6nFqIhQz830fe8g7p5FOFSlvcy5+re/nnZkf4zNSzHXU+thFW",
+	"mEMCn7FztwL9dJUDD3HgFgIsU6dWPVNj/4rFnU5IEdXXwVVF4OAu9L1DNqHkC1F4P5rfPRPjbLQTR1l0",
+	"g9lIhVkpMGYBk/jg8yo+6NB1WQbi54dpltcEtcIYlEAaW1lhzyL57qqqZaAdHa/T0kEj7y1AUu+iA5qV",
+	"6iVlz7bCLAaHPZLN4Dzcdn5SvU+o82lKZvrB0Pe+u7m5MbSgFEX597o7dKnnbV6iS1OdyHke+kX6oUpf",
+	"9JZWxCveDCssQAl4VqD+fc+TL0L0qCCfRBUrZE5H6mkfpqSGlVf/OHuYprdffBeGr88w388ztBA9QwCf",
+	"CdeArHOVe7BV5sRE2Z+W4XgWR2GU5t2oWE/23AutyKNB5RErjiFYbOZi/iv4vkhHcAzN6L32DFNNie/w",
+	"F8rnxDxa3Xd/g3VSDsa/t+J5fcZ2fP5P6KGYuzTjwEWPiQvxK/3ifEF7AndSH3VhsVPZkqg5vcLrBsYv",
+	"1SxHIttdZZZsuMaZrtF5Q5WOK8ySTSKkUMsp0HMB24viQ/iQjTgSCdMLw5PG8GW9UPunSDxV0gGqMWyl",
+	"NQG6AG8VrFlezWUaztyjXTzbm17Os3m2Zae5H75m4RS5+3nrJ/XG29IpemJ6298vzRF7+qIolJEyJkrC",
+	"lbwp6bW/DLWWsSK7WY1319TnwpBOLTMZRJGT8+iKldjHmaN/VDzsKElf4+DNorQIw4qytDjInQjTwpTt",
+	"pClhxCZOBepLArUoQmUTsxClwTtKURpeZrE0iEwa8v5k5muZpa9RaKqXIn2C9UUkNt1Oom2B9Y2JyE5S",
+	"61auIXlqTONV+DCdL7LXQD25q4vpR6mqn1S/RP7Uejtelv5xpevvWxXL+k6lq+xyExYpavKut2o3Liz3",
+	"cYYeVUkqS4tQ8/6lMoN0JwIMn+my8fiifDe7rdZkM2NQDjeVlUPYoAZ5QuHQk/k5ntRrQkYrVfLZwTjE",
+	"nT+yyPVPO39gKOYn8dOnnYVe977jzfE9zwODDk7ekEodhpEsLKqlgdaKSfukHVDudvJAiNT5PxBBdKil",
+	"yKzQrjg9ZdrofCq7bMQTTtHPsVpYd/mIzJGJx3ZiEbv8jCGurVtr4ZptjOrdmMiICAMzHYiysOI4kjyl",
+	"eEkmhUbNdRJQzgyhrNFgVBdd1u7WNhoUF9ozWQl0WNEHPt3GUf5ouLfmBtKWn3MN5Fa5WWZWz1LYSNqR",
+	"CfVZSEnVXZVIvIlXV9ZkPn9OLaHAwKO4j8XcUz1XrXYm/hqNmiNhoVFG19BpOz9+5B4U3LI5qhznoZ4m",
+	"jO5grnImUQ7e6LNHDnDXPhxyKoAK6YfCuuBa60XXFn3/nwDSqmo+9EXd7XZ1Npik9zciqkMSKxDczh++",
+	"98lKdaez6NpxkeYGRBgU1EcH7wD+YAsgoCCacqrEHi+EUxJmxAoSO6msKEahJyVheWiE9RSjgTZOgJjF",
+	"YizyAJrVggP6jrFyUl5Bz6GzXwpCwV/Jjjii1/tBfsKfh/hJqvIUwI6pHdBB0hrBCXsrZu0JtndGWYW4",
+	"Q5ZcR/FlnYHkrWjCm0ydeima3Fo7cseXqMqqiSx2T5l8MaOPuwyalAt8xEl5FPIr+77jL1ps/dHxY9/7",
+	"o+OntfuyEGOTr5k0YwyyImCwmcJcKBKV4m7XBUgiCckH4W6n092ZSnEmtfdPSckmEihSxA4GSI1lmtJG",
+	"n+lFSuQwZ/MoXjqqq7r1EUlIfVwvMcixPp1yg5GGEnXx4/pFL7NugsbE3PFM+ctYaexAAf+waU2B2dOc",
+	"oLlSai3z3t52Qqx8kkd6AhgQj8fuzh/KteVT56h7gCRGDwIy1ZTD7I2XlWNWjpNfyVQJ891+msY+fvEu",
+	"7yQd6HMcwCg1d3D8zIWXB3e+0MJ8BxQ2y7wvVRBaIf0D2YtshIskJwiXhr8twm0KxtjdeuqHhYUmPNQo",
+	"0oJPWZ30OZTN193GDq4elJ8TLS23rV5I+Toes0UfJNiVjGK36P1dS0TUuD/C+iOsM521zASjzqhhgzKV",
+	"ZU3ppVkvzXIqW6R8tuNyWTXc5mstc3NSnH7oZYErqn6eeMHFQRzP52NMOrIcNuhIxzDxPhcVuZ8yST4h",
+	"MgPauFyXynCMbkR2iLP2NPZEaGxxOV2XxBbu+BLrFXeisuPLaU9kT4DI+NgNd7IUYar4Xy21ZVYEvRv8",
+	"A7A8PA8PsnRjDo4dwjZTNucs2bwqMEFJ96YqnTT8ypA2M5O541GQvRrRldPgUCpzsMgBhmZzIG3664S5",
+	"SQojOIAsdLsKCzY7SfPhVGYja2v+OIV1HOgo6hnjKTAG94k77AyBdCFcWqGtM4M2lIyCw/TjGT4JYWQ0",
+	"HvC8BY0dnB7hePdCW637/PT9fofWZ1RwvkOH1+/f9oR+54S+5DFb1D5/HAh9ItczRNB/1rNJoTjNprgz",
+	"6n4VxeP+ev/oiLVD6tS2hiQtL2hvSuppDWkt9fxkJ4imbTxCqDForWPKRSX9QOZpIhRNTHmi6qs4M5Cf",
+	"Qa4B44QDJwo8jI6f+DFPahw99nGa19FdXsr8sJ0Efe3P/eS2C6rB6k8Ix320QGs6Ll/rGp2b9Puc5tH0",
+	"WG51kpU2epW71ej1DOk90bcn+sbUz0QDq+bUXVGY5gmcB3eVXLrPFH3HZLmpNNHCttY2SfR9UHOfU/rJ",
+	"CtbW2aWrVEzl0bKkYjLBESVtisaucKenaIqB41Fqh5tl3SGuJxe+yyO8T2X9+MS2JY/1bdBZnwX7iQUe",
+	"dhCtm8uHjUM3yc41kmCvqjb0abM/t7TZbahX5HZRFlqYG2OY6p6RqYGeFoZu9KQQaMmBSR/gV2PSk6+i",
+	"QAuYAv0AdYexSEGk7vsylyVTCRjJqgDt6CRFXonSuFClSqQp4uQzsRSVX6H5eZjES/KkkHWx8kpZMnOi",
+	"jOzCVdge9g5pYXKpvef8XZGqsyNJqhvN8lmaeNF13VMvtHCwSZaD0U6eVOQsBOqKFsWkY+fhcYU4CwRa",
+	"LKIGROVH3qBIoECbQKAm4nQ5tMSEkJRWy4/z1Eoq8ZJcpQToGT8PVapR/LmelE8VirrS8qEqFtw+adKd",
+	"GNfEso79/vq3HusAldewjYEHVpLta0t2pPXEwDVpmPiBLEGY9b+Yxi78VzAg8ge7WWDKrAYWQVQ8ZHty",
+	"T/Jrkjy+QtmJ/Uyk+6LMNvQoGGmWkPrMk2Jn6JVrE+p4NUuFAChgV5Qzx5SPQn3Tki6H6RxRRVGF8OXa",
+	"jUWZeopK9tgoRZNjgqyC+QfLN9GBLVtG9rbE05F4suGUMVCu3gQctLQUm8ffvDRg8VYLCBYxY/NFMZBX",
+	"YMafUAFgPwvGH1ogkUMUoPHYxE0DoIiJG3CWwTGKooC54W1loX5CtUxWYVYv5DteOl/UZ9TW65Ucvj11",
+	"fgfYHSlsLdZHwavQGAd42PL+7el/4KdH7OLWlSgo+39tIkcW6vJalAvICx6If5L64XuYgJEUEPH27cv8",
+	"jVTYQOwXqBkgPlAhAVEqijX8W6UG+UiG3h9wwO2jw4+OsAKresgfOXpffHQyyqFLBaZKSzH9KkwqQZnj",
+	"g3vMxiTK6K+LwF0iUKJes7AVXbF8JXaaJmDuwh7U5U4gvUuaGxL0r6iwQ+vmJ4St1s0P0Me9dWvc4E7e",
+	"tdXc+F2ddZqlScJuEsEGRgNznTgRi7LbujqWmcnee5Q6kOUIpuJpMdXUQKuQWtGwf6/oLPBmI/VF96Js",
+	"baOTWzIbOR9xgI+o0n5Uk3ys12bzMm4bsoK1tR9kE/fGs3ugLe5P6+xo8NVxtTqHGB7Rjoywa09DT4OG",
+	"6qXT6eZk02kvmZ4QVTWaKjdEUxuwA/Yk9TmQ1LW/qAlF+Td8XfGww649DT0yGgrIvsDiTajkaqwVBNVr",
+	"2fWu9XI1b09l90ZlXRSrDVDYaU9fT42+2qpYG6GuO9SzeuK6P+IKoim6SCZxFNTnKSzSx+toeiB73SOV",
+	"bL7uV74uGtZgjD3F6JIKpwEaxQuwYK9WdcB6il6bojsS7+aI9t7Ij/LmSeJTNNcT3F0RnKykJI5fjNOq",
+	"HsQ/+9KJUe6S7GI6dkWol/QckiPfiofKwvfUQ5CqBZVEziVAanXF8L2CG4aPniRaJRV055rSY6D6xY1j",
+	"fO0zHN+3FRDxiJwuBuZH8x9ZYiAlvUR57ZvzrdJUjbfRhB6KdXKjYPCNOh/d+qvvCbry3aYHidydpgCH",
+	"z1pqxiNvxw0wBlGsyuIc0qn67VQxBQwuUx44cz/EEp/kCCJqCmHgDvwtiJnrLR0JQ57goKH0y+HJ94f7",
+	"OdwP2hGpCOpGvE/vMPylpraynaQqeQjWIKcJS8YzZxJHc8y8RUViBWlVo8ShlTud273XFOXcWcg4TnYi",
+	"s3/cDaXJpfU+zlbqHWyiwrdKONuaIrEx+fkHQV0+xIdAnZu/NVVXdyJmMdHpYRMm8fTIzyzH94Z9Ye9b",
+	"F+chqGk15Qu68I7LLxXfYK0e1/mIk7je3JHzfIS/zOe4zewGFivq5DaxDAF4HzzTsQ/MZc5993jD0h86",
+	"eS9if44JBW6bvOU83cn7WAL4MBSWnlDvi1Bhyij07oJUs5m6E+tpBmRPrk+YXPHab0/moQxnItxENrbd",
+	"2ETmjAd9xycQ+4xwrdNmqJBn2LBkJp46+cIdw5c/Lv3Q+yRfP+syGR7JIe7sffOtArJ9l59hLd0muF06",
+	"VTg7StjcRKloussyJ4g72CCrl0kp+6go+FN8hsrQsoOx+2ggr/x+Re+W1d/Hk6nxd4DI+HvK4w3xj3JN",
+	"GUVRze3t+0jmootGv2KmezW4OXm4oiGRXRv7PjYObP0EsR8Ep4F71SkZ5BuXJx3zQHUvVUFvI+1n6LqG",
+	"03TEO9W2OHOnXVpHdyMF+/zwa4m6zYqo/LneLKRkHtkVxZTo/WQF1R0VXegZ69Z0CJuuYNMtQm77snnt",
+	"okNx2xVY9w5r3T5dHaOzBtALlCd7Ui+mO+kC8zHX1CGi7wV/tmkcpQtM+Y7VKjjZG1cUCMc/iuF7kdBf",
+	"O1pcO3r59CDkk10huUPJhQV7uHR0M0uuY9XEKJ2cf4vkpiB9LvBhJU9RhNnT0Cw+oAeX/Akmm5J5MjEj",
+	"NBT5Hb1Wwi4DuZd2HZJti+JJJ1EQjNzx5S2WTnxNWX+emiBGQn4HdN7bjHpJf6/yvDFufOpTekN8u4A1",
+	"Y2ItEuwZWFS92GMyHy5XGc4J+ku2tPnqlYT0yd0G+/YimvWqby89e+m5tvSsi1g/jKOFFJq051xKURSp",
+	"sfxlxoJCSVeUmSqEwyhj2wvUO4xv7+VpL097edrL0zXlacpnO6rW7w5liq9RTCfQcibLYIpYElFCOBAB",
+	"kSbzQ15IWA8wbSNOATLlJnkkMtj376APij17lluJ5WAVft3L449xdE2cBFOnc0Y56ODfcZRORSLyRRQF",
+	"0v904KT5q4Vsj6NnteUGxK1TNSQGJamYahllLXtptYcCP2z1pnEiFvJk+RIX378+fr4sfyus3b6U3gqv",
+	"iHedALC/Y/R3jP6O0d8x1pSKac3b5UlqfLV0EpdfthKJaf/K2IXBKZQ9nnfpEXcq6NtLzVuRmq0b/xBe",
+	"8V7IPjkh264iLlWTXVH5XLmg7FMWt7007HXIXrxtQLyF7oLPomRnHLNaL94D+u64juphs3N5buK2Enty",
+	"HDHw07V2STT0QTy91cvGmy3D4TbPm089Uq7nzZ43G3gzVvZPuyVGtrBypMgdqEYcOme6v8w85YkzYg66",
+	"5yzauYkrss1Msz3/9vzb82/3+j+rXuj7t6T+Lam3A/Ry73OyA6DMwh7eaLmCWIS/OLK3M8ds7W3F5Kmc",
+	"speWvbTspWUvLT8baYmZ7xo9ek2SUvRtKSBxlt4/t2ewp8dgjeUzV76c9bFED+ul9U10xTp5YvRKRi8D",
+	"n4IMXIZj6DNlvMZQdUTf82CgKzcWwQjw05j5V3madxz1Sg/EhOFVlIOYsZX4hF5izl4vuT3x0+c26gVE",
+	"s4BIw6Zki+9li1WVJdW/V5j6hIs90z8Qpm+RuOx93uiBpC7TIOqFSZ+CbPMZxfobWy+b7002jwOAp8Z3",
+	"Fj87buiwOI5i54vzLRGtOnHhpuadb1ECXFlM+0t8SitkzFElV0jsNqXMoameSBWcns5vpRJNTXLW269R",
+	"I+oM7aAJw1ov7IQlaVxQbIwVYqO5o+YfOkeT7B+ouYSyyA0Wjg3oy8DxItRybpaWetEZh9FcrxDAJ11s",
+	"KhonLNnmSczcefHcEuloYNKRH4rSf8lyAdsJUi/2Q1s96sHWjHQXmvrdm+3XoJhsv4k8f+JjJV9tWDRZ",
+	"bSf+XGxAghoq/Po/5+feHy8/beMfz9UfZ+KPbwt/fHF+PsS/7Q2++fTlP/7zj/8yQ9iLks+hqBVQJ48C",
+	"1uSz4jp8xoJAHa5I064fwn0ns5yKspYwBgiIJMsL4zppHMC/XBAycICPQOQsWCisqq4ziqNrDoOIeplJ",
+	"stzmM8DyR2cc+JbK8/phrdIwHcg1PNWLUbfrwY8xY8kZsH+UJp3uLW5iDODdswU7eUWZ9FoWGce/P1Bx",
+	"8SALhlZFy+ZYXzDxThBNrdrCKeX5zRke2vLGE160fR1Ne56sbw0oehUFQXTdsvFrwGurMPqE3SQ77Ar+",
+	"ZdQx6u7GNE1fffW+LsPNzBiy6xZsCNv4BJ2fkKH8oKVJDhrDabjoGbVXwe9RBc/SnNbe2u3F6MV9nmeK",
+	"OeBWBbDG7LeUcVDFxKXe5fJXgXdnFHnLgXPtJ7MsC+P//f/+f+4AGbsUWfcFGs0wQSqa1cZB6sFI8gqQ",
+	"DSJVPIy787mTiSM0E4i3ERbj1RN7CqD4go3pViqAQuxg4ysWi18BSnGRELeEsJqztcHEoO4Fj9HI0F4B",
+	"0ZCwRlfSYx6WZeNHrH5muEUM7t3sQRAcs3hug+493HYf8P3nISpVg62bbSX3tr7Ft9UNS12VXLrJVlpI",
+	"GO0kGEKixGz5fbideHqM2aNv881Ox1uv99zfBQX3w0vbPTCotuvwy6mar+eV1ryicPbw+eQzsbndNk/J",
+	"VAuteEq25bVpahp4So3RM1UHppJI65nqYeWkIM+O7PasnrGKl4aYBS468G/jWCYVvO6hiTyqHu2TNZkI",
+	"vo+85R3e6T5V2LQFwT4XBPu5MdjL3W/atP3mkZ9wGI6DJ9OaFi7hPStPvgBu+yISh8xVgj4dEfSThe6c",
+	"h+rqNnC4KA2EZYJYTE/S8A0PTzIzwdxxio6AThK7IZ+wuOkYhakPcU1PNphHImAFM1HHs1dORKK4P3xv",
+	"ld8HFlcQO4Me+wvGdYszmZaxcVS0nRCvCv6UUXNUCQhZVLD4NXrWCz4XvMwzdhaZ3cTvHFk4UZW+OFBl",
+	"zuUO4CGXAYBNx0UHFjT9NXmU9PwsEbC2GLgDpQIn8WO08pJV8A6ETy937k/PWPdZHHnzjp7EH9gbdHNb",
+	"XOADeKz+zA2xTRQ8d/HwDJHkBQmrrMRFbLxmriysTnyi9RIHIP0KxyKGr0a/M3GAamZVoP+s87V88V3A",
+	"4kG3heNw5joBXYxRhcaDcgqX4tDBRFimw1FkMEbif6NBvy4LfVjr9ndb0vPrNm2/frA3uhrF7QdU2lqQ",
+	"lBej355HBCWIC6gs8QNBUVo3bBywSeJQ4M0CTmE+dFCRgsNZpOOlId0AZATpgeeh3lukTRCkqf8OreFc",
+	"Vq7Efry06Wu3QpBtVJZueoMJ1DtWXYzTP1wN5vPnwfojgIH+OuZWReYYA2iQgbDLM+6oDg4LvUUEuzhA",
+	"nxkgYeQz9Q1gpHtO5u0TQ8+T7/cPnGnswhqBiX6kO1kc4ROcuF5ldhpyvg+C/AeOv4xYIqOZeTqZ+GNf",
+	"3rbcMTTg6gFPQgATnESRHB+jgxg2Ar1d6+G5bA5T5z1sOtobiaLNnzFGZWYRAHMU2aml/fJJPTI3EfYC",
+	"UWWjapdfivMAyAEbEr2NgxRJlT7U0cMxjny/Cke/z9k+r//2j2PVbPfGHvv71/WHRTh8tjMDdeiSLXkr",
+	"4uEzZ5GOYIMc7IaFw7my3i+YtN2D8sQpEBVt/o6fcOcyjK7DC+zBybu0jtJOf/pJAdQfNp8bLcG2dSQj",
+	"6OF4bOLL6EOSQ/AZfzbTlZ8oqnIB0Cj2f2feBdFhM2X9zJY9UX12REX7Tj4EqYGszpS0KVEV+eEQ7Vh1",
+	"meNUEQYN8igNKA9nJ5egWM53PJ9fWkXEv3x2TVtJrWx8TAMdihYPVxtBAHtNpCt5TFUUQT19iGa1BPKj",
+	"bPJwKYQg7EmkK4nM3Ni7hnGaqUS15PWU8pMa8CETiwKyp5eu9OIvXM+DmflGxMrR8b4c7SFTSwZlTy5d",
+	"yWXhji/daQvpohrWkstx1ujhEouEsSeVzqQS484nyxa0olrWE0ve6gFTiwSyJ5eu5MLdEHbKT3w3ieJm",
+	"msmb1hLN6f7bI63lAzbP7r/FyTJgewJahYBUoEQ97SRuDA14I+XghnwORNPTSldaSWVMez2dYKsGKqHg",
+	"+IdMIghgTx8m+hD+AFYqQKTRo6/0UMvSCIo3YIsp/Z1o3JkkkCDe0dRucLsEISDsSYJIQtJAmShk4n17",
+	"gsl3MWCTJzEV0gllVIBTyLzPnbmbjGfkOuBwFsBPUXwesptFLLKpDzBpDHyCFgFzRtjY+TUaCe82NQZ6",
+	"O0b5ZPgKdB5eY95zNOBjDAFoz36Eax84zIUh8CP8xye3NkwyLX0pr/wo5RRZkEQARihDE7J5sXhQFHtZ",
+	"tp00oIw8NKaAhqImjkJkiu0o3g6jhFY3p0y6STYW1nHjChcTPwY2Ev3PQ0x+ncas4v2ZOd0BIoVbEK47",
+	"joIAVwwXMBDFMJVw6fPDK+CYrIBREV2Z815W/yiSRQ/OwxEDdDANUpwkFD5AaiJa436onIVy1OM42BaD",
+	"sDzHnaAbCCJzFqXkcuSOojgRbogY1YGoFGmBRMJvm1OglBcipfvW7Xn0Fee5Y3++f0ajXtZYZU2tzqo9",
+	"Cwd4IAFHStLEbpqMERGCQso4uZBRrtJLaZRJZnndj9ojTLjXr3KM3cXxJZ3/H6Vbfh2dFGJV+dVYBap6",
+	"ojy0/cCS9aOJCq5n6PMIvdFpkkdzcnNClwEVjGapVHt6NZbDrKrxdg8cu9Wswpuqudb75VmIuJL7twUp",
+	"w0WtlpJ/CDdByGKUno57Ot4oHRfSB2iHuuWQvTv6e2hpacT6jxI2f9SneBY+mv1T5HLN/ilSuOaNWaFx",
+	"MWFrK6JTNePoPqJLUdvdQ1aTouZPlxz1ex6IyPSh19XqlsvlM45QWo2PVD2jW2CsPAS2HWeJaNSetXrW",
+	"6lmrgbWqpY3rWevVWoWKe9bqWes+WGtF5kBDHlqo27PHj6pHzyA9gzxkBlmRI4xFsetZ4njdgtQ9T/Q8",
+	"8RkdGos0nrJ2NeMzmyk9zopbjvZGOzwPMWZHpFnRLKzOLAo8kb3Z+Z6hD/7A0erVgzqYukGwlAOK12WZ",
+	"6/kYgJPP41djx4uYqNFKMKsc0dnLbsqz3JjYvP49VTI7Lb5n9J7RHz+jA3BY87T9SXgiOzx89rhNh4gi",
+	"Lu7YLaJn0MesnXbkx9PPhBt7Xuh5YQVeiBZdWCFa9JzQc8Kj5IRrPxnPOvCCaN9raRkqeiWtZ8eNsSPm",
+	"Iy6/ORU3dh9zV2MypWgOJDAmd+7oSqQiRrMFFaL9qLnofzdzPw7PQ9EPrRW/pVGczuFylzDpj4/pJkVG",
+	"ubzVPAp9dN2VLutUUuCj/PE7JPKPuoUmZo7HprHrycS35MUubjHo19bGOvJeLb0/aXvWfvwGEs0muYo9",
+	"9JKxhV4GEghw4k9TQTu3YBvVIDGYSPVBNmAo1SbrpUEvDR6zNBB82+yZeyDaPWhuaO3u/cOVG6RUgqp1",
+	"l6M54AX4p1uvn9nyGmMIb5dT5Sx9COute3FR0XlxXS2FE4nnQeiEP3A81jhL6ADEPy8lHag4TNOhKRKS",
+	"Vs8mnPAR8qDAGO/Q4z2itEuHU2p+m5x3EM3nfpI8ppPxiXlaChbM6s42xZxaGRdLrcyB35eyZg/egl1Q",
+	"ZBdBtAT9M6uS7ryOosssDN0wjtRgg2jsBmIsChkfOkeT8oeZi9pvXoKrUJh9ANoxJpK6WdaGtQqZsk5Z",
+	"3Ieo6W660u2gVIj4tcuT7TeR5098tLlpw2JNnG2sUkjoxPobOMT/nJ97f7z8tI1/PFd/nIk/vi388cX5",
+	"+RD/tjf45tOX//jPP/7LDGF/mm/mNG+wOX9W3NGXgH5iJaBvmTdSE2ukPWf0nPGkOWMl/VJdALvkNeHp",
+	"YiFy6OjXR5U6qEmly0wPj+S6GPtX7WqxZpc/mdqndQ+RbuxOTDWHWMLEx/73Y7R5YkyIbwhNnOdiUfJ0",
+	"DH8HhlMsiHVl0IaD9kKmKubx2gvVOpXHHxjPAbESSLdc+QIQ9jPWb/r0RG82axke92W+vO0kdkMuH8sB",
+	"XtRVRA6+ieN63sAZz9xwSoUiZShDRr9Zbjqg9m2idExZF9O/zYVwcpPkw6f223LGQRzopNvsg/O56X+3",
+	"80L2cq8NDHsPlA+7nzuqyJmtUrh8OXDprCEjooEV7VW9czZco1rZwzx3+oxMt3GONOhAIvkq0qIgPzfL",
+	"f0rgOiMQoo36z5MgxVuzP39eBoCHqzAZPZoOYEtI3IbsmsjcD9sK3Nws/Jhp/A5sZY9MUfqsFZqBuUzm",
+	"gbgtkC8dcQVeI0KH3cDFGP3vOnJO2jNOzziPi3FWuwnw+vIKkp94B94qK1786XqsSgwok2rvfHPnZK48",
+	"vWHLJ1Gbtw7VwcEODsVq0FGTlRnJvFvqra4ncpwjnPfJMoCOhYfvDfpZeaV15QTcEi9t51Sm2hbpX3M3",
+	"a8cDp2rKJ0v/CgM97d8r7SfRJQvrbJ4n7Aqa4CUc2nsOjOxQHyJ9PHhUjiWqZRNDa0EOjs+xlowgD+hI",
+	"XpaiMJnqAgNl3CMrU5GzJG8yoZ4R0I/jYkJruYtSajHN1JS1vGc3O7u1MctS5SbFI7zMJIOMDfzYCVy4",
+	"RaQcjhJ/zs4B1LzWTZEbiLME08nH7az4E01qrtCUnTW070/4qqFIvz9p1if9JkMtnRFBFE63A/+qcFyg",
+	"G331vKBv5+E0dkNZME4dEfKnQo0oabiBceMokMGtfAxkT5XPSlyChc2iMFhmTCKYy+dqmKGzL9uLowqh",
+	"EmXPeIRV3OBmnzjUHE9Ab+AwqkYlCrFhpRZ2s/DjZb35+ZEeVc19TnCHqGGRVoQSDeiSu0Qhv6AIjAUN",
+	"JdkmEgGAJHT9OeDPx76/IRTwDyQzNLfhMPDPXAywG3e+QEV+K+R7BrucEZqMZnwPWMTxZIjHAGjvxp9j",
+	"9gMC9MVfvtr1LJCoPhZgvqGOZWDuQuY9pijgh+q3WjK+LFgIf9ZF6Z5eu9Mpld5da/8lPcmSaw+7Eo1C",
+	"2iIdwYo0dC0iuLTU4OoYvq+iu5DAws4dZRxVR5alCG9XLUHgmlSSz9jVgTa2uM87cEFN56xpu/9FrTaw",
+	"6be9ewLQp7OHMQvc5Q5sDXentbt4gg3fyHZdt5E6v5VFz1tpGtjhQNyYjg5b98Di4uEdmHk1VDxOKiGy",
+	"aAjRK1HEbaVca8I2AojXFIzIxWc+jPcXwcAOrcKZwSoTWGqy1TJPW9ML7u6T8m9TpFCUGFjCOrW/poLA",
+	"cKRQ4cqgTh2L+YAElB7eBWUCsjMKsZ76IfA25xirkd0mJgxrZONDFT7V+7IoN909sOw4/iXbaprGYkEh",
+	"gjoV8K8kyHhreXTC5lFyF9JILOcRH1tVKhRPbfVHlsx7tVLZaBGO5AZtNhuPti7tT3wvb34XL5I2ygDU",
+	"5W/AIlZukKf+Q1OM4JOnJfAkaX1AJ4b/Bw==",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
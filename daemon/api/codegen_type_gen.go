@@ -13,6 +13,7 @@ import (
 	"github.com/opensvc/om3/v3/core/instance"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/nodesinfo"
+	"github.com/opensvc/om3/v3/core/placement"
 	"github.com/opensvc/om3/v3/core/resource"
 )
 
@@ -36,6 +37,21 @@ func (e ArrayListKind) Valid() bool {
 	}
 }
 
+// Defines values for AuditRecordListKind.
+const (
+	AuditRecordListKindAuditRecordList AuditRecordListKind = "AuditRecordList"
+)
+
+// Valid indicates whether the value is a known member of the AuditRecordListKind enum.
+func (e AuditRecordListKind) Valid() bool {
+	switch e {
+	case AuditRecordListKindAuditRecordList:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for AuthInfoMethods.
 const (
 	Basic   AuthInfoMethods = "basic"
@@ -285,6 +301,72 @@ func (e InstanceListKind) Valid() bool {
 	}
 }
 
+// Defines values for JobKind.
+const (
+	Action        JobKind = "action"
+	Batch         JobKind = "batch"
+	Orchestration JobKind = "orchestration"
+)
+
+// Valid indicates whether the value is a known member of the JobKind enum.
+func (e JobKind) Valid() bool {
+	switch e {
+	case Action:
+		return true
+	case Batch:
+		return true
+	case Orchestration:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for JobState.
+const (
+	Aborted   JobState = "aborted"
+	Cancelled JobState = "cancelled"
+	Failed    JobState = "failed"
+	Refused   JobState = "refused"
+	Running   JobState = "running"
+	Succeeded JobState = "succeeded"
+)
+
+// Valid indicates whether the value is a known member of the JobState enum.
+func (e JobState) Valid() bool {
+	switch e {
+	case Aborted:
+		return true
+	case Cancelled:
+		return true
+	case Failed:
+		return true
+	case Refused:
+		return true
+	case Running:
+		return true
+	case Succeeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for JobListKind.
+const (
+	JobListKindJobList JobListKind = "JobList"
+)
+
+// Valid indicates whether the value is a known member of the JobListKind enum.
+func (e JobListKind) Valid() bool {
+	switch e {
+	case JobListKindJobList:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for KeywordDefinitionListKind.
 const (
 	KeywordDefinitionListKindKeywordDefinitionList KeywordDefinitionListKind = "KeywordDefinitionList"
@@ -345,6 +427,36 @@ func (e NetworkListKind) Valid() bool {
 	}
 }
 
+// Defines values for NodeCapacityItemKind.
+const (
+	NodeCapacityItemKindNodeCapacityItem NodeCapacityItemKind = "NodeCapacityItem"
+)
+
+// Valid indicates whether the value is a known member of the NodeCapacityItemKind enum.
+func (e NodeCapacityItemKind) Valid() bool {
+	switch e {
+	case NodeCapacityItemKindNodeCapacityItem:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for NodeCapacityListKind.
+const (
+	NodeCapacityListKindNodeCapacityList NodeCapacityListKind = "NodeCapacityList"
+)
+
+// Valid indicates whether the value is a known member of the NodeCapacityListKind enum.
+func (e NodeCapacityListKind) Valid() bool {
+	switch e {
+	case NodeCapacityListKindNodeCapacityList:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for NodeItemKind.
 const (
 	NodeItemKindNodeItem NodeItemKind = "NodeItem"
@@ -513,6 +625,7 @@ const (
 	Score      PlacementPolicy = "score"
 	Shift      PlacementPolicy = "shift"
 	Spread     PlacementPolicy = "spread"
+	Weighted   PlacementPolicy = "weighted"
 )
 
 // Valid indicates whether the value is a known member of the PlacementPolicy enum.
@@ -532,6 +645,8 @@ func (e PlacementPolicy) Valid() bool {
 		return true
 	case Spread:
 		return true
+	case Weighted:
+		return true
 	default:
 		return false
 	}
@@ -822,6 +937,36 @@ func (e ScheduleListKind) Valid() bool {
 	}
 }
 
+// Defines values for SnapshotItemKind.
+const (
+	SnapshotItemKindSnapshotItem SnapshotItemKind = "SnapshotItem"
+)
+
+// Valid indicates whether the value is a known member of the SnapshotItemKind enum.
+func (e SnapshotItemKind) Valid() bool {
+	switch e {
+	case SnapshotItemKindSnapshotItem:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SnapshotListKind.
+const (
+	SnapshotListKindSnapshotList SnapshotListKind = "SnapshotList"
+)
+
+// Valid indicates whether the value is a known member of the SnapshotListKind enum.
+func (e SnapshotListKind) Valid() bool {
+	switch e {
+	case SnapshotListKindSnapshotList:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Status.
 const (
 	StatusDown      Status = "down"
@@ -903,6 +1048,21 @@ func (e UserListKind) Valid() bool {
 	}
 }
 
+// Defines values for UsrTokenListKind.
+const (
+	UsrTokenListKindUsrTokenList UsrTokenListKind = "UsrTokenList"
+)
+
+// Valid indicates whether the value is a known member of the UsrTokenListKind enum.
+func (e UsrTokenListKind) Valid() bool {
+	switch e {
+	case UsrTokenListKindUsrTokenList:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for PostDaemonAuditParamsLevel.
 const (
 	Debug PostDaemonAuditParamsLevel = "debug"
@@ -956,12 +1116,52 @@ type ArrayList struct {
 // ArrayListKind defines model for ArrayList.Kind.
 type ArrayListKind string
 
+// AuditRecord defines model for AuditRecord.
+type AuditRecord struct {
+	At          time.Time            `json:"at"`
+	Grant       []string             `json:"grant"`
+	Method      string               `json:"method"`
+	Node        string               `json:"node"`
+	Object      *string              `json:"object,omitempty"`
+	OperationId string               `json:"operation_id"`
+	Params      *map[string][]string `json:"params,omitempty"`
+	RequestId   string               `json:"request_id"`
+	SourceIp    string               `json:"source_ip"`
+	Status      int                  `json:"status"`
+	Strategy    string               `json:"strategy"`
+	Url         string               `json:"url"`
+	User        string               `json:"user"`
+}
+
+// AuditRecordItems defines model for AuditRecordItems.
+type AuditRecordItems = []AuditRecord
+
+// AuditRecordList defines model for AuditRecordList.
+type AuditRecordList struct {
+	Items AuditRecordItems    `json:"items"`
+	Kind  AuditRecordListKind `json:"kind"`
+}
+
+// AuditRecordListKind defines model for AuditRecordList.Kind.
+type AuditRecordListKind string
+
 // AuthAccessToken defines model for AuthAccessToken.
 type AuthAccessToken struct {
 	AccessExpiredAt time.Time `json:"access_expired_at"`
 	AccessToken     string    `json:"access_token"`
 }
 
+// AuthCanI defines model for AuthCanI.
+type AuthCanI struct {
+	Action  string `json:"action"`
+	Allowed bool   `json:"allowed"`
+
+	// Grant The user grant allowing the operation.
+	Grant  *string `json:"grant,omitempty"`
+	Path   string  `json:"path"`
+	Reason string  `json:"reason"`
+}
+
 // AuthInfo defines model for AuthInfo.
 type AuthInfo struct {
 	Methods []AuthInfoMethods `json:"methods"`
@@ -1327,6 +1527,75 @@ type InstanceMonitor = instance.Monitor
 // InstanceStatus defines model for InstanceStatus.
 type InstanceStatus = instance.Status
 
+// Job defines model for Job.
+type Job struct {
+	Action    string     `json:"action"`
+	CreatedAt time.Time  `json:"created_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+
+	// ID The orchestration id of an orchestration job, the session id of
+	// an action job.
+	ID   openapi_types.UUID `json:"id"`
+	Kind JobKind            `json:"kind"`
+	Log  []string           `json:"log"`
+
+	// Node The node hosting the job record.
+	Node string `json:"node"`
+
+	// Objects The per-object results of a batch job.
+	Objects *[]JobObject `json:"objects,omitempty"`
+
+	// Path The object path, empty for a node action.
+	Path      string    `json:"path"`
+	Result    *string   `json:"result,omitempty"`
+	State     JobState  `json:"state"`
+	Steps     []JobStep `json:"steps"`
+	UpdatedAt time.Time `json:"updated_at"`
+	User      string    `json:"user"`
+}
+
+// JobKind defines model for Job.Kind.
+type JobKind string
+
+// JobState defines model for Job.State.
+type JobState string
+
+// JobItems defines model for JobItems.
+type JobItems = []Job
+
+// JobList defines model for JobList.
+type JobList struct {
+	Items JobItems    `json:"items"`
+	Kind  JobListKind `json:"kind"`
+}
+
+// JobListKind defines model for JobList.Kind.
+type JobListKind string
+
+// JobObject defines model for JobObject.
+type JobObject struct {
+	// Node The node the object orchestration was submitted to.
+	Node            *string             `json:"node,omitempty"`
+	OrchestrationID *openapi_types.UUID `json:"orchestration_id,omitempty"`
+	Path            string              `json:"path"`
+	Priority        int                 `json:"priority"`
+	Result          *string             `json:"result,omitempty"`
+
+	// State One of pending, running, succeeded, failed, refused, aborted,
+	// cancelled, skipped or rolledback.
+	State string `json:"state"`
+}
+
+// JobStep defines model for JobStep.
+type JobStep struct {
+	At   time.Time `json:"at"`
+	Node string    `json:"node"`
+
+	// Rid The resource id of a resource step, empty for an instance step.
+	Rid   *string `json:"rid,omitempty"`
+	State string  `json:"state"`
+}
+
 // KeywordDefinitionItem defines model for KeywordDefinitionItem.
 type KeywordDefinitionItem struct {
 	Aliases       []string `json:"aliases"`
@@ -1456,6 +1725,38 @@ type NodeActionAccepted struct {
 	SessionID openapi_types.UUID `json:"session_id"`
 }
 
+// NodeCapacity defines model for NodeCapacity.
+type NodeCapacity struct {
+	Free NodeResources `json:"free"`
+
+	// Instances the paths of the objects with an instance accounted on the node
+	Instances []string      `json:"instances"`
+	Requested NodeResources `json:"requested"`
+	Total     NodeResources `json:"total"`
+}
+
+// NodeCapacityItem defines model for NodeCapacityItem.
+type NodeCapacityItem struct {
+	Data NodeCapacity         `json:"data"`
+	Kind NodeCapacityItemKind `json:"kind"`
+	Meta NodeMeta             `json:"meta"`
+}
+
+// NodeCapacityItemKind defines model for NodeCapacityItem.Kind.
+type NodeCapacityItemKind string
+
+// NodeCapacityItems defines model for NodeCapacityItems.
+type NodeCapacityItems = []NodeCapacityItem
+
+// NodeCapacityList defines model for NodeCapacityList.
+type NodeCapacityList struct {
+	Items NodeCapacityItems    `json:"items"`
+	Kind  NodeCapacityListKind `json:"kind"`
+}
+
+// NodeCapacityListKind defines model for NodeCapacityList.Kind.
+type NodeCapacityListKind string
+
 // NodeConfig defines model for NodeConfig.
 type NodeConfig struct {
 	Collector              *NodeConfigCollector `json:"collector,omitempty"`
@@ -1483,9 +1784,14 @@ type NodeConfigCollector struct {
 
 // NodeConfigHook defines model for NodeConfigHook.
 type NodeConfigHook struct {
-	Command []string `json:"command"`
-	Events  []string `json:"events"`
-	Name    string   `json:"name"`
+	Command []string      `json:"command"`
+	Events  []string      `json:"events"`
+	Filters []string      `json:"filters"`
+	Name    string        `json:"name"`
+	Retries int           `json:"retries"`
+	Timeout time.Duration `json:"timeout"`
+	Type    string        `json:"type"`
+	Url     string        `json:"url"`
 }
 
 // NodeInfo defines model for NodeInfo.
@@ -1532,6 +1838,14 @@ type NodeList struct {
 // NodeListKind defines model for NodeList.Kind.
 type NodeListKind string
 
+// NodeMaintenance defines model for NodeMaintenance.
+type NodeMaintenance struct {
+	// ExpireAt the zero time if the maintenance never expires.
+	ExpireAt time.Time `json:"expire_at"`
+	Reason   string    `json:"reason"`
+	Since    time.Time `json:"since"`
+}
+
 // NodeMeta defines model for NodeMeta.
 type NodeMeta struct {
 	Node string `json:"node"`
@@ -1551,6 +1865,15 @@ type NodeMonitor struct {
 	UpdatedAt             time.Time `json:"updated_at"`
 }
 
+// NodeResources defines model for NodeResources.
+type NodeResources struct {
+	// CPUs the number of cpu threads
+	CPUs float64 `json:"cpus"`
+
+	// Mem the memory size in bytes
+	Mem int64 `json:"mem"`
+}
+
 // NodeStatus defines model for NodeStatus.
 type NodeStatus struct {
 	Agent        string                      `json:"agent"`
@@ -1561,6 +1884,7 @@ type NodeStatus struct {
 	Gen          map[string]uint64           `json:"gen"`
 	IsLeader     bool                        `json:"is_leader"`
 	IsOverloaded bool                        `json:"is_overloaded"`
+	Maintenance  *NodeMaintenance            `json:"maintenance,omitempty"`
 }
 
 // NodesInfo defines model for NodesInfo.
@@ -1583,6 +1907,12 @@ type ObjectActor struct {
 	// PlacementPolicy object placement policy
 	PlacementPolicy PlacementPolicy `json:"placement_policy"`
 
+	// PlacementReason the affinity constraints preventing the instances to run on the preferred nodes
+	PlacementReason *string `json:"placement_reason,omitempty"`
+
+	// PlacementScores the weighted placement score breakdown, indexed by nodename
+	PlacementScores *PlacementScores `json:"placement_scores,omitempty"`
+
 	// PlacementState object placement state
 	PlacementState PlacementState `json:"placement_state"`
 
@@ -1673,6 +2003,12 @@ type ObjectSvc struct {
 	// PlacementPolicy object placement policy
 	PlacementPolicy PlacementPolicy `json:"placement_policy"`
 
+	// PlacementReason the affinity constraints preventing the instances to run on the preferred nodes
+	PlacementReason *string `json:"placement_reason,omitempty"`
+
+	// PlacementScores the weighted placement score breakdown, indexed by nodename
+	PlacementScores *PlacementScores `json:"placement_scores,omitempty"`
+
 	// PlacementState object placement state
 	PlacementState PlacementState `json:"placement_state"`
 
@@ -1709,6 +2045,12 @@ type ObjectVol struct {
 	// PlacementPolicy object placement policy
 	PlacementPolicy PlacementPolicy `json:"placement_policy"`
 
+	// PlacementReason the affinity constraints preventing the instances to run on the preferred nodes
+	PlacementReason *string `json:"placement_reason,omitempty"`
+
+	// PlacementScores the weighted placement score breakdown, indexed by nodename
+	PlacementScores *PlacementScores `json:"placement_scores,omitempty"`
+
 	// PlacementState object placement state
 	PlacementState PlacementState `json:"placement_state"`
 	Pool           string         `json:"pool"`
@@ -1791,23 +2133,36 @@ type PatchDataKeys = []PatchDataKey
 // PlacementPolicy object placement policy
 type PlacementPolicy string
 
+// PlacementScores the weighted placement score breakdown, indexed by nodename
+type PlacementScores = placement.Scores
+
 // PlacementState object placement state
 type PlacementState string
 
 // Pool defines model for Pool.
 type Pool struct {
-	Capabilities []string  `json:"capabilities"`
-	Errors       *[]string `json:"errors,omitempty"`
-	Free         int64     `json:"free"`
-	Head         string    `json:"head"`
-	Name         string    `json:"name"`
-	Node         string    `json:"node"`
-	Shared       bool      `json:"shared"`
-	Size         int64     `json:"size"`
-	Type         string    `json:"type"`
-	UpdatedAt    time.Time `json:"updated_at"`
-	Used         int64     `json:"used"`
-	VolumeCount  int       `json:"volume_count"`
+	Capabilities []string `json:"capabilities"`
+
+	// DataPercent the thin pool data fill ratio
+	DataPercent *float64  `json:"data_percent,omitempty"`
+	Errors      *[]string `json:"errors,omitempty"`
+	Free        int64     `json:"free"`
+	Head        string    `json:"head"`
+
+	// MetadataPercent the thin pool metadata fill ratio
+	MetadataPercent *float64 `json:"metadata_percent,omitempty"`
+	Name            string   `json:"name"`
+	Node            string   `json:"node"`
+
+	// Provisioned the sum of the thin volumes virtual sizes in bytes
+	Provisioned *int64    `json:"provisioned,omitempty"`
+	Shared      bool      `json:"shared"`
+	Size        int64     `json:"size"`
+	Type        string    `json:"type"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Used        int64     `json:"used"`
+	VolumeCount int       `json:"volume_count"`
+	Warnings    *[]string `json:"warnings,omitempty"`
 }
 
 // PoolItems defines model for PoolItems.
@@ -1856,6 +2211,16 @@ type PostNodeDRBDConfigRequest struct {
 	Data         []byte             `json:"data"`
 }
 
+// PostNodeMaintenance defines model for PostNodeMaintenance.
+type PostNodeMaintenance struct {
+	// Expire the maintenance duration, like 2h. The maintenance never
+	// expires if not set.
+	Expire *string `json:"expire,omitempty"`
+
+	// Reason the reason of the maintenance.
+	Reason string `json:"reason"`
+}
+
 // PostObjectActionRestart defines model for PostObjectActionRestart.
 type PostObjectActionRestart struct {
 	Force *bool `json:"force,omitempty"`
@@ -1867,6 +2232,33 @@ type PostObjectActionSwitch struct {
 	Live        bool     `json:"live"`
 }
 
+// PostObjectsAction defines model for PostObjectsAction.
+type PostObjectsAction struct {
+	// Action One of abort, delete, freeze, giveback, provision, purge, start,
+	// stop, unfreeze or unprovision.
+	Action string `json:"action"`
+
+	// AllOrNothing Stop on the first object failure and roll back the objects
+	// already acted on, except those already in the action state
+	// before the batch. Only the freeze, provision, start, stop,
+	// unfreeze and unprovision actions support this mode.
+	AllOrNothing *bool `json:"all_or_nothing,omitempty"`
+
+	// Order The priority waves order. asc orchestrates the lower priority
+	// objects first, desc the higher priority objects first, and none
+	// orchestrates all the objects in a single wave. Defaults to desc
+	// for the delete, purge, stop and unprovision actions, asc for the
+	// other actions.
+	Order *string `json:"order,omitempty"`
+
+	// Parallel The maximum number of objects orchestrated at the same time.
+	// Defaults to 10.
+	Parallel *int `json:"parallel,omitempty"`
+
+	// Selector The object selector expression.
+	Selector string `json:"selector"`
+}
+
 // PostRelayMessage defines model for PostRelayMessage.
 type PostRelayMessage struct {
 	ClusterID   string `json:"cluster_id"`
@@ -2217,6 +2609,37 @@ type ScheduleList struct {
 // ScheduleListKind defines model for ScheduleList.Kind.
 type ScheduleListKind string
 
+// Snapshot defines model for Snapshot.
+type Snapshot struct {
+	CreatedAt time.Time `json:"created_at"`
+	Name      string    `json:"name"`
+
+	// Used the snapshot used size in bytes
+	Used int64 `json:"used"`
+}
+
+// SnapshotItem defines model for SnapshotItem.
+type SnapshotItem struct {
+	Data Snapshot         `json:"data"`
+	Kind SnapshotItemKind `json:"kind"`
+	Meta InstanceMeta     `json:"meta"`
+}
+
+// SnapshotItemKind defines model for SnapshotItem.Kind.
+type SnapshotItemKind string
+
+// SnapshotItems defines model for SnapshotItems.
+type SnapshotItems = []SnapshotItem
+
+// SnapshotList defines model for SnapshotList.
+type SnapshotList struct {
+	Items SnapshotItems    `json:"items"`
+	Kind  SnapshotListKind `json:"kind"`
+}
+
+// SnapshotListKind defines model for SnapshotList.Kind.
+type SnapshotListKind string
+
 // Scope defines model for Scope.
 type Scope = []string
 
@@ -2229,6 +2652,21 @@ type SubsetConfig = instance.SubsetConfig
 // SubsetsConfig defines model for SubsetsConfig.
 type SubsetsConfig = []SubsetConfig
 
+// SyncData defines model for SyncData.
+type SyncData struct {
+	// Name The name of the ingested sync data stream.
+	Name string `json:"name"`
+
+	// Size The size in bytes of the ingested stream.
+	Size int64 `json:"size"`
+}
+
+// SyncDataState defines model for SyncDataState.
+type SyncDataState struct {
+	// ResumeToken The token to resume the interrupted sync data stream, empty if the stream can not be resumed.
+	ResumeToken string `json:"resume_token"`
+}
+
 // Topology object topology
 type Topology string
 
@@ -2272,6 +2710,36 @@ type UserList struct {
 // UserListKind defines model for UserList.Kind.
 type UserListKind string
 
+// UsrToken defines model for UsrToken.
+type UsrToken struct {
+	Item  UsrTokenItem `json:"item"`
+	Token string       `json:"token"`
+}
+
+// UsrTokenItem defines model for UsrTokenItem.
+type UsrTokenItem struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpireAt   time.Time  `json:"expire_at"`
+	Grant      []string   `json:"grant"`
+	ID         string     `json:"id"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+	Object     string     `json:"object"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// UsrTokenItems defines model for UsrTokenItems.
+type UsrTokenItems = []UsrTokenItem
+
+// UsrTokenList defines model for UsrTokenList.
+type UsrTokenList struct {
+	Items UsrTokenItems    `json:"items"`
+	Kind  UsrTokenListKind `json:"kind"`
+}
+
+// UsrTokenListKind defines model for UsrTokenList.Kind.
+type UsrTokenListKind string
+
 // DRBDResourceName defines model for DRBDResourceName.
 type DRBDResourceName = string
 
@@ -2287,6 +2755,9 @@ type EventCache = bool
 // EventFilter defines model for EventFilter.
 type EventFilter = []string
 
+// EventLastID defines model for EventLastID.
+type EventLastID = uint64
+
 // EventReplay defines model for EventReplay.
 type EventReplay = bool
 
@@ -2341,6 +2812,9 @@ type Roles = []Role
 // InPathHeartbeatName Heartbeat name, example '1.rx' for heartbeat receiver of 'hb#1' section
 type InPathHeartbeatName = DaemonHeartbeatName
 
+// InPathJobID defines model for inPathJobID.
+type InPathJobID = openapi_types.UUID
+
 // InPathKind defines model for inPathKind.
 type InPathKind = Kind
 
@@ -2396,6 +2870,9 @@ type InQueryHBA = string
 // InQueryImpersonate The node name to impersonate when evaluating a keyword. Setting impersonate without evaluate=true returns a Bad Request error.
 type InQueryImpersonate = string
 
+// InQueryJobNode defines model for inQueryJobNode.
+type InQueryJobNode = string
+
 // InQueryKeyName A datastore key name
 type InQueryKeyName = string
 
@@ -2423,9 +2900,15 @@ type InQueryNamespaceOptional = string
 // InQueryNodeSelector defines model for inQueryNodeSelector.
 type InQueryNodeSelector = string
 
+// InQueryObjectPath An object path
+type InQueryObjectPath = string
+
 // InQueryOption defines model for inQueryOption.
 type InQueryOption = string
 
+// InQueryPermission An api operation, like read, start, stop or restart
+type InQueryPermission = string
+
 // InQueryPoolName defines model for inQueryPoolName.
 type InQueryPoolName = string
 
@@ -2453,15 +2936,30 @@ type InQuerySessionID = openapi_types.UUID
 // InQuerySets defines model for inQuerySets.
 type InQuerySets = []string
 
+// InQuerySince A duration (ex 1h) or a RFC3339 timestamp. Only the records more recent are returned.
+type InQuerySince = string
+
+// InQuerySize A size expression, like 10g or 512mi
+type InQuerySize = string
+
 // InQuerySlaves defines model for inQuerySlaves.
 type InQuerySlaves = []string
 
+// InQuerySnapshotName A volume snapshot name
+type InQuerySnapshotName = string
+
 // InQueryStateOnly defines model for inQueryStateOnly.
 type InQueryStateOnly = bool
 
 // InQuerySubset defines model for inQuerySubset.
 type InQuerySubset = string
 
+// InQuerySyncDataName defines model for inQuerySyncDataName.
+type InQuerySyncDataName = string
+
+// InQuerySyncDataRid defines model for inQuerySyncDataRid.
+type InQuerySyncDataRid = string
+
 // InQueryTag defines model for inQueryTag.
 type InQueryTag = string
 
@@ -2471,6 +2969,9 @@ type InQueryTarget = string
 // InQueryTo defines model for inQueryTo.
 type InQueryTo = string
 
+// InQueryTokenName A usr api token name
+type InQueryTokenName = string
+
 // InQueryUnsets defines model for inQueryUnsets.
 type InQueryUnsets = []string
 
@@ -2516,6 +3017,12 @@ type GetArrayParams struct {
 	Name *InQueryArrayName `form:"name,omitempty" json:"name,omitempty"`
 }
 
+// GetAuthCanIParams defines parameters for GetAuthCanI.
+type GetAuthCanIParams struct {
+	Action InQueryPermission `form:"action" json:"action"`
+	Path   InQueryObjectPath `form:"path" json:"path"`
+}
+
 // PostAuthRefreshParams defines parameters for PostAuthRefresh.
 type PostAuthRefreshParams struct {
 	// Role list of api role
@@ -2605,6 +3112,27 @@ type GetInstancesParams struct {
 	Node *NodeOptional `form:"node,omitempty" json:"node,omitempty"`
 }
 
+// GetJobsParams defines parameters for GetJobs.
+type GetJobsParams struct {
+	// Node The node hosting the job record. If not set, all the cluster nodes
+	// are queried.
+	Node *InQueryJobNode `form:"node,omitempty" json:"node,omitempty"`
+}
+
+// GetJobParams defines parameters for GetJob.
+type GetJobParams struct {
+	// Node The node hosting the job record. If not set, all the cluster nodes
+	// are queried.
+	Node *InQueryJobNode `form:"node,omitempty" json:"node,omitempty"`
+}
+
+// PostJobCancelParams defines parameters for PostJobCancel.
+type PostJobCancelParams struct {
+	// Node The node hosting the job record. If not set, all the cluster nodes
+	// are queried.
+	Node *InQueryJobNode `form:"node,omitempty" json:"node,omitempty"`
+}
+
 // GetNetworksParams defines parameters for GetNetworks.
 type GetNetworksParams struct {
 	// Name the name of a cluster backend network
@@ -2623,6 +3151,12 @@ type GetNodesParams struct {
 	Node *NodeOptional `form:"node,omitempty" json:"node,omitempty"`
 }
 
+// GetNodesCapacityParams defines parameters for GetNodesCapacity.
+type GetNodesCapacityParams struct {
+	// Node node selector expression.
+	Node *NodeOptional `form:"node,omitempty" json:"node,omitempty"`
+}
+
 // PostPeerActionDequeueParams defines parameters for PostPeerActionDequeue.
 type PostPeerActionDequeueParams struct {
 	SessionId *InQuerySessionID `form:"session_id,omitempty" json:"session_id,omitempty"`
@@ -2678,6 +3212,14 @@ type PostPeerActionUnfreezeParams struct {
 	SessionId *InQuerySessionID `form:"session_id,omitempty" json:"session_id,omitempty"`
 }
 
+// GetNodeAuditLogParams defines parameters for GetNodeAuditLog.
+type GetNodeAuditLogParams struct {
+	Since *InQuerySince `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit limit items count
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetNodeConfigParams defines parameters for GetNodeConfig.
 type GetNodeConfigParams struct {
 	Kw          *InQueryKeywords    `form:"kw,omitempty" json:"kw,omitempty"`
@@ -2745,6 +3287,11 @@ type GetDaemonEventsParams struct {
 
 	// Selector selector
 	Selector *InQuerySelectorOptional `form:"selector,omitempty" json:"selector,omitempty"`
+	Since    *InQuerySince            `form:"since,omitempty" json:"since,omitempty"`
+
+	// LastEventID the id of the last event received. The journaled events with a greater id
+	// are replayed before the live events.
+	LastEventID *EventLastID `json:"Last-Event-ID,omitempty"`
 }
 
 // DeleteDaemonProcessParams defines parameters for DeleteDaemonProcess.
@@ -2893,6 +3440,13 @@ type PostInstanceActionPushResourceInfoParams struct {
 	SessionId *InQuerySessionID `form:"session_id,omitempty" json:"session_id,omitempty"`
 }
 
+// PostInstanceActionResizeParams defines parameters for PostInstanceActionResize.
+type PostInstanceActionResizeParams struct {
+	// Size A size expression, like 10g or 512mi
+	Size      InQuerySize       `form:"size" json:"size"`
+	SessionId *InQuerySessionID `form:"session_id,omitempty" json:"session_id,omitempty"`
+}
+
 // PostInstanceActionRestartParams defines parameters for PostInstanceActionRestart.
 type PostInstanceActionRestartParams struct {
 	Slaves          *InQueryAllSlaves       `form:"slaves,omitempty" json:"slaves,omitempty"`
@@ -2942,6 +3496,27 @@ type PostInstanceActionShutdownParams struct {
 	To     *InQueryTo     `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// PostInstanceActionSnapshotCreateParams defines parameters for PostInstanceActionSnapshotCreate.
+type PostInstanceActionSnapshotCreateParams struct {
+	// Name A volume snapshot name
+	Name      InQuerySnapshotName `form:"name" json:"name"`
+	SessionId *InQuerySessionID   `form:"session_id,omitempty" json:"session_id,omitempty"`
+}
+
+// PostInstanceActionSnapshotDeleteParams defines parameters for PostInstanceActionSnapshotDelete.
+type PostInstanceActionSnapshotDeleteParams struct {
+	// Name A volume snapshot name
+	Name      InQuerySnapshotName `form:"name" json:"name"`
+	SessionId *InQuerySessionID   `form:"session_id,omitempty" json:"session_id,omitempty"`
+}
+
+// PostInstanceActionSnapshotRollbackParams defines parameters for PostInstanceActionSnapshotRollback.
+type PostInstanceActionSnapshotRollbackParams struct {
+	// Name A volume snapshot name
+	Name      InQuerySnapshotName `form:"name" json:"name"`
+	SessionId *InQuerySessionID   `form:"session_id,omitempty" json:"session_id,omitempty"`
+}
+
 // PostInstanceActionStartParams defines parameters for PostInstanceActionStart.
 type PostInstanceActionStartParams struct {
 	Slaves          *InQueryAllSlaves       `form:"slaves,omitempty" json:"slaves,omitempty"`
@@ -3074,6 +3649,21 @@ type GetInstanceResourceFileParams struct {
 	Rid InQueryResourceFileRid `form:"rid" json:"rid"`
 }
 
+// GetInstanceSyncDataParams defines parameters for GetInstanceSyncData.
+type GetInstanceSyncDataParams struct {
+	// Rid The rid of the sync resource receiving the data.
+	Rid InQuerySyncDataRid `form:"rid" json:"rid"`
+}
+
+// PostInstanceSyncDataParams defines parameters for PostInstanceSyncData.
+type PostInstanceSyncDataParams struct {
+	// Name The name of the sync data stream, interpreted by the sync resource driver.
+	Name InQuerySyncDataName `form:"name" json:"name"`
+
+	// Rid The rid of the sync resource receiving the data.
+	Rid InQuerySyncDataRid `form:"rid" json:"rid"`
+}
+
 // GetNodeLogsParams defines parameters for GetNodeLogs.
 type GetNodeLogsParams struct {
 	// Filter list of log filter
@@ -3171,6 +3761,25 @@ type PutObjectDataKeyParams struct {
 	Name InQueryKeyName `form:"name" json:"name"`
 }
 
+// DeleteObjectTokenParams defines parameters for DeleteObjectToken.
+type DeleteObjectTokenParams struct {
+	Name InQueryTokenName `form:"name" json:"name"`
+}
+
+// PostObjectTokenParams defines parameters for PostObjectToken.
+type PostObjectTokenParams struct {
+	Name InQueryTokenName `form:"name" json:"name"`
+
+	// Role list of api role
+	Role *Roles `form:"role,omitempty" json:"role,omitempty"`
+
+	// Scope the scope value used to create the token grant claim
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty"`
+
+	// Duration the token validity duration, maximum value 3650d
+	Duration *string `form:"duration,omitempty" json:"duration,omitempty"`
+}
+
 // GetPoolsParams defines parameters for GetPools.
 type GetPoolsParams struct {
 	// Name the name of a backend storage pool
@@ -3234,6 +3843,12 @@ type PostDaemonLogControlJSONRequestBody = LogControlBody
 // PostNodeDRBDConfigJSONRequestBody defines body for PostNodeDRBDConfig for application/json ContentType.
 type PostNodeDRBDConfigJSONRequestBody = PostNodeDRBDConfigRequest
 
+// PostNodeMaintenanceJSONRequestBody defines body for PostNodeMaintenance for application/json ContentType.
+type PostNodeMaintenanceJSONRequestBody = PostNodeMaintenance
+
+// PostObjectsActionJSONRequestBody defines body for PostObjectsAction for application/json ContentType.
+type PostObjectsActionJSONRequestBody = PostObjectsAction
+
 // PostObjectActionRestartJSONRequestBody defines body for PostObjectActionRestart for application/json ContentType.
 type PostObjectActionRestartJSONRequestBody = PostObjectActionRestart
 
@@ -118,7 +118,12 @@ func (t *NodeConfigHook) Unstructured() map[string]any {
 	return map[string]any{
 		"command": t.Command,
 		"events":  t.Events,
+		"filters": t.Filters,
 		"name":    t.Name,
+		"retries": t.Retries,
+		"timeout": t.Timeout,
+		"type":    t.Type,
+		"url":     t.Url,
 	}
 }
 
@@ -494,17 +499,21 @@ func (t PoolList) GetItems() any {
 
 func (t Pool) Unstructured() map[string]any {
 	return map[string]any{
-		"type":         t.Type,
-		"name":         t.Name,
-		"node":         t.Node,
-		"capabilities": t.Capabilities,
-		"head":         t.Head,
-		"errors":       t.Errors,
-		"volume_count": t.VolumeCount,
-		"free":         t.Free,
-		"updated_at":   t.UpdatedAt,
-		"used":         t.Used,
-		"size":         t.Size,
+		"type":             t.Type,
+		"name":             t.Name,
+		"node":             t.Node,
+		"capabilities":     t.Capabilities,
+		"head":             t.Head,
+		"errors":           t.Errors,
+		"volume_count":     t.VolumeCount,
+		"free":             t.Free,
+		"updated_at":       t.UpdatedAt,
+		"used":             t.Used,
+		"size":             t.Size,
+		"provisioned":      t.Provisioned,
+		"data_percent":     t.DataPercent,
+		"metadata_percent": t.MetadataPercent,
+		"warnings":         t.Warnings,
 	}
 }
 
@@ -606,3 +615,102 @@ func (t ProcessItem) Unstructured() map[string]any {
 		"rid":           t.Rid,
 	}
 }
+
+func (t UsrToken) Unstructured() map[string]any {
+	return map[string]any{
+		"token": t.Token,
+		"item":  t.Item.Unstructured(),
+	}
+}
+
+func (t UsrTokenList) GetItems() any {
+	return t.Items
+}
+
+func (t UsrTokenItem) Unstructured() map[string]any {
+	m := map[string]any{
+		"object":     t.Object,
+		"name":       t.Name,
+		"id":         t.ID,
+		"grant":      t.Grant,
+		"created_at": t.CreatedAt,
+		"expire_at":  t.ExpireAt,
+	}
+	if t.LastUsedAt != nil {
+		m["last_used_at"] = *t.LastUsedAt
+	}
+	if t.RevokedAt != nil {
+		m["revoked_at"] = *t.RevokedAt
+	}
+	return m
+}
+
+func (t AuthCanI) Unstructured() map[string]any {
+	m := map[string]any{
+		"allowed": t.Allowed,
+		"action":  t.Action,
+		"path":    t.Path,
+		"reason":  t.Reason,
+	}
+	if t.Grant != nil {
+		m["grant"] = *t.Grant
+	}
+	return m
+}
+
+func (t AuditRecordList) GetItems() any {
+	return t.Items
+}
+
+func (t AuditRecord) Unstructured() map[string]any {
+	m := map[string]any{
+		"at":           t.At,
+		"node":         t.Node,
+		"request_id":   t.RequestId,
+		"user":         t.User,
+		"strategy":     t.Strategy,
+		"grant":        t.Grant,
+		"source_ip":    t.SourceIp,
+		"method":       t.Method,
+		"url":          t.Url,
+		"operation_id": t.OperationId,
+		"status":       t.Status,
+	}
+	if t.Object != nil {
+		m["object"] = *t.Object
+	}
+	if t.Params != nil {
+		m["params"] = *t.Params
+	}
+	return m
+}
+
+func (t JobList) GetItems() any {
+	return t.Items
+}
+
+func (t Job) Unstructured() map[string]any {
+	m := map[string]any{
+		"id":         t.ID,
+		"kind":       t.Kind,
+		"node":       t.Node,
+		"path":       t.Path,
+		"action":     t.Action,
+		"user":       t.User,
+		"state":      t.State,
+		"created_at": t.CreatedAt,
+		"updated_at": t.UpdatedAt,
+		"steps":      t.Steps,
+		"log":        t.Log,
+	}
+	if t.EndedAt != nil {
+		m["ended_at"] = *t.EndedAt
+	}
+	if t.Objects != nil {
+		m["objects"] = *t.Objects
+	}
+	if t.Result != nil {
+		m["result"] = *t.Result
+	}
+	return m
+}
@@ -0,0 +1,207 @@
+// Package auditlog is the persistent store of the api requests audit
+// records.
+//
+// The records are appended as json lines to the <var>/audit/api.log file,
+// rotated when exceeding MaxSize megabytes. MaxBackups rotated files are
+// kept.
+package auditlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/opensvc/om3/v3/core/rawconfig"
+)
+
+type (
+	// Record is the audit record of an api request.
+	Record struct {
+		At          time.Time           `json:"at"`
+		Node        string              `json:"node"`
+		RequestID   string              `json:"request_id"`
+		User        string              `json:"user"`
+		Strategy    string              `json:"strategy"`
+		Grants      []string            `json:"grant"`
+		SourceIP    string              `json:"source_ip"`
+		Method      string              `json:"method"`
+		URL         string              `json:"url"`
+		OperationID string              `json:"operation_id"`
+		Object      string              `json:"object,omitempty"`
+		Params      map[string][]string `json:"params,omitempty"`
+		Status      int                 `json:"status"`
+	}
+
+	// Store is a rotating audit records store.
+	Store struct {
+		sync.Mutex
+		dir string
+		w   *lumberjack.Logger
+	}
+)
+
+const (
+	filename = "api.log"
+)
+
+var (
+	// MaxSize is the size in megabytes of the audit file triggering its
+	// rotation.
+	MaxSize = 10
+
+	// MaxBackups is the number of rotated audit files to keep.
+	MaxBackups = 5
+
+	defaultStore     *Store
+	defaultStoreOnce sync.Once
+)
+
+// Default returns the audit store of the <var>/audit directory.
+func Default() *Store {
+	defaultStoreOnce.Do(func() {
+		defaultStore = New(filepath.Join(rawconfig.Paths.Var, "audit"))
+	})
+	return defaultStore
+}
+
+// New returns a Store of the audit files in the dir directory.
+func New(dir string) *Store {
+	return &Store{
+		dir: dir,
+		w: &lumberjack.Logger{
+			Filename:   filepath.Join(dir, filename),
+			MaxSize:    MaxSize,
+			MaxBackups: MaxBackups,
+		},
+	}
+}
+
+// Append writes the record r to the store.
+func (t *Store) Append(r Record) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	t.Lock()
+	defer t.Unlock()
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return err
+	}
+	_, err = t.w.Write(b)
+	return err
+}
+
+// Read returns the records more recent than since, oldest first. With a
+// positive limit, only the limit most recent records are returned.
+//
+// The files are read newest first, and the older files are not read once
+// the limit is reached. The store lock is only held to open the files, so
+// a long read does not delay the Append calls.
+func (t *Store) Read(since time.Time, limit int) ([]Record, error) {
+	files, err := t.open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, f := range files {
+			_ = f.Close()
+		}
+	}()
+	l := make([]Record, 0)
+	for i := len(files) - 1; i >= 0; i-- {
+		records, err := readFile(files[i], since, limit)
+		if err != nil {
+			return nil, err
+		}
+		if limit > 0 && len(l)+len(records) >= limit {
+			l = append(records[len(l)+len(records)-limit:], l...)
+			break
+		}
+		l = append(records, l...)
+	}
+	return l, nil
+}
+
+// open returns the opened audit files, oldest first. The files are opened
+// under the store lock, so a rotation can't rename or remove a file
+// between the listing and the opening, and the open files are readable
+// after a later rotation.
+func (t *Store) open() ([]*os.File, error) {
+	t.Lock()
+	defer t.Unlock()
+	names, err := t.files()
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*os.File, 0, len(names))
+	for _, name := range names {
+		f, err := os.Open(name)
+		if errors.Is(err, os.ErrNotExist) {
+			// no current file yet
+			continue
+		} else if err != nil {
+			for _, f := range files {
+				_ = f.Close()
+			}
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// Close closes the current audit file.
+func (t *Store) Close() error {
+	t.Lock()
+	defer t.Unlock()
+	return t.w.Close()
+}
+
+// files returns the rotated audit files, oldest first, followed by the
+// current audit file.
+func (t *Store) files() ([]string, error) {
+	ext := filepath.Ext(filename)
+	prefix := filename[:len(filename)-len(ext)]
+	l, err := filepath.Glob(filepath.Join(t.dir, prefix+"-*"+ext))
+	if err != nil {
+		return nil, err
+	}
+	// the rotated files are suffixed by their rotation timestamp
+	sort.Strings(l)
+	return append(l, filepath.Join(t.dir, filename)), nil
+}
+
+// readFile returns the records of the file f more recent than since,
+// oldest first. With a positive limit, only the limit most recent records
+// of the file are kept in memory.
+func readFile(f *os.File, since time.Time, limit int) ([]Record, error) {
+	l := make([]Record, 0)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var r Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			// skip truncated records
+			continue
+		}
+		if !r.At.After(since) {
+			continue
+		}
+		l = append(l, r)
+		if limit > 0 && len(l) >= 2*limit {
+			l = append(make([]Record, 0, 2*limit), l[len(l)-limit:]...)
+		}
+	}
+	if limit > 0 && len(l) > limit {
+		l = l[len(l)-limit:]
+	}
+	return l, scanner.Err()
+}
@@ -0,0 +1,86 @@
+package auditlog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	store := New(dir)
+	defer func() { _ = store.Close() }()
+
+	t0 := time.Now().Add(-time.Hour)
+	for i := 0; i < 5; i++ {
+		r := Record{
+			At:          t0.Add(time.Duration(i) * time.Minute),
+			Node:        "node1",
+			User:        "alice",
+			Method:      "POST",
+			OperationID: "PostObjectActionStart",
+			Object:      "ns1/svc/web1",
+			Status:      200,
+		}
+		require.NoError(t, store.Append(r))
+	}
+
+	l, err := store.Read(time.Time{}, 0)
+	require.NoError(t, err)
+	require.Len(t, l, 5)
+	require.Equal(t, "ns1/svc/web1", l[0].Object)
+
+	l, err = store.Read(t0.Add(2*time.Minute), 0)
+	require.NoError(t, err)
+	require.Len(t, l, 2, "expected only the records more recent than since")
+
+	l, err = store.Read(time.Time{}, 2)
+	require.NoError(t, err)
+	require.Len(t, l, 2)
+	require.True(t, l[1].At.Equal(t0.Add(4*time.Minute)), "expected the most recent records")
+}
+
+func TestStoreReadRotated(t *testing.T) {
+	dir := t.TempDir()
+	store := New(dir)
+	defer func() { _ = store.Close() }()
+
+	t0 := time.Now().Add(-time.Hour)
+	rotated := `{"at":"` + t0.Format(time.RFC3339Nano) + `","node":"node1","operation_id":"PostObjectActionStop","status":200}` + "\n" + `{"at":"truncat`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "api-2026-01-01T00-00-00.000.log"), []byte(rotated), 0600))
+	require.NoError(t, store.Append(Record{At: t0.Add(time.Minute), OperationID: "PostObjectActionStart"}))
+
+	l, err := store.Read(time.Time{}, 0)
+	require.NoError(t, err)
+	require.Len(t, l, 2)
+	require.Equal(t, "PostObjectActionStop", l[0].OperationID)
+	require.Equal(t, "PostObjectActionStart", l[1].OperationID)
+}
+
+func TestStoreReadLimitRotated(t *testing.T) {
+	dir := t.TempDir()
+	store := New(dir)
+	defer func() { _ = store.Close() }()
+
+	t0 := time.Now().Add(-time.Hour)
+	rotated := `{"at":"` + t0.Format(time.RFC3339Nano) + `","operation_id":"PostObjectActionStop","status":200}` + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "api-2026-01-01T00-00-00.000.log"), []byte(rotated), 0600))
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, store.Append(Record{At: t0.Add(time.Duration(i) * time.Minute), OperationID: "PostObjectActionStart"}))
+	}
+
+	l, err := store.Read(time.Time{}, 2)
+	require.NoError(t, err)
+	require.Len(t, l, 2)
+	require.True(t, l[0].At.Equal(t0.Add(2*time.Minute)))
+	require.True(t, l[1].At.Equal(t0.Add(3*time.Minute)))
+
+	l, err = store.Read(time.Time{}, 4)
+	require.NoError(t, err)
+	require.Len(t, l, 4)
+	require.Equal(t, "PostObjectActionStop", l[0].OperationID, "expected the rotated file record first")
+	require.True(t, l[3].At.Equal(t0.Add(3*time.Minute)))
+}
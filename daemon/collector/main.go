@@ -124,6 +124,14 @@ type (
 
 		// clusterNode is a map of cluster nodenames
 		clusterNode map[string]struct{}
+
+		// feedAudit is true when the node.collector_audit keyword enables
+		// the api requests audit records forwarding.
+		feedAudit bool
+
+		// auditForwardedAt is the timestamp of the last audit record
+		// forwarded to the collector.
+		auditForwardedAt time.Time
 	}
 
 	requester interface {
@@ -281,6 +289,7 @@ func (t *T) Start(ctx context.Context) error {
 		cfg := initialNodeConfig.Collector
 
 		t.setThrottle(cfg)
+		t.feedAudit = cfg != nil && cfg.Audit
 
 		if err := t.setNodeFeedClient(cfg); err != nil {
 			t.log.Infof("the collector routine is dormant: %s", err)
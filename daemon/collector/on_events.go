@@ -30,6 +30,9 @@ func (t *T) onRefreshTicker() {
 		t.previousUpdatedAt = time.Time{}
 		t.dropChanges()
 	}
+	if err := t.sendAuditRecords(); err != nil {
+		t.log.Warnf("sendAuditRecords: %s", err)
+	}
 }
 
 func (t *T) onClusterConfigUpdated(c *msgbus.ClusterConfigUpdated) {
@@ -125,6 +128,7 @@ func (t *T) onNodeConfigUpdated(c *msgbus.NodeConfigUpdated) {
 	}
 	cfg := c.Value.Collector
 	t.setThrottle(cfg)
+	t.feedAudit = cfg != nil && cfg.Audit
 	err := t.setNodeFeedClient(cfg)
 	if t.feedPinger != nil {
 		t.feedPinger.Stop()
@@ -0,0 +1,125 @@
+package collector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/oc3path"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/auditlog"
+)
+
+type (
+	nodeAuditPost struct {
+		Records []auditlog.Record `json:"records"`
+	}
+)
+
+var (
+	// auditMaxBatch is the max number of audit records posted to the
+	// collector per refresh tick.
+	auditMaxBatch = 500
+)
+
+// auditForwardedAtFile is the file storing the timestamp of the last audit
+// record forwarded to the collector, so the forwarding resumes from there
+// after a daemon restart.
+func auditForwardedAtFile() string {
+	return filepath.Join(rawconfig.Paths.Var, "node", "collector", "audit_forwarded_at")
+}
+
+// sendAuditRecords posts the audit records not yet forwarded to the
+// collector. Each node forwards its own records, so it doesn't depend on
+// the speaker state.
+func (t *T) sendAuditRecords() error {
+	if !t.feedAudit || t.client == nil {
+		return nil
+	}
+	if t.auditForwardedAt.IsZero() {
+		if err := t.loadAuditForwardedAt(); err != nil {
+			return err
+		}
+	}
+	records, err := auditlog.Default().Read(t.auditForwardedAt, 0)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	if len(records) > auditMaxBatch {
+		records = records[:auditMaxBatch]
+	}
+	b, err := json.Marshal(nodeAuditPost{Records: records})
+	if err != nil {
+		return err
+	}
+	if err := t.doPostNodeAudit(b, len(records)); err != nil {
+		return err
+	}
+	t.auditForwardedAt = records[len(records)-1].At
+	return t.saveAuditForwardedAt()
+}
+
+func (t *T) doPostNodeAudit(b []byte, count int) error {
+	var (
+		method = http.MethodPost
+		path   = oc3path.FeedNodeAudit
+	)
+
+	ctx, cancel := context.WithTimeout(t.ctx, defaultPostMaxDuration)
+	defer cancel()
+
+	req, err := t.client.NewRequestWithContext(ctx, method, path, bytes.NewBuffer(b))
+	if err != nil {
+		return fmt.Errorf("%s %s create request: %w", method, path, err)
+	}
+
+	t.log.Debugf("%s %s %d records", method, path, count)
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %s", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusAccepted:
+		return nil
+	default:
+		return fmt.Errorf("%s %s unexpected status code: %d", method, path, resp.StatusCode)
+	}
+}
+
+// loadAuditForwardedAt initializes the timestamp of the last forwarded
+// audit record. Without a stored timestamp, only the records created from
+// now are forwarded.
+func (t *T) loadAuditForwardedAt() error {
+	b, err := os.ReadFile(auditForwardedAtFile())
+	if errors.Is(err, fs.ErrNotExist) {
+		t.auditForwardedAt = time.Now()
+		return t.saveAuditForwardedAt()
+	} else if err != nil {
+		return err
+	}
+	return t.auditForwardedAt.UnmarshalText(bytes.TrimSpace(b))
+}
+
+func (t *T) saveAuditForwardedAt() error {
+	b, err := t.auditForwardedAt.MarshalText()
+	if err != nil {
+		return err
+	}
+	p := auditForwardedAtFile()
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(p, b, 0644)
+}
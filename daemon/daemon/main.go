@@ -32,17 +32,20 @@ import (
 	"github.com/opensvc/om3/v3/daemon/daemonsys"
 	"github.com/opensvc/om3/v3/daemon/discover"
 	"github.com/opensvc/om3/v3/daemon/dns"
+	"github.com/opensvc/om3/v3/daemon/eventjournal"
 	"github.com/opensvc/om3/v3/daemon/hb"
 	"github.com/opensvc/om3/v3/daemon/hb/hbcrypto"
 	"github.com/opensvc/om3/v3/daemon/hbcache"
 	"github.com/opensvc/om3/v3/daemon/hook"
 	"github.com/opensvc/om3/v3/daemon/imon"
 	"github.com/opensvc/om3/v3/daemon/istat"
+	"github.com/opensvc/om3/v3/daemon/jobs"
 	"github.com/opensvc/om3/v3/daemon/listener"
 	"github.com/opensvc/om3/v3/daemon/msgbus"
 	"github.com/opensvc/om3/v3/daemon/netmon"
 	"github.com/opensvc/om3/v3/daemon/nmon"
 	"github.com/opensvc/om3/v3/daemon/pgmetrics"
+	"github.com/opensvc/om3/v3/daemon/rebalancer"
 	"github.com/opensvc/om3/v3/daemon/runner"
 	"github.com/opensvc/om3/v3/daemon/scheduler"
 	"github.com/opensvc/om3/v3/util/converters"
@@ -140,6 +143,13 @@ func (t *T) Start(ctx context.Context) error {
 	bus.SetDefaultSubscriptionQueueSize(defaultSubscriptionQueueSize)
 	bus.SetDrainChanDuration(3 * daemonenv.DrainChanDuration)
 	bus.SetPanicOnFullQueue(10 * time.Second)
+	// continue the event ids sequence of the journal, so the event stream
+	// clients can resume after a daemon restart
+	if lastID, err := eventjournal.Default().LastID(); err != nil {
+		t.log.Warnf("event journal: %s", err)
+	} else {
+		bus.SetSeq(lastID)
+	}
 	t.ctx = pubsub.ContextWithBus(t.ctx, bus)
 	t.wg.Add(1)
 	bus.Start(t.ctx)
@@ -229,6 +239,9 @@ func (t *T) Start(ctx context.Context) error {
 
 	t.ctx = daemonapi.WithSubQS(t.ctx, qsMedium)
 	for _, s := range []startStopper{
+		// Prefer start `eventjournal` first to journal the startup events
+		eventjournal.New(qsHuge),
+
 		hbcache.New(2 * daemonenv.DrainChanDuration),
 
 		// Prefer start `hb` before the `discover` event storm
@@ -241,6 +254,7 @@ func (t *T) Start(ctx context.Context) error {
 
 		cstat.New(qsMedium),
 		istat.New(qsLarge),
+		jobs.New(qsMedium),
 		listener.New(),
 		nmon.NewManager(daemonenv.DrainChanDuration, qsMedium),
 		netmon.NewManager(daemonenv.DrainChanDuration, qsSmall),
@@ -253,6 +267,7 @@ func (t *T) Start(ctx context.Context) error {
 		collector.New(t.ctx, qsHuge),
 		scheduler.New(qsHuge),
 		runner.NewDefault(qsSmall),
+		rebalancer.New(qsSmall),
 	} {
 		if err := t.startComponent(t.ctx, s); err != nil {
 			return err
@@ -0,0 +1,38 @@
+package daemonapi
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+)
+
+func (a *DaemonAPI) DeleteNodeMaintenance(ctx echo.Context, nodename string) error {
+	if v, err := assertRoot(ctx); !v {
+		return err
+	}
+	nodename = a.parseNodename(nodename)
+	if nodename == a.localhost {
+		return a.localNodeMaintenanceLeave(ctx)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.DeleteNodeMaintenance(ctx.Request().Context(), nodename)
+	})
+}
+
+func (a *DaemonAPI) localNodeMaintenanceLeave(eCtx echo.Context) error {
+	ctx, cancel := context.WithTimeout(eCtx.Request().Context(), 300*time.Millisecond)
+	defer cancel()
+
+	msg, errReceiver := msgbus.NewSetNodeMaintenanceWithErr(ctx, a.localhost, nil)
+	a.Bus.Pub(msg, a.LabelLocalhost, labelOriginAPI)
+
+	if err := errReceiver.Receive(); err != nil {
+		return JSONFromSetNodeMaintenanceError(eCtx, err)
+	}
+	return eCtx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,59 @@
+package daemonapi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+// DeleteObjectToken revokes a named api token of a usr object.
+func (a *DaemonAPI) DeleteObjectToken(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.DeleteObjectTokenParams) error {
+	log := LogHandler(ctx, "DeleteObjectToken")
+
+	p, err := usrTokenPath(ctx, namespace, kind, name)
+	if err != nil {
+		return err
+	}
+	if v, err := assertUsrTokenManager(ctx, p, true); !v {
+		return err
+	}
+	log = naming.LogWithPath(log, p)
+
+	instanceConfigData := instance.ConfigData.GetByPath(p)
+
+	if _, ok := instanceConfigData[a.localhost]; ok {
+		o, err := object.NewUsr(p)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "NewUsr", "%s", err)
+		}
+		tk, err := o.RevokeToken(params.Name)
+		switch {
+		case errors.Is(err, object.ErrTokenNotExist):
+			return JSONProblemf(ctx, http.StatusNotFound, "RevokeToken", "%s", err)
+		case err != nil:
+			return JSONProblemf(ctx, http.StatusInternalServerError, "RevokeToken", "%s", err)
+		}
+		log.Infof("api token %s revoked", tk.Name)
+		return ctx.JSON(http.StatusOK, usrTokenItem(p, tk))
+	}
+
+	for nodename := range instanceConfigData {
+		c, err := a.newProxyClient(ctx, nodename)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+		}
+		if resp, err := c.DeleteObjectTokenWithResponse(ctx.Request().Context(), namespace, kind, name, &params); err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
+		} else if len(resp.Body) > 0 {
+			return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+		}
+	}
+
+	return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s", p)
+}
@@ -0,0 +1,38 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/rbac"
+)
+
+// GetAuthCanI reports if the authenticated user is allowed an operation
+// on an object, and explains the decision.
+func (a *DaemonAPI) GetAuthCanI(ctx echo.Context, params api.GetAuthCanIParams) error {
+	perm, err := rbac.ParsePermission(params.Action)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "action: %s", err)
+	} else if perm == rbac.PermAll {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "action: '%s' is not an operation", perm)
+	}
+	p, err := naming.ParsePath(params.Path)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "path: %s", err)
+	}
+	decision := canI(grantsFromContext(ctx), perm, p)
+	data := api.AuthCanI{
+		Action:  string(perm),
+		Allowed: decision.Allowed,
+		Path:    p.String(),
+		Reason:  decision.Reason,
+	}
+	if decision.Allowed {
+		grant := string(decision.Grant)
+		data.Grant = &grant
+	}
+	return ctx.JSON(http.StatusOK, data)
+}
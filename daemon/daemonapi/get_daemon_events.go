@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
-	"strconv"
 	"strings"
 	"time"
 
@@ -20,38 +19,29 @@ import (
 	"github.com/opensvc/om3/v3/core/object"
 	"github.com/opensvc/om3/v3/core/objectselector"
 	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/eventfilter"
+	"github.com/opensvc/om3/v3/daemon/eventjournal"
 	"github.com/opensvc/om3/v3/daemon/msgbus"
 	"github.com/opensvc/om3/v3/daemon/rbac"
 	"github.com/opensvc/om3/v3/util/converters"
-	"github.com/opensvc/om3/v3/util/flatten"
 	"github.com/opensvc/om3/v3/util/funcopt"
 	"github.com/opensvc/om3/v3/util/pubsub"
 )
 
 type (
-	Filter struct {
-		Kind   any
-		Labels []pubsub.Label
+	Filter      = eventfilter.Filter
+	DataFilters = eventfilter.DataFilters
 
-		// DataFilters is a slice of DataFilter used to define filtering data conditions
-		// based on key, value, and operator.
-		DataFilters DataFilters
+	sequencer interface {
+		Seq() uint64
 	}
+)
 
-	// DataFilter represents a filtering data condition based on a key, value,
-	// and an operator.
-	DataFilter struct {
-		Key   string
-		Value string
-		Op    string
-	}
-
-	// DataFilters is a slice of DataFilter used to define a collection of filtering data conditions.
-	DataFilters []DataFilter
-
-	kinder interface {
-		Kind() string
-	}
+var (
+	// journalWaitTimeout is the maximum delay to wait for the journal to
+	// catch up with the bus publications before replaying the journaled
+	// events.
+	journalWaitTimeout = 2 * time.Second
 )
 
 // GetDaemonEvents feeds node daemon event publications in rss format.
@@ -177,11 +167,22 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		// dataFiltersByKind is a map of DataFilters indexed on event kind.
 		dataFiltersByKind = make(map[string]DataFilters)
 
+		// journalReplay is true when the journaled events published after
+		// lastEventID and since must be sent before the live events.
+		journalReplay bool
+		lastEventID   uint64
+		since         time.Time
+
+		// replayedID is the id of the last event sent from the journal.
+		// The live events with a lower or equal id are already sent.
+		replayedID uint64
+
 		evCtx  = ctx.Request().Context()
 		cancel context.CancelFunc
 	)
 	hasRoot := grantsFromContext(ctx).HasRole(rbac.RoleRoot)
 	userGrants := grantsFromContext(ctx)
+	roles := customRoles()
 
 	log := LogHandler(ctx, handlerName)
 	log.Tracef("starting")
@@ -211,22 +212,26 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 	}
 
 	// isAllowed returns false if a message has a namespace label that
-	// doesn't match any of the user's guest grant.
-	isAllowed := func(msg pubsub.Messager) bool {
+	// doesn't match any of the user's guest grant, unless its path label
+	// is readable through a custom role.
+	isAllowed := func(labels pubsub.Labels) bool {
 		if hasRoot {
 			return true
 		}
-		labels := msg.GetLabels()
+		if s, ok := labels["path"]; ok {
+			if p, err := naming.ParsePath(s); err == nil && canRead(userGrants, roles, p) {
+				return true
+			}
+		}
 		if namespace, ok := labels["namespace"]; ok {
 			return hasRoleGuestOn(userGrants, namespace)
 		}
 		return true
 	}
 
-	// isSelected returns true when msg has path label that is selected or
-	// doesn't have a path label.
-	isSelected := func(msg pubsub.Messager) bool {
-		labels := msg.GetLabels()
+	// isSelected returns true when a message has path label that is
+	// selected or doesn't have a path label.
+	isSelected := func(labels pubsub.Labels) bool {
 		if s, ok := labels["path"]; ok {
 			if pathSelected.Has(s) {
 				// path label is selected
@@ -254,6 +259,20 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 			defer cancel()
 		}
 	}
+	if params.Since != nil && *params.Since != "" {
+		if d, err := converters.ParseDuration(*params.Since); err == nil {
+			since = time.Now().Add(-d)
+		} else if t, err := time.Parse(time.RFC3339, *params.Since); err == nil {
+			since = t
+		} else {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "field 'since' with value '%s' is neither a duration nor a RFC3339 time", *params.Since)
+		}
+		journalReplay = true
+	}
+	if params.LastEventID != nil {
+		lastEventID = *params.LastEventID
+		journalReplay = true
+	}
 
 	filters, err := parseFilters(params)
 	if err != nil {
@@ -275,7 +294,7 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 	a.announceSub(name)
 	defer a.announceUnsub(name)
 
-	sub := a.Bus.Sub(name, pubsub.Timeout(time.Second), a.SubQS)
+	sub := a.Bus.Sub(name, pubsub.Timeout(time.Second), a.SubQS, pubsub.WithSeq(true))
 
 	for _, filter := range filters {
 		if filter.Kind == nil {
@@ -322,6 +341,14 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 			sub.AddFilter(&msgbus.ObjectDeleted{}, a.LabelLocalhost)
 		}
 	}
+	// The bus sequence number before the subscription start is the id of
+	// the events extracted from the cluster data, so the event ids are
+	// never decreasing on the response event stream.
+	var startSeq uint64
+	bus, hasSeq := a.Bus.(sequencer)
+	if hasSeq {
+		startSeq = bus.Seq()
+	}
 	sub.Start()
 	defer func() {
 		if err := sub.Stop(); err != nil {
@@ -357,15 +384,14 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 
 	sseWriter := sseevent.NewWriter(w)
 
-	doEvent := func(i any) error {
-		ev := event.ToEvent(i, eventCount)
+	doEvent := func(ev *event.Event) error {
 		if ev != nil {
 			if dataFilter, ok := dataFiltersByKind[ev.Kind]; ok {
 				v := make(map[string]any)
 				if err := json.Unmarshal(ev.Data, &v); err != nil {
 					return err
 				}
-				if !dataFilter.match(v) {
+				if !dataFilter.Match(v) {
 					return nil
 				}
 			}
@@ -379,15 +405,72 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		return nil
 	}
 
+	// isFiltered returns true when the journal record r matches one of
+	// the requested filters.
+	isFiltered := func(r eventjournal.Record) bool {
+		if len(filters) == 0 {
+			return true
+		}
+		for _, filter := range filters {
+			if filter.MatchLabels(r.Kind, r.Labels) {
+				return true
+			}
+		}
+		return false
+	}
+
 	// defines replay from the primary replay option, fallback to the deprecated
-	// cache value if any.
+	// cache value if any. The journal replay supersedes the cluster data
+	// replay.
 	var replay bool
-	if params.Replay != nil && *params.Replay {
+	if journalReplay {
+		// pass
+	} else if params.Replay != nil && *params.Replay {
 		replay = true
 	} else if params.Cache != nil && *params.Cache {
 		replay = true
 	}
 
+	if journalReplay {
+		journal := eventjournal.Default()
+		if hasSeq {
+			// wait for the journal to record the events published before
+			// the subscription start, so no event is missed.
+			waitCtx, waitCancel := context.WithTimeout(evCtx, journalWaitTimeout)
+			if err := journal.Wait(waitCtx, bus.Seq()); err != nil {
+				log.Warnf("wait event journal: %s", err)
+			}
+			waitCancel()
+		}
+		var errWrite error
+		err := journal.Read(lastEventID, since, func(r eventjournal.Record) bool {
+			if !isFiltered(r) {
+				return true
+			}
+			if !isAllowed(r.Labels) {
+				return true
+			}
+			if hasSelector && !isSelected(r.Labels) {
+				return true
+			}
+			if errWrite = doEvent(r.Event()); errWrite != nil {
+				return false
+			}
+			replayedID = r.ID
+			return limit == 0 || eventCount < limit
+		})
+		if errWrite != nil {
+			log.Tracef("do journaled event failed: %s", errWrite)
+			return nil
+		} else if err != nil {
+			log.Warnf("read event journal: %s", err)
+		}
+		if limit > 0 && eventCount >= limit {
+			log.Tracef("reach event count limit")
+			return nil
+		}
+	}
+
 	if replay {
 		data := msgbus.NewClusterData(a.Daemondata.ClusterData())
 		if len(filters) == 0 {
@@ -409,17 +492,17 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 			}
 			for _, anyE := range anyL {
 				if msg, ok := anyE.(pubsub.Messager); ok {
-					if !isAllowed(msg) {
+					if !isAllowed(msg.GetLabels()) {
 						continue
 					}
-					if hasSelector && !isSelected(msg) {
+					if hasSelector && !isSelected(msg.GetLabels()) {
 						continue
 					}
 				} else {
 					// unexpected event type, skip it
 					continue
 				}
-				if err := doEvent(anyE); err != nil {
+				if err := doEvent(event.ToEvent(anyE, startSeq)); err != nil {
 					log.Tracef("do event failed on %v: %s", anyE, err)
 					return nil
 				}
@@ -437,8 +520,16 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		case <-evCtx.Done():
 			return nil
 		case i := <-sub.C:
+			var seq uint64
+			if o, ok := i.(*pubsub.Sequenced); ok {
+				seq, i = o.Seq, o.Data
+			}
+			if seq > 0 && seq <= replayedID {
+				// already sent from the journal
+				continue
+			}
 			if ev, ok := i.(pubsub.Messager); ok {
-				if !isAllowed(ev) {
+				if !isAllowed(ev.GetLabels()) {
 					continue
 				}
 			}
@@ -462,7 +553,7 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 						// not required on response stream
 						continue
 					}
-					if !isSelected(ev) {
+					if !isSelected(ev.GetLabels()) {
 						// message is not for selected path
 						continue
 					}
@@ -496,13 +587,13 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 					if notAnymoreSelected {
 						// message from a previously selected path, that will
 						// be now discarted, we have to send this last message
-					} else if !isSelected(ev) {
+					} else if !isSelected(ev.GetLabels()) {
 						// message is not for selected path
 						continue
 					}
 					// message will be forwarded
 				case pubsub.Messager:
-					if !isSelected(ev) {
+					if !isSelected(ev.GetLabels()) {
 						// message is not for selected path
 						continue
 					}
@@ -510,7 +601,7 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 				}
 			}
 
-			if err := doEvent(i); err != nil {
+			if err := doEvent(event.ToEvent(i, seq)); err != nil {
 				log.Warnf("doEvent error for %v: %s", i, err)
 				return nil
 			}
@@ -523,248 +614,9 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 }
 
 // parseFilters return filters from b.Filter
-func parseFilters(params api.GetDaemonEventsParams) (filters []Filter, err error) {
-	var more []Filter
-	matchKind := make(map[string]bool)
-
+func parseFilters(params api.GetDaemonEventsParams) ([]Filter, error) {
 	if params.Filter == nil {
-		return
-	}
-
-	for _, s := range *params.Filter {
-		if len(s) == 0 {
-			continue
-		}
-		more, err = parseFilter(s)
-		if err != nil {
-			return
-		}
-		for _, filter := range more {
-			if filter.IsZero() {
-				continue
-			}
-			if k, ok := filter.Kind.(kinder); ok {
-				kind := k.Kind()
-				hasMatcher, alreadyFiltered := matchKind[kind]
-				if hasMatcher || (alreadyFiltered && len(filter.DataFilters) > 0) {
-					return nil, fmt.Errorf("can't filter same kind multiple times when it has a value matcher: %s", kind)
-				}
-				matchKind[kind] = len(filter.DataFilters) > 0
-			}
-			filters = append(filters, filter)
-		}
-	}
-	return
-}
-
-// parseFilter return filter from s
-//
-// filter syntax is: [kind][,label=value][,.abcd.efgh=value]*
-func parseFilter(filterStr string) ([]Filter, error) {
-	var (
-		filter Filter
-		kinds  []any
-	)
-
-	parseLabelFilter := func(s string) (pubsub.Label, error) {
-		split := strings.SplitN(s, "=", 2)
-		if len(split) != 2 {
-			return pubsub.Label{}, fmt.Errorf("invalid label filter expression: %s (expecting <key><op><value>)", s)
-		}
-		key := strings.TrimSpace(split[0])
-		value := strings.TrimSpace(split[1])
-		if len(key) == 0 {
-			return pubsub.Label{}, fmt.Errorf("invalid label filter expression: %s (empty key)", s)
-		}
-		return pubsub.Label{key, value}, nil
-	}
-
-	parseDataFilter := func(s string) (DataFilter, error) {
-		ops := []string{
-			"!=", ">=", "<=", // keep longer operators first
-			"=", ">", "<",
-		}
-		for _, op := range ops {
-			split := strings.SplitN(s, op, 2)
-			if len(split) != 2 {
-				continue
-			}
-			key := strings.TrimSpace(split[0])
-			value := strings.TrimSpace(split[1])
-			return DataFilter{Key: key, Value: value, Op: op}, nil
-		}
-		return DataFilter{}, fmt.Errorf("invalid filter expression: %s (unknown operator)", s)
-	}
-
-	for _, filterElement := range strings.Split(filterStr, ",") {
-		switch {
-		case len(filterElement) == 0:
-			continue
-		case strings.HasPrefix(filterElement, "."):
-			dataFilter, err := parseDataFilter(strings.TrimPrefix(filterElement, "."))
-			if err != nil {
-				return nil, err
-			}
-			filter.DataFilters = append(filter.DataFilters, dataFilter)
-		case strings.Contains(filterElement, "="):
-			label, err := parseLabelFilter(filterElement)
-			if err != nil {
-				return nil, err
-			}
-			filter.Labels = append(filter.Labels, label)
-		default:
-			kind, err := msgbus.KindToT(filterElement)
-			if err != nil {
-				return nil, err
-			}
-			kinds = append(kinds, kind)
-		}
-	}
-	if kinds == nil {
-		return []Filter{filter}, nil
-	}
-	var filters []Filter
-	for _, kind := range kinds {
-		filters = append(filters, Filter{
-			Kind:        kind,
-			Labels:      filter.Labels,
-			DataFilters: filter.DataFilters,
-		})
-	}
-	return filters, nil
-}
-
-func (f Filter) IsZero() bool {
-	return f.Kind == nil && len(f.DataFilters) == 0 && len(f.Labels) == 0
-}
-
-func (df DataFilters) match(i any) bool {
-	keys := flatten.Flatten(i)
-
-	intLessOrEqual := func(str1, str2 string) (bool, error) {
-		num1, err1 := strconv.Atoi(str1)
-		num2, err2 := strconv.Atoi(str2)
-		if err1 != nil || err2 != nil {
-			return false, fmt.Errorf("invalid input: both strings must be valid integers")
-		}
-		return num1 <= num2, nil
-	}
-
-	intLess := func(str1, str2 string) (bool, error) {
-		num1, err1 := strconv.Atoi(str1)
-		num2, err2 := strconv.Atoi(str2)
-		if err1 != nil || err2 != nil {
-			return false, fmt.Errorf("invalid input: both strings must be valid integers")
-		}
-		return num1 < num2, nil
-	}
-
-	intGreaterOrEqual := func(str1, str2 string) (bool, error) {
-		num1, err1 := strconv.Atoi(str1)
-		num2, err2 := strconv.Atoi(str2)
-		if err1 != nil || err2 != nil {
-			return false, fmt.Errorf("invalid input: both strings must be valid integers")
-		}
-		return num1 >= num2, nil
-	}
-
-	intGreater := func(str1, str2 string) (bool, error) {
-		num1, err1 := strconv.Atoi(str1)
-		num2, err2 := strconv.Atoi(str2)
-		if err1 != nil || err2 != nil {
-			return false, fmt.Errorf("invalid input: both strings must be valid integers")
-		}
-		return num1 > num2, nil
-	}
-
-	float64LessOrEqual := func(str1, str2 string) (bool, error) {
-		num1, err1 := strconv.ParseFloat(str1, 64)
-		num2, err2 := strconv.ParseFloat(str2, 64)
-		if err1 != nil || err2 != nil {
-			return false, fmt.Errorf("invalid input: both strings must be valid float64")
-		}
-		return num1 <= num2, nil
-	}
-
-	float64Less := func(str1, str2 string) (bool, error) {
-		num1, err1 := strconv.ParseFloat(str1, 64)
-		num2, err2 := strconv.ParseFloat(str2, 64)
-		if err1 != nil || err2 != nil {
-			return false, fmt.Errorf("invalid input: both strings must be valid float64")
-		}
-		return num1 < num2, nil
-	}
-
-	float64GreaterOrEqual := func(str1, str2 string) (bool, error) {
-		num1, err1 := strconv.ParseFloat(str1, 64)
-		num2, err2 := strconv.ParseFloat(str2, 64)
-		if err1 != nil || err2 != nil {
-			return false, fmt.Errorf("invalid input: both strings must be valid float64")
-		}
-		return num1 >= num2, nil
-	}
-
-	float64Greater := func(str1, str2 string) (bool, error) {
-		num1, err1 := strconv.ParseFloat(str1, 64)
-		num2, err2 := strconv.ParseFloat(str2, 64)
-		if err1 != nil || err2 != nil {
-			return false, fmt.Errorf("invalid input: both strings must be valid float64")
-		}
-		return num1 > num2, nil
-	}
-
-	matchDataFilter := func(m DataFilter) bool {
-		s, ok := keys[m.Key]
-		if !ok {
-			return false
-		}
-		s = strings.TrimSpace(s)
-		switch m.Op {
-		case "=":
-			return s == m.Value
-		case "!=":
-			return s != m.Value
-		case "<":
-			if v, err := intLess(s, m.Value); err == nil {
-				return v
-			}
-			if v, err := float64Less(s, m.Value); err == nil {
-				return v
-			}
-			return s < m.Value
-		case ">":
-			if v, err := intGreater(s, m.Value); err == nil {
-				return v
-			}
-			if v, err := float64Greater(s, m.Value); err == nil {
-				return v
-			}
-			return s > m.Value
-		case "<=":
-			if v, err := intLessOrEqual(s, m.Value); err == nil {
-				return v
-			}
-			if v, err := float64LessOrEqual(s, m.Value); err == nil {
-				return v
-			}
-			return s <= m.Value
-		case ">=":
-			if v, err := intGreaterOrEqual(s, m.Value); err == nil {
-				return v
-			}
-			if v, err := float64GreaterOrEqual(s, m.Value); err == nil {
-				return v
-			}
-			return s >= m.Value
-		default:
-			return false
-		}
-	}
-
-	for _, m := range df {
-		if !matchDataFilter(m) {
-			return false
-		}
+		return nil, nil
 	}
-	return true
+	return eventfilter.ParseList(*params.Filter)
 }
@@ -4,12 +4,8 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/require"
 
-	"github.com/opensvc/om3/v3/core/event"
-	"github.com/opensvc/om3/v3/core/instance"
-	"github.com/opensvc/om3/v3/core/status"
 	"github.com/opensvc/om3/v3/daemon/api"
 	"github.com/opensvc/om3/v3/daemon/msgbus"
 	"github.com/opensvc/om3/v3/util/pubsub"
@@ -169,48 +165,3 @@ func TestGetDaemonEventsBadParams(t *testing.T) {
 		})
 	}
 }
-
-func TestDataFilters(t *testing.T) {
-	msg := msgbus.InstanceStatusUpdated{Value: instance.Status{Overall: status.Down, Avail: status.StandbyDown}}
-	ev := event.ToEvent(&msg, 1)
-	v := make(map[string]any)
-	if err := json.Unmarshal(ev.Data, &v); err != nil {
-		return
-	}
-
-	matched := map[string]DataFilters{
-		"match uniq value": {{Key: "instance_status.overall", Op: "=", Value: string("\"down\"")}},
-		"matched both values": DataFilters{
-			{Key: "instance_status.overall", Op: "=", Value: string("\"down\"")},
-			{Key: "instance_status.avail", Op: "=", Value: string("\"stdby down\"")},
-		},
-	}
-
-	notMatched := map[string]DataFilters{
-		"unmatched the value": {{Key: "instance_status.overall", Op: "=", Value: string("\"up\"")}},
-		"unmatched second value": DataFilters{
-			{Key: "instance_status.overall", Op: "=", Value: string("\"down\"")},
-			{Key: "instance_status.avail", Op: "=", Value: string("\"stdby up\"")},
-		},
-		"unmatched first value": DataFilters{
-			{Key: "instance_status.overall", Op: "=", Value: string("\"up\"")},
-			{Key: "instance_status.avail", Op: "=", Value: string("\"stdby down\"")},
-		},
-		"unmatched on both values": DataFilters{
-			{Key: "instance_status.overall", Op: "=", Value: string("\"up\"")},
-			{Key: "instance_status.avail", Op: "=", Value: string("\"up\"")},
-		},
-	}
-
-	for s, c := range matched {
-		t.Run(s, func(t *testing.T) {
-			require.Truef(t, c.match(v), "'%s' should match '%s'", v, c)
-		})
-	}
-
-	for s, c := range notMatched {
-		t.Run(s, func(t *testing.T) {
-			require.Falsef(t, c.match(v), "'%s' shouldn't match '%s'", v, c)
-		})
-	}
-}
@@ -9,10 +9,11 @@ import (
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/schedule"
 	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/rbac"
 )
 
 func (a *DaemonAPI) GetInstanceSchedule(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string) error {
-	if v, err := assertGuest(ctx, namespace); !v {
+	if v, err := assertPermission(ctx, rbac.PermRead, namespace, kind, name); !v {
 		return err
 	}
 	nodename = a.parseNodename(nodename)
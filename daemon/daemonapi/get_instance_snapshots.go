@@ -0,0 +1,67 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/rbac"
+)
+
+func (a *DaemonAPI) GetInstanceSnapshots(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string) error {
+	if v, err := assertPermission(ctx, rbac.PermRead, namespace, kind, name); !v {
+		return err
+	}
+	if kind != naming.KindVol {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "snapshots are only supported by vol objects")
+	}
+	nodename = a.parseNodename(nodename)
+	if a.localhost == nodename {
+		return a.getLocalInstanceSnapshots(ctx, namespace, kind, name)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.GetInstanceSnapshots(ctx.Request().Context(), nodename, namespace, kind, name)
+	})
+}
+
+func (a *DaemonAPI) getLocalInstanceSnapshots(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
+	path, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "New path", "%s", err)
+	}
+	if !path.Exists() {
+		return JSONProblemf(ctx, http.StatusNotFound, "No local instance", "")
+	}
+	o, err := object.NewVol(path, object.WithVolatile(true))
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "New object", "%s", err)
+	}
+	l, err := o.ListSnapshots(ctx.Request().Context())
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "List snapshots", "%s", err)
+	}
+	resp := api.SnapshotList{
+		Kind:  "SnapshotList",
+		Items: api.SnapshotItems{},
+	}
+	for _, e := range l {
+		item := api.SnapshotItem{
+			Kind: "SnapshotItem",
+			Meta: api.InstanceMeta{
+				Node:   a.localhost,
+				Object: path.String(),
+			},
+			Data: api.Snapshot{
+				CreatedAt: e.CreatedAt,
+				Name:      e.Name,
+				Used:      e.Used,
+			},
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,58 @@
+package daemonapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+func (a *DaemonAPI) GetInstanceSyncData(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string, params api.GetInstanceSyncDataParams) error {
+	if v, err := assertRoot(ctx); !v {
+		return err
+	}
+	nodename = a.parseNodename(nodename)
+	if a.localhost == nodename {
+		return a.getLocalInstanceSyncData(ctx, namespace, kind, name, params)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.GetInstanceSyncData(ctx.Request().Context(), nodename, namespace, kind, name, &params)
+	})
+}
+
+func (a *DaemonAPI) getLocalInstanceSyncData(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.GetInstanceSyncDataParams) error {
+	type resumeTokener interface {
+		ResumeToken(context.Context) (string, error)
+	}
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	if !p.Exists() {
+		return JSONProblemf(ctx, http.StatusNotFound, "Object not found", "")
+	}
+	if err := assertSyncRID(params.Rid); err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	o, err := object.NewActor(p, object.WithVolatile(true))
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "New object", "%s", err)
+	}
+	r := o.ResourceByID(params.Rid)
+	if r == nil {
+		return JSONProblemf(ctx, http.StatusNotFound, "Resource not found", "%s", params.Rid)
+	}
+	var data api.SyncDataState
+	if i, ok := r.(resumeTokener); ok {
+		// drivers not implementing ResumeToken can't resume a stream
+		if data.ResumeToken, err = i.ResumeToken(ctx.Request().Context()); err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Get sync data resume token", "%s", err)
+		}
+	}
+	return ctx.JSON(http.StatusOK, data)
+}
@@ -7,10 +7,11 @@ import (
 
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/rbac"
 )
 
 func (a *DaemonAPI) GetInstanceLogs(ctx echo.Context, nodename string, namespace string, kind naming.Kind, name string, params api.GetInstanceLogsParams) error {
-	if v, err := assertGuest(ctx, namespace); !v {
+	if v, err := assertPermission(ctx, rbac.PermRead, namespace, kind, name); !v {
 		return err
 	}
 	nodename = a.parseNodename(nodename)
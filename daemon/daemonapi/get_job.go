@@ -0,0 +1,62 @@
+package daemonapi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/clusternode"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/jobs"
+)
+
+// GetJob returns a job. Without a node parameter, the job is searched on
+// the local node first, then on the peer nodes.
+func (a *DaemonAPI) GetJob(ctx echo.Context, id api.InPathJobID, params api.GetJobParams) error {
+	nodename := a.jobQueryNode(params.Node)
+	switch nodename {
+	case a.localhost:
+		return a.getLocalJob(ctx, id)
+	case "":
+	default:
+		return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+			return c.GetJob(ctx.Request().Context(), id, &params)
+		})
+	}
+	if _, err := jobs.Default().Get(id); err == nil {
+		return a.getLocalJob(ctx, id)
+	}
+	for _, nodename := range clusternode.Get() {
+		if nodename == a.localhost {
+			continue
+		}
+		c, err := a.newProxyClient(ctx, nodename)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+		}
+		resp, err := c.GetJobWithResponse(ctx.Request().Context(), id, &api.GetJobParams{Node: &nodename})
+		if err != nil {
+			LogHandler(ctx, "GetJob").Warnf("%s: get job %s: %s", nodename, id, err)
+			continue
+		} else if resp.StatusCode() == http.StatusNotFound {
+			continue
+		}
+		return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+	}
+	return JSONProblemf(ctx, http.StatusNotFound, "Not found", "job %s", id)
+}
+
+func (a *DaemonAPI) getLocalJob(ctx echo.Context, id api.InPathJobID) error {
+	j, err := jobs.Default().Get(id)
+	switch {
+	case errors.Is(err, jobs.ErrNotFound):
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "job %s", id)
+	case err != nil:
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Get job", "%s", err)
+	case !canReadJob(ctx, j):
+		return JSONProblemf(ctx, http.StatusForbidden, "Forbidden", "not allowed to read job %s", id)
+	}
+	return ctx.JSON(http.StatusOK, j.Job)
+}
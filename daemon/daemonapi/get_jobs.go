@@ -0,0 +1,63 @@
+package daemonapi
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/clusternode"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/jobs"
+)
+
+// GetJobs returns the jobs the user is allowed to read. Without a node
+// parameter, the jobs of all the cluster nodes are returned.
+func (a *DaemonAPI) GetJobs(ctx echo.Context, params api.GetJobsParams) error {
+	nodename := a.jobQueryNode(params.Node)
+	switch nodename {
+	case a.localhost:
+		return ctx.JSON(http.StatusOK, api.JobList{Kind: "JobList", Items: a.localJobs(ctx)})
+	case "":
+	default:
+		return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+			return c.GetJobs(ctx.Request().Context(), &params)
+		})
+	}
+	log := LogHandler(ctx, "GetJobs")
+	items := a.localJobs(ctx)
+	for _, nodename := range clusternode.Get() {
+		if nodename == a.localhost {
+			continue
+		}
+		c, err := a.newProxyClient(ctx, nodename)
+		if err != nil {
+			log.Warnf("%s: new client: %s", nodename, err)
+			continue
+		}
+		resp, err := c.GetJobsWithResponse(ctx.Request().Context(), &api.GetJobsParams{Node: &nodename})
+		if err != nil {
+			log.Warnf("%s: get jobs: %s", nodename, err)
+			continue
+		} else if resp.JSON200 == nil {
+			log.Warnf("%s: get jobs: unexpected status %d", nodename, resp.StatusCode())
+			continue
+		}
+		items = append(items, resp.JSON200.Items...)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].CreatedAt.Before(items[j].CreatedAt)
+	})
+	return ctx.JSON(http.StatusOK, api.JobList{Kind: "JobList", Items: items})
+}
+
+func (a *DaemonAPI) localJobs(ctx echo.Context) api.JobItems {
+	items := make(api.JobItems, 0)
+	for _, j := range jobs.Default().List() {
+		if canReadJob(ctx, j) {
+			items = append(items, j.Job)
+		}
+	}
+	return items
+}
@@ -65,8 +65,13 @@ func (a *DaemonAPI) GetNodes(ctx echo.Context, params api.GetNodesParams) error
 			for i, hook := range config.Value.Hooks {
 				d.Data.Config.Hooks[i] = api.NodeConfigHook{
 					Name:    hook.Name,
+					Type:    hook.Type,
 					Events:  hook.Events,
+					Filters: hook.Filters,
 					Command: hook.Command,
+					Url:     hook.URL,
+					Timeout: hook.Timeout,
+					Retries: hook.Retries,
 				}
 			}
 			for k, v := range config.Value.Labels {
@@ -94,6 +99,13 @@ func (a *DaemonAPI) GetNodes(ctx echo.Context, params api.GetNodesParams) error
 			for k, v := range status.Gen {
 				d.Data.Status.Gen[k] = v
 			}
+			if status.Maintenance != nil {
+				d.Data.Status.Maintenance = &api.NodeMaintenance{
+					Reason:   status.Maintenance.Reason,
+					Since:    status.Maintenance.Since,
+					ExpireAt: status.Maintenance.ExpireAt,
+				}
+			}
 		}
 		if monitor != nil {
 			d.Data.Monitor = &api.NodeMonitor{
@@ -0,0 +1,80 @@
+package daemonapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/auditlog"
+	"github.com/opensvc/om3/v3/util/converters"
+)
+
+// GetNodeAuditLog returns the api requests audit records of a node.
+func (a *DaemonAPI) GetNodeAuditLog(ctx echo.Context, nodename api.InPathNodeName, params api.GetNodeAuditLogParams) error {
+	if v, err := assertRoot(ctx); !v {
+		return err
+	}
+	nodename = a.parseNodename(nodename)
+	if a.localhost == nodename {
+		return a.getLocalNodeAuditLog(ctx, params)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.GetNodeAuditLog(ctx.Request().Context(), nodename, &params)
+	})
+}
+
+func (a *DaemonAPI) getLocalNodeAuditLog(ctx echo.Context, params api.GetNodeAuditLogParams) error {
+	var (
+		since time.Time
+		limit int
+	)
+	if params.Since != nil && *params.Since != "" {
+		if d, err := converters.ParseDuration(*params.Since); err == nil {
+			since = time.Now().Add(-d)
+		} else if t, err := time.Parse(time.RFC3339, *params.Since); err == nil {
+			since = t
+		} else {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "since: %s is neither a duration nor a RFC3339 time", *params.Since)
+		}
+	}
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+	records, err := auditlog.Default().Read(since, limit)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Read audit log", "%s", err)
+	}
+	resp := api.AuditRecordList{
+		Kind:  "AuditRecordList",
+		Items: make(api.AuditRecordItems, 0, len(records)),
+	}
+	for _, r := range records {
+		item := api.AuditRecord{
+			At:          r.At,
+			Grant:       r.Grants,
+			Method:      r.Method,
+			Node:        r.Node,
+			OperationId: r.OperationID,
+			RequestId:   r.RequestID,
+			SourceIp:    r.SourceIP,
+			Status:      r.Status,
+			Strategy:    r.Strategy,
+			Url:         r.URL,
+			User:        r.User,
+		}
+		if item.Grant == nil {
+			item.Grant = []string{}
+		}
+		if r.Object != "" {
+			item.Object = &r.Object
+		}
+		if len(r.Params) > 0 {
+			item.Params = &r.Params
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
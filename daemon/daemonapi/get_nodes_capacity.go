@@ -0,0 +1,57 @@
+package daemonapi
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/core/placement"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+func (a *DaemonAPI) GetNodesCapacity(ctx echo.Context, params api.GetNodesCapacityParams) error {
+	if v, err := assertRoot(ctx); !v {
+		return err
+	}
+	meta := Meta{
+		Context: ctx,
+		Node:    params.Node,
+	}
+	name := "GetNodesCapacity"
+	log := LogHandler(ctx, name)
+	if err := meta.Expand(); err != nil {
+		log.Errorf("%s: %s", name, err)
+		return JSONProblem(ctx, http.StatusInternalServerError, "Server error", "expand selection")
+	}
+	newResources := func(r placement.Resources) api.NodeResources {
+		return api.NodeResources{
+			CPUs: r.CPUs,
+			Mem:  r.Mem,
+		}
+	}
+	l := make(api.NodeCapacityItems, 0)
+	for nodename, capacity := range node.GetCapacities(naming.Path{}) {
+		if !meta.HasNode(nodename) {
+			continue
+		}
+		l = append(l, api.NodeCapacityItem{
+			Kind: "NodeCapacityItem",
+			Meta: api.NodeMeta{
+				Node: nodename,
+			},
+			Data: api.NodeCapacity{
+				Free:      newResources(capacity.Free()),
+				Instances: capacity.Instances,
+				Requested: newResources(capacity.Requested),
+				Total:     newResources(capacity.Total),
+			},
+		})
+	}
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].Meta.Node < l[j].Meta.Node
+	})
+	return ctx.JSON(http.StatusOK, api.NodeCapacityList{Kind: "NodeCapacityList", Items: l})
+}
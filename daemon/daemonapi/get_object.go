@@ -52,6 +52,7 @@ func (a *DaemonAPI) getObjects(ctx echo.Context, pathSelector *string) (api.Obje
 
 	hasRoot := grantsFromContext(ctx).HasRole(rbac.RoleRoot)
 	userGrants := grantsFromContext(ctx)
+	roles := customRoles()
 
 	getCore := func(p naming.Path, ostat *object.Status) api.ObjectCore {
 		core := api.ObjectCore{
@@ -83,6 +84,14 @@ func (a *DaemonAPI) getObjects(ctx echo.Context, pathSelector *string) (api.Obje
 			Topology:         api.Topology(ostat.Topology.String()),
 			UpInstancesCount: ostat.UpInstancesCount,
 		}
+		if ostat.PlacementReason != "" {
+			reason := ostat.PlacementReason
+			actor.PlacementReason = &reason
+		}
+		if len(ostat.PlacementScores) > 0 {
+			scores := ostat.PlacementScores.DeepCopy()
+			actor.PlacementScores = &scores
+		}
 		if ostat.Flex != nil {
 			actor.Flex = &api.FlexConfig{
 				Max:    ostat.Flex.Max,
@@ -102,7 +111,7 @@ func (a *DaemonAPI) getObjects(ctx echo.Context, pathSelector *string) (api.Obje
 
 	l := make(api.ObjectItems, 0)
 	for _, p := range meta.Paths() {
-		if !hasRoot && !hasRoleGuestOn(userGrants, p.Namespace) {
+		if !hasRoot && !canRead(userGrants, roles, p) {
 			continue
 		}
 		ostat := object.StatusData.GetByPath(p)
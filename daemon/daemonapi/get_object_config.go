@@ -9,11 +9,12 @@ import (
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/object"
 	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/rbac"
 	"github.com/opensvc/om3/v3/util/key"
 )
 
 func (a *DaemonAPI) GetObjectConfig(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.GetObjectConfigParams) error {
-	if v, err := assertGuest(ctx, namespace); !v {
+	if v, err := assertPermission(ctx, rbac.PermRead, namespace, kind, name); !v {
 		return err
 	}
 	log := LogHandler(ctx, "GetObjectConfig")
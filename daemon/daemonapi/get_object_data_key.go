@@ -11,6 +11,7 @@ import (
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/object"
 	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/rbac"
 )
 
 func (a *DaemonAPI) GetObjectDataKey(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.GetObjectDataKeyParams) error {
@@ -21,7 +22,7 @@ func (a *DaemonAPI) GetObjectDataKey(ctx echo.Context, namespace string, kind na
 			return err
 		}
 	} else {
-		if v, err := assertGuest(ctx, namespace); !v {
+		if v, err := assertPermission(ctx, rbac.PermRead, namespace, kind, name); !v {
 			return err
 		}
 	}
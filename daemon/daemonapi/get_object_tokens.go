@@ -0,0 +1,57 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+func (a *DaemonAPI) GetObjectTokens(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
+	p, err := usrTokenPath(ctx, namespace, kind, name)
+	if err != nil {
+		return err
+	}
+	if v, err := assertUsrTokenManager(ctx, p, true); !v {
+		return err
+	}
+
+	instanceConfigData := instance.ConfigData.GetByPath(p)
+
+	if _, ok := instanceConfigData[a.localhost]; ok {
+		o, err := object.NewUsr(p, object.WithVolatile(true))
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "NewUsr", "%s", err)
+		}
+		l, err := o.Tokens()
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Tokens", "%s", err)
+		}
+		items := make(api.UsrTokenItems, 0, len(l))
+		for _, tk := range l {
+			items = append(items, usrTokenItem(p, tk))
+		}
+		return ctx.JSON(http.StatusOK, api.UsrTokenList{
+			Kind:  "UsrTokenList",
+			Items: items,
+		})
+	}
+
+	for nodename := range instanceConfigData {
+		c, err := a.newProxyClient(ctx, nodename)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+		}
+		if resp, err := c.GetObjectTokensWithResponse(ctx.Request().Context(), namespace, kind, name); err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
+		} else if len(resp.Body) > 0 {
+			return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+		}
+	}
+
+	return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s", p)
+}
@@ -79,6 +79,11 @@ func (a *DaemonAPI) getNodePools(ctx echo.Context, name *string, nodeMap nodesel
 			l := append([]string{}, stat.Errors...)
 			item.Errors = &l
 		}
+		setPoolThinUsage(&item, stat)
+		if len(stat.Warnings) > 0 {
+			l := append([]string{}, stat.Warnings...)
+			item.Warnings = &l
+		}
 		items = append(items, item)
 	}
 	return items
@@ -120,6 +125,17 @@ func (a *DaemonAPI) getClusterPools(ctx echo.Context, name *string) api.PoolItem
 			l := append([]string{}, stat.Errors...)
 			item.Errors = &l
 		}
+		setPoolThinUsage(&item, stat)
+		if len(stat.Warnings) > 0 {
+			var l []string
+			if item.Warnings != nil {
+				l = *item.Warnings
+			}
+			for _, w := range stat.Warnings {
+				l = append(l, e.Node+": "+w)
+			}
+			item.Warnings = &l
+		}
 		m[e.Name] = item
 	}
 	for _, item := range m {
@@ -127,3 +143,25 @@ func (a *DaemonAPI) getClusterPools(ctx echo.Context, name *string) api.PoolItem
 	}
 	return items
 }
+
+// setPoolThinUsage adds the thin pool usage of a node pool status to the
+// item. The provisioned sizes are summed and the highest fill ratios are
+// kept.
+func setPoolThinUsage(item *api.Pool, stat pool.Status) {
+	if stat.Provisioned > 0 {
+		var v int64
+		if item.Provisioned != nil && !stat.Shared {
+			v = *item.Provisioned
+		}
+		v += stat.Provisioned
+		item.Provisioned = &v
+	}
+	if stat.DataPercent > 0 && (item.DataPercent == nil || *item.DataPercent < stat.DataPercent) {
+		v := stat.DataPercent
+		item.DataPercent = &v
+	}
+	if stat.MetadataPercent > 0 && (item.MetadataPercent == nil || *item.MetadataPercent < stat.MetadataPercent) {
+		v := stat.MetadataPercent
+		item.MetadataPercent = &v
+	}
+}
@@ -28,9 +28,10 @@ func (a *DaemonAPI) GetResources(ctx echo.Context, params api.GetResourcesParams
 	items := make(api.ResourceItems, 0)
 	hasRoot := grantsFromContext(ctx).HasRole(rbac.RoleRoot)
 	userGrants := grantsFromContext(ctx)
+	roles := customRoles()
 
 	for _, config := range configs {
-		if !hasRoot && !hasRoleGuestOn(userGrants, config.Path.Namespace) {
+		if !hasRoot && !canRead(userGrants, roles, config.Path) {
 			continue
 		}
 		if !meta.HasPath(config.Path.String()) {
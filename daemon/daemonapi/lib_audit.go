@@ -0,0 +1,223 @@
+package daemonapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/auditlog"
+	"github.com/opensvc/om3/v3/util/hostname"
+)
+
+var (
+	// auditSkipPaths are the mutating routes not audited, because they are
+	// used by the daemons themselves at high frequency.
+	auditSkipPaths = map[string]any{
+		"/api/relay/message": nil,
+	}
+
+	regexpPathParam = regexp.MustCompile(`{([^}]+)}`)
+
+	// regexpAuditSecretParam matches the names of the request parameters
+	// whose values are redacted from the audit records. It also matches
+	// the keywords of the "<keyword>=<value>" parameter values, like the
+	// set parameter values, whose value part is redacted.
+	regexpAuditSecretParam = regexp.MustCompile(`(?i)(pass|secret|token|credential|private|^bytes$|^string$|^value$|^data$)`)
+
+	// auditMaxBodySize is the size of the largest request body whose
+	// parameters are audited.
+	auditMaxBodySize int64 = 64 * 1024
+)
+
+const (
+	auditRedacted = "xxxx"
+)
+
+// operationIDs returns the map of the api operation ids indexed by
+// "<method> <echo route path>".
+func operationIDs() map[string]string {
+	m := make(map[string]string)
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		return m
+	}
+	for path, pathItem := range swagger.Paths.Map() {
+		route := regexpPathParam.ReplaceAllString(path, ":$1")
+		for method, operation := range pathItem.Operations() {
+			m[method+" "+route] = operation.OperationID
+		}
+	}
+	return m
+}
+
+// AuditMiddleware appends an audit record of the mutating api requests
+// to the local audit store, after the request is handled.
+func AuditMiddleware(parent context.Context) echo.MiddlewareFunc {
+	log := logWithFamilyAndAddr(parent)
+	store := auditlog.Default()
+	ids := operationIDs()
+	localhost := hostname.Hostname()
+
+	isAudited := func(c echo.Context) bool {
+		switch c.Request().Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return false
+		}
+		_, skip := auditSkipPaths[c.Path()]
+		return !skip
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !isAudited(c) {
+				return next(c)
+			}
+			begin := time.Now()
+			params := auditParams(c.Request())
+			err := next(c)
+
+			r := c.Request()
+			record := auditlog.Record{
+				At:          begin,
+				Node:        localhost,
+				RequestID:   uuidFromContext(c),
+				User:        userFromContext(c).GetUserName(),
+				Strategy:    strategyFromContext(c),
+				Grants:      grantsFromContext(c).AsStringList(),
+				SourceIP:    c.RealIP(),
+				Method:      r.Method,
+				URL:         r.URL.Path,
+				OperationID: ids[r.Method+" "+c.Path()],
+				Status:      c.Response().Status,
+			}
+			if namespace, kind, name := c.Param("namespace"), c.Param("kind"), c.Param("name"); namespace != "" && kind != "" && name != "" {
+				record.Object = naming.Path{Namespace: namespace, Kind: naming.ParseKind(kind), Name: name}.String()
+			}
+			if len(params) > 0 {
+				record.Params = params
+			}
+			if err != nil && !c.Response().Committed {
+				var httpError *echo.HTTPError
+				if errors.As(err, &httpError) {
+					record.Status = httpError.Code
+				} else {
+					record.Status = http.StatusInternalServerError
+				}
+			}
+			if err := store.Append(record); err != nil {
+				log.Errorf("audit %s %s: %s", r.Method, r.URL.Path, err)
+			}
+			return err
+		}
+	}
+}
+
+// auditParams returns the query parameters of the request r, merged with
+// its json or form encoded body parameters. The nested body parameter
+// names are dot-joined, and the values of the parameters looking like
+// secrets are redacted.
+//
+// The body is restored so the handler can read it.
+func auditParams(r *http.Request) map[string][]string {
+	params := make(map[string][]string)
+	for k, l := range r.URL.Query() {
+		for _, v := range l {
+			addAuditParam(params, k, v)
+		}
+	}
+	if r.Body == nil || r.Body == http.NoBody {
+		return params
+	}
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch contentType {
+	case "application/json", "application/x-www-form-urlencoded":
+	default:
+		return params
+	}
+	b, err := io.ReadAll(io.LimitReader(r.Body, auditMaxBodySize+1))
+	if int64(len(b)) > auditMaxBodySize || err != nil {
+		// too large to audit: hand the read bytes back to the handler
+		// followed by the unread remainder.
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(b), r.Body), r.Body}
+		return params
+	}
+	r.Body = io.NopCloser(bytes.NewReader(b))
+	switch contentType {
+	case "application/json":
+		var v any
+		if err := json.Unmarshal(b, &v); err == nil {
+			flattenAuditParam(params, "", v)
+		}
+	case "application/x-www-form-urlencoded":
+		if values, err := url.ParseQuery(string(b)); err == nil {
+			for k, l := range values {
+				for _, v := range l {
+					addAuditParam(params, k, v)
+				}
+			}
+		}
+	}
+	return params
+}
+
+func flattenAuditParam(params map[string][]string, k string, v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for subKey, subValue := range v {
+			if k != "" {
+				subKey = k + "." + subKey
+			}
+			flattenAuditParam(params, subKey, subValue)
+		}
+	case []any:
+		for _, item := range v {
+			flattenAuditParam(params, k, item)
+		}
+	case nil:
+		addAuditParam(params, k, "")
+	case string:
+		addAuditParam(params, k, v)
+	default:
+		addAuditParam(params, k, fmt.Sprint(v))
+	}
+}
+
+func addAuditParam(params map[string][]string, k, v string) {
+	if k == "" {
+		k = "body"
+	}
+	if isAuditSecretKey(k) {
+		v = auditRedacted
+	} else if i := strings.Index(v, "="); i > 0 && isAuditSecretKey(strings.TrimRight(v[:i], "+-|^")) {
+		// a keyword assignment like "set=cluster.secret=xxxx"
+		v = v[:i+1] + auditRedacted
+	}
+	params[k] = append(params[k], v)
+}
+
+// isAuditSecretKey returns true if an element of the dot-separated k
+// matches the secret parameter names.
+func isAuditSecretKey(k string) bool {
+	for _, name := range strings.Split(k, ".") {
+		if regexpAuditSecretParam.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
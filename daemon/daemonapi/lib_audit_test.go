@@ -0,0 +1,86 @@
+package daemonapi
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditParams(t *testing.T) {
+	cases := map[string]struct {
+		contentType string
+		body        string
+		expected    map[string][]string
+	}{
+		"json body": {
+			contentType: "application/json",
+			body:        `{"to":"node2","options":{"force":true,"password":"s3cr3t"},"nodes":["node1","node2"]}`,
+			expected: map[string][]string{
+				"rid":              {"fs#1"},
+				"to":               {"node2"},
+				"options.force":    {"true"},
+				"options.password": {auditRedacted},
+				"nodes":            {"node1", "node2"},
+			},
+		},
+		"json data patches": {
+			contentType: "application/json; charset=utf-8",
+			body:        `[{"action":"add","name":"k1","string":"v1"},{"action":"add","name":"k2","bytes":"djI="}]`,
+			expected: map[string][]string{
+				"rid":    {"fs#1"},
+				"action": {"add", "add"},
+				"name":   {"k1", "k2"},
+				"string": {auditRedacted},
+				"bytes":  {auditRedacted},
+			},
+		},
+		"form body": {
+			contentType: "application/x-www-form-urlencoded",
+			body:        "username=alice&client_secret=s3cr3t",
+			expected: map[string][]string{
+				"rid":           {"fs#1"},
+				"username":      {"alice"},
+				"client_secret": {auditRedacted},
+			},
+		},
+		"keyword assignments": {
+			contentType: "application/json",
+			body:        `{"set":["cluster.secret=s3cr3t","ldap.bind_password=s3cr3t","hook#x.secret+=s3cr3t","cluster.name=c1"]}`,
+			expected: map[string][]string{
+				"rid": {"fs#1"},
+				"set": {"cluster.secret=" + auditRedacted, "ldap.bind_password=" + auditRedacted, "hook#x.secret+=" + auditRedacted, "cluster.name=c1"},
+			},
+		},
+		"binary body": {
+			contentType: "application/octet-stream",
+			body:        `{"to":"node2"}`,
+			expected: map[string][]string{
+				"rid": {"fs#1"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/api/object?rid=fs%231", strings.NewReader(tc.body))
+			r.Header.Set("Content-Type", tc.contentType)
+			require.Equal(t, tc.expected, auditParams(r))
+			b, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Equal(t, tc.body, string(b), "expected the body restored for the handler")
+		})
+	}
+}
+
+func TestAuditParamsLargeBody(t *testing.T) {
+	body := `{"password":"` + strings.Repeat("x", int(auditMaxBodySize)) + `"}`
+	r := httptest.NewRequest(http.MethodPost, "/api/object", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	require.Empty(t, auditParams(r))
+	b, err := io.ReadAll(r.Body)
+	require.NoError(t, err)
+	require.Equal(t, body, string(b), "expected the body restored for the handler")
+}
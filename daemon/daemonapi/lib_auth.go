@@ -26,7 +26,8 @@ var (
 )
 
 // canCreateAccessToken determines whether an access token can be created based
-// on the token type (refresh token) or authentication strategy (UX or User).
+// on the token type (refresh token) or authentication strategy (UX, User or
+// LDAP).
 func (a *DaemonAPI) canCreateAccessToken(ctx echo.Context) bool {
 	if s, ok := ctx.Get(daemonauth.TkUseClaim).(string); ok && s == daemonauth.TkUseRefresh {
 		return true
@@ -35,6 +36,7 @@ func (a *DaemonAPI) canCreateAccessToken(ctx echo.Context) bool {
 	switch strategy {
 	case daemonauth.StrategyUX:
 	case daemonauth.StrategyUser:
+	case daemonauth.StrategyLDAP:
 	default:
 		return false
 	}
@@ -42,13 +44,14 @@ func (a *DaemonAPI) canCreateAccessToken(ctx echo.Context) bool {
 }
 
 // canCreateRefreshToken determines if a refresh token can be created based
-// on the authentication strategy from the context: It needs StrategyUX or
-// StrategyUser.
+// on the authentication strategy from the context: It needs StrategyUX,
+// StrategyUser or StrategyLDAP.
 func (a *DaemonAPI) canCreateRefreshToken(ctx echo.Context) bool {
 	strategy := strategyFromContext(ctx)
 	switch strategy {
 	case daemonauth.StrategyUX:
 	case daemonauth.StrategyUser:
+	case daemonauth.StrategyLDAP:
 	default:
 		return false
 	}
@@ -146,11 +149,28 @@ func (a *DaemonAPI) createToken(username, tkUseValue string, duration time.Durat
 
 func (a *DaemonAPI) createAccessToken(ctx echo.Context, username string, duration time.Duration, rolePtr *api.Roles, scopePtr *string) (d api.AuthAccessToken, err error) {
 	var grantL []string
-	if username == "root" && strategyFromContext(ctx) == daemonauth.StrategyUX {
+	strategy := strategyFromContext(ctx)
+	authStrategy := daemonauth.StrategyUser
+	if username == "root" && strategy == daemonauth.StrategyUX {
+		authStrategy = daemonauth.StrategyUX
 		grants := grantsFromContext(ctx)
 		for _, g := range grants {
 			grantL = append(grantL, g.String())
 		}
+	} else if authStrategyFromContext(ctx) == daemonauth.StrategyLDAP && username == userFromContext(ctx).GetUserName() {
+		// ldap users have no usr object: their grants are the grants of
+		// their ldap groups, looked up again on refresh as the refresh
+		// token carries no grant.
+		authStrategy = daemonauth.StrategyLDAP
+		if strategy == daemonauth.StrategyLDAP {
+			grants := grantsFromContext(ctx)
+			for _, g := range grants {
+				grantL = append(grantL, g.String())
+			}
+		} else if grantL, err = daemonauth.LDAPGrants(username); err != nil {
+			err := errors.Join(errForbidden, fmt.Errorf("ldap user grants for username '%s': %w", username, err))
+			return d, err
+		}
 	} else if grantL, err = userDB.GrantsFromUsername(username); err != nil {
 		err := errors.Join(errForbidden, fmt.Errorf("user grants for username '%s': %w", username, err))
 		return d, err
@@ -162,7 +182,7 @@ func (a *DaemonAPI) createAccessToken(ctx echo.Context, username string, duratio
 		return d, errors.Join(errForbidden, fmt.Errorf("no grant matching role and scope for username '%s'", username))
 	} else if claims, err := a.xClaimForGrants(grantL); err != nil {
 		return d, fmt.Errorf("create claims: %w", err)
-	} else if tk, exp, err := a.createToken(username, daemonauth.TkUseAccess, duration, claims); err != nil {
+	} else if tk, exp, err := a.createToken(username, daemonauth.TkUseAccess, duration, withAuthStrategyClaim(claims, authStrategy)); err != nil {
 		return d, fmt.Errorf("create token: %w", err)
 	} else {
 		d.AccessToken = tk
@@ -171,13 +191,15 @@ func (a *DaemonAPI) createAccessToken(ctx echo.Context, username string, duratio
 	}
 }
 
-func (a *DaemonAPI) createAccessTokenWithGrants(username string, duration time.Duration, tkUse string, grantL []string) (d api.AuthAccessToken, err error) {
+// createAccessTokenWithGrants creates a token with the grantL grants. The
+// authStrategy is the origin of the grants, recorded in the token.
+func (a *DaemonAPI) createAccessTokenWithGrants(username string, duration time.Duration, tkUse string, grantL []string, authStrategy string) (d api.AuthAccessToken, err error) {
 	if username == "" {
 		return d, fmt.Errorf("username is empty")
 	}
 	if claims, err := a.xClaimForGrants(grantL); err != nil {
 		return d, fmt.Errorf("create claims: %w", err)
-	} else if tk, exp, err := a.createToken(username, tkUse, duration, claims); err != nil {
+	} else if tk, exp, err := a.createToken(username, tkUse, duration, withAuthStrategyClaim(claims, authStrategy)); err != nil {
 		return d, fmt.Errorf("create token: %w", err)
 	} else {
 		d.AccessToken = tk
@@ -185,3 +207,16 @@ func (a *DaemonAPI) createAccessTokenWithGrants(username string, duration time.D
 		return d, nil
 	}
 }
+
+// withAuthStrategyClaim adds to claims the origin of the token grants, used
+// to tell the tokens issued from a usr object authentication apart.
+func withAuthStrategyClaim(claims map[string]any, authStrategy string) map[string]any {
+	if authStrategy == "" {
+		return claims
+	}
+	if claims == nil {
+		claims = make(map[string]any)
+	}
+	claims[daemonauth.TkAuthStrategyClaim] = authStrategy
+	return claims
+}
@@ -3,6 +3,7 @@ package daemonapi
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,31 +12,47 @@ import (
 
 	"github.com/opensvc/om3/v3/core/env"
 	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/jobs"
 	"github.com/opensvc/om3/v3/daemon/msgbus"
 	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/funcopt"
 	"github.com/opensvc/om3/v3/util/plog"
 	"github.com/opensvc/om3/v3/util/pubsub"
 	"github.com/opensvc/om3/v3/util/xsession"
 )
 
 func (a *DaemonAPI) apiExec(ctx echo.Context, p naming.Path, requesterSid uuid.UUID, args []string, log *plog.Logger) (uuid.UUID, error) {
+	sid, _, err := a.apiExecWithDone(ctx, p, requesterSid, args, log)
+	return sid, err
+}
+
+// apiExecWithDone starts the om command like apiExec, and also returns a
+// channel receiving the command exit error when it terminates.
+func (a *DaemonAPI) apiExecWithDone(ctx echo.Context, p naming.Path, requesterSid uuid.UUID, args []string, log *plog.Logger, opts ...funcopt.O) (uuid.UUID, <-chan error, error) {
 	execname, err := os.Executable()
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("can't detect om execname: %w", err)
+		return uuid.Nil, nil, fmt.Errorf("can't detect om execname: %w", err)
 	}
 	sid := xsession.NewSid(requesterSid)
 	eid := xsession.NewEid()
-	cmd := command.New(
+	registry := jobs.Default()
+	appendLog := func(s string) {
+		registry.AppendLog(sid.UUID(), s)
+	}
+	cmd := command.New(append([]funcopt.O{
 		command.WithName(execname),
 		command.WithArgs(args),
 		command.WithLogger(log),
+		command.WithOnStdoutLine(appendLog),
+		command.WithOnStderrLine(appendLog),
 		command.WithVarEnv(
 			env.ActionOriginDaemonAPI.Var(),
 			sid.Var(),
 			eid.Var(),
 			"OSVC_REQUEST_ID="+fmt.Sprint(ctx.Get("uuid")),
 		),
-	)
+	}, opts...)...)
 	labels := []pubsub.Label{labelOriginAPI}
 	if !p.IsZero() {
 		labels = append(labels, pubsub.Label{"namespace", p.Namespace}, pubsub.Label{"path", p.String()})
@@ -49,12 +66,27 @@ func (a *DaemonAPI) apiExec(ctx echo.Context, p naming.Path, requesterSid uuid.U
 		ExecID:    eid,
 	}
 	a.Bus.Pub(&msg, labels...)
+	j := jobs.Job{Job: api.Job{
+		ID:     sid.UUID(),
+		Kind:   jobs.KindAction,
+		Node:   a.localhost,
+		Action: strings.Join(args, " "),
+		User:   userFromContext(ctx).GetUserName(),
+	}}
+	if !p.IsZero() {
+		j.Path = p.String()
+	}
+	if err := registry.Add(j); err != nil {
+		log.Warnf("add job %s: %s", j.ID, err)
+	}
 	startTime := time.Now()
 	if err = cmd.Start(); err != nil {
 		log.Errorf("exec StartProcess: %s", err)
-		return sid.UUID(), fmt.Errorf("instance action failed: %w", err)
+		_ = registry.End(j.ID, jobs.StateFailed, err.Error())
+		return sid.UUID(), nil, fmt.Errorf("instance action failed: %w", err)
 	}
 	pid := cmd.Cmd().Process.Pid
+	_ = registry.SetPID(j.ID, pid)
 	proc.Register(proc.T{
 		Pid:       pid,
 		Node:      a.localhost,
@@ -65,8 +97,10 @@ func (a *DaemonAPI) apiExec(ctx echo.Context, p naming.Path, requesterSid uuid.U
 		Sub:       "api",
 		Cmd:       cmd.String(),
 	})
+	done := make(chan error, 1)
 	go func() {
 		err := cmd.Wait()
+		defer func() { done <- err }()
 		proc.Unregister(pid)
 		log.Infof("<- exec %s", cmd)
 		duration := time.Now().Sub(startTime)
@@ -81,6 +115,7 @@ func (a *DaemonAPI) apiExec(ctx echo.Context, p naming.Path, requesterSid uuid.U
 				ErrS:      err.Error(),
 			}
 			a.Bus.Pub(&msg, labels...)
+			_ = registry.End(j.ID, jobs.StateFailed, err.Error())
 		} else {
 			msg := msgbus.ExecSuccess{
 				Command:   cmd.String(),
@@ -91,7 +126,8 @@ func (a *DaemonAPI) apiExec(ctx echo.Context, p naming.Path, requesterSid uuid.U
 				ExecID:    eid,
 			}
 			a.Bus.Pub(&msg, labels...)
+			_ = registry.End(j.ID, jobs.StateSucceeded, "")
 		}
 	}()
-	return sid.UUID(), nil
+	return sid.UUID(), done, nil
 }
@@ -0,0 +1,67 @@
+package daemonapi
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/jobs"
+	"github.com/opensvc/om3/v3/daemon/rbac"
+)
+
+// canReadJob returns true if the user is allowed to read the job: the
+// node jobs are reserved to root, the object jobs need the object read
+// permission, the batch jobs need the read permission on all their
+// objects.
+func canReadJob(ctx echo.Context, j *jobs.Job) bool {
+	grants := grantsFromContext(ctx)
+	if grants.HasRole(rbac.RoleRoot) {
+		return true
+	}
+	if j.Kind == jobs.KindBatch {
+		paths, err := jobObjectPaths(j)
+		if err != nil {
+			return false
+		}
+		roles := customRoles()
+		for _, p := range paths {
+			if !canRead(grants, roles, p) {
+				return false
+			}
+		}
+		return true
+	}
+	if j.Path == "" {
+		return false
+	}
+	p, err := naming.ParsePath(j.Path)
+	if err != nil {
+		return false
+	}
+	return canRead(grants, customRoles(), p)
+}
+
+// jobObjectPaths returns the paths of the batch job j objects.
+func jobObjectPaths(j *jobs.Job) (naming.Paths, error) {
+	if j.Objects == nil {
+		return nil, nil
+	}
+	paths := make(naming.Paths, len(*j.Objects))
+	for i, o := range *j.Objects {
+		p, err := naming.ParsePath(o.Path)
+		if err != nil {
+			return nil, err
+		}
+		paths[i] = p
+	}
+	return paths, nil
+}
+
+// jobQueryNode returns the node hosting the job records to query, or an
+// empty string if all the cluster nodes are to be queried.
+func (a *DaemonAPI) jobQueryNode(node *api.InQueryJobNode) string {
+	if node == nil || *node == "" {
+		return ""
+	}
+	return a.parseNodename(*node)
+}
@@ -22,6 +22,7 @@ type (
 		pathMap naming.M
 		nodeMap nodeselector.ResultMap
 		grants  rbac.Grants
+		roles   rbac.CustomRoles
 	}
 )
 
@@ -38,7 +39,14 @@ func (m *Meta) HasPath(s string) bool {
 		return false
 	}
 	grants := m.Grants()
-	return hasRoleGuestOn(grants, p.Namespace) || grants.HasRole(rbac.RoleRoot)
+	return grants.HasRole(rbac.RoleRoot) || canRead(grants, m.Roles(), p)
+}
+
+func (m *Meta) Roles() rbac.CustomRoles {
+	if m.roles == nil {
+		m.roles = customRoles()
+	}
+	return m.roles
 }
 
 func (m *Meta) Grants() rbac.Grants {
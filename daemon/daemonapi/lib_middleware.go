@@ -3,8 +3,11 @@ package daemonapi
 import (
 	"context"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/allenai/go-swaggerui"
 	"github.com/google/uuid"
@@ -17,6 +20,7 @@ import (
 
 	"github.com/opensvc/om3/v3/daemon/daemonauth"
 	"github.com/opensvc/om3/v3/daemon/daemonctx"
+	"github.com/opensvc/om3/v3/daemon/ratelimit"
 	"github.com/opensvc/om3/v3/daemon/rbac"
 	"github.com/opensvc/om3/v3/util/plog"
 )
@@ -49,6 +53,23 @@ var (
 		[]string{"method", "path"},
 	)
 
+	quotaDeniedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "opensvc_listener_quota_denied_total",
+			Help: "The total number of requests denied by the per-user rate limits and stream quotas",
+		},
+		[]string{"rule", "group"},
+	)
+
+	// streamPaths are the routes of the event and log streams, accounted
+	// in the stream endpoint group.
+	streamPaths = map[string]bool{
+		"/api/node/name/:nodename/daemon/event":                                       true,
+		"/api/node/name/:nodename/log":                                                true,
+		"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/log":           true,
+		"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/container/log": true,
+	}
+
 	rateLimitErrorsTotal = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "opensvc_listener_rate_limiter_errors_total",
@@ -115,12 +136,83 @@ func RateLimiterWithConfig(parent context.Context) echo.MiddlewareFunc {
 			}
 
 			rateLimitDeniedTotal.WithLabelValues(c.Request().Method, c.Path()).Inc()
-			return c.JSON(http.StatusForbidden, nil)
+			setRetryAfter(c, time.Duration(float64(time.Second)/float64(rateLimiterConfig.Rate)))
+			return c.JSON(http.StatusTooManyRequests, nil)
 		},
 	}
 	return middleware.RateLimiterWithConfig(config)
 }
 
+// QuotaMiddleware applies the per-user rate limits and stream quotas of
+// the listener limiter to the authenticated requests. The denied requests
+// are answered with a 429 status and a Retry-After header.
+//
+// The peer node requests and the root grant requests are not limited, so
+// a rule without users and roles never throttles the cluster internal
+// traffic nor the node administrators.
+func QuotaMiddleware(parent context.Context) echo.MiddlewareFunc {
+	limiter := daemonctx.ListenRateLimits(parent)
+	if limiter == nil {
+		return func(next echo.HandlerFunc) echo.HandlerFunc { return next }
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if s, ok := c.Get(daemonauth.TkUseClaim).(string); ok && s == daemonauth.TkUseProxy {
+				return next(c)
+			}
+			user, ok := c.Get("user").(auth.Info)
+			if !ok {
+				return next(c)
+			}
+			if authStrategyFromContext(c) == daemonauth.StrategyNode || grantsFromContext(c).HasGrant(rbac.GrantRoot) {
+				return next(c)
+			}
+			roles := make([]string, 0)
+			for _, g := range grantsFromContext(c) {
+				role, _ := g.Split()
+				roles = append(roles, role)
+			}
+			group := endpointGroup(c)
+			release, retryAfter, rule := limiter.Allow(ratelimit.Request{
+				User:  user.GetUserName(),
+				Roles: roles,
+				Group: group,
+			}, time.Now())
+			if release == nil {
+				quotaDeniedTotal.WithLabelValues(rule, group).Inc()
+				setRetryAfter(c, retryAfter)
+				return JSONProblemf(c, http.StatusTooManyRequests, "Too many requests", "rate limit %s exceeded for user %s on %s endpoints", rule, user.GetUserName(), group)
+			}
+			defer release()
+			return next(c)
+		}
+	}
+}
+
+// endpointGroup returns the rate limit endpoint group of the request.
+func endpointGroup(c echo.Context) string {
+	switch {
+	case streamPaths[c.Path()]:
+		return ratelimit.GroupStream
+	case strings.Contains(c.Path(), "/action"):
+		return ratelimit.GroupAction
+	case c.Request().Method == http.MethodGet, c.Request().Method == http.MethodHead:
+		return ratelimit.GroupRead
+	default:
+		return ratelimit.GroupWrite
+	}
+}
+
+// setRetryAfter sets the Retry-After response header to the delay d,
+// rounded up to the second.
+func setRetryAfter(c echo.Context, d time.Duration) {
+	seconds := int(math.Ceil(d.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	c.Response().Header().Set("Retry-After", strconv.Itoa(seconds))
+}
+
 func LogMiddleware(parent context.Context) echo.MiddlewareFunc {
 	oLog := logWithFamilyAndAddr(parent)
 
@@ -207,6 +299,9 @@ func AuthMiddleware(parent context.Context) echo.MiddlewareFunc {
 			if s := extensions.Get(daemonauth.TkUseClaim); s != "" {
 				c.Set(daemonauth.TkUseClaim, s)
 			}
+			if s := extensions.Get(daemonauth.TkAuthStrategyClaim); s != "" {
+				c.Set(daemonauth.TkAuthStrategyClaim, s)
+			}
 			return next(c)
 		}
 	}
@@ -301,6 +396,20 @@ func strategyFromContext(ctx echo.Context) string {
 	return ""
 }
 
+// authStrategyFromContext returns the origin of the request grants: the
+// strategy used to authenticate the request, or for a jwt, the strategy
+// recorded in the token when it was issued.
+func authStrategyFromContext(ctx echo.Context) string {
+	strategy := strategyFromContext(ctx)
+	if strategy != daemonauth.StrategyJWT {
+		return strategy
+	}
+	if s, ok := ctx.Get(daemonauth.TkAuthStrategyClaim).(string); ok {
+		return s
+	}
+	return ""
+}
+
 func grantsFromContext(ctx echo.Context) rbac.Grants {
 	if g, ok := ctx.Get("grants").(rbac.Grants); ok {
 		return g
@@ -13,6 +13,7 @@ import (
 	"github.com/opensvc/om3/v3/core/instance"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/jobs"
 	"github.com/opensvc/om3/v3/daemon/msgbus"
 	"github.com/opensvc/om3/v3/util/pubsub"
 )
@@ -32,9 +33,17 @@ func (a *DaemonAPI) postObjectAction(eCtx echo.Context, namespace string, kind n
 		}
 		msg, setImonErr := msgbus.NewSetInstanceMonitorWithErr(ctx, p, a.localhost, value)
 
+		// register the job before publishing, so the job worker can't miss
+		// the orchestration events.
+		a.addOrchestrationJob(eCtx, p, value.CandidateOrchestrationID, globalExpect)
+
 		a.Bus.Pub(msg, pubsub.Label{"namespace", p.Namespace}, pubsub.Label{"path", p.String()}, labelOriginAPI)
 
-		return JSONFromSetInstanceMonitorError(eCtx, &value, setImonErr.Receive())
+		err := setImonErr.Receive()
+		if err != nil {
+			_ = jobs.Default().End(value.CandidateOrchestrationID, jobs.StateRefused, err.Error())
+		}
+		return JSONFromSetInstanceMonitorError(eCtx, &value, err)
 	}
 	for nodename := range instance.MonitorData.GetByPath(p) {
 		if nodename == a.localhost {
@@ -45,6 +54,22 @@ func (a *DaemonAPI) postObjectAction(eCtx echo.Context, namespace string, kind n
 	return JSONProblem(eCtx, http.StatusNotFound, "object not found", "")
 }
 
+// addOrchestrationJob registers the job of the orchestration submitted to
+// the local instance monitor.
+func (a *DaemonAPI) addOrchestrationJob(eCtx echo.Context, p naming.Path, id uuid.UUID, globalExpect instance.MonitorGlobalExpect) {
+	j := jobs.Job{Job: api.Job{
+		ID:     id,
+		Kind:   jobs.KindOrchestration,
+		Node:   a.localhost,
+		Path:   p.String(),
+		Action: globalExpect.String(),
+		User:   userFromContext(eCtx).GetUserName(),
+	}}
+	if err := jobs.Default().Add(j); err != nil {
+		LogHandler(eCtx, "addOrchestrationJob").Warnf("%s: add job %s: %s", p, id, err)
+	}
+}
+
 // JSONFromSetInstanceMonitorError sends a JSON response where status code depends
 // on SetMonitorUpdate error value.
 //   - StatusOK: expectation value accepted
@@ -2,17 +2,21 @@ package daemonapi
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/anmitsu/go-shlex"
 	"github.com/labstack/echo/v4"
 
+	"github.com/opensvc/om3/v3/core/cluster"
 	"github.com/opensvc/om3/v3/core/datarecv"
+	"github.com/opensvc/om3/v3/core/instance"
 	"github.com/opensvc/om3/v3/core/keyop"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/object"
 	"github.com/opensvc/om3/v3/daemon/rbac"
 	"github.com/opensvc/om3/v3/util/key"
+	"github.com/opensvc/om3/v3/util/label"
 )
 
 // configRbac validates all keys in a config object against RBAC rules.
@@ -57,38 +61,60 @@ func configRbac(ctx echo.Context, p naming.Path, body []byte) error {
 	return nil
 }
 
-// assertGuest asserts that the authenticated user has is either granted the "guest", "operator" or "admin" role on the namespace or is granted the "root" role.
-func assertGuest(ctx echo.Context, namespace string) (bool, error) {
+// assertAdmin asserts that the authenticated user has is either granted the "admin" role on the namespace or is granted the "root" role.
+func assertAdmin(ctx echo.Context, namespace string) (bool, error) {
 	return assertGrant(ctx,
-		rbac.NewGrant(rbac.RoleGuest, namespace),
-		rbac.NewGrant(rbac.RoleOperator, namespace),
 		rbac.NewGrant(rbac.RoleAdmin, namespace),
-		rbac.NewGrant(rbac.RoleGuest, ""),
-		rbac.NewGrant(rbac.RoleOperator, ""),
 		rbac.NewGrant(rbac.RoleAdmin, ""),
-		rbac.GrantJoin,
 		rbac.GrantRoot,
 	)
 }
 
-// assertOperator asserts that the authenticated user has is either granted the "operator" or "admin" role on the namespace or is granted the "root" role.
-func assertOperator(ctx echo.Context, namespace string) (bool, error) {
-	return assertGrant(ctx,
-		rbac.NewGrant(rbac.RoleOperator, namespace),
-		rbac.NewGrant(rbac.RoleAdmin, namespace),
-		rbac.NewGrant(rbac.RoleOperator, ""),
-		rbac.NewGrant(rbac.RoleAdmin, ""),
-		rbac.GrantRoot,
-	)
+// assertPermission asserts that the authenticated user is allowed the perm
+// operation on the object, either by a builtin role or by a custom role
+// declared in the cluster configuration.
+func assertPermission(ctx echo.Context, perm rbac.Permission, namespace string, kind naming.Kind, name string) (bool, error) {
+	p := naming.Path{Namespace: namespace, Kind: kind, Name: name}
+	if decision := canI(grantsFromContext(ctx), perm, p); !decision.Allowed {
+		return false, JSONProblemf(ctx, http.StatusForbidden, "Forbidden", "need one of %v grant or a custom role grant: %s", perm.BuiltinGrants(namespace), decision.Reason)
+	}
+	return true, nil
 }
 
-// assertAdmin asserts that the authenticated user has is either granted the "admin" role on the namespace or is granted the "root" role.
-func assertAdmin(ctx echo.Context, namespace string) (bool, error) {
-	return assertGrant(ctx,
-		rbac.NewGrant(rbac.RoleAdmin, namespace),
-		rbac.NewGrant(rbac.RoleAdmin, ""),
-		rbac.GrantRoot,
-	)
+// canI returns the decision of allowing the perm operation on the object
+// path p to a user with grants.
+func canI(grants rbac.Grants, perm rbac.Permission, p naming.Path) rbac.Decision {
+	return customRoles().Can(grants, perm, p, objectLabels(p))
+}
+
+// canRead returns true if the grants allow the read operation on the object
+// path p, either by a builtin role or by one of the custom roles.
+func canRead(grants rbac.Grants, roles rbac.CustomRoles, p naming.Path) bool {
+	if hasRoleGuestOn(grants, p.Namespace) {
+		return true
+	}
+	if !roles.IsGranted(grants) {
+		return false
+	}
+	return roles.Can(grants, rbac.PermRead, p, objectLabels(p)).Allowed
+}
+
+// customRoles returns the custom roles declared in the cluster
+// configuration.
+func customRoles() rbac.CustomRoles {
+	if !cluster.ConfigData.IsSet() {
+		return nil
+	}
+	return cluster.ConfigData.Get().Roles
+}
+
+// objectLabels returns the labels of the object path p, as defined in its
+// instance configurations.
+func objectLabels(p naming.Path) label.M {
+	for _, instanceConfig := range instance.ConfigData.GetByPath(p) {
+		return instanceConfig.Labels
+	}
+	return nil
 }
 
 // assertRoot asserts that the authenticated user has is granted the "root" role.
@@ -0,0 +1,91 @@
+package daemonapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/daemonauth"
+	"github.com/opensvc/om3/v3/daemon/rbac"
+)
+
+var (
+	// usrTokenDefaultDuration is the validity duration of the api tokens
+	// created without explicit duration.
+	usrTokenDefaultDuration = 90 * 24 * time.Hour
+
+	// usrTokenMaxDuration is the maximum validity duration of the api
+	// tokens.
+	usrTokenMaxDuration = 3650 * 24 * time.Hour
+)
+
+// usrTokenPath returns the usr object path of the token requests, or responds
+// with a 400 status if the path is not a usr object path.
+func usrTokenPath(ctx echo.Context, namespace string, kind naming.Kind, name string) (naming.Path, error) {
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return p, JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	if kind != naming.KindUsr {
+		return p, JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s: api tokens are only supported by usr objects", p)
+	}
+	return p, nil
+}
+
+// assertUsrTokenManager asserts that the authenticated user is granted the
+// "root" role or is the owner of the p usr object. When allowBlacklistAdmin
+// is true, the "blacklistadmin" role is also accepted.
+//
+// The owner is the user authenticated by the p usr object, directly or
+// through a token issued from it. A user authenticated by another strategy,
+// like ldap, is not the owner even if its name matches the usr object name.
+func assertUsrTokenManager(ctx echo.Context, p naming.Path, allowBlacklistAdmin bool) (bool, error) {
+	grants := grantsFromContext(ctx)
+	switch {
+	case grants.HasGrant(rbac.GrantRoot):
+		return true, nil
+	case allowBlacklistAdmin && grants.HasGrant(rbac.GrantBlacklistAdmin):
+		return true, nil
+	case isUsrOwner(ctx, p):
+		return true, nil
+	}
+	if allowBlacklistAdmin {
+		return false, JSONForbiddenMissingGrant(ctx, rbac.GrantRoot, rbac.GrantBlacklistAdmin)
+	}
+	return false, JSONForbiddenMissingGrant(ctx, rbac.GrantRoot)
+}
+
+// isUsrOwner returns true if the request is authenticated by the p usr
+// object.
+func isUsrOwner(ctx echo.Context, p naming.Path) bool {
+	if p.Namespace != naming.NsSys || p.Kind != naming.KindUsr {
+		return false
+	}
+	if authStrategyFromContext(ctx) != daemonauth.StrategyUser {
+		return false
+	}
+	return userFromContext(ctx).GetUserName() == p.Name
+}
+
+func usrTokenItem(p naming.Path, tk object.UsrToken) api.UsrTokenItem {
+	item := api.UsrTokenItem{
+		CreatedAt: tk.CreatedAt,
+		ExpireAt:  tk.ExpireAt,
+		Grant:     append([]string{}, tk.Grants...),
+		ID:        tk.ID,
+		Name:      tk.Name,
+		Object:    p.String(),
+	}
+	if at := daemonauth.TokenUsage.LastUsed(tk.ID); !at.IsZero() {
+		item.LastUsedAt = &at
+	}
+	if !tk.RevokedAt.IsZero() {
+		revokedAt := tk.RevokedAt
+		item.RevokedAt = &revokedAt
+	}
+	return item
+}
@@ -0,0 +1,84 @@
+package daemonapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/shaj13/go-guardian/v2/auth"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/daemonauth"
+	"github.com/opensvc/om3/v3/daemon/rbac"
+)
+
+func TestAssertUsrTokenManager(t *testing.T) {
+	p := naming.Path{Namespace: naming.NsSys, Kind: naming.KindUsr, Name: "alice"}
+	newContext := func(username, strategy, authStrategy string, grants ...string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		ctx.Set("user", auth.NewUserInfo(username, "", nil, nil))
+		ctx.Set("grants", rbac.NewGrants(grants...))
+		ctx.Set("strategy", strategy)
+		if authStrategy != "" {
+			ctx.Set(daemonauth.TkAuthStrategyClaim, authStrategy)
+		}
+		return ctx, rec
+	}
+
+	cases := map[string]struct {
+		username     string
+		strategy     string
+		authStrategy string
+		grants       []string
+		expected     bool
+	}{
+		"usr basic auth owner": {
+			username: "alice",
+			strategy: daemonauth.StrategyUser,
+			expected: true,
+		},
+		"jwt issued from the usr owner": {
+			username:     "alice",
+			strategy:     daemonauth.StrategyJWT,
+			authStrategy: daemonauth.StrategyUser,
+			expected:     true,
+		},
+		"ldap user with the usr name": {
+			username: "alice",
+			strategy: daemonauth.StrategyLDAP,
+		},
+		"jwt issued from a ldap user with the usr name": {
+			username:     "alice",
+			strategy:     daemonauth.StrategyJWT,
+			authStrategy: daemonauth.StrategyLDAP,
+		},
+		"jwt without origin": {
+			username: "alice",
+			strategy: daemonauth.StrategyJWT,
+		},
+		"usr basic auth other user": {
+			username: "bob",
+			strategy: daemonauth.StrategyUser,
+		},
+		"ldap root": {
+			username: "carol",
+			strategy: daemonauth.StrategyLDAP,
+			grants:   []string{"root"},
+			expected: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, rec := newContext(tc.username, tc.strategy, tc.authStrategy, tc.grants...)
+			v, err := assertUsrTokenManager(ctx, p, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, v)
+			if !tc.expected {
+				require.Equal(t, http.StatusForbidden, rec.Code)
+			}
+		})
+	}
+}
@@ -77,7 +77,7 @@ func (a *DaemonAPI) PostAuthToken(ctx echo.Context, params api.PostAuthTokenPara
 	}
 
 	if needRefresh {
-		if rk, exp, err := a.createToken(username, daemonauth.TkUseRefresh, refreshDuration, nil); err != nil {
+		if rk, exp, err := a.createToken(username, daemonauth.TkUseRefresh, refreshDuration, withAuthStrategyClaim(nil, authStrategyFromContext(ctx))); err != nil {
 			log.Errorf("create refresh token: %s", err)
 			return JSONProblemf(ctx, http.StatusInternalServerError, "Unexpected error", "%s", err)
 		} else {
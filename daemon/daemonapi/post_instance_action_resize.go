@@ -0,0 +1,52 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/rbac"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+func (a *DaemonAPI) PostInstanceActionResize(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string, params api.PostInstanceActionResizeParams) error {
+	if v, err := assertPermission(ctx, rbac.PermResize, namespace, kind, name); !v {
+		return err
+	}
+	if kind != naming.KindVol {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "resize is only supported by vol objects")
+	}
+	if _, err := sizeconv.FromSize(params.Size); err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "size: %s", err)
+	}
+	nodename = a.parseNodename(nodename)
+	if a.localhost == nodename {
+		return a.postLocalInstanceActionResize(ctx, namespace, kind, name, params)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostInstanceActionResize(ctx.Request().Context(), nodename, namespace, kind, name, &params)
+	})
+}
+
+func (a *DaemonAPI) postLocalInstanceActionResize(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostInstanceActionResizeParams) error {
+	log := LogHandler(ctx, "PostInstanceActionResize")
+	var requesterSid uuid.UUID
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	log = naming.LogWithPath(log, p)
+	args := []string{p.String(), "resize", "--size", params.Size, "--local"}
+	if params.SessionId != nil {
+		requesterSid = *params.SessionId
+	}
+	if sid, err := a.apiExec(ctx, p, requesterSid, args, log); err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "", "%s", err)
+	} else {
+		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{SessionID: sid})
+	}
+}
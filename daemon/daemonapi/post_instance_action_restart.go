@@ -10,10 +10,11 @@ import (
 	"github.com/opensvc/om3/v3/core/client"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/rbac"
 )
 
 func (a *DaemonAPI) PostInstanceActionRestart(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string, params api.PostInstanceActionRestartParams) error {
-	if v, err := assertOperator(ctx, namespace); !v {
+	if v, err := assertPermission(ctx, rbac.PermRestart, namespace, kind, name); !v {
 		return err
 	}
 	nodename = a.parseNodename(nodename)
@@ -0,0 +1,48 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/rbac"
+)
+
+func (a *DaemonAPI) PostInstanceActionSnapshotDelete(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string, params api.PostInstanceActionSnapshotDeleteParams) error {
+	if v, err := assertPermission(ctx, rbac.PermSnapshot, namespace, kind, name); !v {
+		return err
+	}
+	if kind != naming.KindVol {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "snapshots are only supported by vol objects")
+	}
+	nodename = a.parseNodename(nodename)
+	if a.localhost == nodename {
+		return a.postLocalInstanceActionSnapshotDelete(ctx, namespace, kind, name, params)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostInstanceActionSnapshotDelete(ctx.Request().Context(), nodename, namespace, kind, name, &params)
+	})
+}
+
+func (a *DaemonAPI) postLocalInstanceActionSnapshotDelete(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostInstanceActionSnapshotDeleteParams) error {
+	log := LogHandler(ctx, "PostInstanceActionSnapshotDelete")
+	var requesterSid uuid.UUID
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	log = naming.LogWithPath(log, p)
+	args := []string{p.String(), "snapshot", "delete", "--name", params.Name, "--local"}
+	if params.SessionId != nil {
+		requesterSid = *params.SessionId
+	}
+	if sid, err := a.apiExec(ctx, p, requesterSid, args, log); err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "", "%s", err)
+	} else {
+		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{SessionID: sid})
+	}
+}
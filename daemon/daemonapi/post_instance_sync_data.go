@@ -0,0 +1,135 @@
+package daemonapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/resourceid"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/util/command"
+)
+
+type (
+	// syncDataLocksMap serializes the sync data streams ingested by the
+	// same resource.
+	syncDataLocksMap struct {
+		sync.Mutex
+		m map[string]chan struct{}
+	}
+
+	// countingReader counts the bytes read from r.
+	countingReader struct {
+		r io.Reader
+		n int64
+	}
+)
+
+var (
+	syncDataLocks = &syncDataLocksMap{m: make(map[string]chan struct{})}
+)
+
+func (a *DaemonAPI) PostInstanceSyncData(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string, params api.PostInstanceSyncDataParams) error {
+	if v, err := assertRoot(ctx); !v {
+		return err
+	}
+	nodename = a.parseNodename(nodename)
+	if a.localhost == nodename {
+		return a.postLocalInstanceSyncData(ctx, namespace, kind, name, params)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostInstanceSyncDataWithBody(ctx.Request().Context(), nodename, namespace, kind, name, &params, "application/octet-stream", ctx.Request().Body)
+	})
+}
+
+// postLocalInstanceSyncData pipes the request body into the stdin of the
+// instance sync ingest action of the resource, and responds when the
+// action is done.
+func (a *DaemonAPI) postLocalInstanceSyncData(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostInstanceSyncDataParams) error {
+	log := LogHandler(ctx, "PostInstanceSyncData")
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	if !p.Exists() {
+		return JSONProblemf(ctx, http.StatusNotFound, "Object not found", "")
+	}
+	log = naming.LogWithPath(log, p)
+	if err := assertSyncRID(params.Rid); err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	if params.Name == "" {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "empty sync data stream name")
+	}
+	unlock, err := syncDataLocks.lock(ctx.Request().Context(), p.String()+":"+params.Rid)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusServiceUnavailable, "Wait for the previous sync data stream ingest", "%s", err)
+	}
+	defer unlock()
+
+	body := &countingReader{r: ctx.Request().Body}
+	args := []string{p.String(), "instance", "sync", "ingest", "--rid", params.Rid, "--stream", params.Name}
+	log.Infof("ingest %s sync data stream %s", params.Rid, params.Name)
+	_, done, err := a.apiExecWithDone(ctx, p, uuid.Nil, args, log, command.WithStdin(body))
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Exec sync ingest", "%s", err)
+	}
+	if err := <-done; err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Ingest sync data", "%s sync data stream %s: %s", params.Rid, params.Name, err)
+	}
+	return ctx.JSON(http.StatusOK, api.SyncData{Name: params.Name, Size: body.n})
+}
+
+// lock waits until no other sync data stream is ingested for the key, and
+// returns the function releasing the lock. It returns an error if ctx is
+// done before.
+func (t *syncDataLocksMap) lock(ctx context.Context, key string) (func(), error) {
+	for {
+		t.Lock()
+		busy, ok := t.m[key]
+		if !ok {
+			released := make(chan struct{})
+			t.m[key] = released
+			t.Unlock()
+			return func() {
+				t.Lock()
+				delete(t.m, key)
+				t.Unlock()
+				close(released)
+			}, nil
+		}
+		t.Unlock()
+		select {
+		case <-busy:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (t *countingReader) Read(b []byte) (int, error) {
+	n, err := t.r.Read(b)
+	t.n += int64(n)
+	return n, err
+}
+
+// assertSyncRID returns an error if rid is not the id of a sync resource.
+func assertSyncRID(rid string) error {
+	resID, err := resourceid.Parse(rid)
+	if err != nil {
+		return err
+	}
+	if resID.DriverGroup() != driver.GroupSync || filepath.Base(rid) != rid {
+		return fmt.Errorf("%s is not a sync resource", rid)
+	}
+	return nil
+}
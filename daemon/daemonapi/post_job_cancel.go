@@ -0,0 +1,146 @@
+package daemonapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/clusternode"
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/jobs"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/daemon/rbac"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+// PostJobCancel cancels a running job: the orchestration jobs are aborted,
+// the action jobs process is terminated. Without a node parameter, the
+// job is searched on the local node first, then on the peer nodes.
+func (a *DaemonAPI) PostJobCancel(ctx echo.Context, id api.InPathJobID, params api.PostJobCancelParams) error {
+	nodename := a.jobQueryNode(params.Node)
+	switch nodename {
+	case a.localhost:
+		return a.postLocalJobCancel(ctx, id)
+	case "":
+	default:
+		return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+			return c.PostJobCancel(ctx.Request().Context(), id, &params)
+		})
+	}
+	if _, err := jobs.Default().Get(id); err == nil {
+		return a.postLocalJobCancel(ctx, id)
+	}
+	for _, nodename := range clusternode.Get() {
+		if nodename == a.localhost {
+			continue
+		}
+		c, err := a.newProxyClient(ctx, nodename)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+		}
+		resp, err := c.PostJobCancelWithResponse(ctx.Request().Context(), id, &api.PostJobCancelParams{Node: &nodename})
+		if err != nil {
+			LogHandler(ctx, "PostJobCancel").Warnf("%s: cancel job %s: %s", nodename, id, err)
+			continue
+		} else if resp.StatusCode() == http.StatusNotFound {
+			continue
+		}
+		return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+	}
+	return JSONProblemf(ctx, http.StatusNotFound, "Not found", "job %s", id)
+}
+
+func (a *DaemonAPI) postLocalJobCancel(ctx echo.Context, id api.InPathJobID) error {
+	registry := jobs.Default()
+	j, err := registry.Get(id)
+	switch {
+	case errors.Is(err, jobs.ErrNotFound):
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "job %s", id)
+	case err != nil:
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Get job", "%s", err)
+	}
+	var p naming.Path
+	if j.Kind == jobs.KindBatch {
+		paths, err := jobObjectPaths(j)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Parse job path", "%s", err)
+		}
+		for _, p := range paths {
+			if v, err := assertPermission(ctx, rbac.PermAbort, p.Namespace, p.Kind, p.Name); !v {
+				return err
+			}
+		}
+	} else if j.Path == "" {
+		if v, err := assertRoot(ctx); !v {
+			return err
+		}
+	} else if p, err = naming.ParsePath(j.Path); err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Parse job path", "%s", err)
+	} else if v, err := assertPermission(ctx, rbac.PermAbort, p.Namespace, p.Kind, p.Name); !v {
+		return err
+	}
+	if j.IsEnded() {
+		return JSONProblemf(ctx, http.StatusConflict, "Job ended", "job %s is %s", id, j.State)
+	}
+	// flag the job before aborting, so the job end caused by the abort is
+	// reported as cancelled.
+	if j, err = registry.SetCancelRequested(id, true); err != nil {
+		return JSONProblemf(ctx, http.StatusConflict, "Cancel job", "%s", err)
+	}
+	if err := a.cancelJob(ctx, j, p); err != nil {
+		_, _ = registry.SetCancelRequested(id, false)
+		return JSONProblemf(ctx, http.StatusConflict, "Cancel job", "%s", err)
+	}
+	LogHandler(ctx, "PostJobCancel").Infof("job %s cancel requested", id)
+	return ctx.JSON(http.StatusOK, j.Job)
+}
+
+// cancelJob aborts the orchestration of an orchestration job, cancels a
+// batch job, or terminates the process of an action job.
+func (a *DaemonAPI) cancelJob(ctx echo.Context, j *jobs.Job, p naming.Path) error {
+	switch j.Kind {
+	case jobs.KindBatch:
+		if !jobs.CancelBatch(j.ID) {
+			return fmt.Errorf("batch job %s is not running", j.ID)
+		}
+	case jobs.KindOrchestration:
+		if err := a.abortOrchestration(ctx, p); err != nil {
+			return fmt.Errorf("abort orchestration: %w", err)
+		}
+	case jobs.KindAction:
+		if j.PID == 0 {
+			return fmt.Errorf("job %s has no process", j.ID)
+		}
+		if proc, err := os.FindProcess(j.PID); err != nil {
+			return err
+		} else if err := proc.Signal(syscall.SIGTERM); err != nil {
+			return fmt.Errorf("terminate process %d: %w", j.PID, err)
+		}
+	}
+	return nil
+}
+
+// abortOrchestration sets the aborted global expect on the local instance
+// monitor of the object p.
+func (a *DaemonAPI) abortOrchestration(eCtx echo.Context, p naming.Path) error {
+	ctx, cancel := context.WithTimeout(eCtx.Request().Context(), 500*time.Millisecond)
+	defer cancel()
+	globalExpect := instance.MonitorGlobalExpectAborted
+	value := instance.MonitorUpdate{
+		GlobalExpect:             &globalExpect,
+		CandidateOrchestrationID: uuid.New(),
+	}
+	msg, setImonErr := msgbus.NewSetInstanceMonitorWithErr(ctx, p, a.localhost, value)
+	a.Bus.Pub(msg, pubsub.Label{"namespace", p.Namespace}, pubsub.Label{"path", p.String()}, labelOriginAPI)
+	return setImonErr.Receive()
+}
@@ -0,0 +1,79 @@
+package daemonapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/converters"
+)
+
+func (a *DaemonAPI) PostNodeMaintenance(ctx echo.Context, nodename string) error {
+	if v, err := assertRoot(ctx); !v {
+		return err
+	}
+	nodename = a.parseNodename(nodename)
+	if nodename == a.localhost {
+		return a.localNodeMaintenance(ctx)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostNodeMaintenanceWithBody(ctx.Request().Context(), nodename, ctx.Request().Header.Get("Content-Type"), ctx.Request().Body)
+	})
+}
+
+func (a *DaemonAPI) localNodeMaintenance(eCtx echo.Context) error {
+	var payload api.PostNodeMaintenance
+	if err := eCtx.Bind(&payload); err != nil {
+		return JSONProblem(eCtx, http.StatusBadRequest, "Invalid Body", err.Error())
+	}
+	if payload.Reason == "" {
+		return JSONProblemf(eCtx, http.StatusBadRequest, "Invalid Body", "the maintenance reason is required")
+	}
+	value := node.Maintenance{
+		Reason: payload.Reason,
+	}
+	if payload.Expire != nil && *payload.Expire != "" {
+		d, err := converters.ParseDuration(*payload.Expire)
+		if err != nil {
+			return JSONProblemf(eCtx, http.StatusBadRequest, "Invalid Body", "expire: %s", err)
+		}
+		value.ExpireAt = time.Now().Add(d)
+	}
+
+	ctx, cancel := context.WithTimeout(eCtx.Request().Context(), 300*time.Millisecond)
+	defer cancel()
+
+	msg, errReceiver := msgbus.NewSetNodeMaintenanceWithErr(ctx, a.localhost, &value)
+	a.Bus.Pub(msg, a.LabelLocalhost, labelOriginAPI)
+
+	if err := errReceiver.Receive(); err != nil {
+		return JSONFromSetNodeMaintenanceError(eCtx, err)
+	}
+	status := node.StatusData.GetByNode(a.localhost)
+	if status == nil || status.Maintenance == nil {
+		return JSONProblemf(eCtx, http.StatusInternalServerError, "set node maintenance", "maintenance not found in the node status")
+	}
+	return eCtx.JSON(http.StatusOK, api.NodeMaintenance{
+		Reason:   status.Maintenance.Reason,
+		Since:    status.Maintenance.Since,
+		ExpireAt: status.Maintenance.ExpireAt,
+	})
+}
+
+func JSONFromSetNodeMaintenanceError(eCtx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return JSONProblemf(eCtx, http.StatusRequestTimeout, "set node maintenance", "timeout publishing the maintenance request")
+	case errors.Is(err, context.Canceled):
+		return JSONProblemf(eCtx, http.StatusRequestTimeout, "set node maintenance", "client context canceled")
+	default:
+		return JSONProblemf(eCtx, http.StatusConflict, "set node maintenance", "%s", err)
+	}
+}
@@ -8,10 +8,11 @@ import (
 	"github.com/opensvc/om3/v3/core/client"
 	"github.com/opensvc/om3/v3/core/instance"
 	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/rbac"
 )
 
 func (a *DaemonAPI) PostObjectActionFreeze(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
-	if v, err := assertOperator(ctx, namespace); !v {
+	if v, err := assertPermission(ctx, rbac.PermFreeze, namespace, kind, name); !v {
 		return err
 	}
 	return a.postObjectAction(ctx, namespace, kind, name, instance.MonitorGlobalExpectFrozen, func(c *client.T) (*http.Response, error) {
@@ -13,11 +13,12 @@ import (
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/daemon/api"
 	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/daemon/rbac"
 	"github.com/opensvc/om3/v3/util/pubsub"
 )
 
 func (a *DaemonAPI) PostObjectActionSwitch(eCtx echo.Context, namespace string, kind naming.Kind, name string) error {
-	if v, err := assertOperator(eCtx, namespace); !v {
+	if v, err := assertPermission(eCtx, rbac.PermSwitch, namespace, kind, name); !v {
 		return err
 	}
 	p, err := naming.NewPath(namespace, kind, name)
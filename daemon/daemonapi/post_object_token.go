@@ -0,0 +1,105 @@
+package daemonapi
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/daemonauth"
+	"github.com/opensvc/om3/v3/util/converters"
+)
+
+// PostObjectToken creates a named long-lived api token for a usr object.
+//
+// The token grants are the usr object grants matching the requested roles
+// and scope. The token can't be created using another api token.
+func (a *DaemonAPI) PostObjectToken(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostObjectTokenParams) error {
+	log := LogHandler(ctx, "PostObjectToken")
+
+	p, err := usrTokenPath(ctx, namespace, kind, name)
+	if err != nil {
+		return err
+	}
+	if v, err := assertUsrTokenManager(ctx, p, false); !v {
+		return err
+	}
+	if s, ok := ctx.Get(daemonauth.TkUseClaim).(string); ok && s == daemonauth.TkUseAPI {
+		return JSONProblemf(ctx, http.StatusForbidden, "Forbidden", "not allowed to create an api token using an api token")
+	}
+	log = naming.LogWithPath(log, p)
+
+	instanceConfigData := instance.ConfigData.GetByPath(p)
+
+	if _, ok := instanceConfigData[a.localhost]; ok {
+		duration, err := converters.DurationWithDefaultMinMax(params.Duration, usrTokenDefaultDuration, time.Minute, usrTokenMaxDuration)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "Invalid duration: %s", err)
+		}
+		o, err := object.NewUsr(p)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "NewUsr", "%s", err)
+		}
+		allowed, err := userDB.GrantsFromUsername(p.Name)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Grants", "%s", err)
+		}
+		grants, err := filterGrant(allowed, params.Role, params.Scope)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+		} else if len(grants) == 0 {
+			return JSONProblemf(ctx, http.StatusForbidden, "Forbidden", "no grant matching role and scope for %s", p)
+		}
+		claims, err := a.xClaimForGrants(grants)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Create claims", "%s", err)
+		}
+		id := uuid.New().String()
+		claims["jti"] = id
+		claims[daemonauth.TkAuthStrategyClaim] = daemonauth.StrategyUser
+		now := time.Now()
+		tk, exp, err := a.createToken(p.Name, daemonauth.TkUseAPI, duration, claims)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Create token", "%s", err)
+		}
+		record := object.UsrToken{
+			Name:      params.Name,
+			ID:        id,
+			Grants:    grants,
+			CreatedAt: now,
+			ExpireAt:  exp,
+		}
+		err = o.AddToken(record)
+		switch {
+		case errors.Is(err, object.ErrTokenExist):
+			return JSONProblemf(ctx, http.StatusConflict, "AddToken", "%s", err)
+		case err != nil:
+			return JSONProblemf(ctx, http.StatusInternalServerError, "AddToken", "%s", err)
+		}
+		log.Infof("api token %s created with grants %v, expires at %s", record.Name, grants, exp)
+		return ctx.JSON(http.StatusOK, api.UsrToken{
+			Token: tk,
+			Item:  usrTokenItem(p, record),
+		})
+	}
+
+	for nodename := range instanceConfigData {
+		c, err := a.newProxyClient(ctx, nodename)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+		}
+		if resp, err := c.PostObjectTokenWithResponse(ctx.Request().Context(), namespace, kind, name, &params); err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
+		} else if len(resp.Body) > 0 {
+			return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+		}
+	}
+
+	return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s", p)
+}
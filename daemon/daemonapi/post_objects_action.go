@@ -0,0 +1,309 @@
+package daemonapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/objectselector"
+	"github.com/opensvc/om3/v3/core/provisioned"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/daemonauth"
+	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/v3/daemon/jobs"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/daemon/rbac"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+type (
+	batchAction struct {
+		globalExpect instance.MonitorGlobalExpect
+
+		// rollback is the global expect set on the objects already acted
+		// on in all-or-nothing mode, MonitorGlobalExpectInit if the action
+		// can't be rolled back.
+		rollback instance.MonitorGlobalExpect
+
+		// perm is the permission needed on each object. An empty perm
+		// requires the admin role on the object namespace.
+		perm rbac.Permission
+
+		// rollbackPerm is the permission of the rollback action, also
+		// needed on each object in all-or-nothing mode. An empty
+		// rollbackPerm requires the admin role on the object namespace.
+		rollbackPerm rbac.Permission
+
+		order string
+	}
+
+	objectActionFunc func(c *client.T, ctx context.Context, namespace string, kind naming.Kind, name string, reqEditors ...api.RequestEditorFn) (*http.Response, error)
+)
+
+const (
+	batchDefaultParallel = 10
+
+	// batchObjectTimeout is the maximum duration of a batch object
+	// orchestration.
+	batchObjectTimeout = time.Hour
+)
+
+var (
+	batchActions = map[string]batchAction{
+		"abort":       {globalExpect: instance.MonitorGlobalExpectAborted, perm: rbac.PermAbort, order: jobs.OrderNone},
+		"delete":      {globalExpect: instance.MonitorGlobalExpectDeleted, order: jobs.OrderDesc},
+		"freeze":      {globalExpect: instance.MonitorGlobalExpectFrozen, rollback: instance.MonitorGlobalExpectUnfrozen, perm: rbac.PermFreeze, rollbackPerm: rbac.PermUnfreeze, order: jobs.OrderAsc},
+		"giveback":    {globalExpect: instance.MonitorGlobalExpectPlaced, perm: rbac.PermGiveback, order: jobs.OrderAsc},
+		"provision":   {globalExpect: instance.MonitorGlobalExpectProvisioned, rollback: instance.MonitorGlobalExpectUnprovisioned, order: jobs.OrderAsc},
+		"purge":       {globalExpect: instance.MonitorGlobalExpectPurged, order: jobs.OrderDesc},
+		"start":       {globalExpect: instance.MonitorGlobalExpectStarted, rollback: instance.MonitorGlobalExpectStopped, perm: rbac.PermStart, rollbackPerm: rbac.PermStop, order: jobs.OrderAsc},
+		"stop":        {globalExpect: instance.MonitorGlobalExpectStopped, rollback: instance.MonitorGlobalExpectStarted, perm: rbac.PermStop, rollbackPerm: rbac.PermStart, order: jobs.OrderDesc},
+		"unfreeze":    {globalExpect: instance.MonitorGlobalExpectUnfrozen, rollback: instance.MonitorGlobalExpectFrozen, perm: rbac.PermUnfreeze, rollbackPerm: rbac.PermFreeze, order: jobs.OrderAsc},
+		"unprovision": {globalExpect: instance.MonitorGlobalExpectUnprovisioned, rollback: instance.MonitorGlobalExpectProvisioned, order: jobs.OrderDesc},
+	}
+
+	// objectActionFuncs are the peer api calls setting a global expect,
+	// used when the local node has no instance of the object.
+	objectActionFuncs = map[instance.MonitorGlobalExpect]objectActionFunc{
+		instance.MonitorGlobalExpectAborted:       (*client.T).PostObjectActionAbort,
+		instance.MonitorGlobalExpectDeleted:       (*client.T).PostObjectActionDelete,
+		instance.MonitorGlobalExpectFrozen:        (*client.T).PostObjectActionFreeze,
+		instance.MonitorGlobalExpectPlaced:        (*client.T).PostObjectActionGiveback,
+		instance.MonitorGlobalExpectProvisioned:   (*client.T).PostObjectActionProvision,
+		instance.MonitorGlobalExpectPurged:        (*client.T).PostObjectActionPurge,
+		instance.MonitorGlobalExpectStarted:       (*client.T).PostObjectActionStart,
+		instance.MonitorGlobalExpectStopped:       (*client.T).PostObjectActionStop,
+		instance.MonitorGlobalExpectUnfrozen:      (*client.T).PostObjectActionUnfreeze,
+		instance.MonitorGlobalExpectUnprovisioned: (*client.T).PostObjectActionUnprovision,
+	}
+)
+
+// PostObjectsAction starts a batch job orchestrating the action on the
+// selected objects, by waves of objects of same priority.
+func (a *DaemonAPI) PostObjectsAction(ctx echo.Context) error {
+	var payload api.PostObjectsAction
+	if err := ctx.Bind(&payload); err != nil {
+		return JSONProblem(ctx, http.StatusBadRequest, "Invalid Body", err.Error())
+	}
+	action, ok := batchActions[payload.Action]
+	if !ok {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid Body", "unsupported action: %s", payload.Action)
+	}
+	order := action.order
+	if payload.Order != nil {
+		switch *payload.Order {
+		case jobs.OrderAsc, jobs.OrderDesc, jobs.OrderNone:
+			order = *payload.Order
+		default:
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid Body", "order: must be one of asc, desc or none")
+		}
+	}
+	parallel := batchDefaultParallel
+	if payload.Parallel != nil {
+		if *payload.Parallel < 1 {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid Body", "parallel: must be greater than 0")
+		}
+		parallel = *payload.Parallel
+	}
+	allOrNothing := payload.AllOrNothing != nil && *payload.AllOrNothing
+	if allOrNothing && action.rollback == instance.MonitorGlobalExpectInit {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid Body", "all_or_nothing: the %s action can't be rolled back", payload.Action)
+	}
+
+	log := LogHandler(ctx, "PostObjectsAction")
+	paths, err := objectselector.New(
+		payload.Selector,
+		objectselector.WithPaths(object.StatusData.GetPaths()),
+		objectselector.WithLocal(true),
+	).Expand()
+	if err != nil {
+		log.Errorf("expand selection from selector %s: %s", payload.Selector, err)
+		return JSONProblem(ctx, http.StatusInternalServerError, "Server error", "expand selection")
+	}
+	grants := grantsFromContext(ctx)
+	hasRoot := grants.HasRole(rbac.RoleRoot)
+	roles := customRoles()
+	objects := make([]api.JobObject, 0, len(paths))
+	for _, p := range paths {
+		if !hasRoot && !canRead(grants, roles, p) {
+			continue
+		}
+		if v, err := assertBatchPermission(ctx, action.perm, p); !v {
+			return err
+		}
+		if allOrNothing {
+			// the objects already acted on are rolled back on failure
+			if v, err := assertBatchPermission(ctx, action.rollbackPerm, p); !v {
+				return err
+			}
+		}
+		o := api.JobObject{
+			Path:  p.String(),
+			State: jobs.ObjectPending,
+		}
+		if st := object.StatusData.GetByPath(p); st != nil {
+			o.Priority = int(st.Priority)
+		}
+		objects = append(objects, o)
+	}
+	if len(objects) == 0 {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid Body", "no object matches the selector %s", payload.Selector)
+	}
+
+	username := userFromContext(ctx).GetUserName()
+	j := jobs.Job{Job: api.Job{
+		ID:      uuid.New(),
+		Kind:    jobs.KindBatch,
+		Node:    a.localhost,
+		Action:  payload.Action,
+		User:    username,
+		Objects: &objects,
+	}}
+	registry := jobs.Default()
+	if err := registry.Add(j); err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Add job", "%s", err)
+	}
+	b := jobs.Batch{
+		ID:           j.ID,
+		Action:       action.globalExpect,
+		Rollback:     action.rollback,
+		AllOrNothing: allOrNothing,
+		Order:        order,
+		Parallel:     parallel,
+		Reached:      batchReached,
+		Timeout:      batchObjectTimeout,
+		Submit:       a.batchSubmitter(username, grants.AsStringList(), authStrategyFromContext(ctx)),
+	}
+
+	// subscribe before the job start, so the batch runner can't miss the
+	// orchestration events.
+	// The subscription has no timeout, as a dropped subscription would
+	// let every object wait run into the batch object timeout.
+	sub := a.Bus.Sub(fmt.Sprintf("api.post_objects_action %s", j.ID), a.SubQS)
+	for _, o := range objects {
+		labelPath := pubsub.Label{"path", o.Path}
+		sub.AddFilter(&msgbus.InstanceMonitorUpdated{}, labelPath)
+		sub.AddFilter(&msgbus.ObjectOrchestrationEnd{}, labelPath)
+		sub.AddFilter(&msgbus.ObjectOrchestrationRefused{}, labelPath)
+	}
+	sub.Start()
+	go func(log *plog.Logger) {
+		defer func() {
+			if err := sub.Stop(); err != nil {
+				log.Warnf("batch job %s: subscription stop: %s", j.ID, err)
+			}
+		}()
+		jobs.RunBatch(context.Background(), registry, b, sub.C, log)
+	}(log)
+
+	log.Infof("batch job %s started: %s %d objects", j.ID, payload.Action, len(objects))
+	if v, err := registry.Get(j.ID); err == nil {
+		return ctx.JSON(http.StatusOK, v.Job)
+	}
+	return ctx.JSON(http.StatusOK, j.Job)
+}
+
+// assertBatchPermission returns true if the user has the perm permission
+// on the object p, or the admin role on its namespace if perm is empty.
+func assertBatchPermission(ctx echo.Context, perm rbac.Permission, p naming.Path) (bool, error) {
+	if perm == "" {
+		return assertAdmin(ctx, p.Namespace)
+	}
+	return assertPermission(ctx, perm, p.Namespace, p.Kind, p.Name)
+}
+
+// batchReached reports if the object p is in the state the global expect
+// leads to, from its aggregated avail, frozen and provisioned states.
+func batchReached(p naming.Path, globalExpect instance.MonitorGlobalExpect) bool {
+	st := object.StatusData.GetByPath(p)
+	if st == nil || st.ActorStatus == nil {
+		return false
+	}
+	switch globalExpect {
+	case instance.MonitorGlobalExpectStarted:
+		return st.Avail == status.Up
+	case instance.MonitorGlobalExpectStopped:
+		return st.Avail == status.Down
+	case instance.MonitorGlobalExpectFrozen:
+		return st.Frozen == "frozen"
+	case instance.MonitorGlobalExpectUnfrozen:
+		return st.Frozen == "unfrozen"
+	case instance.MonitorGlobalExpectProvisioned:
+		return st.Provisioned == provisioned.True
+	case instance.MonitorGlobalExpectUnprovisioned:
+		return st.Provisioned == provisioned.False
+	default:
+		return false
+	}
+}
+
+// batchSubmitter returns the function setting a global expect on a batch
+// object. The global expect is set on the local instance monitor if any,
+// else requested to a peer node with a proxy token for the user and
+// grants of the batch submitter, as the batch outlives its request.
+func (a *DaemonAPI) batchSubmitter(username string, grantL []string, authStrategy string) func(context.Context, naming.Path, instance.MonitorGlobalExpect) (string, uuid.UUID, error) {
+	return func(ctx context.Context, p naming.Path, globalExpect instance.MonitorGlobalExpect) (string, uuid.UUID, error) {
+		if instMon := instance.MonitorData.GetByPathAndNode(p, a.localhost); instMon != nil {
+			id, err := a.setObjectGlobalExpect(ctx, p, globalExpect)
+			return a.localhost, id, err
+		}
+		fn, ok := objectActionFuncs[globalExpect]
+		if !ok {
+			return "", uuid.Nil, fmt.Errorf("unsupported global expect %s", globalExpect)
+		}
+		for nodename := range instance.MonitorData.GetByPath(p) {
+			if nodename == a.localhost {
+				continue
+			}
+			tk, err := a.createAccessTokenWithGrants(username, 5*time.Second, daemonauth.TkUseProxy, grantL, authStrategy)
+			if err != nil {
+				return nodename, uuid.Nil, fmt.Errorf("create proxy token: %w", err)
+			}
+			c, err := client.New(client.WithURL(daemonsubsystem.PeerURL(nodename)), client.WithBearer(tk.AccessToken))
+			if err != nil {
+				return nodename, uuid.Nil, err
+			}
+			resp, err := fn(c, ctx, p.Namespace, p.Kind, p.Name)
+			if err != nil {
+				return nodename, uuid.Nil, err
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				var problem api.Problem
+				_ = json.NewDecoder(resp.Body).Decode(&problem)
+				return nodename, uuid.Nil, fmt.Errorf("%s: %s: %s", nodename, resp.Status, problem.Detail)
+			}
+			var queued api.OrchestrationQueued
+			if err := json.NewDecoder(resp.Body).Decode(&queued); err != nil {
+				return nodename, uuid.Nil, fmt.Errorf("%s: decode response: %w", nodename, err)
+			}
+			return nodename, queued.OrchestrationID, nil
+		}
+		return "", uuid.Nil, fmt.Errorf("object not found")
+	}
+}
+
+// setObjectGlobalExpect sets the global expect on the local instance
+// monitor of the object p, and returns the orchestration id.
+func (a *DaemonAPI) setObjectGlobalExpect(ctx context.Context, p naming.Path, globalExpect instance.MonitorGlobalExpect) (uuid.UUID, error) {
+	ctx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel()
+	value := instance.MonitorUpdate{
+		GlobalExpect:             &globalExpect,
+		CandidateOrchestrationID: uuid.New(),
+	}
+	msg, setImonErr := msgbus.NewSetInstanceMonitorWithErr(ctx, p, a.localhost, value)
+	a.Bus.Pub(msg, pubsub.Label{"namespace", p.Namespace}, pubsub.Label{"path", p.String()}, labelOriginAPI)
+	return value.CandidateOrchestrationID, setImonErr.Receive()
+}
@@ -41,7 +41,7 @@ func initBasicUser(_ context.Context, i any) (string, auth.Strategy, error) {
 		}
 		return auth.NewUserInfo(userName, "", nil, *authenticatedExtensions(StrategyUser, hostname.Hostname(), grants...)), nil
 	}
-	return name, basic.NewCached(validateUser, cache), nil
+	return name, basic.NewCached(validateUser, newBasicCache()), nil
 }
 
 func initBasicNode(_ context.Context, i interface{}) (string, auth.Strategy, error) {
@@ -58,5 +58,5 @@ func initBasicNode(_ context.Context, i interface{}) (string, auth.Strategy, err
 		info := auth.NewUserInfo("node-"+userName, "", nil, *extensions)
 		return info, nil
 	}
-	return name, basic.NewCached(validate, cache), nil
+	return name, basic.NewCached(validate, newBasicCache()), nil
 }
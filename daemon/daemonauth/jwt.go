@@ -13,6 +13,10 @@ import (
 	"github.com/shaj13/go-guardian/v2/auth"
 	"github.com/shaj13/go-guardian/v2/auth/strategies/token"
 	"golang.org/x/crypto/ssh"
+
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/pubsub"
 )
 
 type (
@@ -21,8 +25,9 @@ type (
 
 	// apiClaims defines api claims
 	apiClaims struct {
-		Grant    []string `json:"grant"`
-		TokenUse string   `json:"token_use"`
+		Grant        []string `json:"grant"`
+		TokenUse     string   `json:"token_use"`
+		AuthStrategy string   `json:"auth_strategy"`
 		*jwt.RegisteredClaims
 	}
 
@@ -53,11 +58,21 @@ const (
 
 	// TkUseProxy represents the token usage type for proxy tokens.
 	TkUseProxy = "proxy"
+
+	// TkUseAPI represents the token usage type for the long-lived usr api
+	// tokens.
+	TkUseAPI = "api"
+
+	// TkAuthStrategyClaim is a constant used as the key to identify the
+	// origin of the token grants in claims or authentication context: the
+	// strategy of the authentication the token was issued from, or
+	// StrategyUser for the grants of a usr object.
+	TkAuthStrategyClaim = "auth_strategy"
 )
 
 // initJWT initializes the JWT authentication strategy using provided configuration and context.
 // It returns the strategy name ("jwt"), an instance of the auth.Strategy, and any error encountered.
-func initJWT(_ context.Context, i interface{}) (string, auth.Strategy, error) {
+func initJWT(ctx context.Context, i interface{}) (string, auth.Strategy, error) {
 	var (
 		err       error
 		verifyKey *rsa.PublicKey
@@ -70,6 +85,9 @@ func initJWT(_ context.Context, i interface{}) (string, auth.Strategy, error) {
 		return name, nil, err
 	}
 	jwtSignKey.Store(signKey) // Assign to the global variable
+	publisher := pubsub.PubFromContext(ctx)
+	localhost := hostname.Hostname()
+	apiTokenValidater, _ := i.(APITokenValidater)
 	validate := func(ctx context.Context, r *http.Request, s string) (info auth.Info, exp time.Time, err error) {
 		var tk *jwt.Token
 
@@ -83,10 +101,34 @@ func initJWT(_ context.Context, i interface{}) (string, auth.Strategy, error) {
 		exp = claims.ExpiresAt.Time
 		iss := claims.Issuer
 
+		if claims.TokenUse == TkUseAPI {
+			// The api tokens are long-lived and revocable: verify the
+			// token is still active, and limit the validation cache
+			// duration so a revocation is effective shortly.
+			if apiTokenValidater == nil {
+				err = fmt.Errorf("api tokens are not supported")
+				return
+			}
+			if err = apiTokenValidater.ValidateAPIToken(claims.Subject, claims.ID); err != nil {
+				return
+			}
+			now := time.Now()
+			if cacheExp := now.Add(apiTokenCacheTTL); cacheExp.Before(exp) {
+				exp = cacheExp
+			}
+			if TokenUsage.mustPublish(claims.ID, now) {
+				publisher.Pub(&msgbus.UsrTokenUsed{Node: localhost, Username: claims.Subject, ID: claims.ID, At: now},
+					pubsub.Label{"node", localhost})
+			}
+		}
+
 		extensions := authenticatedExtensions(StrategyJWT, iss, claims.Grant...)
 		if claims.TokenUse != "" {
 			extensions.Set(TkUseClaim, claims.TokenUse)
 		}
+		if claims.AuthStrategy != "" {
+			extensions.Set(TkAuthStrategyClaim, claims.AuthStrategy)
+		}
 		info = auth.NewUserInfo(claims.Subject, claims.Subject, nil, *extensions)
 		return
 	}
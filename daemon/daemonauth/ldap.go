@@ -0,0 +1,220 @@
+package daemonauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	ldapv3 "github.com/go-ldap/ldap/v3"
+	"github.com/shaj13/go-guardian/v2/auth"
+	"github.com/shaj13/go-guardian/v2/auth/strategies/basic"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/core/datarecv"
+	"github.com/opensvc/om3/v3/core/naming"
+)
+
+type (
+	// LDAPSettings is the interface for the ldap authentication strategy
+	// configuration.
+	LDAPSettings interface {
+		LDAPConfig() *cluster.ConfigLDAP
+	}
+
+	// ldapDirectory is the directory of the ldap strategy.
+	ldapDirectory struct {
+		cfg       *cluster.ConfigLDAP
+		tlsConfig *tls.Config
+	}
+)
+
+var (
+	// ldapCurrent is the directory of the current ldap strategy, or nil if
+	// the ldap strategy is disabled.
+	ldapCurrent atomic.Pointer[ldapDirectory]
+)
+
+// initLDAP initializes the ldap basic auth strategy. The user is bound in
+// the directory, and granted the grants of the configured groups it is
+// member of.
+func initLDAP(_ context.Context, i any) (string, auth.Strategy, error) {
+	name := StrategyLDAP
+	settings, ok := i.(LDAPSettings)
+	if !ok {
+		return name, nil, nil
+	}
+	cfg := settings.LDAPConfig()
+	if cfg == nil || !cfg.IsEnabled() {
+		ldapCurrent.Store(nil)
+		return name, nil, nil
+	}
+	if err := cfg.ValidateTransport(); err != nil {
+		ldapCurrent.Store(nil)
+		return name, nil, err
+	}
+	tlsConfig, err := ldapTLSConfig(cfg)
+	if err != nil {
+		ldapCurrent.Store(nil)
+		return name, nil, err
+	}
+	ldapCurrent.Store(&ldapDirectory{cfg: cfg, tlsConfig: tlsConfig})
+	validate := func(ctx context.Context, r *http.Request, userName string, password string) (auth.Info, error) {
+		if userName == "" || password == "" {
+			// a bind with an empty password is an unauthenticated bind,
+			// accepted by most directories.
+			return nil, fmt.Errorf("invalid ldap user %s: empty username or password", userName)
+		}
+		groups, err := ldapAuthenticate(cfg, tlsConfig, userName, password)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ldap user %s: %w", userName, err)
+		}
+		grants := cfg.Grants(groups)
+		return auth.NewUserInfo(userName, "", nil, *authenticatedExtensions(StrategyLDAP, "", grants...)), nil
+	}
+	return name, basic.NewCached(validate, newBasicCache()), nil
+}
+
+// LDAPGrants returns the grants of the groups of the ldap user, searched
+// in the directory with the bind dn. It refreshes the grants of a ldap
+// user without its password, for example on a token refresh.
+func LDAPGrants(userName string) ([]string, error) {
+	directory := ldapCurrent.Load()
+	if directory == nil {
+		return nil, fmt.Errorf("the ldap strategy is disabled")
+	}
+	groups, err := ldapSearch(directory.cfg, directory.tlsConfig, userName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ldap user %s: %w", userName, err)
+	}
+	return directory.cfg.Grants(groups), nil
+}
+
+// ldapAuthenticate binds the user in the directory, and returns the values
+// of its group attribute.
+func ldapAuthenticate(cfg *cluster.ConfigLDAP, tlsConfig *tls.Config, userName, password string) ([]string, error) {
+	return ldapSearch(cfg, tlsConfig, userName, func(conn *ldapv3.Conn, dn string) error {
+		return conn.Bind(dn, password)
+	})
+}
+
+// ldapSearch searches the user in the directory with the bind dn, and
+// returns the values of its group attribute. If verify is not nil, it is
+// called with the user entry dn to authenticate the user.
+//
+// The directory connection is secured by start tls if the start_tls
+// keyword is set.
+func ldapSearch(cfg *cluster.ConfigLDAP, tlsConfig *tls.Config, userName string, verify func(*ldapv3.Conn, string) error) ([]string, error) {
+	bindPassword, err := ldapBindPassword(cfg.BindPassword())
+	if err != nil {
+		return nil, fmt.Errorf("bind password: %w", err)
+	}
+	conn, err := ldapv3.DialURL(cfg.URL, ldapv3.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if cfg.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			return nil, fmt.Errorf("start tls: %w", err)
+		}
+	}
+	if bindPassword != "" {
+		err = conn.Bind(cfg.BindDN, bindPassword)
+	} else {
+		err = conn.UnauthenticatedBind(cfg.BindDN)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result, err := conn.Search(&ldapv3.SearchRequest{
+		BaseDN:     cfg.BaseDN,
+		Scope:      ldapv3.ScopeWholeSubtree,
+		Filter:     fmt.Sprintf(cfg.UserFilter, ldapv3.EscapeFilter(userName)),
+		Attributes: []string{cfg.GroupAttribute},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Entries) != 1 {
+		return nil, fmt.Errorf("search returned %d entries, expecting 1", len(result.Entries))
+	}
+	entry := result.Entries[0]
+	if verify != nil {
+		if err := verify(conn, entry.DN); err != nil {
+			return nil, err
+		}
+	}
+	// the attribute name comparison is case insensitive, like in the
+	// directories.
+	return entry.GetEqualFoldAttributeValues(cfg.GroupAttribute), nil
+}
+
+// ldapBindPassword returns the bind password, decoded from the datastore
+// key if s is a "from <path> key <key>" reference.
+func ldapBindPassword(s string) (string, error) {
+	if !strings.HasPrefix(s, "from ") {
+		return s, nil
+	}
+	km, err := datarecv.ParseKeyMetaRel(s, naming.NsSys)
+	if err != nil {
+		return "", err
+	}
+	b, err := km.RootDecode()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func ldapTLSConfig(cfg *cluster.ConfigLDAP) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: cfg.Insecure,
+	}
+	if u, err := url.Parse(cfg.URL); err == nil {
+		// verify the certificate of the directory reached by start tls
+		tlsConfig.ServerName = u.Hostname()
+	}
+	if cfg.CA == "" {
+		return tlsConfig, nil
+	}
+	b, err := os.ReadFile(cfg.CA)
+	if err != nil {
+		return nil, fmt.Errorf("read ldap ca: %w", err)
+	}
+	roots, err := x509.SystemCertPool()
+	if err != nil {
+		roots = x509.NewCertPool()
+	}
+	if !roots.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("read ldap ca %s: no pem certificate found", cfg.CA)
+	}
+	tlsConfig.RootCAs = roots
+	return tlsConfig, nil
+}
+
+// ldapSignature returns a digest of the ldap settings, used to detect the
+// changes requiring a strategies refresh.
+func ldapSignature(i any) string {
+	settings, ok := i.(LDAPSettings)
+	if !ok {
+		return ""
+	}
+	cfg := settings.LDAPConfig()
+	if cfg == nil {
+		return ""
+	}
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return ""
+	}
+	b = append(b, cfg.BindPassword()...)
+	return fmt.Sprintf("%x", sha256.Sum256(b))
+}
@@ -0,0 +1,389 @@
+package daemonauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	ldapv3 "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+)
+
+type (
+	// fakeDirectory is a minimal ldap server, standing in for slapd. It
+	// serves the simple bind and the (uid=<name>) search requests.
+	fakeDirectory struct {
+		bindDN       string
+		bindPassword string
+		baseDN       string
+		users        map[string]fakeDirectoryUser
+
+		// tlsConfig, if set, enables the StartTLS extended operation.
+		tlsConfig *tls.Config
+
+		// clearBinds is the number of binds received before tls.
+		clearBinds atomic.Int32
+	}
+
+	fakeDirectoryUser struct {
+		password string
+		groups   []string
+	}
+
+	ldapSettings struct {
+		cfg *cluster.ConfigLDAP
+	}
+)
+
+const (
+	ldapResultSuccess            = 0
+	ldapResultInvalidCredentials = 49
+)
+
+func (t ldapSettings) LDAPConfig() *cluster.ConfigLDAP {
+	return t.cfg
+}
+
+func (t *fakeDirectory) userDN(uid string) string {
+	return "uid=" + uid + "," + t.baseDN
+}
+
+func (t *fakeDirectory) serve(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go t.handle(conn)
+	}
+}
+
+func (t *fakeDirectory) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	for {
+		req, err := ber.ReadPacket(conn)
+		if err != nil || len(req.Children) < 2 {
+			return
+		}
+		msgID := req.Children[0].Value.(int64)
+		op := req.Children[1]
+		var responses []*ber.Packet
+		switch op.Tag {
+		case ldapv3.ApplicationExtendedRequest:
+			if t.tlsConfig == nil || len(op.Children) < 1 || op.Children[0].Data.String() != "1.3.6.1.4.1.1466.20037" {
+				_, _ = conn.Write(ldapResponse(msgID, ldapv3.ApplicationExtendedResponse, ldapv3.LDAPResultProtocolError).Bytes())
+				continue
+			}
+			if _, err := conn.Write(ldapResponse(msgID, ldapv3.ApplicationExtendedResponse, ldapResultSuccess).Bytes()); err != nil {
+				return
+			}
+			conn = tls.Server(conn, t.tlsConfig)
+			continue
+		case ldapv3.ApplicationBindRequest:
+			if _, ok := conn.(*tls.Conn); !ok {
+				t.clearBinds.Add(1)
+			}
+			responses = append(responses, t.bind(msgID, op))
+		case ldapv3.ApplicationSearchRequest:
+			responses = t.search(msgID, op)
+		default:
+			return
+		}
+		for _, resp := range responses {
+			if _, err := conn.Write(resp.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (t *fakeDirectory) bind(msgID int64, op *ber.Packet) *ber.Packet {
+	dn := op.Children[1].Value.(string)
+	password := op.Children[2].Data.String()
+	if dn == t.bindDN && password == t.bindPassword {
+		return ldapResponse(msgID, ldapv3.ApplicationBindResponse, ldapResultSuccess)
+	}
+	for uid, user := range t.users {
+		if dn == t.userDN(uid) && password == user.password {
+			return ldapResponse(msgID, ldapv3.ApplicationBindResponse, ldapResultSuccess)
+		}
+	}
+	return ldapResponse(msgID, ldapv3.ApplicationBindResponse, ldapResultInvalidCredentials)
+}
+
+func (t *fakeDirectory) search(msgID int64, op *ber.Packet) []*ber.Packet {
+	var responses []*ber.Packet
+	filter, err := ldapv3.DecompileFilter(op.Children[6])
+	if err != nil {
+		return append(responses, ldapResponse(msgID, ldapv3.ApplicationSearchResultDone, ldapv3.LDAPResultProtocolError))
+	}
+	uid := strings.TrimSuffix(strings.TrimPrefix(filter, "(uid="), ")")
+	if user, ok := t.users[uid]; ok {
+		entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldapv3.ApplicationSearchResultEntry, nil, "")
+		entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, t.userDN(uid), ""))
+		attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "memberOf", ""))
+		values := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "")
+		for _, group := range user.groups {
+			values.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, group, ""))
+		}
+		attribute.AppendChild(values)
+		attributes.AppendChild(attribute)
+		entry.AppendChild(attributes)
+		responses = append(responses, ldapEnvelope(msgID, entry))
+	}
+	return append(responses, ldapResponse(msgID, ldapv3.ApplicationSearchResultDone, ldapResultSuccess))
+}
+
+func ldapEnvelope(msgID int64, op *ber.Packet) *ber.Packet {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msgID, ""))
+	p.AppendChild(op)
+	return p
+}
+
+func ldapResponse(msgID int64, tag ber.Tag, code int64) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, ""))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+	return ldapEnvelope(msgID, op)
+}
+
+func TestLDAPStrategy(t *testing.T) {
+	directory := &fakeDirectory{
+		bindDN:       "cn=om3,ou=services,dc=example,dc=com",
+		bindPassword: "s3rv1ce",
+		baseDN:       "ou=people,dc=example,dc=com",
+		users: map[string]fakeDirectoryUser{
+			"alice": {password: "al1ce", groups: []string{"CN=Ops,OU=Groups,DC=example,DC=com", "cn=dev,ou=groups,dc=example,dc=com"}},
+			"bob":   {password: "b0b", groups: []string{"cn=sales,ou=groups,dc=example,dc=com"}},
+		},
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = l.Close() }()
+	go directory.serve(l)
+
+	cfg := &cluster.ConfigLDAP{
+		URL:            "ldap://" + l.Addr().String(),
+		Insecure:       true,
+		BindDN:         directory.bindDN,
+		BaseDN:         directory.baseDN,
+		UserFilter:     "(uid=%s)",
+		GroupAttribute: "memberof",
+		Groups: []cluster.ConfigLDAPGroup{
+			{Name: "ops", DN: "cn=ops,ou=groups,dc=example,dc=com", Grants: []string{"operator:prod", "guest:test"}},
+			{Name: "dev", DN: "cn=dev,ou=groups,dc=example,dc=com", Grants: []string{"admin:test", "guest:test"}},
+		},
+	}
+	cfg.SetBindPassword(directory.bindPassword)
+
+	require.NoError(t, initCache())
+	name, strategy, err := initLDAP(context.Background(), ldapSettings{cfg: cfg})
+	require.NoError(t, err)
+	require.Equal(t, StrategyLDAP, name)
+	require.NotNil(t, strategy)
+
+	authenticate := func(username, password string) (string, []string, error) {
+		r, err := http.NewRequest(http.MethodGet, "/api/node", nil)
+		require.NoError(t, err)
+		r.SetBasicAuth(username, password)
+		info, err := strategy.Authenticate(context.Background(), r)
+		if err != nil {
+			return "", nil, err
+		}
+		extensions := info.GetExtensions()
+		require.Equal(t, StrategyLDAP, extensions.Get("strategy"))
+		return info.GetUserName(), extensions.Values("grant"), nil
+	}
+
+	t.Run("user with mapped groups", func(t *testing.T) {
+		username, grants, err := authenticate("alice", "al1ce")
+		require.NoError(t, err)
+		require.Equal(t, "alice", username)
+		require.Equal(t, []string{"operator:prod", "guest:test", "admin:test"}, grants)
+	})
+
+	t.Run("user without mapped group", func(t *testing.T) {
+		_, grants, err := authenticate("bob", "b0b")
+		require.NoError(t, err)
+		require.Empty(t, grants)
+	})
+
+	t.Run("invalid password", func(t *testing.T) {
+		_, _, err := authenticate("bob", "wrong")
+		require.Error(t, err)
+	})
+
+	t.Run("empty password", func(t *testing.T) {
+		_, _, err := authenticate("bob", "")
+		require.Error(t, err)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		_, _, err := authenticate("carol", "c4rol")
+		require.Error(t, err)
+	})
+
+	t.Run("filter injection", func(t *testing.T) {
+		_, _, err := authenticate("*", "al1ce")
+		require.Error(t, err)
+	})
+
+	t.Run("grants lookup without password", func(t *testing.T) {
+		grants, err := LDAPGrants("alice")
+		require.NoError(t, err)
+		require.Equal(t, []string{"operator:prod", "guest:test", "admin:test"}, grants)
+
+		_, err = LDAPGrants("carol")
+		require.Error(t, err)
+	})
+}
+
+func TestLDAPStrategyDisabled(t *testing.T) {
+	_, strategy, err := initLDAP(context.Background(), ldapSettings{cfg: &cluster.ConfigLDAP{}})
+	require.NoError(t, err)
+	require.Nil(t, strategy)
+	_, err = LDAPGrants("alice")
+	require.Error(t, err)
+}
+
+func TestLDAPStrategyTransport(t *testing.T) {
+	cases := map[string]struct {
+		url      string
+		startTLS bool
+		insecure bool
+		ok       bool
+	}{
+		"ldaps":                  {url: "ldaps://ldap.example.com", ok: true},
+		"ldap with start tls":    {url: "ldap://ldap.example.com", startTLS: true, ok: true},
+		"ldap with insecure":     {url: "ldap://ldap.example.com", insecure: true, ok: true},
+		"ldap without start tls": {url: "ldap://ldap.example.com"},
+		"ldaps with start tls":   {url: "ldaps://ldap.example.com", startTLS: true},
+		"unsupported scheme":     {url: "http://ldap.example.com", insecure: true},
+	}
+	require.NoError(t, initCache())
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := &cluster.ConfigLDAP{URL: tc.url, StartTLS: tc.startTLS, Insecure: tc.insecure, UserFilter: "(uid=%s)"}
+			_, strategy, err := initLDAP(context.Background(), ldapSettings{cfg: cfg})
+			if tc.ok {
+				require.NoError(t, err)
+				require.NotNil(t, strategy)
+			} else {
+				require.Error(t, err)
+				require.Nil(t, strategy)
+			}
+		})
+	}
+}
+
+func TestLDAPStrategyStartTLS(t *testing.T) {
+	certPEM, cert := newTestCertificate(t)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, certPEM, 0600))
+
+	directory := &fakeDirectory{
+		bindDN:       "cn=om3,ou=services,dc=example,dc=com",
+		bindPassword: "s3rv1ce",
+		baseDN:       "ou=people,dc=example,dc=com",
+		users: map[string]fakeDirectoryUser{
+			"alice": {password: "al1ce", groups: []string{"cn=ops,ou=groups,dc=example,dc=com"}},
+		},
+		tlsConfig: &tls.Config{Certificates: []tls.Certificate{cert}},
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = l.Close() }()
+	go directory.serve(l)
+
+	cfg := &cluster.ConfigLDAP{
+		URL:            "ldap://" + l.Addr().String(),
+		StartTLS:       true,
+		CA:             caFile,
+		BindDN:         directory.bindDN,
+		BaseDN:         directory.baseDN,
+		UserFilter:     "(uid=%s)",
+		GroupAttribute: "memberOf",
+		Groups: []cluster.ConfigLDAPGroup{
+			{Name: "ops", DN: "cn=ops,ou=groups,dc=example,dc=com", Grants: []string{"operator:prod"}},
+		},
+	}
+	cfg.SetBindPassword(directory.bindPassword)
+
+	groups, err := ldapAuthenticate(cfg, mustLDAPTLSConfig(t, cfg), "alice", "al1ce")
+	require.NoError(t, err)
+	require.Equal(t, []string{"cn=ops,ou=groups,dc=example,dc=com"}, groups)
+	require.Zero(t, directory.clearBinds.Load(), "expected no bind before start tls")
+
+	t.Run("untrusted directory certificate", func(t *testing.T) {
+		untrusted := *cfg
+		untrusted.CA = ""
+		_, err := ldapAuthenticate(&untrusted, mustLDAPTLSConfig(t, &untrusted), "alice", "al1ce")
+		require.Error(t, err)
+		require.Zero(t, directory.clearBinds.Load(), "expected no bind before start tls")
+	})
+}
+
+func mustLDAPTLSConfig(t *testing.T, cfg *cluster.ConfigLDAP) *tls.Config {
+	t.Helper()
+	tlsConfig, err := ldapTLSConfig(cfg)
+	require.NoError(t, err)
+	return tlsConfig
+}
+
+// newTestCertificate returns a self-signed certificate valid for
+// 127.0.0.1, in pem format and as a tls certificate.
+func newTestCertificate(t *testing.T) ([]byte, tls.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ldap test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	return certPEM, cert
+}
+
+func TestLDAPBindPassword(t *testing.T) {
+	s, err := ldapBindPassword("s3rv1ce")
+	require.NoError(t, err)
+	require.Equal(t, "s3rv1ce", s)
+
+	_, err = ldapBindPassword("from system/sec/ldap")
+	require.ErrorContains(t, err, "key name not found")
+}
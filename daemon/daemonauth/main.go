@@ -28,6 +28,7 @@ type (
 		X509CACertFiler
 		NodeAuthenticater
 		UserGranter
+		APITokenValidater
 	}
 	contextKey int
 
@@ -55,6 +56,7 @@ const (
 	StrategyUX        = "ux"
 	StrategyJWT       = "jwt"
 	StrategyJWTOpenID = "jwt-openid"
+	StrategyLDAP      = "ldap"
 	StrategyNode      = "node"
 	StrategyUser      = "user"
 	StrategyX509      = "x509"
@@ -87,6 +89,16 @@ func initCache() error {
 	return nil
 }
 
+// newBasicCache returns a cache for the authentication decisions of a
+// basic strategy. The cache is keyed by username, so each basic strategy
+// has its own cache: a shared cache would authenticate a user with the
+// decision of another strategy for the same username.
+func newBasicCache() libcache.Cache {
+	c := libcache.FIFO.New(0)
+	c.SetTTL(time.Second * 5)
+	return c
+}
+
 func ContextWithJWTCreator(ctx context.Context) context.Context {
 	return context.WithValue(ctx, jwtCreatorContextKey, &JWTCreator{})
 }
@@ -106,6 +118,7 @@ func Start(ctx context.Context, authCfg any) error {
 	}
 
 	currentSetting := signature(authCfg)
+	currentLDAPSetting := ldapSignature(authCfg)
 
 	s, err := initStategies(ctx, authCfg)
 	if err != nil {
@@ -116,8 +129,13 @@ func Start(ctx context.Context, authCfg any) error {
 	sub.AddFilter(&msgbus.AuditStart{})
 	sub.AddFilter(&msgbus.AuditStop{})
 	sub.AddFilter(&msgbus.ClusterConfigUpdated{}, pubsub.Label{"node", hostname.Hostname()})
+	sub.AddFilter(&msgbus.UsrTokenUsed{})
 	sub.Start()
 
+	if err := TokenUsage.load(); err != nil {
+		log.Warnf("load api token usage: %s", err)
+	}
+
 	go func() {
 		defer func() { _ = sub.Stop() }()
 		log.Infof("starting authentication strategies routine from %s", currentSetting)
@@ -145,9 +163,16 @@ func Start(ctx context.Context, authCfg any) error {
 					log.HandleAuditStart(c.Q, c.Subsystems, "daemonauth")
 				case *msgbus.AuditStop:
 					log.HandleAuditStop(c.Q, c.Subsystems, "daemonauth")
+				case *msgbus.UsrTokenUsed:
+					if TokenUsage.set(c.ID, c.At) {
+						if err := TokenUsage.save(); err != nil {
+							log.Warnf("save api token usage: %s", err)
+						}
+					}
 				case *msgbus.ClusterConfigUpdated:
 					newSetting := signature(authCfg)
-					if newSetting != currentSetting {
+					newLDAPSetting := ldapSignature(authCfg)
+					if newSetting != currentSetting || newLDAPSetting != currentLDAPSetting {
 						log.Infof("listener setting changed, refresh authentication strategies")
 						s, err := initStategies(ctx, authCfg)
 						if err != nil {
@@ -155,6 +180,7 @@ func Start(ctx context.Context, authCfg any) error {
 						} else {
 							Strategy.setStrategy(s)
 							currentSetting = newSetting
+							currentLDAPSetting = newLDAPSetting
 							ticker.Reset(authRefreshInterval)
 						}
 					}
@@ -179,6 +205,7 @@ func initStategies(ctx context.Context, i any) (union.Union, error) {
 		initJWTOpenID,
 		initBasicNode,
 		initBasicUser,
+		initLDAP,
 		initX509,
 	} {
 		name, s, err := fn(ctx, i)
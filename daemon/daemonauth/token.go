@@ -0,0 +1,118 @@
+package daemonauth
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/rawconfig"
+)
+
+type (
+	// APITokenValidater is the interface for ValidateAPIToken method for the
+	// usr api tokens jwt auth.
+	APITokenValidater interface {
+		ValidateAPIToken(username, id string) error
+	}
+
+	// tokenUsage holds the last use time of the usr api tokens, as seen by
+	// all the cluster nodes.
+	tokenUsage struct {
+		sync.RWMutex
+		lastUsed map[string]time.Time
+
+		// published is the last time the local node published the use of
+		// a token.
+		published map[string]time.Time
+	}
+)
+
+var (
+	// TokenUsage holds the last use time of the usr api tokens.
+	TokenUsage = &tokenUsage{
+		lastUsed:  make(map[string]time.Time),
+		published: make(map[string]time.Time),
+	}
+
+	// apiTokenCacheTTL is the maximum duration a validated api token is kept
+	// in the strategy cache. It is the maximum delay for a token revocation
+	// to be effective.
+	apiTokenCacheTTL = 10 * time.Second
+
+	// tokenUsagePublishInterval is the minimum delay between two
+	// UsrTokenUsed publications of the same token by the local node.
+	tokenUsagePublishInterval = time.Minute
+)
+
+func tokenUsageFile() string {
+	return filepath.Join(rawconfig.Paths.Var, "auth", "token_usage.json")
+}
+
+// LastUsed returns the last use time of the api token identified by id.
+func (t *tokenUsage) LastUsed(id string) time.Time {
+	t.RLock()
+	defer t.RUnlock()
+	return t.lastUsed[id]
+}
+
+// set records at as the last use time of the api token identified by id,
+// if more recent than the known one. It returns true if the record changed.
+func (t *tokenUsage) set(id string, at time.Time) bool {
+	t.Lock()
+	defer t.Unlock()
+	if !at.After(t.lastUsed[id]) {
+		return false
+	}
+	t.lastUsed[id] = at
+	return true
+}
+
+// mustPublish returns true if the local use of the api token identified by id
+// has not been published since tokenUsagePublishInterval.
+func (t *tokenUsage) mustPublish(id string, now time.Time) bool {
+	t.Lock()
+	defer t.Unlock()
+	if now.Sub(t.published[id]) < tokenUsagePublishInterval {
+		return false
+	}
+	t.published[id] = now
+	return true
+}
+
+func (t *tokenUsage) load() error {
+	b, err := os.ReadFile(tokenUsageFile())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	m := make(map[string]time.Time)
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	for id, at := range m {
+		t.set(id, at)
+	}
+	return nil
+}
+
+func (t *tokenUsage) save() error {
+	t.RLock()
+	b, err := json.Marshal(t.lastUsed)
+	t.RUnlock()
+	if err != nil {
+		return err
+	}
+	p := tokenUsageFile()
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return err
+	}
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/opensvc/om3/v3/core/cluster"
 	"github.com/opensvc/om3/v3/core/hbtype"
+	"github.com/opensvc/om3/v3/daemon/ratelimit"
 )
 
 type (
@@ -24,6 +25,7 @@ var (
 	contextListenAddr            = contextKey("listen-addr")
 	contextLsnrType              = contextKey("lsnr-type")
 	contextLsnrRateLimiterConfig = contextKey("lsnr-rate-limiter-config")
+	contextLsnrRateLimits        = contextKey("lsnr-rate-limits")
 	contextAuditRegistry         = contextKey("audit-registry")
 	contextLogger                = contextKey("logger")
 )
@@ -106,6 +108,21 @@ func WithListenRateLimiterConfig(ctx context.Context, rate rate.Limit, burst int
 	return context.WithValue(ctx, contextLsnrRateLimiterConfig, cfg)
 }
 
+// WithListenRateLimits adds the per-user rate limits and stream quotas
+// limiter to the given context.
+func WithListenRateLimits(ctx context.Context, l *ratelimit.Limiter) context.Context {
+	return context.WithValue(ctx, contextLsnrRateLimits, l)
+}
+
+// ListenRateLimits returns the per-user rate limits and stream quotas
+// limiter from the context, or nil.
+func ListenRateLimits(ctx context.Context) *ratelimit.Limiter {
+	if v, ok := ctx.Value(contextLsnrRateLimits).(*ratelimit.Limiter); ok {
+		return v
+	}
+	return nil
+}
+
 func WithAuditRegistry(parent context.Context, r *auditstate.Registry) context.Context {
 	return context.WithValue(parent, contextAuditRegistry, r)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/opensvc/om3/v3/core/pool"
 	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
 	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/pubsub"
 )
 
 var (
@@ -178,7 +179,12 @@ func (d *data) setCacheAndPublish(ev event.Event) error {
 		d.publisher.Pub(c, labelFromPeer)
 	case *msgbus.InstanceMonitorUpdated:
 		instance.MonitorData.Set(c.Path, c.Node, &c.Value)
-		d.publisher.Pub(c, labelFromPeer)
+		d.publisher.Pub(c,
+			pubsub.Label{"namespace", c.Path.Namespace},
+			pubsub.Label{"path", c.Path.String()},
+			pubsub.Label{"node", c.Node},
+			labelFromPeer,
+		)
 	case *msgbus.InstanceStatusDeleted:
 		instance.StatusData.Unset(c.Path, c.Node)
 		d.publisher.Pub(c, labelFromPeer)
@@ -213,15 +219,26 @@ func (d *data) setCacheAndPublish(ev event.Event) error {
 	case *msgbus.ObjectOrchestrationAccepted:
 		d.publisher.Pub(c, labelFromPeer)
 	case *msgbus.ObjectOrchestrationEnd:
-		d.publisher.Pub(c, labelFromPeer)
+		d.publisher.Pub(c,
+			pubsub.Label{"namespace", c.Path.Namespace},
+			pubsub.Label{"path", c.Path.String()},
+			labelFromPeer,
+		)
 	case *msgbus.ObjectOrchestrationRefused:
-		d.publisher.Pub(c, labelFromPeer)
+		d.publisher.Pub(c,
+			pubsub.Label{"namespace", c.Path.Namespace},
+			pubsub.Label{"path", c.Path.String()},
+			labelFromPeer,
+		)
 	case *msgbus.ObjectStatusDeleted:
 		d.publisher.Pub(c, labelFromPeer)
 	// pool...
 	case *msgbus.NodePoolStatusUpdated:
 		pool.StatusData.Set(c.Name, c.Node, c.Value.DeepCopy())
 		d.publisher.Pub(c, labelFromPeer)
+	// usr...
+	case *msgbus.UsrTokenUsed:
+		d.publisher.Pub(c, labelFromPeer)
 	// overload
 	case *msgbus.EnterOverloadPeriod:
 		d.publisher.Pub(c, labelFromPeer)
@@ -445,6 +445,8 @@ func (d *data) startSubscriptions(ctx context.Context, qs pubsub.QueueSizer) {
 	sub.AddFilter(&msgbus.NodePoolStatusDeleted{}, d.labelLocalhost)
 	sub.AddFilter(&msgbus.NodePoolStatusUpdated{}, d.labelLocalhost)
 
+	sub.AddFilter(&msgbus.UsrTokenUsed{}, d.labelLocalhost)
+
 	sub.AddFilter(&msgbus.EnterOverloadPeriod{}, d.labelLocalhost)
 	sub.AddFilter(&msgbus.LeaveOverloadPeriod{}, d.labelLocalhost)
 
@@ -515,6 +517,9 @@ func localEventMustBeForwarded(i interface{}) bool {
 	case *msgbus.NodePoolStatusDeleted:
 	case *msgbus.NodePoolStatusUpdated:
 
+	// usr...
+	case *msgbus.UsrTokenUsed:
+
 	// overload
 	case *msgbus.EnterOverloadPeriod:
 	case *msgbus.LeaveOverloadPeriod:
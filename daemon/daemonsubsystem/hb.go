@@ -1,6 +1,7 @@
 package daemonsubsystem
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/fatih/color"
@@ -55,6 +56,15 @@ type (
 		Peers map[string]HeartbeatStreamPeerStatus `json:"peers"`
 
 		Alerts []Alert `json:"alerts"`
+
+		// Codec is the compression codec of the messages sent by a sending
+		// heartbeat stream
+		Codec string `json:"codec,omitempty"`
+
+		// Mode is the mode of the messages sent by a sending heartbeat
+		// stream: full, or patch-only and ping-only when the full messages
+		// exceed the stream size budget
+		Mode string `json:"mode,omitempty"`
 	}
 
 	// HeartbeatStreamPeerStatus status of the communication with a specific peer node.
@@ -67,6 +77,40 @@ type (
 
 		// LastBeatingAt is the last beating time
 		LastBeatingAt time.Time `json:"last_beating_at"`
+
+		// Metrics is the link quality metrics with the peer
+		Metrics HeartbeatStreamPeerMetrics `json:"metrics"`
+	}
+
+	// HeartbeatStreamPeerMetrics is the link quality metrics of the
+	// communication with a specific peer node.
+	HeartbeatStreamPeerMetrics struct {
+		// Latency is the moving average of the delay between a message
+		// creation on the sender node and its reception. It includes the
+		// nodes clock skew.
+		Latency time.Duration `json:"latency"`
+
+		// Jitter is the moving average of the message transit time
+		// variations.
+		Jitter time.Duration `json:"jitter"`
+
+		// LossRatio is the recent ratio of expected messages not received.
+		LossRatio float64 `json:"loss_ratio"`
+
+		// MsgSize is the moving average of the message size in bytes
+		MsgSize int `json:"msg_size"`
+
+		// MsgSizeMax is the largest message size in bytes
+		MsgSizeMax int `json:"msg_size_max"`
+
+		// Received is the number of messages received, or sent for a
+		// sending heartbeat.
+		Received uint64 `json:"received"`
+
+		// Timeout is the delay without message after which the peer is
+		// stale. It varies with the link quality when the heartbeat
+		// adaptive_timeout is enabled.
+		Timeout time.Duration `json:"timeout"`
 	}
 
 	HeartbeatStreamPeerStatusTable      []HeartbeatStreamPeerStatusTableEntry
@@ -76,6 +120,8 @@ type (
 		Status
 		Type   string  `json:"type"`
 		Alerts []Alert `json:"alerts"`
+		Codec  string  `json:"codec,omitempty"`
+		Mode   string  `json:"mode,omitempty"`
 		HeartbeatStreamPeerStatus
 		IsSingleNode bool `json:"-"`
 	}
@@ -124,6 +170,14 @@ func (t HeartbeatStreamPeerStatusTableEntry) Unstructured() map[string]any {
 	if desc == "" {
 		desc = "N/A"
 	}
+	mode := t.Mode
+	if mode == "" {
+		mode = "N/A"
+	}
+	codec := t.Codec
+	if codec == "" {
+		codec = "N/A"
+	}
 
 	return map[string]any{
 		"node":            t.Node,
@@ -138,11 +192,20 @@ func (t HeartbeatStreamPeerStatusTableEntry) Unstructured() map[string]any {
 		"updated_at":      t.Status.UpdatedAt,
 		"created_at":      t.Status.CreatedAt,
 		"desc":            desc,
+		"codec":           codec,
+		"mode":            mode,
 		"changed_at":      t.ChangedAt.Format(time.RFC3339Nano),
 		"last_beating_at": t.LastBeatingAt.Format(time.RFC3339Nano),
 		"is_beating":      t.IsBeating,
 		"beating":         beatingText,
 		"beating_icon":    beatingIcon,
+		"latency":         t.Metrics.Latency.Round(time.Microsecond).String(),
+		"jitter":          t.Metrics.Jitter.Round(time.Microsecond).String(),
+		"loss":            fmt.Sprintf("%.1f%%", 100*t.Metrics.LossRatio),
+		"msg_size":        t.Metrics.MsgSize,
+		"msg_size_max":    t.Metrics.MsgSizeMax,
+		"received":        t.Metrics.Received,
+		"timeout":         t.Metrics.Timeout.String(),
 	}
 }
 
@@ -157,6 +220,8 @@ func (c *Heartbeat) Table(nodeName string, isSingleNode bool) HeartbeatStreamPee
 					Status:                    stream.Status,
 					Type:                      stream.Type,
 					Alerts:                    append([]Alert{}, stream.Alerts...),
+					Codec:                     stream.Codec,
+					Mode:                      stream.Mode,
 					HeartbeatStreamPeerStatus: peerStatus,
 					IsSingleNode:              isSingleNode,
 				})
@@ -168,6 +233,8 @@ func (c *Heartbeat) Table(nodeName string, isSingleNode bool) HeartbeatStreamPee
 				Status:                    stream.Status,
 				Type:                      stream.Type,
 				Alerts:                    append([]Alert{}, stream.Alerts...),
+				Codec:                     stream.Codec,
+				Mode:                      stream.Mode,
 				HeartbeatStreamPeerStatus: HeartbeatStreamPeerStatus{},
 				IsSingleNode:              isSingleNode,
 			})
@@ -198,5 +265,7 @@ func (c *HeartbeatStream) DeepCopy() *HeartbeatStream {
 		Type:   c.Type,
 		Peers:  peers,
 		Alerts: append([]Alert{}, c.Alerts...),
+		Codec:  c.Codec,
+		Mode:   c.Mode,
 	}
 }
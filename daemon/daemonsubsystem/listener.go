@@ -17,11 +17,30 @@ type (
 		Port string `json:"port"`
 
 		RateLimiter cluster.RateLimiterConfig `json:"rate_limiter"`
+
+		// Quotas is the state of the per-user rate limits and stream
+		// quotas of the api listener.
+		Quotas []ListenerQuota `json:"quotas,omitempty"`
+	}
+
+	// ListenerQuota is the state of a rate limit rule for a user.
+	ListenerQuota struct {
+		// Rule is the name of the rate_limit#<name> section.
+		Rule string `json:"rule"`
+
+		User string `json:"user"`
+
+		// Streams is the number of event or log streams the user has open.
+		Streams int `json:"streams"`
+
+		// Denied is the number of requests denied to the user.
+		Denied uint64 `json:"denied"`
 	}
 )
 
 func (c *Listener) DeepCopy() *Listener {
 	d := *c
+	d.Quotas = append([]ListenerQuota(nil), c.Quotas...)
 	return &d
 }
 
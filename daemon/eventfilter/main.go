@@ -0,0 +1,316 @@
+// Package eventfilter parses and evaluates the daemon event filter
+// expressions, used by the event stream api and by the node hooks.
+//
+// The filter syntax is: [kind][,label=value][,.abcd.efgh=value]*
+//
+// A filter without kind matches all the event kinds. The label conditions
+// are matched by the subscription, and the data conditions are matched
+// against the flattened event data.
+package eventfilter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/flatten"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+type (
+	Filter struct {
+		Kind   any
+		Labels []pubsub.Label
+
+		// DataFilters is a slice of DataFilter used to define filtering data conditions
+		// based on key, value, and operator.
+		DataFilters DataFilters
+	}
+
+	// DataFilter represents a filtering data condition based on a key, value,
+	// and an operator.
+	DataFilter struct {
+		Key   string
+		Value string
+		Op    string
+	}
+
+	// DataFilters is a slice of DataFilter used to define a collection of filtering data conditions.
+	DataFilters []DataFilter
+
+	kinder interface {
+		Kind() string
+	}
+)
+
+// ParseList returns the filters from the filter expressions of l.
+//
+// A kind can't be filtered multiple times when one of its filters has a
+// data condition.
+func ParseList(l []string) (filters []Filter, err error) {
+	var more []Filter
+	matchKind := make(map[string]bool)
+
+	for _, s := range l {
+		if len(s) == 0 {
+			continue
+		}
+		more, err = Parse(s)
+		if err != nil {
+			return
+		}
+		for _, filter := range more {
+			if filter.IsZero() {
+				continue
+			}
+			if k, ok := filter.Kind.(kinder); ok {
+				kind := k.Kind()
+				hasMatcher, alreadyFiltered := matchKind[kind]
+				if hasMatcher || (alreadyFiltered && len(filter.DataFilters) > 0) {
+					return nil, fmt.Errorf("can't filter same kind multiple times when it has a value matcher: %s", kind)
+				}
+				matchKind[kind] = len(filter.DataFilters) > 0
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return
+}
+
+// KindString returns the kind of the filter, or "" if the filter matches
+// all the event kinds.
+func (f Filter) KindString() string {
+	if k, ok := f.Kind.(kinder); ok {
+		return k.Kind()
+	}
+	return ""
+}
+
+// MatchLabels returns true if the event kind and labels match the filter
+// kind and label conditions.
+func (f Filter) MatchLabels(kind string, labels map[string]string) bool {
+	if s := f.KindString(); s != "" && s != kind {
+		return false
+	}
+	for _, label := range f.Labels {
+		if v, ok := labels[label[0]]; !ok || v != label[1] {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse returns the filters from the filter expression filterStr.
+//
+// filter syntax is: [kind][,label=value][,.abcd.efgh=value]*
+func Parse(filterStr string) ([]Filter, error) {
+	var (
+		filter Filter
+		kinds  []any
+	)
+
+	parseLabelFilter := func(s string) (pubsub.Label, error) {
+		split := strings.SplitN(s, "=", 2)
+		if len(split) != 2 {
+			return pubsub.Label{}, fmt.Errorf("invalid label filter expression: %s (expecting <key><op><value>)", s)
+		}
+		key := strings.TrimSpace(split[0])
+		value := strings.TrimSpace(split[1])
+		if len(key) == 0 {
+			return pubsub.Label{}, fmt.Errorf("invalid label filter expression: %s (empty key)", s)
+		}
+		return pubsub.Label{key, value}, nil
+	}
+
+	parseDataFilter := func(s string) (DataFilter, error) {
+		ops := []string{
+			"!=", ">=", "<=", // keep longer operators first
+			"=", ">", "<",
+		}
+		for _, op := range ops {
+			split := strings.SplitN(s, op, 2)
+			if len(split) != 2 {
+				continue
+			}
+			key := strings.TrimSpace(split[0])
+			value := strings.TrimSpace(split[1])
+			return DataFilter{Key: key, Value: value, Op: op}, nil
+		}
+		return DataFilter{}, fmt.Errorf("invalid filter expression: %s (unknown operator)", s)
+	}
+
+	for _, filterElement := range strings.Split(filterStr, ",") {
+		switch {
+		case len(filterElement) == 0:
+			continue
+		case strings.HasPrefix(filterElement, "."):
+			dataFilter, err := parseDataFilter(strings.TrimPrefix(filterElement, "."))
+			if err != nil {
+				return nil, err
+			}
+			filter.DataFilters = append(filter.DataFilters, dataFilter)
+		case strings.Contains(filterElement, "="):
+			label, err := parseLabelFilter(filterElement)
+			if err != nil {
+				return nil, err
+			}
+			filter.Labels = append(filter.Labels, label)
+		default:
+			kind, err := msgbus.KindToT(filterElement)
+			if err != nil {
+				return nil, err
+			}
+			kinds = append(kinds, kind)
+		}
+	}
+	if kinds == nil {
+		return []Filter{filter}, nil
+	}
+	var filters []Filter
+	for _, kind := range kinds {
+		filters = append(filters, Filter{
+			Kind:        kind,
+			Labels:      filter.Labels,
+			DataFilters: filter.DataFilters,
+		})
+	}
+	return filters, nil
+}
+
+// IsZero returns true if the filter has no kind, label or data condition.
+func (f Filter) IsZero() bool {
+	return f.Kind == nil && len(f.DataFilters) == 0 && len(f.Labels) == 0
+}
+
+// Match returns true if the flattened i has all the df data conditions.
+func (df DataFilters) Match(i any) bool {
+	keys := flatten.Flatten(i)
+
+	intLessOrEqual := func(str1, str2 string) (bool, error) {
+		num1, err1 := strconv.Atoi(str1)
+		num2, err2 := strconv.Atoi(str2)
+		if err1 != nil || err2 != nil {
+			return false, fmt.Errorf("invalid input: both strings must be valid integers")
+		}
+		return num1 <= num2, nil
+	}
+
+	intLess := func(str1, str2 string) (bool, error) {
+		num1, err1 := strconv.Atoi(str1)
+		num2, err2 := strconv.Atoi(str2)
+		if err1 != nil || err2 != nil {
+			return false, fmt.Errorf("invalid input: both strings must be valid integers")
+		}
+		return num1 < num2, nil
+	}
+
+	intGreaterOrEqual := func(str1, str2 string) (bool, error) {
+		num1, err1 := strconv.Atoi(str1)
+		num2, err2 := strconv.Atoi(str2)
+		if err1 != nil || err2 != nil {
+			return false, fmt.Errorf("invalid input: both strings must be valid integers")
+		}
+		return num1 >= num2, nil
+	}
+
+	intGreater := func(str1, str2 string) (bool, error) {
+		num1, err1 := strconv.Atoi(str1)
+		num2, err2 := strconv.Atoi(str2)
+		if err1 != nil || err2 != nil {
+			return false, fmt.Errorf("invalid input: both strings must be valid integers")
+		}
+		return num1 > num2, nil
+	}
+
+	float64LessOrEqual := func(str1, str2 string) (bool, error) {
+		num1, err1 := strconv.ParseFloat(str1, 64)
+		num2, err2 := strconv.ParseFloat(str2, 64)
+		if err1 != nil || err2 != nil {
+			return false, fmt.Errorf("invalid input: both strings must be valid float64")
+		}
+		return num1 <= num2, nil
+	}
+
+	float64Less := func(str1, str2 string) (bool, error) {
+		num1, err1 := strconv.ParseFloat(str1, 64)
+		num2, err2 := strconv.ParseFloat(str2, 64)
+		if err1 != nil || err2 != nil {
+			return false, fmt.Errorf("invalid input: both strings must be valid float64")
+		}
+		return num1 < num2, nil
+	}
+
+	float64GreaterOrEqual := func(str1, str2 string) (bool, error) {
+		num1, err1 := strconv.ParseFloat(str1, 64)
+		num2, err2 := strconv.ParseFloat(str2, 64)
+		if err1 != nil || err2 != nil {
+			return false, fmt.Errorf("invalid input: both strings must be valid float64")
+		}
+		return num1 >= num2, nil
+	}
+
+	float64Greater := func(str1, str2 string) (bool, error) {
+		num1, err1 := strconv.ParseFloat(str1, 64)
+		num2, err2 := strconv.ParseFloat(str2, 64)
+		if err1 != nil || err2 != nil {
+			return false, fmt.Errorf("invalid input: both strings must be valid float64")
+		}
+		return num1 > num2, nil
+	}
+
+	matchDataFilter := func(m DataFilter) bool {
+		s, ok := keys[m.Key]
+		if !ok {
+			return false
+		}
+		s = strings.TrimSpace(s)
+		switch m.Op {
+		case "=":
+			return s == m.Value
+		case "!=":
+			return s != m.Value
+		case "<":
+			if v, err := intLess(s, m.Value); err == nil {
+				return v
+			}
+			if v, err := float64Less(s, m.Value); err == nil {
+				return v
+			}
+			return s < m.Value
+		case ">":
+			if v, err := intGreater(s, m.Value); err == nil {
+				return v
+			}
+			if v, err := float64Greater(s, m.Value); err == nil {
+				return v
+			}
+			return s > m.Value
+		case "<=":
+			if v, err := intLessOrEqual(s, m.Value); err == nil {
+				return v
+			}
+			if v, err := float64LessOrEqual(s, m.Value); err == nil {
+				return v
+			}
+			return s <= m.Value
+		case ">=":
+			if v, err := intGreaterOrEqual(s, m.Value); err == nil {
+				return v
+			}
+			if v, err := float64GreaterOrEqual(s, m.Value); err == nil {
+				return v
+			}
+			return s >= m.Value
+		default:
+			return false
+		}
+	}
+
+	for _, m := range df {
+		if !matchDataFilter(m) {
+			return false
+		}
+	}
+	return true
+}
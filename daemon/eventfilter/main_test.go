@@ -0,0 +1,73 @@
+package eventfilter
+
+import (
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/event"
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+)
+
+func TestDataFilters(t *testing.T) {
+	msg := msgbus.InstanceStatusUpdated{Value: instance.Status{Overall: status.Down, Avail: status.StandbyDown}}
+	ev := event.ToEvent(&msg, 1)
+	v := make(map[string]any)
+	if err := json.Unmarshal(ev.Data, &v); err != nil {
+		return
+	}
+
+	matched := map[string]DataFilters{
+		"match uniq value": {{Key: "instance_status.overall", Op: "=", Value: string("\"down\"")}},
+		"matched both values": DataFilters{
+			{Key: "instance_status.overall", Op: "=", Value: string("\"down\"")},
+			{Key: "instance_status.avail", Op: "=", Value: string("\"stdby down\"")},
+		},
+	}
+
+	notMatched := map[string]DataFilters{
+		"unmatched the value": {{Key: "instance_status.overall", Op: "=", Value: string("\"up\"")}},
+		"unmatched second value": DataFilters{
+			{Key: "instance_status.overall", Op: "=", Value: string("\"down\"")},
+			{Key: "instance_status.avail", Op: "=", Value: string("\"stdby up\"")},
+		},
+		"unmatched first value": DataFilters{
+			{Key: "instance_status.overall", Op: "=", Value: string("\"up\"")},
+			{Key: "instance_status.avail", Op: "=", Value: string("\"stdby down\"")},
+		},
+		"unmatched on both values": DataFilters{
+			{Key: "instance_status.overall", Op: "=", Value: string("\"up\"")},
+			{Key: "instance_status.avail", Op: "=", Value: string("\"up\"")},
+		},
+	}
+
+	for s, c := range matched {
+		t.Run(s, func(t *testing.T) {
+			require.Truef(t, c.Match(v), "'%s' should match '%s'", v, c)
+		})
+	}
+
+	for s, c := range notMatched {
+		t.Run(s, func(t *testing.T) {
+			require.Falsef(t, c.Match(v), "'%s' shouldn't match '%s'", v, c)
+		})
+	}
+}
+
+func TestOrchestrationFailureFilter(t *testing.T) {
+	filters, err := ParseList([]string{"ObjectOrchestrationEnd,path=ns1/svc/web1,.failed=true"})
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	decode := func(msg *msgbus.ObjectOrchestrationEnd) map[string]any {
+		v := make(map[string]any)
+		require.NoError(t, json.Unmarshal(event.ToEvent(msg, 1).Data, &v))
+		return v
+	}
+	failed := decode(&msgbus.ObjectOrchestrationEnd{Failed: true, FailedNodes: []string{"node1: start failed"}})
+	succeeded := decode(&msgbus.ObjectOrchestrationEnd{})
+	require.True(t, filters[0].DataFilters.Match(failed))
+	require.False(t, filters[0].DataFilters.Match(succeeded))
+}
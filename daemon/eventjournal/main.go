@@ -0,0 +1,242 @@
+// Package eventjournal is the durable journal of the daemon events.
+//
+// The journal worker appends the events published on the local bus as
+// json lines to the <var>/journal/events.log file, rotated when exceeding
+// MaxSize megabytes. MaxBackups rotated files are kept, so the journal is
+// a ring of the most recent events. The high frequency event kinds listed
+// in ExcludedKinds are not journaled.
+//
+// The events are written by a writer goroutine, fed by a QueueSize
+// bounded queue, so a slow disk never blocks the bus subscription. The
+// events are dropped and counted when the queue is full.
+//
+// The journal records are identified by the bus publication sequence
+// number, which the daemon restores from the journal at startup, so the
+// ids are monotonic across daemon restarts. The event stream api handler
+// uses the journal to replay the events missed by a client reconnecting
+// with a Last-Event-ID header, or published since a date.
+package eventjournal
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/opensvc/om3/v3/core/event"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+type (
+	T struct {
+		ctx    context.Context
+		cancel context.CancelFunc
+		log    *plog.Logger
+		store  *Store
+
+		sub   *pubsub.Subscription
+		subQS pubsub.QueueSizer
+
+		// q is the queue of the records to write, or to skip.
+		q chan item
+
+		// dropped is the number of events dropped because the queue was
+		// full.
+		dropped atomic.Uint64
+
+		wg sync.WaitGroup
+	}
+
+	item struct {
+		record Record
+		skip   bool
+	}
+)
+
+var (
+	// QueueSize is the maximum number of events waiting to be written.
+	QueueSize = 10000
+
+	// dropWarnInterval is the minimum delay between two warnings about
+	// the dropped events.
+	dropWarnInterval = time.Minute
+
+	// ExcludedKinds are the high frequency event kinds not journaled.
+	ExcludedKinds = map[string]any{
+		"DaemonDataUpdated":      nil,
+		"DaemonHeartbeatUpdated": nil,
+		"HeartbeatAlive":         nil,
+		"Log":                    nil,
+		"NodeDataUpdated":        nil,
+		"NodeStatsUpdated":       nil,
+		"NodeStatusGenUpdates":   nil,
+		"WatchDog":               nil,
+	}
+
+	droppedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "opensvc",
+			Subsystem: "eventjournal",
+			Name:      "dropped_total",
+			Help:      "The number of events not journaled because the journal queue was full.",
+		})
+)
+
+func New(subQS pubsub.QueueSizer) *T {
+	return &T{
+		store: Default(),
+		subQS: subQS,
+		q:     make(chan item, QueueSize),
+	}
+}
+
+// Dropped returns the number of events not journaled because the queue
+// was full.
+func (t *T) Dropped() uint64 {
+	return t.dropped.Load()
+}
+
+// Start launches the journal worker goroutine
+func (t *T) Start(parent context.Context) error {
+	t.log = plog.NewDefaultLogger().WithPrefix("daemon: eventjournal: ").Attr("pkg", "daemon/eventjournal")
+	t.log.Tracef("starting")
+	defer t.log.Tracef("started")
+	t.ctx, t.cancel = context.WithCancel(parent)
+
+	t.startSubscriptions()
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		t.writer()
+	}()
+	running := make(chan bool)
+	t.wg.Add(1)
+	go func() {
+		t.log.Tracef("start")
+		running <- true
+		defer t.log.Tracef("done")
+		defer t.wg.Done()
+		// let the writer flush the queued records and return
+		defer close(t.q)
+		defer func() {
+			if err := t.sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+				t.log.Errorf("subscription stop error %s", err)
+			}
+		}()
+		t.worker()
+	}()
+	<-running
+	return nil
+}
+
+func (t *T) Stop() error {
+	t.cancel()
+	t.wg.Wait()
+	if err := t.store.Close(); err != nil {
+		t.log.Warnf("close: %s", err)
+	}
+	return nil
+}
+
+func (t *T) startSubscriptions() {
+	// no filter: the journal records all the published events, except
+	// the ExcludedKinds. The subscription has no timeout, as a dropped
+	// subscription would stop the journal. The worker only queues the
+	// records without blocking, so it never falls behind.
+	sub := pubsub.SubFromContext(t.ctx, "daemon.eventjournal", t.subQS, pubsub.WithSeq(true))
+	sub.Start()
+	t.sub = sub
+}
+
+func (t *T) worker() {
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case i := <-t.sub.C:
+			o, ok := i.(*pubsub.Sequenced)
+			if !ok {
+				continue
+			}
+			switch c := o.Data.(type) {
+			case *msgbus.AuditStart:
+				t.log.HandleAuditStart(c.Q, c.Subsystems, "eventjournal")
+			case *msgbus.AuditStop:
+				t.log.HandleAuditStop(c.Q, c.Subsystems, "eventjournal")
+			}
+			t.queue(o.Data, o.Seq)
+		}
+	}
+}
+
+// queue pushes the record of the event i, published with the seq bus
+// sequence number, to the writer queue. The event is dropped if the queue
+// is full.
+func (t *T) queue(i any, seq uint64) {
+	kinder, ok := i.(event.Kinder)
+	if !ok {
+		return
+	}
+	var it item
+	if _, ok := ExcludedKinds[kinder.Kind()]; ok {
+		it = item{record: Record{ID: seq, Kind: kinder.Kind()}, skip: true}
+	} else if r := newRecord(i, seq); r != nil {
+		it = item{record: *r}
+	} else {
+		return
+	}
+	select {
+	case t.q <- it:
+	default:
+		t.dropped.Add(1)
+		droppedTotal.Inc()
+	}
+}
+
+// writer appends the queued records to the store, until the queue is
+// closed.
+func (t *T) writer() {
+	var (
+		dropped  uint64
+		warnedAt time.Time
+	)
+	for it := range t.q {
+		if n := t.dropped.Load(); n > dropped && time.Since(warnedAt) > dropWarnInterval {
+			t.log.Warnf("queue is full => %d events dropped", n-dropped)
+			dropped, warnedAt = n, time.Now()
+		}
+		if it.skip {
+			t.store.Skip(it.record.ID)
+			continue
+		}
+		if err := t.store.Append(it.record); err != nil {
+			t.log.Warnf("append %s event %d: %s", it.record.Kind, it.record.ID, err)
+		}
+	}
+}
+
+func newRecord(i any, id uint64) *Record {
+	ev := event.ToEvent(i, id)
+	if ev == nil {
+		return nil
+	}
+	r := Record{
+		ID:   ev.ID,
+		At:   ev.At,
+		Kind: ev.Kind,
+		Data: ev.Data,
+	}
+	if r.At.IsZero() {
+		r.At = time.Now()
+	}
+	if m, ok := i.(pubsub.Messager); ok {
+		r.Labels = m.GetLabels()
+	}
+	return &r
+}
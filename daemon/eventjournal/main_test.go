@@ -0,0 +1,72 @@
+package eventjournal
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+func TestJournal(t *testing.T) {
+	bus := pubsub.NewBus(t.Name())
+	bus.SetPanicOnFullQueue(time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = pubsub.ContextWithBus(ctx, bus)
+	bus.Start(ctx)
+	defer bus.Stop()
+
+	store := NewStore(t.TempDir())
+	journal := &T{
+		store: store,
+		subQS: pubsub.WithQueueSize(100),
+		q:     make(chan item, QueueSize),
+	}
+	require.NoError(t, journal.Start(ctx))
+
+	pub := pubsub.PubFromContext(ctx)
+	path := naming.Path{Namespace: "ns1", Kind: naming.KindSvc, Name: "web1"}
+	pub.Pub(&msgbus.ObjectCreated{Path: path, Node: "node1"}, pubsub.Label{"path", path.String()})
+	pub.Pub(&msgbus.NodeStatsUpdated{Node: "node1"}, pubsub.Label{"node", "node1"})
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, time.Second)
+	defer waitCancel()
+	require.NoError(t, store.Wait(waitCtx, bus.Seq()), "expected the excluded event to be skipped, not waited for")
+	require.NoError(t, journal.Stop())
+
+	records := make([]Record, 0)
+	require.NoError(t, store.Read(0, time.Time{}, func(r Record) bool {
+		records = append(records, r)
+		return true
+	}))
+	require.Len(t, records, 1, "expected the excluded event kinds not journaled")
+	require.Equal(t, "ObjectCreated", records[0].Kind)
+	require.Equal(t, path.String(), records[0].Labels["path"])
+}
+
+func TestJournalQueueFull(t *testing.T) {
+	journal := &T{
+		log:   plog.NewDefaultLogger(),
+		store: NewStore(t.TempDir()),
+		q:     make(chan item, 1),
+	}
+	journal.queue(&msgbus.ObjectCreated{Node: "node1"}, 1)
+	journal.queue(&msgbus.ObjectCreated{Node: "node2"}, 2)
+	journal.queue(&msgbus.NodeStatsUpdated{Node: "node1"}, 3)
+	require.Equal(t, uint64(2), journal.Dropped(), "expected the events not fitting in the queue dropped")
+
+	close(journal.q)
+	journal.writer()
+	b, err := os.ReadFile(filepath.Join(journal.store.dir, filename))
+	require.NoError(t, err)
+	require.Equal(t, 1, bytes.Count(b, []byte("\n")))
+}
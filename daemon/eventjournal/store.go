@@ -0,0 +1,260 @@
+package eventjournal
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/opensvc/om3/v3/core/event"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+)
+
+type (
+	// Record is a journaled event publication.
+	Record struct {
+		ID     uint64            `json:"id"`
+		At     time.Time         `json:"at"`
+		Kind   string            `json:"kind"`
+		Labels map[string]string `json:"labels,omitempty"`
+		Data   json.RawMessage   `json:"data"`
+	}
+
+	// Store is a rotating event journal store.
+	Store struct {
+		sync.Mutex
+		dir string
+		w   *lumberjack.Logger
+
+		// lastID is the id of the last appended record.
+		lastID uint64
+
+		// skippedID is the id of the last event not journaled, because
+		// its kind is excluded.
+		skippedID uint64
+
+		// loaded is true when lastID has been loaded from the journal
+		// files.
+		loaded bool
+	}
+)
+
+const (
+	filename = "events.log"
+)
+
+var (
+	// MaxSize is the size in megabytes of the journal file triggering its
+	// rotation.
+	MaxSize = 20
+
+	// MaxBackups is the number of rotated journal files to keep. The
+	// oldest events are dropped with the oldest rotated file.
+	MaxBackups = 5
+
+	// waitInterval is the delay between two checks of the last appended
+	// record id by Wait.
+	waitInterval = 10 * time.Millisecond
+
+	defaultStore     *Store
+	defaultStoreOnce sync.Once
+)
+
+// Default returns the journal store of the <var>/journal directory.
+func Default() *Store {
+	defaultStoreOnce.Do(func() {
+		defaultStore = NewStore(filepath.Join(rawconfig.Paths.Var, "journal"))
+	})
+	return defaultStore
+}
+
+// NewStore returns a Store of the journal files in the dir directory.
+func NewStore(dir string) *Store {
+	return &Store{
+		dir: dir,
+		w: &lumberjack.Logger{
+			Filename:   filepath.Join(dir, filename),
+			MaxSize:    MaxSize,
+			MaxBackups: MaxBackups,
+		},
+	}
+}
+
+// Event returns the daemon event of the record.
+func (r Record) Event() *event.Event {
+	return &event.Event{
+		Kind: r.Kind,
+		ID:   r.ID,
+		At:   r.At,
+		Data: r.Data,
+	}
+}
+
+// Append writes the record r to the store.
+func (t *Store) Append(r Record) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	t.Lock()
+	defer t.Unlock()
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return err
+	}
+	if _, err := t.w.Write(b); err != nil {
+		return err
+	}
+	t.lastID = max(t.lastID, r.ID)
+	t.loaded = true
+	return nil
+}
+
+// Skip records the id of an event not journaled, so Wait does not wait
+// for its record.
+func (t *Store) Skip(id uint64) {
+	t.Lock()
+	defer t.Unlock()
+	t.skippedID = max(t.skippedID, id)
+}
+
+// LastID returns the id of the most recent journaled record.
+func (t *Store) LastID() (uint64, error) {
+	t.Lock()
+	defer t.Unlock()
+	if t.loaded {
+		return t.lastID, nil
+	}
+	files, err := t.files()
+	if err != nil {
+		return 0, err
+	}
+	// the most recent record is in the current file, or in the most
+	// recent rotated file if the current file is empty.
+	for i := len(files) - 1; i >= 0; i-- {
+		var found bool
+		err := readFile(files[i], func(r Record) bool {
+			t.lastID = max(t.lastID, r.ID)
+			found = true
+			return true
+		})
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		if found {
+			break
+		}
+	}
+	t.loaded = true
+	return t.lastID, nil
+}
+
+// Wait returns when the record with the id, or a more recent one, is
+// journaled or skipped, or when ctx is done.
+func (t *Store) Wait(ctx context.Context, id uint64) error {
+	ticker := time.NewTicker(waitInterval)
+	defer ticker.Stop()
+	for {
+		if lastID, err := t.LastID(); err != nil {
+			return err
+		} else if max(lastID, t.lastSkippedID()) >= id {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *Store) lastSkippedID() uint64 {
+	t.Lock()
+	defer t.Unlock()
+	return t.skippedID
+}
+
+// Read calls fn with the records having an id greater than afterID and
+// published after since, oldest first, until fn returns false.
+func (t *Store) Read(afterID uint64, since time.Time, fn func(Record) bool) error {
+	t.Lock()
+	files, err := t.files()
+	t.Unlock()
+	if err != nil {
+		return err
+	}
+	for _, p := range files {
+		var stopped bool
+		err := readFile(p, func(r Record) bool {
+			if r.ID <= afterID || r.At.Before(since) {
+				return true
+			}
+			if !fn(r) {
+				stopped = true
+				return false
+			}
+			return true
+		})
+		if errors.Is(err, os.ErrNotExist) {
+			// rotated since listed
+			continue
+		} else if err != nil {
+			return err
+		}
+		if stopped {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Close closes the current journal file.
+func (t *Store) Close() error {
+	t.Lock()
+	defer t.Unlock()
+	return t.w.Close()
+}
+
+// files returns the rotated journal files, oldest first, followed by the
+// current journal file.
+func (t *Store) files() ([]string, error) {
+	ext := filepath.Ext(filename)
+	prefix := filename[:len(filename)-len(ext)]
+	l, err := filepath.Glob(filepath.Join(t.dir, prefix+"-*"+ext))
+	if err != nil {
+		return nil, err
+	}
+	// the rotated files are suffixed by their rotation timestamp
+	sort.Strings(l)
+	return append(l, filepath.Join(t.dir, filename)), nil
+}
+
+func readFile(p string, fn func(Record) bool) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var r Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			// skip truncated records
+			continue
+		}
+		if !fn(r) {
+			return nil
+		}
+	}
+	return scanner.Err()
+}
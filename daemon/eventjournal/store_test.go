@@ -0,0 +1,70 @@
+package eventjournal
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(dir)
+	defer func() { _ = store.Close() }()
+
+	lastID, err := store.LastID()
+	require.NoError(t, err)
+	require.Zero(t, lastID)
+
+	now := time.Now()
+	for i := uint64(1); i <= 5; i++ {
+		require.NoError(t, store.Append(Record{
+			ID:     i * 10,
+			At:     now.Add(time.Duration(i) * time.Minute),
+			Kind:   "ObjectStatusUpdated",
+			Labels: map[string]string{"path": "ns1/svc/web1"},
+			Data:   json.RawMessage(`{}`),
+		}))
+	}
+
+	readIDs := func(afterID uint64, since time.Time, n int) []uint64 {
+		l := make([]uint64, 0)
+		require.NoError(t, store.Read(afterID, since, func(r Record) bool {
+			l = append(l, r.ID)
+			return n == 0 || len(l) < n
+		}))
+		return l
+	}
+
+	t.Run("read all", func(t *testing.T) {
+		require.Equal(t, []uint64{10, 20, 30, 40, 50}, readIDs(0, time.Time{}, 0))
+	})
+
+	t.Run("read after last event id", func(t *testing.T) {
+		require.Equal(t, []uint64{40, 50}, readIDs(30, time.Time{}, 0))
+	})
+
+	t.Run("read since", func(t *testing.T) {
+		require.Equal(t, []uint64{20, 30, 40, 50}, readIDs(0, now.Add(2*time.Minute), 0))
+	})
+
+	t.Run("read stops when fn returns false", func(t *testing.T) {
+		require.Equal(t, []uint64{10, 20}, readIDs(0, time.Time{}, 2))
+	})
+
+	t.Run("last id is loaded from the journal files", func(t *testing.T) {
+		reloaded := NewStore(dir)
+		lastID, err := reloaded.LastID()
+		require.NoError(t, err)
+		require.Equal(t, uint64(50), lastID)
+	})
+
+	t.Run("wait", func(t *testing.T) {
+		require.NoError(t, store.Wait(context.Background(), 50))
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, store.Wait(ctx, 51), context.DeadlineExceeded)
+	})
+}
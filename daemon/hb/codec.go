@@ -0,0 +1,68 @@
+package hb
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/omcrypto"
+)
+
+type (
+	// codecNegotiator selects the compression codec of the sent messages
+	// from the codecs advertised by the peer nodes in their messages.
+	codecNegotiator struct {
+		sync.RWMutex
+		peers map[string]peerCodecs
+	}
+
+	peerCodecs struct {
+		codecs []string
+		seenAt time.Time
+	}
+)
+
+var (
+	// codecPeerTTL is the delay after which the codecs advertised by a
+	// silent peer are ignored by the negotiation.
+	codecPeerTTL = 10 * time.Minute
+)
+
+func newCodecNegotiator() *codecNegotiator {
+	return &codecNegotiator{
+		peers: make(map[string]peerCodecs),
+	}
+}
+
+// set records the codecs advertised by the nodename peer in a message
+// received at tm.
+func (t *codecNegotiator) set(nodename string, codecs []string, tm time.Time) {
+	t.Lock()
+	defer t.Unlock()
+	t.peers[nodename] = peerCodecs{codecs: codecs, seenAt: tm}
+}
+
+// codec returns the zstd codec if all the peers heard from since
+// codecPeerTTL can decode it, else the zlib codec supported by all node
+// versions.
+//
+// A peer starting with a version not supporting zstd can't decode the
+// messages until its first message is received.
+func (t *codecNegotiator) codec(now time.Time) string {
+	t.RLock()
+	defer t.RUnlock()
+	var count int
+	for _, peer := range t.peers {
+		if now.Sub(peer.seenAt) > codecPeerTTL {
+			continue
+		}
+		if !slices.Contains(peer.codecs, omcrypto.CodecZstd) {
+			return omcrypto.CodecZlib
+		}
+		count++
+	}
+	if count == 0 {
+		return omcrypto.CodecZlib
+	}
+	return omcrypto.CodecZstd
+}
@@ -0,0 +1,270 @@
+package hbctrl
+
+import (
+	"math"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
+)
+
+type (
+	// beat is a peer success notification sent to a peer watcher.
+	beat struct {
+		success    bool
+		size       int
+		sentAt     time.Time
+		receivedAt time.Time
+	}
+
+	// linkConfig holds the link settings of a registered heartbeat.
+	linkConfig struct {
+		// interval is the expected delay between two peer messages. The
+		// loss ratio is not computed if zero.
+		interval time.Duration
+
+		// maxTimeout is the upper bound of the adaptive timeout. The
+		// adaptive timeout is disabled if zero.
+		maxTimeout time.Duration
+	}
+
+	// linkStats computes the link quality metrics of a heartbeat peer from
+	// the beats received by the peer watcher.
+	//
+	// The latency, jitter and inter-arrival gap are exponentially weighted
+	// moving averages, so the metrics reflect the recent link quality.
+	linkStats struct {
+		linkConfig
+
+		// timeout is the configured timeout, which is also the lower bound
+		// of the adaptive timeout.
+		timeout time.Duration
+
+		lastBeatAt  time.Time
+		lastSentAt  time.Time
+		lastTransit float64
+
+		received uint64
+		bytes    uint64
+
+		// recentReceived and recentLost are the decayed received and lost
+		// message counters used to compute the recent loss ratio.
+		recentReceived float64
+		recentLost     float64
+
+		// exportedReceived and exportedBytes are the received and bytes
+		// values at the last prometheus export, used to increment the
+		// prometheus counters.
+		exportedReceived uint64
+		exportedBytes    uint64
+
+		latency    float64
+		jitter     float64
+		gap        float64
+		gapDev     float64
+		msgSize    float64
+		msgSizeMax int
+	}
+)
+
+const (
+	// ewmaGain is the gain of the latency, gap and message size moving
+	// averages.
+	ewmaGain = 1.0 / 8
+
+	// devGain is the gain of the inter-arrival gap deviation moving average.
+	devGain = 1.0 / 4
+
+	// jitterGain is the gain of the jitter moving average (RFC 3550).
+	jitterGain = 1.0 / 16
+
+	// lossDecay is the decay factor applied on the recent loss counters on
+	// each beat.
+	lossDecay = 63.0 / 64
+)
+
+var (
+	metricLabels = []string{"hb", "peer"}
+
+	linkLatency = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "opensvc",
+			Subsystem: "hb",
+			Name:      "peer_latency_seconds",
+			Help:      "The moving average of the delay between a heartbeat message creation and its reception",
+		}, metricLabels)
+
+	linkJitter = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "opensvc",
+			Subsystem: "hb",
+			Name:      "peer_jitter_seconds",
+			Help:      "The moving average of the heartbeat message transit time variations",
+		}, metricLabels)
+
+	linkLossRatio = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "opensvc",
+			Subsystem: "hb",
+			Name:      "peer_loss_ratio",
+			Help:      "The recent ratio of expected heartbeat messages not received",
+		}, metricLabels)
+
+	linkMsgSize = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "opensvc",
+			Subsystem: "hb",
+			Name:      "peer_message_size_bytes",
+			Help:      "The moving average of the heartbeat message size",
+		}, metricLabels)
+
+	linkTimeout = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "opensvc",
+			Subsystem: "hb",
+			Name:      "peer_timeout_seconds",
+			Help:      "The heartbeat timeout, adapted to the link quality if adaptive_timeout is set",
+		}, metricLabels)
+
+	linkMessages = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "opensvc",
+			Subsystem: "hb",
+			Name:      "peer_messages_total",
+			Help:      "The total number of heartbeat messages",
+		}, metricLabels)
+
+	linkBytes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "opensvc",
+			Subsystem: "hb",
+			Name:      "peer_bytes_total",
+			Help:      "The total size of the heartbeat messages",
+		}, metricLabels)
+)
+
+func newLinkStats(cfg linkConfig, timeout time.Duration) *linkStats {
+	return &linkStats{
+		linkConfig: cfg,
+		timeout:    timeout,
+	}
+}
+
+// onBeat accounts a successful beat in the link metrics.
+func (t *linkStats) onBeat(b beat) {
+	t.received++
+	t.bytes += uint64(b.size)
+	t.recentReceived = t.recentReceived*lossDecay + 1
+	t.recentLost *= lossDecay
+
+	if b.size > 0 {
+		t.msgSize = ewma(t.msgSize, float64(b.size), ewmaGain, t.received == 1)
+		t.msgSizeMax = max(t.msgSizeMax, b.size)
+	}
+
+	if !t.lastBeatAt.IsZero() {
+		gap := b.receivedAt.Sub(t.lastBeatAt)
+		if t.interval > 0 && gap > t.interval*3/2 {
+			t.recentLost += float64(gap/t.interval - 1)
+		}
+		g := gap.Seconds()
+		switch {
+		case gap > t.currentTimeout():
+			// The gaps longer than the timeout are outages, already
+			// reported as stale events. They must not inflate the
+			// adaptive timeout.
+		case t.gap == 0:
+			t.gap = g
+			t.gapDev = g / 2
+		default:
+			t.gapDev = ewma(t.gapDev, math.Abs(g-t.gap), devGain, false)
+			t.gap = ewma(t.gap, g, ewmaGain, false)
+		}
+	}
+	t.lastBeatAt = b.receivedAt
+
+	// Only the first reception of a message gives a transit time sample,
+	// the drivers can resend the same message on each interval.
+	if b.sentAt.IsZero() || b.sentAt.Equal(t.lastSentAt) {
+		return
+	}
+	transit := b.receivedAt.Sub(b.sentAt).Seconds()
+	if !t.lastSentAt.IsZero() {
+		t.jitter = ewma(t.jitter, math.Abs(transit-t.lastTransit), jitterGain, false)
+	}
+	t.latency = ewma(t.latency, transit, ewmaGain, t.lastSentAt.IsZero())
+	t.lastSentAt = b.sentAt
+	t.lastTransit = transit
+}
+
+// currentTimeout returns the delay without beat after which the peer is
+// stale.
+//
+// When the adaptive timeout is enabled, the timeout is the moving average of
+// the inter-arrival gap plus 4 times its deviation, bounded by the configured
+// timeout and maxTimeout.
+func (t *linkStats) currentTimeout() time.Duration {
+	if t.maxTimeout <= 0 || t.gap == 0 {
+		return t.timeout
+	}
+	d := time.Duration((t.gap + 4*t.gapDev) * float64(time.Second))
+	return min(max(d, t.timeout), t.maxTimeout)
+}
+
+func (t *linkStats) lossRatio() float64 {
+	if total := t.recentReceived + t.recentLost; total > 0 {
+		return t.recentLost / total
+	}
+	return 0
+}
+
+func (t *linkStats) metrics() daemonsubsystem.HeartbeatStreamPeerMetrics {
+	return daemonsubsystem.HeartbeatStreamPeerMetrics{
+		Latency:    seconds(t.latency),
+		Jitter:     seconds(t.jitter),
+		LossRatio:  t.lossRatio(),
+		MsgSize:    int(t.msgSize),
+		MsgSizeMax: t.msgSizeMax,
+		Received:   t.received,
+		Timeout:    t.currentTimeout(),
+	}
+}
+
+// export sets the prometheus metrics of the hbID peer nodename.
+func (t *linkStats) export(hbID, nodename string) {
+	labels := prometheus.Labels{"hb": hbID, "peer": nodename}
+	linkLatency.With(labels).Set(t.latency)
+	linkJitter.With(labels).Set(t.jitter)
+	linkLossRatio.With(labels).Set(t.lossRatio())
+	linkMsgSize.With(labels).Set(t.msgSize)
+	linkTimeout.With(labels).Set(t.currentTimeout().Seconds())
+	linkMessages.With(labels).Add(float64(t.received - t.exportedReceived))
+	linkBytes.With(labels).Add(float64(t.bytes - t.exportedBytes))
+	t.exportedReceived = t.received
+	t.exportedBytes = t.bytes
+}
+
+// unexport removes the prometheus metrics of the hbID peer nodename.
+func unexport(hbID, nodename string) {
+	labels := prometheus.Labels{"hb": hbID, "peer": nodename}
+	linkLatency.Delete(labels)
+	linkJitter.Delete(labels)
+	linkLossRatio.Delete(labels)
+	linkMsgSize.Delete(labels)
+	linkTimeout.Delete(labels)
+	linkMessages.Delete(labels)
+	linkBytes.Delete(labels)
+}
+
+func ewma(avg, sample, gain float64, first bool) float64 {
+	if first {
+		return sample
+	}
+	return avg + gain*(sample-avg)
+}
+
+func seconds(f float64) time.Duration {
+	return time.Duration(f * float64(time.Second))
+}
@@ -0,0 +1,96 @@
+package hbctrl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkStatsLossRatio(t *testing.T) {
+	stats := newLinkStats(linkConfig{interval: time.Second}, 15*time.Second)
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		stats.onBeat(beat{success: true, receivedAt: now})
+		now = now.Add(time.Second)
+	}
+	require.Equal(t, 0.0, stats.lossRatio(), "expected no loss on regular beats")
+
+	// 3 beats lost
+	now = now.Add(3 * time.Second)
+	stats.onBeat(beat{success: true, receivedAt: now})
+	require.InDelta(t, 3.0/14, stats.lossRatio(), 0.02)
+	require.Equal(t, uint64(11), stats.metrics().Received)
+}
+
+func TestLinkStatsAdaptiveTimeout(t *testing.T) {
+	timeout := 3 * time.Second
+	cases := map[string]struct {
+		maxTimeout time.Duration
+		gap        time.Duration
+		expected   time.Duration
+	}{
+		"disabled": {
+			gap:      5 * time.Second,
+			expected: timeout,
+		},
+		"lower bound": {
+			maxTimeout: 3 * timeout,
+			gap:        100 * time.Millisecond,
+			expected:   timeout,
+		},
+		"upper bound": {
+			maxTimeout: 3 * timeout,
+			gap:        30 * time.Second,
+			expected:   3 * timeout,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stats := newLinkStats(linkConfig{maxTimeout: tc.maxTimeout}, timeout)
+			stats.gap = tc.gap.Seconds()
+			require.Equal(t, tc.expected, stats.currentTimeout())
+		})
+	}
+
+	t.Run("follows the gap deviation", func(t *testing.T) {
+		stats := newLinkStats(linkConfig{interval: time.Second, maxTimeout: 10 * time.Second}, timeout)
+		now := time.Now()
+		for i := 0; i < 50; i++ {
+			stats.onBeat(beat{success: true, receivedAt: now})
+			now = now.Add(time.Second)
+		}
+		stable := stats.currentTimeout()
+		require.Equal(t, timeout, stable, "expected the configured timeout on a stable link")
+		for i := 0; i < 50; i++ {
+			stats.onBeat(beat{success: true, receivedAt: now})
+			if i%2 == 0 {
+				now = now.Add(200 * time.Millisecond)
+			} else {
+				now = now.Add(2800 * time.Millisecond)
+			}
+		}
+		require.Greater(t, stats.currentTimeout(), stable, "expected a raised timeout on an irregular link")
+		require.LessOrEqual(t, stats.currentTimeout(), 10*time.Second)
+	})
+}
+
+func TestLinkStatsLatency(t *testing.T) {
+	stats := newLinkStats(linkConfig{}, 15*time.Second)
+	sentAt := time.Now()
+	stats.onBeat(beat{success: true, size: 100, sentAt: sentAt, receivedAt: sentAt.Add(10 * time.Millisecond)})
+	require.Equal(t, 10*time.Millisecond, stats.metrics().Latency)
+	require.Equal(t, time.Duration(0), stats.metrics().Jitter)
+
+	// A resent message gives no transit time sample.
+	stats.onBeat(beat{success: true, size: 100, sentAt: sentAt, receivedAt: sentAt.Add(time.Second)})
+	require.Equal(t, 10*time.Millisecond, stats.metrics().Latency)
+
+	sentAt = sentAt.Add(time.Second)
+	stats.onBeat(beat{success: true, size: 300, sentAt: sentAt, receivedAt: sentAt.Add(26 * time.Millisecond)})
+	metrics := stats.metrics()
+	require.Equal(t, 12*time.Millisecond, metrics.Latency)
+	require.Equal(t, time.Millisecond, metrics.Jitter)
+	require.Equal(t, 300, metrics.MsgSizeMax)
+	require.Equal(t, 125, metrics.MsgSize)
+}
@@ -59,7 +59,7 @@ type (
 		txBeating   int
 		rxBeating   int
 		cancel      map[string]func()      // cancel function of hbID peer watcher for the remote
-		beatingChan map[string]chan<- bool // beating bool chan of hbID for the remote
+		beatingChan map[string]chan<- beat // beat chan of hbID for the remote
 	}
 
 	// CmdRegister is the command to register a new heartbeat status
@@ -67,6 +67,13 @@ type (
 		ID string // the new hb id (example: hb#1.tx)
 		// Type is the hb type
 		Type string
+
+		// Interval is the hb interval, used to compute the peer loss ratio
+		Interval time.Duration
+
+		// MaxTimeout is the upper bound of the peer adaptive timeout. The
+		// adaptive timeout is disabled when zero.
+		MaxTimeout time.Duration
 	}
 
 	// CmdUnregister is the command to unregister a heartbeat status
@@ -101,11 +108,26 @@ type (
 		Alert []daemonsubsystem.Alert
 	}
 
+	// CmdSetMode is a command to set the compression codec and the mode of
+	// the messages sent by a hb tx
+	CmdSetMode struct {
+		HbID  string
+		Codec string
+		Mode  string
+	}
+
 	// CmdSetPeerSuccess is a command to set a hb peer success value for a node
 	CmdSetPeerSuccess struct {
 		Nodename string
 		HbID     string
 		Success  bool
+
+		// Size is the optional size of the message sent or received
+		Size int
+
+		// SentAt is the optional creation time of the received message, used
+		// to compute the peer latency and jitter.
+		SentAt time.Time
 	}
 
 	// CmdSetPeerStatus is a command to set a hb peer HeartbeatPeerStatus for a node
@@ -193,6 +215,7 @@ func (c *C) run() {
 	events := make(EventStats)
 	remotes := make(map[string]RemoteBeating)
 	heartbeat := make(map[string]daemonsubsystem.HeartbeatStream)
+	links := make(map[string]linkConfig)
 	pub := pubsub.PubFromContext(c.ctx)
 	defer c.log.Infof("stopped: %v", events)
 	updateDaemonDataHeartbeatsTicker := time.NewTicker(time.Second)
@@ -245,6 +268,10 @@ func (c *C) run() {
 					Type:  o.Type,
 					Peers: make(map[string]daemonsubsystem.HeartbeatStreamPeerStatus),
 				}
+				links[o.ID] = linkConfig{
+					interval:   o.Interval,
+					maxTimeout: o.MaxTimeout,
+				}
 				changed = true
 			case CmdUnregister:
 				if hbStatus, ok := heartbeat[o.ID]; ok {
@@ -263,6 +290,7 @@ func (c *C) run() {
 					}
 					delete(heartbeat, o.ID)
 				}
+				delete(links, o.ID)
 				changed = true
 			case CmdSetState:
 				if hbToChange, ok := heartbeat[o.ID]; ok {
@@ -273,8 +301,14 @@ func (c *C) run() {
 				if remote, ok := remotes[o.Nodename]; ok {
 					k := o.HbID
 					if beatC, found := remote.beatingChan[k]; found {
+						b := beat{
+							success:    o.Success,
+							size:       o.Size,
+							sentAt:     o.SentAt,
+							receivedAt: time.Now(),
+						}
 						go func() {
-							beatC <- o.Success
+							beatC <- b
 						}()
 					}
 				}
@@ -325,6 +359,13 @@ func (c *C) run() {
 				} else {
 					o.result <- make(map[string]daemonsubsystem.HeartbeatStreamPeerStatus)
 				}
+			case CmdSetMode:
+				hbID := o.HbID
+				if foundHeartbeat, ok := heartbeat[hbID]; ok {
+					foundHeartbeat.Codec = o.Codec
+					foundHeartbeat.Mode = o.Mode
+					heartbeat[hbID] = foundHeartbeat
+				}
 			case CmdSetAlert:
 				hbID := o.HbID
 				if foundHeartbeat, ok := heartbeat[hbID]; ok {
@@ -343,7 +384,7 @@ func (c *C) run() {
 				peerNode := o.Nodename
 				remote, ok := remotes[peerNode]
 				if !ok {
-					remote.beatingChan = make(map[string]chan<- bool)
+					remote.beatingChan = make(map[string]chan<- beat)
 					remote.cancel = make(map[string]func())
 				}
 				if _, registered := remote.cancel[hbID]; registered {
@@ -360,7 +401,7 @@ func (c *C) run() {
 					continue
 				}
 				c.log.Infof("watcher starting %s -> %s", hbID, peerNode)
-				beatingC := make(chan bool)
+				beatingC := make(chan beat)
 				beatingCtx, cancel := context.WithCancel(o.Ctx)
 				remote.cancel[hbID] = cancel
 				remote.beatingChan[hbID] = beatingC
@@ -370,7 +411,7 @@ func (c *C) run() {
 					remote.txCount++
 				}
 				remotes[peerNode] = remote
-				c.peerWatch(beatingCtx, beatingC, o.HbID, peerNode, o.Desc, o.Timeout)
+				c.peerWatch(beatingCtx, beatingC, o.HbID, peerNode, o.Desc, o.Timeout, links[hbID])
 			case CmdDelWatcher:
 				hbID := o.HbID
 				peerNode := o.Nodename
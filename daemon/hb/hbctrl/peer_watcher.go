@@ -20,15 +20,26 @@ var (
 	// t2: evBeating => beating true
 	// if t1 + pubDelay > t2: read current beating (true) => no event
 	pubDelay = 200 * time.Millisecond
+
+	// metricsInterval is the interval between two peer link prometheus
+	// metrics updates. The peer status metrics are only refreshed with
+	// the peer status, so the metrics don't add heartbeat status updates.
+	metricsInterval = 5 * time.Second
 )
 
 // peerWatch starts a new peer watcher of nodename for hbID
 // when beating state change a hb_beating or hb_stale event is fired
 // Once beating, hb_stale event is fired if beating is not received after timeout
-func (c *C) peerWatch(ctx context.Context, beatingC chan bool, HbID, nodename, desc string, timeout time.Duration) {
+//
+// The watcher maintains the peer link metrics from the received beats. When
+// the adaptive timeout is enabled by cfg, the timeout is derived from the
+// link metrics.
+func (c *C) peerWatch(ctx context.Context, beatingC chan beat, HbID, nodename, desc string, timeout time.Duration, cfg linkConfig) {
+	stats := newLinkStats(cfg, timeout)
 	peer := daemonsubsystem.HeartbeatStreamPeerStatus{
 		Desc:      desc,
 		ChangedAt: time.Now(),
+		Metrics:   stats.metrics(),
 	}
 	started := make(chan bool)
 	go func() {
@@ -46,6 +57,11 @@ func (c *C) peerWatch(ctx context.Context, beatingC chan bool, HbID, nodename, d
 		setPeerStatusTicker := time.NewTicker(daemonenv.HeartbeatStatusRefreshMaximumInterval)
 		defer setPeerStatusTicker.Stop()
 
+		// metricsTicker is the interval ticker to export the link metrics.
+		metricsTicker := time.NewTicker(metricsInterval)
+		defer metricsTicker.Stop()
+		defer unexport(HbID, nodename)
+
 		// staleTicker is the ticker to watch beating==true not refreshed since timeout
 		// Reset when receive a beating true
 		// Stop when receive a beating false
@@ -69,23 +85,30 @@ func (c *C) peerWatch(ctx context.Context, beatingC chan bool, HbID, nodename, d
 			case <-c.ctx.Done():
 				log.Infof("done (from ctrl done)")
 				return
-			case beating := <-beatingC:
+			case b := <-beatingC:
+				beating := b.success
+				if beating {
+					stats.onBeat(b)
+				}
 				switch {
 				case beating && peer.IsBeating:
 					// continue beating (normal situation)
-					staleTicker.Reset(timeout)
+					staleTicker.Reset(stats.currentTimeout())
 					peer.LastBeatingAt = time.Now()
 				case beating && !peer.IsBeating:
 					// resume beating
 					setBeating(true)
-					staleTicker.Reset(timeout)
+					staleTicker.Reset(stats.currentTimeout())
 					peer.LastBeatingAt = time.Now()
 				case !beating && peer.IsBeating:
 					// stop beating
 					setBeating(false)
 					staleTicker.Stop()
 				}
+			case <-metricsTicker.C:
+				stats.export(HbID, nodename)
 			case <-setPeerStatusTicker.C:
+				peer.Metrics = stats.metrics()
 				c.cmd <- CmdSetPeerStatus{
 					Nodename:   nodename,
 					HbID:       HbID,
@@ -105,6 +128,7 @@ func (c *C) peerWatch(ctx context.Context, beatingC chan bool, HbID, nodename, d
 							Nodename: nodename,
 							HbID:     HbID,
 						}
+						peer.Metrics = stats.metrics()
 						c.cmd <- CmdSetPeerStatus{
 							Nodename:   nodename,
 							HbID:       HbID,
@@ -221,6 +221,7 @@ func (t *tx) send(b []byte) {
 			Nodename: node,
 			HbID:     t.id,
 			Success:  true,
+			Size:     len(b),
 		}
 	}
 }
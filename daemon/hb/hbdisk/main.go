@@ -51,6 +51,12 @@ const (
 	minimumSlot = 1
 )
 
+var (
+	// maxMsgSize is the size limit of a message written in a data slot,
+	// accounting the base64 encoding and the capsule overhead.
+	maxMsgSize = sign.SlotSize/4*3 - 1024
+)
+
 func New() hbcfg.Confer {
 	t := &T{}
 	var i interface{} = t
@@ -84,7 +90,9 @@ func (t *T) Configure(ctx context.Context) {
 	log.Tracef("timeout=%s interval=%s dev=%s nodes=%s onodes=%s max_slot=%d", timeout, interval, dev, nodes, oNodes, maxSlots)
 
 	t.SetNodes(oNodes)
+	t.SetInterval(interval)
 	t.SetTimeout(timeout)
+	t.SetMaxMsgSize(maxMsgSize)
 	signature := fmt.Sprintf("type: hb.disk, disk: %s nodes: %s timeout: %s interval: %s max_slot: %d",
 		dev, nodes, timeout, interval, maxSlots)
 	t.SetSignature(signature)
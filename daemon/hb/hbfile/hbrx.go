@@ -0,0 +1,243 @@
+package hbfile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/hbtype"
+	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/v3/daemon/hb/hbaudit"
+	"github.com/opensvc/om3/v3/daemon/hb/hbcrypto"
+	"github.com/opensvc/om3/v3/daemon/hb/hbctrl"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	// rx holds a hb file receiver
+	rx struct {
+		sync.WaitGroup
+		ctx      context.Context
+		id       string
+		nodes    []string
+		dir      string
+		timeout  time.Duration
+		interval time.Duration
+
+		// last is the modification time of the last capsule file read,
+		// indexed by nodename.
+		last map[string]time.Time
+
+		// stale is the set of nodes with a missing, unreadable or stale
+		// capsule file, with the reason as value.
+		stale map[string]string
+
+		name   string
+		log    *plog.Logger
+		cmdC   chan<- any
+		msgC   chan<- *hbtype.Msg
+		cancel func()
+
+		crypto decryptWithNoder
+	}
+
+	decryptWithNoder interface {
+		DecryptWithNode(data []byte) ([]byte, string, error)
+	}
+)
+
+// ID implements the ID function of the Receiver interface for rx
+func (t *rx) ID() string {
+	return t.id
+}
+
+// Stop implements the Stop function of the Receiver interface for rx
+func (t *rx) Stop() error {
+	t.log.Tracef("cancelling")
+	t.cancel()
+	for _, node := range t.nodes {
+		t.cmdC <- hbctrl.CmdDelWatcher{
+			HbID:     t.id,
+			Nodename: node,
+		}
+	}
+	t.Wait()
+	t.log.Tracef("wait done")
+	return nil
+}
+
+func (t *rx) streamPeerDesc(node string) string {
+	return fmt.Sprintf("← %s", capsuleFile(t.dir, node))
+}
+
+// Start implements the Start function of the Receiver interface for rx
+func (t *rx) Start(cmdC chan<- any, msgC chan<- *hbtype.Msg) error {
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.ctx = ctx
+	t.cancel = cancel
+
+	hbaudit.EnableAudit(ctx, t.id, t.log, "hb", strings.Replace(t.id, "hb#", "hb:", 1))
+
+	if err := checkDir(t.dir); err != nil {
+		t.log.Warnf("startup failed: %s", err)
+		cancel()
+		return err
+	}
+
+	t.cmdC = cmdC
+	t.msgC = msgC
+
+	for _, node := range t.nodes {
+		cmdC <- hbctrl.CmdAddWatcher{
+			HbID:     t.id,
+			Nodename: node,
+			Ctx:      ctx,
+			Timeout:  t.timeout,
+			Desc:     t.streamPeerDesc(node),
+		}
+	}
+
+	t.Add(1)
+	go func() {
+		defer t.Done()
+		t.log.Infof("started")
+		defer t.log.Infof("stopped")
+
+		crypto := hbcrypto.CryptoFromContext(ctx)
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.crypto = crypto.Load()
+				t.onTick()
+			case <-ctx.Done():
+				t.cancel()
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (t *rx) onTick() {
+	changed := false
+	for _, node := range t.nodes {
+		if t.setStale(node, t.recv(node)) {
+			changed = true
+		}
+	}
+	if changed {
+		t.sendAlert()
+	}
+}
+
+// recv reads the nodename capsule file and forwards its message if the file
+// changed since the last read. It returns the reason why the peer capsule is
+// unusable, or an empty string.
+func (t *rx) recv(nodename string) string {
+	p := capsuleFile(t.dir, nodename)
+	info, err := os.Stat(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return "no capsule file"
+	} else if err != nil {
+		return err.Error()
+	}
+	mtime := info.ModTime()
+	if elapsed := time.Since(mtime); elapsed > t.timeout {
+		t.log.Tracef("node %s capsule has not been updated for %s", nodename, elapsed)
+		return fmt.Sprintf("capsule file not modified since %s", t.timeout)
+	}
+	if last, ok := t.last[nodename]; ok && mtime.Equal(last) {
+		t.log.Tracef("node %s capsule unchanged since last read", nodename)
+		return t.stale[nodename]
+	}
+	c, err := readCapsule(t.dir, nodename)
+	if err != nil {
+		return fmt.Sprintf("read capsule: %s", err)
+	}
+	t.last[nodename] = mtime
+	b, msgNodename, err := t.crypto.DecryptWithNode(c.Msg)
+	if err != nil {
+		return fmt.Sprintf("decrypt: %s", err)
+	}
+	if nodename != msgNodename {
+		return fmt.Sprintf("capsule written by node %s", msgNodename)
+	}
+	msg := hbtype.Msg{}
+	if err := json.Unmarshal(b, &msg); err != nil {
+		return fmt.Sprintf("unmarshal msg: %s", err)
+	}
+	t.log.Tracef("node %s ok", nodename)
+	t.cmdC <- hbctrl.CmdSetPeerSuccess{
+		Nodename: msg.Nodename,
+		HbID:     t.id,
+		Success:  true,
+		Size:     len(c.Msg),
+		SentAt:   msg.UpdatedAt,
+	}
+	t.msgC <- &msg
+	return ""
+}
+
+// setStale updates the stale reason of nodename, and returns true if it
+// changed.
+func (t *rx) setStale(nodename, reason string) bool {
+	if t.stale[nodename] == reason {
+		return false
+	}
+	if reason == "" {
+		t.log.Infof("node %s capsule is now valid", nodename)
+		delete(t.stale, nodename)
+	} else {
+		t.log.Infof("node %s capsule is not valid: %s", nodename, reason)
+		t.stale[nodename] = reason
+	}
+	return true
+}
+
+func (t *rx) sendAlert() {
+	nodes := make([]string, 0, len(t.stale))
+	for nodename := range t.stale {
+		nodes = append(nodes, nodename)
+	}
+	sort.Strings(nodes)
+	alerts := make([]daemonsubsystem.Alert, 0, len(nodes))
+	for _, nodename := range nodes {
+		alerts = append(alerts, daemonsubsystem.Alert{
+			Severity: "warning",
+			Message:  fmt.Sprintf("node %s: %s", nodename, t.stale[nodename]),
+		})
+	}
+	t.cmdC <- hbctrl.CmdSetAlert{
+		HbID:  t.id,
+		Alert: alerts,
+	}
+}
+
+func newRx(ctx context.Context, name string, nodes []string, dir string, timeout, interval time.Duration) *rx {
+	id := name + ".rx"
+	log := plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbfile").
+		Attr("hb_func", "rx").
+		Attr("hb_name", name).
+		Attr("hb_id", id).
+		WithPrefix("daemon: hb: file: rx: " + name + ": ")
+
+	return &rx{
+		ctx:      ctx,
+		id:       id,
+		nodes:    nodes,
+		dir:      dir,
+		timeout:  timeout,
+		interval: interval,
+		last:     make(map[string]time.Time),
+		stale:    make(map[string]string),
+		log:      log,
+	}
+}
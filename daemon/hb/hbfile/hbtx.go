@@ -0,0 +1,170 @@
+package hbfile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/v3/daemon/hb/hbaudit"
+	"github.com/opensvc/om3/v3/daemon/hb/hbctrl"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	// tx holds a hb file transmitter
+	tx struct {
+		sync.WaitGroup
+		ctx       context.Context
+		id        string
+		nodes     []string
+		dir       string
+		timeout   time.Duration
+		interval  time.Duration
+		localhost string
+
+		// lastErr is the last capsule write error, used to log only the
+		// error changes.
+		lastErr string
+
+		name   string
+		log    *plog.Logger
+		cmdC   chan<- interface{}
+		cancel func()
+	}
+)
+
+// ID implements the ID function of Transmitter interface for tx
+func (t *tx) ID() string {
+	return t.id
+}
+
+// Stop implements the Stop function of Transmitter interface for tx
+func (t *tx) Stop() error {
+	t.log.Tracef("cancelling")
+	t.cancel()
+	for _, node := range t.nodes {
+		t.cmdC <- hbctrl.CmdDelWatcher{
+			HbID:     t.id,
+			Nodename: node,
+		}
+	}
+	t.Wait()
+	t.log.Tracef("wait done")
+	return nil
+}
+
+func (t *tx) Ctx() context.Context {
+	return t.ctx
+}
+
+func (t *tx) streamPeerDesc() string {
+	return fmt.Sprintf("→ %s", capsuleFile(t.dir, t.localhost))
+}
+
+// Start implements the Start function of Transmitter interface for tx
+func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.ctx = ctx
+	t.cancel = cancel
+	hbaudit.EnableAudit(ctx, t.id, t.log, "hb", strings.Replace(t.id, "hb#", "hb:", 1))
+	if err := checkDir(t.dir); err != nil {
+		t.log.Warnf("startup failed: %s", err)
+		cancel()
+		return err
+	}
+	reasonTick := fmt.Sprintf("send msg (interval %s)", t.interval)
+	t.cmdC = cmdC
+	t.Add(1)
+	go func() {
+		defer t.Done()
+		t.log.Infof("started")
+		defer t.log.Infof("stopped")
+		for _, node := range t.nodes {
+			cmdC <- hbctrl.CmdAddWatcher{
+				HbID:     t.id,
+				Nodename: node,
+				Ctx:      ctx,
+				Timeout:  t.timeout,
+				Desc:     t.streamPeerDesc(),
+			}
+		}
+		var b []byte
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		var reason string
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case b = <-msgC:
+				reason = "send msg"
+
+				// No need to send the next message before a full ticker period.
+				ticker.Reset(t.interval)
+			case <-ticker.C:
+				reason = reasonTick
+			}
+			if len(b) == 0 {
+				continue
+			}
+			t.log.Tracef(reason)
+			t.send(b)
+		}
+	}()
+	return nil
+}
+
+func (t *tx) send(b []byte) {
+	if err := writeCapsule(t.dir, t.localhost, b); err != nil {
+		if s := err.Error(); s != t.lastErr {
+			t.log.Errorf("write capsule: %s", err)
+			t.lastErr = s
+			t.sendAlert(daemonsubsystem.Alert{Severity: "warning", Message: fmt.Sprintf("write capsule: %s", err)})
+		}
+		return
+	}
+	if t.lastErr != "" {
+		t.log.Infof("write capsule: recovered")
+		t.lastErr = ""
+		t.sendAlert()
+	}
+	t.log.Tracef("written capsule len %d", len(b))
+	for _, node := range t.nodes {
+		t.cmdC <- hbctrl.CmdSetPeerSuccess{
+			Nodename: node,
+			HbID:     t.id,
+			Success:  true,
+			Size:     len(b),
+		}
+	}
+}
+
+func (t *tx) sendAlert(alerts ...daemonsubsystem.Alert) {
+	t.cmdC <- hbctrl.CmdSetAlert{
+		HbID:  t.id,
+		Alert: append([]daemonsubsystem.Alert{}, alerts...),
+	}
+}
+
+func newTx(ctx context.Context, name string, nodes []string, dir string, timeout, interval time.Duration) *tx {
+	id := name + ".tx"
+	log := plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbfile").
+		Attr("hb_func", "tx").
+		Attr("hb_name", name).
+		Attr("hb_id", id).
+		WithPrefix("daemon: hb: file: tx: " + name + ": ")
+	return &tx{
+		ctx:       ctx,
+		id:        id,
+		nodes:     nodes,
+		dir:       dir,
+		timeout:   timeout,
+		interval:  interval,
+		localhost: hostname.Hostname(),
+		log:       log,
+	}
+}
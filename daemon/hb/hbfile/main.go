@@ -0,0 +1,159 @@
+/*
+Package hbfile implements a hb driver exchanging the node data through a
+directory of a shared filesystem, like a NFS or CephFS mount.
+
+Each node writes its encrypted capsule in its own file of the directory. The
+peer files not modified since the timeout are considered stale.
+*/
+package hbfile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/hbcfg"
+	"github.com/opensvc/om3/v3/daemon/hb/hbaudit"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/key"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	T struct {
+		hbcfg.T
+	}
+
+	capsule struct {
+		Updated time.Time `json:"updated"`
+		Msg     []byte    `json:"msg"`
+	}
+)
+
+const (
+	// capsuleSuffix is the suffix of the node capsule file names.
+	capsuleSuffix = ".hb"
+
+	// capsulePermission is the permission of the node capsule files. The
+	// capsule message is encrypted, but there is no need to expose it.
+	capsulePermission = 0600
+)
+
+func New() hbcfg.Confer {
+	t := &T{}
+	var i interface{} = t
+	return i.(hbcfg.Confer)
+}
+
+func init() {
+	hbcfg.Register("file", New)
+}
+
+// Configure implements the Configure function of Confer interface for T
+func (t *T) Configure(ctx context.Context) {
+	log := plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbfile").Attr("hb_name", t.Name()).WithPrefix("daemon: hb: file: " + t.Name() + ": configure: ")
+	hbaudit.AttachActiveAuditIfAny(ctx, log, "hb", "hb.main", strings.Replace(t.Name(), "hb#", "hb:", 1))
+	timeout := t.GetDuration("timeout", 9*time.Second)
+	interval := t.GetDuration("interval", 4*time.Second)
+	if timeout < 2*interval+1*time.Second {
+		oldTimeout := timeout
+		timeout = interval*2 + 1*time.Second
+		log.Warnf("reajust timeout: %s => %s (<interval>*2+1s)", oldTimeout, timeout)
+	}
+	dir := t.GetString("dir")
+	if dir == "" {
+		log.Errorf("%s.dir is not set in node.conf", t.Name())
+		return
+	}
+	nodes := t.GetStrings("nodes")
+	if len(nodes) == 0 {
+		k := key.T{Section: "cluster", Option: "nodes"}
+		nodes = t.Config().GetStrings(k)
+	}
+	oNodes := hostname.OtherNodes(nodes)
+	log.Tracef("timeout=%s interval=%s dir=%s nodes=%s onodes=%s", timeout, interval, dir, nodes, oNodes)
+
+	t.SetNodes(oNodes)
+	t.SetInterval(interval)
+	t.SetTimeout(timeout)
+	signature := fmt.Sprintf("type: hb.file, dir: %s nodes: %s timeout: %s interval: %s",
+		dir, nodes, timeout, interval)
+	t.SetSignature(signature)
+	log.Debugf("signature: [%s]", signature)
+	name := t.Name()
+	tx := newTx(ctx, name, oNodes, dir, timeout, interval)
+	t.SetTx(tx)
+	rx := newRx(ctx, name, oNodes, dir, timeout, interval)
+	t.SetRx(rx)
+}
+
+// capsuleFile returns the path of the capsule file written by nodename.
+func capsuleFile(dir, nodename string) string {
+	return filepath.Join(dir, nodename+capsuleSuffix)
+}
+
+// checkDir returns an error if dir is not an existing directory.
+func checkDir(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	return nil
+}
+
+// writeCapsule atomically replaces the nodename capsule file with a new
+// capsule embedding the encrypted message b.
+//
+// The capsule is written to a temporary file renamed to the capsule file, so
+// the readers never see a partially written capsule.
+func writeCapsule(dir, nodename string, b []byte) error {
+	data, err := json.Marshal(capsule{
+		Updated: time.Now(),
+		Msg:     b,
+	})
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, "."+nodename+capsuleSuffix+".*")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer func() { _ = os.Remove(tmp) }()
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Chmod(capsulePermission); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, capsuleFile(dir, nodename))
+}
+
+// readCapsule returns the capsule read from the nodename capsule file.
+func readCapsule(dir, nodename string) (capsule, error) {
+	var c capsule
+	b, err := os.ReadFile(capsuleFile(dir, nodename))
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, err
+	}
+	return c, nil
+}
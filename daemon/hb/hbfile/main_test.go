@@ -0,0 +1,101 @@
+package hbfile
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/hbtype"
+	"github.com/opensvc/om3/v3/daemon/hb/hbctrl"
+)
+
+// clearCrypto is a decryptWithNoder expecting clear messages prefixed by the
+// sender nodename and a colon.
+type clearCrypto struct{}
+
+func (clearCrypto) DecryptWithNode(data []byte) ([]byte, string, error) {
+	for i, c := range data {
+		if c == ':' {
+			return data[i+1:], string(data[:i]), nil
+		}
+	}
+	return nil, "", os.ErrInvalid
+}
+
+func newTestRx(t *testing.T, dir string, nodes ...string) (*rx, chan any, chan *hbtype.Msg) {
+	t.Helper()
+	cmdC := make(chan any, 10)
+	msgC := make(chan *hbtype.Msg, 10)
+	r := newRx(context.Background(), "hb#1", nodes, dir, 2*time.Second, time.Second)
+	r.cmdC = cmdC
+	r.msgC = msgC
+	r.crypto = clearCrypto{}
+	return r, cmdC, msgC
+}
+
+func encode(t *testing.T, nodename string) []byte {
+	t.Helper()
+	b, err := json.Marshal(hbtype.Msg{Nodename: nodename, Kind: "ping"})
+	require.NoError(t, err)
+	return append([]byte(nodename+":"), b...)
+}
+
+func TestCapsuleReadWrite(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, writeCapsule(dir, "node1", []byte("data1")))
+	require.NoError(t, writeCapsule(dir, "node1", []byte("data2")))
+	c, err := readCapsule(dir, "node1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("data2"), c.Msg)
+	require.WithinDuration(t, time.Now(), c.Updated, time.Minute)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "expected no temporary file left")
+	info, err := entries[0].Info()
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(capsulePermission), info.Mode().Perm())
+}
+
+func TestRecv(t *testing.T) {
+	dir := t.TempDir()
+	r, cmdC, msgC := newTestRx(t, dir, "node2")
+
+	require.Equal(t, "no capsule file", r.recv("node2"))
+
+	require.NoError(t, writeCapsule(dir, "node2", encode(t, "node2")))
+	require.Equal(t, "", r.recv("node2"))
+	require.Equal(t, "node2", (<-msgC).Nodename)
+	cmd := (<-cmdC).(hbctrl.CmdSetPeerSuccess)
+	require.Equal(t, "node2", cmd.Nodename)
+	require.True(t, cmd.Success)
+
+	require.Equal(t, "", r.recv("node2"), "expected an unchanged capsule to be valid")
+	require.Len(t, msgC, 0, "expected no message forwarded for an unchanged capsule")
+
+	old := time.Now().Add(-time.Minute)
+	require.NoError(t, os.Chtimes(capsuleFile(dir, "node2"), old, old))
+	require.Contains(t, r.recv("node2"), "not modified since")
+	require.Len(t, msgC, 0, "expected no message forwarded for a stale capsule")
+}
+
+func TestRecvStolen(t *testing.T) {
+	dir := t.TempDir()
+	r, _, msgC := newTestRx(t, dir, "node2")
+	require.NoError(t, writeCapsule(dir, "node2", encode(t, "node3")))
+	require.Equal(t, "capsule written by node node3", r.recv("node2"))
+	require.Len(t, msgC, 0)
+}
+
+func TestSetStale(t *testing.T) {
+	r, _, _ := newTestRx(t, t.TempDir(), "node2")
+	require.True(t, r.setStale("node2", "no capsule file"))
+	require.False(t, r.setStale("node2", "no capsule file"))
+	require.True(t, r.setStale("node2", ""))
+	require.False(t, r.setStale("node2", ""))
+	require.Empty(t, r.stale)
+}
@@ -234,6 +234,8 @@ func (t *rx) recv(src *net.UDPAddr, n int, b []byte) {
 		Nodename: data.Nodename,
 		HbID:     t.id,
 		Success:  true,
+		Size:     len(encMsg),
+		SentAt:   data.UpdatedAt,
 	}
 	t.msgC <- &data
 }
@@ -169,6 +169,7 @@ func (t *tx) send(b []byte) {
 			Nodename: node,
 			HbID:     t.id,
 			Success:  true,
+			Size:     msgLength,
 		}
 	}
 }
@@ -189,6 +189,8 @@ func (t *rx) recv(nodename string) {
 		Nodename: msg.Nodename,
 		HbID:     t.id,
 		Success:  true,
+		Size:     len(c.Msg),
+		SentAt:   msg.UpdatedAt,
 	}
 	t.msgC <- &msg
 	t.lastAt = c.UpdatedAt
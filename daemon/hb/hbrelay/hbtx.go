@@ -149,6 +149,7 @@ func (t *tx) send(b []byte) {
 			Nodename: node,
 			HbID:     t.id,
 			Success:  true,
+			Size:     len(b),
 		}
 	}
 }
@@ -87,6 +87,7 @@ func (t *T) Configure(ctx context.Context) {
 	oNodes := hostname.OtherNodes(nodes)
 	log.Tracef("timeout=%s interval=%s relay=%s insecure=%t nodes=%s onodes=%s", timeout, interval, relay, insecure, nodes, oNodes)
 	t.SetNodes(oNodes)
+	t.SetInterval(interval)
 	t.SetTimeout(timeout)
 	cfg := cfg{
 		relay:        relay,
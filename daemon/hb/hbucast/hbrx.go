@@ -248,6 +248,8 @@ func (t *rx) handle(conn encryptconn.ConnNoder) {
 		Nodename: msg.Nodename,
 		HbID:     t.id,
 		Success:  true,
+		Size:     i,
+		SentAt:   msg.UpdatedAt,
 	}
 	select {
 	case <-t.ctx.Done():
@@ -244,6 +244,7 @@ func (t *tx) send(node, addr string, b []byte) {
 		Nodename: node,
 		HbID:     t.id,
 		Success:  true,
+		Size:     len(b),
 	}
 }
 
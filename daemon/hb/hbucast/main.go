@@ -97,6 +97,7 @@ func (t *T) Configure(ctx context.Context) {
 	t.SetNodes(peerList)
 	t.SetInterval(interval)
 	t.SetTimeout(timeout)
+	t.SetMaxMsgSize(msgMaxSize - 10000)
 	intf := t.GetString("intf")
 	signature := fmt.Sprintf("type: hb.ucast, nodes: %s timeout: %s interval: %s intf: %s",
 		nodesSig, timeout, interval, intf)
@@ -2,7 +2,6 @@ package hb
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"runtime"
@@ -13,6 +12,7 @@ import (
 	"github.com/opensvc/om3/v3/core/clusterhb"
 	"github.com/opensvc/om3/v3/core/hbcfg"
 	"github.com/opensvc/om3/v3/core/hbtype"
+	"github.com/opensvc/om3/v3/core/omcrypto"
 	"github.com/opensvc/om3/v3/daemon/daemonctx"
 	"github.com/opensvc/om3/v3/daemon/daemondata"
 	"github.com/opensvc/om3/v3/daemon/daemonenv"
@@ -43,6 +43,9 @@ type (
 
 		ridSignature map[string]string
 
+		// codecs negotiates the compression codec of the sent messages
+		codecs *codecNegotiator
+
 		// ctx is the main context for the controller, and started hb drivers
 		ctx context.Context
 
@@ -55,6 +58,21 @@ type (
 		id string
 		// msgToSendQueue is the queue on which a tx fetch messages to send
 		msgToSendQueue chan []byte
+
+		// maxMsgSize is the size budget of the messages to send, zero if
+		// unlimited
+		maxMsgSize int
+	}
+
+	// txQueue is the state of a tx registered in the msgToTx multiplexer
+	txQueue struct {
+		registerTxQueue
+
+		// mode is the current tx mode, one of the txMode* values
+		mode string
+
+		// codec is the compression codec of the last message sent
+		codec string
 	}
 )
 
@@ -69,6 +87,7 @@ func New(_ context.Context, opts ...funcopt.O) *T {
 	t.rxs = make(map[string]hbtype.Receiver)
 	t.readMsgQueue = make(chan *hbtype.Msg)
 	t.ridSignature = make(map[string]string)
+	t.codecs = newCodecNegotiator()
 	return t
 }
 
@@ -181,7 +200,7 @@ func (t *T) startHbTx(hb hbcfg.Confer) error {
 	if tx == nil {
 		return fmt.Errorf("nil tx for %s", hb.Name())
 	}
-	t.ctrlC <- hbctrl.CmdRegister{ID: tx.ID(), Type: hb.Type()}
+	t.ctrlC <- hbctrl.CmdRegister{ID: tx.ID(), Type: hb.Type(), Interval: hb.Interval(), MaxTimeout: hb.MaxTimeout()}
 
 	// start debounce msg goroutine to ensure non-blocking write to msgToSendQ:
 	// the msgToTxCtx goroutine multiplexes data messages to all hb tx drivers.
@@ -201,7 +220,7 @@ func (t *T) startHbTx(hb hbcfg.Confer) error {
 	select {
 	case <-t.msgToTxCtx.Done():
 		// don't hang up when context is done
-	case t.msgToTxRegister <- registerTxQueue{id: tx.ID(), msgToSendQueue: msgToSendQ}:
+	case t.msgToTxRegister <- registerTxQueue{id: tx.ID(), msgToSendQueue: msgToSendQ, maxMsgSize: hb.MaxMsgSize()}:
 		t.txs[hb.Name()] = tx
 	}
 	return nil
@@ -212,7 +231,7 @@ func (t *T) startHbRx(hb hbcfg.Confer) error {
 	if rx == nil {
 		return fmt.Errorf("nil rx for %s", hb.Name())
 	}
-	t.ctrlC <- hbctrl.CmdRegister{ID: rx.ID(), Type: hb.Type()}
+	t.ctrlC <- hbctrl.CmdRegister{ID: rx.ID(), Type: hb.Type(), Interval: hb.Interval(), MaxTimeout: hb.MaxTimeout()}
 	if err := rx.Start(t.ctrlC, t.readMsgQueue); err != nil {
 		t.ctrlC <- hbctrl.CmdSetState{ID: rx.ID(), State: "failed"}
 		return err
@@ -261,7 +280,7 @@ func (t *T) rescanHb(ctx context.Context) error {
 	}
 	ridSignatureNew := make(map[string]string)
 	for rid, hb := range ridHb {
-		ridSignatureNew[rid] = hb.Signature()
+		ridSignatureNew[rid] = fmt.Sprintf("%s max_timeout: %s max_msg_size: %d", hb.Signature(), hb.MaxTimeout(), hb.MaxMsgSize())
 	}
 
 	for rid := range t.ridSignature {
@@ -326,7 +345,7 @@ func (t *T) msgToTx(ctx context.Context) error {
 		defer t.wg.Done()
 		defer t.log.Infof("multiplexer message to hb tx drivers stopped")
 		t.log.Infof("multiplexer message to hb tx drivers started")
-		registeredTxMsgQueue := make(map[string]chan []byte)
+		registeredTxMsgQueue := make(map[string]*txQueue)
 		defer func() {
 			// We have to async ask daemondata to not anymore write to hbSendQ
 			// async because daemon data can be waiting on running queueNewHbMsg():
@@ -359,30 +378,34 @@ func (t *T) msgToTx(ctx context.Context) error {
 				return
 			case c := <-t.msgToTxRegister:
 				t.log.Tracef("msgToTx: add %s to hb transmitters", c.id)
-				registeredTxMsgQueue[c.id] = c.msgToSendQueue
+				registeredTxMsgQueue[c.id] = &txQueue{registerTxQueue: c}
 			case txID := <-t.msgToTxUnregister:
 				t.log.Tracef("msgToTx: remove %s from hb transmitters", txID)
 				delete(registeredTxMsgQueue, txID)
 			case msg := <-msgC:
-				b, err := json.Marshal(msg)
-				if err != nil {
-					err = fmt.Errorf("msgToTx: marshal failure %s for msg %v", err, msg)
-					continue
-				}
 				cipher := crypto.Load()
 				if cipher == nil {
 					continue
 				}
-				b, err = cipher.Encrypt(b)
-				if err != nil {
-					continue
-				}
+				codec := t.codecs.codec(time.Now())
+				msg.Codecs = omcrypto.Codecs
+				encoded := newEncodedMsg(msg, cipher.WithCodec(codec).Encrypt)
 				for _, txQueue := range registeredTxMsgQueue {
+					b, mode, err := encoded.fit(txQueue.maxMsgSize)
+					if err != nil {
+						// the other transmitters may have a larger budget
+						t.log.Tracef("msgToTx: %s: %s", txQueue.id, err)
+						continue
+					}
+					t.setTxMode(txQueue, nextTxMode(txQueue.mode, msg.Kind, mode), codec)
+					if b == nil {
+						continue
+					}
 					select {
 					case <-ctx.Done():
 						// don't hang up when context is done
 						return
-					case txQueue <- b:
+					case txQueue.msgToSendQueue <- b:
 					}
 				}
 			}
@@ -391,11 +414,32 @@ func (t *T) msgToTx(ctx context.Context) error {
 	return nil
 }
 
+// setTxMode updates the mode and codec of a tx, and reports the changes to
+// the hb controller.
+func (t *T) setTxMode(q *txQueue, mode, codec string) {
+	if q.mode == mode && q.codec == codec {
+		return
+	}
+	switch mode {
+	case txModeFull:
+		t.log.Infof("%s: send %s compressed messages in %s mode", q.id, codec, mode)
+	case txModeOversized:
+		t.log.Warnf("%s: skip messages exceeding the %d bytes budget", q.id, q.maxMsgSize)
+	default:
+		t.log.Warnf("%s: send %s compressed messages in %s mode, the full messages exceed the %d bytes budget", q.id, codec, mode, q.maxMsgSize)
+	}
+	q.mode = mode
+	q.codec = codec
+	t.ctrlC <- hbctrl.CmdSetMode{HbID: q.id, Codec: codec, Mode: mode}
+}
+
 // msgFromRx get hbrx decoded messages from readMsgQueue, and
 // forward the decoded hb message to daemondata HBRecvMsgQ.
 //
 // When multiple hb rx are running, we can get multiple times the same hb message,
-// but only one hb decoded message is forwarded to daemondata HBRecvMsgQ
+// but only one hb decoded message is forwarded to daemondata HBRecvMsgQ.
+// A degraded variant of a message is forwarded before the message itself
+// if received first, so the more complete message is also forwarded.
 //
 // It ends when ctx is done
 func (t *T) msgFromRx(ctx context.Context) {
@@ -406,6 +450,7 @@ func (t *T) msgFromRx(ctx context.Context) {
 	defer statTicker.Stop()
 	dataMsgRecvQ := daemonctx.HBRecvMsgQ(ctx)
 	msgTimes := make(map[string]time.Time)
+	msgKinds := make(map[string]string)
 	msgTimeDuration := 10 * time.Minute
 	defer func() {
 		tC := time.After(daemonenv.DrainChanDuration)
@@ -428,11 +473,13 @@ func (t *T) msgFromRx(ctx context.Context) {
 			for peer, updated := range msgTimes {
 				if now.Sub(updated) > msgTimeDuration {
 					delete(msgTimes, peer)
+					delete(msgKinds, peer)
 				}
 			}
 		case msg := <-t.readMsgQueue:
 			peer := msg.Nodename
-			if msgTimes[peer].Equal(msg.UpdatedAt) {
+			t.codecs.set(peer, msg.Codecs, time.Now())
+			if msgTimes[peer].Equal(msg.UpdatedAt) && kindRank(msg.Kind) <= kindRank(msgKinds[peer]) {
 				t.log.Tracef("msgFromRx: drop already processed msg %s from %s gens: %v", msg.Kind, msg.Nodename, msg.Gen)
 				continue
 			}
@@ -443,6 +490,7 @@ func (t *T) msgFromRx(ctx context.Context) {
 			case dataMsgRecvQ <- msg:
 				t.log.Tracef("msgFromRx: processed msg type %s from %s gens: %v", msg.Kind, msg.Nodename, msg.Gen)
 				msgTimes[peer] = msg.UpdatedAt
+				msgKinds[peer] = msg.Kind
 				count++
 			}
 		}
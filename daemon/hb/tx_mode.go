@@ -0,0 +1,149 @@
+package hb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/opensvc/om3/v3/core/hbtype"
+	"github.com/opensvc/om3/v3/core/node"
+)
+
+type (
+	// encodedMsg is a hb message to send, with its lazily encoded degraded
+	// variants.
+	encodedMsg struct {
+		msg     hbtype.Msg
+		encrypt func([]byte) ([]byte, error)
+		cache   map[string][]byte
+	}
+)
+
+const (
+	// txModeFull is the mode of a tx sending the messages as produced by
+	// daemondata.
+	txModeFull = "full"
+
+	// txModePatchOnly is the mode of a tx sending the patch variant of the
+	// full messages exceeding its size budget. The peers needing a full
+	// message must receive it from another heartbeat.
+	txModePatchOnly = "patch-only"
+
+	// txModePingOnly is the mode of a tx sending the ping variant of the
+	// messages exceeding its size budget. The tx only maintains the peers
+	// liveness.
+	txModePingOnly = "ping-only"
+
+	// txModeOversized is the mode of a tx not sending the messages because
+	// even their ping variant exceeds its size budget.
+	txModeOversized = "oversized"
+)
+
+func newEncodedMsg(msg hbtype.Msg, encrypt func([]byte) ([]byte, error)) *encodedMsg {
+	return &encodedMsg{
+		msg:     msg,
+		encrypt: encrypt,
+		cache:   make(map[string][]byte),
+	}
+}
+
+// degradedKinds returns the message kind followed by the kinds of its
+// degraded variants, from the most to the least complete.
+func degradedKinds(kind string) []string {
+	switch kind {
+	case "full":
+		return []string{"full", "patch", "ping"}
+	case "patch":
+		return []string{"patch", "ping"}
+	default:
+		return []string{kind}
+	}
+}
+
+// kindRank returns the completeness rank of a message kind.
+func kindRank(kind string) int {
+	switch kind {
+	case "full":
+		return 2
+	case "patch":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// variant returns the kind variant of msg. The patch variant of a full
+// message drops the node data but keeps the events, the ping variant also
+// drops the events.
+func variant(msg hbtype.Msg, kind string) hbtype.Msg {
+	if kind == msg.Kind {
+		return msg
+	}
+	msg.Kind = kind
+	msg.NodeData = node.Node{}
+	if kind == "ping" {
+		msg.Events = nil
+	}
+	return msg
+}
+
+// get returns the encoded kind variant of the message.
+func (t *encodedMsg) get(kind string) ([]byte, error) {
+	if b, ok := t.cache[kind]; ok {
+		return b, nil
+	}
+	b, err := json.Marshal(variant(t.msg, kind))
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s message: %w", kind, err)
+	}
+	b, err = t.encrypt(b)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt %s message: %w", kind, err)
+	}
+	t.cache[kind] = b
+	return b, nil
+}
+
+// fit returns the most complete encoded variant of the message not
+// exceeding the budget size, with the tx mode it implies. A zero budget is
+// unlimited.
+func (t *encodedMsg) fit(budget int) ([]byte, string, error) {
+	kinds := degradedKinds(t.msg.Kind)
+	for i, kind := range kinds {
+		b, err := t.get(kind)
+		if err != nil {
+			return nil, "", err
+		}
+		if budget > 0 && len(b) > budget {
+			continue
+		}
+		if i == 0 {
+			return b, txModeFull, nil
+		}
+		return b, kind + "-only", nil
+	}
+	return nil, txModeOversized, nil
+}
+
+// nextTxMode returns the mode of a tx after sending a message in the mode
+// returned by fit.
+//
+// A full message decides the mode. A patch message can only report a
+// degradation to ping-only or a recovery to patch-only, as a patch message
+// fitting the budget says nothing about the next full message. A ping
+// message only reports the transitions from and to the oversized mode.
+func nextTxMode(current, kind, fitMode string) string {
+	switch {
+	case kind == "full" || fitMode == txModeOversized:
+		return fitMode
+	case kind == "patch" && fitMode == txModePingOnly:
+		return txModePingOnly
+	case kind == "patch" && (current == txModePingOnly || current == txModeOversized):
+		return txModePatchOnly
+	case current == txModeOversized:
+		return txModePingOnly
+	case current == "":
+		return txModeFull
+	default:
+		return current
+	}
+}
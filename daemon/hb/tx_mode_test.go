@@ -0,0 +1,91 @@
+package hb
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/event"
+	"github.com/opensvc/om3/v3/core/hbtype"
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/core/omcrypto"
+)
+
+func noEncrypt(b []byte) ([]byte, error) {
+	return b, nil
+}
+
+func TestEncodedMsgFit(t *testing.T) {
+	msg := hbtype.Msg{
+		Kind:     "full",
+		Nodename: "node1",
+		Events: map[string][]event.Event{
+			"1": {{Kind: "ObjectStatusUpdated"}},
+		},
+		NodeData: node.Node{
+			Config: node.Config{Env: strings.Repeat("x", 10000)},
+		},
+	}
+	encoded := newEncodedMsg(msg, noEncrypt)
+	full, err := encoded.get("full")
+	require.NoError(t, err)
+	patch, err := encoded.get("patch")
+	require.NoError(t, err)
+	ping, err := encoded.get("ping")
+	require.NoError(t, err)
+	require.Greater(t, len(full), len(patch))
+	require.Greater(t, len(patch), len(ping))
+
+	cases := map[string]struct {
+		budget       int
+		expected     []byte
+		expectedMode string
+	}{
+		"unlimited":   {budget: 0, expected: full, expectedMode: txModeFull},
+		"large":       {budget: len(full), expected: full, expectedMode: txModeFull},
+		"patch only":  {budget: len(full) - 1, expected: patch, expectedMode: txModePatchOnly},
+		"ping only":   {budget: len(patch) - 1, expected: ping, expectedMode: txModePingOnly},
+		"oversized":   {budget: len(ping) - 1, expected: nil, expectedMode: txModeOversized},
+		"ping budget": {budget: len(ping), expected: ping, expectedMode: txModePingOnly},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b, mode, err := encoded.fit(tc.budget)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, b)
+			require.Equal(t, tc.expectedMode, mode)
+		})
+	}
+}
+
+func TestNextTxMode(t *testing.T) {
+	require.Equal(t, txModeFull, nextTxMode("", "ping", txModeFull))
+	require.Equal(t, txModePatchOnly, nextTxMode(txModeFull, "full", txModePatchOnly))
+	require.Equal(t, txModePatchOnly, nextTxMode(txModePatchOnly, "patch", txModeFull),
+		"expected a fitting patch message to keep the patch-only mode")
+	require.Equal(t, txModePingOnly, nextTxMode(txModePatchOnly, "patch", txModePingOnly))
+	require.Equal(t, txModePatchOnly, nextTxMode(txModePingOnly, "patch", txModeFull))
+	require.Equal(t, txModeFull, nextTxMode(txModePatchOnly, "full", txModeFull))
+	require.Equal(t, txModeOversized, nextTxMode(txModeFull, "ping", txModeOversized))
+	require.Equal(t, txModePingOnly, nextTxMode(txModeOversized, "ping", txModeFull))
+}
+
+func TestCodecNegotiator(t *testing.T) {
+	now := time.Now()
+	c := newCodecNegotiator()
+	require.Equal(t, omcrypto.CodecZlib, c.codec(now), "expected zlib without peers")
+
+	c.set("node2", omcrypto.Codecs, now)
+	require.Equal(t, omcrypto.CodecZstd, c.codec(now))
+
+	c.set("node3", nil, now)
+	require.Equal(t, omcrypto.CodecZlib, c.codec(now), "expected zlib with a peer not supporting zstd")
+
+	require.Equal(t, omcrypto.CodecZlib, c.codec(now.Add(codecPeerTTL+time.Second)),
+		"expected zlib when all peers are silent")
+
+	c.set("node3", nil, now.Add(-codecPeerTTL-time.Second))
+	require.Equal(t, omcrypto.CodecZstd, c.codec(now), "expected silent peers to be ignored")
+}
@@ -0,0 +1,172 @@
+package hook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/v3/core/event"
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	// httpSender posts the queued events to a http hook url, in order.
+	httpSender struct {
+		log       *plog.Logger
+		name      string
+		localhost string
+		url       string
+		secret    []byte
+		timeout   time.Duration
+		retries   int
+		client    *http.Client
+		q         chan *event.Event
+	}
+)
+
+const (
+	HeaderDelivery  = "X-Om-Delivery"
+	HeaderEvent     = "X-Om-Event"
+	HeaderEventID   = "X-Om-Event-Id"
+	HeaderNode      = "X-Om-Node"
+	HeaderSignature = "X-Om-Signature"
+)
+
+var (
+	// httpQueueSize is the maximum number of events waiting to be posted
+	// by a http hook. The events are dropped when the queue is full.
+	httpQueueSize = 100
+
+	// httpDefaultTimeout is the http hook request timeout, when the hook
+	// has no timeout.
+	httpDefaultTimeout = 5 * time.Second
+
+	// httpRetryDelay is the delay before the first retry of a failed http
+	// hook request. The delay doubles at each retry, up to
+	// httpMaxRetryDelay.
+	httpRetryDelay    = time.Second
+	httpMaxRetryDelay = time.Minute
+)
+
+// Sign returns the X-Om-Signature header value of the body signed with
+// the secret.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newHTTPDeliverer starts the http hook sender, and returns a function
+// queueing an event for the sender.
+func (t *Manager) newHTTPDeliverer(ctx context.Context, h node.Hook) func(*event.Event) {
+	s := newHTTPSender(t.log, t.localhost, h)
+	go s.run(ctx)
+	return func(ev *event.Event) {
+		select {
+		case s.q <- ev:
+		default:
+			t.log.Warnf("%s: queue is full => drop %s event %d", h.Name, ev.Kind, ev.ID)
+		}
+	}
+}
+
+func newHTTPSender(log *plog.Logger, localhost string, h node.Hook) *httpSender {
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = httpDefaultTimeout
+	}
+	return &httpSender{
+		log:       log,
+		name:      h.Name,
+		localhost: localhost,
+		url:       h.URL,
+		secret:    []byte(h.Secret),
+		timeout:   timeout,
+		retries:   h.Retries,
+		client:    &http.Client{},
+		q:         make(chan *event.Event, httpQueueSize),
+	}
+}
+
+func (s *httpSender) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-s.q:
+			s.log.Infof("%s: on %s post %s", s.name, ev.Kind, s.url)
+			if err := s.send(ctx, ev); err != nil {
+				s.log.Warnf("%s: %s", s.name, err)
+			}
+		}
+	}
+}
+
+// send posts the event, retrying on connection error, timeout, and 429
+// or 5xx response status. All the attempts share the same delivery id, so
+// the receiver can deduplicate.
+func (s *httpSender) send(ctx context.Context, ev *event.Event) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to json-encode event: %w", err)
+	}
+	delivery := uuid.New().String()
+	delay := httpRetryDelay
+	for attempt := 0; ; attempt++ {
+		retryable, err := s.post(ctx, ev, body, delivery)
+		if err == nil {
+			return nil
+		} else if !retryable || attempt >= s.retries {
+			return fmt.Errorf("post %s event %d: %w", ev.Kind, ev.ID, err)
+		}
+		s.log.Debugf("%s: post %s event %d attempt %d failed: %s", s.name, ev.Kind, ev.ID, attempt+1, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, httpMaxRetryDelay)
+	}
+}
+
+func (s *httpSender) post(ctx context.Context, ev *event.Event, body []byte, delivery string) (retryable bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderDelivery, delivery)
+	req.Header.Set(HeaderEvent, ev.Kind)
+	req.Header.Set(HeaderEventID, strconv.FormatUint(ev.ID, 10))
+	req.Header.Set(HeaderNode, s.localhost)
+	if len(s.secret) > 0 {
+		req.Header.Set(HeaderSignature, Sign(s.secret, body))
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("unexpected response status %s", resp.Status)
+	default:
+		return false, fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+}
@@ -0,0 +1,73 @@
+package hook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/event"
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+func TestHTTPSender(t *testing.T) {
+	httpRetryDelay = time.Millisecond
+	ev := &event.Event{Kind: "ObjectOrchestrationRefused", ID: 42, Data: []byte(`{"path":"ns1/svc/web1"}`)}
+
+	t.Run("signed post is retried on 5xx", func(t *testing.T) {
+		var calls atomic.Int32
+		deliveries := make(map[string]int)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			require.Equal(t, Sign([]byte("s3cr3t"), body), r.Header.Get(HeaderSignature))
+			require.Equal(t, "ObjectOrchestrationRefused", r.Header.Get(HeaderEvent))
+			require.Equal(t, "42", r.Header.Get(HeaderEventID))
+			require.Equal(t, "node1", r.Header.Get(HeaderNode))
+			deliveries[r.Header.Get(HeaderDelivery)]++
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		s := newHTTPSender(plog.NewDefaultLogger(), "node1", node.Hook{Name: "h1", URL: srv.URL, Secret: "s3cr3t", Retries: 3})
+		require.NoError(t, s.send(context.Background(), ev))
+		require.Equal(t, int32(3), calls.Load())
+		require.Len(t, deliveries, 1, "all attempts must have the same delivery id")
+	})
+
+	t.Run("post is not retried on 4xx", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Empty(t, r.Header.Get(HeaderSignature))
+			calls.Add(1)
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer srv.Close()
+
+		s := newHTTPSender(plog.NewDefaultLogger(), "node1", node.Hook{Name: "h1", URL: srv.URL, Retries: 3})
+		require.Error(t, s.send(context.Background(), ev))
+		require.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("post fails after retries", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+
+		s := newHTTPSender(plog.NewDefaultLogger(), "node1", node.Hook{Name: "h1", URL: srv.URL, Retries: 2})
+		require.Error(t, s.send(context.Background(), ev))
+		require.Equal(t, int32(3), calls.Load())
+	})
+}
@@ -7,13 +7,13 @@ import (
 	"os/exec"
 	"slices"
 	"sync"
-	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/opensvc/om3/v3/core/event"
 	"github.com/opensvc/om3/v3/core/node"
 	"github.com/opensvc/om3/v3/core/xconfig"
+	"github.com/opensvc/om3/v3/daemon/eventfilter"
 	"github.com/opensvc/om3/v3/daemon/msgbus"
 	"github.com/opensvc/om3/v3/util/hostname"
 	"github.com/opensvc/om3/v3/util/plog"
@@ -46,9 +46,18 @@ type (
 		node.Hook
 		cancel func()
 	}
+
+	labeler interface {
+		GetLabels() pubsub.Labels
+	}
 )
 
 var (
+	// execQueueSize is the maximum number of events waiting for the
+	// execution of an exec hook command. The events are dropped when the
+	// queue is full.
+	execQueueSize = 100
+
 	AllowedEvents = []string{
 		"ArbitratorError",
 		"EnterOverloadPeriod",
@@ -57,11 +66,17 @@ var (
 		"HeartbeatStale",
 		"HeartbeatMessageTypeUpdated",
 		"InstanceMonitorAction",
+		"InstanceMonitorUpdated",
+		"InstanceStatusUpdated",
 		"LeaveOverloadPeriod",
 		"NodeAlive",
 		"NodeFrozen",
 		"NodeSplitAction",
+		"NodeStonithResult",
 		"NodeStale",
+		"ObjectOrchestrationEnd",
+		"ObjectOrchestrationRefused",
+		"ObjectStatusUpdated",
 	}
 )
 
@@ -135,8 +150,9 @@ func (t *Manager) update(hooks []node.Hook) {
 			hooksToStart[name] = h
 			continue
 		}
-		if diff := current.Hook.Diff(h); diff != "" {
-			t.log.Infof("%s: %s", name, diff)
+		if !current.Hook.Equal(&h) {
+			// the diff is empty when only the not serialized secret changed
+			t.log.Infof("%s: %s", name, current.Hook.Diff(h))
 			hooksToStop = append(hooksToStop, name)
 			hooksToStart[name] = h
 		}
@@ -154,24 +170,36 @@ func (t *Manager) update(hooks []node.Hook) {
 		delete(t.hooks, name)
 	}
 	for name, hookToStart := range hooksToStart {
-		kinds := hookToStart.Events
 		h := hook{
 			Hook: hookToStart,
 		}
-		if len(hookToStart.Command) < 1 {
-			t.log.Warnf("%s: empty command", name)
-			continue
+		switch hookToStart.Type {
+		case "http":
+			if hookToStart.URL == "" {
+				t.log.Warnf("%s: empty url", name)
+				continue
+			}
+		default:
+			if len(hookToStart.Command) < 1 {
+				t.log.Warnf("%s: empty command", name)
+				continue
+			}
 		}
-		h.cancel = t.startHook(name, kinds, hookToStart.Command)
+		h.cancel = t.startHook(hookToStart)
 		t.hooks[name] = h
 	}
 }
 
-func (t *Manager) hookExec(ctx context.Context, ev *event.Event, args []string) error {
+func (t *Manager) hookExec(ctx context.Context, ev *event.Event, args []string, timeout time.Duration) error {
 	b, err := json.Marshal(ev)
 	if err != nil {
 		return fmt.Errorf("failed to json-encode event: %w", err)
 	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
 	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
 	cmd.Env = []string{
 		"EVENT=" + string(b),
@@ -185,53 +213,110 @@ func (t *Manager) hookExec(ctx context.Context, ev *event.Event, args []string)
 	return cmd.Wait()
 }
 
-func (t *Manager) hookLoop(ctx context.Context, sub *pubsub.Subscription, name string, kinds, args []string) {
+// newExecDeliverer starts the exec hook runner, and returns a function
+// queueing an event for the runner. The runner executes the hook command
+// with the json-formatted event in the EVENT environment variable, one
+// event at a time, so the events queued while a command runs are executed
+// in order. The events are dropped and counted when the queue is full.
+func (t *Manager) newExecDeliverer(ctx context.Context, h node.Hook) func(*event.Event) {
+	q := make(chan *event.Event, execQueueSize)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev := <-q:
+				t.log.Infof("%s: on %s exec %s", h.Name, ev.Kind, h.Command)
+				if err := t.hookExec(ctx, ev, h.Command, h.Timeout); err != nil {
+					t.log.Warnf("%s: %s", h.Name, err)
+				}
+			}
+		}
+	}()
+	var dropped uint64
+	return func(ev *event.Event) {
+		select {
+		case q <- ev:
+		default:
+			dropped++
+			t.log.Warnf("%s: command is too slow and queue is full => drop %s event %d (%d dropped)", h.Name, ev.Kind, ev.ID, dropped)
+		}
+	}
+}
+
+// matchFilters returns true if the event ev, converted from the message i,
+// matches the labels and the data conditions of one of the filters l.
+func (t *Manager) matchFilters(name string, l []eventfilter.Filter, i any, ev *event.Event) bool {
+	var labels pubsub.Labels
+	if o, ok := i.(labeler); ok {
+		labels = o.GetLabels()
+	}
+	var data map[string]any
+	for _, filter := range l {
+		if !filter.MatchLabels(ev.Kind, labels) {
+			continue
+		}
+		if len(filter.DataFilters) == 0 {
+			return true
+		}
+		if data == nil {
+			data = make(map[string]any)
+			if err := json.Unmarshal(ev.Data, &data); err != nil {
+				t.log.Warnf("%s: on %s decode data: %s", name, ev.Kind, err)
+				return false
+			}
+		}
+		if filter.DataFilters.Match(data) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Manager) hookLoop(ctx context.Context, sub *pubsub.Subscription, name string, kinds []string, filters map[string][]eventfilter.Filter, deliver func(*event.Event)) {
 	t.log.Infof("%s: listening for events %s", name, kinds)
 	defer t.log.Infof("%s: stop listening for events %s", name, kinds)
-	var (
-		running atomic.Bool
-		j       uint64
-	)
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case i := <-sub.C:
+			var seq uint64
+			if o, ok := i.(*pubsub.Sequenced); ok {
+				seq, i = o.Seq, o.Data
+			}
 			switch c := i.(type) {
 			case *msgbus.AuditStart:
 				t.log.HandleAuditStart(c.Q, c.Subsystems, "hook")
+				continue
 			case *msgbus.AuditStop:
 				t.log.HandleAuditStop(c.Q, c.Subsystems, "hook")
+				continue
 			}
-			j += 1
-			ev := event.ToEvent(i, j)
+			ev := event.ToEvent(i, seq)
 			if ev == nil {
 				continue
 			}
-			if running.Load() {
-				t.log.Warnf("%s: on %s command is too slow => skip exec", name, ev.Kind)
+			if l, ok := filters[ev.Kind]; ok && !t.matchFilters(name, l, i, ev) {
 				continue
 			}
-			running.Store(true)
-			go func() {
-				defer running.Store(false)
-				t.log.Infof("%s: on %s exec %s", name, ev.Kind, args)
-				if err := t.hookExec(ctx, ev, args); err != nil {
-					t.log.Warnf("%s: %s", name, err)
-				}
-			}()
+			deliver(ev)
 		}
 	}
 }
 
-func (t *Manager) startHook(name string, kinds []string, args []string) func() {
+// startHook subscribes to the hook events and filters, and starts the
+// hook loop. It returns the function stopping the hook, or nil if the hook
+// has no valid event to listen to.
+func (t *Manager) startHook(h node.Hook) func() {
+	name := h.Name
 	ctx, cancel := context.WithCancel(t.ctx)
-	sub := pubsub.SubFromContext(ctx, "daemon.hook", t.subQS, pubsub.Timeout(time.Second))
+	sub := pubsub.SubFromContext(ctx, "daemon.hook", t.subQS, pubsub.Timeout(time.Second), pubsub.WithSeq(true))
 	sub.AddFilter(&msgbus.AuditStart{})
 	sub.AddFilter(&msgbus.AuditStop{})
-	added := 0
-	for _, kind := range kinds {
+	var kinds []string
+	for _, kind := range h.Events {
 		if !slices.Contains(AllowedEvents, kind) {
 			t.log.Warnf("%s: event %s is not allowed in hooks", name, kind)
 			continue
@@ -242,16 +327,54 @@ func (t *Manager) startHook(name string, kinds []string, args []string) func() {
 			continue
 		}
 		sub.AddFilter(event)
-		added += 1
+		kinds = append(kinds, kind)
+	}
+	// The filters of a kind are ORed by the subscription, so each event
+	// is matched again against the labels and data conditions of each
+	// filter of its kind.
+	filters := make(map[string][]eventfilter.Filter)
+	for _, s := range h.Filters {
+		l, err := eventfilter.Parse(s)
+		if err != nil {
+			t.log.Warnf("%s: invalid filter %s: %s", name, s, err)
+			continue
+		}
+		for _, filter := range l {
+			if filter.IsZero() {
+				continue
+			}
+			kind := filter.KindString()
+			if kind == "" {
+				t.log.Warnf("%s: filter without event kind is not allowed in hooks", name)
+				continue
+			} else if !slices.Contains(AllowedEvents, kind) {
+				t.log.Warnf("%s: event %s is not allowed in hooks", name, kind)
+				continue
+			}
+			sub.AddFilter(filter.Kind, filter.Labels...)
+			if !slices.Contains(h.Events, kind) {
+				// the events list kinds are not filtered
+				filters[kind] = append(filters[kind], filter)
+			}
+			kinds = append(kinds, kind)
+		}
 	}
-	if added == 0 {
+	if len(kinds) == 0 {
 		cancel()
 		return nil
 	}
 	sub.Start()
 
+	var deliver func(*event.Event)
+	switch h.Type {
+	case "http":
+		deliver = t.newHTTPDeliverer(ctx, h)
+	default:
+		deliver = t.newExecDeliverer(ctx, h)
+	}
+
 	go func() {
-		t.hookLoop(ctx, sub, name, kinds, args)
+		t.hookLoop(ctx, sub, name, kinds, filters, deliver)
 		sub.Stop()
 	}()
 	return cancel
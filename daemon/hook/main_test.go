@@ -0,0 +1,75 @@
+package hook
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/event"
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/daemon/eventfilter"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+func TestExecDeliverer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	out := filepath.Join(t.TempDir(), "out")
+
+	m := &Manager{log: plog.NewDefaultLogger()}
+	deliver := m.newExecDeliverer(ctx, node.Hook{
+		Name:    "hook#1",
+		Command: []string{"/bin/sh", "-c", `sleep 0.1; echo "$EVENT" >>` + out},
+	})
+
+	// the events delivered while the command runs are queued, not dropped
+	for i := uint64(1); i <= 3; i++ {
+		deliver(&event.Event{Kind: "ObjectOrchestrationEnd", ID: i, Data: []byte(`{}`)})
+	}
+	require.Eventually(t, func() bool {
+		b, err := os.ReadFile(out)
+		return err == nil && strings.Count(string(b), "\n") == 3
+	}, 5*time.Second, 50*time.Millisecond)
+	b, err := os.ReadFile(out)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	for i, line := range lines {
+		require.Contains(t, line, `"id":`+strconv.Itoa(i+1), "expected the events executed in order")
+	}
+}
+
+func TestMatchFilters(t *testing.T) {
+	var filters []eventfilter.Filter
+	for _, s := range []string{`ObjectStatusUpdated,path=a,.node="n1"`, `ObjectStatusUpdated,path=b,.node="n2"`} {
+		l, err := eventfilter.Parse(s)
+		require.NoError(t, err)
+		filters = append(filters, l...)
+	}
+	m := &Manager{log: plog.NewDefaultLogger()}
+	cases := []struct {
+		path     string
+		node     string
+		expected bool
+	}{
+		{"a", "n1", true},
+		{"a", "n2", false},
+		{"b", "n2", true},
+		{"b", "n1", false},
+		{"c", "n1", false},
+	}
+	for _, tc := range cases {
+		msg := &msgbus.ObjectStatusUpdated{Node: tc.node}
+		msg.AddLabels(pubsub.Label{"path", tc.path})
+		ev := event.ToEvent(msg, 1)
+		require.Equalf(t, tc.expected, m.matchFilters("hook#1", filters, msg, ev),
+			"expected the labels and data of the same filter matched for path %s node %s", tc.path, tc.node)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -36,8 +37,10 @@ import (
 	"github.com/opensvc/om3/v3/util/file"
 	"github.com/opensvc/om3/v3/util/hostname"
 	"github.com/opensvc/om3/v3/util/key"
+	"github.com/opensvc/om3/v3/util/pg"
 	"github.com/opensvc/om3/v3/util/plog"
 	"github.com/opensvc/om3/v3/util/pubsub"
+	"github.com/opensvc/om3/v3/util/sizeconv"
 )
 
 type (
@@ -77,6 +80,10 @@ var (
 	standbyDefaultRestart = 2
 
 	keyApp              = key.New("DEFAULT", "app")
+	keyHardAffinity     = key.New("DEFAULT", "hard_affinity")
+	keyHardAntiAffinity = key.New("DEFAULT", "hard_anti_affinity")
+	keySoftAffinity     = key.New("DEFAULT", "soft_affinity")
+	keySoftAntiAffinity = key.New("DEFAULT", "soft_anti_affinity")
 	keyChildren         = key.New("DEFAULT", "children")
 	keyDisable          = key.New("DEFAULT", "disable")
 	keyEnv              = key.New("DEFAULT", "env")
@@ -89,8 +96,13 @@ var (
 	keyParents          = key.New("DEFAULT", "parents")
 	keyPool             = key.New("DEFAULT", "pool")
 	keyPlacement        = key.New("DEFAULT", "placement")
+	keyPlacementWeights = key.New("DEFAULT", "placement_weights")
+	keyPGCPUs           = key.New("DEFAULT", "pg_cpus")
+	keyPGMemLimit       = key.New("DEFAULT", "pg_mem_limit")
 	keyPreMonitorAction = key.New("DEFAULT", "pre_monitor_action")
 	keyPriority         = key.New("DEFAULT", "priority")
+	keyRequestedCPUs    = key.New("DEFAULT", "requested_cpus")
+	keyRequestedMem     = key.New("DEFAULT", "requested_mem")
 	keySize             = key.New("DEFAULT", "size")
 	keyTopology         = key.New("DEFAULT", "topology")
 	keyStonith          = key.New("DEFAULT", "stonith")
@@ -353,6 +365,7 @@ func (t *Manager) configFileCheck() error {
 	cfg.Scope = scope
 	cfg.UpdatedAt = mtime
 	cfg.Priority = t.getPriority(cf)
+	cfg.Labels = cf.SectionMap("labels")
 	if t.path.Kind == naming.KindVol {
 		cfg.VolConfig = &instance.VolConfig{
 			Pool: cf.GetString(keyPool),
@@ -363,6 +376,7 @@ func (t *Manager) configFileCheck() error {
 	}
 	if actor, ok := any(t.configure).(object.Actor); ok {
 		cfg.ActorConfig = &instance.ActorConfig{
+			Affinity:         t.getAffinity(cf),
 			App:              cf.GetString(keyApp),
 			Children:         t.getChildren(cf),
 			Env:              cf.GetString(keyEnv),
@@ -372,6 +386,8 @@ func (t *Manager) configFileCheck() error {
 			Parents:          t.getParents(cf),
 			PreMonitorAction: cf.GetString(keyPreMonitorAction),
 			PlacementPolicy:  t.getPlacementPolicy(cf),
+			PlacementWeights: t.getPlacementWeights(cf),
+			Requested:        t.getRequested(cf),
 			Resources:        t.getResources(cf),
 			Schedules:        make([]schedule.Config, 0),
 			Subsets:          t.getSubsets(cf),
@@ -438,11 +454,76 @@ func (t *Manager) getParents(cf *xconfig.T) naming.Relations {
 	return naming.ParseRelations(l, t.path.Namespace)
 }
 
+// getAffinity returns the object placement constraints, or nil if the object
+// has no constraint.
+func (t *Manager) getAffinity(cf *xconfig.T) *placement.Affinity {
+	paths := func(k key.T) []string {
+		return naming.ParseRelations(cf.GetStrings(k), t.path.Namespace).Strings()
+	}
+	affinity := placement.Affinity{
+		Hard:     paths(keyHardAffinity),
+		HardAnti: paths(keyHardAntiAffinity),
+		Soft:     paths(keySoftAffinity),
+		SoftAnti: paths(keySoftAntiAffinity),
+	}
+	if affinity.IsZero() {
+		return nil
+	}
+	return &affinity
+}
+
 func (t *Manager) getPlacementPolicy(cf *xconfig.T) placement.Policy {
 	s := cf.GetString(keyPlacement)
 	return placement.NewPolicy(s)
 }
 
+func (t *Manager) getPlacementWeights(cf *xconfig.T) placement.Weights {
+	weights, err := placement.ParseWeights(cf.GetStrings(keyPlacementWeights))
+	if err != nil {
+		t.log.Warnf("get placement_weights value: %s", err)
+	}
+	return weights
+}
+
+// getRequested returns the resources requested by the object instances, or
+// nil if the object requests no resource.
+//
+// The requested cpus default to the DEFAULT.pg_cpus cpuset size, and the
+// requested memory defaults to DEFAULT.pg_mem_limit.
+func (t *Manager) getRequested(cf *xconfig.T) *placement.Resources {
+	var requested placement.Resources
+	if s, _ := cf.EvalNoConv(keyRequestedCPUs); s != "" {
+		if f, err := strconv.ParseFloat(s, 64); err != nil {
+			t.log.Warnf("get requested_cpus value: %s", err)
+		} else {
+			requested.CPUs = f
+		}
+	} else if s, _ := cf.EvalNoConv(keyPGCPUs); s != "" {
+		if n, err := pg.CPUSet(s).Count(); err != nil {
+			t.log.Warnf("get pg_cpus value: %s", err)
+		} else {
+			requested.CPUs = float64(n)
+		}
+	}
+	if s, _ := cf.EvalNoConv(keyRequestedMem); s != "" {
+		if n, err := sizeconv.FromSize(s); err != nil {
+			t.log.Warnf("get requested_mem value: %s", err)
+		} else {
+			requested.Mem = n
+		}
+	} else if s, _ := cf.EvalNoConv(keyPGMemLimit); s != "" {
+		if n, err := sizeconv.FromSize(s); err != nil {
+			t.log.Warnf("get pg_mem_limit value: %s", err)
+		} else {
+			requested.Mem = n
+		}
+	}
+	if requested.IsZero() {
+		return nil
+	}
+	return &requested
+}
+
 func (t *Manager) getTopology(cf *xconfig.T) topology.T {
 	s := cf.GetString(keyTopology)
 	return topology.New(s)
@@ -0,0 +1,118 @@
+package imon
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/placement"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+// affinity returns the object placement constraints, or nil if the object
+// has none.
+func (t *Manager) affinity() *placement.Affinity {
+	if t.instConfig.ActorConfig == nil {
+		return nil
+	}
+	return t.instConfig.Affinity
+}
+
+// affinityRunning returns the nodes where the objects referenced by the
+// affinity constraints have a running instance.
+func (t *Manager) affinityRunning(affinity placement.Affinity) placement.Running {
+	running := make(placement.Running)
+	for _, s := range affinity.Paths() {
+		p, err := naming.ParsePath(s)
+		if err != nil {
+			continue
+		}
+		for nodename, instStatus := range instance.StatusData.GetByPath(p) {
+			if instStatus.Avail.Is(status.Up, status.Warn) {
+				running[s] = append(running[s], nodename)
+			}
+		}
+	}
+	return running
+}
+
+// applyAffinity returns the candidates not excluded by the hard affinity
+// constraints, with the candidates violating soft affinity constraints moved
+// last. The reasons map explains the constraints violated by each excluded or
+// penalized candidate.
+func (t *Manager) applyAffinity(candidates []string) ([]string, map[string]string) {
+	affinity := t.affinity()
+	if affinity == nil {
+		return candidates, nil
+	}
+	return affinity.Apply(candidates, t.affinityRunning(*affinity))
+}
+
+// updatePlacementReason sets the instance monitor placement reason when the
+// affinity constraints or the capacity admission control exclude or penalize
+// some of the preferred ha candidates.
+func (t *Manager) updatePlacementReason() {
+	var reason string
+	if t.hasPlacementConstraints() {
+		preferred := t.newHACandidates()
+		selected, reasons := t.applyPlacementConstraints(preferred)
+		maxLeaders := t.maxHALeaders()
+		var l []string
+		for i, nodename := range preferred {
+			if i >= maxLeaders {
+				break
+			}
+			if i := slices.Index(selected, nodename); i < 0 || i >= maxLeaders {
+				l = append(l, fmt.Sprintf("%s: %s", nodename, reasons[nodename]))
+			}
+		}
+		reason = strings.Join(l, "; ")
+	}
+	if reason != t.state.PlacementReason {
+		t.state.PlacementReason = reason
+		t.change = true
+	}
+}
+
+// janitorAffinity subscribes to the instance status updates and deletes of
+// the objects referenced by the affinity constraints, so the placement is
+// re-evaluated when they move, and unsubscribes from the objects no longer
+// referenced.
+func (t *Manager) janitorAffinity() {
+	var paths []string
+	if affinity := t.affinity(); affinity != nil {
+		paths = affinity.Paths()
+	}
+	for _, s := range paths {
+		if _, ok := t.affinityPaths[s]; ok {
+			continue
+		}
+		t.log.Infof("affinity subscribe to %s instance status updates and deletes", s)
+		t.sub.AddFilter(&msgbus.InstanceStatusUpdated{}, pubsub.Label{"path", s})
+		t.sub.AddFilter(&msgbus.InstanceStatusDeleted{}, pubsub.Label{"path", s})
+		t.affinityPaths[s] = struct{}{}
+	}
+	for s := range t.affinityPaths {
+		if slices.Contains(paths, s) {
+			continue
+		}
+		t.log.Infof("affinity unsubscribe from %s instance status updates and deletes", s)
+		t.sub.DelFilter(&msgbus.InstanceStatusUpdated{}, pubsub.Label{"path", s})
+		t.sub.DelFilter(&msgbus.InstanceStatusDeleted{}, pubsub.Label{"path", s})
+		delete(t.affinityPaths, s)
+	}
+}
+
+// onAffinityInstanceStatusChanged re-evaluates the placement when an object
+// referenced by the affinity constraints changes.
+func (t *Manager) onAffinityInstanceStatusChanged(p naming.Path) {
+	if _, ok := t.affinityPaths[p.String()]; !ok {
+		return
+	}
+	t.log.Tracef("affinity %s instance status changed", p)
+	t.onChange()
+}
@@ -0,0 +1,63 @@
+package imon
+
+import (
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/core/placement"
+	"github.com/opensvc/om3/v3/core/status"
+)
+
+// requested returns the resources requested by the object instances, or nil
+// if the object requests no resource.
+func (t *Manager) requested() *placement.Resources {
+	if t.instConfig.ActorConfig == nil {
+		return nil
+	}
+	return t.instConfig.Requested
+}
+
+// applyCapacity returns the candidates with enough free resources to host an
+// instance. The reasons map explains why each rejected candidate was rejected.
+//
+// The candidates already running an instance are always admitted, so an
+// overcommitted node doesn't cause a running instance failover.
+func (t *Manager) applyCapacity(candidates []string) ([]string, map[string]string) {
+	requested := t.requested()
+	if requested == nil {
+		return candidates, nil
+	}
+	capacities := node.GetCapacities(t.path)
+	for nodename, instStatus := range t.instStatus {
+		if instStatus.Avail.Is(status.Up, status.Warn) {
+			delete(capacities, nodename)
+		}
+	}
+	return placement.AdmitCandidates(candidates, capacities, *requested)
+}
+
+// applyPlacementConstraints applies the affinity constraints and the capacity
+// admission control to the candidates. The reasons map explains the
+// constraints violated by each excluded or penalized candidate.
+func (t *Manager) applyPlacementConstraints(candidates []string) ([]string, map[string]string) {
+	candidates, reasons := t.applyAffinity(candidates)
+	candidates, capacityReasons := t.applyCapacity(candidates)
+	if len(capacityReasons) == 0 {
+		return candidates, reasons
+	}
+	if reasons == nil {
+		return candidates, capacityReasons
+	}
+	for nodename, reason := range capacityReasons {
+		if s, ok := reasons[nodename]; ok {
+			reasons[nodename] = s + ", " + reason
+		} else {
+			reasons[nodename] = reason
+		}
+	}
+	return candidates, reasons
+}
+
+// hasPlacementConstraints returns true if the object has affinity constraints
+// or requests resources.
+func (t *Manager) hasPlacementConstraints() bool {
+	return t.affinity() != nil || t.requested() != nil
+}
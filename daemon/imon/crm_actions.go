@@ -177,10 +177,6 @@ func (t *Manager) crmStatus() error {
 	return t.crmAction("status", t.path.String(), "instance", "status", "-r")
 }
 
-func (t *Manager) crmStonith(nodename string) error {
-	return t.crmAction("stonith", "node", "stonith", "--node", nodename)
-}
-
 func (t *Manager) crmStopMoveToFunc(dst string) func() error {
 	return func() error {
 		return t.crmStopMoveTo(dst)
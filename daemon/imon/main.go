@@ -170,6 +170,11 @@ type (
 		// standbyResourceOrchestrate is the orchestrationResource for regular resources
 		regularResourceOrchestrate orchestrationResource
 
+		// affinityPaths is the set of object paths referenced by the affinity
+		// constraints, whose instance status updates and deletes we are
+		// subscribed to.
+		affinityPaths map[string]struct{}
+
 		// isPeerFrozenMerged remembers we already mirrored locally a peer instance freeze
 		// that happened during this daemon last blackout (crash time => rejoin).
 		// i.e. this boolean shortcuts the t.mergePeerFrozen func.
@@ -255,6 +260,7 @@ func start(parent context.Context, qs pubsub.QueueSizer, p naming.Path, nodes []
 		nodeStats:     make(map[string]node.Stats),
 		nodeStatus:    make(map[string]node.Status),
 		priors:        make([]string, 0),
+		affinityPaths: make(map[string]struct{}),
 		localhost:     localhost,
 		scopeNodes:    nodes,
 		change:        true,
@@ -378,6 +384,7 @@ func (t *Manager) worker(initialNodes []string) {
 
 	t.mergePeerFrozen()
 	t.initRelationAvailStatus()
+	t.janitorAffinity()
 	t.initResourceMonitor()
 	t.initLocalResourceFiles()
 	t.updateIsLeader()
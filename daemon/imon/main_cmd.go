@@ -14,6 +14,7 @@ import (
 
 	"github.com/opensvc/om3/v3/core/instance"
 	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/node"
 	"github.com/opensvc/om3/v3/core/nodeselector"
 	"github.com/opensvc/om3/v3/core/object"
 	"github.com/opensvc/om3/v3/core/placement"
@@ -170,6 +171,7 @@ func (t *Manager) onRelationInstanceStatusDeleted(c *msgbus.InstanceStatusDelete
 		// Can't relate to self.
 		return
 	}
+	t.onAffinityInstanceStatusChanged(c.Path)
 	changes := false
 	do := func(relation string, name string, cache map[string]status.T) {
 		if _, ok := cache[relation]; ok {
@@ -220,6 +222,7 @@ func (t *Manager) onRelationInstanceStatusUpdated(c *msgbus.InstanceStatusUpdate
 		// Can't relate to self. This case is handled by onInstanceStatusUpdated.
 		return
 	}
+	t.onAffinityInstanceStatusChanged(c.Path)
 	changes := false
 	relation := c.Path.String() + "@" + c.Node
 	do := func(relation string, name string, cache map[string]status.T) {
@@ -368,6 +371,7 @@ func (t *Manager) onLocalInstanceConfigUpdated(srcCmd *msgbus.InstanceConfigUpda
 		janitorRelations(srcCmd.Value.ActorConfig.Children, "Child", t.state.Children)
 		janitorRelations(srcCmd.Value.ActorConfig.Parents, "Parent", t.state.Parents)
 	}
+	t.janitorAffinity()
 	// config has changed refresh resource monitor states
 	t.requestStatusRefresh(t.instConfig.Priority)
 }
@@ -668,7 +672,16 @@ func (t *Manager) onNodeStatusUpdated(c *msgbus.NodeStatusUpdated) {
 
 func (t *Manager) onNodeStatsUpdated(c *msgbus.NodeStatsUpdated) {
 	t.nodeStats[c.Node] = c.Value
-	if t.objStatus.ActorStatus != nil && t.objStatus.ActorStatus.PlacementPolicy == placement.Score {
+	if t.objStatus.ActorStatus == nil {
+		return
+	}
+	if t.requested() != nil {
+		// the node capacity is re-evaluated on each stats update
+		t.onChange()
+		return
+	}
+	switch t.objStatus.ActorStatus.PlacementPolicy {
+	case placement.Score, placement.LoadAvg, placement.Weighted:
 		t.onChange()
 	}
 }
@@ -829,6 +842,10 @@ func (t *Manager) sortCandidates(candidates []string) []string {
 		return t.sortWithShiftPolicy(candidates)
 	case placement.LastStart:
 		return t.sortWithLastStartPolicy(candidates)
+	case placement.LoadAvg:
+		return t.sortWithLoadAvgPolicy(candidates)
+	case placement.Weighted:
+		return t.sortWithWeightedPolicy(candidates)
 	default:
 		return []string{}
 	}
@@ -862,6 +879,7 @@ func (t *Manager) sortWithScorePolicy(candidates []string) []string {
 	return l
 }
 
+// sortWithLoadAvgPolicy sorts candidates by ascending cluster.NodeStats.Load15M
 func (t *Manager) sortWithLoadAvgPolicy(candidates []string) []string {
 	l := append([]string{}, candidates...)
 	sort.SliceStable(l, func(i, j int) bool {
@@ -872,11 +890,73 @@ func (t *Manager) sortWithLoadAvgPolicy(candidates []string) []string {
 		if stats, ok := t.nodeStats[l[j]]; ok {
 			sj = stats.Load15M
 		}
-		return si > sj
+		return si < sj
 	})
 	return l
 }
 
+// sortWithWeightedPolicy sorts candidates by descending weighted placement
+// score. The scores are computed relative to all the scope nodes, so the
+// candidates relative order does not depend on the candidates subset.
+func (t *Manager) sortWithWeightedPolicy(candidates []string) []string {
+	return t.newPlacementScores().Sort(t.sortWithNodesOrderPolicy(candidates))
+}
+
+// newPlacementScores returns the weighted placement scores of the scope nodes.
+func (t *Manager) newPlacementScores() placement.Scores {
+	if t.instConfig.ActorConfig == nil {
+		return placement.Scores{}
+	}
+	weights := t.instConfig.PlacementWeights
+	needInstances := slices.ContainsFunc(weights, func(w placement.Weight) bool {
+		return w.Name == "instances"
+	})
+	env := &placement.Env{
+		Candidates: t.scopeNodes,
+		Nodes:      make(map[string]placement.NodeInfo),
+	}
+	for _, nodename := range t.scopeNodes {
+		var info placement.NodeInfo
+		if stats, ok := t.nodeStats[nodename]; ok {
+			info.Load15M = stats.Load15M
+			info.MemAvailPct = stats.MemAvailPct
+			info.MemTotalMB = stats.MemTotalMB
+		}
+		if instStatus, ok := t.instStatus[nodename]; ok {
+			info.LastStartedAt = instStatus.LastStartedAt
+		}
+		if nodeConfig := node.ConfigData.GetByNode(nodename); nodeConfig != nil {
+			info.Labels = nodeConfig.Labels
+		}
+		if needInstances {
+			for _, instStatus := range instance.StatusData.GetByNode(nodename) {
+				if instStatus.Avail == status.Up {
+					info.Instances++
+				}
+			}
+		}
+		env.Nodes[nodename] = info
+	}
+	return weights.Score(env)
+}
+
+// updatePlacementScores refreshes the weighted placement scores exposed in the
+// instance monitor.
+func (t *Manager) updatePlacementScores() {
+	if t.objStatus.PlacementPolicy != placement.Weighted {
+		if t.state.PlacementScores != nil {
+			t.state.PlacementScores = nil
+			t.change = true
+		}
+		return
+	}
+	scores := t.newPlacementScores()
+	if !scores.Equal(t.state.PlacementScores) {
+		t.state.PlacementScores = scores
+		t.change = true
+	}
+}
+
 func (t *Manager) sortWithLastStartPolicy(candidates []string) []string {
 	l := append([]string{}, candidates...)
 	sort.SliceStable(l, func(i, j int) bool {
@@ -927,7 +1007,15 @@ func (t *Manager) nextPlacedAtCandidates(want []string) (string, error) {
 		}
 		wantNodes = append(wantNodes, node)
 	}
-	return strings.Join(wantNodes, ","), nil
+	candidates, reasons := t.applyPlacementConstraints(wantNodes)
+	if len(wantNodes) > 0 && len(candidates) == 0 {
+		l := make([]string, len(wantNodes))
+		for i, node := range wantNodes {
+			l[i] = fmt.Sprintf("%s: %s", node, reasons[node])
+		}
+		return "", fmt.Errorf("excluded by placement constraints: %s", strings.Join(l, "; "))
+	}
+	return strings.Join(candidates, ","), nil
 }
 
 func (t *Manager) nextPlacedAtCandidate() string {
@@ -937,6 +1025,7 @@ func (t *Manager) nextPlacedAtCandidate() string {
 	var candidates []string
 	candidates = append(candidates, t.scopeNodes...)
 	candidates = t.sortCandidates(candidates)
+	candidates, _ = t.applyPlacementConstraints(candidates)
 
 	for _, candidate := range candidates {
 		if instStatus, ok := t.instStatus[candidate]; ok {
@@ -983,7 +1072,9 @@ func (t *Manager) IsNodeMonitorStatusRankable(node string) (bool, bool) {
 	return nodeMonitor.State.IsRankable(), true
 }
 
-func (t *Manager) newIsHALeader() bool {
+// newHACandidates returns the sorted list of nodes that can host an ha leader
+// instance, without the affinity constraints applied.
+func (t *Manager) newHACandidates() []string {
 	var candidates []string
 
 	for _, node := range t.scopeNodes {
@@ -1007,18 +1098,26 @@ func (t *Manager) newIsHALeader() bool {
 		}
 		candidates = append(candidates, node)
 	}
-	candidates = t.sortCandidates(candidates)
+	return t.sortCandidates(candidates)
+}
 
-	var maxLeaders int = 1
+// maxHALeaders returns the number of instances expected to be started by the
+// ha orchestration.
+func (t *Manager) maxHALeaders() int {
 	if t.objStatus.Topology == topology.Flex {
-		maxLeaders = t.objStatus.Flex.Target
+		return t.objStatus.Flex.Target
 	}
+	return 1
+}
+
+func (t *Manager) newIsHALeader() bool {
+	candidates, _ := t.applyPlacementConstraints(t.newHACandidates())
 
 	i := stringslice.Index(t.localhost, candidates)
 	if i < 0 {
 		return false
 	}
-	return i < maxLeaders
+	return i < t.maxHALeaders()
 }
 
 func (t *Manager) newIsLeader() bool {
@@ -1050,6 +1149,8 @@ func (t *Manager) updateIsLeader() {
 	if t.objStatus.ActorStatus == nil {
 		return
 	}
+	t.updatePlacementScores()
+	t.updatePlacementReason()
 	isLeader := t.newIsLeader()
 	if isLeader != t.state.IsLeader {
 		t.change = true
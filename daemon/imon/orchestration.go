@@ -2,6 +2,7 @@ package imon
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/google/uuid"
@@ -176,6 +177,10 @@ func (t *Manager) setWaitChildren() bool {
 func (t *Manager) endOrchestration() {
 	t.change = true
 
+	if t.orchestrationPending != nil {
+		t.orchestrationPending.FailedNodes = failedNodes(t.AllInstanceMonitors())
+		t.orchestrationPending.Failed = len(t.orchestrationPending.FailedNodes) > 0
+	}
 	defer t.publishOrchestrationEnded()
 
 	t.state.GlobalExpect = instance.MonitorGlobalExpectNone
@@ -187,6 +192,19 @@ func (t *Manager) endOrchestration() {
 	t.logSetOrchestrationID(uuid.Nil)
 }
 
+// failedNodes returns the sorted "<node>: <state>" list of the instance
+// monitors in a failure state.
+func failedNodes(m map[string]instance.Monitor) []string {
+	l := make([]string, 0)
+	for node, mon := range m {
+		if strings.HasSuffix(mon.State.String(), " failed") {
+			l = append(l, node+": "+mon.State.String())
+		}
+	}
+	sort.Strings(l)
+	return l
+}
+
 // doneAndIdle marks the orchestration as done on the local instance and
 // sets the state to idle.
 func (t *Manager) doneAndIdle() {
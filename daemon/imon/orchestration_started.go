@@ -160,14 +160,22 @@ func (t *Manager) startedFromReady() {
 			t.log.Tracef("stonith: %s already fenced", t.peerDrop)
 			return nil
 		}
-		nodeStonithAtMap[t.peerDrop] = t.peerDropAt
 		t.log.Infof("stonith: %s: fence", t.peerDrop)
-		return t.crmStonith(t.peerDrop)
+		if err := t.fence(t.peerDrop); err != nil {
+			return err
+		}
+		nodeStonithAtMap[t.peerDrop] = t.peerDropAt
+		return nil
 	}
 
+	// stonithAndStart aborts the takeover if the peer is not fenced, as
+	// starting the instance could corrupt the data still accessed by the
+	// peer.
 	stonithAndStart := func() error {
+		peer := t.peerDrop
 		if err := stonith(); err != nil {
-			t.log.Warnf("stonith: %s: %s", t.peerDrop, err)
+			t.log.Errorf("stonith: %s: abort the takeover: %s", peer, err)
+			return err
 		}
 		return t.crmStart()
 	}
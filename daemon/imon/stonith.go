@@ -4,8 +4,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/opensvc/om3/v3/core/object"
 	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/core/stonith"
 	"github.com/opensvc/om3/v3/core/topology"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
 )
 
 var (
@@ -49,3 +52,17 @@ func (t *Manager) clearStonith(nodename string, avail status.T) {
 	t.log.Tracef("stonith: clear the instance on node %s is started", nodename)
 	t.unsetStonith()
 }
+
+// fence fences the peer node using the fencers configured for it in the
+// node configuration, and publishes the result of each fencing attempt.
+func (t *Manager) fence(nodename string) error {
+	n, err := object.NewNode(object.WithLogger(t.log))
+	if err != nil {
+		return err
+	}
+	onResult := func(result stonith.Result) {
+		t.publisher.Pub(&msgbus.NodeStonithResult{Node: t.localhost, Peer: nodename, Value: result}, t.labelLocalhost)
+	}
+	_, err = n.Stonith(t.ctx, nodename, stonith.WithResultFunc(onResult))
+	return err
+}
@@ -0,0 +1,489 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	// Batch defines the orchestration of an action on the objects of a
+	// registered batch job.
+	Batch struct {
+		ID     uuid.UUID
+		Action instance.MonitorGlobalExpect
+
+		// Rollback is the inverse of Action, set on the objects already
+		// acted on when an object fails in all-or-nothing mode.
+		Rollback instance.MonitorGlobalExpect
+
+		AllOrNothing bool
+
+		// Reached reports if the object p is already in the state the
+		// global expect leads to. The objects already in the batch action
+		// state before the batch are not rolled back. Nil means no object
+		// is in the batch action state.
+		Reached func(p naming.Path, globalExpect instance.MonitorGlobalExpect) bool
+
+		// Timeout is the maximum duration of an object orchestration.
+		// The orchestrations timing out are aborted. Zero means no limit.
+		Timeout time.Duration
+
+		// Order is the priority waves order: OrderAsc, OrderDesc or
+		// OrderNone.
+		Order string
+
+		// Parallel is the maximum number of objects orchestrated at the
+		// same time.
+		Parallel int
+
+		// Submit sets the global expect of the object p, and returns the
+		// node the orchestration was submitted to and the orchestration id.
+		Submit func(ctx context.Context, p naming.Path, globalExpect instance.MonitorGlobalExpect) (string, uuid.UUID, error)
+	}
+
+	batchRunner struct {
+		Batch
+		registry *Registry
+		log      *plog.Logger
+		paths    []naming.Path
+		members  map[naming.Path]bool
+		failed   atomic.Bool
+		endOnce  sync.Once
+
+		// unchanged flags the objects already in the batch action state
+		// before the batch, indexed like paths.
+		unchanged []bool
+
+		mu sync.Mutex
+
+		// ended is the outcome of the ended orchestrations nobody waits for
+		// yet, indexed by orchestration id.
+		ended map[uuid.UUID]outcome
+
+		// states is the last instance monitor state of each node, indexed
+		// by orchestration id.
+		states map[uuid.UUID]map[string]string
+
+		waiters map[uuid.UUID]chan outcome
+
+		// done flags the orchestrations whose outcome was delivered or is
+		// no longer waited for, so a late or duplicate end event is not
+		// kept as an outcome.
+		done map[uuid.UUID]bool
+	}
+
+	outcome struct {
+		state  string
+		result string
+	}
+)
+
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+	OrderNone = "none"
+
+	ObjectAborted    = "aborted"
+	ObjectCancelled  = "cancelled"
+	ObjectFailed     = "failed"
+	ObjectPending    = "pending"
+	ObjectRefused    = "refused"
+	ObjectRolledBack = "rolledback"
+	ObjectRunning    = "running"
+	ObjectSkipped    = "skipped"
+	ObjectSucceeded  = "succeeded"
+)
+
+var (
+	// batchAbortTimeout is the maximum duration of the abort submission of
+	// the running objects of a cancelled batch.
+	batchAbortTimeout = 5 * time.Second
+
+	runningBatches = struct {
+		sync.Mutex
+		m map[uuid.UUID]context.CancelFunc
+	}{m: make(map[uuid.UUID]context.CancelFunc)}
+)
+
+// CancelBatch cancels the running batch job with the id: no more object is
+// orchestrated, and the running object orchestrations are aborted. It
+// returns false if no batch with the id is running.
+func CancelBatch(id uuid.UUID) bool {
+	runningBatches.Lock()
+	defer runningBatches.Unlock()
+	cancel, ok := runningBatches.m[id]
+	if ok {
+		cancel()
+	}
+	return ok
+}
+
+// RunBatch orchestrates the batch action on the objects of the batch job
+// registered in the registry, by waves of objects of same priority. The
+// orchestration end events are read from c. RunBatch returns when the job
+// is ended.
+func RunBatch(ctx context.Context, registry *Registry, b Batch, c <-chan any, log *plog.Logger) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	runningBatches.Lock()
+	runningBatches.m[b.ID] = cancel
+	runningBatches.Unlock()
+	defer func() {
+		runningBatches.Lock()
+		delete(runningBatches.m, b.ID)
+		runningBatches.Unlock()
+	}()
+
+	t := &batchRunner{
+		Batch:    b,
+		registry: registry,
+		log:      log,
+		members:  make(map[naming.Path]bool),
+		ended:    make(map[uuid.UUID]outcome),
+		states:   make(map[uuid.UUID]map[string]string),
+		waiters:  make(map[uuid.UUID]chan outcome),
+		done:     make(map[uuid.UUID]bool),
+	}
+	if t.Parallel < 1 {
+		t.Parallel = 1
+	}
+	j, err := registry.Get(b.ID)
+	if err != nil {
+		log.Warnf("batch job %s: %s", b.ID, err)
+		return
+	}
+	var objects []api.JobObject
+	if j.Objects != nil {
+		objects = *j.Objects
+	}
+	for _, o := range objects {
+		p, err := naming.ParsePath(o.Path)
+		if err != nil {
+			t.end(StateFailed, fmt.Sprintf("invalid path %s: %s", o.Path, err))
+			return
+		}
+		t.paths = append(t.paths, p)
+		t.members[p] = true
+		t.unchanged = append(t.unchanged, b.Reached != nil && b.Reached(p, b.Action))
+	}
+
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+	go t.watch(watchCtx, c)
+
+	waves := batchWaves(objects, b.Order)
+	for _, wave := range waves {
+		t.runWave(ctx, wave, b.Action, t.runObject)
+	}
+
+	rolledBack := false
+	if t.failed.Load() && t.AllOrNothing && ctx.Err() == nil {
+		for i := len(waves) - 1; i >= 0; i-- {
+			t.runWave(ctx, waves[i], b.Rollback, t.rollbackObject)
+		}
+		rolledBack = true
+	}
+
+	j, err = registry.Get(b.ID)
+	if err != nil {
+		log.Warnf("batch job %s: %s", b.ID, err)
+		return
+	}
+	var failed int
+	for _, o := range *j.Objects {
+		switch o.State {
+		case ObjectFailed, ObjectRefused, ObjectAborted:
+			failed++
+		}
+	}
+	switch {
+	case ctx.Err() != nil:
+		t.end(StateCancelled, "")
+	case failed > 0 || t.failed.Load():
+		result := fmt.Sprintf("%d/%d objects failed", failed, len(objects))
+		if rolledBack {
+			result += ", rolled back"
+		}
+		t.end(StateFailed, result)
+	default:
+		t.end(StateSucceeded, "")
+	}
+}
+
+// batchWaves returns the object indexes grouped by priority, in the
+// orchestration order.
+func batchWaves(objects []api.JobObject, order string) [][]int {
+	if len(objects) == 0 {
+		return nil
+	}
+	if order == OrderNone {
+		wave := make([]int, len(objects))
+		for i := range objects {
+			wave[i] = i
+		}
+		return [][]int{wave}
+	}
+	m := make(map[int][]int)
+	priorities := make([]int, 0)
+	for i, o := range objects {
+		if _, ok := m[o.Priority]; !ok {
+			priorities = append(priorities, o.Priority)
+		}
+		m[o.Priority] = append(m[o.Priority], i)
+	}
+	sort.Ints(priorities)
+	if order == OrderDesc {
+		sort.Sort(sort.Reverse(sort.IntSlice(priorities)))
+	}
+	waves := make([][]int, len(priorities))
+	for i, priority := range priorities {
+		waves[i] = m[priority]
+	}
+	return waves
+}
+
+// runWave runs fn on the wave objects, with at most Parallel objects at
+// the same time, and returns when all the objects are done.
+func (t *batchRunner) runWave(ctx context.Context, wave []int, globalExpect instance.MonitorGlobalExpect, fn func(context.Context, int, instance.MonitorGlobalExpect)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, t.Parallel)
+	for _, i := range wave {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(ctx, i, globalExpect)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// runObject orchestrates the batch action on the object at index i,
+// unless the batch is cancelled, or stopped by a failure in all-or-nothing
+// mode.
+func (t *batchRunner) runObject(ctx context.Context, i int, globalExpect instance.MonitorGlobalExpect) {
+	if ctx.Err() != nil || (t.AllOrNothing && t.failed.Load()) {
+		t.setObject(i, ObjectSkipped, "")
+		return
+	}
+	p := t.paths[i]
+	t.setObject(i, ObjectRunning, "")
+	node, id, err := t.Submit(ctx, p, globalExpect)
+	if ctx.Err() != nil {
+		t.setObject(i, ObjectCancelled, "")
+		return
+	} else if err != nil {
+		t.failed.Store(true)
+		t.setObject(i, ObjectFailed, err.Error())
+		return
+	}
+	_ = t.registry.SetObject(t.ID, i, func(o *api.JobObject) {
+		o.Node = &node
+		o.OrchestrationID = &id
+	})
+	o, err := t.wait(ctx, id)
+	switch {
+	case ctx.Err() != nil:
+		t.abort(p)
+		t.setObject(i, ObjectCancelled, "")
+		return
+	case err != nil:
+		t.abort(p)
+		t.failed.Store(true)
+		t.setObject(i, ObjectFailed, err.Error())
+		return
+	}
+	if o.state != ObjectSucceeded {
+		t.failed.Store(true)
+	}
+	t.setObject(i, o.state, o.result)
+}
+
+// rollbackObject orchestrates the rollback action on the object at index
+// i, if the batch action succeeded on this object and changed its state.
+func (t *batchRunner) rollbackObject(ctx context.Context, i int, globalExpect instance.MonitorGlobalExpect) {
+	j, err := t.registry.Get(t.ID)
+	if err != nil || (*j.Objects)[i].State != ObjectSucceeded {
+		return
+	}
+	p := t.paths[i]
+	if t.unchanged[i] {
+		t.setObject(i, ObjectSucceeded, fmt.Sprintf("already %s before the batch, not rolled back", t.Action))
+		return
+	}
+	node, id, err := t.Submit(ctx, p, globalExpect)
+	if err != nil {
+		t.setObject(i, ObjectFailed, "rollback: "+err.Error())
+		return
+	}
+	o, err := t.wait(ctx, id)
+	switch {
+	case ctx.Err() != nil:
+		t.abort(p)
+		t.setObject(i, ObjectCancelled, "rollback cancelled")
+	case err != nil:
+		t.abort(p)
+		t.setObject(i, ObjectFailed, "rollback: "+err.Error())
+	case o.state == ObjectSucceeded:
+		_ = t.registry.SetObject(t.ID, i, func(o *api.JobObject) {
+			o.Node = &node
+			o.OrchestrationID = &id
+		})
+		t.setObject(i, ObjectRolledBack, "")
+	default:
+		t.setObject(i, ObjectFailed, fmt.Sprintf("rollback %s: %s", o.state, o.result))
+	}
+}
+
+// abort sets the aborted global expect on the object p, whose
+// orchestration was cancelled.
+func (t *batchRunner) abort(p naming.Path) {
+	ctx, cancel := context.WithTimeout(context.Background(), batchAbortTimeout)
+	defer cancel()
+	if _, _, err := t.Submit(ctx, p, instance.MonitorGlobalExpectAborted); err != nil {
+		t.log.Warnf("batch job %s: %s: abort: %s", t.ID, p, err)
+	}
+}
+
+func (t *batchRunner) setObject(i int, state, result string) {
+	err := t.registry.SetObject(t.ID, i, func(o *api.JobObject) {
+		o.State = state
+		if result == "" {
+			o.Result = nil
+		} else {
+			o.Result = &result
+		}
+	})
+	if err != nil {
+		t.log.Warnf("batch job %s: set object %s: %s", t.ID, t.paths[i], err)
+	}
+}
+
+// end ends the batch job. Only the first call has effect, so the job end
+// is published once.
+func (t *batchRunner) end(state api.JobState, result string) {
+	t.endOnce.Do(func() {
+		if err := t.registry.End(t.ID, state, result); err != nil {
+			t.log.Warnf("batch job %s: end: %s", t.ID, err)
+			return
+		}
+		t.log.Infof("batch job %s ended: %s", t.ID, state)
+	})
+}
+
+// wait returns the outcome of the orchestration with the id, or an error
+// if ctx is done or the batch timeout expires before the orchestration
+// end.
+func (t *batchRunner) wait(ctx context.Context, id uuid.UUID) (outcome, error) {
+	var timeout <-chan time.Time
+	if t.Timeout > 0 {
+		timer := time.NewTimer(t.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	t.mu.Lock()
+	if o, ok := t.ended[id]; ok {
+		delete(t.ended, id)
+		t.done[id] = true
+		t.mu.Unlock()
+		return o, nil
+	}
+	c := make(chan outcome, 1)
+	t.waiters[id] = c
+	t.mu.Unlock()
+	select {
+	case o := <-c:
+		return o, nil
+	case <-ctx.Done():
+		t.mu.Lock()
+		delete(t.waiters, id)
+		t.done[id] = true
+		t.mu.Unlock()
+		return outcome{}, ctx.Err()
+	case <-timeout:
+		t.mu.Lock()
+		delete(t.waiters, id)
+		t.done[id] = true
+		t.mu.Unlock()
+		return outcome{}, fmt.Errorf("timeout after %s", t.Timeout)
+	}
+}
+
+// watch records the orchestration outcomes from the events read from c,
+// until ctx is done.
+func (t *batchRunner) watch(ctx context.Context, c <-chan any) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case i := <-c:
+			t.onEvent(i)
+		}
+	}
+}
+
+func (t *batchRunner) onEvent(i any) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch c := i.(type) {
+	case *msgbus.InstanceMonitorUpdated:
+		id := c.Value.OrchestrationID
+		if id == uuid.Nil || !t.members[c.Path] {
+			return
+		}
+		if _, ok := t.states[id]; !ok {
+			t.states[id] = make(map[string]string)
+		}
+		t.states[id][c.Node] = c.Value.State.String()
+	case *msgbus.ObjectOrchestrationEnd:
+		id, err := uuid.Parse(c.ID)
+		if err != nil || !t.members[c.Path] {
+			return
+		}
+		o := outcome{state: ObjectSucceeded}
+		if c.Aborted {
+			o = outcome{state: ObjectAborted, result: "aborted"}
+		} else if l := failedStates(t.states[id]); len(l) > 0 {
+			o = outcome{state: ObjectFailed, result: strings.Join(l, ", ")}
+		}
+		delete(t.states, id)
+		t.setOutcome(id, o)
+	case *msgbus.ObjectOrchestrationRefused:
+		id, err := uuid.Parse(c.ID)
+		if err != nil || !t.members[c.Path] {
+			return
+		}
+		delete(t.states, id)
+		t.setOutcome(id, outcome{state: ObjectRefused, result: c.Reason})
+	}
+}
+
+// setOutcome notifies the orchestration outcome to its waiter, or keeps
+// it for a later wait, as the orchestration may end before its submission
+// returns. Only the first outcome of an orchestration is kept.
+func (t *batchRunner) setOutcome(id uuid.UUID, o outcome) {
+	if _, ok := t.ended[id]; ok || t.done[id] {
+		return
+	}
+	if c, ok := t.waiters[id]; ok {
+		delete(t.waiters, id)
+		t.done[id] = true
+		c <- o
+		return
+	}
+	t.ended[id] = o
+}
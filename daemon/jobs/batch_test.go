@@ -0,0 +1,164 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+func TestBatchWaves(t *testing.T) {
+	objects := []api.JobObject{
+		{Path: "svc1", Priority: 50},
+		{Path: "svc2", Priority: 10},
+		{Path: "svc3", Priority: 50},
+		{Path: "svc4", Priority: 90},
+	}
+	require.Equal(t, [][]int{{1}, {0, 2}, {3}}, batchWaves(objects, OrderAsc))
+	require.Equal(t, [][]int{{3}, {0, 2}, {1}}, batchWaves(objects, OrderDesc))
+	require.Equal(t, [][]int{{0, 1, 2, 3}}, batchWaves(objects, OrderNone))
+	require.Nil(t, batchWaves(nil, OrderAsc))
+}
+
+func TestRunBatch(t *testing.T) {
+	newBatch := func(t *testing.T, allOrNothing bool, fail string) (*Registry, Batch, chan any, *[]string) {
+		registry := NewRegistry(t.TempDir())
+		id := uuid.New()
+		require.NoError(t, registry.Add(Job{Job: api.Job{
+			ID:     id,
+			Kind:   KindBatch,
+			Node:   "node1",
+			Action: "start",
+			Objects: &[]api.JobObject{
+				{Path: "svc1", Priority: 10, State: ObjectPending},
+				{Path: "svc2", Priority: 20, State: ObjectPending},
+				{Path: "svc3", Priority: 30, State: ObjectPending},
+			},
+		}}))
+		c := make(chan any, 100)
+		var (
+			mu        sync.Mutex
+			submitted []string
+		)
+		submit := func(ctx context.Context, p naming.Path, globalExpect instance.MonitorGlobalExpect) (string, uuid.UUID, error) {
+			mu.Lock()
+			submitted = append(submitted, p.String()+" "+globalExpect.String())
+			mu.Unlock()
+			id := uuid.New()
+			if p.String() == fail {
+				c <- &msgbus.InstanceMonitorUpdated{Path: p, Node: "node1", Value: instance.Monitor{
+					OrchestrationID: id,
+					State:           instance.MonitorStateStartFailure,
+				}}
+			}
+			c <- &msgbus.ObjectOrchestrationEnd{ID: id.String(), Path: p}
+			return "node1", id, nil
+		}
+		b := Batch{
+			ID:           id,
+			Action:       instance.MonitorGlobalExpectStarted,
+			Rollback:     instance.MonitorGlobalExpectStopped,
+			AllOrNothing: allOrNothing,
+			Order:        OrderAsc,
+			Parallel:     2,
+			Submit:       submit,
+		}
+		return registry, b, c, &submitted
+	}
+	objectStates := func(j *Job) []string {
+		l := make([]string, len(*j.Objects))
+		for i, o := range *j.Objects {
+			l[i] = o.State
+		}
+		return l
+	}
+	log := plog.NewDefaultLogger()
+
+	t.Run("all objects succeed in priority order", func(t *testing.T) {
+		registry, b, c, submitted := newBatch(t, false, "")
+		RunBatch(context.Background(), registry, b, c, log)
+		j, err := registry.Get(b.ID)
+		require.NoError(t, err)
+		require.Equal(t, StateSucceeded, j.State)
+		require.Equal(t, []string{ObjectSucceeded, ObjectSucceeded, ObjectSucceeded}, objectStates(j))
+		require.Equal(t, []string{"svc1 started", "svc2 started", "svc3 started"}, *submitted)
+	})
+
+	t.Run("failure without all-or-nothing continues", func(t *testing.T) {
+		registry, b, c, _ := newBatch(t, false, "svc2")
+		RunBatch(context.Background(), registry, b, c, log)
+		j, err := registry.Get(b.ID)
+		require.NoError(t, err)
+		require.Equal(t, StateFailed, j.State)
+		require.Equal(t, []string{ObjectSucceeded, ObjectFailed, ObjectSucceeded}, objectStates(j))
+	})
+
+	t.Run("failure with all-or-nothing rolls back", func(t *testing.T) {
+		registry, b, c, submitted := newBatch(t, true, "svc2")
+		RunBatch(context.Background(), registry, b, c, log)
+		j, err := registry.Get(b.ID)
+		require.NoError(t, err)
+		require.Equal(t, StateFailed, j.State)
+		require.Equal(t, "1/3 objects failed, rolled back", *j.Result)
+		require.Equal(t, []string{ObjectRolledBack, ObjectFailed, ObjectSkipped}, objectStates(j))
+		require.Equal(t, []string{"svc1 started", "svc2 started", "svc1 stopped"}, *submitted)
+	})
+
+	t.Run("all-or-nothing does not roll back the unchanged objects", func(t *testing.T) {
+		registry, b, c, submitted := newBatch(t, true, "svc3")
+		b.Reached = func(p naming.Path, globalExpect instance.MonitorGlobalExpect) bool {
+			return p.String() == "svc1"
+		}
+		RunBatch(context.Background(), registry, b, c, log)
+		j, err := registry.Get(b.ID)
+		require.NoError(t, err)
+		require.Equal(t, StateFailed, j.State)
+		require.Equal(t, []string{ObjectSucceeded, ObjectRolledBack, ObjectFailed}, objectStates(j))
+		require.Equal(t, "already started before the batch, not rolled back", *(*j.Objects)[0].Result)
+		require.Equal(t, []string{"svc1 started", "svc2 started", "svc3 started", "svc2 stopped"}, *submitted)
+	})
+
+	t.Run("orchestration timeout aborts and fails the object", func(t *testing.T) {
+		registry, b, c, submitted := newBatch(t, false, "")
+		submit := b.Submit
+		b.Timeout = 10 * time.Millisecond
+		b.Submit = func(ctx context.Context, p naming.Path, globalExpect instance.MonitorGlobalExpect) (string, uuid.UUID, error) {
+			if p.String() == "svc2" || globalExpect == instance.MonitorGlobalExpectAborted {
+				*submitted = append(*submitted, p.String()+" "+globalExpect.String())
+				return "node1", uuid.New(), nil
+			}
+			return submit(ctx, p, globalExpect)
+		}
+		RunBatch(context.Background(), registry, b, c, log)
+		j, err := registry.Get(b.ID)
+		require.NoError(t, err)
+		require.Equal(t, StateFailed, j.State)
+		require.Equal(t, []string{ObjectSucceeded, ObjectFailed, ObjectSucceeded}, objectStates(j))
+		require.Equal(t, "timeout after 10ms", *(*j.Objects)[1].Result)
+		require.Equal(t, []string{"svc1 started", "svc2 started", "svc2 aborted", "svc3 started"}, *submitted)
+	})
+
+	t.Run("duplicate orchestration end keeps the first outcome", func(t *testing.T) {
+		registry, b, c, _ := newBatch(t, false, "")
+		submit := b.Submit
+		b.Submit = func(ctx context.Context, p naming.Path, globalExpect instance.MonitorGlobalExpect) (string, uuid.UUID, error) {
+			node, id, err := submit(ctx, p, globalExpect)
+			c <- &msgbus.ObjectOrchestrationEnd{ID: id.String(), Path: p, Aborted: true}
+			return node, id, err
+		}
+		RunBatch(context.Background(), registry, b, c, log)
+		j, err := registry.Get(b.ID)
+		require.NoError(t, err)
+		require.Equal(t, StateSucceeded, j.State)
+		require.Equal(t, []string{ObjectSucceeded, ObjectSucceeded, ObjectSucceeded}, objectStates(j))
+	})
+}
@@ -0,0 +1,231 @@
+// Package jobs is the registry of the api-triggered orchestrations,
+// actions and batches hosted by the local node.
+//
+// The api handlers register a job when they accept an orchestration, start
+// an action process or start a batch. The jobs worker then follows the
+// orchestration and action jobs unfolding from the daemon events:
+//
+//   - the instance monitor states of the orchestration nodes, and the
+//     progress of the action processes, are recorded as node steps
+//   - the resource status changes of the job object instances are recorded
+//     as resource steps
+//   - the orchestration end or refusal sets the job terminal state
+//
+// A batch job records the per-object results of an orchestration
+// submitted to many objects, updated by its RunBatch runner.
+//
+// The jobs are persisted in <var>/jobs, so they survive a daemon restart.
+// The ended jobs are purged after the Retention window.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+type (
+	T struct {
+		ctx       context.Context
+		cancel    context.CancelFunc
+		log       *plog.Logger
+		localhost string
+		registry  *Registry
+
+		sub   *pubsub.Subscription
+		subQS pubsub.QueueSizer
+
+		wg sync.WaitGroup
+	}
+)
+
+var (
+	// purgeInterval is the delay between two purges of the ended jobs.
+	purgeInterval = time.Hour
+)
+
+func New(subQS pubsub.QueueSizer) *T {
+	return &T{
+		registry: Default(),
+		subQS:    subQS,
+	}
+}
+
+// Start loads the persisted jobs and launches the jobs worker goroutine
+func (t *T) Start(parent context.Context) error {
+	t.log = plog.NewDefaultLogger().WithPrefix("daemon: jobs: ").Attr("pkg", "daemon/jobs")
+	t.log.Tracef("starting")
+	defer t.log.Tracef("started")
+	t.ctx, t.cancel = context.WithCancel(parent)
+	t.localhost = hostname.Hostname()
+
+	if err := t.registry.Load(); err != nil {
+		t.log.Warnf("load: %s", err)
+	}
+	t.purge(time.Now())
+
+	t.startSubscriptions()
+	running := make(chan bool)
+	t.wg.Add(1)
+	go func() {
+		t.log.Tracef("start")
+		running <- true
+		defer t.log.Tracef("done")
+		defer t.wg.Done()
+		defer func() {
+			if err := t.sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+				t.log.Errorf("subscription stop error %s", err)
+			}
+		}()
+		t.worker()
+	}()
+	<-running
+	return nil
+}
+
+func (t *T) Stop() error {
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
+
+func (t *T) startSubscriptions() {
+	sub := pubsub.SubFromContext(t.ctx, "daemon.jobs", t.subQS)
+	sub.AddFilter(&msgbus.AuditStart{})
+	sub.AddFilter(&msgbus.AuditStop{})
+	sub.AddFilter(&msgbus.InstanceMonitorUpdated{})
+	sub.AddFilter(&msgbus.InstanceStatusUpdated{})
+	sub.AddFilter(&msgbus.ObjectOrchestrationEnd{})
+	sub.AddFilter(&msgbus.ObjectOrchestrationRefused{})
+	sub.AddFilter(&msgbus.ProgressInstanceMonitor{})
+	sub.Start()
+	t.sub = sub
+}
+
+func (t *T) worker() {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case i := <-t.sub.C:
+			switch c := i.(type) {
+			case *msgbus.AuditStart:
+				t.log.HandleAuditStart(c.Q, c.Subsystems, "jobs")
+			case *msgbus.AuditStop:
+				t.log.HandleAuditStop(c.Q, c.Subsystems, "jobs")
+			case *msgbus.InstanceMonitorUpdated:
+				t.onInstanceMonitorUpdated(c)
+			case *msgbus.InstanceStatusUpdated:
+				t.onInstanceStatusUpdated(c)
+			case *msgbus.ObjectOrchestrationEnd:
+				t.onObjectOrchestrationEnd(c)
+			case *msgbus.ObjectOrchestrationRefused:
+				t.onObjectOrchestrationRefused(c)
+			case *msgbus.ProgressInstanceMonitor:
+				t.onProgressInstanceMonitor(c)
+			}
+		case now := <-ticker.C:
+			t.purge(now)
+		}
+	}
+}
+
+func (t *T) purge(now time.Time) {
+	if err := t.registry.Purge(now); err != nil {
+		t.log.Warnf("purge: %s", err)
+	}
+}
+
+// onInstanceMonitorUpdated records the instance monitor state of the
+// nodes running the orchestration as a node step.
+func (t *T) onInstanceMonitorUpdated(c *msgbus.InstanceMonitorUpdated) {
+	id := c.Value.OrchestrationID
+	if id == uuid.Nil {
+		return
+	}
+	if _, err := t.registry.Get(id); err != nil {
+		return
+	}
+	t.addStep(id, c.Node, "", c.Value.State.String())
+}
+
+// onInstanceStatusUpdated records the resource status changes of the
+// running jobs instances as resource steps.
+func (t *T) onInstanceStatusUpdated(c *msgbus.InstanceStatusUpdated) {
+	path := c.Path.String()
+	for _, j := range t.registry.Running() {
+		if j.Path != path {
+			continue
+		}
+		if j.Kind == KindAction && c.Node != j.Node {
+			continue
+		}
+		for rid, rstat := range c.Value.Resources {
+			t.addStep(j.ID, c.Node, rid, rstat.Status.String())
+		}
+	}
+}
+
+func (t *T) onObjectOrchestrationEnd(c *msgbus.ObjectOrchestrationEnd) {
+	id, err := uuid.Parse(c.ID)
+	if err != nil {
+		return
+	}
+	j, err := t.registry.Get(id)
+	if err != nil || j.IsEnded() {
+		return
+	}
+	state, result := StateSucceeded, ""
+	if c.Aborted {
+		state, result = StateAborted, "aborted"
+	} else if l := j.failedNodes(); len(l) > 0 {
+		state, result = StateFailed, strings.Join(l, ", ")
+	}
+	if err := t.registry.End(id, state, result); err != nil {
+		t.log.Warnf("%s: end orchestration job %s: %s", c.Path, id, err)
+		return
+	}
+	t.log.Infof("%s: orchestration job %s ended: %s", c.Path, id, state)
+}
+
+func (t *T) onObjectOrchestrationRefused(c *msgbus.ObjectOrchestrationRefused) {
+	id, err := uuid.Parse(c.ID)
+	if err != nil {
+		return
+	}
+	j, err := t.registry.Get(id)
+	if err != nil || j.IsEnded() {
+		return
+	}
+	if err := t.registry.End(id, StateRefused, c.Reason); err != nil {
+		t.log.Warnf("%s: end orchestration job %s: %s", c.Path, id, err)
+	}
+}
+
+// onProgressInstanceMonitor records the instance monitor state set by
+// the action processes as a node step.
+func (t *T) onProgressInstanceMonitor(c *msgbus.ProgressInstanceMonitor) {
+	id := c.SessionID.UUID()
+	j, err := t.registry.Get(id)
+	if err != nil || j.Kind != KindAction {
+		return
+	}
+	t.addStep(id, c.Node, "", c.State.String())
+}
+
+func (t *T) addStep(id uuid.UUID, node, rid, state string) {
+	if err := t.registry.AddStep(id, node, rid, state); err != nil && !errors.Is(err, ErrEnded) && !errors.Is(err, ErrNotFound) {
+		t.log.Warnf("job %s: add step: %s", id, err)
+	}
+}
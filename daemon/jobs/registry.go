@@ -0,0 +1,471 @@
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+type (
+	// Job is the record of an api-triggered orchestration or action
+	// hosted by the local node.
+	Job struct {
+		api.Job
+
+		// PID is the process id of a running action job.
+		PID int `json:"pid,omitempty"`
+
+		// CancelRequested is set when the job cancel is requested, so the
+		// job end is reported as cancelled instead of aborted or failed.
+		CancelRequested bool `json:"cancel_requested,omitempty"`
+	}
+
+	// Registry is the store of the local jobs, persisted as one json file
+	// per job in its directory.
+	//
+	// The job files are written outside the registry lock, so a slow disk
+	// does not block the registry readers and the events worker.
+	Registry struct {
+		sync.Mutex
+		dir string
+		m   map[uuid.UUID]*Job
+
+		// rev is the revision of the last job snapshot.
+		rev uint64
+
+		// fileMu serializes the job file writes.
+		fileMu sync.Mutex
+
+		// written is the revision of the last written snapshot of each
+		// job file.
+		written map[uuid.UUID]uint64
+	}
+
+	// jobSnapshot is the json encoding of a job, taken with the registry
+	// lock held, to write to the job file.
+	jobSnapshot struct {
+		id  uuid.UUID
+		rev uint64
+		b   []byte
+	}
+)
+
+const (
+	KindAction        = api.Action
+	KindBatch         = api.Batch
+	KindOrchestration = api.Orchestration
+
+	StateAborted   = api.Aborted
+	StateCancelled = api.Cancelled
+	StateFailed    = api.Failed
+	StateRefused   = api.Refused
+	StateRunning   = api.Running
+	StateSucceeded = api.Succeeded
+)
+
+var (
+	// Retention is the minimum duration an ended job is kept in the
+	// registry.
+	Retention = 24 * time.Hour
+
+	// MaxSteps is the maximum number of steps kept in a job record. The
+	// oldest steps are dropped first.
+	MaxSteps = 500
+
+	// MaxLogLines is the number of most recent output lines kept in an
+	// action job record.
+	MaxLogLines = 50
+
+	ErrNotFound = errors.New("job not found")
+	ErrEnded    = errors.New("job already ended")
+
+	defaultRegistry     *Registry
+	defaultRegistryOnce sync.Once
+)
+
+// Default returns the registry of the <var>/jobs directory.
+func Default() *Registry {
+	defaultRegistryOnce.Do(func() {
+		defaultRegistry = NewRegistry(filepath.Join(rawconfig.Paths.Var, "jobs"))
+	})
+	return defaultRegistry
+}
+
+// NewRegistry returns a Registry of the job files in the dir directory.
+func NewRegistry(dir string) *Registry {
+	return &Registry{
+		dir:     dir,
+		m:       make(map[uuid.UUID]*Job),
+		written: make(map[uuid.UUID]uint64),
+	}
+}
+
+// IsEnded returns true if the job is in a terminal state.
+func (j Job) IsEnded() bool {
+	return j.State != StateRunning
+}
+
+// DeepCopy returns a copy of the job not sharing the slices with j.
+func (j *Job) DeepCopy() *Job {
+	n := *j
+	n.Steps = append([]api.JobStep{}, j.Steps...)
+	n.Log = append([]string{}, j.Log...)
+	if j.Objects != nil {
+		l := append([]api.JobObject{}, *j.Objects...)
+		n.Objects = &l
+	}
+	if j.EndedAt != nil {
+		v := *j.EndedAt
+		n.EndedAt = &v
+	}
+	if j.Result != nil {
+		v := *j.Result
+		n.Result = &v
+	}
+	return &n
+}
+
+// Add registers the running job j, replacing any job with the same id.
+func (t *Registry) Add(j Job) error {
+	now := time.Now()
+	j.State = StateRunning
+	if j.CreatedAt.IsZero() {
+		j.CreatedAt = now
+	}
+	j.UpdatedAt = now
+	if j.Steps == nil {
+		j.Steps = make([]api.JobStep, 0)
+	}
+	if j.Log == nil {
+		j.Log = make([]string, 0)
+	}
+	t.Lock()
+	t.m[j.ID] = &j
+	snapshot, err := t.snapshot(&j)
+	t.Unlock()
+	if err != nil {
+		return err
+	}
+	return t.write(snapshot)
+}
+
+// Get returns a copy of the job with the id.
+func (t *Registry) Get(id uuid.UUID) (*Job, error) {
+	t.Lock()
+	defer t.Unlock()
+	j, ok := t.m[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	return j.DeepCopy(), nil
+}
+
+// List returns a copy of the jobs, oldest first.
+func (t *Registry) List() []*Job {
+	t.Lock()
+	defer t.Unlock()
+	l := make([]*Job, 0, len(t.m))
+	for _, j := range t.m {
+		l = append(l, j.DeepCopy())
+	}
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].CreatedAt.Before(l[j].CreatedAt)
+	})
+	return l
+}
+
+// Running returns a copy of the running jobs.
+func (t *Registry) Running() []*Job {
+	t.Lock()
+	defer t.Unlock()
+	l := make([]*Job, 0)
+	for _, j := range t.m {
+		if !j.IsEnded() {
+			l = append(l, j.DeepCopy())
+		}
+	}
+	return l
+}
+
+// AddStep appends a step to the running job with the id, unless the last
+// step of the same node and resource has the same state.
+func (t *Registry) AddStep(id uuid.UUID, node, rid, state string) error {
+	return t.update(id, func(j *Job) bool {
+		for i := len(j.Steps) - 1; i >= 0; i-- {
+			step := j.Steps[i]
+			if step.Node != node || stepRID(step) != rid {
+				continue
+			}
+			if step.State == state {
+				return false
+			}
+			break
+		}
+		step := api.JobStep{
+			At:    time.Now(),
+			Node:  node,
+			State: state,
+		}
+		if rid != "" {
+			step.Rid = &rid
+		}
+		j.Steps = append(j.Steps, step)
+		if n := len(j.Steps); n > MaxSteps {
+			j.Steps = j.Steps[n-MaxSteps:]
+		}
+		return true
+	})
+}
+
+// SetObject applies fn to the object at index i of the running batch job
+// with the id.
+func (t *Registry) SetObject(id uuid.UUID, i int, fn func(*api.JobObject)) error {
+	return t.update(id, func(j *Job) bool {
+		if j.Objects == nil || i < 0 || i >= len(*j.Objects) {
+			return false
+		}
+		fn(&(*j.Objects)[i])
+		return true
+	})
+}
+
+// AppendLog appends an output line to the log excerpt of the running job
+// with the id. The log is not persisted until the next job update.
+func (t *Registry) AppendLog(id uuid.UUID, line string) {
+	t.Lock()
+	defer t.Unlock()
+	j, ok := t.m[id]
+	if !ok || j.IsEnded() {
+		return
+	}
+	j.Log = append(j.Log, line)
+	if n := len(j.Log); n > MaxLogLines {
+		j.Log = j.Log[n-MaxLogLines:]
+	}
+}
+
+// SetPID sets the process id of the running action job with the id.
+func (t *Registry) SetPID(id uuid.UUID, pid int) error {
+	return t.update(id, func(j *Job) bool {
+		j.PID = pid
+		return true
+	})
+}
+
+// SetCancelRequested sets the cancel request flag of the running job with
+// the id, and returns a copy of the job.
+func (t *Registry) SetCancelRequested(id uuid.UUID, v bool) (*Job, error) {
+	var n *Job
+	err := t.update(id, func(j *Job) bool {
+		j.CancelRequested = v
+		n = j.DeepCopy()
+		return true
+	})
+	return n, err
+}
+
+// End sets the terminal state and result of the running job with the id.
+// A job with a cancel request ends in the cancelled state, unless it
+// succeeded.
+func (t *Registry) End(id uuid.UUID, state api.JobState, result string) error {
+	return t.update(id, func(j *Job) bool {
+		now := time.Now()
+		if j.CancelRequested && state != StateSucceeded {
+			state = StateCancelled
+		}
+		j.State = state
+		j.EndedAt = &now
+		j.PID = 0
+		if result != "" {
+			j.Result = &result
+		}
+		return true
+	})
+}
+
+// Load reads the job files, and ends the running jobs. The action and
+// batch jobs processes or runners were lost with the daemon restart, and
+// the orchestration jobs end events may have been missed while the daemon
+// was down.
+func (t *Registry) Load() error {
+	l, err := filepath.Glob(filepath.Join(t.dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	var errs error
+	snapshots := make([]jobSnapshot, 0)
+	t.Lock()
+	for _, p := range l {
+		var j Job
+		if b, err := os.ReadFile(p); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		} else if err := json.Unmarshal(b, &j); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", p, err))
+			continue
+		}
+		if !j.IsEnded() {
+			now := time.Now()
+			result := "interrupted by daemon restart"
+			j.State = StateFailed
+			j.UpdatedAt = now
+			j.EndedAt = &now
+			j.Result = &result
+			j.PID = 0
+			if snapshot, err := t.snapshot(&j); err != nil {
+				errs = errors.Join(errs, err)
+			} else {
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+		t.m[j.ID] = &j
+	}
+	t.Unlock()
+	for _, snapshot := range snapshots {
+		if err := t.write(snapshot); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+// Purge removes the jobs ended before the retention window.
+func (t *Registry) Purge(now time.Time) error {
+	var errs error
+	t.Lock()
+	defer t.Unlock()
+	for id, j := range t.m {
+		if j.EndedAt == nil || now.Sub(*j.EndedAt) < Retention {
+			continue
+		}
+		if err := t.remove(id); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		delete(t.m, id)
+	}
+	return errs
+}
+
+func (t *Registry) update(id uuid.UUID, fn func(*Job) bool) error {
+	t.Lock()
+	j, ok := t.m[id]
+	if !ok {
+		t.Unlock()
+		return fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	if j.IsEnded() {
+		t.Unlock()
+		return fmt.Errorf("%w: %s", ErrEnded, id)
+	}
+	if !fn(j) {
+		t.Unlock()
+		return nil
+	}
+	j.UpdatedAt = time.Now()
+	snapshot, err := t.snapshot(j)
+	t.Unlock()
+	if err != nil {
+		return err
+	}
+	return t.write(snapshot)
+}
+
+func (t *Registry) file(id uuid.UUID) string {
+	return filepath.Join(t.dir, id.String()+".json")
+}
+
+// snapshot returns the json encoding of the job j, with a new revision.
+// It must be called with the registry lock held.
+func (t *Registry) snapshot(j *Job) (jobSnapshot, error) {
+	b, err := json.Marshal(j)
+	if err != nil {
+		return jobSnapshot{}, err
+	}
+	t.rev++
+	return jobSnapshot{id: j.ID, rev: t.rev, b: b}, nil
+}
+
+// write writes the job file, unless a more recent snapshot of the job was
+// already written. The file is written via a temporary file renamed so a
+// crash can't leave a truncated job file.
+func (t *Registry) write(snapshot jobSnapshot) error {
+	t.fileMu.Lock()
+	defer t.fileMu.Unlock()
+	if t.written[snapshot.id] > snapshot.rev {
+		return nil
+	}
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return err
+	}
+	p := t.file(snapshot.id)
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, snapshot.b, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, p); err != nil {
+		return err
+	}
+	t.written[snapshot.id] = snapshot.rev
+	return nil
+}
+
+// remove removes the job file.
+func (t *Registry) remove(id uuid.UUID) error {
+	t.fileMu.Lock()
+	defer t.fileMu.Unlock()
+	if err := os.Remove(t.file(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	delete(t.written, id)
+	return nil
+}
+
+func stepRID(step api.JobStep) string {
+	if step.Rid == nil {
+		return ""
+	}
+	return *step.Rid
+}
+
+// lastInstanceStates returns the state of the last instance step of each
+// node.
+func (j Job) lastInstanceStates() map[string]string {
+	m := make(map[string]string)
+	for _, step := range j.Steps {
+		if step.Rid == nil {
+			m[step.Node] = step.State
+		}
+	}
+	return m
+}
+
+// failedNodes returns the nodes whose last instance step is a failed
+// state.
+func (j Job) failedNodes() []string {
+	return failedStates(j.lastInstanceStates())
+}
+
+// failedStates returns the nodes whose instance monitor state in m is a
+// failed state.
+func failedStates(m map[string]string) []string {
+	l := make([]string, 0)
+	for node, state := range m {
+		if strings.HasSuffix(state, "failed") {
+			l = append(l, node+": "+state)
+		}
+	}
+	sort.Strings(l)
+	return l
+}
@@ -0,0 +1,149 @@
+package jobs
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+func TestRegistry(t *testing.T) {
+	dir := t.TempDir()
+	registry := NewRegistry(dir)
+
+	orchestrationID := uuid.New()
+	require.NoError(t, registry.Add(Job{Job: api.Job{
+		ID:     orchestrationID,
+		Kind:   KindOrchestration,
+		Node:   "node1",
+		Path:   "ns1/svc/web1",
+		Action: "started",
+		User:   "alice",
+	}}))
+	actionID := uuid.New()
+	require.NoError(t, registry.Add(Job{Job: api.Job{
+		ID:     actionID,
+		Kind:   KindAction,
+		Node:   "node1",
+		Path:   "ns1/svc/web1",
+		Action: "ns1/svc/web1 instance start",
+	}, PID: 1234}))
+
+	t.Run("steps are deduplicated per node and resource", func(t *testing.T) {
+		require.NoError(t, registry.AddStep(orchestrationID, "node1", "", "starting"))
+		require.NoError(t, registry.AddStep(orchestrationID, "node1", "", "starting"))
+		require.NoError(t, registry.AddStep(orchestrationID, "node1", "ip#0", "up"))
+		require.NoError(t, registry.AddStep(orchestrationID, "node2", "", "starting"))
+		require.NoError(t, registry.AddStep(orchestrationID, "node1", "", "start failed"))
+		j, err := registry.Get(orchestrationID)
+		require.NoError(t, err)
+		require.Len(t, j.Steps, 4)
+		require.Equal(t, []string{"node1: start failed"}, j.failedNodes())
+	})
+
+	t.Run("log excerpt is bounded", func(t *testing.T) {
+		for i := 0; i < MaxLogLines+10; i++ {
+			registry.AppendLog(actionID, "line")
+		}
+		j, err := registry.Get(actionID)
+		require.NoError(t, err)
+		require.Len(t, j.Log, MaxLogLines)
+	})
+
+	t.Run("cancel requested job ends cancelled", func(t *testing.T) {
+		_, err := registry.SetCancelRequested(orchestrationID, true)
+		require.NoError(t, err)
+		require.NoError(t, registry.End(orchestrationID, StateAborted, "aborted"))
+		j, err := registry.Get(orchestrationID)
+		require.NoError(t, err)
+		require.Equal(t, StateCancelled, j.State)
+		require.NotNil(t, j.EndedAt)
+		require.ErrorIs(t, registry.End(orchestrationID, StateSucceeded, ""), ErrEnded)
+	})
+
+	t.Run("reload fails the interrupted action jobs", func(t *testing.T) {
+		reloaded := NewRegistry(dir)
+		require.NoError(t, reloaded.Load())
+		l := reloaded.List()
+		require.Len(t, l, 2)
+		require.Equal(t, orchestrationID, l[0].ID)
+		require.Equal(t, StateCancelled, l[0].State)
+		require.Len(t, l[0].Steps, 4)
+		require.Equal(t, actionID, l[1].ID)
+		require.Equal(t, StateFailed, l[1].State)
+		require.Equal(t, "interrupted by daemon restart", *l[1].Result)
+		require.Zero(t, l[1].PID)
+	})
+
+	t.Run("purge drops the jobs ended before the retention window", func(t *testing.T) {
+		require.NoError(t, registry.Purge(time.Now()))
+		require.Len(t, registry.List(), 2)
+		require.NoError(t, registry.Purge(time.Now().Add(Retention+time.Minute)))
+		l := registry.List()
+		require.Len(t, l, 1)
+		require.Equal(t, actionID, l[0].ID)
+
+		_, err := registry.Get(orchestrationID)
+		require.ErrorIs(t, err, ErrNotFound)
+		reloaded := NewRegistry(dir)
+		require.NoError(t, reloaded.Load())
+		require.Len(t, reloaded.List(), 1)
+	})
+}
+
+func TestRegistryLoadInterruptedOrchestration(t *testing.T) {
+	dir := t.TempDir()
+	registry := NewRegistry(dir)
+	id := uuid.New()
+	require.NoError(t, registry.Add(Job{Job: api.Job{
+		ID:     id,
+		Kind:   KindOrchestration,
+		Node:   "node1",
+		Path:   "ns1/svc/web1",
+		Action: "started",
+	}}))
+	require.NoError(t, registry.AddStep(id, "node1", "", "starting"))
+
+	reloaded := NewRegistry(dir)
+	require.NoError(t, reloaded.Load())
+	j, err := reloaded.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateFailed, j.State)
+	require.Equal(t, "interrupted by daemon restart", *j.Result)
+	require.NotNil(t, j.EndedAt)
+	require.Len(t, j.Steps, 1)
+	require.Empty(t, reloaded.Running())
+}
+
+func TestRegistryConcurrentUpdates(t *testing.T) {
+	dir := t.TempDir()
+	registry := NewRegistry(dir)
+	id := uuid.New()
+	require.NoError(t, registry.Add(Job{Job: api.Job{
+		ID:   id,
+		Kind: KindOrchestration,
+		Node: "node1",
+		Path: "ns1/svc/web1",
+	}}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			require.NoError(t, registry.AddStep(id, fmt.Sprintf("node%d", i), "", "starting"))
+		}(i)
+	}
+	wg.Wait()
+
+	reloaded := NewRegistry(dir)
+	require.NoError(t, reloaded.Load())
+	j, err := reloaded.Get(id)
+	require.NoError(t, err)
+	require.Len(t, j.Steps, 20, "expected the job file written from the most recent snapshot")
+}
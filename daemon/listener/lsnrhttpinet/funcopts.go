@@ -36,3 +36,12 @@ func WithRateLimiterConfig(o cluster.RateLimiterConfig) funcopt.O {
 		return nil
 	})
 }
+
+// WithRateLimits sets the per-user rate limits and stream quotas rules.
+func WithRateLimits(o []cluster.ConfigRateLimit) funcopt.O {
+	return funcopt.F(func(i interface{}) error {
+		t := i.(*T)
+		t.rateLimits.Configure(o)
+		return nil
+	})
+}
@@ -8,6 +8,7 @@ import (
 	golog "log"
 	"net"
 	"net/http"
+	"reflect"
 	"sync"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
 	"github.com/opensvc/om3/v3/daemon/listener/routehttp"
 	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/daemon/ratelimit"
 	"github.com/opensvc/om3/v3/util/file"
 	"github.com/opensvc/om3/v3/util/funcopt"
 	"github.com/opensvc/om3/v3/util/hostname"
@@ -36,11 +38,22 @@ type (
 		wg        sync.WaitGroup
 		status    daemonsubsystem.Listener
 
+		// rateLimits is the per-user rate limits and stream quotas
+		// limiter, reconfigured without restart on cluster config
+		// changes.
+		rateLimits *ratelimit.Limiter
+
 		labelLocalhost pubsub.Label
 		localhost      string
 	}
 )
 
+var (
+	// quotaPublishDelay is the minimum delay between two publications of
+	// the quotas state.
+	quotaPublishDelay = time.Second
+)
+
 func New(ctx context.Context, opts ...funcopt.O) *T {
 	localhost := hostname.Hostname()
 	t := &T{
@@ -51,6 +64,8 @@ func New(ctx context.Context, opts ...funcopt.O) *T {
 
 		status: daemonsubsystem.Listener{Status: daemonsubsystem.Status{CreatedAt: time.Now()}},
 
+		rateLimits: ratelimit.New(),
+
 		localhost:      localhost,
 		labelLocalhost: pubsub.Label{"node", localhost},
 	}
@@ -97,6 +112,7 @@ func (t *T) start(ctx context.Context, errC chan<- error) {
 	rateCfg := t.status.RateLimiter
 	ctx = daemonctx.WithListenAddr(ctx, t.addr)
 	ctx = daemonctx.WithListenRateLimiterConfig(ctx, rateCfg.Rate, rateCfg.Burst, rateCfg.Expires)
+	ctx = daemonctx.WithListenRateLimits(ctx, t.rateLimits)
 
 	t.log.Infof("starting")
 	for _, fname := range []string{t.certFile, t.keyFile} {
@@ -238,10 +254,39 @@ func (t *T) janitor(ctx context.Context, errC chan<- error) {
 
 	errC <- start()
 
+	// The quotas state is published on change, at most once per
+	// quotaPublishDelay so a client hammering the api does not flood the
+	// bus. The ticker only forgets the idle users.
+	quotaTicker := time.NewTicker(time.Minute)
+	defer quotaTicker.Stop()
+	var quotaDelay <-chan time.Time
+
+	publishQuotas := func() {
+		now := time.Now()
+		quotas := t.rateLimits.State(now)
+		if len(quotas) == 0 {
+			quotas = nil
+		}
+		if started && !reflect.DeepEqual(quotas, t.status.Quotas) {
+			t.status.Quotas = quotas
+			t.status.UpdatedAt = now
+			t.publish()
+		}
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-quotaTicker.C:
+			publishQuotas()
+		case <-t.rateLimits.Changed():
+			if quotaDelay == nil {
+				quotaDelay = time.After(quotaPublishDelay)
+			}
+		case <-quotaDelay:
+			quotaDelay = nil
+			publishQuotas()
 		case e := <-sub.C:
 			switch m := e.(type) {
 			case *msgbus.AuditStart:
@@ -311,6 +356,8 @@ func (t *T) janitor(ctx context.Context, errC chan<- error) {
 					t.log.Infof("will restart: rate limiter config changed")
 				}
 
+				t.rateLimits.Configure(clusterConfig.Listener.RateLimits)
+
 				if needRestart {
 					restart(nil)
 				}
@@ -94,6 +94,7 @@ func (t *T) Start(ctx context.Context) error {
 			lsnrhttpinet.WithCertFile(daemonenv.CertChainFile()),
 			lsnrhttpinet.WithKeyFile(daemonenv.KeyFile()),
 			lsnrhttpinet.WithRateLimiterConfig(clusterConfig.Listener.RateLimiter),
+			lsnrhttpinet.WithRateLimits(clusterConfig.Listener.RateLimits),
 		),
 	} {
 		if err := lsnr.Start(ctx); err != nil {
@@ -135,3 +136,12 @@ func (authOpt *authOption) OpenIDClientID() string {
 		return cfg.Listener.OpenIDClientID
 	}
 }
+
+func (authOpt *authOption) LDAPConfig() *cluster.ConfigLDAP {
+	if cfg := cluster.ConfigData.Get(); cfg == nil {
+		return nil
+	} else {
+		ldapConfig := cfg.LDAP.DeepCopy()
+		return &ldapConfig
+	}
+}
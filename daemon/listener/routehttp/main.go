@@ -72,8 +72,10 @@ func New(ctx context.Context, enableUI bool) *T {
 	e.Use(daemonapi.LogMiddleware(ctx))
 	e.Use(daemonapi.AuthMiddleware(ctx))
 	e.Use(daemonapi.RateLimiterWithConfig(ctx))
+	e.Use(daemonapi.QuotaMiddleware(ctx))
 	e.Use(daemonapi.LogUserMiddleware(ctx))
 	e.Use(daemonapi.LogRequestMiddleWare(ctx))
+	e.Use(daemonapi.AuditMiddleware(ctx))
 	api.RegisterHandlers(e, daemonapi.New(ctx))
 
 	return &T{mux: e}
@@ -62,6 +62,7 @@ import (
 	"github.com/opensvc/om3/v3/core/node"
 	"github.com/opensvc/om3/v3/core/object"
 	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/core/stonith"
 	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
 	"github.com/opensvc/om3/v3/util/errcontext"
 	"github.com/opensvc/om3/v3/util/label"
@@ -227,6 +228,8 @@ var (
 
 		"NodeStatusUpdated": func() any { return &NodeStatusUpdated{} },
 
+		"NodeStonithResult": func() any { return &NodeStonithResult{} },
+
 		"ObjectCreated": func() any { return &ObjectCreated{} },
 
 		"ObjectDeleted": func() any { return &ObjectDeleted{} },
@@ -257,12 +260,16 @@ var (
 
 		"SetInstanceMonitorRefused": func() any { return &SetInstanceMonitorRefused{} },
 
+		"SetNodeMaintenance": func() any { return &SetNodeMaintenance{} },
+
 		"SetNodeMonitor": func() any { return &SetNodeMonitor{} },
 
 		"SubscriptionError": func() any { return &pubsub.SubscriptionError{} },
 
 		"SubscriptionQueueThreshold": func() any { return &pubsub.SubscriptionQueueThreshold{} },
 
+		"UsrTokenUsed": func() any { return &UsrTokenUsed{} },
+
 		"WatchDog": func() any { return &WatchDog{} },
 
 		"ZoneRecordDeleted": func() any { return &ZoneRecordDeleted{} },
@@ -779,6 +786,15 @@ type (
 		ProVoters       int    `json:"pro_voters" yaml:"pro_voters"`
 	}
 
+	// NodeStonithResult is the result of a fencing attempt of the Peer node
+	// by the Node node.
+	NodeStonithResult struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string         `json:"node" yaml:"node"`
+		Peer       string         `json:"peer" yaml:"peer"`
+		Value      stonith.Result `json:"stonith_result" yaml:"stonith_result"`
+	}
+
 	NodeStale struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string `json:"node" yaml:"node"`
@@ -857,6 +873,14 @@ type (
 		GlobalExpect          instance.MonitorGlobalExpect `json:"global_expect" yaml:"global_expect"`
 		GlobalExpectUpdatedAt time.Time                    `json:"global_expect_updated_at" yaml:"global_expect_updated_at"`
 		Aborted               bool                         `json:"aborted" yaml:"aborted"`
+
+		// Failed is true when the instance monitor state of a node is a
+		// failure state at the orchestration end.
+		Failed bool `json:"failed" yaml:"failed"`
+
+		// FailedNodes are the "<node>: <state>" of the nodes with a
+		// failure instance monitor state at the orchestration end.
+		FailedNodes []string `json:"failed_nodes,omitempty" yaml:"failed_nodes,omitempty"`
 	}
 
 	ObjectOrchestrationRefused struct {
@@ -949,6 +973,15 @@ type (
 		Value      instance.MonitorUpdate `json:"instance_monitor_update" yaml:"instance_monitor_update"`
 	}
 
+	// SetNodeMaintenance requests the node to enter the maintenance
+	// described by Value, or to leave the maintenance if Value is nil.
+	SetNodeMaintenance struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string                    `json:"node" yaml:"node"`
+		Value      *node.Maintenance         `json:"maintenance" yaml:"maintenance"`
+		Err        errcontext.ErrCloseSender `json:"-" yaml:"-"`
+	}
+
 	SetNodeMonitor struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string                    `json:"node" yaml:"node"`
@@ -956,6 +989,17 @@ type (
 		Err        errcontext.ErrCloseSender `json:"-" yaml:"-"`
 	}
 
+	// UsrTokenUsed is published when a usr api token authenticates a
+	// request. It is forwarded to the peer nodes, so all the cluster nodes
+	// know the last use of the tokens.
+	UsrTokenUsed struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string    `json:"node" yaml:"node"`
+		Username   string    `json:"username" yaml:"username"`
+		ID         string    `json:"id" yaml:"id"`
+		At         time.Time `json:"at" yaml:"at"`
+	}
+
 	WatchDog struct {
 		pubsub.Msg `yaml:",inline"`
 		Bus        string `json:"bus" yaml:"bus"`
@@ -1416,6 +1460,10 @@ func (e *NodeStale) Kind() string {
 	return "NodeStale"
 }
 
+func (e *NodeStonithResult) Kind() string {
+	return "NodeStonithResult"
+}
+
 func (e *NodeStatusUpdated) Kind() string {
 	return "NodeStatusUpdated"
 }
@@ -1506,10 +1554,18 @@ func (e *SetInstanceMonitorRefused) Kind() string {
 	return "SetInstanceMonitorRefused"
 }
 
+func (e *SetNodeMaintenance) Kind() string {
+	return "SetNodeMaintenance"
+}
+
 func (e *SetNodeMonitor) Kind() string {
 	return "SetNodeMonitor"
 }
 
+func (e *UsrTokenUsed) Kind() string {
+	return "UsrTokenUsed"
+}
+
 func (e *WatchDog) Kind() string {
 	return "WatchDog"
 }
@@ -1569,6 +1625,11 @@ func NewSetInstanceMonitorWithErr(ctx context.Context, p naming.Path, nodename s
 	return &SetInstanceMonitor{Path: p, Node: nodename, Value: value, Err: err}, err
 }
 
+func NewSetNodeMaintenanceWithErr(ctx context.Context, nodename string, value *node.Maintenance) (*SetNodeMaintenance, errcontext.ErrReceiver) {
+	err := errcontext.New(ctx)
+	return &SetNodeMaintenance{Node: nodename, Value: value, Err: err}, err
+}
+
 func NewSetNodeMonitorWithErr(ctx context.Context, nodename string, value node.MonitorUpdate) (*SetNodeMonitor, errcontext.ErrReceiver) {
 	err := errcontext.New(ctx)
 	return &SetNodeMonitor{Node: nodename, Value: value, Err: err}, err
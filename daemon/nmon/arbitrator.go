@@ -19,9 +19,16 @@ import (
 type (
 	arbitratorConfig struct {
 		Name     string `json:"name"`
+		Type     string `json:"type"`
 		URI      string `json:"uri"`
 		Weight   int    `json:"weight"`
 		Insecure bool
+
+		// Dev is the shared block device raced for by the disk arbitrators.
+		Dev string `json:"dev"`
+
+		// Lock is the disk arbitrator race method: pr or lease.
+		Lock string `json:"lock"`
 	}
 )
 
@@ -35,10 +42,22 @@ func (t *Manager) setArbitratorConfig() {
 		name := strings.TrimPrefix(s, "arbitrator#")
 		a := arbitratorConfig{
 			Name:     name,
+			Type:     t.config.GetString(key.New(s, "type")),
 			URI:      t.config.GetString(key.New(s, "uri")),
 			Insecure: t.config.GetBool(key.New(s, "insecure")),
 			Weight:   t.config.GetInt(key.New(s, "weight")),
 		}
+		if a.Type == arbitratorTypeDisk {
+			a.Dev = t.config.GetString(key.New(s, "dev"))
+			a.Lock = t.config.GetString(key.New(s, "lock"))
+			if a.Dev == "" {
+				t.log.Warnf("ignored arbitrator %s (empty dev)", s)
+				continue
+			}
+			a.URI = "disk://" + a.Dev
+			arbitrators[name] = a
+			continue
+		}
 		if a.URI == "" {
 			t.log.Tracef("arbitrator keyword 'name' is deprecated, use 'uri' instead")
 			a.URI = t.config.GetString(key.New(s, "name"))
@@ -53,7 +72,22 @@ func (t *Manager) setArbitratorConfig() {
 }
 
 // getStatusArbitrators checks all arbitrators and returns result
+//
+// The disk arbitrators held by the local node are released when the node
+// has the nodes quorum, so the next split race starts on a free device.
 func (t *Manager) getStatusArbitrators() map[string]node.ArbitratorStatus {
+	release := t.hasNodesQuorum()
+	return t.getArbitratorsResult(func(ctx context.Context, a arbitratorConfig) error {
+		if a.Type == arbitratorTypeDisk {
+			return t.checkDisk(ctx, a, release)
+		}
+		return t.arbitratorCheck(ctx, a)
+	})
+}
+
+// getArbitratorsResult calls fn concurrently on all arbitrators and returns
+// the resulting arbitrators status.
+func (t *Manager) getArbitratorsResult(fn func(context.Context, arbitratorConfig) error) map[string]node.ArbitratorStatus {
 	type res struct {
 		name string
 		err  error
@@ -63,7 +97,7 @@ func (t *Manager) getStatusArbitrators() map[string]node.ArbitratorStatus {
 	c := make(chan res, len(t.arbitrators))
 	for _, a := range t.arbitrators {
 		go func(a arbitratorConfig) {
-			c <- res{name: a.Name, err: t.arbitratorCheck(ctx, a)}
+			c <- res{name: a.Name, err: fn(ctx, a)}
 		}(a)
 	}
 	result := make(map[string]node.ArbitratorStatus)
@@ -106,14 +140,16 @@ func (t *Manager) getAndUpdateStatusArbitrator() {
 
 func (t *Manager) arbitratorTotal() int {
 	i := 0
-	for _, v := range t.getStatusArbitrators() {
-		i += v.Weight
+	for _, a := range t.arbitrators {
+		i += a.Weight
 	}
 	return i
 }
 
+// arbitratorVotes asks the arbitrators for their vote. The disk arbitrators
+// vote for the node winning the device race.
 func (t *Manager) arbitratorVotes() (votes []string) {
-	for s, v := range t.getStatusArbitrators() {
+	for s, v := range t.getArbitratorsResult(t.arbitratorVote) {
 		if v.Status == status.Up {
 			for i := 0; i < v.Weight; i++ {
 				votes = append(votes, s)
@@ -133,6 +169,13 @@ func (t *Manager) arbitratorCheck(ctx context.Context, a arbitratorConfig) error
 	return fmt.Errorf("invalid arbitrator uri")
 }
 
+func (t *Manager) arbitratorVote(ctx context.Context, a arbitratorConfig) error {
+	if a.Type == arbitratorTypeDisk {
+		return t.acquireDisk(ctx, a)
+	}
+	return t.arbitratorCheck(ctx, a)
+}
+
 func (a *arbitratorConfig) checkURL(ctx context.Context) error {
 	client := &http.Client{
 		Transport: &http.Transport{
@@ -0,0 +1,166 @@
+package nmon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/util/device"
+	"github.com/opensvc/om3/v3/util/disklease"
+	"github.com/opensvc/om3/v3/util/key"
+	"github.com/opensvc/om3/v3/util/scsi"
+)
+
+const (
+	arbitratorTypeDisk = "disk"
+
+	// arbitratorLockPR is the disk arbitrator race method based on a SCSI-3
+	// persistent reservation of the device.
+	arbitratorLockPR = "pr"
+
+	// arbitratorLockLease is the disk arbitrator race method based on a
+	// lease written in the first block of the device.
+	arbitratorLockLease = "lease"
+)
+
+var (
+	// diskLeaseDuration is the validity duration of a won disk arbitrator
+	// lease.
+	diskLeaseDuration = arbitratorInterval
+
+	// diskLeaseSettle is the delay between the disk arbitrator lease claim
+	// and its verification. It must be lower than arbitratorCheckDuration.
+	diskLeaseSettle = arbitratorCheckDuration / 3
+
+	// diskLeaseMaxClockSkew is the tolerated clock difference between the
+	// nodes racing for a disk arbitrator lease.
+	diskLeaseMaxClockSkew = 10 * time.Second
+
+	// newPRDriver returns the SCSI persistent reservation driver used by
+	// the disk arbitrators.
+	newPRDriver = func() (scsi.PersistentReservationDriver, error) {
+		h := scsi.PersistentReservationHandle{}
+		return h.Driver()
+	}
+)
+
+// hasNodesQuorum returns true if the live nodes are a majority of the cluster
+// nodes.
+func (t *Manager) hasNodesQuorum() bool {
+	return len(t.livePeers) > len(t.clusterConfig.Nodes)/2
+}
+
+// prKey returns the local node SCSI persistent reservation key.
+func (t *Manager) prKey() (string, error) {
+	if s := t.config.GetString(key.New("node", "prkey")); s != "" {
+		return scsi.FormatPRKey(s), nil
+	}
+	n, err := object.NewNode(object.WithLogger(t.log))
+	if err != nil {
+		return "", err
+	}
+	s, err := n.PRKey()
+	if err != nil {
+		return "", err
+	}
+	return scsi.FormatPRKey(s), nil
+}
+
+func (t *Manager) diskLease(a arbitratorConfig) disklease.T {
+	return disklease.T{
+		Path:         a.Dev,
+		Holder:       t.localhost,
+		Duration:     diskLeaseDuration,
+		Settle:       diskLeaseSettle,
+		MaxClockSkew: diskLeaseMaxClockSkew,
+	}
+}
+
+// checkDisk verifies the disk arbitrator device is usable. If release is
+// true, the device reservation or lease held by the local node is released.
+func (t *Manager) checkDisk(_ context.Context, a arbitratorConfig, release bool) error {
+	switch a.Lock {
+	case arbitratorLockLease:
+		lease := t.diskLease(a)
+		if _, err := lease.Read(); err != nil {
+			return err
+		}
+		if release {
+			return lease.Release()
+		}
+		return nil
+	case arbitratorLockPR, "":
+		driver, err := newPRDriver()
+		if err != nil {
+			return err
+		}
+		dev := device.New(a.Dev)
+		reservation, err := driver.ReadReservation(dev)
+		if err != nil {
+			return err
+		}
+		if !release || reservation == "" {
+			return nil
+		}
+		prKey, err := t.prKey()
+		if err != nil {
+			return err
+		}
+		if reservation != prKey {
+			return nil
+		}
+		t.log.Infof("arbitrator#%s release the %s reservation", a.Name, a.Dev)
+		return driver.Release(dev, prKey)
+	default:
+		return fmt.Errorf("invalid disk arbitrator lock %s", a.Lock)
+	}
+}
+
+// acquireDisk races for the disk arbitrator device. It returns nil if the
+// local node won the race, so the arbitrator votes for the local node.
+func (t *Manager) acquireDisk(_ context.Context, a arbitratorConfig) error {
+	switch a.Lock {
+	case arbitratorLockLease:
+		return t.diskLease(a).Acquire()
+	case arbitratorLockPR, "":
+		driver, err := newPRDriver()
+		if err != nil {
+			return err
+		}
+		prKey, err := t.prKey()
+		if err != nil {
+			return err
+		}
+		return acquirePR(driver, device.New(a.Dev), prKey)
+	default:
+		return fmt.Errorf("invalid disk arbitrator lock %s", a.Lock)
+	}
+}
+
+// acquirePR registers prKey on the device and reserves the device if not
+// already reserved. It returns an error if the device is reserved by another
+// key, which means another node won the race.
+func acquirePR(driver scsi.PersistentReservationDriver, dev device.T, prKey string) error {
+	if err := driver.Register(dev, prKey); err != nil {
+		return fmt.Errorf("%s spr register: %w", dev, err)
+	}
+	reservation, err := driver.ReadReservation(dev)
+	if err != nil {
+		return err
+	}
+	if reservation == "" {
+		// The reserve command fails with a reservation conflict if a peer
+		// reserved the device since our read.
+		if err := driver.Reserve(dev, prKey); err != nil {
+			return fmt.Errorf("%s spr reserve: %w", dev, err)
+		}
+		if reservation, err = driver.ReadReservation(dev); err != nil {
+			return err
+		}
+	}
+	if reservation != prKey {
+		return fmt.Errorf("%s is reserved by %s", dev, reservation)
+	}
+	return nil
+}
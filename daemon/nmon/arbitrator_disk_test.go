@@ -0,0 +1,93 @@
+package nmon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/util/device"
+)
+
+// fakePRDriver is a SCSI persistent reservation driver keeping the device
+// registrations and reservation in memory.
+type fakePRDriver struct {
+	registrations map[string]bool
+	reservation   string
+}
+
+func newFakePRDriver() *fakePRDriver {
+	return &fakePRDriver{registrations: make(map[string]bool)}
+}
+
+func (t *fakePRDriver) ReadRegistrations(_ device.T) ([]string, error) {
+	l := make([]string, 0)
+	for k := range t.registrations {
+		l = append(l, k)
+	}
+	return l, nil
+}
+
+func (t *fakePRDriver) Register(_ device.T, key string) error {
+	t.registrations[key] = true
+	return nil
+}
+
+func (t *fakePRDriver) Unregister(_ device.T, key string) error {
+	delete(t.registrations, key)
+	return nil
+}
+
+func (t *fakePRDriver) ReadReservation(_ device.T) (string, error) {
+	return t.reservation, nil
+}
+
+func (t *fakePRDriver) Reserve(_ device.T, key string) error {
+	if !t.registrations[key] {
+		return errors.New("not registered")
+	}
+	if t.reservation != "" && t.reservation != key {
+		return errors.New("reservation conflict")
+	}
+	t.reservation = key
+	return nil
+}
+
+func (t *fakePRDriver) Release(_ device.T, key string) error {
+	if t.reservation == key {
+		t.reservation = ""
+	}
+	return nil
+}
+
+func (t *fakePRDriver) Clear(_ device.T, _ string) error {
+	clear(t.registrations)
+	t.reservation = ""
+	return nil
+}
+
+func (t *fakePRDriver) Preempt(_ device.T, oldKey, newKey string) error {
+	delete(t.registrations, oldKey)
+	t.reservation = newKey
+	return nil
+}
+
+func (t *fakePRDriver) PreemptAbort(dev device.T, oldKey, newKey string) error {
+	return t.Preempt(dev, oldKey, newKey)
+}
+
+func TestAcquirePR(t *testing.T) {
+	dev := device.New("/dev/arb0")
+	driver := newFakePRDriver()
+	key1 := "0x0000000000000001"
+	key2 := "0x0000000000000002"
+
+	require.NoError(t, acquirePR(driver, dev, key1), "expected node1 to win the race on a free device")
+	require.Equal(t, key1, driver.reservation)
+	require.NoError(t, acquirePR(driver, dev, key1), "expected node1 to keep its vote")
+	require.ErrorContains(t, acquirePR(driver, dev, key2), "is reserved by "+key1)
+	require.True(t, driver.registrations[key2], "expected the race loser to stay registered")
+
+	require.NoError(t, driver.Release(dev, key1))
+	require.NoError(t, acquirePR(driver, dev, key2), "expected node2 to win the race on a released device")
+}
@@ -4,6 +4,8 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/opensvc/om3/v3/core/datarecv"
+	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/node"
 	"github.com/opensvc/om3/v3/core/object"
 	"github.com/opensvc/om3/v3/util/key"
@@ -62,15 +64,36 @@ func (t *Manager) getNodeConfig() node.Config {
 			t.log.Debugf("skip empty hook name for %s", s)
 			continue
 		}
+		hook.Type = t.config.GetString(key.New(s, "type"))
 		hook.Events = t.config.GetStrings(key.New(s, "events"))
-		if len(hook.Events) == 0 {
-			t.log.Debugf("skip empty hook events for %s", s)
+		hook.Filters = t.config.GetStrings(key.New(s, "filters"))
+		if len(hook.Events) == 0 && len(hook.Filters) == 0 {
+			t.log.Debugf("skip empty hook events and filters for %s", s)
 			continue
 		}
-		hook.Command = t.config.GetStrings(key.New(s, "command"))
-		if len(hook.Command) == 0 {
-			t.log.Debugf("skip empty hook command for %s", s)
-			continue
+		if d := t.config.GetDuration(key.New(s, "timeout")); d != nil {
+			hook.Timeout = *d
+		}
+		switch hook.Type {
+		case "http":
+			hook.URL = t.config.GetString(key.New(s, "url"))
+			if hook.URL == "" {
+				t.log.Debugf("skip empty hook url for %s", s)
+				continue
+			}
+			secret, err := hookSecret(t.config.GetString(key.New(s, "secret")))
+			if err != nil {
+				t.log.Warnf("skip hook %s: secret: %s", s, err)
+				continue
+			}
+			hook.Secret = secret
+			hook.Retries = t.config.GetInt(key.New(s, "retries"))
+		default:
+			hook.Command = t.config.GetStrings(key.New(s, "command"))
+			if len(hook.Command) == 0 {
+				t.log.Debugf("skip empty hook command for %s", s)
+				continue
+			}
 		}
 		cfg.Hooks = append(cfg.Hooks, hook)
 		t.log.Tracef("hook %s: %#v", hook.Name, hook)
@@ -88,3 +111,20 @@ func (t *Manager) getNodeConfig() node.Config {
 
 	return cfg
 }
+
+// hookSecret returns the hook secret, decoded from the datastore key if s
+// is a "from <path> key <key>" reference.
+func hookSecret(s string) (string, error) {
+	if !strings.HasPrefix(s, "from ") {
+		return s, nil
+	}
+	km, err := datarecv.ParseKeyMetaRel(s, naming.NsSys)
+	if err != nil {
+		return "", err
+	}
+	b, err := km.RootDecode()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
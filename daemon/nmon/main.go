@@ -91,6 +91,17 @@ type (
 		// frozen is true when local node is frozen
 		frozen bool
 
+		// maintenance is the local node maintenance state, nil if the node
+		// is not in maintenance.
+		maintenance *maintenanceState
+
+		// maintenanceTimer triggers the maintenance expiry.
+		maintenanceTimer *time.Timer
+
+		// givebackPending is the list of objects to give back when the
+		// node is unfrozen after leaving the maintenance.
+		givebackPending []naming.Path
+
 		nodeMonitor map[string]node.Monitor
 
 		// clusterConfig is a cache of published ClusterConfigUpdated
@@ -267,6 +278,8 @@ func (t *Manager) Start(parent context.Context) error {
 
 	t.setArbitratorConfig()
 
+	t.initMaintenance()
+
 	t.startSubscriptions()
 
 	if clusterConfig := cluster.ConfigData.Get(); clusterConfig != nil {
@@ -354,6 +367,7 @@ func (t *Manager) startSubscriptions() {
 	sub.AddFilter(&msgbus.NodeRejoin{}, t.labelLocalhost)
 	sub.AddFilter(&msgbus.NodeStatusGenUpdates{}, t.labelLocalhost)
 	sub.AddFilter(&msgbus.NodeLabelsUpdated{}, pubsub.Label{"from", "peer"})
+	sub.AddFilter(&msgbus.SetNodeMaintenance{}, t.labelLocalhost)
 	sub.AddFilter(&msgbus.SetNodeMonitor{})
 	sub.AddFilter(&msgbus.NetLinkUp{})
 	sub.Start()
@@ -378,6 +392,7 @@ func (t *Manager) startRejoin() {
 			LastShutdownAt: leftAt,
 			IsUpgrading:    os.Getenv("OPENSVC_AGENT_UPGRADE") != "",
 		}, t.labelLocalhost)
+		t.resumeMaintenanceDrain()
 	} else {
 		// Begin the rejoin state phase.
 		// Arm the re-join grace period ticker.
@@ -443,6 +458,7 @@ func (t *Manager) worker() {
 		t.rejoinTicker.Stop()
 		t.log.Infof("single cluster node, transition to idle")
 		t.transitionTo(node.MonitorStateIdle)
+		t.resumeMaintenanceDrain()
 	}
 
 	statsTicker := time.NewTicker(statsInterval)
@@ -504,6 +520,8 @@ func (t *Manager) worker() {
 				t.onLeaveRequest(c)
 			case *msgbus.NodeRejoin:
 				t.onNodeRejoin(c)
+			case *msgbus.SetNodeMaintenance:
+				t.onSetNodeMaintenance(c)
 			case *msgbus.SetNodeMonitor:
 				t.onSetNodeMonitor(c)
 			case *msgbus.NetLinkUp:
@@ -511,6 +529,8 @@ func (t *Manager) worker() {
 			}
 		case i := <-t.cmdC:
 			switch c := i.(type) {
+			case cmdMaintenanceExpired:
+				t.onMaintenanceExpired()
 			case cmdOrchestrate:
 				t.onOrchestrate(c)
 			}
@@ -565,6 +585,7 @@ func (t *Manager) onRejoinGracePeriodExpire() {
 		t.transitionTo(node.MonitorStateIdle)
 	}
 	t.rejoinTicker.Stop()
+	t.resumeMaintenanceDrain()
 }
 
 func (t *Manager) update() {
@@ -629,7 +650,9 @@ func (t *Manager) clearPending() {
 }
 
 func (t *Manager) getStats() (node.Stats, error) {
-	stats := node.Stats{}
+	stats := node.Stats{
+		CPUs: runtime.NumCPU(),
+	}
 	if runtime.GOOS != "linux" {
 		return stats, nil
 	}
@@ -349,6 +349,7 @@ func (t *Manager) onNodeFrozenFileRemoved(_ *msgbus.NodeFrozenFileRemoved) {
 	t.nodeStatus.FrozenAt = time.Time{}
 	t.publisher.Pub(&msgbus.NodeFrozen{Node: t.localhost, Status: t.frozen, FrozenAt: time.Time{}}, t.labelLocalhost)
 	t.publishNodeStatus()
+	t.giveback()
 	t.orchestrate()
 }
 
@@ -421,6 +422,7 @@ func (t *Manager) onHeartbeatMessageTypeUpdated(c *msgbus.HeartbeatMessageTypeUp
 	t.nodeStatus.RejoinedAt = time.Now()
 	_ = os.Unsetenv("OPENSVC_AGENT_UPGRADE")
 	t.transitionTo(node.MonitorStateIdle)
+	t.resumeMaintenanceDrain()
 }
 
 func (t *Manager) onNodeRejoin(c *msgbus.NodeRejoin) {
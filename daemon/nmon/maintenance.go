@@ -0,0 +1,421 @@
+package nmon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/errcontext"
+	"github.com/opensvc/om3/v3/util/file"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+type (
+	// maintenanceState is the maintenance data persisted in the
+	// var/node/maintenance file, so the maintenance survives the daemon
+	// restarts.
+	maintenanceState struct {
+		node.Maintenance
+
+		// Giveback is the list of objects the local node was the preferred
+		// ha leader of when entering the maintenance. These objects are
+		// given back when the maintenance is left.
+		Giveback []naming.Path `json:"giveback"`
+
+		// WasFrozen is true if the node was already frozen when entering
+		// the maintenance. Such a node is not unfrozen when the
+		// maintenance is left.
+		WasFrozen bool `json:"was_frozen"`
+
+		// Drained is true when the maintenance drain succeeded. A drain
+		// interrupted by a daemon restart is resumed when the node leaves
+		// the rejoin state.
+		Drained bool `json:"drained"`
+	}
+
+	// cmdMaintenanceExpired is sent by the maintenance expiry timer.
+	cmdMaintenanceExpired struct{}
+)
+
+var (
+	// givebackTimeout is the maximum duration of a giveback orchestration
+	// submission.
+	givebackTimeout = 5 * time.Second
+
+	// givebackWaitTimeout is the maximum duration to wait for the local
+	// instance monitor of an object to give back, after a daemon restart.
+	givebackWaitTimeout = time.Minute
+
+	// maintenanceRetryInterval is the delay before retrying to leave an
+	// expired maintenance.
+	maintenanceRetryInterval = 10 * time.Second
+)
+
+func maintenanceFile() string {
+	return filepath.Join(rawconfig.Paths.Var, "node", "maintenance")
+}
+
+// givebackFile is the file persisting the objects to give back after the
+// maintenance is left, until the node is unfrozen and the giveback
+// orchestrations are submitted.
+func givebackFile() string {
+	return filepath.Join(rawconfig.Paths.Var, "node", "maintenance_giveback")
+}
+
+// isFrozen returns true if the local node frozen flag file exists.
+func isFrozen() bool {
+	return !file.ModTime(filepath.Join(rawconfig.Paths.Var, "node", "frozen")).IsZero()
+}
+
+func loadMaintenanceState() (*maintenanceState, error) {
+	b, err := os.ReadFile(maintenanceFile())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var m maintenanceState
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("%s: %w", maintenanceFile(), err)
+	}
+	return &m, nil
+}
+
+func (t *maintenanceState) save() error {
+	return writeJSONFile(maintenanceFile(), t)
+}
+
+func loadGivebackPending() ([]naming.Path, error) {
+	b, err := os.ReadFile(givebackFile())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var l []naming.Path
+	if err := json.Unmarshal(b, &l); err != nil {
+		return nil, fmt.Errorf("%s: %w", givebackFile(), err)
+	}
+	return l, nil
+}
+
+func saveGivebackPending(l []naming.Path) error {
+	if len(l) == 0 {
+		return removeGivebackPending()
+	}
+	return writeJSONFile(givebackFile(), l)
+}
+
+func removeGivebackPending() error {
+	if err := os.Remove(givebackFile()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func writeJSONFile(p string, v any) error {
+	tmp := p + ".tmp"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p)
+}
+
+// initMaintenance restores the maintenance state and the pending giveback
+// persisted before the daemon restart.
+func (t *Manager) initMaintenance() {
+	if l, err := loadGivebackPending(); err != nil {
+		t.log.Errorf("load maintenance giveback: %s", err)
+	} else if len(l) > 0 {
+		t.givebackPending = l
+		if !isFrozen() {
+			// The node was unfrozen before the giveback submission.
+			t.giveback()
+		} else {
+			t.log.Infof("giveback pending the node unfreeze: %s", l)
+		}
+	}
+	m, err := loadMaintenanceState()
+	if err != nil {
+		t.log.Errorf("load maintenance state: %s", err)
+		return
+	}
+	if m == nil {
+		return
+	}
+	t.log.Infof("node is in maintenance since %s: %s", m.Since, m.Reason)
+	if !m.Drained {
+		t.log.Infof("maintenance drain will resume after rejoin")
+	}
+	t.maintenance = m
+	t.nodeStatus.Maintenance = &m.Maintenance
+	t.publishNodeStatus()
+	t.armMaintenanceTimer()
+}
+
+func (t *Manager) onSetNodeMaintenance(c *msgbus.SetNodeMaintenance) {
+	var err error
+	if c.Value == nil {
+		err = t.leaveMaintenance()
+	} else {
+		err = t.enterMaintenance(*c.Value)
+	}
+	if v, ok := c.Err.(errcontext.ErrCloseSender); ok {
+		v.Send(err)
+		v.Close()
+	}
+}
+
+// onMaintenanceExpired leaves the expired maintenance. The leave is
+// retried until it succeeds, for example when the node is still draining.
+func (t *Manager) onMaintenanceExpired() {
+	if t.maintenance == nil || !t.maintenance.IsExpired(time.Now()) {
+		return
+	}
+	t.log.Infof("maintenance expired")
+	if err := t.leaveMaintenance(); err != nil {
+		t.log.Errorf("leave expired maintenance: %s: retry in %s", err, maintenanceRetryInterval)
+		t.armMaintenanceTimerAfter(maintenanceRetryInterval)
+	}
+}
+
+// enterMaintenance persists the maintenance state and drains the node. A
+// node already in maintenance only updates its maintenance reason and
+// expiry.
+func (t *Manager) enterMaintenance(m node.Maintenance) error {
+	now := time.Now()
+	if m.IsExpired(now) {
+		return fmt.Errorf("maintenance expiry %s is in the past", m.ExpireAt)
+	}
+	state := maintenanceState{Maintenance: m}
+	if t.maintenance != nil {
+		state.Since = t.maintenance.Since
+		state.Giveback = t.maintenance.Giveback
+		state.WasFrozen = t.maintenance.WasFrozen
+		state.Drained = t.maintenance.Drained
+	} else {
+		state.Since = now
+		state.Giveback = localHALeaders(t.localhost)
+		state.WasFrozen = isFrozen()
+	}
+	if err := state.save(); err != nil {
+		return fmt.Errorf("save maintenance state: %w", err)
+	}
+	isNew := t.maintenance == nil
+	t.maintenance = &state
+	t.nodeStatus.Maintenance = &state.Maintenance
+	t.publishNodeStatus()
+	t.armMaintenanceTimer()
+	if !isNew {
+		t.log.Infof("maintenance updated: %s", m.Reason)
+		return nil
+	}
+	t.log.Infof("enter maintenance: %s", m.Reason)
+	t.givebackPending = nil
+	if err := removeGivebackPending(); err != nil {
+		t.log.Warnf("remove maintenance giveback: %s", err)
+	}
+	if t.state.LocalExpect != node.MonitorLocalExpectDrained {
+		t.log.Infof("set local expect %s -> %s", t.state.LocalExpect, node.MonitorLocalExpectDrained)
+		t.change = true
+		t.state.LocalExpect = node.MonitorLocalExpectDrained
+		if t.isStateFailed() {
+			t.state.State = node.MonitorStateIdle
+		}
+		t.updateIfChange()
+		t.orchestrate()
+	}
+	return nil
+}
+
+// resumeMaintenanceDrain drains the node in maintenance if the drain was
+// interrupted by a daemon restart. It is called when the node leaves the
+// rejoin state, as the rejoin state prevents the node orchestration.
+func (t *Manager) resumeMaintenanceDrain() {
+	if t.maintenance == nil || t.maintenance.Drained {
+		return
+	}
+	if t.state.LocalExpect == node.MonitorLocalExpectDrained {
+		return
+	}
+	t.log.Infof("resume maintenance drain: set local expect %s -> %s", t.state.LocalExpect, node.MonitorLocalExpectDrained)
+	t.change = true
+	t.state.LocalExpect = node.MonitorLocalExpectDrained
+	t.updateIfChange()
+	t.orchestrate()
+}
+
+// onMaintenanceDrained records the maintenance drain success, so the drain
+// is not resumed after a daemon restart.
+func (t *Manager) onMaintenanceDrained() {
+	if t.maintenance == nil || t.maintenance.Drained {
+		return
+	}
+	t.maintenance.Drained = true
+	if err := t.maintenance.save(); err != nil {
+		t.log.Warnf("save maintenance state: %s", err)
+	}
+}
+
+// leaveMaintenance removes the maintenance state and unfreezes the node,
+// unless the node was already frozen when entering the maintenance. The
+// objects the node was the preferred ha leader of are given back when the
+// node is unfrozen.
+func (t *Manager) leaveMaintenance() error {
+	if t.maintenance == nil {
+		return fmt.Errorf("node is not in maintenance")
+	}
+	if t.state.LocalExpect == node.MonitorLocalExpectDrained {
+		return fmt.Errorf("node is still draining")
+	}
+	// Persist the giveback before removing the maintenance state, so a
+	// daemon restart can't lose it.
+	givebackPending := append(t.givebackPending, t.maintenance.Giveback...)
+	if err := saveGivebackPending(givebackPending); err != nil {
+		return fmt.Errorf("save maintenance giveback: %w", err)
+	}
+	if err := os.Remove(maintenanceFile()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove maintenance state: %w", err)
+	}
+	t.log.Infof("leave maintenance")
+	wasFrozen := t.maintenance.WasFrozen
+	t.givebackPending = givebackPending
+	t.maintenance = nil
+	t.nodeStatus.Maintenance = nil
+	t.publishNodeStatus()
+	t.armMaintenanceTimer()
+
+	if t.nodeStatus.FrozenAt.IsZero() {
+		t.giveback()
+		return nil
+	}
+	if wasFrozen {
+		t.log.Infof("keep the node frozen as before the maintenance, giveback pending the node unfreeze: %s", givebackPending)
+		return nil
+	}
+	go func() {
+		t.log.Infof("run action unfreeze")
+		if err := t.crmUnfreeze(); err != nil {
+			t.log.Errorf("unfreeze: %s", err)
+		}
+	}()
+	return nil
+}
+
+// armMaintenanceTimer schedules the maintenance expiry, if any.
+func (t *Manager) armMaintenanceTimer() {
+	if t.maintenance == nil || t.maintenance.ExpireAt.IsZero() {
+		t.armMaintenanceTimerAfter(0)
+		return
+	}
+	t.armMaintenanceTimerAfter(max(time.Until(t.maintenance.ExpireAt), 0))
+}
+
+// armMaintenanceTimerAfter schedules a maintenance expiry check after d,
+// or cancels the scheduled check if the node is not in maintenance with an
+// expiry.
+func (t *Manager) armMaintenanceTimerAfter(d time.Duration) {
+	if t.maintenanceTimer != nil {
+		t.maintenanceTimer.Stop()
+		t.maintenanceTimer = nil
+	}
+	if t.maintenance == nil || t.maintenance.ExpireAt.IsZero() {
+		return
+	}
+	t.maintenanceTimer = time.AfterFunc(d, func() {
+		select {
+		case <-t.ctx.Done():
+		case t.cmdC <- cmdMaintenanceExpired{}:
+		}
+	})
+}
+
+// giveback submits a giveback orchestration for the objects recorded when
+// entering the maintenance.
+func (t *Manager) giveback() {
+	paths := t.givebackPending
+	t.givebackPending = nil
+	if len(paths) == 0 {
+		return
+	}
+	if err := removeGivebackPending(); err != nil {
+		t.log.Warnf("remove maintenance giveback: %s", err)
+	}
+	go func() {
+		globalExpect := instance.MonitorGlobalExpectPlaced
+		for _, p := range paths {
+			if !t.waitLocalInstanceMonitor(p) {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(t.ctx, givebackTimeout)
+			value := instance.MonitorUpdate{
+				GlobalExpect:             &globalExpect,
+				CandidateOrchestrationID: uuid.New(),
+			}
+			msg, setImonErr := msgbus.NewSetInstanceMonitorWithErr(ctx, p, t.localhost, value)
+			t.publisher.Pub(msg, pubsub.Label{"namespace", p.Namespace}, pubsub.Label{"path", p.String()}, pubsub.Label{"origin", "nmon"})
+			if err := setImonErr.Receive(); err != nil {
+				t.log.Warnf("giveback %s: %s", p, err)
+			} else {
+				t.log.Infof("giveback %s", p)
+			}
+			cancel()
+		}
+	}()
+}
+
+// waitLocalInstanceMonitor returns true when the local instance monitor of
+// the object p exists. After a daemon restart, the instance monitors may
+// not be started yet, so wait up to givebackWaitTimeout.
+func (t *Manager) waitLocalInstanceMonitor(p naming.Path) bool {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	timeout := time.After(givebackWaitTimeout)
+	for {
+		if instance.MonitorData.GetByPathAndNode(p, t.localhost) != nil {
+			return true
+		}
+		select {
+		case <-t.ctx.Done():
+			return false
+		case <-timeout:
+			t.log.Warnf("giveback %s: no local instance", p)
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+// localHALeaders returns the sorted paths of the objects the local node is
+// the preferred ha leader of.
+func localHALeaders(localhost string) []naming.Path {
+	var l []naming.Path
+	for p, instMonitor := range instance.MonitorData.GetByNode(localhost) {
+		if instMonitor.IsHALeader {
+			l = append(l, p)
+		}
+	}
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].String() < l[j].String()
+	})
+	return l
+}
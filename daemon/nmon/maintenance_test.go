@@ -0,0 +1,122 @@
+package nmon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+func TestMaintenanceStateSaveLoad(t *testing.T) {
+	varDir := rawconfig.Paths.Var
+	rawconfig.Paths.Var = t.TempDir()
+	defer func() { rawconfig.Paths.Var = varDir }()
+
+	m, err := loadMaintenanceState()
+	require.NoError(t, err)
+	require.Nil(t, m, "expected no maintenance state without maintenance file")
+
+	now := time.Now().Truncate(time.Second)
+	saved := maintenanceState{
+		Maintenance: node.Maintenance{
+			Reason:   "firmware upgrade",
+			Since:    now,
+			ExpireAt: now.Add(time.Hour),
+		},
+		Giveback:  []naming.Path{{Name: "svc1", Namespace: "root", Kind: naming.KindSvc}},
+		WasFrozen: true,
+	}
+	require.NoError(t, saved.save())
+
+	m, err = loadMaintenanceState()
+	require.NoError(t, err)
+	require.NotNil(t, m)
+	require.Equal(t, saved.Reason, m.Reason)
+	require.True(t, saved.Since.Equal(m.Since))
+	require.True(t, saved.ExpireAt.Equal(m.ExpireAt))
+	require.Equal(t, saved.Giveback, m.Giveback)
+	require.True(t, m.WasFrozen)
+	require.False(t, m.Drained)
+}
+
+func TestMaintenanceDrained(t *testing.T) {
+	varDir := rawconfig.Paths.Var
+	rawconfig.Paths.Var = t.TempDir()
+	defer func() { rawconfig.Paths.Var = varDir }()
+
+	m := &Manager{
+		log: plog.NewDefaultLogger(),
+		maintenance: &maintenanceState{
+			Maintenance: node.Maintenance{
+				Reason: "firmware upgrade",
+				Since:  time.Now().Truncate(time.Second),
+			},
+		},
+	}
+	require.NoError(t, m.maintenance.save())
+
+	m.onMaintenanceDrained()
+	require.True(t, m.maintenance.Drained)
+	saved, err := loadMaintenanceState()
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	require.True(t, saved.Drained, "expected the drain success persisted, so it is not resumed after a daemon restart")
+}
+
+func TestGivebackPendingSaveLoad(t *testing.T) {
+	varDir := rawconfig.Paths.Var
+	rawconfig.Paths.Var = t.TempDir()
+	defer func() { rawconfig.Paths.Var = varDir }()
+
+	l, err := loadGivebackPending()
+	require.NoError(t, err)
+	require.Empty(t, l)
+
+	saved := []naming.Path{{Name: "svc1", Namespace: "root", Kind: naming.KindSvc}}
+	require.NoError(t, saveGivebackPending(saved))
+	l, err = loadGivebackPending()
+	require.NoError(t, err)
+	require.Equal(t, saved, l)
+
+	require.NoError(t, removeGivebackPending())
+	l, err = loadGivebackPending()
+	require.NoError(t, err)
+	require.Empty(t, l, "expected no giveback pending after removal")
+}
+
+func TestMaintenanceExpiredRetry(t *testing.T) {
+	retryInterval := maintenanceRetryInterval
+	maintenanceRetryInterval = 10 * time.Millisecond
+	defer func() { maintenanceRetryInterval = retryInterval }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m := &Manager{
+		ctx:  ctx,
+		cmdC: make(chan any),
+		log:  plog.NewDefaultLogger(),
+		maintenance: &maintenanceState{
+			Maintenance: node.Maintenance{
+				Reason:   "firmware upgrade",
+				Since:    time.Now().Add(-time.Hour),
+				ExpireAt: time.Now().Add(-time.Minute),
+			},
+		},
+	}
+	m.state.LocalExpect = node.MonitorLocalExpectDrained
+
+	m.onMaintenanceExpired()
+	require.NotNil(t, m.maintenance, "expected the maintenance kept while the node is draining")
+	select {
+	case c := <-m.cmdC:
+		require.IsType(t, cmdMaintenanceExpired{}, c)
+	case <-time.After(time.Second):
+		require.Fail(t, "expected the expired maintenance leave to be retried")
+	}
+}
@@ -16,10 +16,11 @@ func (t *Manager) orchestrateDrained() {
 	case node.MonitorStateFreezeSuccess:
 		t.drainFromFrozen()
 	case node.MonitorStateDrainSuccess:
+		t.onMaintenanceDrained()
 		t.change = true
 		t.state.State = node.MonitorStateIdle
 		t.state.LocalExpect = node.MonitorLocalExpectNone
-	case node.MonitorStateDrainFailure:
+	case node.MonitorStateFreezeFailure, node.MonitorStateDrainFailure:
 		t.change = true
 		t.state.LocalExpect = node.MonitorLocalExpectNone
 	default:
@@ -485,11 +485,24 @@ func (t *Manager) updateStatus() {
 		}
 	}
 
+	updatePlacementScoresAndReason := func() {
+		// the placement scores and reason are computed by the local imon,
+		// the peers compute the same values from the same dataset.
+		if instMonitor, ok := t.instMonitor[t.localhost]; ok {
+			t.status.PlacementScores = instMonitor.PlacementScores.DeepCopy()
+			t.status.PlacementReason = instMonitor.PlacementReason
+		} else {
+			t.status.PlacementScores = nil
+			t.status.PlacementReason = ""
+		}
+	}
+
 	if t.isActor {
 		updateAvailOverall()
 		updateProvisioned()
 		updateFrozen()
 		updatePlacementState()
+		updatePlacementScoresAndReason()
 	}
 	t.update()
 }
@@ -0,0 +1,269 @@
+// Package ratelimit implements the per-user api rate limits and stream
+// quotas declared in the cluster config rate_limit#<name> sections.
+//
+// A rule applies to the requests of the users, roles and endpoint groups
+// it matches, each user having its own token bucket and stream counter.
+// A request is allowed only if all the matching rules allow it.
+package ratelimit
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
+)
+
+type (
+	// Limiter holds the per-user state of the configured rules.
+	Limiter struct {
+		mu      sync.Mutex
+		rules   []cluster.ConfigRateLimit
+		buckets map[bucketKey]*bucket
+
+		// changed is notified when the state returned by State changes.
+		changed chan struct{}
+	}
+
+	// Request describes the api request to account.
+	Request struct {
+		User  string
+		Roles []string
+		Group string
+	}
+
+	bucketKey struct {
+		rule string
+		user string
+	}
+
+	bucket struct {
+		limiter  *rate.Limiter
+		streams  int
+		denied   uint64
+		lastSeen time.Time
+	}
+)
+
+const (
+	// GroupAction is the endpoint group of the object and node actions.
+	GroupAction = "action"
+
+	// GroupRead is the endpoint group of the GET requests.
+	GroupRead = "read"
+
+	// GroupStream is the endpoint group of the event and log streams.
+	GroupStream = "stream"
+
+	// GroupWrite is the endpoint group of the other requests.
+	GroupWrite = "write"
+)
+
+var (
+	// Groups is the list of the supported endpoint groups.
+	Groups = []string{GroupAction, GroupRead, GroupStream, GroupWrite}
+
+	// StreamRetryAfter is the delay advertised to the clients denied a
+	// new stream.
+	StreamRetryAfter = 5 * time.Second
+
+	// IdleTimeout is the delay after which the state of a user without
+	// request nor stream is forgotten.
+	IdleTimeout = 10 * time.Minute
+)
+
+// New returns a Limiter without rule.
+func New() *Limiter {
+	return &Limiter{
+		buckets: make(map[bucketKey]*bucket),
+		changed: make(chan struct{}, 1),
+	}
+}
+
+// Changed returns the channel notified when the users, open streams or
+// denied requests counts change. The token buckets refill is not a
+// change, so the state is published only when it changes.
+func (t *Limiter) Changed() <-chan struct{} {
+	return t.changed
+}
+
+// notify notifies a state change without blocking. A pending
+// notification already covers this change.
+func (t *Limiter) notify() {
+	select {
+	case t.changed <- struct{}{}:
+	default:
+	}
+}
+
+// Configure sets the rules. The state of the users of the removed or
+// changed rules is reset.
+func (t *Limiter) Configure(rules []cluster.ConfigRateLimit) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	kept := make(map[string]bool)
+	for _, rule := range rules {
+		i := slices.IndexFunc(t.rules, func(r cluster.ConfigRateLimit) bool { return r.Name == rule.Name })
+		if i >= 0 && equalRule(t.rules[i], rule) {
+			kept[rule.Name] = true
+		}
+	}
+	for k := range t.buckets {
+		if !kept[k.rule] {
+			delete(t.buckets, k)
+		}
+	}
+	t.rules = append([]cluster.ConfigRateLimit{}, rules...)
+	t.notify()
+}
+
+// Allow accounts the request on all the matching rules. If allowed, the
+// returned release function must be called when the request ends. If
+// denied, release is nil, retryAfter is the delay before the request can
+// be retried and rule is the name of the rule denying the request.
+func (t *Limiter) Allow(req Request, now time.Time) (release func(), retryAfter time.Duration, rule string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var (
+		reserved     []*rate.Reservation
+		streamBucket []*bucket
+	)
+	cancel := func() {
+		for _, r := range reserved {
+			r.CancelAt(now)
+		}
+	}
+	for _, r := range t.rules {
+		if !match(r, req) {
+			continue
+		}
+		b := t.bucket(r, req.User, now)
+		if req.Group == GroupStream && r.Streams > 0 {
+			if b.streams >= r.Streams {
+				cancel()
+				b.denied++
+				t.notify()
+				return nil, StreamRetryAfter, r.Name
+			}
+			streamBucket = append(streamBucket, b)
+		}
+		if b.limiter == nil {
+			continue
+		}
+		reservation := b.limiter.ReserveN(now, 1)
+		if !reservation.OK() {
+			cancel()
+			b.denied++
+			t.notify()
+			return nil, time.Second, r.Name
+		}
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			reservation.CancelAt(now)
+			cancel()
+			b.denied++
+			t.notify()
+			return nil, delay, r.Name
+		}
+		reserved = append(reserved, reservation)
+	}
+	for _, b := range streamBucket {
+		b.streams++
+	}
+	if len(streamBucket) > 0 {
+		t.notify()
+	}
+	var once sync.Once
+	release = func() {
+		once.Do(func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			for _, b := range streamBucket {
+				b.streams--
+				b.lastSeen = time.Now()
+			}
+			if len(streamBucket) > 0 {
+				t.notify()
+			}
+		})
+	}
+	return release, 0, ""
+}
+
+// State returns the state of the users of the rules, sorted by rule and
+// user. The state of the idle users is forgotten.
+func (t *Limiter) State(now time.Time) []daemonsubsystem.ListenerQuota {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	l := make([]daemonsubsystem.ListenerQuota, 0, len(t.buckets))
+	for k, b := range t.buckets {
+		if b.streams == 0 && now.Sub(b.lastSeen) > IdleTimeout {
+			delete(t.buckets, k)
+			continue
+		}
+		l = append(l, daemonsubsystem.ListenerQuota{
+			Rule:    k.rule,
+			User:    k.user,
+			Streams: b.streams,
+			Denied:  b.denied,
+		})
+	}
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].Rule != l[j].Rule {
+			return l[i].Rule < l[j].Rule
+		}
+		return l[i].User < l[j].User
+	})
+	return l
+}
+
+// bucket returns the state of the user for the rule r, creating it if
+// needed.
+func (t *Limiter) bucket(r cluster.ConfigRateLimit, user string, now time.Time) *bucket {
+	k := bucketKey{rule: r.Name, user: user}
+	b, ok := t.buckets[k]
+	if !ok {
+		b = &bucket{}
+		if r.Rate > 0 {
+			burst := r.Burst
+			if burst == 0 {
+				burst = int(math.Max(1, math.Ceil(float64(r.Rate))))
+			}
+			b.limiter = rate.NewLimiter(r.Rate, burst)
+		}
+		t.buckets[k] = b
+		t.notify()
+	}
+	b.lastSeen = now
+	return b
+}
+
+// match returns true if the rule r applies to the request. An empty rule
+// list matches all.
+func match(r cluster.ConfigRateLimit, req Request) bool {
+	if len(r.Users) > 0 && !slices.Contains(r.Users, req.User) {
+		return false
+	}
+	if len(r.Endpoints) > 0 && !slices.Contains(r.Endpoints, req.Group) {
+		return false
+	}
+	if len(r.Roles) > 0 && !slices.ContainsFunc(req.Roles, func(s string) bool { return slices.Contains(r.Roles, s) }) {
+		return false
+	}
+	return true
+}
+
+func equalRule(a, b cluster.ConfigRateLimit) bool {
+	return a.Name == b.Name &&
+		a.Rate == b.Rate &&
+		a.Burst == b.Burst &&
+		a.Streams == b.Streams &&
+		slices.Equal(a.Users, b.Users) &&
+		slices.Equal(a.Roles, b.Roles) &&
+		slices.Equal(a.Endpoints, b.Endpoints)
+}
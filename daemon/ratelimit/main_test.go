@@ -0,0 +1,144 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
+)
+
+func TestAllowRate(t *testing.T) {
+	l := New()
+	l.Configure([]cluster.ConfigRateLimit{
+		{Name: "reads", Endpoints: []string{GroupRead}, Rate: 1, Burst: 2},
+	})
+	now := time.Now()
+	req := Request{User: "alice", Group: GroupRead}
+	for i := 0; i < 2; i++ {
+		release, _, rule := l.Allow(req, now)
+		require.NotNil(t, release)
+		require.Equal(t, "", rule)
+		release()
+	}
+	release, retryAfter, rule := l.Allow(req, now)
+	require.Nil(t, release)
+	require.Equal(t, "reads", rule)
+	require.Equal(t, time.Second, retryAfter)
+
+	// other users and endpoint groups have their own budget
+	release, _, _ = l.Allow(Request{User: "bob", Group: GroupRead}, now)
+	require.NotNil(t, release)
+	release, _, _ = l.Allow(Request{User: "alice", Group: GroupAction}, now)
+	require.NotNil(t, release)
+
+	release, _, _ = l.Allow(req, now.Add(time.Second))
+	require.NotNil(t, release)
+
+	require.Equal(t, []daemonsubsystem.ListenerQuota{
+		{Rule: "reads", User: "alice", Denied: 1},
+		{Rule: "reads", User: "bob"},
+	}, l.State(now.Add(time.Second)))
+}
+
+func TestAllowAllRules(t *testing.T) {
+	l := New()
+	l.Configure([]cluster.ConfigRateLimit{
+		{Name: "all", Rate: 10, Burst: 10},
+		{Name: "guests", Roles: []string{"guest"}, Rate: 1, Burst: 1},
+	})
+	now := time.Now()
+	req := Request{User: "alice", Roles: []string{"guest"}, Group: GroupRead}
+	release, _, _ := l.Allow(req, now)
+	require.NotNil(t, release)
+	release, _, rule := l.Allow(req, now)
+	require.Nil(t, release)
+	require.Equal(t, "guests", rule)
+
+	// the denied request does not consume the tokens of the other rules
+	require.Equal(t, 9.0, l.buckets[bucketKey{rule: "all", user: "alice"}].limiter.TokensAt(now))
+}
+
+func TestAllowStreams(t *testing.T) {
+	l := New()
+	l.Configure([]cluster.ConfigRateLimit{
+		{Name: "streams", Endpoints: []string{GroupStream}, Streams: 2},
+	})
+	now := time.Now()
+	req := Request{User: "dashboard", Group: GroupStream}
+	release1, _, _ := l.Allow(req, now)
+	require.NotNil(t, release1)
+	release2, _, _ := l.Allow(req, now)
+	require.NotNil(t, release2)
+	release, retryAfter, rule := l.Allow(req, now)
+	require.Nil(t, release)
+	require.Equal(t, "streams", rule)
+	require.Equal(t, StreamRetryAfter, retryAfter)
+
+	release1()
+	release1()
+	require.Equal(t, 1, l.State(now)[0].Streams)
+	release, _, _ = l.Allow(req, now)
+	require.NotNil(t, release)
+}
+
+func TestConfigure(t *testing.T) {
+	l := New()
+	rules := []cluster.ConfigRateLimit{
+		{Name: "a", Rate: 1, Burst: 1},
+		{Name: "b", Rate: 1, Burst: 1},
+	}
+	l.Configure(rules)
+	now := time.Now()
+	release, _, _ := l.Allow(Request{User: "alice"}, now)
+	require.NotNil(t, release)
+	require.Len(t, l.State(now), 2)
+
+	l.Configure([]cluster.ConfigRateLimit{
+		{Name: "a", Rate: 1, Burst: 1},
+		{Name: "b", Rate: 2, Burst: 2},
+	})
+	require.Equal(t, []daemonsubsystem.ListenerQuota{
+		{Rule: "a", User: "alice"},
+	}, l.State(now))
+}
+
+func TestChanged(t *testing.T) {
+	l := New()
+	changed := func() bool {
+		select {
+		case <-l.Changed():
+			return true
+		default:
+			return false
+		}
+	}
+	l.Configure([]cluster.ConfigRateLimit{
+		{Name: "reads", Endpoints: []string{GroupRead}, Rate: 1, Burst: 1},
+		{Name: "streams", Endpoints: []string{GroupStream}, Streams: 1},
+	})
+	require.True(t, changed(), "configure")
+
+	now := time.Now()
+	req := Request{User: "alice", Group: GroupRead}
+	release, _, _ := l.Allow(req, now)
+	require.NotNil(t, release)
+	require.True(t, changed(), "new user")
+
+	release, _, _ = l.Allow(req, now.Add(time.Second))
+	require.NotNil(t, release)
+	require.False(t, changed(), "allowed request of a known user")
+
+	release, _, _ = l.Allow(req, now.Add(time.Second))
+	require.Nil(t, release)
+	require.True(t, changed(), "denied request")
+
+	release, _, _ = l.Allow(Request{User: "alice", Group: GroupStream}, now)
+	require.NotNil(t, release)
+	require.True(t, changed(), "stream open")
+	release()
+	require.True(t, changed(), "stream close")
+	require.False(t, changed())
+}
@@ -0,0 +1,247 @@
+package rbac
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/util/label"
+)
+
+type (
+	// Permission is an api operation on an object, allowed by a role.
+	Permission string
+
+	// CustomRole is an administrator-defined role, declared in a cluster
+	// configuration role#<name> section. A user granted <name>:<namespace>
+	// is allowed the role permissions on the namespace objects selected by
+	// the role selector and labels.
+	CustomRole struct {
+		Name        string       `json:"name"`
+		Permissions []Permission `json:"permissions"`
+
+		// Selector is a list of object path patterns. The role applies to
+		// the objects matching any pattern. An empty list selects all
+		// objects.
+		Selector []string `json:"selector,omitempty"`
+
+		// Labels is the set of labels the objects must all have for the
+		// role to apply.
+		Labels label.M `json:"labels,omitempty"`
+	}
+
+	// CustomRoles is a map of CustomRole indexed by role name
+	CustomRoles map[string]CustomRole
+
+	// Decision is the result of a permission evaluation.
+	Decision struct {
+		Allowed bool   `json:"allowed"`
+		Grant   Grant  `json:"grant,omitempty"`
+		Reason  string `json:"reason"`
+	}
+)
+
+const (
+	// PermAll allows all the operations a custom role can allow.
+	PermAll Permission = "*"
+
+	PermRead Permission = "read"
+
+	PermAbort            Permission = "abort"
+	PermClear            Permission = "clear"
+	PermFreeze           Permission = "freeze"
+	PermGiveback         Permission = "giveback"
+	PermPRStart          Permission = "prstart"
+	PermPRStop           Permission = "prstop"
+	PermPushResInfo      Permission = "push_resinfo"
+	PermResize           Permission = "resize"
+	PermRestart          Permission = "restart"
+	PermRun              Permission = "run"
+	PermShutdown         Permission = "shutdown"
+	PermSnapshot         Permission = "snapshot"
+	PermSnapshotRollback Permission = "snapshot_rollback"
+	PermStart            Permission = "start"
+	PermStartStandby     Permission = "startstandby"
+	PermStatus           Permission = "status"
+	PermStop             Permission = "stop"
+	PermSwitch           Permission = "switch"
+	PermSyncIngest       Permission = "sync_ingest"
+	PermUnfreeze         Permission = "unfreeze"
+)
+
+var (
+	// permissions is the list of the operations a custom role can allow.
+	permissions = []Permission{
+		PermAll,
+		PermRead,
+		PermAbort,
+		PermClear,
+		PermFreeze,
+		PermGiveback,
+		PermPRStart,
+		PermPRStop,
+		PermPushResInfo,
+		PermResize,
+		PermRestart,
+		PermRun,
+		PermShutdown,
+		PermSnapshot,
+		PermSnapshotRollback,
+		PermStart,
+		PermStartStandby,
+		PermStatus,
+		PermStop,
+		PermSwitch,
+		PermSyncIngest,
+		PermUnfreeze,
+	}
+)
+
+// ParsePermission returns the Permission named s, or an error if s is not
+// a known operation.
+func ParsePermission(s string) (Permission, error) {
+	perm := Permission(s)
+	if !slices.Contains(permissions, perm) {
+		return perm, fmt.Errorf("unknown permission '%s'", s)
+	}
+	return perm, nil
+}
+
+// Permissions returns the list of the operations a custom role can allow.
+func Permissions() []string {
+	l := make([]string, len(permissions))
+	for i, perm := range permissions {
+		l[i] = string(perm)
+	}
+	return l
+}
+
+// IsBuiltinRole returns true if s is the name of a builtin role.
+func IsBuiltinRole(s string) bool {
+	_, ok := roleMap[s]
+	return ok
+}
+
+// BuiltinGrants returns the builtin grants allowing the operation on the
+// namespace objects: the guest, operator and admin roles allow read, the
+// operator and admin roles allow the other operations.
+func (t Permission) BuiltinGrants(namespace string) []Grant {
+	if t == PermRead {
+		return []Grant{
+			NewGrant(RoleGuest, namespace),
+			NewGrant(RoleOperator, namespace),
+			NewGrant(RoleAdmin, namespace),
+			NewGrant(RoleGuest, ""),
+			NewGrant(RoleOperator, ""),
+			NewGrant(RoleAdmin, ""),
+			GrantJoin,
+			GrantRoot,
+		}
+	}
+	return []Grant{
+		NewGrant(RoleOperator, namespace),
+		NewGrant(RoleAdmin, namespace),
+		NewGrant(RoleOperator, ""),
+		NewGrant(RoleAdmin, ""),
+		GrantRoot,
+	}
+}
+
+// Allows returns true if the role permissions include perm.
+func (t CustomRole) Allows(perm Permission) bool {
+	return slices.Contains(t.Permissions, perm) || slices.Contains(t.Permissions, PermAll)
+}
+
+// Selects returns true if the object path p with labels is selected by
+// the role selector and labels.
+func (t CustomRole) Selects(p naming.Path, labels label.M) bool {
+	for k, v := range t.Labels {
+		if value, ok := labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	if len(t.Selector) == 0 {
+		return true
+	}
+	for _, pattern := range t.Selector {
+		if p.Match(pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t CustomRole) DeepCopy() CustomRole {
+	return CustomRole{
+		Name:        t.Name,
+		Permissions: append([]Permission{}, t.Permissions...),
+		Selector:    append([]string{}, t.Selector...),
+		Labels:      t.Labels.DeepCopy(),
+	}
+}
+
+func (t CustomRoles) DeepCopy() CustomRoles {
+	if t == nil {
+		return nil
+	}
+	m := make(CustomRoles, len(t))
+	for name, role := range t {
+		m[name] = role.DeepCopy()
+	}
+	return m
+}
+
+// IsGranted returns true if one of the grants refers to a custom role.
+func (t CustomRoles) IsGranted(grants Grants) bool {
+	for _, grant := range grants {
+		if _, ok := t[string(grant.Role())]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Can returns the decision of allowing the perm operation on the object
+// path p, having the labels, to a user with grants. The builtin grants are
+// evaluated first, then the grants of the custom roles.
+func (t CustomRoles) Can(grants Grants, perm Permission, p naming.Path, labels label.M) Decision {
+	for _, grant := range perm.BuiltinGrants(p.Namespace) {
+		if grants.HasGrant(grant) {
+			return Decision{
+				Allowed: true,
+				Grant:   grant,
+				Reason:  fmt.Sprintf("the builtin role %s allows %s on %s", grant.Role(), perm, p),
+			}
+		}
+	}
+	var reasons []string
+	for _, grant := range grants {
+		role, ok := t[string(grant.Role())]
+		if !ok {
+			continue
+		}
+		switch {
+		case grant.Scope() != "" && grant.Scope() != p.Namespace:
+			reasons = append(reasons, fmt.Sprintf("%s: not scoped on namespace %s", grant, p.Namespace))
+		case !role.Allows(perm):
+			reasons = append(reasons, fmt.Sprintf("%s: %s is not in the role permissions", grant, perm))
+		case !role.Selects(p, labels):
+			reasons = append(reasons, fmt.Sprintf("%s: %s is not selected by the role selector and labels", grant, p))
+		default:
+			return Decision{
+				Allowed: true,
+				Grant:   grant,
+				Reason:  fmt.Sprintf("the custom role %s allows %s on %s", role.Name, perm, p),
+			}
+		}
+	}
+	if len(reasons) == 0 {
+		return Decision{
+			Reason: fmt.Sprintf("no grant allows %s on %s", perm, p),
+		}
+	}
+	return Decision{
+		Reason: fmt.Sprintf("no grant allows %s on %s: %s", perm, p, strings.Join(reasons, ", ")),
+	}
+}
@@ -0,0 +1,93 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/util/label"
+)
+
+func TestCustomRolesCan(t *testing.T) {
+	roles := CustomRoles{
+		"deployer": CustomRole{
+			Name:        "deployer",
+			Permissions: []Permission{PermRead, PermStart, PermStop, PermRestart},
+			Selector:    []string{"*/svc/web*"},
+		},
+		"frontops": CustomRole{
+			Name:        "frontops",
+			Permissions: []Permission{PermAll},
+			Labels:      label.M{"tier": "front"},
+		},
+	}
+	web1 := naming.Path{Namespace: "ns1", Kind: naming.KindSvc, Name: "web1"}
+	db1 := naming.Path{Namespace: "ns1", Kind: naming.KindSvc, Name: "db1"}
+
+	t.Run("builtin operator role", func(t *testing.T) {
+		decision := roles.Can(NewGrants("operator:ns1"), PermStart, web1, nil)
+		require.True(t, decision.Allowed)
+		require.Equal(t, Grant("operator:ns1"), decision.Grant)
+	})
+
+	t.Run("builtin guest role can't start", func(t *testing.T) {
+		decision := roles.Can(NewGrants("guest:ns1"), PermStart, web1, nil)
+		require.False(t, decision.Allowed)
+	})
+
+	t.Run("custom role allows restart on selected object", func(t *testing.T) {
+		decision := roles.Can(NewGrants("deployer:ns1"), PermRestart, web1, nil)
+		require.True(t, decision.Allowed, decision.Reason)
+		require.Equal(t, Grant("deployer:ns1"), decision.Grant)
+	})
+
+	t.Run("custom role without namespace scope", func(t *testing.T) {
+		decision := roles.Can(NewGrants("deployer"), PermRestart, web1, nil)
+		require.True(t, decision.Allowed, decision.Reason)
+	})
+
+	t.Run("custom role denies freeze", func(t *testing.T) {
+		decision := roles.Can(NewGrants("deployer:ns1"), PermFreeze, web1, nil)
+		require.False(t, decision.Allowed)
+		require.Contains(t, decision.Reason, "freeze is not in the role permissions")
+	})
+
+	t.Run("custom role denies other namespace", func(t *testing.T) {
+		decision := roles.Can(NewGrants("deployer:ns2"), PermStart, web1, nil)
+		require.False(t, decision.Allowed)
+		require.Contains(t, decision.Reason, "not scoped on namespace ns1")
+	})
+
+	t.Run("custom role denies unselected object", func(t *testing.T) {
+		decision := roles.Can(NewGrants("deployer:ns1"), PermStart, db1, nil)
+		require.False(t, decision.Allowed)
+		require.Contains(t, decision.Reason, "is not selected")
+	})
+
+	t.Run("custom role with labels", func(t *testing.T) {
+		decision := roles.Can(NewGrants("frontops:ns1"), PermSwitch, db1, label.M{"tier": "front", "env": "prd"})
+		require.True(t, decision.Allowed, decision.Reason)
+
+		decision = roles.Can(NewGrants("frontops:ns1"), PermSwitch, db1, label.M{"tier": "back"})
+		require.False(t, decision.Allowed)
+
+		decision = roles.Can(NewGrants("frontops:ns1"), PermSwitch, db1, nil)
+		require.False(t, decision.Allowed)
+	})
+
+	t.Run("undeclared role", func(t *testing.T) {
+		decision := roles.Can(NewGrants("unknown:ns1"), PermRead, web1, nil)
+		require.False(t, decision.Allowed)
+		require.Equal(t, "no grant allows read on ns1/svc/web1", decision.Reason)
+	})
+}
+
+func TestParsePermission(t *testing.T) {
+	perm, err := ParsePermission("restart")
+	require.NoError(t, err)
+	require.Equal(t, PermRestart, perm)
+
+	_, err = ParsePermission("delete")
+	require.Error(t, err)
+}
@@ -0,0 +1,206 @@
+// Package rebalancer is responsible for the automatic rebalancing of the ha
+// objects with a non-optimal placement.
+//
+// When enabled by the cluster.rebalance keyword, the cluster leader node
+// periodically submits giveback orchestrations for the flex objects and
+// switch orchestrations to the ha leader node for the failover objects, so
+// the instances move back to their preferred nodes after a failover.
+//
+// The submissions are limited by:
+//
+//   - the cluster.rebalance_schedule maintenance window
+//   - the cluster.rebalance_interval minimum delay between two submissions
+//   - the cluster.rebalance_max_parallel maximum number of rebalancing
+//     orchestrations running at the same time
+package rebalancer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+type (
+	T struct {
+		ctx       context.Context
+		cancel    context.CancelFunc
+		log       *plog.Logger
+		localhost string
+		publisher pubsub.Publisher
+
+		sub   *pubsub.Subscription
+		subQS pubsub.QueueSizer
+
+		// pending is the submission time of the rebalancing orchestrations
+		// not yet done, indexed by object path.
+		pending map[naming.Path]time.Time
+
+		// lastSubmitAt is the time of the last rebalancing orchestration
+		// submission.
+		lastSubmitAt time.Time
+
+		wg sync.WaitGroup
+	}
+)
+
+var (
+	// checkInterval is the delay between two rebalancing evaluations.
+	checkInterval = 10 * time.Second
+
+	// pendingMinAge is the delay before a submitted orchestration not
+	// reported by any instance monitor is considered done. It leaves time
+	// for the orchestration to propagate to the instance monitors.
+	pendingMinAge = 10 * time.Second
+
+	// submitTimeout is the maximum duration of an orchestration submission.
+	submitTimeout = 5 * time.Second
+)
+
+func New(subQS pubsub.QueueSizer) *T {
+	return &T{
+		pending: make(map[naming.Path]time.Time),
+		subQS:   subQS,
+	}
+}
+
+// Start launches the rebalancer worker goroutine
+func (t *T) Start(parent context.Context) error {
+	t.log = plog.NewDefaultLogger().WithPrefix("daemon: rebalancer: ").Attr("pkg", "daemon/rebalancer")
+	t.log.Tracef("starting")
+	defer t.log.Tracef("started")
+	t.ctx, t.cancel = context.WithCancel(parent)
+	t.localhost = hostname.Hostname()
+	t.publisher = pubsub.PubFromContext(t.ctx)
+
+	t.startSubscriptions()
+	running := make(chan bool)
+	t.wg.Add(1)
+	go func() {
+		t.log.Tracef("start")
+		running <- true
+		defer t.log.Tracef("done")
+		defer t.wg.Done()
+		defer func() {
+			if err := t.sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+				t.log.Errorf("subscription stop error %s", err)
+			}
+		}()
+		t.worker()
+	}()
+	<-running
+	return nil
+}
+
+func (t *T) Stop() error {
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
+
+func (t *T) startSubscriptions() {
+	sub := pubsub.SubFromContext(t.ctx, "daemon.rebalancer", t.subQS)
+	sub.AddFilter(&msgbus.AuditStart{})
+	sub.AddFilter(&msgbus.AuditStop{})
+	sub.Start()
+	t.sub = sub
+}
+
+func (t *T) worker() {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case i := <-t.sub.C:
+			switch c := i.(type) {
+			case *msgbus.AuditStart:
+				t.log.HandleAuditStart(c.Q, c.Subsystems, "rebalancer")
+			case *msgbus.AuditStop:
+				t.log.HandleAuditStop(c.Q, c.Subsystems, "rebalancer")
+			}
+		case now := <-ticker.C:
+			t.onTick(now)
+		}
+	}
+}
+
+func (t *T) onTick(now time.Time) {
+	cfg := cluster.ConfigData.Get().Rebalance
+	if !cfg.Enabled || !t.isLeader() {
+		clear(t.pending)
+		return
+	}
+	t.dropDone(now)
+	if err := isInWindow(cfg.Schedule, now); err != nil {
+		t.log.Tracef("skip: %s", err)
+		return
+	}
+	if nodename, ok := unrankableNode(); ok {
+		t.log.Tracef("skip: node %s is not rankable", nodename)
+		return
+	}
+	if n := max(cfg.MaxParallel, 1); len(t.pending) >= n {
+		t.log.Tracef("skip: %d rebalancing orchestrations running, max %d", len(t.pending), n)
+		return
+	}
+	if now.Sub(t.lastSubmitAt) < cfg.Interval {
+		t.log.Tracef("skip: last submission at %s, interval %s", t.lastSubmitAt, cfg.Interval)
+		return
+	}
+	for _, o := range newOrders() {
+		if _, ok := t.pending[o.Path]; ok {
+			continue
+		}
+		t.lastSubmitAt = now
+		if err := t.submit(o); err != nil {
+			t.log.Warnf("%s: submit %s: %s", o.Path, o, err)
+			return
+		}
+		t.log.Infof("%s: submitted %s", o.Path, o)
+		t.pending[o.Path] = now
+		return
+	}
+}
+
+// isLeader returns true if the local node is the cluster leader node.
+func (t *T) isLeader() bool {
+	nodeStatus := node.StatusData.GetByNode(t.localhost)
+	return nodeStatus != nil && nodeStatus.IsLeader
+}
+
+// dropDone forgets the submitted orchestrations no longer reported by the
+// object instance monitors.
+func (t *T) dropDone(now time.Time) {
+	for p, submitAt := range t.pending {
+		if now.Sub(submitAt) < pendingMinAge {
+			continue
+		}
+		if isOrchestrated(instance.MonitorData.GetByPath(p)) {
+			continue
+		}
+		t.log.Infof("%s: rebalancing orchestration done", p)
+		delete(t.pending, p)
+	}
+}
+
+// unrankableNode returns the name of a cluster node in a transient state,
+// like rejoin or shutdown, during which the rebalancing is postponed.
+func unrankableNode() (string, bool) {
+	for _, e := range node.MonitorData.GetAll() {
+		if !e.Value.State.IsRankable() {
+			return e.Node, true
+		}
+	}
+	return "", false
+}
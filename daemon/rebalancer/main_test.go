@@ -0,0 +1,86 @@
+package rebalancer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/placement"
+	"github.com/opensvc/om3/v3/core/topology"
+)
+
+func TestIsInWindow(t *testing.T) {
+	tm := time.Date(2024, 6, 1, 3, 0, 0, 0, time.Local) // saturday
+	require.NoError(t, isInWindow("", tm))
+	require.NoError(t, isInWindow("02:00-04:00", tm))
+	require.NoError(t, isInWindow("02:00-04:00@1m sat", tm))
+	require.Error(t, isInWindow("05:00-06:00", tm))
+	require.Error(t, isInWindow("02:00-04:00 mon-fri", tm))
+}
+
+func TestNewOrder(t *testing.T) {
+	p := naming.Path{Namespace: "test", Kind: naming.KindSvc, Name: "s1"}
+	newStatus := func(topo topology.T) object.Status {
+		return object.Status{
+			ActorStatus: &object.ActorStatus{
+				Frozen:         "unfrozen",
+				Orchestrate:    "ha",
+				PlacementState: placement.NonOptimal,
+				Topology:       topo,
+			},
+		}
+	}
+	instMonitors := func() map[string]*instance.Monitor {
+		return map[string]*instance.Monitor{
+			"n1": {GlobalExpect: instance.MonitorGlobalExpectNone, IsHALeader: true},
+			"n2": {GlobalExpect: instance.MonitorGlobalExpectNone},
+		}
+	}
+
+	t.Run("failover switch to the ha leader", func(t *testing.T) {
+		o, ok := newOrder(p, newStatus(topology.Failover), instMonitors())
+		require.True(t, ok)
+		require.Equal(t, instance.MonitorGlobalExpectPlacedAt, o.GlobalExpect)
+		require.Equal(t, []string{"n1"}, o.Destination)
+	})
+
+	t.Run("flex giveback", func(t *testing.T) {
+		o, ok := newOrder(p, newStatus(topology.Flex), instMonitors())
+		require.True(t, ok)
+		require.Equal(t, instance.MonitorGlobalExpectPlaced, o.GlobalExpect)
+		require.Empty(t, o.Destination)
+	})
+
+	t.Run("optimal placement", func(t *testing.T) {
+		objStatus := newStatus(topology.Failover)
+		objStatus.PlacementState = placement.Optimal
+		_, ok := newOrder(p, objStatus, instMonitors())
+		require.False(t, ok)
+	})
+
+	t.Run("frozen", func(t *testing.T) {
+		objStatus := newStatus(topology.Failover)
+		objStatus.Frozen = "mixed"
+		_, ok := newOrder(p, objStatus, instMonitors())
+		require.False(t, ok)
+	})
+
+	t.Run("orchestration in progress", func(t *testing.T) {
+		m := instMonitors()
+		m["n2"].OrchestrationID = uuid.New()
+		_, ok := newOrder(p, newStatus(topology.Failover), m)
+		require.False(t, ok)
+	})
+
+	t.Run("no ha leader", func(t *testing.T) {
+		m := instMonitors()
+		m["n1"].IsHALeader = false
+		_, ok := newOrder(p, newStatus(topology.Failover), m)
+		require.False(t, ok)
+	})
+}
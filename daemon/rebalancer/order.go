@@ -0,0 +1,199 @@
+package rebalancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/placement"
+	"github.com/opensvc/om3/v3/core/topology"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/daemonenv"
+	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/pubsub"
+	"github.com/opensvc/om3/v3/util/schedule"
+)
+
+type (
+	// order is a rebalancing orchestration to submit.
+	order struct {
+		Path naming.Path
+
+		// GlobalExpect is either placed (giveback) or placed@ (switch).
+		GlobalExpect instance.MonitorGlobalExpect
+
+		// Destination is the switch destination nodes.
+		Destination []string
+	}
+)
+
+func (t order) String() string {
+	if len(t.Destination) > 0 {
+		return fmt.Sprintf("%s %s", t.GlobalExpect, strings.Join(t.Destination, ","))
+	}
+	return t.GlobalExpect.String()
+}
+
+// isInWindow returns nil if tm is in the maintenance window defined by the
+// schedule expression s. An empty expression allows any time.
+func isInWindow(s string, tm time.Time) error {
+	if s == "" {
+		return nil
+	}
+	if _, err := schedule.New(s).Test(tm); err != nil {
+		return fmt.Errorf("out of the rebalance schedule '%s': %w", s, err)
+	}
+	return nil
+}
+
+// isOrchestrated returns true if an orchestration is in progress on one of the
+// instance monitors.
+func isOrchestrated(instMonitors map[string]*instance.Monitor) bool {
+	for _, instMonitor := range instMonitors {
+		switch instMonitor.GlobalExpect {
+		case instance.MonitorGlobalExpectInit, instance.MonitorGlobalExpectNone:
+		default:
+			return true
+		}
+		if instMonitor.OrchestrationID != uuid.Nil {
+			return true
+		}
+	}
+	return false
+}
+
+// newOrder returns the rebalancing orchestration to submit for the object p,
+// and false if the object doesn't need or doesn't accept to be rebalanced.
+func newOrder(p naming.Path, objStatus object.Status, instMonitors map[string]*instance.Monitor) (order, bool) {
+	if objStatus.ActorStatus == nil {
+		return order{}, false
+	}
+	if objStatus.Orchestrate != "ha" || objStatus.Frozen != "unfrozen" {
+		return order{}, false
+	}
+	if objStatus.PlacementState != placement.NonOptimal {
+		return order{}, false
+	}
+	if isOrchestrated(instMonitors) {
+		return order{}, false
+	}
+	switch objStatus.Topology {
+	case topology.Failover:
+		var leaders []string
+		for nodename, instMonitor := range instMonitors {
+			if instMonitor.IsHALeader {
+				leaders = append(leaders, nodename)
+			}
+		}
+		if len(leaders) != 1 {
+			return order{}, false
+		}
+		return order{Path: p, GlobalExpect: instance.MonitorGlobalExpectPlacedAt, Destination: leaders}, true
+	case topology.Flex:
+		return order{Path: p, GlobalExpect: instance.MonitorGlobalExpectPlaced}, true
+	default:
+		return order{}, false
+	}
+}
+
+// newOrders returns the rebalancing orchestrations to submit, sorted by
+// object priority and path.
+func newOrders() []order {
+	type prioritizedOrder struct {
+		order
+		priority int
+	}
+	var l []prioritizedOrder
+	for _, e := range object.StatusData.GetAll() {
+		if o, ok := newOrder(e.Path, *e.Value, instance.MonitorData.GetByPath(e.Path)); ok {
+			l = append(l, prioritizedOrder{order: o, priority: int(e.Value.Priority)})
+		}
+	}
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].priority != l[j].priority {
+			return l[i].priority < l[j].priority
+		}
+		return l[i].Path.String() < l[j].Path.String()
+	})
+	orders := make([]order, len(l))
+	for i, o := range l {
+		orders[i] = o.order
+	}
+	return orders
+}
+
+// submit sets the global expect of the object instance monitors.
+//
+// The order is submitted to the local instance monitor if the object has a
+// local instance, else to the daemon of a peer node hosting an instance.
+func (t *T) submit(o order) error {
+	ctx, cancel := context.WithTimeout(t.ctx, submitTimeout)
+	defer cancel()
+	if instance.MonitorData.GetByPathAndNode(o.Path, t.localhost) != nil {
+		return t.submitLocal(ctx, o)
+	}
+	for nodename := range instance.MonitorData.GetByPath(o.Path) {
+		return t.submitPeer(ctx, nodename, o)
+	}
+	return fmt.Errorf("no instance monitor")
+}
+
+func (t *T) submitLocal(ctx context.Context, o order) error {
+	value := instance.MonitorUpdate{
+		GlobalExpect:             &o.GlobalExpect,
+		CandidateOrchestrationID: uuid.New(),
+	}
+	if o.GlobalExpect == instance.MonitorGlobalExpectPlacedAt {
+		value.GlobalExpectOptions = instance.MonitorGlobalExpectOptionsPlacedAt{
+			Destination: o.Destination,
+		}
+	}
+	msg, setImonErr := msgbus.NewSetInstanceMonitorWithErr(ctx, o.Path, t.localhost, value)
+	t.publisher.Pub(msg, pubsub.Label{"namespace", o.Path.Namespace}, pubsub.Label{"path", o.Path.String()}, pubsub.Label{"origin", "rebalancer"})
+	return setImonErr.Receive()
+}
+
+func (t *T) submitPeer(ctx context.Context, nodename string, o order) error {
+	c, err := client.New(
+		client.WithURL(daemonsubsystem.PeerURL(nodename)),
+		client.WithUsername(t.localhost),
+		client.WithPassword(cluster.ConfigData.Get().Secret()),
+		client.WithCertificate(daemonenv.CertChainFile()),
+	)
+	if err != nil {
+		return err
+	}
+	var statusCode int
+	switch o.GlobalExpect {
+	case instance.MonitorGlobalExpectPlacedAt:
+		body := api.PostObjectActionSwitch{Destination: o.Destination}
+		resp, err := c.PostObjectActionSwitchWithResponse(ctx, o.Path.Namespace, o.Path.Kind, o.Path.Name, body)
+		if err != nil {
+			return err
+		}
+		statusCode = resp.StatusCode()
+	case instance.MonitorGlobalExpectPlaced:
+		resp, err := c.PostObjectActionGivebackWithResponse(ctx, o.Path.Namespace, o.Path.Kind, o.Path.Name)
+		if err != nil {
+			return err
+		}
+		statusCode = resp.StatusCode()
+	default:
+		return errors.New("unsupported global expect")
+	}
+	if statusCode != 200 {
+		return fmt.Errorf("%s: unexpected status code %d", nodename, statusCode)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package arraypure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type (
+	pureVolumeSnapshotSpace struct {
+		Snapshots     int64 `json:"snapshots,omitempty"`
+		TotalPhysical int64 `json:"total_physical,omitempty"`
+		Unique        int64 `json:"unique,omitempty"`
+	}
+
+	pureVolumeSnapshot struct {
+		ID          string                  `json:"id,omitempty"`
+		Name        string                  `json:"name,omitempty"`
+		Created     int64                   `json:"created,omitempty"`
+		Destroyed   bool                    `json:"destroyed,omitempty"`
+		Provisioned int64                   `json:"provisioned,omitempty"`
+		Serial      string                  `json:"serial,omitempty"`
+		Source      pureVolumeIdentifiers   `json:"source,omitempty"`
+		Space       pureVolumeSnapshotSpace `json:"space,omitempty"`
+		Suffix      string                  `json:"suffix,omitempty"`
+	}
+
+	pureResponseVolumeSnapshots struct {
+		TotalItems        int                  `json:"total_item_count,omitempty"`
+		ContinuationToken any                  `json:"continuation_token,omitempty"`
+		Items             []pureVolumeSnapshot `json:"items,omitempty"`
+	}
+)
+
+// CreatedAt returns the snapshot creation time.
+func (t pureVolumeSnapshot) CreatedAt() time.Time {
+	return time.UnixMilli(t.Created)
+}
+
+// AddSnapshot creates the <volumeName>.<suffix> snapshot of the volume.
+func (t *Array) AddSnapshot(ctx context.Context, volumeName, suffix string) (pureVolumeSnapshot, error) {
+	params := map[string]string{
+		"source_names": volumeName,
+	}
+	data := map[string]string{
+		"suffix": suffix,
+	}
+	req, err := t.newRequest(ctx, http.MethodPost, "/volume-snapshots", params, data)
+	if err != nil {
+		return pureVolumeSnapshot{}, err
+	}
+	var responseData pureResponseVolumeSnapshots
+	if _, err := t.Do(req, &responseData, true); err != nil {
+		return pureVolumeSnapshot{}, err
+	}
+	if len(responseData.Items) == 0 {
+		return pureVolumeSnapshot{}, fmt.Errorf("no volume snapshot item in response")
+	}
+	return responseData.Items[0], nil
+}
+
+// GetSnapshots returns the snapshots of the volume, destroyed snapshots
+// excluded.
+func (t *Array) GetSnapshots(ctx context.Context, volumeName string) ([]pureVolumeSnapshot, error) {
+	params := map[string]string{
+		"source_names": volumeName,
+		"destroyed":    "false",
+	}
+	req, err := t.newRequest(ctx, http.MethodGet, "/volume-snapshots", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var responseData pureResponseVolumeSnapshots
+	if _, err := t.Do(req, &responseData, true); err != nil {
+		return nil, err
+	}
+	return responseData.Items, nil
+}
+
+// DelSnapshot destroys and eradicates the snapshot <name>.
+func (t *Array) DelSnapshot(ctx context.Context, name string) error {
+	params := map[string]string{
+		"names": name,
+	}
+	data := map[string]any{
+		"destroyed": true,
+	}
+	req, err := t.newRequest(ctx, http.MethodPatch, "/volume-snapshots", params, data)
+	if err != nil {
+		return err
+	}
+	var responseData pureResponseVolumeSnapshots
+	if _, err := t.Do(req, &responseData, true); err != nil {
+		return err
+	}
+	req, err = t.newRequest(ctx, http.MethodDelete, "/volume-snapshots", params, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := t.Do(req, &responseData, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CopyVolume copies the source volume or snapshot to the volume <name>.
+// The existing volume data is replaced if overwrite is true.
+func (t *Array) CopyVolume(ctx context.Context, source, name string, overwrite bool) (pureVolume, error) {
+	params := map[string]string{
+		"names":     name,
+		"overwrite": fmt.Sprint(overwrite),
+	}
+	data := map[string]any{
+		"source": pureSourceIdentifiers{Name: source},
+	}
+	req, err := t.newRequest(ctx, http.MethodPost, "/volumes", params, data)
+	if err != nil {
+		return pureVolume{}, err
+	}
+	var responseData pureResponseVolumes
+	if _, err := t.Do(req, &responseData, true); err != nil {
+		return pureVolume{}, err
+	}
+	if len(responseData.Items) == 0 {
+		return pureVolume{}, fmt.Errorf("no volume item in response")
+	}
+	return responseData.Items[0], nil
+}
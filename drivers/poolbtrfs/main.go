@@ -0,0 +1,96 @@
+//go:build linux
+
+package poolbtrfs
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/util/btrfs"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+type (
+	T struct {
+		pool.T
+	}
+)
+
+var (
+	drvID = driver.NewID(driver.GroupPool, "btrfs")
+)
+
+func init() {
+	driver.Register(drvID, NewPooler)
+}
+
+func NewPooler() pool.Pooler {
+	t := New()
+	var i interface{} = t
+	return i.(pool.Pooler)
+}
+
+func New() *T {
+	t := T{}
+	return &t
+}
+
+func (t T) Head() string {
+	return t.device() + ":" + t.subvol()
+}
+
+func (t T) Capabilities() pool.Capabilities {
+	return pool.Capabilities{
+		pool.CapFile,
+		pool.CapROO,
+		pool.CapROX,
+		pool.CapRWO,
+		pool.CapRWX,
+	}
+}
+
+func (t T) device() string {
+	return t.GetString("dev")
+}
+
+func (t T) subvol() string {
+	return t.GetString("subvol")
+}
+
+func (t T) Usage(ctx context.Context) (pool.Usage, error) {
+	fs := btrfs.Filesystem{Device: t.device()}
+	info, err := fs.Usage(ctx)
+	if err != nil {
+		return pool.Usage{}, err
+	}
+	usage := pool.Usage{
+		Size: info.Size,
+		Free: info.Free,
+		Used: info.Used,
+	}
+	return usage, nil
+}
+
+// Translate returns the keywords of a btrfs fs resource mounting the
+// subvolume <subvol>/<name>, limited to size by its quota group.
+func (t *T) Translate(name string, size int64, shared bool) ([]string, error) {
+	data := []string{
+		"fs#0.type=btrfs",
+		"fs#0.dev=" + t.device(),
+		"fs#0.subvol=" + filepath.Join(t.subvol(), name),
+		"fs#0.mnt=" + pool.MountPointFromName(name),
+		"fs#0.size=" + sizeconv.ExactBSizeCompact(float64(size)),
+	}
+	if mntOpt := t.MntOptions(); mntOpt != "" {
+		data = append(data, "fs#0.mnt_opt="+mntOpt)
+	}
+	return data, nil
+}
+
+// ResizeDisk is a noop, as the subvolume quota group limit is set by the
+// fs resource resize, from its size keyword.
+func (t *T) ResizeDisk(ctx context.Context, name string, size int64) error {
+	return nil
+}
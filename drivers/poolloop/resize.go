@@ -0,0 +1,23 @@
+//go:build linux
+
+package poolloop
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// ResizeDisk grows the image file of the disk <name> to size. The file is
+// never shrunk. The loop device must be told to reread the file size.
+func (t *T) ResizeDisk(ctx context.Context, name string, size int64) error {
+	p := fmt.Sprintf("%s/%s.img", t.Head(), name)
+	stat, err := os.Stat(p)
+	if err != nil {
+		return err
+	}
+	if stat.Size() >= size {
+		return nil
+	}
+	return os.Truncate(p, size)
+}
@@ -0,0 +1,19 @@
+//go:build linux
+
+package poollvmthin
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/util/capabilities"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner(ctx context.Context) ([]string, error) {
+	volDrvID := driver.NewID(driver.GroupVolume, drvID.Name)
+	return []string{drvID.Cap(), volDrvID.Cap()}, nil
+}
@@ -0,0 +1,188 @@
+//go:build linux
+
+package poollvmthin
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/util/lvm2"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+type (
+	T struct {
+		pool.T
+	}
+)
+
+var (
+	drvID = driver.NewID(driver.GroupPool, "lvmthin")
+)
+
+func init() {
+	driver.Register(drvID, NewPooler)
+}
+
+func NewPooler() pool.Pooler {
+	t := New()
+	var i interface{} = t
+	return i.(pool.Pooler)
+}
+
+func New() *T {
+	t := T{}
+	return &t
+}
+
+func (t T) Head() string {
+	return t.VGName() + "/" + t.ThinPoolName()
+}
+
+func (t T) Capabilities() pool.Capabilities {
+	return pool.Capabilities{
+		pool.CapBlk,
+		pool.CapFile,
+		pool.CapROO,
+		pool.CapROX,
+		pool.CapRWO,
+		pool.CapRWX,
+		pool.CapSnap,
+	}
+}
+
+func (t T) VGName() string {
+	return t.GetString("vg")
+}
+
+func (t T) ThinPoolName() string {
+	return t.GetString("thin_pool")
+}
+
+func (t T) thinPoolSize() string {
+	return t.GetString("thin_pool_size")
+}
+
+func (t T) overcommit() int {
+	return t.GetInt("overcommit")
+}
+
+// Usage returns the thin pool data usage. The free space is the space
+// left for thin volumes virtual sizes, considering the overcommit ratio.
+// If the thin pool is not created yet, the usage is the one of the thin
+// pool the first volume provision will create in the vg free space.
+func (t T) Usage(ctx context.Context) (pool.Usage, error) {
+	lv := lvm2.NewLV(t.VGName(), t.ThinPoolName())
+	info, err := lv.ThinPoolUsage(ctx)
+	switch {
+	case errors.Is(err, lvm2.ErrExist):
+		return t.vgUsage(ctx)
+	case err != nil:
+		return pool.Usage{}, err
+	}
+	usage := pool.Usage{
+		Size:            info.Size,
+		Used:            info.Used,
+		Provisioned:     info.Provisioned,
+		DataPercent:     info.DataPercent,
+		MetadataPercent: info.MetadataPercent,
+	}
+	usage.Free = allocatable(info.Size, info.Provisioned, t.overcommit())
+	return usage, nil
+}
+
+// vgUsage returns the usage of the thin pool to create, sized by the
+// thin_pool_size keyword from the vg free space.
+func (t T) vgUsage(ctx context.Context) (pool.Usage, error) {
+	vg := lvm2.NewVG(t.VGName())
+	info, err := vg.Show(ctx, "vg_name,vg_free,vg_size")
+	if err != nil {
+		return pool.Usage{}, err
+	}
+	free, err := info.Free()
+	if err != nil {
+		return pool.Usage{}, err
+	}
+	size := thinPoolCapacity(t.thinPoolSize(), free)
+	return pool.Usage{
+		Size: size,
+		Free: allocatable(size, 0, t.overcommit()),
+	}, nil
+}
+
+// thinPoolCapacity returns the size of the thin pool created with the
+// thin_pool_size expression s in a vg with <free> free bytes. The
+// expressions relative to other lvm quantities than the vg free space
+// are estimated to the vg free space.
+func thinPoolCapacity(s string, free int64) int64 {
+	switch {
+	case s == "":
+		return free
+	case strings.HasSuffix(strings.ToUpper(s), "%FREE"):
+		pct, err := strconv.ParseFloat(s[:len(s)-len("%FREE")], 64)
+		if err != nil || pct > 100 {
+			return free
+		}
+		return int64(float64(free) * pct / 100)
+	case strings.Contains(s, "%"):
+		return free
+	default:
+		size, err := sizeconv.FromSize(s)
+		if err != nil || size > free {
+			return free
+		}
+		return size
+	}
+}
+
+// allocatable returns the virtual size still allocatable to new thin
+// volumes in a thin pool of <size> bytes with <provisioned> bytes
+// already allocated.
+func allocatable(size, provisioned int64, overcommit int) int64 {
+	if overcommit < 100 {
+		overcommit = 100
+	}
+	free := size*int64(overcommit)/100 - provisioned
+	if free < 0 {
+		return 0
+	}
+	return free
+}
+
+func (t *T) Translate(name string, size int64, shared bool) ([]string, error) {
+	data, err := t.BlkTranslate(name, size, shared)
+	if err != nil {
+		return nil, err
+	}
+	fs := pool.FS{
+		Pool:      t,
+		Name:      name,
+		Shared:    shared,
+		FsIndex:   1,
+		DiskIndex: 0,
+		OnDisk:    "disk#0",
+	}
+	data = append(data, fs.Keywords()...)
+	return data, nil
+}
+
+func (t *T) BlkTranslate(name string, size int64, shared bool) ([]string, error) {
+	data := []string{
+		"disk#0.type=lv",
+		"disk#0.name=" + name,
+		"disk#0.vg=" + t.VGName(),
+		"disk#0.size=" + sizeconv.ExactBSizeCompact(float64(size)),
+		"disk#0.thin_pool=" + t.ThinPoolName(),
+	}
+	if s := t.thinPoolSize(); s != "" {
+		data = append(data, "disk#0.thin_pool_size="+s)
+	}
+	if opts := t.MkblkOptions(); opts != "" {
+		data = append(data, "disk#0.create_options="+opts)
+	}
+	return data, nil
+}
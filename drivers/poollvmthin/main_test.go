@@ -0,0 +1,129 @@
+package poollvmthin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/util/key"
+)
+
+type testConfig map[string]string
+
+func (t testConfig) Eval(k key.T) (any, error)               { return t[k.String()], nil }
+func (t testConfig) GetString(k key.T) string                { return t[k.String()] }
+func (t testConfig) GetStringAs(k key.T, _ string) string    { return t[k.String()] }
+func (t testConfig) GetStringStrict(k key.T) (string, error) { return t[k.String()], nil }
+func (t testConfig) GetStrings(k key.T) []string             { return strings.Fields(t[k.String()]) }
+func (t testConfig) GetBool(k key.T) bool                    { return t[k.String()] == "true" }
+func (t testConfig) GetSize(k key.T) *int64                  { return nil }
+func (t testConfig) HasSectionString(s string) bool          { return true }
+func (t testConfig) GetInt(k key.T) int {
+	i, _ := strconv.Atoi(t[k.String()])
+	return i
+}
+
+// newTestPool returns a lvmthin pool of the vg vg1 and the thin pool
+// thinpool, configured with the extra pool keywords.
+func newTestPool(extra map[string]string) *T {
+	config := testConfig{
+		"pool#thin.vg":         "vg1",
+		"pool#thin.thin_pool":  "thinpool",
+		"pool#thin.overcommit": "200",
+	}
+	for k, v := range extra {
+		config["pool#thin."+k] = v
+	}
+	p := New()
+	p.SetName("thin")
+	p.SetDriver("lvmthin")
+	p.SetConfig(config)
+	return p
+}
+
+// installCommands installs the shell scripts as commands in a directory
+// prepended to PATH.
+func installCommands(t *testing.T, scripts map[string]string) {
+	t.Helper()
+	dir := t.TempDir()
+	for name, script := range scripts {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script), 0o755))
+	}
+	t.Setenv("PATH", dir+":"+os.Getenv("PATH"))
+}
+
+func TestAllocatable(t *testing.T) {
+	require.Equal(t, int64(1500), allocatable(1000, 500, 200))
+	require.Equal(t, int64(500), allocatable(1000, 500, 100))
+	require.Equal(t, int64(500), allocatable(1000, 500, 50), "overcommit below 100 is raised to 100")
+	require.Equal(t, int64(0), allocatable(1000, 2500, 200))
+}
+
+func TestThinPoolCapacity(t *testing.T) {
+	require.Equal(t, int64(1000), thinPoolCapacity("", 1000))
+	require.Equal(t, int64(900), thinPoolCapacity("90%FREE", 1000))
+	require.Equal(t, int64(1000), thinPoolCapacity("50%VG", 1000), "not relative to the free space")
+	require.Equal(t, int64(512), thinPoolCapacity("512", 1000))
+	require.Equal(t, int64(1000), thinPoolCapacity("2k", 1000), "bounded by the free space")
+}
+
+func TestUsage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("with the thin pool", func(t *testing.T) {
+		installCommands(t, map[string]string{
+			"lvs": `echo '{"report":[{"lv":[
+{"lv_name":"thinpool","vg_name":"vg1","lv_size":"1000","pool_lv":"","data_percent":"25.00","metadata_percent":"10.00"},
+{"lv_name":"vol1","vg_name":"vg1","lv_size":"600","pool_lv":"thinpool","data_percent":"40.00"}
+]}]}'
+`,
+		})
+		usage, err := newTestPool(nil).Usage(ctx)
+		require.NoError(t, err)
+		require.Equal(t, pool.Usage{
+			Size:            1000,
+			Used:            250,
+			Free:            1400,
+			Provisioned:     600,
+			DataPercent:     25,
+			MetadataPercent: 10,
+		}, usage)
+	})
+
+	t.Run("without the thin pool", func(t *testing.T) {
+		installCommands(t, map[string]string{
+			"lvs": `echo '{"report":[{"lv":[]}]}'` + "\n",
+			"vgs": `echo '{"report":[{"vg":[{"vg_name":"vg1","vg_size":"2000B","vg_free":"1000B"}]}]}'` + "\n",
+		})
+		usage, err := newTestPool(map[string]string{"thin_pool_size": "90%FREE"}).Usage(ctx)
+		require.NoError(t, err)
+		require.Equal(t, pool.Usage{Size: 900, Free: 1800}, usage)
+	})
+
+	t.Run("without the vg", func(t *testing.T) {
+		installCommands(t, map[string]string{
+			"lvs": "exit 5\n",
+			"vgs": "exit 5\n",
+		})
+		_, err := newTestPool(nil).Usage(ctx)
+		require.ErrorContains(t, err, "does not exist")
+	})
+}
+
+func TestStatusFillWarning(t *testing.T) {
+	installCommands(t, map[string]string{
+		"lvs": `echo '{"report":[{"lv":[{"lv_name":"thinpool","vg_name":"vg1","lv_size":"1000","pool_lv":"","data_percent":"91.50","metadata_percent":"10.00"}]}]}'` + "\n",
+	})
+	status := pool.GetStatus(context.Background(), newTestPool(map[string]string{"fill_warning": "90"}), true)
+	require.Empty(t, status.Errors)
+	require.Equal(t, []string{"data usage 91.50% exceeds the 90% fill warning threshold"}, status.Warnings)
+
+	status = pool.GetStatus(context.Background(), newTestPool(nil), true)
+	require.Empty(t, status.Warnings, "no warning without fill_warning")
+}
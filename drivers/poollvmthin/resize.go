@@ -0,0 +1,23 @@
+//go:build linux
+
+package poollvmthin
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/util/lvm2"
+)
+
+// ResizeDisk extends the virtual size of the thin lv <name> to size. The
+// lv is never shrunk.
+func (t *T) ResizeDisk(ctx context.Context, name string, size int64) error {
+	lv := lvm2.NewLV(t.VGName(), name)
+	current, err := lv.Size(ctx)
+	if err != nil {
+		return err
+	}
+	if current >= size {
+		return nil
+	}
+	return lv.Extend(ctx, size)
+}
@@ -0,0 +1,98 @@
+//go:build linux
+
+package poollvmthin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/util/lvm2"
+)
+
+// snapshotLVName returns the name of the thin lv holding the snapshot of
+// the lv <name>.
+func snapshotLVName(name, snapshot string) string {
+	return name + "_" + snapshot
+}
+
+// CreateSnapshot creates a thin snapshot of the lv <name>. The snapshot
+// shares the origin blocks in the thin pool, so it needs no preallocated
+// copy-on-write area.
+func (t *T) CreateSnapshot(ctx context.Context, name, snapshot string) error {
+	lv := lvm2.NewLV(t.VGName(), name)
+	return lv.CreateThinSnapshot(ctx, snapshotLVName(name, snapshot), true)
+}
+
+func (t *T) DeleteSnapshot(ctx context.Context, name, snapshot string) error {
+	if err := t.assertSnapshot(ctx, name, snapshot); err != nil {
+		return err
+	}
+	lv := lvm2.NewLV(t.VGName(), snapshotLVName(name, snapshot))
+	return lv.Remove(ctx, []string{"-f"})
+}
+
+func (t *T) ListSnapshots(ctx context.Context, name string) (pool.Snapshots, error) {
+	lv := lvm2.NewLV(t.VGName(), name)
+	infos, err := lv.Snapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefix := name + "_"
+	l := make(pool.Snapshots, 0, len(infos))
+	for _, info := range infos {
+		if !strings.HasPrefix(info.LVName, prefix) {
+			continue
+		}
+		snapshot := pool.Snapshot{
+			Name: strings.TrimPrefix(info.LVName, prefix),
+		}
+		if createdAt, err := info.CreatedAt(); err == nil {
+			snapshot.CreatedAt = createdAt
+		}
+		if used, err := info.Used(); err == nil {
+			snapshot.Used = used
+		}
+		l = append(l, snapshot)
+	}
+	sort.Sort(l)
+	return l, nil
+}
+
+// RollbackSnapshot merges the thin snapshot lv into the lv <name>. The
+// snapshot is consumed by the merge.
+func (t *T) RollbackSnapshot(ctx context.Context, name, snapshot string) error {
+	if err := t.assertSnapshot(ctx, name, snapshot); err != nil {
+		return err
+	}
+	lv := lvm2.NewLV(t.VGName(), snapshotLVName(name, snapshot))
+	return lv.Merge(ctx)
+}
+
+// CloneSnapshot creates the thin lv <cloneName> as a writable thin
+// snapshot of the snapshot lv. No data is copied.
+func (t *T) CloneSnapshot(ctx context.Context, name, snapshot, cloneName string, shared bool, nodes []string) ([]string, error) {
+	if err := t.assertSnapshot(ctx, name, snapshot); err != nil {
+		return nil, err
+	}
+	src := lvm2.NewLV(t.VGName(), snapshotLVName(name, snapshot))
+	if err := src.CreateThinSnapshot(ctx, cloneName, false); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (t *T) assertSnapshot(ctx context.Context, name, snapshot string) error {
+	l, err := t.ListSnapshots(ctx, name)
+	if err != nil {
+		return err
+	}
+	for _, e := range l {
+		if e.Name == snapshot {
+			return nil
+		}
+	}
+	return fmt.Errorf("snapshot %s of %s/%s not found", snapshot, t.VGName(), name)
+}
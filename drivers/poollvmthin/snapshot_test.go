@@ -0,0 +1,49 @@
+//go:build linux
+
+package poollvmthin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshots(t *testing.T) {
+	calls := filepath.Join(t.TempDir(), "calls")
+	installCommands(t, map[string]string{
+		"lvs": `echo '{"report":[{"lv":[
+{"lv_name":"vol1_snap2","vg_name":"vg1","lv_size":"1073741824B","origin":"vol1","lv_time":"2026-10-02 10:00:00 +0000","data_percent":"10.00"},
+{"lv_name":"vol1_snap1","vg_name":"vg1","lv_size":"1073741824B","origin":"vol1","lv_time":"2026-10-01 10:00:00 +0000","data_percent":""},
+{"lv_name":"other","vg_name":"vg1","lv_size":"1073741824B","origin":"vol1"}
+]}]}'
+`,
+		"lvcreate": `echo "lvcreate $*" >>` + calls + "\n",
+		"lvremove": `echo "lvremove $*" >>` + calls + "\n",
+	})
+	p := newTestPool(nil)
+	ctx := context.Background()
+
+	l, err := p.ListSnapshots(ctx, "vol1")
+	require.NoError(t, err)
+	require.Len(t, l, 2, "the lvs not named after the origin are not snapshots")
+	require.Equal(t, "snap1", l[0].Name)
+	require.Equal(t, "snap2", l[1].Name)
+	require.Equal(t, int64(107374182), l[1].Used)
+
+	require.NoError(t, p.CreateSnapshot(ctx, "vol1", "snap3"))
+	_, err = p.CloneSnapshot(ctx, "vol1", "snap1", "vol2", false, nil)
+	require.NoError(t, err)
+	require.NoError(t, p.DeleteSnapshot(ctx, "vol1", "snap2"))
+	_, err = p.CloneSnapshot(ctx, "vol1", "snap9", "vol3", false, nil)
+	require.ErrorContains(t, err, "snapshot snap9 of vg1/vol1 not found")
+
+	b, err := os.ReadFile(calls)
+	require.NoError(t, err)
+	require.Equal(t, ""+
+		"lvcreate --snapshot -ky -n vol1_snap3 vg1/vol1\n"+
+		"lvcreate --snapshot -kn -n vol2 vg1/vol1_snap1\n"+
+		"lvremove -f /dev/vg1/vol1_snap2\n", string(b))
+}
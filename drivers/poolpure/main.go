@@ -80,6 +80,7 @@ func (t T) Capabilities() pool.Capabilities {
 		pool.CapRWO,
 		pool.CapRWX,
 		pool.CapShared,
+		pool.CapSnap,
 	}
 }
 
@@ -188,15 +189,8 @@ func (t *T) CreateDisk(ctx context.Context, name string, size int64, nodenames [
 	}
 	a := t.array()
 	drvSize := sizeconv.ExactBSizeCompact(float64(size))
-	pod := t.pod()
-	vg := t.volumeGroup()
-	if pod != "" {
-		name = pod + "::" + name
-	} else if vg != "" {
-		name = vg + "/" + name
-	}
 	arrayDisk, err := a.AddDisk(ctx, arraypure.OptAddDisk{
-		Name:     name,
+		Name:     t.arrayVolumeName(name),
 		Size:     drvSize,
 		Mappings: paths.MappingList(),
 		LUN:      -1,
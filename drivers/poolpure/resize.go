@@ -0,0 +1,40 @@
+//go:build linux || solaris
+
+package poolpure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/v3/drivers/arraypure"
+)
+
+// ResizeDisk grows the provisioned size of the array volume backing the
+// disk <name>. The volume is never shrunk. The nodes must rescan their
+// paths to the volume to see the new size.
+func (t *T) ResizeDisk(ctx context.Context, name string, size int64) error {
+	a := t.array()
+	volumeName, err := t.resolveVolumeName(ctx, a, name)
+	if err != nil {
+		return err
+	}
+	items, err := a.GetVolumes(ctx, arraypure.OptGetItems{
+		Filter: fmt.Sprintf("name='%s'", volumeName),
+	})
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("volume %s not found on array %s", volumeName, t.arrayName())
+	}
+	if items[0].Provisioned >= size {
+		return nil
+	}
+	_, err = a.ResizeDisk(ctx, arraypure.OptResizeDisk{
+		Volume: arraypure.OptVolume{
+			ID: items[0].ID,
+		},
+		Size: fmt.Sprintf("%d", size),
+	})
+	return err
+}
@@ -0,0 +1,165 @@
+//go:build linux || solaris
+
+package poolpure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/drivers/arraypure"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/san"
+)
+
+// arrayVolumeName returns the name of the array volume <name>, prefixed
+// with the pool pod or volume group.
+func (t *T) arrayVolumeName(name string) string {
+	if pod := t.pod(); pod != "" {
+		return pod + "::" + name
+	} else if vg := t.volumeGroup(); vg != "" {
+		return vg + "/" + name
+	}
+	return name
+}
+
+// resolveVolumeName returns the name of the array volume backing the disk
+// <name>: the shared volume if it exists, else the volume of the local node.
+func (t *T) resolveVolumeName(ctx context.Context, a *arraypure.Array, name string) (string, error) {
+	candidates := []string{
+		t.arrayVolumeName(name),
+		t.arrayVolumeName(name + t.Separator() + hostname.Hostname()),
+	}
+	for _, candidate := range candidates {
+		items, err := a.GetVolumes(ctx, arraypure.OptGetItems{
+			Filter: fmt.Sprintf("name='%s'", candidate),
+		})
+		if err != nil {
+			return "", err
+		}
+		if len(items) > 0 {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("volume %s not found on array %s", candidates[0], t.arrayName())
+}
+
+func (t *T) CreateSnapshot(ctx context.Context, name, snapshot string) error {
+	a := t.array()
+	volumeName, err := t.resolveVolumeName(ctx, a, name)
+	if err != nil {
+		return err
+	}
+	_, err = a.AddSnapshot(ctx, volumeName, snapshot)
+	return err
+}
+
+func (t *T) DeleteSnapshot(ctx context.Context, name, snapshot string) error {
+	a := t.array()
+	volumeName, err := t.resolveVolumeName(ctx, a, name)
+	if err != nil {
+		return err
+	}
+	if err := t.assertSnapshot(ctx, a, volumeName, snapshot); err != nil {
+		return err
+	}
+	return a.DelSnapshot(ctx, volumeName+"."+snapshot)
+}
+
+func (t *T) ListSnapshots(ctx context.Context, name string) (pool.Snapshots, error) {
+	a := t.array()
+	volumeName, err := t.resolveVolumeName(ctx, a, name)
+	if err != nil {
+		return nil, err
+	}
+	return t.listSnapshots(ctx, a, volumeName)
+}
+
+// RollbackSnapshot overwrites the array volume with the snapshot data.
+func (t *T) RollbackSnapshot(ctx context.Context, name, snapshot string) error {
+	a := t.array()
+	volumeName, err := t.resolveVolumeName(ctx, a, name)
+	if err != nil {
+		return err
+	}
+	if err := t.assertSnapshot(ctx, a, volumeName, snapshot); err != nil {
+		return err
+	}
+	_, err = a.CopyVolume(ctx, volumeName+"."+snapshot, volumeName, true)
+	return err
+}
+
+// CloneSnapshot copies the snapshot to a new array volume, maps it to the
+// clone nodes and returns the disk_id keyword so the disk resource
+// provisioning does not create another disk.
+func (t *T) CloneSnapshot(ctx context.Context, name, snapshot, cloneName string, shared bool, nodes []string) ([]string, error) {
+	a := t.array()
+	volumeName, err := t.resolveVolumeName(ctx, a, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.assertSnapshot(ctx, a, volumeName, snapshot); err != nil {
+		return nil, err
+	}
+	diskIDKey := "disk#0.disk_id"
+	if !shared {
+		localhost := hostname.Hostname()
+		cloneName = cloneName + t.Separator() + localhost
+		diskIDKey += "@" + localhost
+		nodes = []string{localhost}
+	}
+	paths, err := pool.GetPaths(ctx, t, nodes, san.FC)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("no mapping in request. cowardly refuse to create a disk that can not be mapped")
+	}
+	volume, err := a.CopyVolume(ctx, volumeName+"."+snapshot, t.arrayVolumeName(cloneName), false)
+	if err != nil {
+		return nil, err
+	}
+	_, err = a.MapDisk(ctx, arraypure.OptMapDisk{
+		Volume: arraypure.OptVolume{
+			ID: volume.ID,
+		},
+		Mapping: arraypure.OptMapping{
+			Mappings: paths.MappingList(),
+			LUN:      -1,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []string{diskIDKey + "=" + volume.WWN()}, nil
+}
+
+func (t *T) listSnapshots(ctx context.Context, a *arraypure.Array, volumeName string) (pool.Snapshots, error) {
+	items, err := a.GetSnapshots(ctx, volumeName)
+	if err != nil {
+		return nil, err
+	}
+	l := make(pool.Snapshots, len(items))
+	for i, item := range items {
+		l[i] = pool.Snapshot{
+			Name:      item.Suffix,
+			CreatedAt: item.CreatedAt(),
+			Used:      item.Space.Unique,
+		}
+	}
+	return l, nil
+}
+
+func (t *T) assertSnapshot(ctx context.Context, a *arraypure.Array, volumeName, snapshot string) error {
+	l, err := t.listSnapshots(ctx, a, volumeName)
+	if err != nil {
+		return err
+	}
+	for _, e := range l {
+		if e.Name == snapshot {
+			return nil
+		}
+	}
+	return fmt.Errorf("snapshot %s of %s not found", snapshot, volumeName)
+}
@@ -67,6 +67,7 @@ func (t T) Capabilities() pool.Capabilities {
 		pool.CapRWO,
 		pool.CapRWX,
 		pool.CapShared,
+		pool.CapSnap,
 	}
 }
 
@@ -0,0 +1,49 @@
+package poolrados
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+type (
+	rbdInfo struct {
+		Name string `json:"name"`
+		Size int64  `json:"size"`
+	}
+)
+
+// ResizeDisk grows the image <name> to size, rounded up to the MiB. The
+// image is never shrunk.
+func (t *T) ResizeDisk(ctx context.Context, name string, size int64) error {
+	info, err := t.info(ctx, name)
+	if err != nil {
+		return err
+	}
+	if info.Size >= size {
+		return nil
+	}
+	mib := (size + sizeconv.MiB - 1) / sizeconv.MiB
+	return t.rbd(ctx, "resize", "--size", fmt.Sprint(mib), t.imageSpec(name))
+}
+
+func (t *T) info(ctx context.Context, name string) (rbdInfo, error) {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("rbd"),
+		command.WithVarArgs("info", "--format", "json", t.imageSpec(name)),
+		command.WithBufferedStdout(),
+	)
+	b, err := cmd.Output()
+	if err != nil {
+		return rbdInfo{}, err
+	}
+	var data rbdInfo
+	if err := json.Unmarshal(b, &data); err != nil {
+		return rbdInfo{}, err
+	}
+	return data, nil
+}
@@ -0,0 +1,139 @@
+package poolrados
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/drivers/resdiskrados"
+	"github.com/opensvc/om3/v3/util/command"
+)
+
+type (
+	rbdSnap struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		Size      int64  `json:"size"`
+		Protected string `json:"protected"`
+		Timestamp string `json:"timestamp"`
+	}
+)
+
+func (t *T) imageSpec(name string) string {
+	rbd := resdiskrados.RBDMap{
+		Name:      name,
+		Namespace: t.rbdNamespace(),
+		Pool:      t.rbdPool(),
+	}
+	return rbd.ImageSpec()
+}
+
+func (t *T) snapSpec(name, snapshot string) string {
+	return t.imageSpec(name) + "@" + snapshot
+}
+
+func (t *T) rbd(ctx context.Context, args ...string) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("rbd"),
+		command.WithVarArgs(args...),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+func (t *T) CreateSnapshot(ctx context.Context, name, snapshot string) error {
+	return t.rbd(ctx, "snap", "create", t.snapSpec(name, snapshot))
+}
+
+func (t *T) DeleteSnapshot(ctx context.Context, name, snapshot string) error {
+	snap, err := t.getSnap(ctx, name, snapshot)
+	if err != nil {
+		return err
+	}
+	if snap.Protected == "true" {
+		// fails if the snapshot still has clones
+		if err := t.rbd(ctx, "snap", "unprotect", t.snapSpec(name, snapshot)); err != nil {
+			return err
+		}
+	}
+	return t.rbd(ctx, "snap", "rm", t.snapSpec(name, snapshot))
+}
+
+func (t *T) ListSnapshots(ctx context.Context, name string) (pool.Snapshots, error) {
+	snaps, err := t.listSnaps(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	l := make(pool.Snapshots, len(snaps))
+	for i, snap := range snaps {
+		l[i] = pool.Snapshot{
+			Name: snap.Name,
+		}
+		if createdAt, err := time.ParseInLocation(time.ANSIC, snap.Timestamp, time.Local); err == nil {
+			l[i].CreatedAt = createdAt
+		}
+	}
+	return l, nil
+}
+
+// RollbackSnapshot rolls the image back to the snapshot. The image must
+// not be mapped.
+func (t *T) RollbackSnapshot(ctx context.Context, name, snapshot string) error {
+	if _, err := t.getSnap(ctx, name, snapshot); err != nil {
+		return err
+	}
+	return t.rbd(ctx, "snap", "rollback", t.snapSpec(name, snapshot))
+}
+
+// CloneSnapshot protects the snapshot and creates the image <cloneName> as
+// a copy-on-write clone of the snapshot.
+func (t *T) CloneSnapshot(ctx context.Context, name, snapshot, cloneName string, shared bool, nodes []string) ([]string, error) {
+	snap, err := t.getSnap(ctx, name, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if snap.Protected != "true" {
+		if err := t.rbd(ctx, "snap", "protect", t.snapSpec(name, snapshot)); err != nil {
+			return nil, err
+		}
+	}
+	return nil, t.rbd(ctx, "clone", t.snapSpec(name, snapshot), t.imageSpec(cloneName))
+}
+
+func (t *T) getSnap(ctx context.Context, name, snapshot string) (rbdSnap, error) {
+	snaps, err := t.listSnaps(ctx, name)
+	if err != nil {
+		return rbdSnap{}, err
+	}
+	for _, snap := range snaps {
+		if snap.Name == snapshot {
+			return snap, nil
+		}
+	}
+	return rbdSnap{}, fmt.Errorf("snapshot %s of %s not found", snapshot, t.imageSpec(name))
+}
+
+func (t *T) listSnaps(ctx context.Context, name string) ([]rbdSnap, error) {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("rbd"),
+		command.WithVarArgs("snap", "ls", "--format", "json", t.imageSpec(name)),
+		command.WithBufferedStdout(),
+	)
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	var data []rbdSnap
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
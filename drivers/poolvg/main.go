@@ -48,6 +48,7 @@ func (t T) Capabilities() pool.Capabilities {
 		pool.CapROX,
 		pool.CapRWO,
 		pool.CapRWX,
+		pool.CapSnap,
 	}
 }
 
@@ -0,0 +1,122 @@
+//go:build linux
+
+package poolvg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/util/lvm2"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+const (
+	// snapshotSize is the size of the copy-on-write area of the snapshot
+	// lvs, relative to the origin lv size.
+	snapshotSize = "20%ORIGIN"
+)
+
+// snapshotLVName returns the name of the lv holding the snapshot of the
+// lv <name>.
+func snapshotLVName(name, snapshot string) string {
+	return name + "_" + snapshot
+}
+
+func (t *T) CreateSnapshot(ctx context.Context, name, snapshot string) error {
+	lv := lvm2.NewLV(t.VGName(), name)
+	return lv.CreateSnapshot(ctx, snapshotLVName(name, snapshot), snapshotSize)
+}
+
+func (t *T) DeleteSnapshot(ctx context.Context, name, snapshot string) error {
+	if err := t.assertSnapshot(ctx, name, snapshot); err != nil {
+		return err
+	}
+	lv := lvm2.NewLV(t.VGName(), snapshotLVName(name, snapshot))
+	return lv.Remove(ctx, []string{"-f"})
+}
+
+func (t *T) ListSnapshots(ctx context.Context, name string) (pool.Snapshots, error) {
+	lv := lvm2.NewLV(t.VGName(), name)
+	infos, err := lv.Snapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefix := name + "_"
+	l := make(pool.Snapshots, 0, len(infos))
+	for _, info := range infos {
+		if !strings.HasPrefix(info.LVName, prefix) {
+			continue
+		}
+		snapshot := pool.Snapshot{
+			Name: strings.TrimPrefix(info.LVName, prefix),
+		}
+		if createdAt, err := info.CreatedAt(); err == nil {
+			snapshot.CreatedAt = createdAt
+		}
+		if used, err := info.Used(); err == nil {
+			snapshot.Used = used
+		}
+		l = append(l, snapshot)
+	}
+	sort.Sort(l)
+	return l, nil
+}
+
+// RollbackSnapshot merges the snapshot lv into the lv <name>. The
+// snapshot is consumed by the merge.
+func (t *T) RollbackSnapshot(ctx context.Context, name, snapshot string) error {
+	if err := t.assertSnapshot(ctx, name, snapshot); err != nil {
+		return err
+	}
+	lv := lvm2.NewLV(t.VGName(), snapshotLVName(name, snapshot))
+	return lv.Merge(ctx)
+}
+
+// CloneSnapshot creates the lv <cloneName> with the size of the lv
+// <name> and copies the snapshot data into it. The clone lv is removed if
+// the copy fails.
+func (t *T) CloneSnapshot(ctx context.Context, name, snapshot, cloneName string, shared bool, nodes []string) (_ []string, err error) {
+	if err := t.assertSnapshot(ctx, name, snapshot); err != nil {
+		return nil, err
+	}
+	origin := lvm2.NewLV(t.VGName(), name)
+	size, err := origin.Size(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clone := lvm2.NewLV(t.VGName(), cloneName)
+	if err := clone.Create(ctx, sizeconv.ExactBSizeCompact(float64(size)), nil); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		// ctx may be cancelled, and is the cause of the error.
+		if rmErr := clone.Remove(context.WithoutCancel(ctx), []string{"-f"}); rmErr != nil {
+			err = errors.Join(err, fmt.Errorf("remove the incomplete clone %s: %w", clone.FQN(), rmErr))
+		}
+	}()
+	src := lvm2.NewLV(t.VGName(), snapshotLVName(name, snapshot))
+	if err := src.CopyTo(ctx, clone); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (t *T) assertSnapshot(ctx context.Context, name, snapshot string) error {
+	l, err := t.ListSnapshots(ctx, name)
+	if err != nil {
+		return err
+	}
+	for _, e := range l {
+		if e.Name == snapshot {
+			return nil
+		}
+	}
+	return fmt.Errorf("snapshot %s of %s/%s not found", snapshot, t.VGName(), name)
+}
@@ -0,0 +1,63 @@
+//go:build linux
+
+package poolvg
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/util/key"
+)
+
+type testConfig map[string]string
+
+func (t testConfig) Eval(k key.T) (any, error)               { return t[k.String()], nil }
+func (t testConfig) GetInt(k key.T) int                      { return 0 }
+func (t testConfig) GetString(k key.T) string                { return t[k.String()] }
+func (t testConfig) GetStringAs(k key.T, _ string) string    { return t[k.String()] }
+func (t testConfig) GetStringStrict(k key.T) (string, error) { return t[k.String()], nil }
+func (t testConfig) GetStrings(k key.T) []string             { return strings.Fields(t[k.String()]) }
+func (t testConfig) GetBool(k key.T) bool                    { return t[k.String()] == "true" }
+func (t testConfig) GetSize(k key.T) *int64                  { return nil }
+func (t testConfig) HasSectionString(s string) bool          { return true }
+
+// installCommands installs the shell scripts as commands in a directory
+// prepended to PATH.
+func installCommands(t *testing.T, scripts map[string]string) {
+	t.Helper()
+	dir := t.TempDir()
+	for name, script := range scripts {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script), 0o755))
+	}
+	t.Setenv("PATH", dir+":"+os.Getenv("PATH"))
+}
+
+func TestCloneSnapshotCopyFailure(t *testing.T) {
+	removed := filepath.Join(t.TempDir(), "removed")
+	installCommands(t, map[string]string{
+		"lvs": `case "$*" in
+*--select*) echo '{"report":[{"lv":[{"lv_name":"vol1_snap1","vg_name":"vg1","lv_size":"1073741824B","origin":"vol1"}]}]}' ;;
+*lv_size*) echo '{"report":[{"lv":[{"lv_size":"1073741824"}]}]}' ;;
+esac
+`,
+		"lvcreate": "exit 0\n",
+		"dd":       "exit 1\n",
+		"lvremove": `echo "$*" >>` + removed + "\n",
+	})
+	p := New()
+	p.SetName("vg1")
+	p.SetConfig(testConfig{"pool#vg1.name": "vg1"})
+	var _ pool.Pooler = p
+
+	_, err := p.CloneSnapshot(context.Background(), "vol1", "snap1", "vol2", false, nil)
+	require.ErrorContains(t, err, "dd")
+	b, err := os.ReadFile(removed)
+	require.NoError(t, err, "the clone lv is removed")
+	require.Equal(t, "-f /dev/vg1/vol2\n", string(b))
+}
@@ -0,0 +1,54 @@
+//go:build linux || solaris
+
+package poolzpool
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/opensvc/om3/v3/util/zfs"
+)
+
+// ResizeDisk grows the volsize of the zvol <name>, rounded up to its
+// volblocksize, or the refquota of the filesystem <name> if it has one.
+// The dataset is never shrunk.
+func (t *T) ResizeDisk(ctx context.Context, name string, size int64) error {
+	ds := &zfs.Vol{Name: t.datasetName(name)}
+	dsType, err := ds.GetProperty("type")
+	if err != nil {
+		return err
+	}
+	var prop string
+	switch dsType {
+	case "volume":
+		prop = "volsize"
+		if s, err := ds.GetProperty("volblocksize"); err != nil {
+			return err
+		} else if bs, err := strconv.ParseInt(s, 10, 64); err != nil {
+			return fmt.Errorf("%s volblocksize: %w", ds.Name, err)
+		} else if bs > 0 && size%bs != 0 {
+			size = (size/bs + 1) * bs
+		}
+	case "filesystem":
+		prop = "refquota"
+	default:
+		return fmt.Errorf("%s: unsupported dataset type %s", ds.Name, dsType)
+	}
+	s, err := ds.GetProperty(prop)
+	if err != nil {
+		return err
+	}
+	current, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", ds.Name, prop, err)
+	}
+	if prop == "refquota" && current == 0 {
+		// no refquota, the filesystem is only limited by the pool size
+		return nil
+	}
+	if current >= size {
+		return nil
+	}
+	return ds.SetProperty(prop, fmt.Sprint(size))
+}
@@ -0,0 +1,78 @@
+//go:build linux || solaris
+
+package poolzpool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/util/zfs"
+)
+
+func (t *T) datasetName(name string) string {
+	return t.poolName() + "/" + name
+}
+
+func (t *T) snap(name, snapshot string) *zfs.Snap {
+	return &zfs.Snap{Name: t.datasetName(name) + "@" + snapshot}
+}
+
+func (t *T) CreateSnapshot(ctx context.Context, name, snapshot string) error {
+	return t.snap(name, snapshot).Create(ctx)
+}
+
+func (t *T) DeleteSnapshot(ctx context.Context, name, snapshot string) error {
+	if err := t.assertSnapshot(ctx, name, snapshot); err != nil {
+		return err
+	}
+	return t.snap(name, snapshot).Destroy(ctx)
+}
+
+func (t *T) ListSnapshots(ctx context.Context, name string) (pool.Snapshots, error) {
+	infos, err := zfs.ListSnaps(ctx, t.datasetName(name), nil)
+	if err != nil {
+		return nil, err
+	}
+	l := make(pool.Snapshots, len(infos))
+	for i, info := range infos {
+		l[i] = pool.Snapshot{
+			Name:      zfs.Snap{Name: info.Name}.SnapName(),
+			CreatedAt: info.CreatedAt,
+			Used:      info.Used,
+		}
+	}
+	return l, nil
+}
+
+// RollbackSnapshot rolls the dataset back to the snapshot. The more recent
+// snapshots are destroyed.
+func (t *T) RollbackSnapshot(ctx context.Context, name, snapshot string) error {
+	if err := t.assertSnapshot(ctx, name, snapshot); err != nil {
+		return err
+	}
+	return t.snap(name, snapshot).Rollback(ctx)
+}
+
+// CloneSnapshot creates the dataset <cloneName> as a zfs clone of the
+// snapshot. The clone shares its unmodified blocks with the snapshot, which
+// can not be deleted while the clone exists.
+func (t *T) CloneSnapshot(ctx context.Context, name, snapshot, cloneName string, shared bool, nodes []string) ([]string, error) {
+	if err := t.assertSnapshot(ctx, name, snapshot); err != nil {
+		return nil, err
+	}
+	return nil, t.snap(name, snapshot).Clone(ctx, t.datasetName(cloneName))
+}
+
+func (t *T) assertSnapshot(ctx context.Context, name, snapshot string) error {
+	l, err := t.ListSnapshots(ctx, name)
+	if err != nil {
+		return err
+	}
+	for _, e := range l {
+		if e.Name == snapshot {
+			return nil
+		}
+	}
+	return fmt.Errorf("snapshot %s of %s not found", snapshot, t.datasetName(name))
+}
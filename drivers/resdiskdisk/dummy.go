@@ -18,6 +18,10 @@ func (t *T) ExposedDevices(ctx context.Context) device.L {
 	return l
 }
 
+func (t *T) rescan(_ context.Context) error {
+	return nil
+}
+
 func (t *T) unconfigure(_ context.Context) error {
 	return nil
 }
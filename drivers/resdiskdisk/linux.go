@@ -57,6 +57,29 @@ func (t *T) Status(ctx context.Context) status.T {
 	return status.NotApplicable
 }
 
+// rescan rescans the paths of the exposed multipath devices and resizes
+// the multipath maps to the new size of the paths.
+func (t *T) rescan(ctx context.Context) error {
+	for _, dev := range t.ExposedDevices(ctx) {
+		slaves, err := dev.Slaves()
+		if err != nil {
+			return fmt.Errorf("%s get slaves: %w", dev, err)
+		}
+		for _, slave := range slaves {
+			slave = device.New(slave.Path(), device.WithLogger(t.Log()))
+			if err := slave.Rescan(); err != nil {
+				return fmt.Errorf("%s slave %s rescan: %w", dev, slave, err)
+			}
+		}
+		udevadm.Settle()
+		dev = device.New(dev.Path(), device.WithLogger(t.Log()))
+		if err := dev.ResizeMultipath(ctx); err != nil {
+			return fmt.Errorf("%s multipath resize: %w", dev, err)
+		}
+	}
+	return nil
+}
+
 func (t *T) unconfigure(ctx context.Context) error {
 	for _, dev := range t.ExposedDevices(ctx) {
 		slaves, err := dev.Slaves()
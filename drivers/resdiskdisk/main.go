@@ -110,6 +110,15 @@ func (t *T) UnprovisionAsLeader(ctx context.Context) error {
 	return nil
 }
 
+// Resize rescans the disk paths so the node sees the new disk size.
+func (t *T) Resize(ctx context.Context) error {
+	if t.DiskID == "" {
+		t.Log().Infof("skip resize, disk_id is not set")
+		return nil
+	}
+	return t.rescan(ctx)
+}
+
 func (t *T) ReservableDevices(ctx context.Context) device.L {
 	return t.ExposedDevices(ctx)
 }
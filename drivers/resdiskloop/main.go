@@ -108,6 +108,20 @@ func (t *T) Status(ctx context.Context) status.T {
 	return status.Up
 }
 
+// Resize makes the loop device use the new size of its backing file.
+func (t *T) Resize(ctx context.Context) error {
+	lo := t.loop()
+	loInfo, err := lo.FileGet(ctx, t.File)
+	if err != nil {
+		return err
+	}
+	if loInfo == nil {
+		t.Log().Infof("skip resize, %s is not setup", t.File)
+		return nil
+	}
+	return lo.SetCapacity(ctx, loInfo.Name)
+}
+
 func (t *T) fileExists() (os.FileInfo, error) {
 	info, err := os.Stat(t.File)
 	switch {
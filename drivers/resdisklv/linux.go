@@ -11,3 +11,11 @@ func (t *T) lv() LVDriver {
 	)
 	return lv
 }
+
+func (t *T) thinPool() LVDriver {
+	lv := lvm2.NewLV(
+		t.VGName, t.ThinPool,
+		lvm2.WithLogger(t.Log()),
+	)
+	return lv
+}
@@ -22,6 +22,8 @@ type (
 		VGName        string   `json:"vg"`
 		Size          string   `json:"size"`
 		CreateOptions []string `json:"create_options"`
+		ThinPool      string   `json:"thin_pool"`
+		ThinPoolSize  string   `json:"thin_pool_size"`
 	}
 	LVDriver interface {
 		Activate(context.Context) error
@@ -35,6 +37,10 @@ type (
 	LVDriverProvisioner interface {
 		Create(context.Context, string, []string) error
 	}
+	LVDriverThinProvisioner interface {
+		CreateThin(context.Context, string, string, []string) error
+		CreateThinPool(context.Context, string, []string) error
+	}
 	LVDriverUnprovisioner interface {
 		Remove(context.Context, []string) error
 	}
@@ -69,6 +75,9 @@ func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
 		{Key: "name", Value: t.LVName},
 		{Key: "vg", Value: t.VGName},
 	}
+	if t.ThinPool != "" {
+		m = append(m, resource.InfoKey{Key: "thin_pool", Value: t.ThinPool})
+	}
 	return m, nil
 }
 
@@ -132,7 +141,11 @@ func (t *T) ProvisionAsLeader(ctx context.Context) error {
 		t.Log().Infof("%s is already provisioned", lv.FQN())
 		return nil
 	}
-	if lvi.Create(ctx, t.Size, t.CreateOptions); err != nil {
+	if t.ThinPool != "" {
+		if err := t.provisionThin(ctx, lv); err != nil {
+			return err
+		}
+	} else if lvi.Create(ctx, t.Size, t.CreateOptions); err != nil {
 		return err
 	}
 	actionrollback.Register(ctx, func(ctx context.Context) error {
@@ -145,6 +158,34 @@ func (t *T) ProvisionAsLeader(ctx context.Context) error {
 	return nil
 }
 
+// provisionThin creates the lv as a thin volume allocated from the
+// thin_pool lv. The thin pool is created first if it does not exist and
+// thin_pool_size is set.
+func (t *T) provisionThin(ctx context.Context, lv LVDriver) error {
+	lvi, ok := lv.(LVDriverThinProvisioner)
+	if !ok {
+		return fmt.Errorf("lv %s %s driver does not implement thin provisioning", lv.FQN(), lv.DriverName())
+	}
+	pool := t.thinPool()
+	exists, err := pool.Exists(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if t.ThinPoolSize == "" {
+			return fmt.Errorf("thin pool %s does not exist and thin_pool_size is not set", pool.FQN())
+		}
+		pooli, ok := pool.(LVDriverThinProvisioner)
+		if !ok {
+			return fmt.Errorf("lv %s %s driver does not implement thin provisioning", pool.FQN(), pool.DriverName())
+		}
+		if err := pooli.CreateThinPool(ctx, t.ThinPoolSize, nil); err != nil {
+			return err
+		}
+	}
+	return lvi.CreateThin(ctx, t.ThinPool, t.Size, t.CreateOptions)
+}
+
 func (t *T) UnprovisionAsLeader(ctx context.Context) error {
 	lv := t.lv()
 	exists, err := lv.Exists(ctx)
@@ -52,6 +52,22 @@ var (
 			Scopable:     true,
 			Text:         keywords.NewText(fs, "text/kw/create_options"),
 		},
+		{
+			Attr:         "ThinPool",
+			Example:      "pool1",
+			Option:       "thin_pool",
+			Provisioning: true,
+			Scopable:     true,
+			Text:         keywords.NewText(fs, "text/kw/thin_pool"),
+		},
+		{
+			Attr:         "ThinPoolSize",
+			Example:      "90%FREE",
+			Option:       "thin_pool_size",
+			Provisioning: true,
+			Scopable:     true,
+			Text:         keywords.NewText(fs, "text/kw/thin_pool_size"),
+		},
 	}
 )
 
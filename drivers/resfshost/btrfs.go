@@ -0,0 +1,123 @@
+package resfshost
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/util/btrfs"
+)
+
+const (
+	// btrfsTopLevelSubvolID is the id of the btrfs top-level subvolume,
+	// hosting all the other subvolumes.
+	btrfsTopLevelSubvolID = "5"
+)
+
+func (t *T) isBtrfsSubvol() bool {
+	return t.Type == "btrfs" && t.Subvol != ""
+}
+
+// btrfsSubvolMountOptions returns the mount options with the subvol
+// option added, unless already set.
+func btrfsSubvolMountOptions(options, subvol string) string {
+	for _, s := range strings.Split(options, ",") {
+		if strings.HasPrefix(s, "subvol=") || strings.HasPrefix(s, "subvolid=") {
+			return options
+		}
+	}
+	if options == "" {
+		return "subvol=" + subvol
+	}
+	return options + ",subvol=" + subvol
+}
+
+// withBtrfsTopLevel mounts the btrfs top-level subvolume on a temporary
+// directory and calls fn with this directory path.
+func (t *T) withBtrfsTopLevel(ctx context.Context, fn func(string) error) error {
+	fs := t.fs()
+	devpath := t.devpath(ctx)
+	if err := os.MkdirAll(rawconfig.Paths.Tmp, os.ModePerm); err != nil {
+		return err
+	}
+	dir, err := os.MkdirTemp(rawconfig.Paths.Tmp, "btrfs.")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(dir)
+	}()
+	if err := fs.Mount(ctx, devpath, dir, "subvolid="+btrfsTopLevelSubvolID); err != nil {
+		return err
+	}
+	defer func() {
+		if err := fs.Umount(ctx, dir); err != nil {
+			t.Log().Warnf("umount %s: %s", dir, err)
+		}
+	}()
+	return fn(dir)
+}
+
+func (t *T) btrfsSubvol(path string) *btrfs.Subvolume {
+	return &btrfs.Subvolume{Path: path, Log: t.Log()}
+}
+
+// provisionBtrfsSubvol creates the subvol in the btrfs filesystem and
+// limits its quota group to size, if set.
+func (t *T) provisionBtrfsSubvol(ctx context.Context) error {
+	return t.withBtrfsTopLevel(ctx, func(top string) error {
+		subvol := t.btrfsSubvol(filepath.Join(top, t.Subvol))
+		if v, err := subvol.Exists(ctx); err != nil {
+			return err
+		} else if v {
+			t.Log().Infof("subvolume %s is already created", t.Subvol)
+		} else if err := subvol.Create(ctx); err != nil {
+			return err
+		}
+		return t.setBtrfsSubvolLimit(ctx, subvol)
+	})
+}
+
+// unprovisionBtrfsSubvol deletes the subvol from the btrfs filesystem.
+func (t *T) unprovisionBtrfsSubvol(ctx context.Context) error {
+	return t.withBtrfsTopLevel(ctx, func(top string) error {
+		subvol := t.btrfsSubvol(filepath.Join(top, t.Subvol))
+		if v, err := subvol.Exists(ctx); err != nil {
+			return err
+		} else if !v {
+			t.Log().Infof("subvolume %s is already deleted", t.Subvol)
+			return nil
+		}
+		return subvol.Delete(ctx)
+	})
+}
+
+func (t *T) setBtrfsSubvolLimit(ctx context.Context, subvol *btrfs.Subvolume) error {
+	if t.Size == nil {
+		return nil
+	}
+	if err := subvol.EnableQuota(ctx); err != nil {
+		return err
+	}
+	return subvol.SetLimit(ctx, *t.Size)
+}
+
+// resizeBtrfsSubvol sets the mounted subvol quota group limit to size.
+func (t *T) resizeBtrfsSubvol(ctx context.Context) error {
+	if t.Size == nil {
+		return nil
+	}
+	t.Log().Infof("set the subvolume %s quota group limit", t.Subvol)
+	return t.setBtrfsSubvolLimit(ctx, t.btrfsSubvol(t.mountPoint()))
+}
+
+// UnprovisionAsLeader deletes the btrfs subvol, if set. The other
+// filesystems data is left on the device.
+func (t *T) UnprovisionAsLeader(ctx context.Context) error {
+	if !t.isBtrfsSubvol() {
+		return nil
+	}
+	return t.unprovisionBtrfsSubvol(ctx)
+}
@@ -50,6 +50,8 @@ type (
 		NoPreemptAbort  bool           `json:"no_preempt_abort"`
 		PromoteRW       bool           `json:"promote_rw"`
 		CheckRead       bool           `json:"check_read"`
+		Subvol          string         `json:"subvol"`
+		Size            *int64         `json:"size"`
 	}
 
 	IsFormateder interface {
@@ -196,6 +198,9 @@ func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
 		{Key: "mnt", Value: t.mountPoint()},
 		{Key: "mnt_opt", Value: t.MountOptions},
 	}
+	if t.isBtrfsSubvol() {
+		m = append(m, resource.InfoKey{Key: "subvol", Value: t.Subvol})
+	}
 	return m, nil
 }
 
@@ -205,6 +210,9 @@ func (t *T) testFile() string {
 
 func (t *T) mountOptions() string {
 	// in can we need to mangle options
+	if t.isBtrfsSubvol() {
+		return btrfsSubvolMountOptions(t.MountOptions, t.Subvol)
+	}
 	return t.MountOptions
 }
 
@@ -489,6 +497,16 @@ func (t *T) isMounted(ctx context.Context) (bool, error) {
 }
 
 func (t *T) ProvisionAsLeader(ctx context.Context) error {
+	if err := t.mkfs(ctx); err != nil {
+		return err
+	}
+	if t.isBtrfsSubvol() {
+		return t.provisionBtrfsSubvol(ctx)
+	}
+	return nil
+}
+
+func (t *T) mkfs(ctx context.Context) error {
 	fs := t.fs()
 	i1, ok := fs.(IsFormateder)
 	if !ok {
@@ -513,6 +531,36 @@ func (t *T) ProvisionAsLeader(ctx context.Context) error {
 	return nil
 }
 
+// Resize grows the mounted filesystem to the size of its device.
+func (t *T) Resize(ctx context.Context) error {
+	if t.isBtrfsSubvol() {
+		if v, err := t.isMounted(ctx); err != nil {
+			return err
+		} else if v {
+			if err := t.resizeBtrfsSubvol(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	fs := t.fs()
+	if _, ok := fs.(filesystems.Growfser); !ok {
+		t.Log().Infof("skip growfs, not implemented for type %s", fs)
+		return nil
+	}
+	if v, err := t.isMounted(ctx); err != nil {
+		return err
+	} else if !v {
+		t.Log().Infof("skip growfs, %s is not mounted", t.mountPoint())
+		return nil
+	}
+	devpath := t.devpath(ctx)
+	if devpath == "" {
+		return fmt.Errorf("%s real dev path is empty", t.Device)
+	}
+	t.Log().Infof("grow the %s filesystem mounted on %s", fs, t.mountPoint())
+	return filesystems.Growfs(ctx, fs, devpath, t.mountPoint())
+}
+
 func (t *T) Head() string {
 	return t.MountPoint
 }
@@ -73,6 +73,27 @@ var (
 		Scopable:  true,
 		Text:      keywords.NewText(fs, "text/kw/check_read"),
 	}
+	KeywordBtrfsSubvol = keywords.Keyword{
+		Attr:     "Subvol",
+		Example:  "volumes/{fqdn}",
+		Option:   "subvol",
+		Scopable: true,
+		Text:     keywords.NewText(fs, "text/kw/subvol"),
+	}
+	KeywordBtrfsSize = keywords.Keyword{
+		Attr:         "Size",
+		Converter:    "size",
+		Example:      "10g",
+		Option:       "size",
+		Provisioning: true,
+		Scopable:     true,
+		Text:         keywords.NewText(fs, "text/kw/size"),
+	}
+
+	KeywordsBtrfs = []*keywords.Keyword{
+		&KeywordBtrfsSubvol,
+		&KeywordBtrfsSize,
+	}
 
 	KeywordsVirtual = []*keywords.Keyword{
 		&KeywordMountPoint,
@@ -124,6 +145,9 @@ func (t *T) Manifest() *manifest.T {
 	m.Kinds.Or(naming.KindSvc, naming.KindVol)
 	m.Add(manifest.ContextObjectPath)
 	m.AddKeywords(KeywordsBase...)
+	if t.Type == "btrfs" {
+		m.AddKeywords(KeywordsBtrfs...)
+	}
 	m.AddKeywords(manifest.SCSIPersistentReservationKeywords...)
 	m.AddKeywords(datarecv.Keywords("DataRecv.")...)
 	return m
@@ -430,6 +430,48 @@ func (t *T) ProvisionAsLeader(ctx context.Context) error {
 	return nil
 }
 
+// Resize grows the size-relative quotas and reservations of the dataset
+// to their value computed from the size keyword. They are never shrunk.
+func (t *T) Resize(ctx context.Context) error {
+	fs := t.fs()
+	if v, err := fs.Exists(); err != nil {
+		return err
+	} else if !v {
+		t.Log().Infof("skip resize, dataset %s does not exist", t.Device)
+		return nil
+	}
+	props := []struct {
+		name string
+		fn   func() (*int64, error)
+	}{
+		{"refquota", t.refquota},
+		{"quota", t.quota},
+		{"refreservation", t.refreservation},
+		{"reservation", t.reservation},
+	}
+	for _, prop := range props {
+		v, err := prop.fn()
+		if err != nil {
+			return fmt.Errorf("%s: %w", prop.name, err)
+		} else if v == nil {
+			continue
+		}
+		s, err := fs.GetProperty(prop.name)
+		if err != nil {
+			return err
+		}
+		if current, err := strconv.ParseInt(s, 10, 64); err != nil {
+			return fmt.Errorf("%s: %w", prop.name, err)
+		} else if current >= *v {
+			continue
+		}
+		if err := fs.SetProperty(prop.name, fmt.Sprint(*v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (t *T) UnprovisionAsLeader(ctx context.Context) error {
 	fs := t.fs()
 	if v, err := fs.Exists(); err != nil {
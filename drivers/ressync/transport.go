@@ -0,0 +1,193 @@
+package ressync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/keywords"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+type (
+	// limitedReader throttles the reads from r to the limiter rate.
+	limitedReader struct {
+		ctx     context.Context
+		r       io.Reader
+		limiter *rate.Limiter
+	}
+)
+
+const (
+	// TransportSSH is the transport sending the data through a ssh
+	// session opened on the peer node. It requires a ssh trust between
+	// the cluster nodes.
+	TransportSSH = "ssh"
+
+	// TransportAPI is the transport streaming the data to the peer node
+	// daemon api, which pipes it into the peer instance sync ingest
+	// action.
+	TransportAPI = "api"
+)
+
+var (
+	KWTransport = keywords.Keyword{
+		Attr:       "Transport",
+		Candidates: []string{TransportSSH, TransportAPI},
+		Default:    TransportSSH,
+		Option:     "transport",
+		Scopable:   true,
+		Text:       keywords.NewText(fs, "text/kw/transport"),
+	}
+
+	bandwidthLimitRegexp = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// ParseBandwidthLimit returns the bytes per second rate of a bandwidth
+// limit expression. The default unit is KiB/s, like the rsync --bwlimit
+// option. An empty expression returns 0, meaning no limit.
+func ParseBandwidthLimit(s string) (int64, error) {
+	switch {
+	case s == "":
+		return 0, nil
+	case bandwidthLimitRegexp.MatchString(s):
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid bandwidth limit %s: %w", s, err)
+		}
+		return i * 1024, nil
+	default:
+		i, err := sizeconv.FromSize(s)
+		if err != nil {
+			return 0, fmt.Errorf("invalid bandwidth limit %s: %w", s, err)
+		}
+		return i, nil
+	}
+}
+
+// NewLimitedReader returns a reader throttled to bps bytes per second, or
+// r itself if bps is not positive.
+func NewLimitedReader(ctx context.Context, r io.Reader, bps int64) io.Reader {
+	if bps <= 0 {
+		return r
+	}
+	return &limitedReader{
+		ctx:     ctx,
+		r:       r,
+		limiter: rate.NewLimiter(rate.Limit(bps), int(bps)),
+	}
+}
+
+func (t *limitedReader) Read(b []byte) (int, error) {
+	if burst := t.limiter.Burst(); len(b) > burst {
+		b = b[:burst]
+	}
+	n, err := t.r.Read(b)
+	if n > 0 {
+		if err := t.limiter.WaitN(t.ctx, n); err != nil {
+			return n, err
+		}
+	}
+	return n, err
+}
+
+// ErrNoSyncData is returned by SyncData when the sync ingest action was
+// not given a data stream to ingest.
+var ErrNoSyncData = errors.New("no sync data stream to ingest")
+
+// SyncData returns the name and the reader of the data stream piped by
+// the daemon api into the resource sync ingest action.
+func (t *T) SyncData(ctx context.Context) (string, io.Reader, error) {
+	name, r := actioncontext.SyncData(ctx)
+	if name == "" || r == nil {
+		return "", nil, ErrNoSyncData
+	}
+	return name, r, nil
+}
+
+// PeerResumeToken returns the token to resume the data stream
+// interrupted on the peer node, or an empty string if the peer has no
+// stream to resume.
+func (t *T) PeerResumeToken(ctx context.Context, nodename string) (string, error) {
+	c, err := client.New(client.WithURL(nodename))
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.GetInstanceSyncDataWithResponse(ctx, nodename, t.Path.Namespace, t.Path.Kind, t.Path.Name, &api.GetInstanceSyncDataParams{
+		Rid: t.RID(),
+	})
+	switch {
+	case err != nil:
+		return "", fmt.Errorf("%s: get sync data state: %w", nodename, err)
+	case resp.StatusCode() != http.StatusOK:
+		return "", fmt.Errorf("%s: get sync data state: unexpected response: %s", nodename, resp.Status())
+	}
+	return resp.JSON200.ResumeToken, nil
+}
+
+// SendData streams the data written by the write function to the peer
+// node daemon api, which pipes it into the peer instance sync ingest
+// action, and returns when the peer has ingested the stream. The stream
+// is throttled to bps bytes per second if bps is positive.
+func (t *T) SendData(ctx context.Context, nodename, name string, bps int64, write func(io.Writer) error) error {
+	// The transfer duration depends on the stream size and the bandwidth
+	// limit, so rely on ctx instead of the client timeout.
+	c, err := client.New(client.WithURL(nodename), client.WithTimeout(0))
+	if err != nil {
+		return err
+	}
+
+	// The data flows from the write function to the request body through
+	// two pipes, so the transfer is throttled and accounted by
+	// CopyWithStats. Closing a pipe end with an error unblocks its peer,
+	// so an early failure of any side stops the others.
+	dataReader, dataWriter := io.Pipe()
+	bodyReader, bodyWriter := io.Pipe()
+	writeErr := make(chan error, 1)
+	go func() {
+		err := write(dataWriter)
+		_ = dataWriter.CloseWithError(err)
+		writeErr <- err
+	}()
+	copyDone := make(chan struct{})
+	go func() {
+		defer close(copyDone)
+		stats := NewStats(nodename)
+		_, err := t.CopyWithStats(ctx, bodyWriter, NewLimitedReader(ctx, dataReader, bps), stats)
+		_ = bodyWriter.CloseWithError(err)
+		_ = dataReader.CloseWithError(err)
+	}()
+	resp, err := c.PostInstanceSyncDataWithBodyWithResponse(ctx, nodename, t.Path.Namespace, t.Path.Kind, t.Path.Name, &api.PostInstanceSyncDataParams{
+		Name: name,
+		Rid:  t.RID(),
+	}, "application/octet-stream", bodyReader)
+	_ = bodyReader.CloseWithError(io.ErrClosedPipe)
+	<-copyDone
+	werr := <-writeErr
+	switch {
+	case err != nil:
+		return fmt.Errorf("%s: send sync data %s: %w", nodename, name, err)
+	case resp.StatusCode() != http.StatusOK:
+		return fmt.Errorf("%s: send sync data %s: unexpected response: %s: %s", nodename, name, resp.Status(), resp.Body)
+	case werr != nil:
+		return fmt.Errorf("%s: send sync data %s: %w", nodename, name, werr)
+	}
+	t.Log().Infof("%s: sync data %s ingested", nodename, name)
+	return nil
+}
+
+// LastSync returns the time of the last successful sync to the node, or
+// a zero time if the node was never synced.
+func (t *T) LastSync(nodename string) (time.Time, error) {
+	return t.readLastSync(nodename)
+}
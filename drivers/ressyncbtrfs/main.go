@@ -0,0 +1,524 @@
+//go:build linux
+
+package ressyncbtrfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/nodesinfo"
+	"github.com/opensvc/om3/v3/core/provisioned"
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/core/topology"
+	"github.com/opensvc/om3/v3/drivers/ressync"
+	"github.com/opensvc/om3/v3/util/btrfs"
+	"github.com/opensvc/om3/v3/util/hostname"
+)
+
+// T is the driver structure.
+type (
+	T struct {
+		ressync.T
+		resource.SSH
+		Src      string
+		Dst      string
+		Target   []string
+		Nodes    []string
+		DRPNodes []string
+		Timeout  *time.Duration
+		Topology topology.T
+
+		snapPrefix string
+	}
+
+	modeT uint
+)
+
+const (
+	modeFull modeT = iota
+	modeIncr
+
+	lockName = "sync"
+)
+
+func New() resource.Driver {
+	return &T{}
+}
+
+func (t *T) Running() (resource.RunningInfoList, error) {
+	return t.RunningFromLock(lockName)
+}
+
+func (t *T) Full(ctx context.Context) error {
+	disable := actioncontext.IsLockDisabled(ctx)
+	timeout := actioncontext.LockTimeout(ctx)
+	target := actioncontext.Target(ctx)
+	cancel, err := t.Lock(disable, timeout, lockName)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	return t.lockedSync(ctx, modeFull, target)
+}
+
+func (t *T) Update(ctx context.Context) error {
+	disable := actioncontext.IsLockDisabled(ctx)
+	timeout := actioncontext.LockTimeout(ctx)
+	target := actioncontext.Target(ctx)
+	cancel, err := t.Lock(disable, timeout, lockName)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	return t.lockedSync(ctx, modeIncr, target)
+}
+
+func (t *T) lockedSync(ctx context.Context, mode modeT, target []string) error {
+	if len(target) == 0 {
+		target = t.Target
+	}
+
+	isCron := actioncontext.IsCron(ctx)
+
+	if t.isFlexAndNotPrimary() {
+		return fmt.Errorf("this flex instance is not primary. only %s can sync", t.Nodes[0])
+	}
+
+	if v, rids := t.IsInstanceSufficientlyStarted(ctx); !v {
+		return fmt.Errorf("the instance is not sufficiently started (%s). refuse to sync to protect the data of the started remote instance", strings.Join(rids, ","))
+	}
+
+	var errs error
+	nodenames := t.GetTargetPeernames(target, t.Nodes, t.DRPNodes)
+	for _, nodename := range nodenames {
+		if err := t.isSendAllowedToPeerEnv(nodename); err != nil {
+			if isCron {
+				t.Log().Tracef("%s", err)
+			} else {
+				t.Log().Infof("%s", err)
+			}
+			continue
+		}
+		if err := t.targetSync(ctx, mode, nodename, nodenames); err != nil {
+			t.Log().Attr("host", nodename).Errorf("sync to %s: %s", nodename, err)
+			errs = errors.Join(errs, fmt.Errorf("sync to %s: %w", nodename, err))
+		}
+	}
+	return errs
+}
+
+// targetSync sends the src subvolume to the nodename. Each target node
+// has its own pair of snapshots, so a failed sync to a node does not
+// break the incremental syncs to the other nodes.
+func (t *T) targetSync(ctx context.Context, mode modeT, nodename string, nodenames []string) error {
+	srcSnapSent := t.srcSnapSent(nodename)
+	srcSnapTosend := t.srcSnapTosend(nodename)
+	hasSnapSent, err := t.subvol(srcSnapSent).Exists(ctx)
+	if err != nil {
+		return err
+	}
+	hasSnapTosend, err := t.subvol(srcSnapTosend).Exists(ctx)
+	if err != nil {
+		return err
+	}
+
+	if mode != modeFull && !hasSnapSent {
+		t.Log().Infof("%s does not exist: can't send delta, send full", srcSnapSent)
+		mode = modeFull
+	}
+	if mode == modeFull && hasSnapTosend {
+		if err := t.subvol(srcSnapTosend).Delete(ctx); err != nil {
+			return err
+		}
+		hasSnapTosend = false
+	}
+	if !hasSnapTosend {
+		if err := t.subvol(t.Src).Snapshot(ctx, srcSnapTosend, true); err != nil {
+			return err
+		}
+	}
+
+	if err := t.peerSync(ctx, mode, nodename); err != nil {
+		return err
+	}
+	if err := t.rotatePeerSnaps(ctx, nodename); err != nil {
+		return err
+	}
+	if err := t.rotateSnaps(ctx, nodename, hasSnapSent); err != nil {
+		return err
+	}
+	if err := t.refreshPeerDst(ctx, nodename); err != nil {
+		return err
+	}
+	return t.WritePeerLastSync(ctx, nodename, nodenames)
+}
+
+func (t *T) peerSync(ctx context.Context, mode modeT, nodename string) error {
+	if hostname.Hostname() == nodename && filepath.Dir(t.Dst) == filepath.Dir(t.Src) {
+		return fmt.Errorf("a local sync requires the src and dst subvolumes in different directories")
+	}
+	if err := t.peerMkdir(ctx, nodename, filepath.Dir(t.Dst)); err != nil {
+		return err
+	}
+	if err := t.peerDeleteSubvol(ctx, nodename, t.dstSnapTosend(nodename)); err != nil {
+		return err
+	}
+	if mode == modeFull {
+		return t.send(ctx, nodename, t.sendInitialCmd(nodename))
+	} else if v, err := t.peerSubvolExists(ctx, nodename, t.dstSnapSent(nodename)); err != nil {
+		return err
+	} else if v {
+		return t.send(ctx, nodename, t.sendIncrementalCmd(nodename))
+	} else {
+		t.Log().Infof("%s does not exist on %s: can't send delta, send full", t.dstSnapSent(nodename), nodename)
+		return t.send(ctx, nodename, t.sendInitialCmd(nodename))
+	}
+}
+
+func (t *T) sendInitialCmd(nodename string) []string {
+	return []string{"btrfs", "send", t.srcSnapTosend(nodename)}
+}
+
+func (t *T) sendIncrementalCmd(nodename string) []string {
+	return []string{"btrfs", "send", "-p", t.srcSnapSent(nodename), t.srcSnapTosend(nodename)}
+}
+
+func (t *T) receiveCmd() []string {
+	return []string{"btrfs", "receive", filepath.Dir(t.Dst)}
+}
+
+// send pipes the btrfs send stream to a btrfs receive command, run
+// locally or through ssh depending on the nodename. Both commands are
+// killed and waited on copy error, so none is left running.
+func (t *T) send(ctx context.Context, nodename string, args []string) error {
+	nfoWriter := t.Log().Writer(zerolog.InfoLevel)
+	errWriter := t.Log().Writer(zerolog.ErrorLevel)
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	stdoutPipe, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("error creating stdout pipe for btrfs send: %w", err)
+	}
+	defer stdoutPipe.Close()
+	cmd.Stderr = errWriter
+
+	rargs := t.receiveCmd()
+	var (
+		stdinPipe io.WriteCloser
+		wait      func() error
+		kill      func()
+		rcmdStr   string
+	)
+	if hostname.Hostname() == nodename {
+		rcmd := exec.CommandContext(ctx, rargs[0], rargs[1:]...)
+		if stdinPipe, err = rcmd.StdinPipe(); err != nil {
+			return fmt.Errorf("error creating stdin pipe for btrfs receive: %w", err)
+		}
+		rcmd.Stdout = nfoWriter
+		rcmd.Stderr = errWriter
+		rcmdStr = rcmd.String()
+		t.Log().Infof("%s | %s", cmd, rcmdStr)
+		if err := rcmd.Start(); err != nil {
+			return err
+		}
+		wait = rcmd.Wait
+		kill = func() { _ = rcmd.Process.Kill() }
+	} else {
+		client, err := t.NewSSHClient(nodename)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		session, err := client.NewSession()
+		if err != nil {
+			return err
+		}
+		defer session.Close()
+		if stdinPipe, err = session.StdinPipe(); err != nil {
+			return err
+		}
+		session.Stdout = nfoWriter
+		session.Stderr = errWriter
+		rcmdStr = exec.Command(rargs[0], rargs[1:]...).String()
+		t.Log().Infof("%s | ssh %s '%s'", cmd, nodename, rcmdStr)
+		if err := session.Start(rcmdStr); err != nil {
+			return err
+		}
+		wait = session.Wait
+		kill = func() {
+			_ = session.Signal(ssh.SIGKILL)
+			_ = session.Close()
+		}
+	}
+	defer stdinPipe.Close()
+
+	abortReceive := func() {
+		_ = stdinPipe.Close()
+		kill()
+		_ = wait()
+	}
+	if err := cmd.Start(); err != nil {
+		abortReceive()
+		return err
+	}
+	stats := ressync.NewStats(nodename)
+	if _, err := t.CopyWithStats(ctx, stdinPipe, stdoutPipe, stats); err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		abortReceive()
+		return err
+	}
+	if err := stdinPipe.Close(); err != nil {
+		return err
+	}
+	if err := cmd.Wait(); err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			t.Log().Attr("exitcode", ee.ExitCode()).Errorf("exec '%s' on localhost exited with code %d", cmd, ee.ExitCode())
+		}
+		return err
+	}
+	if err := wait(); err != nil {
+		t.Log().Attr("host", nodename).Errorf("exec '%s' on %s: %s", rcmdStr, nodename, err)
+		return err
+	}
+	return nil
+}
+
+// peerRun runs the command on the nodename, locally or through ssh, and
+// returns its combined outputs.
+func (t *T) peerRun(ctx context.Context, nodename string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	if hostname.Hostname() == nodename {
+		return cmd.CombinedOutput()
+	}
+	client, err := t.NewSSHClient(nodename)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+	return session.CombinedOutput(cmd.String())
+}
+
+// peerExec runs the command on the nodename and logs it.
+func (t *T) peerExec(ctx context.Context, nodename string, args ...string) error {
+	cmdStr := exec.Command(args[0], args[1:]...).String()
+	t.Log().Attr("host", nodename).Infof("exec '%s' on %s", cmdStr, nodename)
+	if b, err := t.peerRun(ctx, nodename, args...); err != nil {
+		t.Log().Attr("host", nodename).Attr("outputs", string(b)).Errorf("exec '%s' on %s: %s", cmdStr, nodename, err)
+		return err
+	}
+	return nil
+}
+
+func (t *T) peerSubvolExists(ctx context.Context, nodename, path string) (bool, error) {
+	if hostname.Hostname() == nodename {
+		return t.subvol(path).Exists(ctx)
+	}
+	if _, err := t.peerRun(ctx, nodename, "btrfs", "subvolume", "show", path); err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+func (t *T) peerDeleteSubvol(ctx context.Context, nodename, path string) error {
+	if v, err := t.peerSubvolExists(ctx, nodename, path); err != nil {
+		return err
+	} else if !v {
+		return nil
+	}
+	return t.peerExec(ctx, nodename, "btrfs", "subvolume", "delete", path)
+}
+
+func (t *T) peerMkdir(ctx context.Context, nodename, path string) error {
+	_, err := t.peerRun(ctx, nodename, "mkdir", "-p", path)
+	return err
+}
+
+// rotatePeerSnaps replaces the sent snapshot by the snapshot just
+// received on the nodename.
+func (t *T) rotatePeerSnaps(ctx context.Context, nodename string) error {
+	if err := t.peerDeleteSubvol(ctx, nodename, t.dstSnapSent(nodename)); err != nil {
+		return err
+	}
+	return t.peerExec(ctx, nodename, "mv", t.dstSnapTosend(nodename), t.dstSnapSent(nodename))
+}
+
+// refreshPeerDst replaces the dst subvolume of the nodename by a
+// writable snapshot of the last received snapshot.
+func (t *T) refreshPeerDst(ctx context.Context, nodename string) error {
+	if err := t.peerDeleteSubvol(ctx, nodename, t.Dst); err != nil {
+		return err
+	}
+	return t.peerExec(ctx, nodename, "btrfs", "subvolume", "snapshot", t.dstSnapSent(nodename), t.Dst)
+}
+
+// rotateSnaps replaces the source snapshot last received by the
+// nodename by the snapshot just sent.
+func (t *T) rotateSnaps(ctx context.Context, nodename string, hasSnapSent bool) error {
+	if hasSnapSent {
+		if err := t.subvol(t.srcSnapSent(nodename)).Delete(ctx); err != nil {
+			return err
+		}
+	}
+	return t.subvol(t.srcSnapTosend(nodename)).Rename(t.srcSnapSent(nodename))
+}
+
+// srcSnapSent returns the path of the source snapshot last received by
+// the nodename, the parent of the next incremental send.
+func (t *T) srcSnapSent(nodename string) string {
+	return t.snapPrefix + ".sent." + nodename
+}
+
+// srcSnapTosend returns the path of the source snapshot being sent to
+// the nodename.
+func (t *T) srcSnapTosend(nodename string) string {
+	return t.snapPrefix + ".tosend." + nodename
+}
+
+// dstSnapSent returns the path of the snapshot last received on the
+// nodename. btrfs receive names the received snapshots after the source
+// snapshots.
+func (t *T) dstSnapSent(nodename string) string {
+	return filepath.Join(filepath.Dir(t.Dst), filepath.Base(t.srcSnapSent(nodename)))
+}
+
+// dstSnapTosend returns the path of the snapshot being received on the
+// nodename.
+func (t *T) dstSnapTosend(nodename string) string {
+	return filepath.Join(filepath.Dir(t.Dst), filepath.Base(t.srcSnapTosend(nodename)))
+}
+
+func (t *T) subvol(path string) *btrfs.Subvolume {
+	return &btrfs.Subvolume{Path: path, Log: t.Log()}
+}
+
+func (t *T) Kill(ctx context.Context) error {
+	return nil
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	var isSourceNode bool
+	if v, _ := t.IsInstanceSufficientlyStarted(ctx); !v {
+		isSourceNode = false
+	} else if t.isFlexAndNotPrimary() {
+		isSourceNode = false
+	} else {
+		isSourceNode = true
+	}
+	nodenames := t.getTargetNodenames(isSourceNode)
+	return t.StatusLastSync(nodenames)
+}
+
+// Label implements Label from resource.Driver interface,
+// it returns a formatted short description of the Resource
+func (t *T) Label(_ context.Context) string {
+	switch {
+	case t.Src != "" && len(t.Target) > 0:
+		return t.Src + " to " + strings.Join(t.Target, " ")
+	case t.Src != "":
+		return t.Src + " to void"
+	case len(t.Target) > 0:
+		return "nothing to " + strings.Join(t.Target, " ")
+	default:
+		return ""
+	}
+}
+
+func (t *T) ScheduleOptions() resource.ScheduleOptions {
+	return resource.ScheduleOptions{
+		Action: "sync_update",
+		Option: "schedule",
+		Base:   "",
+	}
+}
+
+func (t *T) Provisioned(ctx context.Context) (provisioned.T, error) {
+	return provisioned.NotApplicable, nil
+}
+
+// Configure sets the prefix of the snapshots paths. The source snapshots
+// are created next to the src subvolume, and received next to the dst
+// subvolume.
+func (t *T) Configure() error {
+	rid := strings.Replace(t.RID(), "#", ".", 1)
+	t.snapPrefix = t.Src + "@" + rid
+	return nil
+}
+
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	target := sort.StringSlice(t.Target)
+	sort.Sort(target)
+	m := resource.InfoKeys{
+		{Key: "src", Value: t.Src},
+		{Key: "dst", Value: t.Dst},
+		{Key: "target", Value: strings.Join(target, " ")},
+	}
+	if t.Timeout != nil {
+		m = append(m, resource.InfoKey{Key: "timeout", Value: fmt.Sprintf("%s", t.Timeout)})
+	}
+	return m, nil
+}
+
+func (t *T) isFlexAndNotPrimary() bool {
+	if t.Topology != topology.Flex {
+		return false
+	}
+	if hostname.Hostname() == t.Nodes[0] {
+		return false
+	}
+	return true
+}
+
+func (t *T) isSendAllowedToPeerEnv(nodename string) error {
+	var localEnv, peerEnv string
+	nodesInfo, err := nodesinfo.Load()
+	if err != nil {
+		return fmt.Errorf("get nodes info: %w", err)
+	}
+	getEnv := func(n string, s *string) error {
+		if m, ok := nodesInfo[n]; !ok {
+			return fmt.Errorf("node %s not found in nodes_info.json", n)
+		} else {
+			*s = m.Env
+		}
+		return nil
+	}
+	if err := getEnv(hostname.Hostname(), &localEnv); err != nil {
+		return err
+	}
+	if err := getEnv(nodename, &peerEnv); err != nil {
+		return err
+	}
+	if localEnv != "PRD" && peerEnv == "PRD" {
+		return fmt.Errorf("refuse to sync from a non-PRD node to a PRD node")
+	}
+	return nil
+}
+
+func (t *T) getTargetNodenames(isSourceNode bool) []string {
+	if isSourceNode {
+		// if the instance is active, check last sync timestamp for each peer
+		return t.GetTargetPeernames(t.Target, t.Nodes, t.DRPNodes)
+	} else {
+		// if the instance is passive, check last sync timestamp for the local node (received from the source node)
+		return []string{hostname.Hostname()}
+	}
+}
@@ -0,0 +1,19 @@
+//go:build linux
+
+package ressyncbtrfs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapPaths(t *testing.T) {
+	r := &T{Src: "/srv/data/svc1", Dst: "/srv/replica/svc1", snapPrefix: "/srv/data/svc1@sync.1"}
+	require.Equal(t, "/srv/data/svc1@sync.1.sent.node2", r.srcSnapSent("node2"))
+	require.Equal(t, "/srv/data/svc1@sync.1.tosend.node2", r.srcSnapTosend("node2"))
+	require.Equal(t, "/srv/replica/svc1@sync.1.sent.node2", r.dstSnapSent("node2"))
+	require.Equal(t, "/srv/replica/svc1@sync.1.tosend.node2", r.dstSnapTosend("node2"))
+	require.NotEqual(t, r.srcSnapSent("node2"), r.srcSnapSent("node3"), "each target has its own parent snapshot")
+	require.Equal(t, []string{"btrfs", "send", "-p", "/srv/data/svc1@sync.1.sent.node3", "/srv/data/svc1@sync.1.tosend.node3"}, r.sendIncrementalCmd("node3"))
+}
@@ -0,0 +1,124 @@
+package ressyncrsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/opensvc/om3/v3/drivers/ressync"
+	"github.com/opensvc/om3/v3/util/findmnt"
+)
+
+// apiSync streams the tar stream of the source tree changes since the
+// last sync to the peer through its daemon api, which pipes it into the
+// peer instance sync ingest action. The peer index is updated only when
+// the peer acknowledges the stream is applied, so an interrupted stream
+// is sent again by the next sync.
+func (t *T) apiSync(ctx context.Context, mode modeT, nodename string) error {
+	if t.Dst == "" {
+		return fmt.Errorf("the %s transport requires a dst", ressync.TransportAPI)
+	}
+	bps, err := ressync.ParseBandwidthLimit(t.BandwidthLimit)
+	if err != nil {
+		return err
+	}
+	var prev index
+	if mode != modeFull {
+		if prev, err = t.loadPeerIndex(nodename); err != nil {
+			return err
+		} else if prev == nil {
+			t.Log().Infof("%s was never synced: send full", nodename)
+			mode = modeFull
+		}
+	}
+	sources, contentOnly, err := trimmedSources(t.Src)
+	if err != nil {
+		return err
+	}
+	tr, err := scanTree(sources, contentOnly)
+	if err != nil {
+		return err
+	}
+	t.Log().Infof("send the %s changes of %s to %s", mode, t.Src, nodename)
+	err = t.SendData(ctx, nodename, mode.String(), bps, func(w io.Writer) error {
+		return writeArchive(w, tr, prev)
+	})
+	if err != nil {
+		return err
+	}
+	return writeIndex(t.peerIndexFile(nodename), tr.index)
+}
+
+// peerIndexFile returns the path of the index of the source tree files
+// last ingested by the peer.
+func (t *T) peerIndexFile(nodename string) string {
+	return filepath.Join(t.VarDir(), "sync_index_"+nodename+".json")
+}
+
+// loadPeerIndex returns the index of the source tree files last ingested
+// by the peer, or nil if the peer was never synced.
+func (t *T) loadPeerIndex(nodename string) (index, error) {
+	b, err := os.ReadFile(t.peerIndexFile(nodename))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var idx index
+	if err := json.Unmarshal(b, &idx); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+func writeIndex(filename string, idx index) error {
+	b, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, b, 0600)
+}
+
+// Ingest applies the tar stream piped by the daemon api from the sender
+// with the api transport to the destination directory.
+func (t *T) Ingest(ctx context.Context) error {
+	name, r, err := t.SyncData(ctx)
+	if errors.Is(err, ressync.ErrNoSyncData) {
+		t.Log().Infof("%s", err)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if t.Dst == "" {
+		return fmt.Errorf("the %s transport requires a dst", ressync.TransportAPI)
+	}
+	if t.DstFS != "" {
+		if v, err := findmnt.HasMnt(ctx, t.DstFS); err != nil {
+			return err
+		} else if !v {
+			return fmt.Errorf("the destination fs %s is not mounted. refuse to sync %s to protect parent fs", t.DstFS, t.Dst)
+		}
+	}
+	if err := os.MkdirAll(t.Dst, 0755); err != nil {
+		return err
+	}
+	contentOnly := strings.HasSuffix(t.Src, "/")
+	t.Log().Infof("apply the %s sync data stream to %s", name, t.Dst)
+	return applyArchive(r, t.Dst, name == modeFull.String(), contentOnly)
+}
+
+func (t modeT) String() string {
+	switch t {
+	case modeFull:
+		return "full"
+	case modeIncr:
+		return "incr"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,336 @@
+package ressyncrsync
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+)
+
+type (
+	// indexEntry is the metadata of a file of the source tree, compared
+	// to the previous sync metadata to detect the files to send.
+	indexEntry struct {
+		Mode    os.FileMode `json:"mode"`
+		Size    int64       `json:"size"`
+		ModTime int64       `json:"mtime"`
+		CTime   int64       `json:"ctime"`
+		UID     int         `json:"uid"`
+		GID     int         `json:"gid"`
+		Link    string      `json:"link,omitempty"`
+
+		// HardLink is the relative path of the first file of the tree
+		// sharing the inode of this file.
+		HardLink string `json:"hardlink,omitempty"`
+	}
+
+	// inode identifies the files sharing their data through hardlinks.
+	inode struct {
+		dev uint64
+		ino uint64
+	}
+
+	// index maps the relative paths of the source tree files to their
+	// metadata.
+	index map[string]indexEntry
+
+	// tree is a scanned source tree.
+	tree struct {
+		// names are the relative paths of the files, in walk order.
+		names []string
+
+		// paths maps the relative paths to the source file paths.
+		paths map[string]string
+
+		index index
+	}
+)
+
+const (
+	// paxDeleted is the pax record marking an archive entry as a file
+	// deleted from the source tree since the last sync.
+	paxDeleted = "OPENSVC.deleted"
+
+	// paxXattrPrefix is the prefix of the pax records holding the file
+	// extended attributes, as written by gnu tar and star.
+	paxXattrPrefix = "SCHILY.xattr."
+)
+
+// scanTree walks the source directories. Like rsync, a source with a
+// trailing slash sends the directory content, and a source without sends
+// the directory itself.
+func scanTree(sources []string, contentOnly bool) (*tree, error) {
+	t := &tree{
+		paths: make(map[string]string),
+		index: make(index),
+	}
+	inodes := make(map[inode]string)
+	for _, src := range sources {
+		prefix := ""
+		if !contentOnly {
+			prefix = filepath.Base(src)
+		}
+		err := filepath.WalkDir(src, func(path string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(src, path)
+			if err != nil {
+				return err
+			}
+			name := filepath.Join(prefix, rel)
+			if name == "." {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			entry := indexEntry{
+				Mode:    info.Mode(),
+				ModTime: info.ModTime().UnixNano(),
+			}
+			if info.Mode().IsRegular() {
+				entry.Size = info.Size()
+			}
+			if st, ok := info.Sys().(*syscall.Stat_t); ok {
+				entry.UID = int(st.Uid)
+				entry.GID = int(st.Gid)
+				entry.CTime = changeTime(st)
+				if info.Mode().IsRegular() && st.Nlink > 1 {
+					key := inode{dev: uint64(st.Dev), ino: uint64(st.Ino)}
+					if first, ok := inodes[key]; ok && first != name {
+						entry.HardLink = first
+					} else {
+						inodes[key] = name
+					}
+				}
+			}
+			if info.Mode()&os.ModeSymlink != 0 {
+				if entry.Link, err = os.Readlink(path); err != nil {
+					return err
+				}
+			}
+			if _, ok := t.index[name]; !ok {
+				t.names = append(t.names, name)
+			}
+			t.paths[name] = path
+			t.index[name] = entry
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// writeArchive writes to w the deletion markers of the prev index files
+// not found in the tree, followed by the tar stream of the tree files
+// changed since the prev index. A nil prev index sends all the files.
+// The deletions are sent first, so they are applied before a directory
+// is replaced by a file or a symlink.
+func writeArchive(w io.Writer, t *tree, prev index) error {
+	tw := tar.NewWriter(w)
+	deleted := make([]string, 0)
+	for name := range prev {
+		if _, ok := t.index[name]; !ok {
+			deleted = append(deleted, name)
+		}
+	}
+	slices.Sort(deleted)
+	for _, name := range deleted {
+		hdr := &tar.Header{
+			Name:       name,
+			Typeflag:   tar.TypeReg,
+			Mode:       0600,
+			Format:     tar.FormatPAX,
+			PAXRecords: map[string]string{paxDeleted: "true"},
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+	}
+	for _, name := range t.names {
+		entry := t.index[name]
+		if prevEntry, ok := prev[name]; ok && prevEntry == entry {
+			continue
+		}
+		if err := writeArchiveEntry(tw, name, t.paths[name], entry); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+func writeArchiveEntry(tw *tar.Writer, name, path string, entry indexEntry) error {
+	hdr := &tar.Header{
+		Name:     name,
+		Mode:     int64(entry.Mode.Perm()),
+		ModTime:  time.Unix(0, entry.ModTime),
+		Uid:      entry.UID,
+		Gid:      entry.GID,
+		Format:   tar.FormatPAX,
+		Linkname: entry.Link,
+	}
+	switch {
+	case entry.Mode.IsDir():
+		hdr.Typeflag = tar.TypeDir
+	case entry.Mode&os.ModeSymlink != 0:
+		hdr.Typeflag = tar.TypeSymlink
+	case entry.HardLink != "":
+		hdr.Typeflag = tar.TypeLink
+		hdr.Linkname = entry.HardLink
+	case entry.Mode.IsRegular():
+		hdr.Typeflag = tar.TypeReg
+		hdr.Size = entry.Size
+	default:
+		// devices, fifos and sockets are not replicated
+		return nil
+	}
+	if hdr.Typeflag == tar.TypeDir || hdr.Typeflag == tar.TypeReg {
+		xattrs, err := readXattrs(path)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		for k, v := range xattrs {
+			if hdr.PAXRecords == nil {
+				hdr.PAXRecords = make(map[string]string)
+			}
+			hdr.PAXRecords[paxXattrPrefix+k] = v
+		}
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if hdr.Typeflag != tar.TypeReg {
+		return nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := io.CopyN(tw, file, hdr.Size); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// applyArchive applies the tar stream written by writeArchive to the dst
+// directory. With prune, the dst files not found in the stream are
+// removed, like the rsync --delete option does: the top-level files are
+// removed only if the stream was sent from the content of the source
+// directories. The entries are resolved under dst without following
+// symlinks.
+func applyArchive(r io.Reader, dst string, prune, contentOnly bool) error {
+	type dirTime struct {
+		name  string
+		mtime time.Time
+	}
+	var dirs []dirTime
+	seen := make(map[string]bool)
+	d, err := openDestination(dst)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+		name := filepath.Clean(hdr.Name)
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("refuse to apply the archive entry %s: not local to %s", hdr.Name, dst)
+		}
+		if _, ok := hdr.PAXRecords[paxDeleted]; ok {
+			if err := d.remove(name); err != nil {
+				return err
+			}
+			continue
+		}
+		seen[name] = true
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := d.mkdir(name, hdr); err != nil {
+				return err
+			}
+			dirs = append(dirs, dirTime{name: name, mtime: hdr.ModTime})
+		case tar.TypeReg:
+			if err := d.writeFile(name, hdr, tr); err != nil {
+				return err
+			}
+		case tar.TypeLink:
+			linkname := filepath.Clean(hdr.Linkname)
+			if !filepath.IsLocal(linkname) {
+				return fmt.Errorf("refuse to apply the archive entry %s: hardlink to %s not local to %s", hdr.Name, hdr.Linkname, dst)
+			}
+			if err := d.link(linkname, name, hdr); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := d.symlink(name, hdr); err != nil {
+				return err
+			}
+		}
+	}
+	if prune {
+		if err := d.prune(seen, contentOnly); err != nil {
+			return err
+		}
+	}
+	// Set the directories modification time last, as the creation of
+	// their content changes it.
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := d.chtimes(dirs[i].name, dirs[i].mtime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archiveXattrs returns the extended attributes of the archive entry.
+func archiveXattrs(hdr *tar.Header) map[string]string {
+	m := make(map[string]string)
+	for k, v := range hdr.PAXRecords {
+		if name, ok := strings.CutPrefix(k, paxXattrPrefix); ok {
+			m[name] = v
+		}
+	}
+	return m
+}
+
+// xattrFilter returns true if the extended attribute is replicated. The
+// selinux labels are left to the destination policy, and only the root
+// user can set the attributes outside the user namespace.
+func xattrFilter(name string) bool {
+	switch {
+	case name == "security.selinux":
+		return false
+	case os.Geteuid() == 0:
+		return true
+	default:
+		return strings.HasPrefix(name, "user.")
+	}
+}
+
+// trimmedSources returns the existing paths matching the src glob
+// pattern, and true if the pattern has a trailing slash.
+func trimmedSources(src string) ([]string, bool, error) {
+	contentOnly := strings.HasSuffix(src, "/")
+	matches, err := filepath.Glob(strings.TrimRight(src, "/"))
+	if err != nil {
+		return nil, false, err
+	}
+	return matches, contentOnly, nil
+}
@@ -0,0 +1,17 @@
+//go:build !linux
+
+package ressyncrsync
+
+import "syscall"
+
+func changeTime(st *syscall.Stat_t) int64 {
+	return 0
+}
+
+func readXattrs(path string) (map[string]string, error) {
+	return nil, nil
+}
+
+func writeXattrs(fd int, m map[string]string, filter func(string) bool) error {
+	return nil
+}
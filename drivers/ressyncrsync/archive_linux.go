@@ -0,0 +1,111 @@
+//go:build linux
+
+package ressyncrsync
+
+import (
+	"errors"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// changeTime returns the inode change time of the file stat, in
+// nanoseconds. It changes with the file xattrs, acls, mode and owner.
+func changeTime(st *syscall.Stat_t) int64 {
+	return st.Ctim.Nano()
+}
+
+// readXattrs returns the extended attributes of the file, including the
+// posix acls stored in the system.posix_acl_access and
+// system.posix_acl_default attributes. The attributes are not followed
+// through symlinks.
+func readXattrs(path string) (map[string]string, error) {
+	names, err := listXattrs(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	m := make(map[string]string)
+	for _, name := range names {
+		size, err := unix.Lgetxattr(path, name, nil)
+		if errors.Is(err, unix.ENODATA) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		value := make([]byte, size)
+		if size, err = unix.Lgetxattr(path, name, value); err != nil {
+			return nil, err
+		}
+		m[name] = string(value[:size])
+	}
+	return m, nil
+}
+
+// listXattrs returns the names of the extended attributes of the file, or
+// nil if the filesystem does not support extended attributes.
+func listXattrs(path string) ([]string, error) {
+	size, err := unix.Llistxattr(path, nil)
+	if errors.Is(err, unix.ENOTSUP) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		return nil, nil
+	}
+	buf := make([]byte, size)
+	if size, err = unix.Llistxattr(path, buf); err != nil {
+		return nil, err
+	}
+	return strings.Split(strings.TrimRight(string(buf[:size]), "\x00"), "\x00"), nil
+}
+
+// writeXattrs sets the extended attributes of the fd file to m, removing
+// the attributes not in m. The attributes the filter rejects are left
+// untouched.
+func writeXattrs(fd int, m map[string]string, filter func(string) bool) error {
+	names, err := flistXattrs(fd)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if _, ok := m[name]; ok || !filter(name) {
+			continue
+		}
+		if err := unix.Fremovexattr(fd, name); err != nil && !errors.Is(err, unix.ENODATA) {
+			return err
+		}
+	}
+	for name, value := range m {
+		if !filter(name) {
+			continue
+		}
+		if err := unix.Fsetxattr(fd, name, []byte(value), 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flistXattrs returns the names of the extended attributes of the fd
+// file, or nil if the filesystem does not support extended attributes.
+func flistXattrs(fd int) ([]string, error) {
+	size, err := unix.Flistxattr(fd, nil)
+	if errors.Is(err, unix.ENOTSUP) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		return nil, nil
+	}
+	buf := make([]byte, size)
+	if size, err = unix.Flistxattr(fd, buf); err != nil {
+		return nil, err
+	}
+	return strings.Split(strings.TrimRight(string(buf[:size]), "\x00"), "\x00"), nil
+}
@@ -0,0 +1,59 @@
+//go:build linux
+
+package ressyncrsync
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+func TestArchiveHardLinksAndXattrs(t *testing.T) {
+	src := t.TempDir()
+	dst := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(src, "f1"), []byte("foo"), 0644))
+	require.NoError(t, os.Link(filepath.Join(src, "f1"), filepath.Join(src, "f2")))
+	xattrs := true
+	if err := unix.Setxattr(filepath.Join(src, "f1"), "user.test", []byte("bar"), 0); errors.Is(err, unix.ENOTSUP) {
+		xattrs = false
+	} else {
+		require.NoError(t, err)
+	}
+
+	tr, err := scanTree([]string{src + "/"}, true)
+	require.NoError(t, err)
+	require.Equal(t, "f1", tr.index["f2"].HardLink)
+	var b bytes.Buffer
+	require.NoError(t, writeArchive(&b, tr, nil))
+	require.NoError(t, applyArchive(&b, dst, true, true))
+
+	info1, err := os.Stat(filepath.Join(dst, "f1"))
+	require.NoError(t, err)
+	info2, err := os.Stat(filepath.Join(dst, "f2"))
+	require.NoError(t, err)
+	require.True(t, os.SameFile(info1, info2), "f2 is a hardlink to f1")
+
+	if !xattrs {
+		t.Skip("the filesystem does not support xattrs")
+	}
+	m, err := readXattrs(filepath.Join(dst, "f1"))
+	require.NoError(t, err)
+	require.Equal(t, "bar", m["user.test"])
+
+	t.Run("removed xattrs are removed from dst", func(t *testing.T) {
+		require.NoError(t, unix.Removexattr(filepath.Join(src, "f1"), "user.test"))
+		next, err := scanTree([]string{src + "/"}, true)
+		require.NoError(t, err)
+		var b bytes.Buffer
+		require.NoError(t, writeArchive(&b, next, tr.index))
+		require.NoError(t, applyArchive(&b, dst, false, true))
+		m, err := readXattrs(filepath.Join(dst, "f1"))
+		require.NoError(t, err)
+		require.NotContains(t, m, "user.test")
+	})
+}
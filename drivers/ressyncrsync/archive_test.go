@@ -0,0 +1,133 @@
+package ressyncrsync
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "data")
+	dst := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "d1"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "f1"), []byte("foo"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "d1", "f2"), []byte("bar"), 0600))
+	require.NoError(t, os.Symlink("f1", filepath.Join(src, "l1")))
+	require.NoError(t, os.WriteFile(filepath.Join(dst, "extra"), []byte("keep"), 0644))
+
+	sync := func(prev index, prune, contentOnly bool) index {
+		tr, err := scanTree([]string{src}, contentOnly)
+		require.NoError(t, err)
+		var b bytes.Buffer
+		require.NoError(t, writeArchive(&b, tr, prev))
+		require.NoError(t, applyArchive(&b, dst, prune, contentOnly))
+		return tr.index
+	}
+
+	t.Run("full sync of the source directory", func(t *testing.T) {
+		idx := sync(nil, true, false)
+		require.Len(t, idx, 5)
+		b, err := os.ReadFile(filepath.Join(dst, "data", "d1", "f2"))
+		require.NoError(t, err)
+		require.Equal(t, "bar", string(b))
+		info, err := os.Stat(filepath.Join(dst, "data", "d1", "f2"))
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+		link, err := os.Readlink(filepath.Join(dst, "data", "l1"))
+		require.NoError(t, err)
+		require.Equal(t, "f1", link)
+		require.FileExists(t, filepath.Join(dst, "extra"), "top-level files not sent must be kept")
+
+		t.Run("incremental sync sends the changes and the deletions", func(t *testing.T) {
+			mtime := time.Now().Add(time.Minute)
+			require.NoError(t, os.WriteFile(filepath.Join(src, "f1"), []byte("foo2"), 0644))
+			require.NoError(t, os.Chtimes(filepath.Join(src, "f1"), mtime, mtime))
+			require.NoError(t, os.Remove(filepath.Join(src, "d1", "f2")))
+
+			tr, err := scanTree([]string{src}, false)
+			require.NoError(t, err)
+			var b bytes.Buffer
+			require.NoError(t, writeArchive(&b, tr, idx))
+			require.NoError(t, applyArchive(&b, dst, false, false))
+
+			data, err := os.ReadFile(filepath.Join(dst, "data", "f1"))
+			require.NoError(t, err)
+			require.Equal(t, "foo2", string(data))
+			require.NoFileExists(t, filepath.Join(dst, "data", "d1", "f2"))
+			info, err := os.Stat(filepath.Join(dst, "data", "f1"))
+			require.NoError(t, err)
+			require.True(t, info.ModTime().Equal(mtime))
+		})
+	})
+
+	t.Run("full sync of the source directory content prunes dst", func(t *testing.T) {
+		sync(nil, true, true)
+		require.FileExists(t, filepath.Join(dst, "f1"))
+		require.NoFileExists(t, filepath.Join(dst, "extra"))
+		require.NoDirExists(t, filepath.Join(dst, "data"))
+	})
+
+	t.Run("refuse entries outside dst", func(t *testing.T) {
+		tr := &tree{
+			names: []string{"../evil"},
+			paths: map[string]string{"../evil": filepath.Join(src, "f1")},
+			index: index{"../evil": {Mode: 0644, Size: 4}},
+		}
+		var b bytes.Buffer
+		require.NoError(t, writeArchive(&b, tr, nil))
+		require.ErrorContains(t, applyArchive(&b, dst, false, false), "not local")
+	})
+}
+
+func TestArchiveDirReplacedBySymlink(t *testing.T) {
+	src := t.TempDir()
+	dst := t.TempDir()
+	victim := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(victim, "keep"), []byte("victim"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "x"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "x", "keep"), []byte("foo"), 0644))
+
+	tr, err := scanTree([]string{src + "/"}, true)
+	require.NoError(t, err)
+	var b bytes.Buffer
+	require.NoError(t, writeArchive(&b, tr, nil))
+	require.NoError(t, applyArchive(&b, dst, true, true))
+	require.FileExists(t, filepath.Join(dst, "x", "keep"))
+
+	t.Run("the deletions of the directory content do not follow the symlink", func(t *testing.T) {
+		require.NoError(t, os.RemoveAll(filepath.Join(src, "x")))
+		require.NoError(t, os.Symlink(victim, filepath.Join(src, "x")))
+		next, err := scanTree([]string{src + "/"}, true)
+		require.NoError(t, err)
+		var b bytes.Buffer
+		require.NoError(t, writeArchive(&b, next, tr.index))
+		require.NoError(t, applyArchive(&b, dst, false, true))
+		link, err := os.Readlink(filepath.Join(dst, "x"))
+		require.NoError(t, err)
+		require.Equal(t, victim, link)
+		require.FileExists(t, filepath.Join(victim, "keep"))
+	})
+
+	t.Run("deletions under a symlink are skipped", func(t *testing.T) {
+		var b bytes.Buffer
+		require.NoError(t, writeArchive(&b, &tree{}, index{"x/keep": {Mode: 0644}}))
+		require.NoError(t, applyArchive(&b, dst, false, true))
+		require.FileExists(t, filepath.Join(victim, "keep"))
+	})
+
+	t.Run("entries under a symlink are refused", func(t *testing.T) {
+		tr := &tree{
+			names: []string{"x/new"},
+			paths: map[string]string{"x/new": filepath.Join(victim, "keep")},
+			index: index{"x/new": {Mode: 0644, Size: 6}},
+		}
+		var b bytes.Buffer
+		require.NoError(t, writeArchive(&b, tr, nil))
+		require.Error(t, applyArchive(&b, dst, false, true))
+		require.NoFileExists(t, filepath.Join(victim, "new"))
+	})
+}
@@ -0,0 +1,346 @@
+package ressyncrsync
+
+import (
+	"archive/tar"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+type (
+	// destination applies the archive entries to the files of a
+	// destination directory. The entry paths are resolved with openat
+	// from the destination directory without following symlinks, so an
+	// entry can't create, change or remove a file outside the destination
+	// directory through a symlink in one of its parent directories.
+	destination struct {
+		fd     int
+		path   string
+		isRoot bool
+	}
+)
+
+const (
+	openDirFlags = unix.O_RDONLY | unix.O_DIRECTORY | unix.O_NOFOLLOW | unix.O_CLOEXEC
+)
+
+func openDestination(path string) (*destination, error) {
+	fd, err := unix.Open(path, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: path, Err: err}
+	}
+	return &destination{
+		fd:     fd,
+		path:   path,
+		isRoot: os.Geteuid() == 0,
+	}, nil
+}
+
+func (t *destination) Close() error {
+	return unix.Close(t.fd)
+}
+
+// openParent returns the fd of the parent directory of the name relative
+// path, and the name base. The parent directories are walked without
+// following symlinks, and the missing ones are created if create is set.
+// A parent that is not a directory fails with ENOTDIR, or ELOOP for a
+// symlink.
+func (t *destination) openParent(name string, create bool) (int, string, error) {
+	dir, base := filepath.Split(name)
+	fd, err := unix.Openat(t.fd, ".", openDirFlags, 0)
+	if err != nil {
+		return -1, "", &os.PathError{Op: "openat", Path: t.path, Err: err}
+	}
+	walked := t.path
+	for _, elem := range strings.Split(dir, string(filepath.Separator)) {
+		if elem == "" {
+			continue
+		}
+		walked = filepath.Join(walked, elem)
+		next, err := unix.Openat(fd, elem, openDirFlags, 0)
+		if errors.Is(err, unix.ENOENT) && create {
+			if err := unix.Mkdirat(fd, elem, 0755); err != nil && !errors.Is(err, unix.EEXIST) {
+				_ = unix.Close(fd)
+				return -1, "", &os.PathError{Op: "mkdirat", Path: walked, Err: err}
+			}
+			next, err = unix.Openat(fd, elem, openDirFlags, 0)
+		}
+		_ = unix.Close(fd)
+		if err != nil {
+			return -1, "", &os.PathError{Op: "openat", Path: walked, Err: err}
+		}
+		fd = next
+	}
+	return fd, base, nil
+}
+
+// remove removes the name file, or directory with its content. The
+// removal is skipped if a parent of name is no longer a directory, for
+// example a directory replaced by a symlink on the source, so the
+// deletion of its old content does not apply to the symlink target.
+func (t *destination) remove(name string) error {
+	fd, base, err := t.openParent(name, false)
+	if errors.Is(err, unix.ENOENT) || errors.Is(err, unix.ENOTDIR) || errors.Is(err, unix.ELOOP) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+	return t.removeAllAt(fd, base, name)
+}
+
+// mkdir creates the name directory, replacing the non-directory file of
+// the same name, and sets its owner, mode and xattrs.
+func (t *destination) mkdir(name string, hdr *tar.Header) error {
+	pfd, base, err := t.openParent(name, true)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(pfd)
+	var st unix.Stat_t
+	err = unix.Fstatat(pfd, base, &st, unix.AT_SYMLINK_NOFOLLOW)
+	if err == nil && st.Mode&unix.S_IFMT != unix.S_IFDIR {
+		if err := t.removeAllAt(pfd, base, name); err != nil {
+			return err
+		}
+		err = unix.ENOENT
+	}
+	if errors.Is(err, unix.ENOENT) {
+		if err := unix.Mkdirat(pfd, base, 0700); err != nil {
+			return t.pathError("mkdirat", name, err)
+		}
+	} else if err != nil {
+		return t.pathError("fstatat", name, err)
+	}
+	fd, err := unix.Openat(pfd, base, openDirFlags, 0)
+	if err != nil {
+		return t.pathError("openat", name, err)
+	}
+	defer unix.Close(fd)
+	return t.setAttrs(fd, name, hdr)
+}
+
+// writeFile writes the regular file data to a temporary file, with the
+// owner, mode, xattrs and modification time of the archive entry, and
+// renames it to name when complete.
+func (t *destination) writeFile(name string, hdr *tar.Header, r io.Reader) error {
+	pfd, base, err := t.openParent(name, true)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(pfd)
+	fd, tmp, err := t.createTempAt(pfd, base, name)
+	if err != nil {
+		return err
+	}
+	defer unix.Unlinkat(pfd, tmp, 0)
+	file := os.NewFile(uintptr(fd), filepath.Join(t.path, filepath.Dir(name), tmp))
+	defer file.Close()
+	if _, err := io.Copy(file, r); err != nil {
+		return err
+	}
+	if err := t.setAttrs(fd, name, hdr); err != nil {
+		return err
+	}
+	if err := unix.UtimesNanoAt(pfd, tmp, timespecs(hdr.ModTime), unix.AT_SYMLINK_NOFOLLOW); err != nil {
+		return t.pathError("utimensat", name, err)
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	var st unix.Stat_t
+	if err := unix.Fstatat(pfd, base, &st, unix.AT_SYMLINK_NOFOLLOW); err == nil && st.Mode&unix.S_IFMT == unix.S_IFDIR {
+		if err := t.removeAllAt(pfd, base, name); err != nil {
+			return err
+		}
+	}
+	if err := unix.Renameat(pfd, tmp, pfd, base); err != nil {
+		return t.pathError("renameat", name, err)
+	}
+	return nil
+}
+
+// createTempAt creates a new temporary file in the pfd directory, and
+// returns its fd and name.
+func (t *destination) createTempAt(pfd int, base, name string) (int, string, error) {
+	for i := 0; ; i++ {
+		tmp := "." + base + "." + strconv.FormatUint(uint64(rand.Uint32()), 10)
+		fd, err := unix.Openat(pfd, tmp, unix.O_WRONLY|unix.O_CREAT|unix.O_EXCL|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0600)
+		if errors.Is(err, unix.EEXIST) && i < 10000 {
+			continue
+		} else if err != nil {
+			return -1, "", t.pathError("openat", filepath.Join(filepath.Dir(name), tmp), err)
+		}
+		return fd, tmp, nil
+	}
+}
+
+// link creates the name hardlink to the linkname file, replacing the
+// file of the same name.
+func (t *destination) link(linkname, name string, hdr *tar.Header) error {
+	opfd, obase, err := t.openParent(linkname, false)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(opfd)
+	pfd, base, err := t.openParent(name, true)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(pfd)
+	if err := t.removeAllAt(pfd, base, name); err != nil {
+		return err
+	}
+	if err := unix.Linkat(opfd, obase, pfd, base, 0); err != nil {
+		return t.pathError("linkat", name, err)
+	}
+	return t.lchownAt(pfd, base, name, hdr)
+}
+
+// symlink creates the name symlink, replacing the file of the same name.
+func (t *destination) symlink(name string, hdr *tar.Header) error {
+	pfd, base, err := t.openParent(name, true)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(pfd)
+	if err := t.removeAllAt(pfd, base, name); err != nil {
+		return err
+	}
+	if err := unix.Symlinkat(hdr.Linkname, pfd, base); err != nil {
+		return t.pathError("symlinkat", name, err)
+	}
+	return t.lchownAt(pfd, base, name, hdr)
+}
+
+// chtimes sets the access and modification times of the name file,
+// without following symlinks.
+func (t *destination) chtimes(name string, mtime time.Time) error {
+	pfd, base, err := t.openParent(name, false)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(pfd)
+	if err := unix.UtimesNanoAt(pfd, base, timespecs(mtime), unix.AT_SYMLINK_NOFOLLOW); err != nil {
+		return t.pathError("utimensat", name, err)
+	}
+	return nil
+}
+
+// prune removes the files of the destination directory not in keep. The
+// top-level files are not removed unless contentOnly is set.
+func (t *destination) prune(keep map[string]bool, contentOnly bool) error {
+	return t.pruneAt(t.fd, ".", "", keep, contentOnly)
+}
+
+func (t *destination) pruneAt(pfd int, base, rel string, keep map[string]bool, contentOnly bool) error {
+	fd, err := unix.Openat(pfd, base, openDirFlags, 0)
+	if err != nil {
+		return t.pathError("openat", rel, err)
+	}
+	dir := os.NewFile(uintptr(fd), filepath.Join(t.path, rel))
+	defer dir.Close()
+	entries, err := dir.ReadDir(-1)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := filepath.Join(rel, entry.Name())
+		switch {
+		case keep[name]:
+			if !entry.IsDir() {
+				continue
+			}
+			if err := t.pruneAt(fd, entry.Name(), name, keep, contentOnly); err != nil {
+				return err
+			}
+		case !contentOnly && rel == "":
+			// not sent by the sync
+		default:
+			if err := t.removeAllAt(fd, entry.Name(), name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removeAllAt removes the base file of the pfd directory, and its content
+// if it is a directory, without following symlinks.
+func (t *destination) removeAllAt(pfd int, base, name string) error {
+	var st unix.Stat_t
+	if err := unix.Fstatat(pfd, base, &st, unix.AT_SYMLINK_NOFOLLOW); errors.Is(err, unix.ENOENT) {
+		return nil
+	} else if err != nil {
+		return t.pathError("fstatat", name, err)
+	}
+	if st.Mode&unix.S_IFMT != unix.S_IFDIR {
+		if err := unix.Unlinkat(pfd, base, 0); err != nil && !errors.Is(err, unix.ENOENT) {
+			return t.pathError("unlinkat", name, err)
+		}
+		return nil
+	}
+	fd, err := unix.Openat(pfd, base, openDirFlags, 0)
+	if err != nil {
+		return t.pathError("openat", name, err)
+	}
+	dir := os.NewFile(uintptr(fd), filepath.Join(t.path, name))
+	defer dir.Close()
+	entries, err := dir.ReadDir(-1)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := t.removeAllAt(fd, entry.Name(), filepath.Join(name, entry.Name())); err != nil {
+			return err
+		}
+	}
+	if err := unix.Unlinkat(pfd, base, unix.AT_REMOVEDIR); err != nil && !errors.Is(err, unix.ENOENT) {
+		return t.pathError("unlinkat", name, err)
+	}
+	return nil
+}
+
+// setAttrs sets the owner, mode and xattrs of the archive entry to the
+// fd file. The owner is set first, as a chown clears the setuid and
+// setgid bits.
+func (t *destination) setAttrs(fd int, name string, hdr *tar.Header) error {
+	if t.isRoot {
+		if err := unix.Fchown(fd, hdr.Uid, hdr.Gid); err != nil {
+			return t.pathError("fchown", name, err)
+		}
+	}
+	if err := unix.Fchmod(fd, uint32(hdr.FileInfo().Mode().Perm())); err != nil {
+		return t.pathError("fchmod", name, err)
+	}
+	if err := writeXattrs(fd, archiveXattrs(hdr), xattrFilter); err != nil {
+		return t.pathError("setxattr", name, err)
+	}
+	return nil
+}
+
+func (t *destination) lchownAt(pfd int, base, name string, hdr *tar.Header) error {
+	if !t.isRoot {
+		return nil
+	}
+	if err := unix.Fchownat(pfd, base, hdr.Uid, hdr.Gid, unix.AT_SYMLINK_NOFOLLOW); err != nil {
+		return t.pathError("fchownat", name, err)
+	}
+	return nil
+}
+
+func (t *destination) pathError(op, name string, err error) error {
+	return &os.PathError{Op: op, Path: filepath.Join(t.path, name), Err: err}
+}
+
+func timespecs(tm time.Time) []unix.Timespec {
+	ts := unix.NsecToTimespec(tm.UnixNano())
+	return []unix.Timespec{ts, ts}
+}
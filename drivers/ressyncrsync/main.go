@@ -48,6 +48,7 @@ type (
 		ObjectID       uuid.UUID
 		Timeout        *time.Duration
 		Topology       topology.T
+		Transport      string
 	}
 
 	modeT uint
@@ -65,6 +66,15 @@ func New() resource.Driver {
 	return &T{}
 }
 
+// Configure rejects the rsync options with the api transport, which does
+// not run rsync.
+func (t *T) Configure() error {
+	if t.Transport == ressync.TransportAPI && (len(t.Options) > 0 || t.ResetOptions) {
+		return fmt.Errorf("the %s transport does not support the options and reset_options keywords", ressync.TransportAPI)
+	}
+	return nil
+}
+
 func (t *T) Running() (resource.RunningInfoList, error) {
 	return t.RunningFromLock(lockName)
 }
@@ -123,7 +133,11 @@ func (t *T) lockedSync(ctx context.Context, mode modeT, target []string) (err er
 			}
 			continue
 		}
-		if err := t.peerSync(ctx, mode, nodename); err != nil {
+		if t.Transport == ressync.TransportAPI {
+			if err := t.apiSync(ctx, mode, nodename); err != nil {
+				return err
+			}
+		} else if err := t.peerSync(ctx, mode, nodename); err != nil {
 			return err
 		}
 		if t.WritePeerLastSync(ctx, nodename, nodenames); err != nil {
@@ -354,6 +368,7 @@ func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
 		{Key: "target", Value: strings.Join(target, " ")},
 		{Key: "options", Value: strings.Join(t.Options, " ")},
 		{Key: "reset_options", Value: fmt.Sprintf("%v", t.ResetOptions)},
+		{Key: "transport", Value: t.Transport},
 	}
 	if t.Timeout != nil {
 		m = append(m, resource.InfoKey{Key: "timeout", Value: fmt.Sprintf("%s", t.Timeout)})
@@ -81,6 +81,7 @@ var (
 			Option: "bwlimit",
 			Text:   keywords.NewText(fs, "text/kw/bwlimit"),
 		},
+		&ressync.KWTransport,
 	}
 )
 
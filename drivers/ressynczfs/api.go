@@ -0,0 +1,185 @@
+package ressynczfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/drivers/ressync"
+	"github.com/opensvc/om3/v3/util/zfs"
+)
+
+const (
+	// streamResume is the name of the sync data stream resuming the zfs
+	// receive interrupted on the peer.
+	streamResume = "resume"
+)
+
+func (t modeT) String() string {
+	switch t {
+	case modeFull:
+		return "full"
+	case modeIncr:
+		return "incr"
+	default:
+		return "unknown"
+	}
+}
+
+// apiSync streams the zfs send output to the peer daemon api, which pipes
+// it into the zfs receive of the peer instance sync ingest action. A zfs
+// receive interrupted on the peer is resumed from its resume token.
+func (t *T) apiSync(ctx context.Context, mode modeT, nodename string) error {
+	if mode == modeIncr {
+		if tm, err := t.LastSync(nodename); err != nil {
+			return err
+		} else if tm.IsZero() {
+			t.Log().Infof("%s was never synced: send full", nodename)
+			mode = modeFull
+		}
+	}
+	if token, err := t.PeerResumeToken(ctx, nodename); err != nil {
+		return err
+	} else if token != "" {
+		if err := t.checkResumeToken(ctx, token); err != nil {
+			t.Log().Infof("%s: can't resume the interrupted receive, send a new stream: %s", nodename, err)
+		} else {
+			t.Log().Infof("%s: resume the interrupted receive", nodename)
+			return t.SendData(ctx, nodename, streamResume, t.bps, t.sendFunc(ctx, t.sendResumeCmd(token)))
+		}
+	}
+	var args []string
+	if mode == modeFull {
+		args = t.sendInitialCmd()
+	} else {
+		args = t.sendIncrementalCmd()
+	}
+	return t.SendData(ctx, nodename, mode.String(), t.bps, t.sendFunc(ctx, args))
+}
+
+// sendFunc returns a function writing the output of the zfs send command
+// to a writer.
+func (t *T) sendFunc(ctx context.Context, args []string) func(io.Writer) error {
+	return func(w io.Writer) error {
+		cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+		cmd.Stdout = w
+		cmd.Stderr = t.Log().Writer(zerolog.ErrorLevel)
+		t.Log().Infof("%s", cmd)
+		return cmd.Run()
+	}
+}
+
+func (t *T) sendResumeCmd(token string) []string {
+	return []string{"/usr/sbin/zfs", "send", "-t", token}
+}
+
+// checkResumeToken returns an error if the stream of the resume token
+// can't be sent, for example because its snapshot was destroyed.
+func (t *T) checkResumeToken(ctx context.Context, token string) error {
+	cmd := exec.CommandContext(ctx, "/usr/sbin/zfs", "send", "-n", "-t", token)
+	if b, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(b)))
+	}
+	return nil
+}
+
+// resumableReceiveCmd returns the zfs receive command of the api
+// transport, saving the state of an interrupted receive so it can be
+// resumed. The replication streams of recursive syncs are not resumable.
+func (t *T) resumableReceiveCmd(discardFirst bool, dst string) []string {
+	args := t.receiveCmd(nil, discardFirst, dst)
+	if t.Recursive {
+		return args
+	}
+	return append([]string{args[0], args[1], "-s"}, args[2:]...)
+}
+
+// ResumeToken returns the token to resume the zfs receive interrupted on
+// the dst dataset, or an empty string if there is none.
+func (t *T) ResumeToken(ctx context.Context) (string, error) {
+	if t.Recursive {
+		return "", nil
+	}
+	if v, err := t.zfs(t.Dst).Exists(); err != nil {
+		return "", err
+	} else if !v {
+		return "", nil
+	}
+	token, err := t.zfs(t.Dst).GetProperty("receive_resume_token")
+	if err != nil {
+		return "", err
+	}
+	if token == "-" {
+		return "", nil
+	}
+	return token, nil
+}
+
+// abortResumableReceive discards the state of the zfs receive
+// interrupted on the dst dataset, so a new stream can be received.
+func (t *T) abortResumableReceive(ctx context.Context) error {
+	if token, err := t.ResumeToken(ctx); err != nil {
+		return err
+	} else if token == "" {
+		return nil
+	}
+	cmd := exec.CommandContext(ctx, "/usr/sbin/zfs", "receive", "-A", t.Dst)
+	t.Log().Infof("%s", cmd)
+	if b, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s: %w: %s", cmd, err, strings.TrimSpace(string(b)))
+	}
+	return nil
+}
+
+// Ingest receives the zfs send stream piped by the daemon api from the
+// sender with the api transport, and rotates the destination snapshots.
+func (t *T) Ingest(ctx context.Context) error {
+	name, r, err := t.SyncData(ctx)
+	if errors.Is(err, ressync.ErrNoSyncData) {
+		t.Log().Infof("%s", err)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	discardFirst, dst := t.receiveDst()
+	switch name {
+	case streamResume:
+		// the partially received state is kept
+	case modeFull.String():
+		if err := t.abortResumableReceive(ctx); err != nil {
+			return err
+		}
+		if err := t.zfs(t.Dst + "@%").Destroy(zfs.FilesystemDestroyWithRecurse(t.Recursive)); err != nil {
+			return err
+		}
+		if !discardFirst {
+			if err := t.zfs(dst).Create(); err != nil {
+				return err
+			}
+		}
+	case modeIncr.String():
+		if err := t.abortResumableReceive(ctx); err != nil {
+			return err
+		}
+		if err := t.zfs(t.dstSnapTosend).Destroy(zfs.FilesystemDestroyWithRecurse(t.Recursive)); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unexpected sync data stream %s", name)
+	}
+	rargs := t.resumableReceiveCmd(discardFirst, dst)
+	rcmd := exec.CommandContext(ctx, rargs[0], rargs[1:]...)
+	rcmd.Stdin = r
+	rcmd.Stdout = t.Log().Writer(zerolog.InfoLevel)
+	rcmd.Stderr = t.Log().Writer(zerolog.ErrorLevel)
+	t.Log().Infof("%s", rcmd)
+	if err := rcmd.Run(); err != nil {
+		return err
+	}
+	return t.rotateSnaps(t.dstSnapTosend, t.dstSnapSent)
+}
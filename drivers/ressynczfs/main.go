@@ -31,23 +31,28 @@ type (
 	T struct {
 		ressync.T
 		resource.SSH
-		Src          string
-		Dst          string
-		Target       []string
-		Schedule     string
-		Intermediary bool
-		Recursive    bool
-		Nodes        []string
-		DRPNodes     []string
-		ObjectID     uuid.UUID
-		Timeout      *time.Duration
-		Topology     topology.T
-		User         string
+		BandwidthLimit string
+		Src            string
+		Dst            string
+		Target         []string
+		Schedule       string
+		Intermediary   bool
+		Recursive      bool
+		Nodes          []string
+		DRPNodes       []string
+		ObjectID       uuid.UUID
+		Timeout        *time.Duration
+		Topology       topology.T
+		Transport      string
+		User           string
 
 		srcSnapSent   string
 		srcSnapTosend string
 		dstSnapSent   string
 		dstSnapTosend string
+
+		// bps is the bandwidth limit in bytes per second, 0 for no limit.
+		bps int64
 	}
 
 	modeT uint
@@ -149,11 +154,17 @@ func (t *T) lockedSync(ctx context.Context, mode modeT, target []string) (err er
 			}
 			continue
 		}
-		if err := t.peerSync(ctx, mode, nodename); err != nil {
-			return err
-		}
-		if err := t.rotatePeerSnaps(nodename, t.dstSnapTosend, t.dstSnapSent); err != nil {
-			return err
+		if t.Transport == ressync.TransportAPI {
+			if err := t.apiSync(ctx, mode, nodename); err != nil {
+				return err
+			}
+		} else {
+			if err := t.peerSync(ctx, mode, nodename); err != nil {
+				return err
+			}
+			if err := t.rotatePeerSnaps(nodename, t.dstSnapTosend, t.dstSnapSent); err != nil {
+				return err
+			}
 		}
 		if t.WritePeerLastSync(ctx, nodename, nodenames); err != nil {
 			return err
@@ -285,7 +296,7 @@ func (t *T) sendIncrementalTo(ctx context.Context, nodename string) error {
 		return err
 	}
 	stats := ressync.NewStats(nodename)
-	if _, err := t.CopyWithStats(ctx, stdinPipe, stdoutPipe, stats); err != nil {
+	if _, err := t.CopyWithStats(ctx, stdinPipe, ressync.NewLimitedReader(ctx, stdoutPipe, t.bps), stats); err != nil {
 		return err
 	}
 
@@ -442,7 +453,7 @@ func (t *T) sendInitialTo(ctx context.Context, nodename string) error {
 	}
 
 	stats := ressync.NewStats(nodename)
-	if _, err := t.CopyWithStats(ctx, stdinPipe, stdoutPipe, stats); err != nil {
+	if _, err := t.CopyWithStats(ctx, stdinPipe, ressync.NewLimitedReader(ctx, stdoutPipe, t.bps), stats); err != nil {
 		return err
 	}
 
@@ -578,6 +589,11 @@ func (t *T) Configure() error {
 	t.srcSnapTosend = t.Src + "@" + rid + ".tosend"
 	t.dstSnapSent = t.Dst + "@" + rid + ".sent"
 	t.dstSnapTosend = t.Dst + "@" + rid + ".tosend"
+	if bps, err := ressync.ParseBandwidthLimit(t.BandwidthLimit); err != nil {
+		return err
+	} else {
+		t.bps = bps
+	}
 	return nil
 }
 
@@ -700,6 +716,10 @@ func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
 		{Key: "dst", Value: t.Dst},
 		{Key: "recursive", Value: fmt.Sprintf("%v", t.Recursive)},
 		{Key: "target", Value: strings.Join(target, " ")},
+		{Key: "transport", Value: t.Transport},
+	}
+	if t.BandwidthLimit != "" {
+		m = append(m, resource.InfoKey{Key: "bwlimit", Value: t.BandwidthLimit})
 	}
 	if t.Timeout != nil {
 		m = append(m, resource.InfoKey{Key: "timeout", Value: fmt.Sprintf("%s", t.Timeout)})
@@ -65,6 +65,14 @@ var (
 			Scopable:  true,
 			Text:      keywords.NewText(fs, "text/kw/target"),
 		},
+		{
+			Attr:     "BandwidthLimit",
+			Example:  "10m",
+			Option:   "bwlimit",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/bwlimit"),
+		},
+		&ressync.KWTransport,
 	}
 )
 
@@ -63,6 +63,10 @@ type (
 		Volatile bool   `json:"volatile"`
 		VolNodes []string
 
+		// SourceSnapshot is the <volname>@<snapshot> the volume disk is
+		// cloned from on provision.
+		SourceSnapshot string `json:"source_snapshot"`
+
 		// Context
 		Path          naming.Path
 		Topology      topology.T
@@ -388,9 +392,67 @@ func (t *T) lockedCreateVolume(ctx context.Context, volume object.Vol) (object.V
 	if err != nil {
 		return nil, err
 	}
+	if t.SourceSnapshot != "" {
+		if err := t.cloneVolume(ctx, volume); err != nil {
+			return nil, err
+		}
+	}
 	return volume, nil
 }
 
+// sourceVolume returns the vol object holding the source snapshot, and
+// the snapshot name.
+func (t *T) sourceVolume() (object.Vol, string, error) {
+	volName, snapshot, err := pool.ParseSourceSnapshot(t.SourceSnapshot)
+	if err != nil {
+		return nil, "", err
+	}
+	p, err := naming.NewPath(t.Path.Namespace, naming.KindVol, volName)
+	if err != nil {
+		return nil, "", err
+	}
+	if !p.Exists() {
+		return nil, "", fmt.Errorf("source volume %s does not exist", p)
+	}
+	volume, err := object.NewVol(p, object.WithLogger(t.volumeLogger()), object.WithVolatile(true))
+	if err != nil {
+		return nil, "", err
+	}
+	return volume, snapshot, nil
+}
+
+// cloneVolume creates the volume disk from the source snapshot, using the
+// source volume pool.
+func (t *T) cloneVolume(ctx context.Context, volume object.Vol) error {
+	srcVolume, snapshot, err := t.sourceVolume()
+	if err != nil {
+		return err
+	}
+	p, err := srcVolume.Pool()
+	if err != nil {
+		return err
+	}
+	cloner, err := pool.AsCloner(p)
+	if err != nil {
+		return err
+	}
+	nodes := t.VolNodes
+	if len(nodes) == 0 {
+		nodes = t.Nodes
+	}
+	srcName := pool.DiskName(p, srcVolume)
+	name := pool.DiskName(p, volume)
+	t.Log().Infof("clone %s from snapshot %s of %s", name, snapshot, srcName)
+	kws, err := cloner.CloneSnapshot(ctx, srcName, snapshot, name, t.Shared, nodes)
+	if err != nil {
+		return err
+	}
+	if len(kws) == 0 {
+		return nil
+	}
+	return volume.Config().Set(keyop.ParseOps(kws)...)
+}
+
 // poolLookup exposes some methods like ConfigureVolume, which
 // are relayed to the pool best matching the lookup criteria.
 // The withUsage critierium can be toggled on/off because it
@@ -404,6 +466,18 @@ func (t *T) poolLookup(withUsage bool) (*pool.Lookup, error) {
 	}
 	l := pool.NewLookup(node)
 	l.Name = t.Pool
+	if t.SourceSnapshot != "" {
+		// the clone must be allocated from the source volume pool
+		srcVolume, _, err := t.sourceVolume()
+		if err != nil {
+			return nil, err
+		}
+		p, err := srcVolume.Pool()
+		if err != nil {
+			return nil, err
+		}
+		l.Name = p.Name()
+	}
 	l.Type = t.PoolType
 	if t.Size == nil {
 		// unprovisionned volume should be able to access vol.Head()
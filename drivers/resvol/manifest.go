@@ -82,6 +82,14 @@ var (
 			Scopable:     true,
 			Text:         keywords.NewText(fs, "text/kw/format"),
 		},
+		{
+			Attr:         "SourceSnapshot",
+			Example:      "db-vol-1@before-upgrade",
+			Option:       "source_snapshot",
+			Provisioning: true,
+			Scopable:     true,
+			Text:         keywords.NewText(fs, "text/kw/source_snapshot"),
+		},
 	}
 )
 
@@ -0,0 +1,83 @@
+// Package stonithcommand implements the stonith driver running a fencing
+// command, like the Fence Agents callouts.
+package stonithcommand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/core/stonith"
+	"github.com/opensvc/om3/v3/util/command"
+)
+
+type (
+	T struct {
+		stonith.T
+		argv       []string
+		verifyArgv []string
+	}
+)
+
+func init() {
+	stonith.Register("command", New)
+}
+
+func New() stonith.Confer {
+	return &T{}
+}
+
+func (t *T) Configure() error {
+	t.argv = t.GetStrings("command")
+	if len(t.argv) == 0 {
+		return fmt.Errorf("fencing command for node %s is not defined", t.Node())
+	}
+	t.verifyArgv = t.GetStrings("verify_command")
+	return nil
+}
+
+// Fence runs the fencing command.
+func (t *T) Fence(ctx context.Context) error {
+	return t.newCommand(ctx, t.argv).Run()
+}
+
+// CanVerify returns true if the verify command is set. Without verify
+// command, the fencing command zero exit code is trusted, as before the
+// fencing verification was introduced.
+func (t *T) CanVerify() bool {
+	return len(t.verifyArgv) > 0
+}
+
+// PowerState runs the verify command. A zero exit code means the node is
+// off, another exit code means the node is still on. Without verify
+// command, the power state is unknown.
+func (t *T) PowerState(ctx context.Context) (stonith.PowerState, error) {
+	if len(t.verifyArgv) == 0 {
+		return stonith.PowerStateUnknown, nil
+	}
+	cmd := t.newCommand(ctx, t.verifyArgv)
+	err := cmd.Run()
+	var errExitCode *command.ErrExitCode
+	switch {
+	case err == nil:
+		return stonith.PowerStateOff, nil
+	case errors.As(err, &errExitCode):
+		return stonith.PowerStateOn, nil
+	default:
+		return stonith.PowerStateUnknown, err
+	}
+}
+
+func (t *T) newCommand(ctx context.Context, argv []string) *command.T {
+	return command.New(
+		command.WithContext(ctx),
+		command.WithName(argv[0]),
+		command.WithArgs(argv[1:]),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+}
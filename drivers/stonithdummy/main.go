@@ -0,0 +1,38 @@
+// Package stonithdummy implements a stonith driver not fencing anything,
+// for tests and demonstrations.
+package stonithdummy
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/core/stonith"
+)
+
+type (
+	T struct {
+		stonith.T
+	}
+)
+
+func init() {
+	stonith.Register("dummy", New)
+}
+
+func New() stonith.Confer {
+	return &T{}
+}
+
+func (t *T) Configure() error {
+	return nil
+}
+
+// Fence logs the fencing request and succeeds.
+func (t *T) Fence(_ context.Context) error {
+	t.Log().Infof("dummy fence node %s", t.Node())
+	return nil
+}
+
+// PowerState always reports the node is off.
+func (t *T) PowerState(_ context.Context) (stonith.PowerState, error) {
+	return stonith.PowerStateOff, nil
+}
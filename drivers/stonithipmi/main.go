@@ -0,0 +1,125 @@
+// Package stonithipmi implements the stonith driver powering the node
+// down through its BMC, using IPMI v2.0 RMCP+ (lanplus) or IPMI v1.5 (lan)
+// over LAN.
+package stonithipmi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+
+	"github.com/opensvc/om3/v3/core/datarecv"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/stonith"
+	"github.com/opensvc/om3/v3/util/ipmi"
+)
+
+type (
+	T struct {
+		stonith.T
+		addr     string
+		username string
+		password string
+
+		intf        string
+		cipherSuite uint8
+	}
+)
+
+func init() {
+	stonith.Register("ipmi", New)
+}
+
+func New() stonith.Confer {
+	return &T{}
+}
+
+func (t *T) Configure() error {
+	addr := t.GetString("addr")
+	if addr == "" {
+		return fmt.Errorf("bmc address of node %s is not defined", t.Node())
+	}
+	t.addr = net.JoinHostPort(addr, strconv.Itoa(t.GetInt("port")))
+	t.username = t.GetString("username")
+	t.password = t.GetString("password")
+	t.intf = t.GetString("interface")
+	switch t.intf {
+	case ipmi.InterfaceLan:
+	case ipmi.InterfaceLanPlus:
+		suite := t.GetInt("cipher_suite")
+		if !slices.Contains(ipmi.CipherSuites(), suite) {
+			return fmt.Errorf("unsupported ipmi cipher suite %d", suite)
+		}
+		t.cipherSuite = uint8(suite)
+	default:
+		return fmt.Errorf("unsupported ipmi interface %s", t.intf)
+	}
+	return nil
+}
+
+// Fence requests a chassis power down to the node BMC.
+func (t *T) Fence(ctx context.Context) error {
+	client, err := t.open(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+	t.Log().Infof("ipmi %s %s: %s", t.intf, t.addr, ipmi.ChassisPowerDown)
+	return client.ChassisControl(ctx, ipmi.ChassisPowerDown)
+}
+
+// PowerState returns the chassis power state reported by the node BMC.
+func (t *T) PowerState(ctx context.Context) (stonith.PowerState, error) {
+	client, err := t.open(ctx)
+	if err != nil {
+		return stonith.PowerStateUnknown, err
+	}
+	defer func() { _ = client.Close() }()
+	on, err := client.IsPowerOn(ctx)
+	switch {
+	case err != nil:
+		return stonith.PowerStateUnknown, err
+	case on:
+		return stonith.PowerStateOn, nil
+	default:
+		return stonith.PowerStateOff, nil
+	}
+}
+
+func (t *T) open(ctx context.Context) (*ipmi.Client, error) {
+	password, err := t.decodePassword()
+	if err != nil {
+		return nil, err
+	}
+	client := &ipmi.Client{
+		Addr:        t.addr,
+		Username:    t.username,
+		Password:    password,
+		Interface:   t.intf,
+		CipherSuite: t.cipherSuite,
+		Retries:     2,
+	}
+	if err := client.Open(ctx); err != nil {
+		return nil, fmt.Errorf("ipmi %s: %w", t.addr, err)
+	}
+	return client, nil
+}
+
+// decodePassword returns the BMC password stored in the secret referenced
+// by the password keyword, formatted as "from <path> key <key>".
+func (t *T) decodePassword() (string, error) {
+	if t.password == "" {
+		return "", nil
+	}
+	km, err := datarecv.ParseKeyMetaRelWithFallback(t.password, naming.NsSys, "password")
+	if err != nil {
+		return "", err
+	}
+	b, err := km.RootDecode()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,111 @@
+// Package stonithssh implements the stonith driver running a poweroff
+// command on the node through ssh.
+//
+// This driver can only fence a node still responsive enough to accept a
+// ssh session, so it is best used as a first fencer escalating to an
+// out-of-band fencer.
+//
+// An unreachable ssh port does not prove the node is powered off, so the
+// driver can't confirm the fencing: its verification fails unless the
+// fencer verify keyword is disabled.
+package stonithssh
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/opensvc/om3/v3/core/stonith"
+	"github.com/opensvc/om3/v3/util/sshnode"
+)
+
+type (
+	T struct {
+		stonith.T
+		addr    string
+		user    string
+		command string
+	}
+)
+
+const (
+	sshPort = "22"
+)
+
+func init() {
+	stonith.Register("ssh", New)
+}
+
+func New() stonith.Confer {
+	return &T{}
+}
+
+func (t *T) Configure() error {
+	t.addr = t.GetString("addr")
+	if t.addr == "" {
+		t.addr = t.Node()
+	}
+	t.user = t.GetString("user")
+	t.command = t.GetString("command")
+	return nil
+}
+
+// Fence runs the poweroff command on the node. The node closing the
+// connection before the command exits is expected.
+func (t *T) Fence(ctx context.Context) error {
+	timeout := 10 * time.Second
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	client, err := sshnode.NewClient(t.addr, sshnode.WithUser(t.user), sshnode.WithTimeout(timeout))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = session.Close() }()
+	t.Log().Infof("ssh %s@%s %s", t.user, t.addr, t.command)
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Run(t.command)
+	}()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-done:
+		var errExitMissing *ssh.ExitMissingError
+		switch {
+		case err == nil:
+			return nil
+		case errors.As(err, &errExitMissing), errors.Is(err, io.EOF):
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
+// PowerState returns on while the node ssh port accepts connections, and
+// unknown when it no longer does, as the node may be hung or isolated
+// rather than powered off.
+func (t *T) PowerState(ctx context.Context) (stonith.PowerState, error) {
+	dialCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(dialCtx, "tcp", net.JoinHostPort(t.addr, sshPort))
+	if err != nil {
+		if err := ctx.Err(); err != nil {
+			return stonith.PowerStateUnknown, err
+		}
+		return stonith.PowerStateUnknown, nil
+	}
+	_ = conn.Close()
+	return stonith.PowerStateOn, nil
+}
@@ -0,0 +1,157 @@
+package btrfs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/opensvc/om3/v3/util/findmnt"
+)
+
+type (
+	// Usage is the space usage of a btrfs filesystem, in bytes.
+	Usage struct {
+		Size int64
+		Used int64
+		Free int64
+	}
+)
+
+// Usage returns the space usage of the filesystem. When the filesystem
+// is mounted, the size and free space are divided by the data profile
+// ratio, so a raid1 or raid10 filesystem reports the space usable by
+// the data. When not mounted, the profile is not known and the size is
+// the sum of the devices sizes.
+func (t *Filesystem) Usage(ctx context.Context) (Usage, error) {
+	if mounts, err := findmnt.List(ctx, t.Device, ""); err == nil && len(mounts) > 0 {
+		b, err := output(ctx, t.Log, "filesystem", "usage", "--raw", mounts[0].Target)
+		if err != nil {
+			return Usage{}, err
+		}
+		return parseMountedUsage(b)
+	}
+	b, err := output(ctx, t.Log, "filesystem", "show", "--raw", t.Device)
+	if err != nil {
+		return Usage{}, err
+	}
+	return parseUsage(b)
+}
+
+// parseMountedUsage parses a 'btrfs filesystem usage --raw' output like:
+//
+//	Overall:
+//	    Device size:		       21474836480
+//	    Device allocated:		        2174746624
+//	    Used:			            393216
+//	    Free (estimated):		       10736893952	(min: 10736893952)
+//	    Data ratio:			              2.00
+//	    Metadata ratio:		              2.00
+//
+// The free space estimation already accounts for the data ratio.
+func parseMountedUsage(b []byte) (Usage, error) {
+	var (
+		size, free           int64
+		ratio                float64
+		foundSize, foundFree bool
+	)
+	for _, line := range strings.Split(string(b), "\n") {
+		k, v, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(v)
+		if len(fields) == 0 {
+			continue
+		}
+		var err error
+		switch strings.TrimSpace(k) {
+		case "Device size":
+			size, err = strconv.ParseInt(fields[0], 10, 64)
+			foundSize = true
+		case "Free (estimated)":
+			free, err = strconv.ParseInt(fields[0], 10, 64)
+			foundFree = true
+		case "Data ratio":
+			ratio, err = strconv.ParseFloat(fields[0], 64)
+		}
+		if err != nil {
+			return Usage{}, fmt.Errorf("unexpected value in line: %s", line)
+		}
+	}
+	if !foundSize || !foundFree || size == 0 {
+		return Usage{}, fmt.Errorf("unexpected 'btrfs filesystem usage --raw' output: %s", string(b))
+	}
+	if ratio < 1 {
+		ratio = 1
+	}
+	usage := Usage{
+		Size: int64(float64(size) / ratio),
+		Free: free,
+	}
+	usage.Used = usage.Size - usage.Free
+	if usage.Used < 0 {
+		usage.Used = 0
+	}
+	return usage, nil
+}
+
+// parseUsage parses a 'btrfs filesystem show --raw' output like:
+//
+//	Label: 'data'  uuid: 8c0f36a2-5d1e-4c4b-9d6e-0d8f6f4d6a51
+//		Total devices 1 FS bytes used 196608
+//		devid    1 size 10737418240 used 562036736 path /dev/sdb
+func parseUsage(b []byte) (Usage, error) {
+	var (
+		usage     Usage
+		foundUsed bool
+	)
+	for _, line := range strings.Split(string(b), "\n") {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) >= 7 && fields[0] == "Total" && fields[3] == "FS":
+			i, err := strconv.ParseInt(fields[6], 10, 64)
+			if err != nil {
+				return Usage{}, fmt.Errorf("unexpected used value in line: %s", line)
+			}
+			usage.Used = i
+			foundUsed = true
+		case len(fields) >= 4 && fields[0] == "devid" && fields[2] == "size":
+			i, err := strconv.ParseInt(fields[3], 10, 64)
+			if err != nil {
+				return Usage{}, fmt.Errorf("unexpected size value in line: %s", line)
+			}
+			usage.Size += i
+		}
+	}
+	if !foundUsed || usage.Size == 0 {
+		return Usage{}, fmt.Errorf("unexpected 'btrfs filesystem show --raw' output: %s", string(b))
+	}
+	usage.Free = usage.Size - usage.Used
+	if usage.Free < 0 {
+		usage.Free = 0
+	}
+	return usage, nil
+}
+
+// SetLabel sets the label of the filesystem.
+func (t *Filesystem) SetLabel(ctx context.Context, label string) error {
+	return run(ctx, t.Log, "filesystem", "label", t.Device, label)
+}
+
+// IsFormated returns true if the device holds a btrfs filesystem.
+func (t *Filesystem) IsFormated(ctx context.Context) (bool, error) {
+	if !IsCapable() {
+		return false, fmt.Errorf("btrfs not found")
+	}
+	if _, err := output(ctx, t.Log, "filesystem", "show", t.Device); err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+// ResizeMax grows the filesystem, mounted on mnt, to the size of its
+// device.
+func (t *Filesystem) ResizeMax(ctx context.Context, mnt string) error {
+	return run(ctx, t.Log, "filesystem", "resize", "max", mnt)
+}
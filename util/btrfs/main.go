@@ -0,0 +1,68 @@
+// Package btrfs wraps the btrfs command to manage btrfs filesystems,
+// subvolumes and quota groups.
+package btrfs
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	// Filesystem is a btrfs filesystem, designated by one of its devices.
+	Filesystem struct {
+		Device string
+		Log    *plog.Logger
+	}
+
+	// Subvolume is a btrfs subvolume, designated by its path in a mounted
+	// btrfs filesystem.
+	Subvolume struct {
+		Path string
+		Log  *plog.Logger
+	}
+)
+
+// IsCapable returns true if the btrfs command is installed.
+func IsCapable() bool {
+	if _, err := exec.LookPath("btrfs"); err != nil {
+		return false
+	}
+	return true
+}
+
+func run(ctx context.Context, log *plog.Logger, args ...string) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("btrfs"),
+		command.WithArgs(args),
+		command.WithLogger(log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s exit code %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
+func output(ctx context.Context, log *plog.Logger, args ...string) ([]byte, error) {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("btrfs"),
+		command.WithArgs(args),
+		command.WithLogger(log),
+		command.WithBufferedStdout(),
+		command.WithCommandLogLevel(zerolog.TraceLevel),
+		command.WithStdoutLogLevel(zerolog.TraceLevel),
+		command.WithStderrLogLevel(zerolog.TraceLevel),
+	)
+	return cmd.Output()
+}
@@ -0,0 +1,53 @@
+package btrfs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUsage(t *testing.T) {
+	b := []byte(`Label: 'data'  uuid: 8c0f36a2-5d1e-4c4b-9d6e-0d8f6f4d6a51
+	Total devices 2 FS bytes used 196608
+	devid    1 size 10737418240 used 562036736 path /dev/sdb
+	devid    2 size 10737418240 used 562036736 path /dev/sdc
+
+`)
+	usage, err := parseUsage(b)
+	require.Nil(t, err)
+	require.Equal(t, int64(21474836480), usage.Size)
+	require.Equal(t, int64(196608), usage.Used)
+	require.Equal(t, int64(21474639872), usage.Free)
+
+	_, err = parseUsage([]byte("ERROR: not a valid btrfs filesystem: /dev/sdd\n"))
+	require.NotNil(t, err)
+}
+
+func TestParseMountedUsage(t *testing.T) {
+	b := []byte(`Overall:
+    Device size:		       21474836480
+    Device allocated:		        2174746624
+    Device unallocated:		       19300089856
+    Device missing:		                 0
+    Device slack:		                 0
+    Used:			        2147876864
+    Free (estimated):		        9663414272	(min: 9663414272)
+    Free (statfs, df):		        9663414272
+    Data ratio:			              2.00
+    Metadata ratio:		              2.00
+    Global reserve:		           3670016	(min: 0)
+    Multiple profiles:		                no
+
+Data,RAID1: Size:1073741824, Used:1073741824 (100.00%)
+   /dev/sdb	1073741824
+   /dev/sdc	1073741824
+`)
+	usage, err := parseMountedUsage(b)
+	require.Nil(t, err)
+	require.Equal(t, int64(10737418240), usage.Size, "the raid1 size is half the devices size")
+	require.Equal(t, int64(9663414272), usage.Free)
+	require.Equal(t, int64(1074003968), usage.Used)
+
+	_, err = parseMountedUsage([]byte("ERROR: can't access '/mnt': No such file or directory\n"))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,69 @@
+package btrfs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Exists returns true if the subvolume exists.
+func (t *Subvolume) Exists(ctx context.Context) (bool, error) {
+	if _, err := os.Stat(t.Path); os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if _, err := output(ctx, t.Log, "subvolume", "show", t.Path); err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+// Create creates the subvolume. The missing parent directories are
+// created as plain directories.
+func (t *Subvolume) Create(ctx context.Context) error {
+	if err := os.MkdirAll(filepath.Dir(t.Path), 0755); err != nil {
+		return err
+	}
+	return run(ctx, t.Log, "subvolume", "create", t.Path)
+}
+
+// Delete deletes the subvolume.
+func (t *Subvolume) Delete(ctx context.Context) error {
+	return run(ctx, t.Log, "subvolume", "delete", t.Path)
+}
+
+// Snapshot creates the snapshot dst of the subvolume. A read-only
+// snapshot can be sent with 'btrfs send'.
+func (t *Subvolume) Snapshot(ctx context.Context, dst string, readOnly bool) error {
+	args := []string{"subvolume", "snapshot"}
+	if readOnly {
+		args = append(args, "-r")
+	}
+	args = append(args, t.Path, dst)
+	return run(ctx, t.Log, args...)
+}
+
+// Rename renames the subvolume to dst, in the same filesystem.
+func (t *Subvolume) Rename(dst string) error {
+	if t.Log != nil {
+		t.Log.Infof("rename subvolume %s to %s", t.Path, dst)
+	}
+	if err := os.Rename(t.Path, dst); err != nil {
+		return fmt.Errorf("rename subvolume %s to %s: %w", t.Path, dst, err)
+	}
+	t.Path = dst
+	return nil
+}
+
+// EnableQuota enables the quota groups accounting on the filesystem
+// hosting the subvolume. It is a noop if already enabled.
+func (t *Subvolume) EnableQuota(ctx context.Context) error {
+	return run(ctx, t.Log, "quota", "enable", t.Path)
+}
+
+// SetLimit sets the referenced space limit of the subvolume quota group.
+func (t *Subvolume) SetLimit(ctx context.Context, size int64) error {
+	return run(ctx, t.Log, "qgroup", "limit", fmt.Sprint(size), t.Path)
+}
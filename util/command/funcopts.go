@@ -3,6 +3,7 @@ package command
 import (
 	"bufio"
 	"context"
+	"io"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -192,6 +193,15 @@ func WithVarEnv(env ...string) funcopt.O {
 	})
 }
 
+// WithStdin sets the reader the process reads its standard input from.
+func WithStdin(r io.Reader) funcopt.O {
+	return funcopt.F(func(i interface{}) error {
+		t := i.(*T)
+		t.stdin = r
+		return nil
+	})
+}
+
 func WithEnv(env []string) funcopt.O {
 	return funcopt.F(func(i interface{}) error {
 		t := i.(*T)
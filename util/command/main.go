@@ -33,6 +33,7 @@ type (
 		group        string
 		cwd          string
 		env          []string
+		stdin        io.Reader
 		cmd          *exec.Cmd
 		label        string
 		timeout      time.Duration
@@ -376,6 +377,9 @@ func (t *T) update() error {
 	if len(t.env) > 0 {
 		cmd.Env = append(cmd.Env, t.env...)
 	}
+	if t.stdin != nil {
+		cmd.Stdin = t.stdin
+	}
 	if credential, err := credential(t.user, t.group); err != nil {
 		if t.log != nil {
 			t.log.Levelf(t.logLevel, "unable to set credential from user '%v', group '%v' for action '%v': %s", t.user, t.group, t.label, err)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os/exec"
 	"runtime"
+	"strings"
 	"syscall"
 	"testing"
 
@@ -147,6 +148,12 @@ func TestT_StdoutStderr(t *testing.T) {
 	}
 }
 
+func TestStdin(t *testing.T) {
+	cmd := New(WithName("cat"), WithStdin(strings.NewReader("foo\nbar\n")), WithBufferedStdout())
+	require.NoError(t, cmd.Run())
+	require.Equal(t, "foo\nbar\n", string(cmd.Stdout()))
+}
+
 func TestStart(t *testing.T) {
 	t.Run("can not call Start twice", func(t *testing.T) {
 		cmd := New(WithName("pwd"))
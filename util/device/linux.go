@@ -401,6 +401,25 @@ func (t T) RemoveMultipath(ctx context.Context) error {
 	return nil
 }
 
+// ResizeMultipath makes multipathd resize the multipath map to the size
+// of its paths. The paths must be rescanned first.
+func (t T) ResizeMultipath(ctx context.Context) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("multipathd"),
+		command.WithVarArgs("resize", "map", filepath.Base(t.path)),
+		command.WithLogger(t.log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
 func (t T) WWID() (string, error) {
 	props, err := udevadm.Properties(t.path)
 	if err != nil {
@@ -0,0 +1,188 @@
+// Package disklease implements a lease stored in the first block of a shared
+// block device, or of a regular file standing in for one.
+//
+// The lease is acquired with a write-then-verify race: a node reads the lease
+// block, writes its own claim if no other node holds a valid lease, waits for
+// the settle delay so a concurrent claim can land on the device, and reads the
+// block back. The node whose claim survives is the lease holder.
+//
+// The device must be dedicated to the lease, as its first block is
+// overwritten. The lease block is read and written with direct io, so a
+// claim written by another node is not hidden by the local page cache.
+//
+// The lease expiry is a wall clock time written by the holder and compared
+// to the local clock of the other claimants, so the claimant clocks must be
+// synchronized. A claimant considers the lease of another holder valid
+// until its expiry plus the MaxClockSkew tolerance, so the clock
+// difference between the claimants must stay below MaxClockSkew.
+package disklease
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ncw/directio"
+)
+
+type (
+	// T is a lease stored on a device.
+	T struct {
+		// Path is the path of the block device or regular file storing the
+		// lease block.
+		Path string
+
+		// Holder is the name of the lease claimant, usually the node name.
+		Holder string
+
+		// Duration is the validity duration of an acquired lease.
+		Duration time.Duration
+
+		// Settle is the delay between the claim write and its verification.
+		// It must be longer than the delay between a concurrent claimant
+		// read and write.
+		Settle time.Duration
+
+		// MaxClockSkew is the maximum clock difference between the
+		// claimants. The lease of another holder is considered valid until
+		// its expiry plus MaxClockSkew.
+		MaxClockSkew time.Duration
+	}
+
+	// Lease is the content of the lease block.
+	Lease struct {
+		Holder    string    `json:"holder"`
+		Nonce     uuid.UUID `json:"nonce"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+)
+
+const (
+	// BlockSize is the size of the lease block, a multiple of the device
+	// logical block size as required by direct io.
+	BlockSize = directio.BlockSize
+
+	magic = "OSVCLEASE"
+)
+
+var (
+	// ErrHeld is returned by Acquire when another holder has a valid lease.
+	ErrHeld = errors.New("lease held")
+
+	// ErrLost is returned by Acquire when a concurrent claim overwrote ours.
+	ErrLost = errors.New("lease race lost")
+)
+
+// IsValid returns true if the lease is not expired at tm.
+func (t Lease) IsValid(tm time.Time) bool {
+	return t.Holder != "" && tm.Before(t.ExpiresAt)
+}
+
+// Read returns the lease stored on the device. A zero Lease is returned if the
+// device holds no lease block.
+func (t T) Read() (Lease, error) {
+	var lease Lease
+	f, err := t.open(os.O_RDONLY)
+	if err != nil {
+		return lease, err
+	}
+	defer func() { _ = f.Close() }()
+	b := directio.AlignedBlock(BlockSize)
+	if _, err := io.ReadFull(f, b); err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return lease, fmt.Errorf("read lease block: %w", err)
+	}
+	if !bytes.HasPrefix(b, []byte(magic)) {
+		return lease, nil
+	}
+	b = bytes.TrimRight(b[len(magic):], "\x00")
+	if err := json.Unmarshal(b, &lease); err != nil {
+		return lease, fmt.Errorf("decode lease block: %w", err)
+	}
+	return lease, nil
+}
+
+func (t T) write(lease Lease) error {
+	b, err := json.Marshal(lease)
+	if err != nil {
+		return err
+	}
+	b = append([]byte(magic), b...)
+	if len(b) > BlockSize {
+		return fmt.Errorf("lease block too large: %d > %d bytes", len(b), BlockSize)
+	}
+	block := directio.AlignedBlock(BlockSize)
+	copy(block, b)
+	f, err := t.open(os.O_WRONLY | os.O_SYNC)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteAt(block, 0); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write lease block: %w", err)
+	}
+	return f.Close()
+}
+
+// open opens the lease device with direct io. A regular file on a local
+// filesystem not supporting direct io, like tmpfs, is opened without: such
+// a file can't be shared by the claimant nodes anyway.
+func (t T) open(flag int) (*os.File, error) {
+	f, err := directio.OpenFile(t.Path, flag, 0)
+	if errors.Is(err, syscall.EINVAL) {
+		if info, statErr := os.Stat(t.Path); statErr == nil && info.Mode().IsRegular() {
+			return os.OpenFile(t.Path, flag, 0)
+		}
+	}
+	return f, err
+}
+
+// Acquire races for the lease. It returns nil if the lease is now held by
+// t.Holder, ErrHeld if another holder has a valid lease, and ErrLost if a
+// concurrent claimant won the race.
+//
+// A lease already held by t.Holder is renewed.
+func (t T) Acquire() error {
+	current, err := t.Read()
+	if err != nil {
+		return err
+	}
+	if current.Holder != t.Holder && current.IsValid(time.Now().Add(-t.MaxClockSkew)) {
+		return fmt.Errorf("%w by %s until %s", ErrHeld, current.Holder, current.ExpiresAt.Format(time.RFC3339))
+	}
+	claim := Lease{
+		Holder:    t.Holder,
+		Nonce:     uuid.New(),
+		ExpiresAt: time.Now().Add(t.Duration),
+	}
+	if err := t.write(claim); err != nil {
+		return err
+	}
+	time.Sleep(t.Settle)
+	current, err = t.Read()
+	if err != nil {
+		return err
+	}
+	if current.Nonce != claim.Nonce {
+		return fmt.Errorf("%w to %s", ErrLost, current.Holder)
+	}
+	return nil
+}
+
+// Release expires the lease if it is held by t.Holder.
+func (t T) Release() error {
+	current, err := t.Read()
+	if err != nil {
+		return err
+	}
+	if current.Holder != t.Holder || !current.IsValid(time.Now()) {
+		return nil
+	}
+	current.ExpiresAt = time.Now()
+	return t.write(current)
+}
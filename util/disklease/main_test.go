@@ -0,0 +1,84 @@
+package disklease
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newDevice(t *testing.T) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), "dev")
+	require.NoError(t, os.WriteFile(p, make([]byte, 4*BlockSize), 0600))
+	return p
+}
+
+func TestAcquire(t *testing.T) {
+	p := newDevice(t)
+	a := T{Path: p, Holder: "node1", Duration: time.Minute}
+	b := T{Path: p, Holder: "node2", Duration: time.Minute}
+
+	lease, err := a.Read()
+	require.NoError(t, err)
+	require.Equal(t, Lease{}, lease, "expected no lease on a blank device")
+
+	require.NoError(t, a.Acquire())
+	require.ErrorIs(t, b.Acquire(), ErrHeld)
+	require.NoError(t, a.Acquire(), "expected the holder to renew its lease")
+
+	require.NoError(t, b.Release(), "expected a no-op release by a non holder")
+	lease, err = b.Read()
+	require.NoError(t, err)
+	require.Equal(t, "node1", lease.Holder)
+	require.True(t, lease.IsValid(time.Now()))
+
+	require.NoError(t, a.Release())
+	require.NoError(t, b.Acquire())
+}
+
+func TestAcquireExpired(t *testing.T) {
+	p := newDevice(t)
+	a := T{Path: p, Holder: "node1", Duration: time.Millisecond}
+	b := T{Path: p, Holder: "node2", Duration: time.Minute}
+	require.NoError(t, a.Acquire())
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, b.Acquire())
+}
+
+func TestAcquireClockSkew(t *testing.T) {
+	p := newDevice(t)
+	a := T{Path: p, Holder: "node1", Duration: time.Millisecond}
+	b := T{Path: p, Holder: "node2", Duration: time.Minute, MaxClockSkew: time.Minute}
+	require.NoError(t, a.Acquire())
+	time.Sleep(10 * time.Millisecond)
+	require.ErrorIs(t, b.Acquire(), ErrHeld, "expected the expired lease to be held within the clock skew tolerance")
+}
+
+func TestAcquireRace(t *testing.T) {
+	p := newDevice(t)
+	holders := []string{"node1", "node2", "node3"}
+	errs := make([]error, len(holders))
+	var wg sync.WaitGroup
+	for i, holder := range holders {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = T{Path: p, Holder: holder, Duration: time.Minute, Settle: 200 * time.Millisecond}.Acquire()
+		}()
+	}
+	wg.Wait()
+	var winners []string
+	for i, err := range errs {
+		if err == nil {
+			winners = append(winners, holders[i])
+		}
+	}
+	require.Len(t, winners, 1, "expected a single winner: %v", errs)
+	lease, err := T{Path: p}.Read()
+	require.NoError(t, err)
+	require.Equal(t, winners[0], lease.Holder)
+}
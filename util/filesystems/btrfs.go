@@ -0,0 +1,73 @@
+package filesystems
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/util/btrfs"
+	"github.com/opensvc/om3/v3/util/command"
+)
+
+type (
+	// Btrfs is the btrfs filesystem. A btrfs filesystem can span multiple
+	// devices, and mounting any one of them mounts the filesystem.
+	//
+	// Btrfs does not implement FSCKer: the btrfs check is slow on large
+	// filesystems and its repairs are not safe to run unattended, and
+	// btrfs verifies its checksums and replays its log at mount time.
+	Btrfs struct{ T }
+)
+
+func init() {
+	registerFS(NewBtrfs())
+}
+
+func NewBtrfs() *Btrfs {
+	t := Btrfs{
+		T{fsType: "btrfs", isMultiDevice: true},
+	}
+	return &t
+}
+
+func (t Btrfs) btrfs(dev string) *btrfs.Filesystem {
+	return &btrfs.Filesystem{Device: dev, Log: t.log}
+}
+
+func (t Btrfs) IsFormated(ctx context.Context, s string) (bool, error) {
+	return t.btrfs(s).IsFormated(ctx)
+}
+
+func (t Btrfs) MKFS(ctx context.Context, s string, args []string) error {
+	if _, err := exec.LookPath("mkfs.btrfs"); err != nil {
+		return fmt.Errorf("mkfs.btrfs not found")
+	}
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("mkfs.btrfs"),
+		command.WithArgs(append(args, "-f", "-q", s)),
+		command.WithLogger(t.log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// Growfs grows the btrfs filesystem, which must be mounted on mnt.
+func (t Btrfs) Growfs(ctx context.Context, dev string, mnt string) error {
+	return t.btrfs(dev).ResizeMax(ctx, mnt)
+}
+
+func (t Btrfs) SetLabel(ctx context.Context, dev string, label string) error {
+	return t.btrfs(dev).SetLabel(ctx, label)
+}
+
+func (t Btrfs) IsCapable() bool {
+	if _, err := exec.LookPath("mkfs.btrfs"); err != nil {
+		return false
+	}
+	return true
+}
@@ -71,3 +71,19 @@ func xMKFS(ctx context.Context, x string, s string, xargs []string, log *plog.Lo
 	)
 	return cmd.Run()
 }
+
+func extGrowfs(ctx context.Context, s string, log *plog.Logger) error {
+	if _, err := exec.LookPath("resize2fs"); err != nil {
+		return errors.New("resize2fs not found")
+	}
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("resize2fs"),
+		command.WithVarArgs(s),
+		command.WithLogger(log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
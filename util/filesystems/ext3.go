@@ -32,3 +32,7 @@ func (t Ext3) IsFormated(ctx context.Context, s string) (bool, error) {
 func (t Ext3) MKFS(ctx context.Context, s string, args []string) error {
 	return xMKFS(ctx, "mkfs.ext3", s, args, t.log)
 }
+
+func (t Ext3) Growfs(ctx context.Context, dev string, mnt string) error {
+	return extGrowfs(ctx, dev, t.log)
+}
@@ -32,3 +32,7 @@ func (t Ext4) IsFormated(ctx context.Context, s string) (bool, error) {
 func (t Ext4) MKFS(ctx context.Context, s string, args []string) error {
 	return xMKFS(ctx, "mkfs.ext4", s, args, t.log)
 }
+
+func (t Ext4) Growfs(ctx context.Context, dev string, mnt string) error {
+	return extGrowfs(ctx, dev, t.log)
+}
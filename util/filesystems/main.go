@@ -60,6 +60,16 @@ type (
 	MKFSer interface {
 		MKFS(context.Context, string, []string) error
 	}
+	// Growfser is implemented by the filesystems able to grow online to
+	// the size of their device.
+	Growfser interface {
+		Growfs(ctx context.Context, dev string, mnt string) error
+	}
+	// Labeler is implemented by the filesystems able to change their
+	// label.
+	Labeler interface {
+		SetLabel(ctx context.Context, dev string, label string) error
+	}
 )
 
 var (
@@ -75,7 +85,6 @@ func init() {
 	registerFS(&T{fsType: "none", isFileBacked: true})
 	registerFS(&T{fsType: "bind", isFileBacked: true})
 	registerFS(&T{fsType: "lofs", isFileBacked: true})
-	registerFS(&T{fsType: "btrfs", isMultiDevice: true})
 	registerFS(&T{fsType: "vfat"})
 	registerFS(&T{fsType: "reiserfs"})
 	registerFS(&T{fsType: "jfs"})
@@ -211,6 +220,24 @@ func DevicesFSCK(ctx context.Context, fs any, dl subDeviceLister) error {
 	return nil
 }
 
+// Growfs grows the filesystem mounted on mnt from dev to the size of dev.
+func Growfs(ctx context.Context, fs any, dev, mnt string) error {
+	i, ok := fs.(Growfser)
+	if !ok {
+		return fmt.Errorf("growfs: %w", ErrNotImplemented)
+	}
+	return i.Growfs(ctx, dev, mnt)
+}
+
+// SetLabel sets the label of the filesystem on dev.
+func SetLabel(ctx context.Context, fs any, dev, label string) error {
+	i, ok := fs.(Labeler)
+	if !ok {
+		return fmt.Errorf("label: %w", ErrNotImplemented)
+	}
+	return i.SetLabel(ctx, dev, label)
+}
+
 func DevicesFormated(ctx context.Context, fs any, dl subDeviceLister) (bool, error) {
 	i, ok := fs.(IsFormateder)
 	if !ok {
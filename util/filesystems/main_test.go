@@ -5,15 +5,24 @@ import "testing"
 var (
 	_ IsFormateder = (*XFS)(nil)
 	_ MKFSer       = (*XFS)(nil)
+	_ Growfser     = (*XFS)(nil)
+
+	_ IsFormateder = (*Btrfs)(nil)
+	_ MKFSer       = (*Btrfs)(nil)
+	_ Growfser     = (*Btrfs)(nil)
+	_ Labeler      = (*Btrfs)(nil)
 
 	_ IsFormateder = (*Ext2)(nil)
 	_ MKFSer       = (*Ext2)(nil)
+	_ Growfser     = (*Ext2)(nil)
 
 	_ IsFormateder = (*Ext3)(nil)
 	_ MKFSer       = (*Ext3)(nil)
+	_ Growfser     = (*Ext3)(nil)
 
 	_ IsFormateder = (*Ext4)(nil)
 	_ MKFSer       = (*Ext4)(nil)
+	_ Growfser     = (*Ext4)(nil)
 
 	_ IsFormateder = (*Ext2)(nil)
 	_ MKFSer       = (*Ext2)(nil)
@@ -25,3 +34,9 @@ var (
 func TestFS(t *testing.T) {
 	// Ensure interfaces are implemented
 }
+
+func TestBtrfsIsMultiDevice(t *testing.T) {
+	if !FromType("btrfs").IsMultiDevice() {
+		t.Fatal("btrfs must be multi device")
+	}
+}
@@ -58,6 +58,23 @@ func (t XFS) MKFS(ctx context.Context, devpath string, args []string) error {
 	return cmd.Run()
 }
 
+// Growfs grows the xfs filesystem, which must be mounted on mnt.
+func (t XFS) Growfs(ctx context.Context, dev string, mnt string) error {
+	if _, err := exec.LookPath("xfs_growfs"); err != nil {
+		return fmt.Errorf("xfs_growfs not found")
+	}
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("xfs_growfs"),
+		command.WithVarArgs(mnt),
+		command.WithLogger(t.log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
 func (t XFS) IsCapable() bool {
 	if _, err := exec.LookPath("mkfs.xfs"); err != nil {
 		return false
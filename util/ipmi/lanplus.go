@@ -0,0 +1,430 @@
+package ipmi
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+)
+
+type (
+	// cipherSuite is a set of RMCP+ authentication, integrity and
+	// confidentiality algorithms.
+	cipherSuite struct {
+		id           uint8
+		authAlg      uint8
+		integrityAlg uint8
+		confAlg      uint8
+		hash         func() hash.Hash
+
+		// icvSize is the size of the truncated HMAC of the RAKP message 4
+		// and of the session packets.
+		icvSize int
+	}
+
+	// plusSession holds the keys of an activated RMCP+ session. A nil
+	// session encodes and decodes the unauthenticated packets of the
+	// session setup.
+	plusSession struct {
+		suite *cipherSuite
+		k1    []byte
+		k2    []byte
+	}
+
+	// RAKPStatusError is returned when the BMC responds to a RMCP+ session
+	// setup message with a non zero status code.
+	RAKPStatusError struct {
+		Message string
+		Code    uint8
+	}
+)
+
+const (
+	// InterfaceLan selects the IPMI v1.5 session protocol.
+	InterfaceLan = "lan"
+
+	// InterfaceLanPlus selects the IPMI v2.0 RMCP+ session protocol.
+	InterfaceLanPlus = "lanplus"
+
+	// DefaultCipherSuite is the RMCP+ cipher suite used when the client
+	// CipherSuite is not set: RAKP-HMAC-SHA1, HMAC-SHA1-96, AES-CBC-128.
+	DefaultCipherSuite = 3
+
+	authTypeRMCPPlus = 0x06
+
+	payloadTypeIPMI            = 0x00
+	payloadTypeOpenSessionRq   = 0x10
+	payloadTypeOpenSessionRs   = 0x11
+	payloadTypeRAKP1           = 0x12
+	payloadTypeRAKP2           = 0x13
+	payloadTypeRAKP3           = 0x14
+	payloadTypeRAKP4           = 0x15
+	payloadFlagEncrypted       = 0x80
+	payloadFlagAuthenticated   = 0x40
+	payloadTypeMask            = 0x3f
+	integrityNextHeader        = 0x07
+	privilegeLookupNameOnly    = 0x10
+	channelAuthCapabilitiesV2  = 0x80
+	extendedCapabilitiesV2     = 0x02
+	keyConstantSize            = 20
+	passwordMaxSize            = 20
+	confidentialityBlockSize   = aes.BlockSize
+	openSessionResponseMinSize = 36
+)
+
+var (
+	cipherSuites = map[uint8]*cipherSuite{
+		3: {
+			id:           3,
+			authAlg:      0x01, // RAKP-HMAC-SHA1
+			integrityAlg: 0x01, // HMAC-SHA1-96
+			confAlg:      0x01, // AES-CBC-128
+			hash:         sha1.New,
+			icvSize:      12,
+		},
+		17: {
+			id:           17,
+			authAlg:      0x03, // RAKP-HMAC-SHA256
+			integrityAlg: 0x04, // HMAC-SHA256-128
+			confAlg:      0x01, // AES-CBC-128
+			hash:         sha256.New,
+			icvSize:      16,
+		},
+	}
+
+	// ErrUnsupportedCipherSuite is returned when the client CipherSuite is
+	// not implemented.
+	ErrUnsupportedCipherSuite = errors.New("unsupported cipher suite")
+
+	// ErrIntegrity is returned when a RMCP+ message authentication code
+	// does not match, usually because the password is wrong.
+	ErrIntegrity = errors.New("integrity check failed")
+)
+
+func (t RAKPStatusError) Error() string {
+	return fmt.Sprintf("%s: status code 0x%02x", t.Message, t.Code)
+}
+
+// CipherSuites returns the ids of the supported RMCP+ cipher suites.
+func CipherSuites() []int {
+	return []int{3, 17}
+}
+
+func getCipherSuite(id uint8) (*cipherSuite, error) {
+	if id == 0 {
+		id = DefaultCipherSuite
+	}
+	suite, ok := cipherSuites[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedCipherSuite, id)
+	}
+	return suite, nil
+}
+
+func (t *cipherSuite) hmac(key []byte, data ...[]byte) []byte {
+	h := hmac.New(t.hash, key)
+	for _, b := range data {
+		h.Write(b)
+	}
+	return h.Sum(nil)
+}
+
+// newPlusSession derives the session integrity and confidentiality keys
+// from the session integrity key.
+func newPlusSession(suite *cipherSuite, sik []byte) *plusSession {
+	return &plusSession{
+		suite: suite,
+		k1:    suite.hmac(sik, bytes.Repeat([]byte{0x01}, keyConstantSize)),
+		k2:    suite.hmac(sik, bytes.Repeat([]byte{0x02}, keyConstantSize)),
+	}
+}
+
+// encode returns the RMCP+ packet embedding the payload. The payload is
+// encrypted and the packet authenticated if the session is activated.
+func (t *plusSession) encode(payloadType uint8, sessionID, seq uint32, payload []byte) ([]byte, error) {
+	if t != nil {
+		var err error
+		if payload, err = encrypt(t.k2[:16], payload); err != nil {
+			return nil, err
+		}
+		payloadType |= payloadFlagEncrypted | payloadFlagAuthenticated
+	}
+	b := bytes.NewBuffer(append([]byte{}, rmcpHeader...))
+	b.WriteByte(authTypeRMCPPlus)
+	b.WriteByte(payloadType)
+	_ = binary.Write(b, binary.LittleEndian, sessionID)
+	_ = binary.Write(b, binary.LittleEndian, seq)
+	_ = binary.Write(b, binary.LittleEndian, uint16(len(payload)))
+	b.Write(payload)
+	if t == nil {
+		return b.Bytes(), nil
+	}
+	// The integrity data, from the auth type to the next header, is
+	// padded to a multiple of 4 bytes.
+	padSize := (4 - (b.Len()-len(rmcpHeader)+2)%4) % 4
+	b.Write(bytes.Repeat([]byte{0xff}, padSize))
+	b.WriteByte(byte(padSize))
+	b.WriteByte(integrityNextHeader)
+	b.Write(t.suite.hmac(t.k1, b.Bytes()[len(rmcpHeader):])[:t.suite.icvSize])
+	return b.Bytes(), nil
+}
+
+// decode returns the payload type, the session id and the payload of a
+// RMCP+ packet. The authenticated packets are verified and the encrypted
+// payloads decrypted, which requires an activated session.
+func (t *plusSession) decode(b []byte) (uint8, uint32, []byte, error) {
+	if len(b) < 16 || b[3] != rmcpClassIPMI || b[4] != authTypeRMCPPlus {
+		return 0, 0, nil, fmt.Errorf("not a rmcp+ packet")
+	}
+	payloadType := b[5]
+	sessionID := binary.LittleEndian.Uint32(b[6:10])
+	size := int(binary.LittleEndian.Uint16(b[14:16]))
+	if len(b) < 16+size {
+		return 0, 0, nil, fmt.Errorf("short packet")
+	}
+	payload := b[16 : 16+size]
+	if payloadType&payloadFlagAuthenticated != 0 {
+		if t == nil {
+			return 0, 0, nil, fmt.Errorf("authenticated packet out of session")
+		}
+		end := len(b) - t.suite.icvSize
+		if end < 16+size+2 {
+			return 0, 0, nil, fmt.Errorf("short packet")
+		}
+		if !hmac.Equal(b[end:], t.suite.hmac(t.k1, b[len(rmcpHeader):end])[:t.suite.icvSize]) {
+			return 0, 0, nil, ErrIntegrity
+		}
+	} else if t != nil {
+		return 0, 0, nil, fmt.Errorf("unauthenticated packet in session")
+	}
+	if payloadType&payloadFlagEncrypted != 0 {
+		if t == nil {
+			return 0, 0, nil, fmt.Errorf("encrypted packet out of session")
+		}
+		var err error
+		if payload, err = decrypt(t.k2[:16], payload); err != nil {
+			return 0, 0, nil, err
+		}
+	}
+	return payloadType & payloadTypeMask, sessionID, payload, nil
+}
+
+// encrypt returns the AES-CBC-128 encrypted payload, prefixed by its
+// initialization vector.
+func encrypt(key, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	padSize := (confidentialityBlockSize - (len(data)+1)%confidentialityBlockSize) % confidentialityBlockSize
+	plain := append([]byte{}, data...)
+	for i := 1; i <= padSize; i++ {
+		plain = append(plain, byte(i))
+	}
+	plain = append(plain, byte(padSize))
+	b := make([]byte, confidentialityBlockSize+len(plain))
+	if _, err := rand.Read(b[:confidentialityBlockSize]); err != nil {
+		return nil, err
+	}
+	cipher.NewCBCEncrypter(block, b[:confidentialityBlockSize]).CryptBlocks(b[confidentialityBlockSize:], plain)
+	return b, nil
+}
+
+// decrypt returns the payload data of an AES-CBC-128 encrypted payload.
+func decrypt(key, b []byte) ([]byte, error) {
+	if len(b) < 2*confidentialityBlockSize || len(b)%confidentialityBlockSize != 0 {
+		return nil, fmt.Errorf("invalid encrypted payload size %d", len(b))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	plain := make([]byte, len(b)-confidentialityBlockSize)
+	cipher.NewCBCDecrypter(block, b[:confidentialityBlockSize]).CryptBlocks(plain, b[confidentialityBlockSize:])
+	padSize := int(plain[len(plain)-1])
+	if padSize >= confidentialityBlockSize || padSize >= len(plain) {
+		return nil, fmt.Errorf("invalid confidentiality pad size %d", padSize)
+	}
+	return plain[:len(plain)-1-padSize], nil
+}
+
+// activatePlus opens a RMCP+ session with the operator privilege level,
+// authenticated by the RAKP key exchange.
+func (t *Client) activatePlus(ctx context.Context) error {
+	suite, err := getCipherSuite(t.CipherSuite)
+	if err != nil {
+		return err
+	}
+	b, err := t.request(ctx, netFnApp, cmdGetChannelAuthCapabilities, []byte{channelAuthCapabilitiesV2 | 0x0e, privilegeOperator})
+	if err != nil {
+		return fmt.Errorf("get channel authentication capabilities: %w", err)
+	}
+	if len(b) < 4 || b[1]&channelAuthCapabilitiesV2 == 0 || b[3]&extendedCapabilitiesV2 == 0 {
+		return fmt.Errorf("get channel authentication capabilities: ipmi v2.0 is not supported")
+	}
+
+	consoleSessionID, err := randomUint32()
+	if err != nil {
+		return err
+	}
+	data := []byte{t.nextTag(), privilegeOperator, 0, 0}
+	data = binary.LittleEndian.AppendUint32(data, consoleSessionID)
+	data = append(data, 0x00, 0, 0, 0x08, suite.authAlg, 0, 0, 0)
+	data = append(data, 0x01, 0, 0, 0x08, suite.integrityAlg, 0, 0, 0)
+	data = append(data, 0x02, 0, 0, 0x08, suite.confAlg, 0, 0, 0)
+	b, err = t.exchange(ctx, payloadTypeOpenSessionRq, payloadTypeOpenSessionRs, data)
+	if err != nil {
+		return fmt.Errorf("open session: %w", err)
+	}
+	if len(b) < openSessionResponseMinSize {
+		return fmt.Errorf("open session: short response")
+	}
+	if binary.LittleEndian.Uint32(b[4:8]) != consoleSessionID {
+		return fmt.Errorf("open session: unexpected remote console session id")
+	}
+	if b[16] != suite.authAlg || b[24] != suite.integrityAlg || b[32] != suite.confAlg {
+		return fmt.Errorf("open session: cipher suite %d algorithms not accepted", suite.id)
+	}
+	bmcSessionID := binary.LittleEndian.Uint32(b[8:12])
+
+	password := []byte(t.Password)
+	if len(password) > passwordMaxSize {
+		password = password[:passwordMaxSize]
+	}
+	username := []byte(t.Username)
+	role := byte(privilegeLookupNameOnly | privilegeOperator)
+	consoleRandom := make([]byte, 16)
+	if _, err := rand.Read(consoleRandom); err != nil {
+		return err
+	}
+	data = []byte{t.nextTag(), 0, 0, 0}
+	data = binary.LittleEndian.AppendUint32(data, bmcSessionID)
+	data = append(data, consoleRandom...)
+	data = append(data, role, 0, 0, byte(len(username)))
+	data = append(data, username...)
+	b, err = t.exchange(ctx, payloadTypeRAKP1, payloadTypeRAKP2, data)
+	if err != nil {
+		return fmt.Errorf("rakp message 1: %w", err)
+	}
+	hashSize := suite.hash().Size()
+	if len(b) < 40+hashSize {
+		return fmt.Errorf("rakp message 2: short response")
+	}
+	bmcRandom := b[8:24]
+	bmcGUID := b[24:40]
+	consoleSID := binary.LittleEndian.AppendUint32(nil, consoleSessionID)
+	bmcSID := binary.LittleEndian.AppendUint32(nil, bmcSessionID)
+	userData := append([]byte{role, byte(len(username))}, username...)
+	expected := suite.hmac(password, consoleSID, bmcSID, consoleRandom, bmcRandom, bmcGUID, userData)
+	if !hmac.Equal(b[40:40+hashSize], expected) {
+		return fmt.Errorf("rakp message 2: %w", ErrIntegrity)
+	}
+	sik := suite.hmac(password, consoleRandom, bmcRandom, userData)
+
+	data = []byte{t.nextTag(), 0, 0, 0}
+	data = append(data, bmcSID...)
+	data = append(data, suite.hmac(password, bmcRandom, consoleSID, userData)...)
+	b, err = t.exchange(ctx, payloadTypeRAKP3, payloadTypeRAKP4, data)
+	if err != nil {
+		return fmt.Errorf("rakp message 3: %w", err)
+	}
+	if len(b) < 8+suite.icvSize {
+		return fmt.Errorf("rakp message 4: short response")
+	}
+	if !hmac.Equal(b[8:8+suite.icvSize], suite.hmac(sik, consoleRandom, bmcSID, bmcGUID)[:suite.icvSize]) {
+		return fmt.Errorf("rakp message 4: %w", ErrIntegrity)
+	}
+
+	t.consoleSessionID = consoleSessionID
+	t.sessionID = bmcSessionID
+	t.sessionSeq = 1
+	t.plus = newPlusSession(suite, sik)
+
+	if _, err := t.request(ctx, netFnApp, cmdSetSessionPrivilege, []byte{privilegeOperator}); err != nil {
+		return fmt.Errorf("set session privilege level: %w", err)
+	}
+	return nil
+}
+
+// exchange sends a RMCP+ session setup message and returns the payload of
+// the response. The message is retransmitted on timeout.
+func (t *Client) exchange(ctx context.Context, rqType, rsType uint8, data []byte) ([]byte, error) {
+	packet, err := (*plusSession)(nil).encode(rqType, 0, 0, data)
+	if err != nil {
+		return nil, err
+	}
+	tag := data[0]
+	for range t.Retries + 1 {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		if _, err = t.conn.Write(packet); err != nil {
+			return nil, err
+		}
+		var b []byte
+		b, err = t.readPlus(ctx, func(payloadType uint8, payload []byte) bool {
+			return payloadType == rsType && len(payload) >= 2 && payload[0] == tag
+		})
+		if err == nil {
+			if b[1] != 0 {
+				return nil, RAKPStatusError{Message: "session setup refused", Code: b[1]}
+			}
+			return b, nil
+		}
+		if !errors.Is(err, os.ErrDeadlineExceeded) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// readPlus returns the payload of the first RMCP+ packet received and
+// accepted by the match function.
+func (t *Client) readPlus(ctx context.Context, match func(uint8, []byte) bool) ([]byte, error) {
+	if err := t.setReadDeadline(ctx); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 1024)
+	for {
+		n, err := t.conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		payloadType, sessionID, payload, err := t.plus.decode(buf[:n])
+		if err != nil {
+			continue
+		}
+		if t.plus != nil && sessionID != t.consoleSessionID {
+			continue
+		}
+		if match(payloadType, payload) {
+			return payload, nil
+		}
+	}
+}
+
+func (t *Client) nextTag() uint8 {
+	t.tag++
+	return t.tag
+}
+
+func randomUint32() (uint32, error) {
+	b := make([]byte, 4)
+	for {
+		if _, err := rand.Read(b); err != nil {
+			return 0, err
+		}
+		if v := binary.LittleEndian.Uint32(b); v != 0 {
+			return v, nil
+		}
+	}
+}
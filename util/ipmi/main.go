@@ -0,0 +1,447 @@
+// Package ipmi implements a minimal IPMI over LAN client, able to read and
+// control the chassis power of a server through its BMC.
+//
+// With the lan interface, the client opens an IPMI v1.5 session
+// authenticated with the MD5 or the straight password method, whichever the
+// BMC supports. With the lanplus interface, the client opens an IPMI v2.0
+// RMCP+ session authenticated by the RAKP key exchange, with integrity
+// checked and encrypted messages.
+//
+// In both cases, the session has the operator privilege level required by
+// the chassis control command.
+package ipmi
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+)
+
+type (
+	// Client is an IPMI over LAN client.
+	Client struct {
+		// Addr is the BMC address, as host or host:port. The port defaults
+		// to 623.
+		Addr string
+
+		Username string
+		Password string
+
+		// Interface is the session protocol, InterfaceLan or
+		// InterfaceLanPlus. It defaults to InterfaceLan.
+		Interface string
+
+		// CipherSuite is the RMCP+ cipher suite id of the lanplus
+		// interface. It defaults to DefaultCipherSuite.
+		CipherSuite uint8
+
+		// Timeout is the delay to wait for a response before retrying the
+		// request.
+		Timeout time.Duration
+
+		// Retries is the number of request retransmissions on timeout.
+		Retries int
+
+		conn       net.Conn
+		authType   uint8
+		sessionID  uint32
+		sessionSeq uint32
+		rqSeq      uint8
+
+		// plus is the activated RMCP+ session, nil with the lan interface
+		// and during the RMCP+ session setup.
+		plus             *plusSession
+		consoleSessionID uint32
+		tag              uint8
+	}
+
+	// ChassisControl is the action requested by the chassis control command.
+	ChassisControl uint8
+
+	// CompletionCodeError is returned when the BMC responds with a non zero
+	// completion code.
+	CompletionCodeError struct {
+		Cmd  uint8
+		Code uint8
+	}
+)
+
+const (
+	// DefaultPort is the RMCP port of the BMC.
+	DefaultPort = "623"
+
+	ChassisPowerDown  ChassisControl = 0x00
+	ChassisPowerUp    ChassisControl = 0x01
+	ChassisPowerCycle ChassisControl = 0x02
+	ChassisHardReset  ChassisControl = 0x03
+
+	authTypeNone     = 0x00
+	authTypeMD5      = 0x02
+	authTypePassword = 0x04
+
+	privilegeOperator = 0x03
+
+	netFnChassis = 0x00
+	netFnApp     = 0x06
+
+	cmdGetChassisStatus           = 0x01
+	cmdChassisControl             = 0x02
+	cmdGetChannelAuthCapabilities = 0x38
+	cmdGetSessionChallenge        = 0x39
+	cmdActivateSession            = 0x3a
+	cmdSetSessionPrivilege        = 0x3b
+	cmdCloseSession               = 0x3c
+
+	bmcAddr     = 0x20
+	consoleAddr = 0x81
+
+	rmcpClassIPMI = 0x07
+)
+
+var (
+	rmcpHeader = []byte{0x06, 0x00, 0xff, rmcpClassIPMI}
+
+	// ErrNoAuthType is returned when the BMC supports none of the
+	// authentication methods of the client.
+	ErrNoAuthType = errors.New("no supported authentication type")
+)
+
+func (t CompletionCodeError) Error() string {
+	return fmt.Sprintf("ipmi command 0x%02x: completion code 0x%02x", t.Cmd, t.Code)
+}
+
+func (t ChassisControl) String() string {
+	switch t {
+	case ChassisPowerDown:
+		return "power down"
+	case ChassisPowerUp:
+		return "power up"
+	case ChassisPowerCycle:
+		return "power cycle"
+	case ChassisHardReset:
+		return "hard reset"
+	default:
+		return fmt.Sprintf("chassis control 0x%02x", uint8(t))
+	}
+}
+
+// Open dials the BMC and activates an operator session.
+func (t *Client) Open(ctx context.Context) error {
+	addr := t.Addr
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, DefaultPort)
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", addr)
+	if err != nil {
+		return err
+	}
+	t.conn = conn
+	t.authType = authTypeNone
+	t.sessionID = 0
+	t.sessionSeq = 0
+	t.plus = nil
+	activate := t.activate
+	switch t.Interface {
+	case "", InterfaceLan:
+	case InterfaceLanPlus:
+		activate = t.activatePlus
+	default:
+		_ = t.conn.Close()
+		t.conn = nil
+		return fmt.Errorf("unsupported interface %s", t.Interface)
+	}
+	if err := activate(ctx); err != nil {
+		_ = t.conn.Close()
+		t.conn = nil
+		return err
+	}
+	return nil
+}
+
+func (t *Client) activate(ctx context.Context) error {
+	b, err := t.request(ctx, netFnApp, cmdGetChannelAuthCapabilities, []byte{0x0e, privilegeOperator})
+	if err != nil {
+		return fmt.Errorf("get channel authentication capabilities: %w", err)
+	}
+	if len(b) < 2 {
+		return fmt.Errorf("get channel authentication capabilities: short response")
+	}
+	authType, err := chooseAuthType(b[1], t.Password)
+	if err != nil {
+		return err
+	}
+
+	username := make([]byte, 16)
+	copy(username, t.Username)
+	b, err = t.request(ctx, netFnApp, cmdGetSessionChallenge, append([]byte{authType}, username...))
+	if err != nil {
+		return fmt.Errorf("get session challenge: %w", err)
+	}
+	if len(b) < 20 {
+		return fmt.Errorf("get session challenge: short response")
+	}
+	t.authType = authType
+	t.sessionID = binary.LittleEndian.Uint32(b[0:4])
+
+	data := []byte{authType, privilegeOperator}
+	data = append(data, b[4:20]...)
+	data = binary.LittleEndian.AppendUint32(data, 1)
+	b, err = t.request(ctx, netFnApp, cmdActivateSession, data)
+	if err != nil {
+		return fmt.Errorf("activate session: %w", err)
+	}
+	if len(b) < 9 {
+		return fmt.Errorf("activate session: short response")
+	}
+	t.sessionID = binary.LittleEndian.Uint32(b[1:5])
+	t.sessionSeq = binary.LittleEndian.Uint32(b[5:9])
+
+	if _, err := t.request(ctx, netFnApp, cmdSetSessionPrivilege, []byte{privilegeOperator}); err != nil {
+		return fmt.Errorf("set session privilege level: %w", err)
+	}
+	return nil
+}
+
+// Close closes the session and the connection to the BMC.
+func (t *Client) Close() error {
+	if t.conn == nil {
+		return nil
+	}
+	var errs error
+	if t.sessionID != 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), t.timeout())
+		defer cancel()
+		data := binary.LittleEndian.AppendUint32(nil, t.sessionID)
+		if _, err := t.request(ctx, netFnApp, cmdCloseSession, data); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("close session: %w", err))
+		}
+	}
+	errs = errors.Join(errs, t.conn.Close())
+	t.conn = nil
+	t.sessionID = 0
+	t.plus = nil
+	return errs
+}
+
+// ChassisControl requests the c chassis action to the BMC.
+func (t *Client) ChassisControl(ctx context.Context, c ChassisControl) error {
+	if _, err := t.request(ctx, netFnChassis, cmdChassisControl, []byte{byte(c)}); err != nil {
+		return fmt.Errorf("chassis control %s: %w", c, err)
+	}
+	return nil
+}
+
+// IsPowerOn returns true if the BMC reports the chassis power is on.
+func (t *Client) IsPowerOn(ctx context.Context) (bool, error) {
+	b, err := t.request(ctx, netFnChassis, cmdGetChassisStatus, nil)
+	if err != nil {
+		return false, fmt.Errorf("get chassis status: %w", err)
+	}
+	if len(b) < 1 {
+		return false, fmt.Errorf("get chassis status: short response")
+	}
+	return b[0]&0x01 != 0, nil
+}
+
+func (t *Client) timeout() time.Duration {
+	if t.Timeout > 0 {
+		return t.Timeout
+	}
+	return 2 * time.Second
+}
+
+// chooseAuthType returns the strongest authentication type supported by the
+// BMC from its capabilities bitmask.
+func chooseAuthType(supported uint8, password string) (uint8, error) {
+	switch {
+	case supported&(1<<authTypeMD5) != 0:
+		return authTypeMD5, nil
+	case supported&(1<<authTypePassword) != 0:
+		return authTypePassword, nil
+	case supported&(1<<authTypeNone) != 0 && password == "":
+		return authTypeNone, nil
+	default:
+		return 0, fmt.Errorf("%w in bitmask 0x%02x", ErrNoAuthType, supported)
+	}
+}
+
+func checksum(b []byte) byte {
+	var c byte
+	for _, v := range b {
+		c += v
+	}
+	return -c
+}
+
+// authCode returns the session header authentication code of msg.
+func authCode(authType uint8, password string, sessionID, seq uint32, msg []byte) []byte {
+	pass := make([]byte, 16)
+	copy(pass, password)
+	switch authType {
+	case authTypePassword:
+		return pass
+	case authTypeMD5:
+		h := md5.New()
+		h.Write(pass)
+		_ = binary.Write(h, binary.LittleEndian, sessionID)
+		h.Write(msg)
+		_ = binary.Write(h, binary.LittleEndian, seq)
+		h.Write(pass)
+		return h.Sum(nil)
+	default:
+		return nil
+	}
+}
+
+// packet returns the RMCP or RMCP+ packet embedding the request message.
+func (t *Client) packet(netFn, cmd uint8, data []byte) ([]byte, error) {
+	msg := []byte{bmcAddr, netFn << 2, 0, consoleAddr, t.rqSeq << 2, cmd}
+	msg[2] = checksum(msg[0:2])
+	msg = append(msg, data...)
+	msg = append(msg, checksum(msg[3:]))
+
+	if t.plus != nil {
+		seq := t.sessionSeq
+		t.sessionSeq++
+		return t.plus.encode(payloadTypeIPMI, t.sessionID, seq, msg)
+	}
+
+	// The session sequence number is not incremented before the session
+	// activation.
+	seq := t.sessionSeq
+	if seq != 0 {
+		t.sessionSeq++
+	}
+	b := bytes.NewBuffer(append([]byte{}, rmcpHeader...))
+	b.WriteByte(t.authType)
+	_ = binary.Write(b, binary.LittleEndian, seq)
+	_ = binary.Write(b, binary.LittleEndian, t.sessionID)
+	b.Write(authCode(t.authType, t.Password, t.sessionID, seq, msg))
+	b.WriteByte(byte(len(msg)))
+	b.Write(msg)
+	return b.Bytes(), nil
+}
+
+// request sends a request to the BMC and returns the response data. The
+// request is retransmitted on timeout.
+func (t *Client) request(ctx context.Context, netFn, cmd uint8, data []byte) ([]byte, error) {
+	if t.conn == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+	t.rqSeq = (t.rqSeq + 1) & 0x3f
+	packet, err := t.packet(netFn, cmd, data)
+	if err != nil {
+		return nil, err
+	}
+	for range t.Retries + 1 {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		if _, err = t.conn.Write(packet); err != nil {
+			return nil, err
+		}
+		var b []byte
+		b, err = t.read(ctx, cmd)
+		if err == nil {
+			return b, nil
+		}
+		if !errors.Is(err, os.ErrDeadlineExceeded) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// read returns the data of the response to the pending request.
+func (t *Client) read(ctx context.Context, cmd uint8) ([]byte, error) {
+	// isResponse skips the responses to the retransmitted requests.
+	isResponse := func(msg []byte) bool {
+		return msg[4]>>2 == t.rqSeq && msg[5] == cmd
+	}
+	var msg []byte
+	if t.plus != nil {
+		b, err := t.readPlus(ctx, func(payloadType uint8, payload []byte) bool {
+			if payloadType != payloadTypeIPMI || checkMessage(payload) != nil {
+				return false
+			}
+			return isResponse(payload)
+		})
+		if err != nil {
+			return nil, err
+		}
+		msg = b
+	} else {
+		if err := t.setReadDeadline(ctx); err != nil {
+			return nil, err
+		}
+		buf := make([]byte, 1024)
+		for {
+			n, err := t.conn.Read(buf)
+			if err != nil {
+				return nil, err
+			}
+			b, err := parseResponse(buf[:n])
+			if err != nil || !isResponse(b) {
+				continue
+			}
+			msg = b
+			break
+		}
+	}
+	if code := msg[6]; code != 0 {
+		return nil, CompletionCodeError{Cmd: cmd, Code: code}
+	}
+	return msg[7 : len(msg)-1], nil
+}
+
+func (t *Client) setReadDeadline(ctx context.Context) error {
+	deadline := time.Now().Add(t.timeout())
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	return t.conn.SetReadDeadline(deadline)
+}
+
+// parseResponse returns the IPMI message embedded in a RMCP packet.
+func parseResponse(b []byte) ([]byte, error) {
+	if len(b) < 14 || b[3] != rmcpClassIPMI {
+		return nil, fmt.Errorf("not an ipmi packet")
+	}
+	offset := 13
+	if b[4] != authTypeNone {
+		offset += 16
+	}
+	if len(b) <= offset {
+		return nil, fmt.Errorf("short packet")
+	}
+	size := int(b[offset])
+	offset++
+	if len(b) < offset+size {
+		return nil, fmt.Errorf("short message")
+	}
+	msg := b[offset : offset+size]
+	if err := checkMessage(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// checkMessage verifies the size and the checksums of an IPMI response
+// message.
+func checkMessage(msg []byte) error {
+	size := len(msg)
+	if size < 8 {
+		return fmt.Errorf("short message")
+	}
+	if checksum(msg[0:2]) != msg[2] || checksum(msg[3:size-1]) != msg[size-1] {
+		return fmt.Errorf("bad checksum")
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+package ipmi
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// fakeBMC is a BMC answering the commands used by the client, with
+	// the authentication types in the supported bitmask.
+	fakeBMC struct {
+		conn      net.PacketConn
+		supported uint8
+		password  string
+		powerOn   bool
+		sessionID uint32
+
+		// RMCP+ session setup state
+		suite            *cipherSuite
+		consoleSessionID uint32
+		consoleRandom    []byte
+		bmcRandom        []byte
+		userData         []byte
+		plus             *plusSession
+	}
+)
+
+func newFakeBMC(t *testing.T, supported uint8, password string) *fakeBMC {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	bmc := &fakeBMC{conn: conn, supported: supported, password: password, powerOn: true, sessionID: 0x11223344}
+	t.Cleanup(func() { _ = conn.Close() })
+	go bmc.serve()
+	return bmc
+}
+
+func (t *fakeBMC) serve() {
+	buf := make([]byte, 1024)
+	for {
+		n, addr, err := t.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		if resp := t.handle(buf[:n]); resp != nil {
+			_, _ = t.conn.WriteTo(resp, addr)
+		}
+	}
+}
+
+func (t *fakeBMC) handle(b []byte) []byte {
+	if b[4] == authTypeRMCPPlus {
+		return t.handlePlus(b)
+	}
+	authType := b[4]
+	seq := binary.LittleEndian.Uint32(b[5:9])
+	sessionID := binary.LittleEndian.Uint32(b[9:13])
+	offset := 13
+	var code []byte
+	if authType != authTypeNone {
+		code = b[offset : offset+16]
+		offset += 16
+	}
+	size := int(b[offset])
+	msg := b[offset+1 : offset+1+size]
+	if authType != authTypeNone && !bytes.Equal(code, authCode(authType, t.password, sessionID, seq, msg)) {
+		return nil
+	}
+	rsMsg := t.handleMessage(msg, sessionID)
+	out := append([]byte{}, rmcpHeader...)
+	out = append(out, authTypeNone, 0, 0, 0, 0, 0, 0, 0, 0, byte(len(rsMsg)))
+	return append(out, rsMsg...)
+}
+
+// handlePlus answers the RMCP+ session setup messages, and the IPMI
+// messages of the activated session.
+func (t *fakeBMC) handlePlus(b []byte) []byte {
+	payloadType, sessionID, payload, err := t.plus.decode(b)
+	if err != nil {
+		return nil
+	}
+	var resp []byte
+	switch payloadType {
+	case payloadTypeOpenSessionRq:
+		t.plus = nil
+		t.suite = nil
+		for _, suite := range cipherSuites {
+			if suite.authAlg == payload[12] && suite.integrityAlg == payload[20] && suite.confAlg == payload[28] {
+				t.suite = suite
+			}
+		}
+		status := uint8(0)
+		if t.suite == nil {
+			status = 0x11
+		}
+		t.consoleSessionID = binary.LittleEndian.Uint32(payload[4:8])
+		resp = []byte{payload[0], status, privilegeOperator, 0}
+		resp = append(resp, payload[4:8]...)
+		resp = binary.LittleEndian.AppendUint32(resp, t.sessionID)
+		resp = append(resp, payload[8:32]...)
+		payloadType = payloadTypeOpenSessionRs
+	case payloadTypeRAKP1:
+		t.consoleRandom = append([]byte{}, payload[8:24]...)
+		t.bmcRandom = bytes.Repeat([]byte{0x42}, 16)
+		t.userData = append([]byte{payload[24], payload[27]}, payload[28:28+int(payload[27])]...)
+		guid := bytes.Repeat([]byte{0x24}, 16)
+		resp = []byte{payload[0], 0, 0, 0}
+		resp = binary.LittleEndian.AppendUint32(resp, t.consoleSessionID)
+		resp = append(resp, t.bmcRandom...)
+		resp = append(resp, guid...)
+		resp = append(resp, t.suite.hmac([]byte(t.password),
+			binary.LittleEndian.AppendUint32(nil, t.consoleSessionID),
+			binary.LittleEndian.AppendUint32(nil, t.sessionID),
+			t.consoleRandom, t.bmcRandom, guid, t.userData)...)
+		payloadType = payloadTypeRAKP2
+	case payloadTypeRAKP3:
+		expected := t.suite.hmac([]byte(t.password), t.bmcRandom, binary.LittleEndian.AppendUint32(nil, t.consoleSessionID), t.userData)
+		if !bytes.Equal(payload[8:], expected) {
+			return t.encodePlus(payloadTypeRAKP4, []byte{payload[0], 0x0f, 0, 0, 0, 0, 0, 0})
+		}
+		sik := t.suite.hmac([]byte(t.password), t.consoleRandom, t.bmcRandom, t.userData)
+		guid := bytes.Repeat([]byte{0x24}, 16)
+		resp = []byte{payload[0], 0, 0, 0}
+		resp = binary.LittleEndian.AppendUint32(resp, t.consoleSessionID)
+		resp = append(resp, t.suite.hmac(sik, t.consoleRandom, binary.LittleEndian.AppendUint32(nil, t.sessionID), guid)[:t.suite.icvSize]...)
+		out := t.encodePlus(payloadTypeRAKP4, resp)
+		t.plus = newPlusSession(t.suite, sik)
+		return out
+	case payloadTypeIPMI:
+		if t.plus == nil || sessionID != t.sessionID {
+			return nil
+		}
+		resp = t.handleMessage(payload, sessionID)
+	default:
+		return nil
+	}
+	return t.encodePlus(payloadType, resp)
+}
+
+func (t *fakeBMC) encodePlus(payloadType uint8, payload []byte) []byte {
+	b, err := t.plus.encode(payloadType, t.consoleSessionID, 1, payload)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// handleMessage returns the response message to the IPMI request message.
+func (t *fakeBMC) handleMessage(msg []byte, sessionID uint32) []byte {
+	netFn, cmd := msg[1]>>2, msg[5]
+	data := msg[6 : len(msg)-1]
+	var (
+		cc   uint8
+		resp []byte
+	)
+	switch {
+	case netFn == netFnApp && cmd == cmdGetChannelAuthCapabilities:
+		resp = []byte{0x01, t.supported | channelAuthCapabilitiesV2, 0, extendedCapabilitiesV2, 0, 0, 0, 0}
+	case netFn == netFnApp && cmd == cmdGetSessionChallenge:
+		resp = binary.LittleEndian.AppendUint32(nil, 0xaabbccdd)
+		resp = append(resp, bytes.Repeat([]byte{0x42}, 16)...)
+	case netFn == netFnApp && cmd == cmdActivateSession:
+		resp = []byte{data[0]}
+		resp = binary.LittleEndian.AppendUint32(resp, t.sessionID)
+		resp = binary.LittleEndian.AppendUint32(resp, 100)
+		resp = append(resp, privilegeOperator)
+	case netFn == netFnApp && (cmd == cmdSetSessionPrivilege || cmd == cmdCloseSession):
+		if sessionID != t.sessionID {
+			cc = 0xd4
+		}
+	case netFn == netFnChassis && cmd == cmdGetChassisStatus:
+		if t.powerOn {
+			resp = []byte{0x01, 0, 0}
+		} else {
+			resp = []byte{0x00, 0, 0}
+		}
+	case netFn == netFnChassis && cmd == cmdChassisControl:
+		switch ChassisControl(data[0]) {
+		case ChassisPowerDown:
+			t.powerOn = false
+		case ChassisPowerUp:
+			t.powerOn = true
+		}
+	default:
+		cc = 0xc1
+	}
+	rsMsg := []byte{consoleAddr, (netFn | 1) << 2, 0, bmcAddr, msg[4], cmd, cc}
+	rsMsg[2] = checksum(rsMsg[0:2])
+	rsMsg = append(rsMsg, resp...)
+	rsMsg = append(rsMsg, checksum(rsMsg[3:]))
+	return rsMsg
+}
+
+func TestClientPowerDown(t *testing.T) {
+	for name, supported := range map[string]uint8{
+		"md5":      1<<authTypeMD5 | 1<<authTypePassword,
+		"password": 1 << authTypePassword,
+	} {
+		t.Run(name, func(t *testing.T) {
+			bmc := newFakeBMC(t, supported, "secret")
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			c := Client{Addr: bmc.conn.LocalAddr().String(), Username: "admin", Password: "secret", Timeout: time.Second}
+			require.NoError(t, c.Open(ctx))
+
+			on, err := c.IsPowerOn(ctx)
+			require.NoError(t, err)
+			require.True(t, on)
+
+			require.NoError(t, c.ChassisControl(ctx, ChassisPowerDown))
+			on, err = c.IsPowerOn(ctx)
+			require.NoError(t, err)
+			require.False(t, on)
+			require.NoError(t, c.Close())
+		})
+	}
+}
+
+func TestClientLanPlus(t *testing.T) {
+	for _, suite := range CipherSuites() {
+		t.Run(fmt.Sprintf("cipher suite %d", suite), func(t *testing.T) {
+			bmc := newFakeBMC(t, 1<<authTypeMD5, "secret")
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			c := Client{
+				Addr:        bmc.conn.LocalAddr().String(),
+				Username:    "admin",
+				Password:    "secret",
+				Interface:   InterfaceLanPlus,
+				CipherSuite: uint8(suite),
+				Timeout:     time.Second,
+			}
+			require.NoError(t, c.Open(ctx))
+
+			on, err := c.IsPowerOn(ctx)
+			require.NoError(t, err)
+			require.True(t, on)
+
+			require.NoError(t, c.ChassisControl(ctx, ChassisPowerDown))
+			on, err = c.IsPowerOn(ctx)
+			require.NoError(t, err)
+			require.False(t, on)
+			require.NoError(t, c.Close())
+		})
+	}
+	t.Run("bad password", func(t *testing.T) {
+		bmc := newFakeBMC(t, 1<<authTypeMD5, "secret")
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		c := Client{Addr: bmc.conn.LocalAddr().String(), Username: "admin", Password: "wrong", Interface: InterfaceLanPlus, Timeout: time.Second}
+		require.ErrorIs(t, c.Open(ctx), ErrIntegrity)
+	})
+	t.Run("unsupported cipher suite", func(t *testing.T) {
+		c := Client{Addr: "127.0.0.1", Interface: InterfaceLanPlus, CipherSuite: 1}
+		require.ErrorIs(t, c.Open(context.Background()), ErrUnsupportedCipherSuite)
+	})
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := bytes.Repeat([]byte{0x01}, 16)
+	for size := range 40 {
+		data := bytes.Repeat([]byte{0xab}, size)
+		b, err := encrypt(key, data)
+		require.NoError(t, err)
+		require.Zero(t, len(b)%16)
+		plain, err := decrypt(key, b)
+		require.NoError(t, err)
+		require.Equal(t, data, plain)
+	}
+}
+
+func TestClientBadPassword(t *testing.T) {
+	bmc := newFakeBMC(t, 1<<authTypeMD5, "secret")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c := Client{Addr: bmc.conn.LocalAddr().String(), Username: "admin", Password: "wrong", Timeout: 100 * time.Millisecond}
+	require.Error(t, c.Open(ctx), "expected the fake bmc to drop the badly authenticated requests")
+}
+
+func TestChooseAuthType(t *testing.T) {
+	_, err := chooseAuthType(1<<authTypeNone, "secret")
+	require.ErrorIs(t, err, ErrNoAuthType)
+	authType, err := chooseAuthType(1<<authTypeNone, "")
+	require.NoError(t, err)
+	require.Equal(t, uint8(authTypeNone), authType)
+}
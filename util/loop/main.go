@@ -187,6 +187,25 @@ func (t T) Delete(ctx context.Context, devPath string) error {
 	return fmt.Errorf("losetup silently failed to delete %s", devPath)
 }
 
+// SetCapacity makes the loop device devPath reread the size of its backing
+// file.
+func (t T) SetCapacity(ctx context.Context, devPath string) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName(losetup),
+		command.WithVarArgs("-c", devPath),
+		command.WithLogger(t.log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
 func (t InfoEntries) Name(s string) *InfoEntry {
 	for _, i := range t {
 		if i.Name == s {
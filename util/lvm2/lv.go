@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/rs/zerolog"
@@ -26,6 +27,7 @@ type (
 		LVAttr          string `json:"lv_attr"`
 		LVSize          string `json:"lv_size"`
 		Origin          string `json:"origin"`
+		LVTime          string `json:"lv_time"`
 		DataPercent     string `json:"data_percent"`
 		CopyPercent     string `json:"copy_percent"`
 		MetadataPercent string `json:"metadata_percent"`
@@ -34,6 +36,7 @@ type (
 		ConvertPV       string `json:"convert_pv"`
 		MirrorLog       string `json:"mirror_log"`
 		Devices         string `json:"devices"`
+		PoolLV          string `json:"pool_lv"`
 	}
 	LV struct {
 		driver
@@ -161,6 +164,35 @@ func (t *LV) Show(ctx context.Context) (*LVInfo, error) {
 	return nil, fmt.Errorf("%w: %s", ErrExist, fqn)
 }
 
+// Size returns the exact size of the lv in bytes.
+func (t *LV) Size(ctx context.Context) (int64, error) {
+	data := ShowData{}
+	fqn := t.FQN()
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("lvs"),
+		command.WithVarArgs("-o", "lv_size", "--units", "b", "--nosuffix", "--reportformat", "json", fqn),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.TraceLevel),
+		command.WithStdoutLogLevel(zerolog.TraceLevel),
+		command.WithStderrLogLevel(zerolog.TraceLevel),
+		command.WithBufferedStdout(),
+	)
+	if err := cmd.Run(); err != nil {
+		if cmd.ExitCode() == 5 {
+			return 0, fmt.Errorf("%w: %s", ErrExist, fqn)
+		}
+		return 0, err
+	}
+	if err := json.Unmarshal(cmd.Stdout(), &data); err != nil {
+		return 0, err
+	}
+	if len(data.Report) == 1 && len(data.Report[0].LV) == 1 {
+		return strconv.ParseInt(strings.TrimSpace(data.Report[0].LV[0].LVSize), 10, 64)
+	}
+	return 0, fmt.Errorf("%w: %s", ErrExist, fqn)
+}
+
 func (t *LV) Attrs(ctx context.Context) (LVAttrs, error) {
 	lvInfo, err := t.Show(ctx)
 	switch {
@@ -302,3 +334,22 @@ func (t *LV) Remove(ctx context.Context, args []string) error {
 	}
 	return nil
 }
+
+// Extend grows the lv to size. The filesystem on the lv, if any, is not
+// resized.
+func (t *LV) Extend(ctx context.Context, size int64) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("lvextend"),
+		command.WithVarArgs("-L", fmt.Sprintf("%dB", size), t.FQN()),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+//go:build linux
+
+package lvm2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+const (
+	// lvTimeLayout is the layout of the lvs lv_time field.
+	lvTimeLayout = "2006-01-02 15:04:05 -0700"
+)
+
+// Size returns the LVSize field value in bytes.
+func (t *LVInfo) Size() (int64, error) {
+	return sizeconv.FromSize(strings.TrimLeft(t.LVSize, "<>+"))
+}
+
+// CreatedAt returns the LVTime field value as a time.Time.
+func (t *LVInfo) CreatedAt() (time.Time, error) {
+	return time.Parse(lvTimeLayout, t.LVTime)
+}
+
+// Used returns the bytes used in the snapshot copy-on-write area.
+func (t *LVInfo) Used() (int64, error) {
+	size, err := t.Size()
+	if err != nil {
+		return 0, err
+	}
+	if t.DataPercent == "" {
+		return 0, nil
+	}
+	pct, err := strconv.ParseFloat(t.DataPercent, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int64(float64(size) * pct / 100), nil
+}
+
+// CreateSnapshot creates the snapshot lv <name> of the lv. The size of the
+// copy-on-write area is either an absolute size or a lvcreate extents
+// expression like "20%ORIGIN".
+func (t *LV) CreateSnapshot(ctx context.Context, name, size string) error {
+	args := []string{"--snapshot"}
+	if strings.Contains(size, "%") {
+		args = append(args, "-l", size)
+	} else if i, err := sizeconv.FromSize(size); err == nil {
+		args = append(args, "-L", fmt.Sprintf("%dB", i))
+	} else {
+		args = append(args, "-L", size)
+	}
+	args = append(args, "-n", name, t.FQN())
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("lvcreate"),
+		command.WithArgs(args),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
+// Snapshots returns the information of the snapshot lvs of the lv.
+func (t *LV) Snapshots(ctx context.Context) ([]LVInfo, error) {
+	data := ShowData{}
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("lvs"),
+		command.WithVarArgs(
+			"-o", "lv_name,vg_name,lv_attr,lv_size,origin,lv_time,data_percent",
+			"--select", "origin="+t.LVName,
+			"--reportformat", "json",
+			t.VGName,
+		),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.TraceLevel),
+		command.WithStdoutLogLevel(zerolog.TraceLevel),
+		command.WithStderrLogLevel(zerolog.TraceLevel),
+		command.WithBufferedStdout(),
+	)
+	if err := cmd.Run(); err != nil {
+		if cmd.ExitCode() == 5 {
+			return nil, fmt.Errorf("%w: %s", ErrExist, t.VGName)
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(cmd.Stdout(), &data); err != nil {
+		return nil, err
+	}
+	if len(data.Report) == 1 {
+		return data.Report[0].LV, nil
+	}
+	return nil, nil
+}
+
+// Merge merges the snapshot lv into its origin. The snapshot lv is removed
+// when the merge completes. If the origin is open, the merge is deferred
+// to its next activation.
+func (t *LV) Merge(ctx context.Context) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("lvconvert"),
+		command.WithVarArgs("--merge", t.FQN()),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
+// CopyTo copies the lv data to the dst lv, which must be at least as
+// large.
+func (t *LV) CopyTo(ctx context.Context, dst *LV) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("dd"),
+		command.WithVarArgs("if="+t.DevPath(), "of="+dst.DevPath(), "bs=1M", "conv=fsync"),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
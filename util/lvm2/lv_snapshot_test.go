@@ -0,0 +1,29 @@
+//go:build linux
+
+package lvm2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLVInfo_Used(t *testing.T) {
+	lvInfo := LVInfo{LVSize: "1g", DataPercent: "25.00"}
+	used, err := lvInfo.Used()
+	require.Nil(t, err)
+	require.Equal(t, int64(256*1024*1024), used)
+
+	lvInfo = LVInfo{LVSize: "1g"}
+	used, err = lvInfo.Used()
+	require.Nil(t, err)
+	require.Equal(t, int64(0), used)
+}
+
+func TestLVInfo_CreatedAt(t *testing.T) {
+	lvInfo := LVInfo{LVTime: "2024-03-01 10:20:30 +0100"}
+	createdAt, err := lvInfo.CreatedAt()
+	require.Nil(t, err)
+	require.True(t, createdAt.Equal(time.Date(2024, 3, 1, 9, 20, 30, 0, time.UTC)))
+}
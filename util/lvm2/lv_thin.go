@@ -0,0 +1,166 @@
+//go:build linux
+
+package lvm2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+type (
+	// ThinPoolUsage is the usage of a thin pool lv.
+	ThinPoolUsage struct {
+		// Size is the size of the thin pool data lv, in bytes.
+		Size int64
+
+		// Used is the size of the data allocated in the thin pool, in bytes.
+		Used int64
+
+		// Provisioned is the sum of the virtual sizes of the thin volumes
+		// allocated from the thin pool, in bytes.
+		Provisioned int64
+
+		// DataPercent is the fill ratio of the thin pool data lv.
+		DataPercent float64
+
+		// MetadataPercent is the fill ratio of the thin pool metadata lv.
+		MetadataPercent float64
+	}
+)
+
+// CreateThinPool creates the thin pool lv. The size is either an absolute
+// size or a lvcreate extents expression like "90%FREE".
+func (t *LV) CreateThinPool(ctx context.Context, size string, args []string) error {
+	args = append(args, "--type", "thin-pool")
+	if strings.Contains(size, "%") {
+		args = append(args, "-l", size)
+	} else if i, err := sizeconv.FromSize(size); err == nil {
+		args = append(args, "-L", fmt.Sprintf("%dB", i))
+	} else {
+		args = append(args, "-L", size)
+	}
+	return t.lvcreate(ctx, append(args, "--yes", "-n", t.LVName, t.VGName))
+}
+
+// CreateThin creates the lv as a thin volume of virtual size allocated
+// from the thin pool lv <thinPool> of the same vg.
+func (t *LV) CreateThin(ctx context.Context, thinPool, size string, args []string) error {
+	i, err := sizeconv.FromSize(size)
+	if err != nil {
+		return err
+	}
+	args = append(args, "-V", fmt.Sprintf("%dB", i), "--thinpool", thinPool)
+	return t.lvcreate(ctx, append(args, "--yes", "-n", t.LVName, t.VGName))
+}
+
+// CreateThinSnapshot creates the thin snapshot lv <name> of the thin lv.
+// The snapshot allocates no space until the origin or the snapshot is
+// modified. If activationSkip is false, the snapshot can be activated
+// like a normal lv, which is required to use it as a writable clone.
+func (t *LV) CreateThinSnapshot(ctx context.Context, name string, activationSkip bool) error {
+	args := []string{"--snapshot"}
+	if activationSkip {
+		args = append(args, "-ky")
+	} else {
+		args = append(args, "-kn")
+	}
+	return t.lvcreate(ctx, append(args, "-n", name, t.FQN()))
+}
+
+func (t *LV) lvcreate(ctx context.Context, args []string) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("lvcreate"),
+		command.WithArgs(args),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
+// ThinPoolUsage returns the usage of the thin pool lv, including the
+// provisioned size of its thin volumes.
+func (t *LV) ThinPoolUsage(ctx context.Context) (ThinPoolUsage, error) {
+	data := ShowData{}
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("lvs"),
+		command.WithVarArgs(
+			"-o", "lv_name,vg_name,lv_size,pool_lv,data_percent,metadata_percent",
+			"--units", "b", "--nosuffix",
+			"--select", fmt.Sprintf("lv_name=%s || pool_lv=%s", t.LVName, t.LVName),
+			"--reportformat", "json",
+			t.VGName,
+		),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.TraceLevel),
+		command.WithStdoutLogLevel(zerolog.TraceLevel),
+		command.WithStderrLogLevel(zerolog.TraceLevel),
+		command.WithBufferedStdout(),
+	)
+	if err := cmd.Run(); err != nil {
+		if cmd.ExitCode() == 5 {
+			return ThinPoolUsage{}, fmt.Errorf("%w: %s", ErrExist, t.VGName)
+		}
+		return ThinPoolUsage{}, err
+	}
+	if err := json.Unmarshal(cmd.Stdout(), &data); err != nil {
+		return ThinPoolUsage{}, err
+	}
+	if len(data.Report) != 1 {
+		return ThinPoolUsage{}, fmt.Errorf("%w: %s", ErrExist, t.FQN())
+	}
+	return parseThinPoolUsage(t.LVName, data.Report[0].LV)
+}
+
+func parseThinPoolUsage(name string, l []LVInfo) (ThinPoolUsage, error) {
+	var (
+		usage ThinPoolUsage
+		found bool
+	)
+	parsePercent := func(s string) (float64, error) {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return 0, nil
+		}
+		return strconv.ParseFloat(s, 64)
+	}
+	for _, info := range l {
+		size, err := strconv.ParseInt(strings.TrimSpace(info.LVSize), 10, 64)
+		if err != nil {
+			return usage, fmt.Errorf("%s/%s size: %w", info.VGName, info.LVName, err)
+		}
+		switch {
+		case info.LVName == name:
+			found = true
+			usage.Size = size
+			if usage.DataPercent, err = parsePercent(info.DataPercent); err != nil {
+				return usage, fmt.Errorf("%s/%s data percent: %w", info.VGName, info.LVName, err)
+			}
+			if usage.MetadataPercent, err = parsePercent(info.MetadataPercent); err != nil {
+				return usage, fmt.Errorf("%s/%s metadata percent: %w", info.VGName, info.LVName, err)
+			}
+		case info.PoolLV == name:
+			usage.Provisioned += size
+		}
+	}
+	if !found {
+		return usage, fmt.Errorf("%w: thin pool %s", ErrExist, name)
+	}
+	usage.Used = int64(float64(usage.Size) * usage.DataPercent / 100)
+	return usage, nil
+}
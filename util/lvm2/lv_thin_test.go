@@ -0,0 +1,29 @@
+//go:build linux
+
+package lvm2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseThinPoolUsage(t *testing.T) {
+	l := []LVInfo{
+		{LVName: "pool", VGName: "vg", LVSize: "1073741824", DataPercent: "25.00", MetadataPercent: "10.50"},
+		{LVName: "thin1", VGName: "vg", LVSize: "2147483648", PoolLV: "pool", DataPercent: "12.50"},
+		{LVName: "thin2", VGName: "vg", LVSize: "1073741824", PoolLV: "pool"},
+		{LVName: "other", VGName: "vg", LVSize: "1073741824", PoolLV: "pool2"},
+	}
+	usage, err := parseThinPoolUsage("pool", l)
+	require.Nil(t, err)
+	require.Equal(t, int64(1073741824), usage.Size)
+	require.Equal(t, int64(268435456), usage.Used)
+	require.Equal(t, int64(3221225472), usage.Provisioned)
+	require.Equal(t, 25.0, usage.DataPercent)
+	require.Equal(t, 10.5, usage.MetadataPercent)
+
+	_, err = parseThinPoolUsage("pool3", l)
+	require.True(t, errors.Is(err, ErrExist))
+}
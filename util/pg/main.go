@@ -35,6 +35,7 @@ type (
 		configs map[string]*Config
 	}
 	CPUQuota string
+	CPUSet   string
 	key      int
 )
 
@@ -244,3 +245,33 @@ func (t CPUQuota) Convert(period uint64) (int64, error) {
 	}
 	return int64(pct) * int64(period) * int64(cpus) / int64(maxCpus) / 100, nil
 }
+
+// Count returns the number of cpus in the cpuset, for example 4 for "0-2,5".
+func (t CPUSet) Count() (int, error) {
+	var n int
+	invalidFmtError := "invalid cpuset format: %s (accepted expressions: 0-2, 0,1,2, 0-2,5)"
+	for _, s := range strings.Split(string(t), ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		first, last, isRange := strings.Cut(s, "-")
+		i, err := strconv.Atoi(first)
+		if err != nil {
+			return 0, fmt.Errorf(invalidFmtError+": %w", t, err)
+		}
+		if !isRange {
+			n++
+			continue
+		}
+		j, err := strconv.Atoi(last)
+		if err != nil {
+			return 0, fmt.Errorf(invalidFmtError+": %w", t, err)
+		}
+		if j < i {
+			return 0, fmt.Errorf(invalidFmtError, t)
+		}
+		n += j - i + 1
+	}
+	return n, nil
+}
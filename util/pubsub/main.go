@@ -105,6 +105,10 @@ type (
 		// when non 0, the subscription is stopped if the push timeout exceeds timeout
 		timeout time.Duration
 
+		// withSeq is true if the messages are received wrapped in a
+		// Sequenced.
+		withSeq bool
+
 		// block is 'yes' when subscription timeout is 0 (the subscription is not
 		// stopped when blocked on full internal queue).
 		block string
@@ -156,6 +160,7 @@ type (
 		timeout   time.Duration
 		queueSize uint64
 		family    string
+		withSeq   bool
 	}
 
 	cmdUnsub struct {
@@ -199,6 +204,9 @@ type (
 		bufferedPublicationC chan cmdPub
 		// bufferedPublicationEnabled is true if publication buffering is enabled
 		bufferedPublicationEnabled bool
+
+		// seq is the sequence number of the last delivered publication
+		seq atomic.Uint64
 	}
 
 	stringer interface {
@@ -209,6 +217,14 @@ type (
 		Labels Labels `json:"labels"`
 	}
 
+	// Sequenced is a published message with its bus publication sequence
+	// number, received by the subscriptions created with the WithSeq
+	// option.
+	Sequenced struct {
+		Seq  uint64
+		Data any
+	}
+
 	Messager interface {
 		AddLabels(...Label)
 		GetLabels() Labels
@@ -551,6 +567,24 @@ func (b *Bus) SetDrainChanDuration(duration time.Duration) {
 	b.drainChanDuration = duration
 }
 
+// SetSeq sets the sequence number of the last publication of a not yet
+// started bus. The next publications are numbered from v+1, so a bus
+// restarted with the last sequence number of a previous run delivers
+// monotonically increasing sequence numbers across runs.
+//
+// It panics if called on started bus.
+func (b *Bus) SetSeq(v uint64) {
+	if b.started {
+		panic("can't set sequence number on started bus")
+	}
+	b.seq.Store(v)
+}
+
+// Seq returns the sequence number of the last delivered publication.
+func (b *Bus) Seq() uint64 {
+	return b.seq.Load()
+}
+
 // SetDefaultSubscriptionQueueSize overrides the default queue size of subscribers for not yet started bus.
 //
 // It panics if called on started bus.
@@ -582,6 +616,7 @@ func (b *Bus) onSubCmd(c cmdSub) {
 		q:         make(chan any, c.queueSize),
 		id:        id,
 		timeout:   c.timeout,
+		withSeq:   c.withSeq,
 		bus:       b,
 		publisher: b,
 
@@ -632,6 +667,10 @@ func (b *Bus) doBufferedPublication(c cmdPub) {
 }
 
 func (b *Bus) doPublication(c cmdPub) {
+	// the sequence number is delivered aside the message, so the
+	// published message is not modified.
+	seq := b.seq.Add(1)
+	var sequenced *Sequenced
 	for _, toFilterKey := range c.pubKeys {
 		// search subscribers that listen to on one of cmdPub.keys
 		if subIDMap, ok := b.subMap[toFilterKey]; ok {
@@ -644,7 +683,14 @@ func (b *Bus) doPublication(c cmdPub) {
 				}
 				b.log.Tracef("route %s to %s", c, sub)
 				queueLen := sub.queued.Add(1)
-				sub.q <- c.data
+				if sub.withSeq {
+					if sequenced == nil {
+						sequenced = &Sequenced{Seq: seq, Data: c.data}
+					}
+					sub.q <- sequenced
+				} else {
+					sub.q <- c.data
+				}
 				publicationPushedTotal.With(prometheus.Labels{"filterkey": toFilterKey}).Inc()
 				if queueLen >= sub.queuedSize {
 					subscriptionQueueFullTotal.With(prometheus.Labels{"family": sub.family, "block": sub.block}).Inc()
@@ -885,6 +931,10 @@ type (
 	WithQueueSize uint64
 
 	Timeout time.Duration
+
+	// WithSeq is the subscription option delivering the messages wrapped
+	// in a Sequenced, with their bus publication sequence number.
+	WithSeq bool
 )
 
 // queueSize implements QueueSizer for WithQueueSize
@@ -927,6 +977,8 @@ func (b *Bus) Sub(name string, options ...interface{}) *Subscription {
 			op.timeout = v.timeout()
 		case QueueSizer:
 			op.queueSize = v.queueSize()
+		case WithSeq:
+			op.withSeq = bool(v)
 		default:
 			panic("invalid option type: " + reflect.TypeOf(opt).String())
 		}
@@ -65,6 +65,28 @@ func TestPub(t *testing.T) {
 	pub.Pub(&msgT{v: "foobar"})
 }
 
+func TestSeq(t *testing.T) {
+	bus := NewBus(t.Name())
+	bus.SetSeq(100)
+	bus.Start(context.Background())
+	defer bus.Stop()
+
+	sub := bus.Sub("listen all", WithSeq(true))
+	sub.Start()
+	defer func() {
+		assert.NoError(t, sub.Stop())
+	}()
+
+	foo := &msgT{v: "foo"}
+	bus.Pub(foo)
+	bus.Pub(&msgS{v: "bar"})
+	require.Equal(t, &Sequenced{Seq: 101, Data: foo}, <-sub.C)
+	require.Equal(t, uint64(102), (<-sub.C).(*Sequenced).Seq)
+	require.Equal(t, uint64(102), bus.Seq())
+	require.Equal(t, &msgT{v: "foo"}, foo, "expected the published message not modified")
+	require.Panics(t, func() { bus.SetSeq(0) })
+}
+
 func TestGenerateCombinations(t *testing.T) {
 	cases := map[string]struct {
 		input    []string
@@ -86,6 +86,15 @@ func (t PersistentReservationHandle) countHandledRegistrations(registrations []s
 	return n
 }
 
+// Driver returns the persistent reservation driver usable on this node,
+// based on the available mpathpersist or sg_persist commands.
+func (t *PersistentReservationHandle) Driver() (PersistentReservationDriver, error) {
+	if err := t.setup(); err != nil {
+		return nil, err
+	}
+	return t.persistentReservationDriver, nil
+}
+
 func (t *PersistentReservationHandle) Status() status.T {
 	if err := t.setup(); err != nil {
 		t.StatusLogger.Error("%s", err)
@@ -0,0 +1,126 @@
+package zfs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	// Snap is a <dataset>@<snapshot> snapshot of a filesystem or volume
+	// dataset.
+	Snap struct {
+		Name string
+		Log  *plog.Logger
+	}
+
+	SnapInfo struct {
+		Name      string
+		CreatedAt time.Time
+		Used      int64
+	}
+	SnapInfos []SnapInfo
+)
+
+func (t Snap) GetName() string {
+	return t.Name
+}
+
+func (t Snap) GetLog() *plog.Logger {
+	return t.Log
+}
+
+// DatasetName returns the name of the snapshotted dataset.
+func (t Snap) DatasetName() string {
+	s, _, _ := strings.Cut(t.Name, "@")
+	return s
+}
+
+// SnapName returns the name of the snapshot, without the dataset prefix.
+func (t Snap) SnapName() string {
+	_, s, _ := strings.Cut(t.Name, "@")
+	return s
+}
+
+func (t *Snap) run(ctx context.Context, args ...string) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("/usr/sbin/zfs"),
+		command.WithVarArgs(args...),
+		command.WithLogger(t.Log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// Create creates the snapshot.
+func (t *Snap) Create(ctx context.Context) error {
+	return t.run(ctx, "snapshot", t.Name)
+}
+
+// Destroy destroys the snapshot.
+func (t *Snap) Destroy(ctx context.Context) error {
+	return t.run(ctx, "destroy", t.Name)
+}
+
+// Rollback rolls the dataset back to the snapshot, destroying the more
+// recent snapshots.
+func (t *Snap) Rollback(ctx context.Context) error {
+	return t.run(ctx, "rollback", "-r", t.Name)
+}
+
+// Clone creates the dataset <name> from the snapshot.
+func (t *Snap) Clone(ctx context.Context, name string) error {
+	return t.run(ctx, "clone", t.Name, name)
+}
+
+func parseSnapInfos(b []byte) SnapInfos {
+	data := make(SnapInfos, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		words := strings.Split(line, "\t")
+		if len(words) != 3 {
+			continue
+		}
+		info := SnapInfo{Name: words[0]}
+		if i, err := strconv.ParseInt(words[1], 10, 64); err == nil {
+			info.CreatedAt = time.Unix(i, 0)
+		}
+		if i, err := strconv.ParseInt(words[2], 10, 64); err == nil {
+			info.Used = i
+		}
+		data = append(data, info)
+	}
+	return data
+}
+
+// ListSnaps returns the snapshots of the dataset <name>, sorted by
+// creation date.
+func ListSnaps(ctx context.Context, name string, log *plog.Logger) (SnapInfos, error) {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("/usr/sbin/zfs"),
+		command.WithVarArgs("list", "-t", "snapshot", "-Hp", "-d", "1", "-s", "creation", "-o", "name,creation,used", name),
+		command.WithBufferedStdout(),
+		command.WithLogger(log),
+		command.WithCommandLogLevel(zerolog.TraceLevel),
+		command.WithStdoutLogLevel(zerolog.TraceLevel),
+		command.WithStderrLogLevel(zerolog.TraceLevel),
+	)
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseSnapInfos(b), nil
+}